@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 	"time"
@@ -9,14 +10,21 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	"github.com/sagikazarmark/modern-go-application/internal/platform/blob"
 	"github.com/sagikazarmark/modern-go-application/internal/platform/database"
 	"github.com/sagikazarmark/modern-go-application/internal/platform/log"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/mail"
 	"github.com/sagikazarmark/modern-go-application/internal/platform/opencensus"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/scheduler"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/watermill"
 )
 
 // configuration holds any kind of configuration that comes from the outside world and
 // is necessary for running the application.
 type configuration struct {
+	// Time to wait for active requests to finish when shutting down
+	ShutdownTimeout time.Duration
+
 	// Log configuration
 	Log log.Config
 
@@ -40,8 +48,33 @@ type configuration struct {
 	// App configuration
 	App appConfig
 
+	// Event publishing configuration
+	Events struct {
+		Async struct {
+			Enabled bool
+
+			watermill.AsyncPublisherConfig `mapstructure:",squash"`
+		}
+	}
+
+	// Scheduler configuration
+	Scheduler struct {
+		// Jobs holds the schedule of registered jobs by name
+		Jobs map[string]scheduler.JobConfig
+	}
+
 	// Database connection information
 	Database database.Config
+
+	// Blob storage configuration (eg. for attachments)
+	Blob blob.Config
+
+	// Mail delivery configuration (eg. for notification emails)
+	Mail struct {
+		Enabled bool
+
+		mail.Config `mapstructure:",squash"`
+	}
 }
 
 // Process post-processes configuration after loading it.
@@ -59,10 +92,32 @@ func (c configuration) Validate() error {
 		return err
 	}
 
+	if c.Events.Async.Enabled {
+		if err := c.Events.Async.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for name, job := range c.Scheduler.Jobs {
+		if err := job.Validate(); err != nil {
+			return fmt.Errorf("job %s: %w", name, err)
+		}
+	}
+
 	if err := c.Database.Validate(); err != nil {
 		return err
 	}
 
+	if err := c.Blob.Validate(); err != nil {
+		return err
+	}
+
+	if c.Mail.Enabled {
+		if err := c.Mail.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -77,6 +132,122 @@ type appConfig struct {
 
 	// Storage is the storage backend of the application
 	Storage string
+
+	// TrashRetentionDays is the number of days deleted todo items are kept in the trash
+	TrashRetentionDays int
+
+	// Attachments configures files attached to todo items
+	Attachments attachmentsConfig
+
+	// Notifications configures notification delivery
+	Notifications notificationsConfig
+
+	// EmailGateway configures the SMTP listener creating todo items from emails
+	EmailGateway emailGatewayConfig
+}
+
+// attachmentsConfig represents the configuration of todo item attachments.
+type attachmentsConfig struct {
+	// MaxSize is the size limit of attachments in bytes
+	MaxSize int64
+
+	// ContentTypes are the accepted content types (eg. image/png or image/*)
+	ContentTypes []string
+
+	// LinkExpiry is how long download links are valid
+	LinkExpiry time.Duration
+
+	// SigningKey signs download links
+	SigningKey string
+}
+
+// notificationsConfig represents the configuration of notification delivery.
+type notificationsConfig struct {
+	// Addresses are the email addresses of users (users whose ID is an email address need no entry)
+	Addresses []userAddressConfig
+}
+
+// userAddressConfig maps a user to an email address.
+type userAddressConfig struct {
+	User    string
+	Address string
+}
+
+// emailGatewayConfig represents the configuration of the email gateway.
+type emailGatewayConfig struct {
+	Enabled bool
+
+	// SMTP server address
+	Addr string
+
+	mail.ServerConfig `mapstructure:",squash"`
+
+	// Mailboxes are the addresses accepting emails
+	Mailboxes []mailboxConfig
+
+	// Senders are the addresses allowed to send emails
+	Senders []senderConfig
+
+	// RateLimit is the number of emails a sender can send per RateInterval (unlimited if zero)
+	RateLimit int
+
+	// RateInterval is the period of the rate limit
+	RateInterval time.Duration
+}
+
+// mailboxConfig maps an email address to a todo list.
+type mailboxConfig struct {
+	Address string
+
+	// ListID is the list items are added to (the default list if empty)
+	ListID string
+}
+
+// senderConfig maps an email address to the user owning the items created from its emails.
+type senderConfig struct {
+	Address string
+	User    string
+}
+
+// Validate validates the configuration.
+func (c emailGatewayConfig) Validate() error {
+	if c.Addr == "" {
+		return errors.New("email gateway address is required")
+	}
+
+	if err := c.ServerConfig.Validate(); err != nil {
+		return err
+	}
+
+	if len(c.Mailboxes) == 0 {
+		return errors.New("email gateway requires at least one mailbox")
+	}
+
+	for _, mailbox := range c.Mailboxes {
+		if mailbox.Address == "" {
+			return errors.New("email gateway mailboxes must have an address")
+		}
+	}
+
+	if len(c.Senders) == 0 {
+		return errors.New("email gateway requires at least one sender")
+	}
+
+	for _, sender := range c.Senders {
+		if sender.Address == "" || sender.User == "" {
+			return errors.New("email gateway senders must have an address and a user")
+		}
+	}
+
+	if c.RateLimit < 0 {
+		return errors.New("email gateway rate limit cannot be negative")
+	}
+
+	if c.RateLimit > 0 && c.RateInterval <= 0 {
+		return errors.New("email gateway rate interval must be positive")
+	}
+
+	return nil
 }
 
 // Validate validates the configuration.
@@ -93,6 +264,34 @@ func (c appConfig) Validate() error {
 		return errors.New("app storage must be inmemory or database")
 	}
 
+	if c.TrashRetentionDays < 1 {
+		return errors.New("app trash retention must be at least one day")
+	}
+
+	if c.Attachments.MaxSize < 1 {
+		return errors.New("app attachment size limit must be positive")
+	}
+
+	if c.Attachments.LinkExpiry < time.Second {
+		return errors.New("app attachment link expiry must be at least one second")
+	}
+
+	if c.Attachments.SigningKey == "" {
+		return errors.New("app attachment signing key is required")
+	}
+
+	for _, address := range c.Notifications.Addresses {
+		if address.User == "" || address.Address == "" {
+			return errors.New("app notification addresses must have a user and an address")
+		}
+	}
+
+	if c.EmailGateway.Enabled {
+		if err := c.EmailGateway.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -141,6 +340,46 @@ func configure(v *viper.Viper, f *pflag.FlagSet) {
 	v.SetDefault("app.grpcAddr", ":8001")
 
 	v.SetDefault("app.storage", "inmemory")
+	v.SetDefault("app.trashRetentionDays", 30)
+	v.SetDefault("app.attachments.maxSize", 10<<20)
+	v.SetDefault("app.attachments.contentTypes", []string{
+		"image/*",
+		"text/plain",
+		"text/csv",
+		"application/pdf",
+		"application/zip",
+	})
+	v.SetDefault("app.attachments.linkExpiry", 15*time.Minute)
+	_ = v.BindEnv("app.attachments.signingKey")
+
+	v.SetDefault("app.emailGateway.enabled", false)
+	f.String("smtp-addr", ":2525", "App SMTP server address (email gateway)")
+	_ = v.BindPFlag("app.emailGateway.addr", f.Lookup("smtp-addr"))
+	v.SetDefault("app.emailGateway.addr", ":2525")
+	v.SetDefault("app.emailGateway.domain", "localhost")
+	v.SetDefault("app.emailGateway.maxMessageSize", 25<<20)
+	v.SetDefault("app.emailGateway.maxRecipients", 10)
+	v.SetDefault("app.emailGateway.maxSessions", 100)
+	v.SetDefault("app.emailGateway.timeout", 5*time.Minute)
+	v.SetDefault("app.emailGateway.rateLimit", 10)
+	v.SetDefault("app.emailGateway.rateInterval", time.Hour)
+
+	// Event publishing configuration
+	v.SetDefault("events.async.enabled", false)
+	v.SetDefault("events.async.queueSize", 1000)
+	v.SetDefault("events.async.batchSize", 100)
+	v.SetDefault("events.async.backpressure", watermill.BackpressureBlock)
+	v.SetDefault("events.async.flushTimeout", 5*time.Second)
+
+	// Scheduler configuration
+	v.SetDefault("scheduler.jobs.todo_due_items.schedule", "* * * * *")
+	v.SetDefault("scheduler.jobs.todo_due_items.timeout", 30*time.Second)
+	v.SetDefault("scheduler.jobs.todo_trash_purge.schedule", "0 * * * *")
+	v.SetDefault("scheduler.jobs.todo_trash_purge.timeout", time.Minute)
+	v.SetDefault("scheduler.jobs.todo_attachment_cleanup.schedule", "30 * * * *")
+	v.SetDefault("scheduler.jobs.todo_attachment_cleanup.timeout", 5*time.Minute)
+	v.SetDefault("scheduler.jobs.notification_email_digest.schedule", "0 7 * * *")
+	v.SetDefault("scheduler.jobs.notification_email_digest.timeout", 5*time.Minute)
 
 	// Database configuration
 	_ = v.BindEnv("database.host")
@@ -151,4 +390,26 @@ func configure(v *viper.Viper, f *pflag.FlagSet) {
 	v.SetDefault("database.params", map[string]string{
 		"collation": "utf8mb4_general_ci",
 	})
+
+	// Blob storage configuration
+	v.SetDefault("blob.driver", "local")
+	v.SetDefault("blob.local.dir", "var/blobs")
+	_ = v.BindEnv("blob.s3.endpoint")
+	_ = v.BindEnv("blob.s3.region")
+	_ = v.BindEnv("blob.s3.bucket")
+	_ = v.BindEnv("blob.s3.accessKey")
+	_ = v.BindEnv("blob.s3.secretKey")
+	v.SetDefault("blob.s3.pathStyle", false)
+
+	// Mail configuration
+	v.SetDefault("mail.enabled", false)
+	_ = v.BindEnv("mail.host")
+	v.SetDefault("mail.port", 587)
+	_ = v.BindEnv("mail.username")
+	_ = v.BindEnv("mail.password")
+	v.SetDefault("mail.from", "Todo <todo@localhost>")
+	v.SetDefault("mail.security", mail.SecuritySTARTTLS)
+	v.SetDefault("mail.timeout", 30*time.Second)
+	v.SetDefault("mail.retries", 3)
+	v.SetDefault("mail.retryInterval", 5*time.Second)
 }
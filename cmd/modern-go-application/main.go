@@ -40,12 +40,18 @@ import (
 	"logur.dev/logur"
 
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/tododriver"
 	"github.com/sagikazarmark/modern-go-application/internal/common/commonadapter"
 	"github.com/sagikazarmark/modern-go-application/internal/platform/appkit"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/blob"
 	"github.com/sagikazarmark/modern-go-application/internal/platform/database"
 	"github.com/sagikazarmark/modern-go-application/internal/platform/gosundheit"
 	"github.com/sagikazarmark/modern-go-application/internal/platform/log"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/mail"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/scheduler"
 	"github.com/sagikazarmark/modern-go-application/internal/platform/watermill"
 )
 
@@ -240,8 +246,49 @@ func main() {
 	defer subscriber.Close()
 
 	publisher = watermill.PublisherCorrelationID(publisher)
+	publisher = watermill.PublisherMetrics(publisher)
+
+	if config.Events.Async.Enabled {
+		asyncPublisher := watermill.NewAsyncPublisher(
+			publisher,
+			config.Events.Async.AsyncPublisherConfig,
+			emperror.WithDetails(errorHandler, "component", "watermill", "publisher", "async"),
+		)
+		// Deferred after the underlying publisher, so queued messages are flushed (within the flush timeout) before it's closed
+		defer func() { errorHandler.Handle(asyncPublisher.Close()) }()
+
+		publisher = asyncPublisher
+	}
 	subscriber = watermill.SubscriberCorrelationID(subscriber)
 
+	routerMonitor := watermill.NewRouterMonitor(30 * time.Second)
+	telemetryRouter.Handle("/debug/events", watermill.EventsHTTPHandler(routerMonitor))
+
+	// Register message router health check
+	_ = healthChecker.RegisterCheck(&health.Config{
+		Check: &checks.CustomCheck{
+			CheckName: "router.check",
+			CheckFunc: routerMonitor.Check,
+		},
+		ExecutionPeriod: 3 * time.Second,
+	})
+
+	// Configure job scheduler
+	var jobLocker scheduler.Locker = scheduler.NewLocalLocker()
+	if config.App.Storage == "database" {
+		hostname, _ := os.Hostname()
+
+		mysqlLocker := scheduler.NewMySQLLocker(db, hostname)
+
+		err := mysqlLocker.CreateSchema(context.Background())
+		emperror.Panic(err)
+
+		jobLocker = mysqlLocker
+	}
+
+	jobScheduler := scheduler.New(jobLocker, logur.WithField(logger, "component", "scheduler"), errorHandler)
+	telemetryRouter.Handle("/debug/jobs", scheduler.JobsHTTPHandler(jobScheduler))
+
 	// Register stat views
 	err = view.Register(
 		// Health checks
@@ -263,6 +310,16 @@ func main() {
 		ocgrpc.ServerLatencyView,
 		ocgrpc.ServerCompletedRPCsView,
 
+		// Messaging
+		watermill.PublishedMessageCountView,
+		watermill.PublishFailedMessageCountView,
+		watermill.ConsumedMessageCountView,
+		watermill.FailedMessageCountView,
+		watermill.RetriedMessageCountView,
+		watermill.HandlerLatencyView,
+		watermill.AsyncQueueLengthView,
+		watermill.AsyncDroppedMessageCountView,
+
 		// Todo
 		tododriver.CreatedTodoItemCountView,
 		tododriver.CompleteTodoItemCountView,
@@ -280,7 +337,7 @@ func main() {
 		cors := handlers.CORS(
 			handlers.AllowedOrigins([]string{"*"}),
 			handlers.AllowedMethods([]string{http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodDelete}),
-			handlers.AllowedHeaders([]string{"content-type"}),
+			handlers.AllowedHeaders([]string{"content-type", "x-user-id"}),
 		)
 
 		httpServer := &http.Server{
@@ -314,15 +371,82 @@ func main() {
 				appkiterrors.IsServiceError, // filter out service errors
 			)
 
-			mga.InitializeApp(httpRouter, grpcServer, publisher, config.App.Storage, db, logger, errorHandler)
+			blobStore, err := blob.NewStore(config.Blob)
+			emperror.Panic(err)
 
-			h, err := watermill.NewRouter(logger)
+			addresses := make(notification.StaticAddressBook, len(config.App.Notifications.Addresses))
+			for _, address := range config.App.Notifications.Addresses {
+				addresses[address.User] = address.Address
+			}
+
+			emails := notification.EmailConfig{Addresses: addresses}
+			if config.Mail.Enabled {
+				sender, err := mail.NewSMTPSender(config.Mail.Config)
+				emperror.Panic(err)
+
+				emails.Mailer = notificationadapter.NewMailer(sender)
+			}
+
+			emailGateway := todo.EmailGatewayConfig{
+				Mailboxes:    make(map[string]string, len(config.App.EmailGateway.Mailboxes)),
+				Senders:      make(map[string]string, len(config.App.EmailGateway.Senders)),
+				RateLimit:    config.App.EmailGateway.RateLimit,
+				RateInterval: config.App.EmailGateway.RateInterval,
+			}
+			for _, mailbox := range config.App.EmailGateway.Mailboxes {
+				emailGateway.Mailboxes[mailbox.Address] = mailbox.ListID
+			}
+			for _, sender := range config.App.EmailGateway.Senders {
+				emailGateway.Senders[sender.Address] = sender.User
+			}
+
+			eventHandlers := mga.InitializeApp(
+				httpRouter,
+				httpServer.RegisterOnShutdown,
+				grpcServer,
+				publisher,
+				config.App.Storage,
+				time.Duration(config.App.TrashRetentionDays)*24*time.Hour,
+				todo.AttachmentConfig{
+					Blobs:        blobStore,
+					MaxSize:      config.App.Attachments.MaxSize,
+					ContentTypes: config.App.Attachments.ContentTypes,
+					LinkExpiry:   config.App.Attachments.LinkExpiry,
+					SigningKey:   []byte(config.App.Attachments.SigningKey),
+				},
+				emails,
+				emailGateway,
+				db,
+				jobScheduler,
+				logger,
+				errorHandler,
+			)
+
+			h, err := watermill.NewRouter(logger, routerMonitor)
 			emperror.Panic(err)
 
-			err = mga.RegisterEventHandlers(h, subscriber, logger)
+			err = mga.RegisterEventHandlers(h, routerMonitor.SubscriberConstructor(subscriber), eventHandlers, logger)
 			emperror.Panic(err)
 
 			group.Add(func() error { return h.Run(context.Background()) }, func(e error) { _ = h.Close() })
+
+			if config.App.EmailGateway.Enabled {
+				logger.Info("listening on address", map[string]interface{}{"address": config.App.EmailGateway.Addr})
+
+				smtpLn, err := upg.Fds.Listen("tcp", config.App.EmailGateway.Addr)
+				emperror.Panic(err)
+
+				smtpServer := mail.NewServer(
+					config.App.EmailGateway.ServerConfig,
+					eventHandlers.InboundEmails,
+					emperror.WithDetails(errorHandler, "component", "smtp"),
+				)
+
+				group.Add(
+					func() error { return smtpServer.Serve(smtpLn) },
+					func(err error) { _ = smtpServer.Close() },
+				)
+			}
 		}
 
 		logger.Info("listening on address", map[string]interface{}{"address": config.App.HttpAddr})
@@ -337,7 +461,12 @@ func main() {
 
 		group.Add(
 			func() error { return httpServer.Serve(httpLn) },
-			func(err error) { _ = httpServer.Shutdown(context.Background()) },
+			func(err error) {
+				ctx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+				defer cancel()
+
+				_ = httpServer.Shutdown(ctx)
+			},
 		)
 		group.Add(
 			func() error { return grpcServer.Serve(grpcLn) },
@@ -345,6 +474,18 @@ func main() {
 		)
 	}
 
+	// Set up job scheduler
+	{
+		for name, jobConfig := range config.Scheduler.Jobs {
+			err := jobScheduler.ScheduleJob(name, jobConfig)
+			emperror.Panic(err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		group.Add(func() error { return jobScheduler.Run(ctx) }, func(err error) { cancel() })
+	}
+
 	// Setup signal handler
 	group.Add(run.SignalHandler(context.Background(), syscall.SIGINT, syscall.SIGTERM))
 
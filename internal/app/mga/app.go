@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
 	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/ThreeDotsLabs/watermill/components/cqrs"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -22,35 +24,92 @@ import (
 	kitxtransport "github.com/sagikazarmark/kitx/transport"
 	kitxgrpc "github.com/sagikazarmark/kitx/transport/grpc"
 	kitxhttp "github.com/sagikazarmark/kitx/transport/http"
-	todov1 "github.com/sagikazarmark/todobackend-go-kit/api/todo/v1"
-	"github.com/sagikazarmark/todobackend-go-kit/todo"
-	"github.com/sagikazarmark/todobackend-go-kit/todo/tododriver"
 	"google.golang.org/grpc"
 	watermilllog "logur.dev/integration/watermill"
 
+	notificationv1 "github.com/sagikazarmark/modern-go-application/api/notification/v1"
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/httpbin"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/landing/landingdriver"
-	todo2 "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter"
+	notificationent "github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent"
+	notificationmigrate "github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/migrate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationdriver"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/migrate"
-	tododriver2 "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/tododriver"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/tododriver"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todogen"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/mail"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/scheduler"
 	"github.com/sagikazarmark/modern-go-application/static/templates"
 )
 
 const todoTopic = "todo"
 
+const (
+	// TodoDueItemsJob is the name of the job notifying about items that are due soon or overdue.
+	TodoDueItemsJob = "todo_due_items"
+
+	// todoDueSoonWindow is the time before the due date an item is considered to be due soon.
+	todoDueSoonWindow = time.Hour
+
+	// TodoTrashPurgeJob is the name of the job permanently deleting items kept in the trash for too long.
+	TodoTrashPurgeJob = "todo_trash_purge"
+
+	// TodoAttachmentCleanupJob is the name of the job deleting the attachments of permanently deleted items.
+	TodoAttachmentCleanupJob = "todo_attachment_cleanup"
+
+	// NotificationEmailDigestJob is the name of the job emailing users a digest of their open and overdue items.
+	NotificationEmailDigestJob = "notification_email_digest"
+)
+
+// JobRegistry registers jobs that can be scheduled.
+type JobRegistry interface {
+	// RegisterJob registers a job implementation under a name.
+	RegisterJob(name string, fn scheduler.JobFunc)
+}
+
+// EventHandlers are the event handlers built by InitializeApp.
+type EventHandlers struct {
+	// Rules runs the automation rules of todo items.
+	Rules todo.RuleEngine
+
+	// Notifications turns todo events into notifications.
+	Notifications notification.EventHandler
+
+	// Emails emails users about todo events (nil if email notifications are disabled).
+	Emails *notification.EmailHandler
+
+	// InboundEmails creates todo items from the emails received by an SMTP server.
+	InboundEmails mail.Handler
+}
+
 // InitializeApp initializes a new HTTP and a new gRPC application.
+// It returns the event handlers depending on the application services
+// (including the handler of inbound emails).
+// Functions passed to onShutdown end long-running HTTP requests (eg. notification streams)
+// when the HTTP server shuts down.
 func InitializeApp(
 	httpRouter *mux.Router,
+	onShutdown func(f func()),
 	grpcServer *grpc.Server,
 	publisher message.Publisher,
 	storage string,
+	trashRetention time.Duration,
+	attachments todo.AttachmentConfig,
+	emails notification.EmailConfig,
+	inboundEmails todo.EmailGatewayConfig,
 	db *sql.DB,
+	jobs JobRegistry,
 	logger Logger,
 	errorHandler ErrorHandler, // nolint: interfacer
-) {
+) EventHandlers {
+	var handlers EventHandlers
+
 	endpointMiddleware := []endpoint.Middleware{
 		correlation.Middleware(),
 		opencensus.TraceEndpoint("", opencensus.WithSpanName(func(ctx context.Context, _ string) string {
@@ -66,14 +125,17 @@ func InitializeApp(
 	httpServerOptions := []kithttp.ServerOption{
 		kithttp.ServerErrorHandler(transportErrorHandler),
 		kithttp.ServerErrorEncoder(kitxhttp.NewJSONProblemErrorEncoder(appkithttp.NewDefaultProblemConverter())),
-		kithttp.ServerBefore(correlation.HTTPToContext(), kithttp.PopulateRequestContext),
+		kithttp.ServerBefore(correlation.HTTPToContext(), principal.HTTPToContext(), kithttp.PopulateRequestContext),
 	}
 
 	grpcServerOptions := []kitgrpc.ServerOption{
 		kitgrpc.ServerErrorHandler(transportErrorHandler),
-		kitgrpc.ServerBefore(correlation.GRPCToContext()),
+		kitgrpc.ServerBefore(correlation.GRPCToContext(), principal.GRPCToContext()),
 	}
 
+	// items are summarized in notification digests
+	var items notification.ItemStore
+
 	{
 		eventBus, _ := cqrs.NewEventBus(
 			publisher,
@@ -81,13 +143,17 @@ func InitializeApp(
 			cqrs.JSONMarshaler{GenerateName: cqrs.StructName},
 		)
 
-		var store todo.Store = todo.NewInMemoryStore()
+		var store interface {
+			todo.ServiceStore
+			todo.DueItemStore
+		} = todo.NewInMemoryStore()
 		if storage == "database" {
 			client := ent.NewClient(ent.Driver(entsql.OpenDB("mysql", db)))
 			err := client.Schema.Create(
 				context.Background(),
 				migrate.WithDropIndex(true),
 				migrate.WithDropColumn(true),
+				schema.WithHooks(todoadapter.FullTextIndexHook(db)),
 			)
 			if err != nil {
 				panic(err)
@@ -96,10 +162,24 @@ func InitializeApp(
 			store = todoadapter.NewEntStore(client)
 		}
 
-		service := todo.NewService(ulidgen.NewGenerator(), store)
-		service = todo2.EventMiddleware(todogen.NewEventDispatcher(eventBus))(service)
-		service = tododriver2.LoggingMiddleware(logger)(service)
-		service = tododriver2.InstrumentationMiddleware()(service)
+		items = store
+
+		events := todogen.NewEventDispatcher(eventBus)
+
+		service := todo.NewService(ulidgen.NewGenerator(), store, attachments)
+		service = todo.FeatureMiddleware(ulidgen.NewGenerator(), store, events)(service)
+		service = tododriver.LoggingMiddleware(logger)(service)
+		service = tododriver.InstrumentationMiddleware()(service)
+
+		handlers.Rules = todo.NewRuleEngine(service, store, store, events, ulidgen.NewGenerator())
+
+		// Attachments of emails are stored if attachments are enabled
+		inboundEmails.Attachments = attachments.Blobs != nil
+		handlers.InboundEmails = tododriver.MakeSMTPHandler(todo.NewEmailGateway(service, store, events, inboundEmails))
+
+		jobs.RegisterJob(TodoDueItemsJob, todo.NewDueItemNotifier(store, events, todoDueSoonWindow).Notify)
+		jobs.RegisterJob(TodoTrashPurgeJob, todo.NewTrashPurger(store, trashRetention).Purge)
+		jobs.RegisterJob(TodoAttachmentCleanupJob, todo.NewAttachmentCollector(store, attachments.Blobs).Collect)
 
 		endpoints := tododriver.MakeEndpoints(
 			service,
@@ -111,13 +191,124 @@ func InitializeApp(
 			httpRouter.PathPrefix("/todos").Subrouter(),
 			kitxhttp.ServerOptions(httpServerOptions),
 		)
+		tododriver.RegisterListHTTPHandlers(
+			endpoints,
+			httpRouter.PathPrefix("/lists").Subrouter(),
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
+		tododriver.RegisterTagHTTPHandlers(
+			endpoints,
+			httpRouter.PathPrefix("/tags").Subrouter(),
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
+		tododriver.RegisterCommentHTTPHandlers(
+			endpoints,
+			httpRouter.PathPrefix("/comments").Subrouter(),
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
+		tododriver.RegisterAttachmentHTTPHandlers(
+			endpoints,
+			httpRouter.PathPrefix("/attachments").Subrouter(),
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
+		tododriver.RegisterTimeHTTPHandlers(
+			endpoints,
+			httpRouter.PathPrefix("/time").Subrouter(),
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
+		tododriver.RegisterTemplateHTTPHandlers(
+			endpoints,
+			httpRouter.PathPrefix("/templates").Subrouter(),
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
+		tododriver.RegisterRuleHTTPHandlers(
+			endpoints,
+			httpRouter.PathPrefix("/rules").Subrouter(),
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
+		tododriver.RegisterShareHTTPHandlers(
+			endpoints,
+			httpRouter.PathPrefix("/shares").Subrouter(),
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
+		tododriver.RegisterSharedHTTPHandlers(
+			endpoints,
+			httpRouter.PathPrefix("/shared").Subrouter(),
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
 		todov1.RegisterTodoListServiceServer(
 			grpcServer,
 			tododriver.MakeGRPCServer(endpoints, kitxgrpc.ServerOptions(grpcServerOptions)),
 		)
-		httpRouter.PathPrefix("/graphql").Handler(handler.NewDefaultServer(
+		httpRouter.PathPrefix("/graphql").Handler(principal.HTTPHandler(handler.NewDefaultServer(
 			tododriver.MakeGraphQLSchema(endpoints),
+		)))
+	}
+
+	{
+		var store interface {
+			notification.Store
+			notification.PreferenceStore
+		} = notification.NewInMemoryStore()
+		if storage == "database" {
+			client := notificationent.NewClient(notificationent.Driver(entsql.OpenDB("mysql", db)))
+			err := client.Schema.Create(
+				context.Background(),
+				notificationmigrate.WithDropIndex(true),
+				notificationmigrate.WithDropColumn(true),
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			store = notificationadapter.NewEntStore(client)
+		}
+
+		broker := notification.NewBroker()
+		onShutdown(broker.Close)
+
+		service := notification.NewService(store, store)
+		service = notificationdriver.LoggingMiddleware(logger)(service)
+
+		handlers.Notifications = notification.NewEventHandler(store, store, ulidgen.NewGenerator(), broker)
+
+		digest := func(context.Context) error { return nil }
+		if emails.Mailer != nil {
+			renderer, err := notification.NewEmailRenderer(templates.Files())
+			if err != nil {
+				panic(err)
+			}
+
+			emailHandler := notification.NewEmailHandler(store, emails.Addresses, renderer, emails.Mailer)
+			handlers.Emails = &emailHandler
+
+			digest = notification.NewDigestMailer(items, emails.Addresses, renderer, emails.Mailer).Send
+		}
+
+		jobs.RegisterJob(NotificationEmailDigestJob, digest)
+
+		endpoints := notificationdriver.MakeEndpoints(
+			service,
+			kitxendpoint.Combine(endpointMiddleware...),
+		)
+
+		router := httpRouter.PathPrefix("/notifications").Subrouter()
+
+		router.Methods(http.MethodGet).Path("/stream").Handler(principal.HTTPHandler(
+			notificationdriver.MakeStreamHTTPHandler(broker),
 		))
+		router.PathPrefix("/graphql").Handler(principal.HTTPHandler(handler.NewDefaultServer(
+			notificationdriver.MakeGraphQLSchema(endpoints),
+		)))
+		notificationdriver.RegisterHTTPHandlers(
+			endpoints,
+			router,
+			kitxhttp.ServerOptions(httpServerOptions),
+		)
+		notificationv1.RegisterNotificationServiceServer(
+			grpcServer,
+			notificationdriver.MakeGRPCServer(endpoints, kitxgrpc.ServerOptions(grpcServerOptions)),
+		)
 	}
 
 	landingdriver.RegisterHTTPHandlers(httpRouter, templates.Files())
@@ -125,16 +316,53 @@ func InitializeApp(
 		"/httpbin",
 		httpbin.MakeHTTPHandler(logger.WithFields(map[string]interface{}{"module": "httpbin"})),
 	))
+
+	return handlers
 }
 
 // RegisterEventHandlers registers event handlers in a message router.
-func RegisterEventHandlers(router *message.Router, subscriber message.Subscriber, logger Logger) error {
+func RegisterEventHandlers(
+	router *message.Router,
+	subscriberConstructor cqrs.EventsSubscriberConstructor,
+	handlers EventHandlers,
+	logger Logger,
+) error {
+	eventHandlers := []cqrs.EventHandler{
+		todogen.NewMarkedAsCompleteEventHandler(todo.NewLogEventHandler(logger), "marked_as_complete"),
+		todogen.NewItemDueSoonEventHandler(todo.NewLogEventHandler(logger), "item_due_soon"),
+		todogen.NewItemOverdueEventHandler(todo.NewLogEventHandler(logger), "item_overdue"),
+		todogen.NewOperationUndoneEventHandler(todo.NewLogEventHandler(logger), "operation_undone"),
+		todogen.NewOperationRedoneEventHandler(todo.NewLogEventHandler(logger), "operation_redone"),
+		todogen.NewItemAddedEventHandler(todo.NewLogEventHandler(logger), "item_added"),
+		todogen.NewItemUpdatedEventHandler(todo.NewLogEventHandler(logger), "item_updated"),
+		todogen.NewItemDeletedEventHandler(todo.NewLogEventHandler(logger), "item_deleted"),
+		todogen.NewUserMentionedEventHandler(todo.NewLogEventHandler(logger), "user_mentioned"),
+		todogen.NewCollaboratorInvitedEventHandler(todo.NewLogEventHandler(logger), "collaborator_invited"),
+		todogen.NewItemAssignedEventHandler(todo.NewLogEventHandler(logger), "item_assigned"),
+		todogen.NewItemAddedEventHandler(handlers.Rules, "rules_item_added"),
+		todogen.NewItemUpdatedEventHandler(handlers.Rules, "rules_item_updated"),
+		todogen.NewMarkedAsCompleteEventHandler(handlers.Rules, "rules_marked_as_complete"),
+		todogen.NewItemDueSoonEventHandler(handlers.Rules, "rules_item_due_soon"),
+		todogen.NewItemOverdueEventHandler(handlers.Rules, "rules_item_overdue"),
+		todogen.NewItemAssignedEventHandler(handlers.Rules, "rules_item_assigned"),
+		todogen.NewMarkedAsCompleteEventHandler(handlers.Notifications, "notifications_marked_as_complete"),
+		todogen.NewItemAssignedEventHandler(handlers.Notifications, "notifications_item_assigned"),
+		todogen.NewUserMentionedEventHandler(handlers.Notifications, "notifications_user_mentioned"),
+		todogen.NewItemOverdueEventHandler(handlers.Notifications, "notifications_item_overdue"),
+	}
+
+	if handlers.Emails != nil {
+		eventHandlers = append(
+			eventHandlers,
+			todogen.NewItemAssignedEventHandler(handlers.Emails, "emails_item_assigned"),
+			todogen.NewItemOverdueEventHandler(handlers.Emails, "emails_item_overdue"),
+		)
+	}
+
 	todoEventProcessor, _ := cqrs.NewEventProcessor(
-		[]cqrs.EventHandler{
-			todogen.NewMarkedAsCompleteEventHandler(todo2.NewLogEventHandler(logger), "marked_as_complete"),
-		},
+		eventHandlers,
 		func(eventName string) string { return todoTopic },
-		func(handlerName string) (message.Subscriber, error) { return subscriber, nil },
+		subscriberConstructor,
 		cqrs.JSONMarshaler{GenerateName: cqrs.StructName},
 		watermilllog.New(logger.WithFields(map[string]interface{}{"component": "watermill"})),
 	)
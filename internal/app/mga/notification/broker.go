@@ -0,0 +1,112 @@
+package notification
+
+import (
+	"context"
+	"sync"
+
+	"emperror.dev/errors"
+)
+
+// subscriptionBuffer is the number of notifications buffered for a subscriber.
+// Notifications are dropped for subscribers falling behind: the inbox remains the source of truth.
+const subscriptionBuffer = 16
+
+// Publisher publishes new notifications to live subscribers.
+type Publisher interface {
+	// Publish publishes a new notification.
+	Publish(notification Notification)
+}
+
+// Subscriber subscribes to the new notifications of the user making the request.
+type Subscriber interface {
+	// Subscribe returns a channel receiving the new notifications of the user until the context is done.
+	Subscribe(ctx context.Context) (<-chan Notification, error)
+}
+
+// Broker fans notifications out to the live subscribers of their recipients within the process.
+type Broker struct {
+	subscriptions map[string]map[chan Notification]struct{}
+	closed        bool
+	mu            sync.Mutex
+}
+
+// NewBroker returns a new Broker.
+func NewBroker() *Broker {
+	return &Broker{
+		subscriptions: make(map[string]map[chan Notification]struct{}),
+	}
+}
+
+// Publish publishes a new notification to the subscribers of its recipient.
+func (b *Broker) Publish(notification Notification) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscriptions[notification.User] {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the new notifications of the user
+// until the context is done or the broker is closed.
+func (b *Broker) Subscribe(ctx context.Context) (<-chan Notification, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan Notification, subscriptionBuffer)
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+
+		return nil, errors.New("notification broker is closed")
+	}
+	if b.subscriptions[user] == nil {
+		b.subscriptions[user] = make(map[chan Notification]struct{})
+	}
+	b.subscriptions[user][ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		// Closing the broker ends subscriptions as well
+		if _, ok := b.subscriptions[user][ch]; !ok {
+			return
+		}
+
+		delete(b.subscriptions[user], ch)
+		if len(b.subscriptions[user]) == 0 {
+			delete(b.subscriptions, user)
+		}
+
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+// Close closes the channel of every subscriber and rejects new subscriptions
+// (eg. to end notification streams when the server shuts down).
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+
+	for user, subscriptions := range b.subscriptions {
+		for ch := range subscriptions {
+			close(ch)
+		}
+
+		delete(b.subscriptions, user)
+	}
+}
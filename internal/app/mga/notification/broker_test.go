@@ -0,0 +1,57 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func TestBroker(t *testing.T) {
+	broker := NewBroker()
+
+	ctx, cancel := context.WithCancel(principal.ToContext(context.Background(), "jane"))
+
+	notifications, err := broker.Subscribe(ctx)
+	require.NoError(t, err)
+
+	broker.Publish(Notification{ID: "1", User: "john"})
+	broker.Publish(Notification{ID: "2", User: "jane"})
+
+	notification := <-notifications
+	assert.Equal(t, "2", notification.ID)
+
+	cancel()
+
+	// The channel gets closed once the subscription is over
+	for range notifications {
+	}
+
+	_, err = broker.Subscribe(context.Background())
+	assert.Error(t, err)
+}
+
+func TestBroker_Close(t *testing.T) {
+	broker := NewBroker()
+
+	ctx, cancel := context.WithCancel(principal.ToContext(context.Background(), "jane"))
+	defer cancel()
+
+	notifications, err := broker.Subscribe(ctx)
+	require.NoError(t, err)
+
+	broker.Close()
+
+	_, ok := <-notifications
+	assert.False(t, ok, "subscriptions end when the broker is closed")
+
+	_, err = broker.Subscribe(ctx)
+	assert.Error(t, err)
+
+	// Ending a closed subscription is a no-op
+	cancel()
+}
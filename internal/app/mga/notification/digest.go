@@ -0,0 +1,146 @@
+package notification
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// ItemStore provides the todo items summarized in digests.
+type ItemStore interface {
+	// GetAll returns all items matching a filter.
+	GetAll(ctx context.Context, filter todo.ItemFilter) ([]todo.Item, error)
+}
+
+// DigestMailer emails users a summary of their open and overdue items.
+type DigestMailer struct {
+	items     ItemStore
+	addresses AddressBook
+	renderer  EmailRenderer
+	mailer    Mailer
+}
+
+// NewDigestMailer returns a new DigestMailer instance.
+func NewDigestMailer(items ItemStore, addresses AddressBook, renderer EmailRenderer, mailer Mailer) DigestMailer {
+	return DigestMailer{
+		items:     items,
+		addresses: addresses,
+		renderer:  renderer,
+		mailer:    mailer,
+	}
+}
+
+// digestEmailData is rendered by the digest email template.
+type digestEmailData struct {
+	User    string
+	Overdue []digestItem
+	Open    []digestItem
+}
+
+type digestItem struct {
+	ID     string
+	ListID string
+	Title  string
+	DueAt  *time.Time
+}
+
+// Send emails a digest to every user with incomplete items assigned to them.
+// Users without an email address are skipped, failing deliveries don't stop the rest.
+func (m DigestMailer) Send(ctx context.Context) error {
+	items, err := m.items.GetAll(ctx, todo.ItemFilter{})
+	if err != nil {
+		return errors.WithMessage(err, "get items")
+	}
+
+	now := time.Now()
+	digests := make(map[string]*digestEmailData)
+
+	for _, item := range items {
+		if item.Completed || item.Assignee == "" {
+			continue
+		}
+
+		digest, ok := digests[item.Assignee]
+		if !ok {
+			digest = &digestEmailData{User: item.Assignee}
+			digests[item.Assignee] = digest
+		}
+
+		di := digestItem{
+			ID:     item.ID,
+			ListID: item.ListID,
+			Title:  item.Title,
+			DueAt:  item.DueAt,
+		}
+
+		if item.Overdue(now) {
+			digest.Overdue = append(digest.Overdue, di)
+		} else {
+			digest.Open = append(digest.Open, di)
+		}
+	}
+
+	users := make([]string, 0, len(digests))
+	for user := range digests {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+
+	var errs []error
+
+	for _, user := range users {
+		err := m.send(ctx, digests[user])
+		if err != nil {
+			errs = append(errs, errors.WithDetails(err, "user", user))
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+func (m DigestMailer) send(ctx context.Context, digest *digestEmailData) error {
+	address, err := m.addresses.Address(ctx, digest.User)
+	if err != nil {
+		return errors.WithMessage(err, "get email address")
+	}
+
+	if address == "" {
+		return nil
+	}
+
+	sortDigestItems(digest.Overdue)
+	sortDigestItems(digest.Open)
+
+	email, err := m.renderer.Render(address, digestEmail, digest)
+	if err != nil {
+		return err
+	}
+
+	err = m.mailer.Send(ctx, email)
+	if err != nil {
+		return errors.WithMessage(err, "send digest")
+	}
+
+	return nil
+}
+
+// sortDigestItems orders items by due date (items without one come last), then by title.
+func sortDigestItems(items []digestItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+
+		switch {
+		case a.DueAt != nil && b.DueAt != nil && !a.DueAt.Equal(*b.DueAt):
+			return a.DueAt.Before(*b.DueAt)
+
+		case (a.DueAt == nil) != (b.DueAt == nil):
+			return a.DueAt != nil
+		}
+
+		return a.Title < b.Title
+	})
+}
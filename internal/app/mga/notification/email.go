@@ -0,0 +1,239 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	htmltemplate "html/template"
+	"io/fs"
+	"net/mail"
+	"path"
+	texttemplate "text/template"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// Email templates (see static/templates/email).
+const (
+	itemAssignedEmail = "item_assigned"
+	itemOverdueEmail  = "item_overdue"
+	digestEmail       = "digest"
+)
+
+// Email is a rendered email.
+type Email struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Mailer sends emails.
+type Mailer interface {
+	// Send sends an email.
+	Send(ctx context.Context, email Email) error
+}
+
+// EmailConfig configures email notifications.
+type EmailConfig struct {
+	// Mailer sends emails. Email notifications are disabled without a mailer.
+	Mailer Mailer
+
+	// Addresses resolves the email addresses of users.
+	Addresses AddressBook
+}
+
+// AddressBook resolves the email addresses of users.
+type AddressBook interface {
+	// Address returns the email address of a user (empty if the user has no known address).
+	Address(ctx context.Context, user string) (string, error)
+}
+
+// StaticAddressBook is an AddressBook mapping users to email addresses.
+// Users without an entry are looked up by their ID if it's an email address itself.
+type StaticAddressBook map[string]string
+
+// Address returns the email address of a user.
+func (b StaticAddressBook) Address(_ context.Context, user string) (string, error) {
+	if address, ok := b[user]; ok {
+		return address, nil
+	}
+
+	if address, err := mail.ParseAddress(user); err == nil && address.Address == user {
+		return user, nil
+	}
+
+	return "", nil
+}
+
+// EmailRenderer renders emails from templates.
+// Every email has a plain text template (<name>.txt defining the subject in a "subject" template)
+// and an HTML template (<name>.html).
+type EmailRenderer struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+// NewEmailRenderer parses the email templates under the email directory of a filesystem.
+func NewEmailRenderer(fsys fs.FS) (EmailRenderer, error) {
+	renderer := EmailRenderer{
+		text: make(map[string]*texttemplate.Template),
+		html: make(map[string]*htmltemplate.Template),
+	}
+
+	for _, name := range []string{itemAssignedEmail, itemOverdueEmail, digestEmail} {
+		text, err := texttemplate.ParseFS(fsys, path.Join("email", name+".txt"))
+		if err != nil {
+			return EmailRenderer{}, errors.WrapIfWithDetails(err, "parse email template", "template", name)
+		}
+
+		if text.Lookup("subject") == nil {
+			return EmailRenderer{}, errors.NewWithDetails("email template has no subject", "template", name)
+		}
+
+		html, err := htmltemplate.ParseFS(fsys, path.Join("email", name+".html"))
+		if err != nil {
+			return EmailRenderer{}, errors.WrapIfWithDetails(err, "parse email template", "template", name)
+		}
+
+		renderer.text[name] = text
+		renderer.html[name] = html
+	}
+
+	return renderer, nil
+}
+
+// Render renders an email to a recipient.
+func (r EmailRenderer) Render(to string, name string, data interface{}) (Email, error) {
+	text, ok := r.text[name]
+	if !ok {
+		return Email{}, errors.NewWithDetails("unknown email template", "template", name)
+	}
+
+	var subject, textBody, htmlBody bytes.Buffer
+
+	err := text.ExecuteTemplate(&subject, "subject", data)
+	if err != nil {
+		return Email{}, errors.WrapIfWithDetails(err, "render email subject", "template", name)
+	}
+
+	err = text.Execute(&textBody, data)
+	if err != nil {
+		return Email{}, errors.WrapIfWithDetails(err, "render email text", "template", name)
+	}
+
+	err = r.html[name].Execute(&htmlBody, data)
+	if err != nil {
+		return Email{}, errors.WrapIfWithDetails(err, "render email html", "template", name)
+	}
+
+	return Email{
+		To:      to,
+		Subject: subject.String(),
+		Text:    textBody.String(),
+		HTML:    htmlBody.String(),
+	}, nil
+}
+
+// EmailHandler emails users about todo events right away.
+// Emails follow the notification preferences of users.
+type EmailHandler struct {
+	preferences PreferenceStore
+	addresses   AddressBook
+	renderer    EmailRenderer
+	mailer      Mailer
+}
+
+// NewEmailHandler returns a new EmailHandler instance.
+func NewEmailHandler(
+	preferences PreferenceStore,
+	addresses AddressBook,
+	renderer EmailRenderer,
+	mailer Mailer,
+) EmailHandler {
+	return EmailHandler{
+		preferences: preferences,
+		addresses:   addresses,
+		renderer:    renderer,
+		mailer:      mailer,
+	}
+}
+
+// itemAssignedEmailData is rendered by the item assigned email template.
+type itemAssignedEmailData struct {
+	ItemID     string
+	ListID     string
+	Title      string
+	AssignedBy string
+}
+
+// itemOverdueEmailData is rendered by the item overdue email template.
+type itemOverdueEmailData struct {
+	ItemID string
+	ListID string
+	Title  string
+	DueAt  time.Time
+}
+
+// ItemAssigned emails a user about someone else assigning an item to them.
+func (h EmailHandler) ItemAssigned(ctx context.Context, event todo.ItemAssigned) error {
+	if event.Assignee == event.AssignedBy {
+		return nil
+	}
+
+	return h.send(ctx, event.Assignee, ItemAssigned, itemAssignedEmail, itemAssignedEmailData{
+		ItemID:     event.ID,
+		ListID:     event.ListID,
+		Title:      event.Title,
+		AssignedBy: event.AssignedBy,
+	})
+}
+
+// ItemOverdue emails the assignee of an item about the item being past its due date.
+func (h EmailHandler) ItemOverdue(ctx context.Context, event todo.ItemOverdue) error {
+	return h.send(ctx, event.Assignee, ItemOverdue, itemOverdueEmail, itemOverdueEmailData{
+		ItemID: event.ID,
+		ListID: event.ListID,
+		Title:  event.Title,
+		DueAt:  event.DueAt,
+	})
+}
+
+// send emails a user unless they disabled the kind of notification or have no email address.
+func (h EmailHandler) send(ctx context.Context, user string, kind Kind, name string, data interface{}) error {
+	if user == "" {
+		return nil
+	}
+
+	preferences, err := getPreferences(ctx, h.preferences, user)
+	if err != nil {
+		return errors.WithMessage(err, "get notification preferences")
+	}
+
+	if !preferences.Enabled(kind) {
+		return nil
+	}
+
+	address, err := h.addresses.Address(ctx, user)
+	if err != nil {
+		return errors.WithMessage(err, "get email address")
+	}
+
+	if address == "" {
+		return nil
+	}
+
+	email, err := h.renderer.Render(address, name, data)
+	if err != nil {
+		return err
+	}
+
+	err = h.mailer.Send(ctx, email)
+	if err != nil {
+		return errors.WithMessage(err, "send email")
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package notification_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/static/templates"
+)
+
+type recordingMailer struct {
+	emails []Email
+	fail   map[string]bool
+}
+
+func (m *recordingMailer) Send(_ context.Context, email Email) error {
+	if m.fail[email.To] {
+		return errors.New("delivery failed")
+	}
+
+	m.emails = append(m.emails, email)
+
+	return nil
+}
+
+func newEmailRenderer(t *testing.T) EmailRenderer {
+	t.Helper()
+
+	renderer, err := NewEmailRenderer(templates.Files())
+	require.NoError(t, err)
+
+	return renderer
+}
+
+func TestStaticAddressBook(t *testing.T) {
+	addresses := StaticAddressBook{"john": "john@example.com"}
+
+	ctx := context.Background()
+
+	address, err := addresses.Address(ctx, "john")
+	require.NoError(t, err)
+	assert.Equal(t, "john@example.com", address)
+
+	address, err = addresses.Address(ctx, "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", address)
+
+	address, err = addresses.Address(ctx, "jane")
+	require.NoError(t, err)
+	assert.Empty(t, address)
+}
+
+func TestEmailHandler(t *testing.T) {
+	preferences := NewInMemoryStore()
+	mailer := &recordingMailer{}
+
+	handler := NewEmailHandler(
+		preferences,
+		StaticAddressBook{"jane": "jane@example.com", "john": "john@example.com"},
+		newEmailRenderer(t),
+		mailer,
+	)
+
+	ctx := context.Background()
+	dueAt := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, handler.ItemAssigned(ctx, todo.ItemAssigned{
+		ID:         "1",
+		ListID:     "10",
+		Title:      "Mow the <lawn>",
+		Assignee:   "jane",
+		AssignedBy: "john",
+	}))
+
+	require.NoError(t, handler.ItemOverdue(ctx, todo.ItemOverdue{
+		ID:       "1",
+		ListID:   "10",
+		Title:    "Mow the <lawn>",
+		DueAt:    dueAt,
+		Assignee: "jane",
+	}))
+
+	// Ignored: self assignment, unassigned item, user without an address
+	require.NoError(t, handler.ItemAssigned(ctx, todo.ItemAssigned{ID: "2", Title: "Buy milk", Assignee: "john", AssignedBy: "john"}))
+	require.NoError(t, handler.ItemOverdue(ctx, todo.ItemOverdue{ID: "2", Title: "Buy milk", DueAt: dueAt}))
+	require.NoError(t, handler.ItemOverdue(ctx, todo.ItemOverdue{ID: "3", Title: "Buy milk", DueAt: dueAt, Assignee: "joe"}))
+
+	require.Len(t, mailer.emails, 2)
+
+	assigned := mailer.emails[0]
+	assert.Equal(t, "jane@example.com", assigned.To)
+	assert.Equal(t, "john assigned you: Mow the <lawn>", assigned.Subject)
+	assert.Contains(t, assigned.Text, "Mow the <lawn>")
+	assert.Contains(t, assigned.HTML, "Mow the &lt;lawn&gt;")
+
+	overdue := mailer.emails[1]
+	assert.Equal(t, "jane@example.com", overdue.To)
+	assert.Equal(t, "Overdue: Mow the <lawn>", overdue.Subject)
+	assert.Contains(t, overdue.Text, "Mon, Mar 1, 2021 12:00 UTC")
+	assert.Contains(t, overdue.HTML, "Mon, Mar 1, 2021 12:00 UTC")
+}
+
+func TestEmailHandler_Preferences(t *testing.T) {
+	preferences := NewInMemoryStore()
+	mailer := &recordingMailer{}
+
+	handler := NewEmailHandler(preferences, StaticAddressBook{}, newEmailRenderer(t), mailer)
+
+	ctx := context.Background()
+
+	disabled := DefaultPreferences()
+	disabled.ItemOverdue = false
+
+	require.NoError(t, preferences.StorePreferences(ctx, "jane@example.com", disabled))
+
+	require.NoError(t, handler.ItemOverdue(ctx, todo.ItemOverdue{ID: "1", Title: "Mow the lawn", Assignee: "jane@example.com"}))
+	require.NoError(t, handler.ItemAssigned(ctx, todo.ItemAssigned{ID: "1", Title: "Mow the lawn", Assignee: "jane@example.com"}))
+
+	require.Len(t, mailer.emails, 1)
+	assert.Equal(t, "You were assigned: Mow the lawn", mailer.emails[0].Subject)
+}
+
+func TestDigestMailer(t *testing.T) {
+	items := todo.NewInMemoryStore()
+	mailer := &recordingMailer{fail: map[string]bool{"joe@example.com": true}}
+
+	ctx := context.Background()
+	now := time.Now()
+	yesterday := now.Add(-24 * time.Hour)
+	lastWeek := now.Add(-7 * 24 * time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+
+	for _, item := range []todo.Item{
+		{ID: "1", ListID: "10", Title: "Water the plants", Assignee: "jane", DueAt: &yesterday},
+		{ID: "2", ListID: "10", Title: "Mow the lawn", Assignee: "jane", DueAt: &lastWeek},
+		{ID: "3", ListID: "10", Title: "Buy milk", Assignee: "jane"},
+		{ID: "4", ListID: "10", Title: "Pay the bills", Assignee: "jane", DueAt: &tomorrow},
+		{ID: "5", ListID: "10", Title: "Call mom", Assignee: "jane", Completed: true},
+		{ID: "6", ListID: "10", Title: "Walk the dog"},
+		{ID: "7", ListID: "10", Title: "Fix the roof", Assignee: "john"},
+		{ID: "8", ListID: "10", Title: "Clean the garage", Assignee: "joe@example.com"},
+		{ID: "9", ListID: "10", Title: "Wash the car", Assignee: "mary"},
+	} {
+		require.NoError(t, items.Store(ctx, item))
+	}
+
+	digests := NewDigestMailer(
+		items,
+		StaticAddressBook{"jane": "jane@example.com", "john": "john@example.com"},
+		newEmailRenderer(t),
+		mailer,
+	)
+
+	err := digests.Send(ctx)
+	require.Error(t, err, "delivery to joe fails")
+
+	require.Len(t, mailer.emails, 2)
+
+	jane := mailer.emails[0]
+	assert.Equal(t, "jane@example.com", jane.To)
+	assert.Equal(t, "Your todo digest: 2 overdue, 2 open", jane.Subject)
+	assert.NotContains(t, jane.Text, "Call mom")
+	assert.NotContains(t, jane.Text, "Fix the roof")
+
+	// Overdue items first, then open items by due date
+	text := jane.Text
+	order := []string{"Overdue:", "Mow the lawn", "Water the plants", "Open:", "Pay the bills", "Buy milk"}
+	last := -1
+
+	for _, s := range order {
+		i := strings.Index(text, s)
+		require.Greater(t, i, last, s)
+
+		last = i
+	}
+
+	assert.Contains(t, jane.HTML, "Water the plants")
+
+	john := mailer.emails[1]
+	assert.Equal(t, "john@example.com", john.To)
+	assert.Equal(t, "Your todo digest: 0 overdue, 1 open", john.Subject)
+	assert.NotContains(t, john.Text, "Overdue:")
+}
@@ -0,0 +1,114 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// EventHandler turns todo events into notifications.
+type EventHandler struct {
+	store       Store
+	preferences PreferenceStore
+	idgenerator IDGenerator
+	publisher   Publisher
+}
+
+// NewEventHandler returns a new EventHandler instance.
+func NewEventHandler(
+	store Store,
+	preferences PreferenceStore,
+	idgenerator IDGenerator,
+	publisher Publisher,
+) EventHandler {
+	return EventHandler{
+		store:       store,
+		preferences: preferences,
+		idgenerator: idgenerator,
+		publisher:   publisher,
+	}
+}
+
+// MarkedAsComplete notifies the assignee of an item about someone else completing it.
+func (h EventHandler) MarkedAsComplete(ctx context.Context, event todo.MarkedAsComplete) error {
+	return h.notify(ctx, Notification{
+		User:   event.Assignee,
+		Kind:   ItemCompleted,
+		ItemID: event.ID,
+		ListID: event.ListID,
+		Title:  event.Title,
+		Actor:  event.CompletedBy,
+	})
+}
+
+// ItemAssigned notifies a user about someone else assigning an item to them.
+func (h EventHandler) ItemAssigned(ctx context.Context, event todo.ItemAssigned) error {
+	return h.notify(ctx, Notification{
+		User:   event.Assignee,
+		Kind:   ItemAssigned,
+		ItemID: event.ID,
+		ListID: event.ListID,
+		Title:  event.Title,
+		Actor:  event.AssignedBy,
+	})
+}
+
+// UserMentioned notifies a user about someone else mentioning them in a comment.
+func (h EventHandler) UserMentioned(ctx context.Context, event todo.UserMentioned) error {
+	return h.notify(ctx, Notification{
+		User:      event.User,
+		Kind:      Mentioned,
+		ItemID:    event.ItemID,
+		ListID:    event.ListID,
+		CommentID: event.CommentID,
+		Title:     event.Title,
+		Actor:     event.Author,
+	})
+}
+
+// ItemOverdue notifies the assignee of an item about the item being past its due date.
+func (h EventHandler) ItemOverdue(ctx context.Context, event todo.ItemOverdue) error {
+	return h.notify(ctx, Notification{
+		User:   event.Assignee,
+		Kind:   ItemOverdue,
+		ItemID: event.ID,
+		ListID: event.ListID,
+		Title:  event.Title,
+	})
+}
+
+// notify stores and publishes a notification unless the recipient caused it or disabled its kind.
+func (h EventHandler) notify(ctx context.Context, notification Notification) error {
+	if notification.User == "" || notification.User == notification.Actor {
+		return nil
+	}
+
+	preferences, err := getPreferences(ctx, h.preferences, notification.User)
+	if err != nil {
+		return errors.WithMessage(err, "get notification preferences")
+	}
+
+	if !preferences.Enabled(notification.Kind) {
+		return nil
+	}
+
+	id, err := h.idgenerator.Generate()
+	if err != nil {
+		return errors.WithMessage(err, "generate notification id")
+	}
+
+	notification.ID = id
+	notification.CreatedAt = time.Now()
+
+	err = h.store.StoreNotification(ctx, notification)
+	if err != nil {
+		return errors.WithMessage(err, "store notification")
+	}
+
+	h.publisher.Publish(notification)
+
+	return nil
+}
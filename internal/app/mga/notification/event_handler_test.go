@@ -0,0 +1,120 @@
+package notification_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+type sequenceIDGenerator struct {
+	next int
+}
+
+func (g *sequenceIDGenerator) Generate() (string, error) {
+	g.next++
+
+	return fmt.Sprintf("%02d", g.next), nil
+}
+
+type recordingPublisher struct {
+	notifications []Notification
+}
+
+func (p *recordingPublisher) Publish(notification Notification) {
+	p.notifications = append(p.notifications, notification)
+}
+
+func TestEventHandler(t *testing.T) {
+	store := NewInMemoryStore()
+	publisher := &recordingPublisher{}
+
+	handler := NewEventHandler(store, store, &sequenceIDGenerator{}, publisher)
+
+	ctx := context.Background()
+
+	require.NoError(t, handler.ItemAssigned(ctx, todo.ItemAssigned{
+		ID:         "1",
+		ListID:     "10",
+		Title:      "Mow the lawn",
+		Assignee:   "jane",
+		AssignedBy: "john",
+	}))
+
+	require.NoError(t, handler.MarkedAsComplete(ctx, todo.MarkedAsComplete{
+		ID:          "1",
+		ListID:      "10",
+		Title:       "Mow the lawn",
+		Assignee:    "jane",
+		CompletedBy: "john",
+	}))
+
+	require.NoError(t, handler.UserMentioned(ctx, todo.UserMentioned{
+		User:      "jane",
+		Author:    "john",
+		CommentID: "100",
+		ItemID:    "1",
+		ListID:    "10",
+		Title:     "Mow the lawn",
+	}))
+
+	require.NoError(t, handler.ItemOverdue(ctx, todo.ItemOverdue{
+		ID:       "1",
+		ListID:   "10",
+		Title:    "Mow the lawn",
+		Assignee: "jane",
+	}))
+
+	// Users are not notified about their own actions or unassigned items
+	require.NoError(t, handler.MarkedAsComplete(ctx, todo.MarkedAsComplete{ID: "1", Assignee: "jane", CompletedBy: "jane"}))
+	require.NoError(t, handler.ItemOverdue(ctx, todo.ItemOverdue{ID: "2"}))
+
+	require.Len(t, publisher.notifications, 4)
+	assert.Equal(t, ItemAssigned, publisher.notifications[0].Kind)
+	assert.Equal(t, ItemCompleted, publisher.notifications[1].Kind)
+	assert.Equal(t, Mentioned, publisher.notifications[2].Kind)
+	assert.Equal(t, "100", publisher.notifications[2].CommentID)
+	assert.Equal(t, ItemOverdue, publisher.notifications[3].Kind)
+	assert.Equal(t, "", publisher.notifications[3].Actor)
+
+	for _, notification := range publisher.notifications {
+		assert.Equal(t, "jane", notification.User)
+		assert.Equal(t, "1", notification.ItemID)
+		assert.Equal(t, "10", notification.ListID)
+		assert.Equal(t, "Mow the lawn", notification.Title)
+		assert.False(t, notification.CreatedAt.IsZero())
+	}
+
+	service := NewService(store, store)
+
+	notifications, err := service.ListNotifications(principal.ToContext(ctx, "jane"), Filter{})
+	require.NoError(t, err)
+	assert.Len(t, notifications, 4)
+}
+
+func TestEventHandler_Preferences(t *testing.T) {
+	store := NewInMemoryStore()
+	publisher := &recordingPublisher{}
+
+	handler := NewEventHandler(store, store, &sequenceIDGenerator{}, publisher)
+	service := NewService(store, store)
+
+	disabled := false
+
+	_, err := service.UpdatePreferences(principal.ToContext(context.Background(), "jane"), PreferencesUpdate{ItemOverdue: &disabled})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, handler.ItemOverdue(ctx, todo.ItemOverdue{ID: "1", Title: "Mow the lawn", Assignee: "jane"}))
+	require.NoError(t, handler.ItemOverdue(ctx, todo.ItemOverdue{ID: "1", Title: "Mow the lawn", Assignee: "john"}))
+
+	require.Len(t, publisher.notifications, 1)
+	assert.Equal(t, "john", publisher.notifications[0].User)
+}
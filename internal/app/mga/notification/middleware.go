@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"context"
+)
+
+// Middleware is a service middleware.
+type Middleware func(Service) Service
+
+// DefaultMiddleware helps implementing partial middleware.
+type DefaultMiddleware struct {
+	Service Service
+}
+
+func (m DefaultMiddleware) ListNotifications(ctx context.Context, filter Filter) ([]Notification, error) {
+	return m.Service.ListNotifications(ctx, filter)
+}
+
+func (m DefaultMiddleware) CountUnread(ctx context.Context) (int, error) {
+	return m.Service.CountUnread(ctx)
+}
+
+func (m DefaultMiddleware) MarkAsRead(ctx context.Context, id string) (Notification, error) {
+	return m.Service.MarkAsRead(ctx, id)
+}
+
+func (m DefaultMiddleware) MarkAsUnread(ctx context.Context, id string) (Notification, error) {
+	return m.Service.MarkAsUnread(ctx, id)
+}
+
+func (m DefaultMiddleware) MarkAllAsRead(ctx context.Context) (int, error) {
+	return m.Service.MarkAllAsRead(ctx)
+}
+
+func (m DefaultMiddleware) GetPreferences(ctx context.Context) (Preferences, error) {
+	return m.Service.GetPreferences(ctx)
+}
+
+func (m DefaultMiddleware) UpdatePreferences(ctx context.Context, update PreferencesUpdate) (Preferences, error) {
+	return m.Service.UpdatePreferences(ctx, update)
+}
@@ -0,0 +1,207 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notification"
+)
+
+// Notification is the model entity for the Notification schema.
+type Notification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// User holds the value of the "user" field.
+	User string `json:"user,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID string `json:"item_id,omitempty"`
+	// ListID holds the value of the "list_id" field.
+	ListID string `json:"list_id,omitempty"`
+	// CommentID holds the value of the "comment_id" field.
+	CommentID string `json:"comment_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Read holds the value of the "read" field.
+	Read bool `json:"read,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ReadAt holds the value of the "read_at" field.
+	ReadAt *time.Time `json:"read_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Notification) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notification.FieldRead:
+			values[i] = new(sql.NullBool)
+		case notification.FieldID:
+			values[i] = new(sql.NullInt64)
+		case notification.FieldUID, notification.FieldUser, notification.FieldKind, notification.FieldItemID, notification.FieldListID, notification.FieldCommentID, notification.FieldTitle, notification.FieldActor:
+			values[i] = new(sql.NullString)
+		case notification.FieldCreatedAt, notification.FieldReadAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Notification", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Notification fields.
+func (n *Notification) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			n.ID = int(value.Int64)
+		case notification.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				n.UID = value.String
+			}
+		case notification.FieldUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user", values[i])
+			} else if value.Valid {
+				n.User = value.String
+			}
+		case notification.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				n.Kind = value.String
+			}
+		case notification.FieldItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				n.ItemID = value.String
+			}
+		case notification.FieldListID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field list_id", values[i])
+			} else if value.Valid {
+				n.ListID = value.String
+			}
+		case notification.FieldCommentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_id", values[i])
+			} else if value.Valid {
+				n.CommentID = value.String
+			}
+		case notification.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				n.Title = value.String
+			}
+		case notification.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				n.Actor = value.String
+			}
+		case notification.FieldRead:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read", values[i])
+			} else if value.Valid {
+				n.Read = value.Bool
+			}
+		case notification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				n.CreatedAt = value.Time
+			}
+		case notification.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				n.ReadAt = new(time.Time)
+				*n.ReadAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Notification.
+// Note that you need to call Notification.Unwrap() before calling this method if this Notification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (n *Notification) Update() *NotificationUpdateOne {
+	return (&NotificationClient{config: n.config}).UpdateOne(n)
+}
+
+// Unwrap unwraps the Notification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (n *Notification) Unwrap() *Notification {
+	tx, ok := n.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Notification is not a transactional entity")
+	}
+	n.config.driver = tx.drv
+	return n
+}
+
+// String implements the fmt.Stringer.
+func (n *Notification) String() string {
+	var builder strings.Builder
+	builder.WriteString("Notification(")
+	builder.WriteString(fmt.Sprintf("id=%v", n.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(n.UID)
+	builder.WriteString(", user=")
+	builder.WriteString(n.User)
+	builder.WriteString(", kind=")
+	builder.WriteString(n.Kind)
+	builder.WriteString(", item_id=")
+	builder.WriteString(n.ItemID)
+	builder.WriteString(", list_id=")
+	builder.WriteString(n.ListID)
+	builder.WriteString(", comment_id=")
+	builder.WriteString(n.CommentID)
+	builder.WriteString(", title=")
+	builder.WriteString(n.Title)
+	builder.WriteString(", actor=")
+	builder.WriteString(n.Actor)
+	builder.WriteString(", read=")
+	builder.WriteString(fmt.Sprintf("%v", n.Read))
+	builder.WriteString(", created_at=")
+	builder.WriteString(n.CreatedAt.Format(time.ANSIC))
+	if v := n.ReadAt; v != nil {
+		builder.WriteString(", read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Notifications is a parsable slice of Notification.
+type Notifications []*Notification
+
+func (n Notifications) config(cfg config) {
+	for _i := range n {
+		n[_i].config = cfg
+	}
+}
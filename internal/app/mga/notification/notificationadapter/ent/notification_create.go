@@ -0,0 +1,429 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notification"
+)
+
+// NotificationCreate is the builder for creating a Notification entity.
+type NotificationCreate struct {
+	config
+	mutation *NotificationMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (nc *NotificationCreate) SetUID(s string) *NotificationCreate {
+	nc.mutation.SetUID(s)
+	return nc
+}
+
+// SetUser sets the "user" field.
+func (nc *NotificationCreate) SetUser(s string) *NotificationCreate {
+	nc.mutation.SetUser(s)
+	return nc
+}
+
+// SetKind sets the "kind" field.
+func (nc *NotificationCreate) SetKind(s string) *NotificationCreate {
+	nc.mutation.SetKind(s)
+	return nc
+}
+
+// SetItemID sets the "item_id" field.
+func (nc *NotificationCreate) SetItemID(s string) *NotificationCreate {
+	nc.mutation.SetItemID(s)
+	return nc
+}
+
+// SetListID sets the "list_id" field.
+func (nc *NotificationCreate) SetListID(s string) *NotificationCreate {
+	nc.mutation.SetListID(s)
+	return nc
+}
+
+// SetCommentID sets the "comment_id" field.
+func (nc *NotificationCreate) SetCommentID(s string) *NotificationCreate {
+	nc.mutation.SetCommentID(s)
+	return nc
+}
+
+// SetTitle sets the "title" field.
+func (nc *NotificationCreate) SetTitle(s string) *NotificationCreate {
+	nc.mutation.SetTitle(s)
+	return nc
+}
+
+// SetActor sets the "actor" field.
+func (nc *NotificationCreate) SetActor(s string) *NotificationCreate {
+	nc.mutation.SetActor(s)
+	return nc
+}
+
+// SetRead sets the "read" field.
+func (nc *NotificationCreate) SetRead(b bool) *NotificationCreate {
+	nc.mutation.SetRead(b)
+	return nc
+}
+
+// SetNillableRead sets the "read" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableRead(b *bool) *NotificationCreate {
+	if b != nil {
+		nc.SetRead(*b)
+	}
+	return nc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nc *NotificationCreate) SetCreatedAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetCreatedAt(t)
+	return nc
+}
+
+// SetReadAt sets the "read_at" field.
+func (nc *NotificationCreate) SetReadAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetReadAt(t)
+	return nc
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableReadAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetReadAt(*t)
+	}
+	return nc
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nc *NotificationCreate) Mutation() *NotificationMutation {
+	return nc.mutation
+}
+
+// Save creates the Notification in the database.
+func (nc *NotificationCreate) Save(ctx context.Context) (*Notification, error) {
+	var (
+		err  error
+		node *Notification
+	)
+	nc.defaults()
+	if len(nc.hooks) == 0 {
+		if err = nc.check(); err != nil {
+			return nil, err
+		}
+		node, err = nc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = nc.check(); err != nil {
+				return nil, err
+			}
+			nc.mutation = mutation
+			if node, err = nc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nc.hooks) - 1; i >= 0; i-- {
+			if nc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NotificationCreate) SaveX(ctx context.Context) *Notification {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nc *NotificationCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NotificationCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NotificationCreate) defaults() {
+	if _, ok := nc.mutation.Read(); !ok {
+		v := notification.DefaultRead
+		nc.mutation.SetRead(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NotificationCreate) check() error {
+	if _, ok := nc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := nc.mutation.UID(); ok {
+		if err := notification.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required field "user"`)}
+	}
+	if v, ok := nc.mutation.User(); ok {
+		if err := notification.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "user": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "kind"`)}
+	}
+	if v, ok := nc.mutation.Kind(); ok {
+		if err := notification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "kind": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "item_id"`)}
+	}
+	if _, ok := nc.mutation.ListID(); !ok {
+		return &ValidationError{Name: "list_id", err: errors.New(`ent: missing required field "list_id"`)}
+	}
+	if _, ok := nc.mutation.CommentID(); !ok {
+		return &ValidationError{Name: "comment_id", err: errors.New(`ent: missing required field "comment_id"`)}
+	}
+	if _, ok := nc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "title"`)}
+	}
+	if _, ok := nc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "actor"`)}
+	}
+	if _, ok := nc.mutation.Read(); !ok {
+		return &ValidationError{Name: "read", err: errors.New(`ent: missing required field "read"`)}
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
+	}
+	return nil
+}
+
+func (nc *NotificationCreate) sqlSave(ctx context.Context) (*Notification, error) {
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Notification{config: nc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: notification.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notification.FieldID,
+			},
+		}
+	)
+	if value, ok := nc.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := nc.mutation.User(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldUser,
+		})
+		_node.User = value
+	}
+	if value, ok := nc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := nc.mutation.ItemID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldItemID,
+		})
+		_node.ItemID = value
+	}
+	if value, ok := nc.mutation.ListID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldListID,
+		})
+		_node.ListID = value
+	}
+	if value, ok := nc.mutation.CommentID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldCommentID,
+		})
+		_node.CommentID = value
+	}
+	if value, ok := nc.mutation.Title(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldTitle,
+		})
+		_node.Title = value
+	}
+	if value, ok := nc.mutation.Actor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldActor,
+		})
+		_node.Actor = value
+	}
+	if value, ok := nc.mutation.Read(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notification.FieldRead,
+		})
+		_node.Read = value
+	}
+	if value, ok := nc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := nc.mutation.ReadAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldReadAt,
+		})
+		_node.ReadAt = &value
+	}
+	return _node, _spec
+}
+
+// NotificationCreateBulk is the builder for creating many Notification entities in bulk.
+type NotificationCreateBulk struct {
+	config
+	builders []*NotificationCreate
+}
+
+// Save creates the Notification entities in the database.
+func (ncb *NotificationCreateBulk) Save(ctx context.Context) ([]*Notification, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Notification, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) SaveX(ctx context.Context) []*Notification {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ncb *NotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
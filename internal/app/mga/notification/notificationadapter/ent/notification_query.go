@@ -0,0 +1,913 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/predicate"
+)
+
+// NotificationQuery is the builder for querying Notification entities.
+type NotificationQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Notification
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NotificationQuery builder.
+func (nq *NotificationQuery) Where(ps ...predicate.Notification) *NotificationQuery {
+	nq.predicates = append(nq.predicates, ps...)
+	return nq
+}
+
+// Limit adds a limit step to the query.
+func (nq *NotificationQuery) Limit(limit int) *NotificationQuery {
+	nq.limit = &limit
+	return nq
+}
+
+// Offset adds an offset step to the query.
+func (nq *NotificationQuery) Offset(offset int) *NotificationQuery {
+	nq.offset = &offset
+	return nq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nq *NotificationQuery) Unique(unique bool) *NotificationQuery {
+	nq.unique = &unique
+	return nq
+}
+
+// Order adds an order step to the query.
+func (nq *NotificationQuery) Order(o ...OrderFunc) *NotificationQuery {
+	nq.order = append(nq.order, o...)
+	return nq
+}
+
+// First returns the first Notification entity from the query.
+// Returns a *NotFoundError when no Notification was found.
+func (nq *NotificationQuery) First(ctx context.Context) (*Notification, error) {
+	nodes, err := nq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{notification.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nq *NotificationQuery) FirstX(ctx context.Context) *Notification {
+	node, err := nq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Notification ID from the query.
+// Returns a *NotFoundError when no Notification ID was found.
+func (nq *NotificationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{notification.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nq *NotificationQuery) FirstIDX(ctx context.Context) int {
+	id, err := nq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Notification entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one Notification entity is not found.
+// Returns a *NotFoundError when no Notification entities are found.
+func (nq *NotificationQuery) Only(ctx context.Context) (*Notification, error) {
+	nodes, err := nq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{notification.Label}
+	default:
+		return nil, &NotSingularError{notification.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nq *NotificationQuery) OnlyX(ctx context.Context) *Notification {
+	node, err := nq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Notification ID in the query.
+// Returns a *NotSingularError when exactly one Notification ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (nq *NotificationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{notification.Label}
+	default:
+		err = &NotSingularError{notification.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nq *NotificationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := nq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Notifications.
+func (nq *NotificationQuery) All(ctx context.Context) ([]*Notification, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return nq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nq *NotificationQuery) AllX(ctx context.Context) []*Notification {
+	nodes, err := nq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Notification IDs.
+func (nq *NotificationQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := nq.Select(notification.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nq *NotificationQuery) IDsX(ctx context.Context) []int {
+	ids, err := nq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nq *NotificationQuery) Count(ctx context.Context) (int, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return nq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nq *NotificationQuery) CountX(ctx context.Context) int {
+	count, err := nq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nq *NotificationQuery) Exist(ctx context.Context) (bool, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return nq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nq *NotificationQuery) ExistX(ctx context.Context) bool {
+	exist, err := nq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NotificationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nq *NotificationQuery) Clone() *NotificationQuery {
+	if nq == nil {
+		return nil
+	}
+	return &NotificationQuery{
+		config:     nq.config,
+		limit:      nq.limit,
+		offset:     nq.offset,
+		order:      append([]OrderFunc{}, nq.order...),
+		predicates: append([]predicate.Notification{}, nq.predicates...),
+		// clone intermediate query.
+		sql:  nq.sql.Clone(),
+		path: nq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Notification.Query().
+//		GroupBy(notification.FieldUID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (nq *NotificationQuery) GroupBy(field string, fields ...string) *NotificationGroupBy {
+	group := &NotificationGroupBy{config: nq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return nq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//	}
+//
+//	client.Notification.Query().
+//		Select(notification.FieldUID).
+//		Scan(ctx, &v)
+func (nq *NotificationQuery) Select(fields ...string) *NotificationSelect {
+	nq.fields = append(nq.fields, fields...)
+	return &NotificationSelect{NotificationQuery: nq}
+}
+
+func (nq *NotificationQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range nq.fields {
+		if !notification.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if nq.path != nil {
+		prev, err := nq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nq.sql = prev
+	}
+	return nil
+}
+
+func (nq *NotificationQuery) sqlAll(ctx context.Context) ([]*Notification, error) {
+	var (
+		nodes = []*Notification{}
+		_spec = nq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Notification{config: nq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (nq *NotificationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nq.querySpec()
+	return sqlgraph.CountNodes(ctx, nq.driver, _spec)
+}
+
+func (nq *NotificationQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := nq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (nq *NotificationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notification.Table,
+			Columns: notification.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notification.FieldID,
+			},
+		},
+		From:   nq.sql,
+		Unique: true,
+	}
+	if unique := nq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := nq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for i := range fields {
+			if fields[i] != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := nq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nq *NotificationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nq.driver.Dialect())
+	t1 := builder.Table(notification.Table)
+	columns := nq.fields
+	if len(columns) == 0 {
+		columns = notification.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if nq.sql != nil {
+		selector = nq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range nq.predicates {
+		p(selector)
+	}
+	for _, p := range nq.order {
+		p(selector)
+	}
+	if offset := nq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NotificationGroupBy is the group-by builder for Notification entities.
+type NotificationGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ngb *NotificationGroupBy) Aggregate(fns ...AggregateFunc) *NotificationGroupBy {
+	ngb.fns = append(ngb.fns, fns...)
+	return ngb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ngb *NotificationGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ngb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ngb.sql = query
+	return ngb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ngb *NotificationGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ngb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NotificationGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NotificationGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ngb *NotificationGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ngb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NotificationGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ngb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notification.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ngb *NotificationGroupBy) StringX(ctx context.Context) string {
+	v, err := ngb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NotificationGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NotificationGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ngb *NotificationGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ngb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NotificationGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ngb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notification.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ngb *NotificationGroupBy) IntX(ctx context.Context) int {
+	v, err := ngb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NotificationGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NotificationGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ngb *NotificationGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ngb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NotificationGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ngb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notification.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ngb *NotificationGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ngb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NotificationGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NotificationGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ngb *NotificationGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ngb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NotificationGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ngb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notification.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ngb *NotificationGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ngb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ngb *NotificationGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ngb.fields {
+		if !notification.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ngb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ngb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ngb *NotificationGroupBy) sqlQuery() *sql.Selector {
+	selector := ngb.sql.Select()
+	aggregation := make([]string, 0, len(ngb.fns))
+	for _, fn := range ngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ngb.fields)+len(ngb.fns))
+		for _, f := range ngb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ngb.fields...)...)
+}
+
+// NotificationSelect is the builder for selecting fields of Notification entities.
+type NotificationSelect struct {
+	*NotificationQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ns *NotificationSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ns.sql = ns.NotificationQuery.sqlQuery(ctx)
+	return ns.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ns *NotificationSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ns.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ns *NotificationSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NotificationSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ns *NotificationSelect) StringsX(ctx context.Context) []string {
+	v, err := ns.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ns *NotificationSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ns.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notification.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ns *NotificationSelect) StringX(ctx context.Context) string {
+	v, err := ns.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ns *NotificationSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NotificationSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ns *NotificationSelect) IntsX(ctx context.Context) []int {
+	v, err := ns.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ns *NotificationSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ns.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notification.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ns *NotificationSelect) IntX(ctx context.Context) int {
+	v, err := ns.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ns *NotificationSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NotificationSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ns *NotificationSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ns.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ns *NotificationSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ns.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notification.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ns *NotificationSelect) Float64X(ctx context.Context) float64 {
+	v, err := ns.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ns *NotificationSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NotificationSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ns *NotificationSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ns.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ns *NotificationSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ns.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notification.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ns *NotificationSelect) BoolX(ctx context.Context) bool {
+	v, err := ns.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ns *NotificationSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ns.sql.Query()
+	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
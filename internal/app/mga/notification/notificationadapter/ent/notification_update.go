@@ -0,0 +1,347 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/predicate"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetRead sets the "read" field.
+func (nu *NotificationUpdate) SetRead(b bool) *NotificationUpdate {
+	nu.mutation.SetRead(b)
+	return nu
+}
+
+// SetNillableRead sets the "read" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableRead(b *bool) *NotificationUpdate {
+	if b != nil {
+		nu.SetRead(*b)
+	}
+	return nu
+}
+
+// SetReadAt sets the "read_at" field.
+func (nu *NotificationUpdate) SetReadAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetReadAt(t)
+	return nu
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableReadAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetReadAt(*t)
+	}
+	return nu
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nu *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	nu.mutation.ClearReadAt()
+	return nu
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nu *NotificationUpdate) Mutation() *NotificationMutation {
+	return nu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(nu.hooks) == 0 {
+		affected, err = nu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nu.mutation = mutation
+			affected, err = nu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(nu.hooks) - 1; i >= 0; i-- {
+			if nu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notification.Table,
+			Columns: notification.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notification.FieldID,
+			},
+		},
+	}
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.Read(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notification.FieldRead,
+		})
+	}
+	if value, ok := nu.mutation.ReadAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldReadAt,
+		})
+	}
+	if nu.mutation.ReadAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: notification.FieldReadAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetRead sets the "read" field.
+func (nuo *NotificationUpdateOne) SetRead(b bool) *NotificationUpdateOne {
+	nuo.mutation.SetRead(b)
+	return nuo
+}
+
+// SetNillableRead sets the "read" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableRead(b *bool) *NotificationUpdateOne {
+	if b != nil {
+		nuo.SetRead(*b)
+	}
+	return nuo
+}
+
+// SetReadAt sets the "read_at" field.
+func (nuo *NotificationUpdateOne) SetReadAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetReadAt(t)
+	return nuo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableReadAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetReadAt(*t)
+	}
+	return nuo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nuo *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	nuo.mutation.ClearReadAt()
+	return nuo
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return nuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	var (
+		err  error
+		node *Notification
+	)
+	if len(nuo.hooks) == 0 {
+		node, err = nuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nuo.mutation = mutation
+			node, err = nuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nuo.hooks) - 1; i >= 0; i-- {
+			if nuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notification.Table,
+			Columns: notification.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notification.FieldID,
+			},
+		},
+	}
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Notification.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Read(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notification.FieldRead,
+		})
+	}
+	if value, ok := nuo.mutation.ReadAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldReadAt,
+		})
+	}
+	if nuo.mutation.ReadAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: notification.FieldReadAt,
+		})
+	}
+	_node = &Notification{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,141 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notificationpreference"
+)
+
+// NotificationPreference is the model entity for the NotificationPreference schema.
+type NotificationPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User holds the value of the "user" field.
+	User string `json:"user,omitempty"`
+	// ItemCompleted holds the value of the "item_completed" field.
+	ItemCompleted bool `json:"item_completed,omitempty"`
+	// ItemAssigned holds the value of the "item_assigned" field.
+	ItemAssigned bool `json:"item_assigned,omitempty"`
+	// Mentioned holds the value of the "mentioned" field.
+	Mentioned bool `json:"mentioned,omitempty"`
+	// ItemOverdue holds the value of the "item_overdue" field.
+	ItemOverdue bool `json:"item_overdue,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationPreference) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldItemCompleted, notificationpreference.FieldItemAssigned, notificationpreference.FieldMentioned, notificationpreference.FieldItemOverdue:
+			values[i] = new(sql.NullBool)
+		case notificationpreference.FieldID:
+			values[i] = new(sql.NullInt64)
+		case notificationpreference.FieldUser:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type NotificationPreference", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationPreference fields.
+func (np *NotificationPreference) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			np.ID = int(value.Int64)
+		case notificationpreference.FieldUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user", values[i])
+			} else if value.Valid {
+				np.User = value.String
+			}
+		case notificationpreference.FieldItemCompleted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field item_completed", values[i])
+			} else if value.Valid {
+				np.ItemCompleted = value.Bool
+			}
+		case notificationpreference.FieldItemAssigned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field item_assigned", values[i])
+			} else if value.Valid {
+				np.ItemAssigned = value.Bool
+			}
+		case notificationpreference.FieldMentioned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mentioned", values[i])
+			} else if value.Valid {
+				np.Mentioned = value.Bool
+			}
+		case notificationpreference.FieldItemOverdue:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field item_overdue", values[i])
+			} else if value.Valid {
+				np.ItemOverdue = value.Bool
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this NotificationPreference.
+// Note that you need to call NotificationPreference.Unwrap() before calling this method if this NotificationPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (np *NotificationPreference) Update() *NotificationPreferenceUpdateOne {
+	return (&NotificationPreferenceClient{config: np.config}).UpdateOne(np)
+}
+
+// Unwrap unwraps the NotificationPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (np *NotificationPreference) Unwrap() *NotificationPreference {
+	tx, ok := np.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationPreference is not a transactional entity")
+	}
+	np.config.driver = tx.drv
+	return np
+}
+
+// String implements the fmt.Stringer.
+func (np *NotificationPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v", np.ID))
+	builder.WriteString(", user=")
+	builder.WriteString(np.User)
+	builder.WriteString(", item_completed=")
+	builder.WriteString(fmt.Sprintf("%v", np.ItemCompleted))
+	builder.WriteString(", item_assigned=")
+	builder.WriteString(fmt.Sprintf("%v", np.ItemAssigned))
+	builder.WriteString(", mentioned=")
+	builder.WriteString(fmt.Sprintf("%v", np.Mentioned))
+	builder.WriteString(", item_overdue=")
+	builder.WriteString(fmt.Sprintf("%v", np.ItemOverdue))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationPreferences is a parsable slice of NotificationPreference.
+type NotificationPreferences []*NotificationPreference
+
+func (np NotificationPreferences) config(cfg config) {
+	for _i := range np {
+		np[_i].config = cfg
+	}
+}
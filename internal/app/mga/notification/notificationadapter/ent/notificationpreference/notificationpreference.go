@@ -0,0 +1,47 @@
+// Code generated by entc, DO NOT EDIT.
+
+package notificationpreference
+
+const (
+	// Label holds the string label denoting the notificationpreference type in the database.
+	Label = "notification_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUser holds the string denoting the user field in the database.
+	FieldUser = "user"
+	// FieldItemCompleted holds the string denoting the item_completed field in the database.
+	FieldItemCompleted = "item_completed"
+	// FieldItemAssigned holds the string denoting the item_assigned field in the database.
+	FieldItemAssigned = "item_assigned"
+	// FieldMentioned holds the string denoting the mentioned field in the database.
+	FieldMentioned = "mentioned"
+	// FieldItemOverdue holds the string denoting the item_overdue field in the database.
+	FieldItemOverdue = "item_overdue"
+	// Table holds the table name of the notificationpreference in the database.
+	Table = "notification_preferences"
+)
+
+// Columns holds all SQL columns for notificationpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldUser,
+	FieldItemCompleted,
+	FieldItemAssigned,
+	FieldMentioned,
+	FieldItemOverdue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserValidator is a validator for the "user" field. It is called by the builders before save.
+	UserValidator func(string) error
+)
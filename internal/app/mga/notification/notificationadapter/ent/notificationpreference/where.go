@@ -0,0 +1,325 @@
+// Code generated by entc, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// User applies equality check predicate on the "user" field. It's identical to UserEQ.
+func User(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUser), v))
+	})
+}
+
+// ItemCompleted applies equality check predicate on the "item_completed" field. It's identical to ItemCompletedEQ.
+func ItemCompleted(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemCompleted), v))
+	})
+}
+
+// ItemAssigned applies equality check predicate on the "item_assigned" field. It's identical to ItemAssignedEQ.
+func ItemAssigned(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemAssigned), v))
+	})
+}
+
+// Mentioned applies equality check predicate on the "mentioned" field. It's identical to MentionedEQ.
+func Mentioned(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMentioned), v))
+	})
+}
+
+// ItemOverdue applies equality check predicate on the "item_overdue" field. It's identical to ItemOverdueEQ.
+func ItemOverdue(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemOverdue), v))
+	})
+}
+
+// UserEQ applies the EQ predicate on the "user" field.
+func UserEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUser), v))
+	})
+}
+
+// UserNEQ applies the NEQ predicate on the "user" field.
+func UserNEQ(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUser), v))
+	})
+}
+
+// UserIn applies the In predicate on the "user" field.
+func UserIn(vs ...string) predicate.NotificationPreference {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUser), v...))
+	})
+}
+
+// UserNotIn applies the NotIn predicate on the "user" field.
+func UserNotIn(vs ...string) predicate.NotificationPreference {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUser), v...))
+	})
+}
+
+// UserGT applies the GT predicate on the "user" field.
+func UserGT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUser), v))
+	})
+}
+
+// UserGTE applies the GTE predicate on the "user" field.
+func UserGTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUser), v))
+	})
+}
+
+// UserLT applies the LT predicate on the "user" field.
+func UserLT(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUser), v))
+	})
+}
+
+// UserLTE applies the LTE predicate on the "user" field.
+func UserLTE(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUser), v))
+	})
+}
+
+// UserContains applies the Contains predicate on the "user" field.
+func UserContains(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUser), v))
+	})
+}
+
+// UserHasPrefix applies the HasPrefix predicate on the "user" field.
+func UserHasPrefix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUser), v))
+	})
+}
+
+// UserHasSuffix applies the HasSuffix predicate on the "user" field.
+func UserHasSuffix(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUser), v))
+	})
+}
+
+// UserEqualFold applies the EqualFold predicate on the "user" field.
+func UserEqualFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUser), v))
+	})
+}
+
+// UserContainsFold applies the ContainsFold predicate on the "user" field.
+func UserContainsFold(v string) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUser), v))
+	})
+}
+
+// ItemCompletedEQ applies the EQ predicate on the "item_completed" field.
+func ItemCompletedEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemCompleted), v))
+	})
+}
+
+// ItemCompletedNEQ applies the NEQ predicate on the "item_completed" field.
+func ItemCompletedNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldItemCompleted), v))
+	})
+}
+
+// ItemAssignedEQ applies the EQ predicate on the "item_assigned" field.
+func ItemAssignedEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemAssigned), v))
+	})
+}
+
+// ItemAssignedNEQ applies the NEQ predicate on the "item_assigned" field.
+func ItemAssignedNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldItemAssigned), v))
+	})
+}
+
+// MentionedEQ applies the EQ predicate on the "mentioned" field.
+func MentionedEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMentioned), v))
+	})
+}
+
+// MentionedNEQ applies the NEQ predicate on the "mentioned" field.
+func MentionedNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMentioned), v))
+	})
+}
+
+// ItemOverdueEQ applies the EQ predicate on the "item_overdue" field.
+func ItemOverdueEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemOverdue), v))
+	})
+}
+
+// ItemOverdueNEQ applies the NEQ predicate on the "item_overdue" field.
+func ItemOverdueNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldItemOverdue), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,293 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notificationpreference"
+)
+
+// NotificationPreferenceCreate is the builder for creating a NotificationPreference entity.
+type NotificationPreferenceCreate struct {
+	config
+	mutation *NotificationPreferenceMutation
+	hooks    []Hook
+}
+
+// SetUser sets the "user" field.
+func (npc *NotificationPreferenceCreate) SetUser(s string) *NotificationPreferenceCreate {
+	npc.mutation.SetUser(s)
+	return npc
+}
+
+// SetItemCompleted sets the "item_completed" field.
+func (npc *NotificationPreferenceCreate) SetItemCompleted(b bool) *NotificationPreferenceCreate {
+	npc.mutation.SetItemCompleted(b)
+	return npc
+}
+
+// SetItemAssigned sets the "item_assigned" field.
+func (npc *NotificationPreferenceCreate) SetItemAssigned(b bool) *NotificationPreferenceCreate {
+	npc.mutation.SetItemAssigned(b)
+	return npc
+}
+
+// SetMentioned sets the "mentioned" field.
+func (npc *NotificationPreferenceCreate) SetMentioned(b bool) *NotificationPreferenceCreate {
+	npc.mutation.SetMentioned(b)
+	return npc
+}
+
+// SetItemOverdue sets the "item_overdue" field.
+func (npc *NotificationPreferenceCreate) SetItemOverdue(b bool) *NotificationPreferenceCreate {
+	npc.mutation.SetItemOverdue(b)
+	return npc
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npc *NotificationPreferenceCreate) Mutation() *NotificationPreferenceMutation {
+	return npc.mutation
+}
+
+// Save creates the NotificationPreference in the database.
+func (npc *NotificationPreferenceCreate) Save(ctx context.Context) (*NotificationPreference, error) {
+	var (
+		err  error
+		node *NotificationPreference
+	)
+	if len(npc.hooks) == 0 {
+		if err = npc.check(); err != nil {
+			return nil, err
+		}
+		node, err = npc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = npc.check(); err != nil {
+				return nil, err
+			}
+			npc.mutation = mutation
+			if node, err = npc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(npc.hooks) - 1; i >= 0; i-- {
+			if npc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = npc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, npc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (npc *NotificationPreferenceCreate) SaveX(ctx context.Context) *NotificationPreference {
+	v, err := npc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npc *NotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := npc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npc *NotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := npc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npc *NotificationPreferenceCreate) check() error {
+	if _, ok := npc.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required field "user"`)}
+	}
+	if v, ok := npc.mutation.User(); ok {
+		if err := notificationpreference.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "user": %w`, err)}
+		}
+	}
+	if _, ok := npc.mutation.ItemCompleted(); !ok {
+		return &ValidationError{Name: "item_completed", err: errors.New(`ent: missing required field "item_completed"`)}
+	}
+	if _, ok := npc.mutation.ItemAssigned(); !ok {
+		return &ValidationError{Name: "item_assigned", err: errors.New(`ent: missing required field "item_assigned"`)}
+	}
+	if _, ok := npc.mutation.Mentioned(); !ok {
+		return &ValidationError{Name: "mentioned", err: errors.New(`ent: missing required field "mentioned"`)}
+	}
+	if _, ok := npc.mutation.ItemOverdue(); !ok {
+		return &ValidationError{Name: "item_overdue", err: errors.New(`ent: missing required field "item_overdue"`)}
+	}
+	return nil
+}
+
+func (npc *NotificationPreferenceCreate) sqlSave(ctx context.Context) (*NotificationPreference, error) {
+	_node, _spec := npc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, npc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (npc *NotificationPreferenceCreate) createSpec() (*NotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationPreference{config: npc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: notificationpreference.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notificationpreference.FieldID,
+			},
+		}
+	)
+	if value, ok := npc.mutation.User(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notificationpreference.FieldUser,
+		})
+		_node.User = value
+	}
+	if value, ok := npc.mutation.ItemCompleted(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldItemCompleted,
+		})
+		_node.ItemCompleted = value
+	}
+	if value, ok := npc.mutation.ItemAssigned(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldItemAssigned,
+		})
+		_node.ItemAssigned = value
+	}
+	if value, ok := npc.mutation.Mentioned(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldMentioned,
+		})
+		_node.Mentioned = value
+	}
+	if value, ok := npc.mutation.ItemOverdue(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldItemOverdue,
+		})
+		_node.ItemOverdue = value
+	}
+	return _node, _spec
+}
+
+// NotificationPreferenceCreateBulk is the builder for creating many NotificationPreference entities in bulk.
+type NotificationPreferenceCreateBulk struct {
+	config
+	builders []*NotificationPreferenceCreate
+}
+
+// Save creates the NotificationPreference entities in the database.
+func (npcb *NotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*NotificationPreference, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(npcb.builders))
+	nodes := make([]*NotificationPreference, len(npcb.builders))
+	mutators := make([]Mutator, len(npcb.builders))
+	for i := range npcb.builders {
+		func(i int, root context.Context) {
+			builder := npcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, npcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, npcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, npcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npcb *NotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*NotificationPreference {
+	v, err := npcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npcb *NotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := npcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npcb *NotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := npcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notificationpreference"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/predicate"
+)
+
+// NotificationPreferenceDelete is the builder for deleting a NotificationPreference entity.
+type NotificationPreferenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceDelete builder.
+func (npd *NotificationPreferenceDelete) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceDelete {
+	npd.mutation.Where(ps...)
+	return npd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (npd *NotificationPreferenceDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(npd.hooks) == 0 {
+		affected, err = npd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			npd.mutation = mutation
+			affected, err = npd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(npd.hooks) - 1; i >= 0; i-- {
+			if npd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = npd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, npd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npd *NotificationPreferenceDelete) ExecX(ctx context.Context) int {
+	n, err := npd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (npd *NotificationPreferenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: notificationpreference.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notificationpreference.FieldID,
+			},
+		},
+	}
+	if ps := npd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, npd.driver, _spec)
+}
+
+// NotificationPreferenceDeleteOne is the builder for deleting a single NotificationPreference entity.
+type NotificationPreferenceDeleteOne struct {
+	npd *NotificationPreferenceDelete
+}
+
+// Exec executes the deletion query.
+func (npdo *NotificationPreferenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := npdo.npd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{notificationpreference.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npdo *NotificationPreferenceDeleteOne) ExecX(ctx context.Context) {
+	npdo.npd.ExecX(ctx)
+}
@@ -0,0 +1,913 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notificationpreference"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/predicate"
+)
+
+// NotificationPreferenceQuery is the builder for querying NotificationPreference entities.
+type NotificationPreferenceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.NotificationPreference
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NotificationPreferenceQuery builder.
+func (npq *NotificationPreferenceQuery) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceQuery {
+	npq.predicates = append(npq.predicates, ps...)
+	return npq
+}
+
+// Limit adds a limit step to the query.
+func (npq *NotificationPreferenceQuery) Limit(limit int) *NotificationPreferenceQuery {
+	npq.limit = &limit
+	return npq
+}
+
+// Offset adds an offset step to the query.
+func (npq *NotificationPreferenceQuery) Offset(offset int) *NotificationPreferenceQuery {
+	npq.offset = &offset
+	return npq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (npq *NotificationPreferenceQuery) Unique(unique bool) *NotificationPreferenceQuery {
+	npq.unique = &unique
+	return npq
+}
+
+// Order adds an order step to the query.
+func (npq *NotificationPreferenceQuery) Order(o ...OrderFunc) *NotificationPreferenceQuery {
+	npq.order = append(npq.order, o...)
+	return npq
+}
+
+// First returns the first NotificationPreference entity from the query.
+// Returns a *NotFoundError when no NotificationPreference was found.
+func (npq *NotificationPreferenceQuery) First(ctx context.Context) (*NotificationPreference, error) {
+	nodes, err := npq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{notificationpreference.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (npq *NotificationPreferenceQuery) FirstX(ctx context.Context) *NotificationPreference {
+	node, err := npq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NotificationPreference ID from the query.
+// Returns a *NotFoundError when no NotificationPreference ID was found.
+func (npq *NotificationPreferenceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = npq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{notificationpreference.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (npq *NotificationPreferenceQuery) FirstIDX(ctx context.Context) int {
+	id, err := npq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NotificationPreference entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one NotificationPreference entity is not found.
+// Returns a *NotFoundError when no NotificationPreference entities are found.
+func (npq *NotificationPreferenceQuery) Only(ctx context.Context) (*NotificationPreference, error) {
+	nodes, err := npq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{notificationpreference.Label}
+	default:
+		return nil, &NotSingularError{notificationpreference.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (npq *NotificationPreferenceQuery) OnlyX(ctx context.Context) *NotificationPreference {
+	node, err := npq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NotificationPreference ID in the query.
+// Returns a *NotSingularError when exactly one NotificationPreference ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (npq *NotificationPreferenceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = npq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{notificationpreference.Label}
+	default:
+		err = &NotSingularError{notificationpreference.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (npq *NotificationPreferenceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := npq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NotificationPreferences.
+func (npq *NotificationPreferenceQuery) All(ctx context.Context) ([]*NotificationPreference, error) {
+	if err := npq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return npq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (npq *NotificationPreferenceQuery) AllX(ctx context.Context) []*NotificationPreference {
+	nodes, err := npq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NotificationPreference IDs.
+func (npq *NotificationPreferenceQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := npq.Select(notificationpreference.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (npq *NotificationPreferenceQuery) IDsX(ctx context.Context) []int {
+	ids, err := npq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (npq *NotificationPreferenceQuery) Count(ctx context.Context) (int, error) {
+	if err := npq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return npq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (npq *NotificationPreferenceQuery) CountX(ctx context.Context) int {
+	count, err := npq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (npq *NotificationPreferenceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := npq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return npq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (npq *NotificationPreferenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := npq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NotificationPreferenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (npq *NotificationPreferenceQuery) Clone() *NotificationPreferenceQuery {
+	if npq == nil {
+		return nil
+	}
+	return &NotificationPreferenceQuery{
+		config:     npq.config,
+		limit:      npq.limit,
+		offset:     npq.offset,
+		order:      append([]OrderFunc{}, npq.order...),
+		predicates: append([]predicate.NotificationPreference{}, npq.predicates...),
+		// clone intermediate query.
+		sql:  npq.sql.Clone(),
+		path: npq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		User string `json:"user,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NotificationPreference.Query().
+//		GroupBy(notificationpreference.FieldUser).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (npq *NotificationPreferenceQuery) GroupBy(field string, fields ...string) *NotificationPreferenceGroupBy {
+	group := &NotificationPreferenceGroupBy{config: npq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := npq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return npq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		User string `json:"user,omitempty"`
+//	}
+//
+//	client.NotificationPreference.Query().
+//		Select(notificationpreference.FieldUser).
+//		Scan(ctx, &v)
+func (npq *NotificationPreferenceQuery) Select(fields ...string) *NotificationPreferenceSelect {
+	npq.fields = append(npq.fields, fields...)
+	return &NotificationPreferenceSelect{NotificationPreferenceQuery: npq}
+}
+
+func (npq *NotificationPreferenceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range npq.fields {
+		if !notificationpreference.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if npq.path != nil {
+		prev, err := npq.path(ctx)
+		if err != nil {
+			return err
+		}
+		npq.sql = prev
+	}
+	return nil
+}
+
+func (npq *NotificationPreferenceQuery) sqlAll(ctx context.Context) ([]*NotificationPreference, error) {
+	var (
+		nodes = []*NotificationPreference{}
+		_spec = npq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &NotificationPreference{config: npq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, npq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (npq *NotificationPreferenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := npq.querySpec()
+	return sqlgraph.CountNodes(ctx, npq.driver, _spec)
+}
+
+func (npq *NotificationPreferenceQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := npq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (npq *NotificationPreferenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notificationpreference.Table,
+			Columns: notificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notificationpreference.FieldID,
+			},
+		},
+		From:   npq.sql,
+		Unique: true,
+	}
+	if unique := npq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := npq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for i := range fields {
+			if fields[i] != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := npq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := npq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := npq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := npq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (npq *NotificationPreferenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(npq.driver.Dialect())
+	t1 := builder.Table(notificationpreference.Table)
+	columns := npq.fields
+	if len(columns) == 0 {
+		columns = notificationpreference.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if npq.sql != nil {
+		selector = npq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range npq.predicates {
+		p(selector)
+	}
+	for _, p := range npq.order {
+		p(selector)
+	}
+	if offset := npq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := npq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NotificationPreferenceGroupBy is the group-by builder for NotificationPreference entities.
+type NotificationPreferenceGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (npgb *NotificationPreferenceGroupBy) Aggregate(fns ...AggregateFunc) *NotificationPreferenceGroupBy {
+	npgb.fns = append(npgb.fns, fns...)
+	return npgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (npgb *NotificationPreferenceGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := npgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	npgb.sql = query
+	return npgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (npgb *NotificationPreferenceGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := npgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (npgb *NotificationPreferenceGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(npgb.fields) > 1 {
+		return nil, errors.New("ent: NotificationPreferenceGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := npgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (npgb *NotificationPreferenceGroupBy) StringsX(ctx context.Context) []string {
+	v, err := npgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (npgb *NotificationPreferenceGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = npgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notificationpreference.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationPreferenceGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (npgb *NotificationPreferenceGroupBy) StringX(ctx context.Context) string {
+	v, err := npgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (npgb *NotificationPreferenceGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(npgb.fields) > 1 {
+		return nil, errors.New("ent: NotificationPreferenceGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := npgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (npgb *NotificationPreferenceGroupBy) IntsX(ctx context.Context) []int {
+	v, err := npgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (npgb *NotificationPreferenceGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = npgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notificationpreference.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationPreferenceGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (npgb *NotificationPreferenceGroupBy) IntX(ctx context.Context) int {
+	v, err := npgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (npgb *NotificationPreferenceGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(npgb.fields) > 1 {
+		return nil, errors.New("ent: NotificationPreferenceGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := npgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (npgb *NotificationPreferenceGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := npgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (npgb *NotificationPreferenceGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = npgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notificationpreference.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationPreferenceGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (npgb *NotificationPreferenceGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := npgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (npgb *NotificationPreferenceGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(npgb.fields) > 1 {
+		return nil, errors.New("ent: NotificationPreferenceGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := npgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (npgb *NotificationPreferenceGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := npgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (npgb *NotificationPreferenceGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = npgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notificationpreference.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationPreferenceGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (npgb *NotificationPreferenceGroupBy) BoolX(ctx context.Context) bool {
+	v, err := npgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (npgb *NotificationPreferenceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range npgb.fields {
+		if !notificationpreference.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := npgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := npgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (npgb *NotificationPreferenceGroupBy) sqlQuery() *sql.Selector {
+	selector := npgb.sql.Select()
+	aggregation := make([]string, 0, len(npgb.fns))
+	for _, fn := range npgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(npgb.fields)+len(npgb.fns))
+		for _, f := range npgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(npgb.fields...)...)
+}
+
+// NotificationPreferenceSelect is the builder for selecting fields of NotificationPreference entities.
+type NotificationPreferenceSelect struct {
+	*NotificationPreferenceQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nps *NotificationPreferenceSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := nps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	nps.sql = nps.NotificationPreferenceQuery.sqlQuery(ctx)
+	return nps.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (nps *NotificationPreferenceSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := nps.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (nps *NotificationPreferenceSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(nps.fields) > 1 {
+		return nil, errors.New("ent: NotificationPreferenceSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := nps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (nps *NotificationPreferenceSelect) StringsX(ctx context.Context) []string {
+	v, err := nps.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (nps *NotificationPreferenceSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = nps.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notificationpreference.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationPreferenceSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (nps *NotificationPreferenceSelect) StringX(ctx context.Context) string {
+	v, err := nps.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (nps *NotificationPreferenceSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(nps.fields) > 1 {
+		return nil, errors.New("ent: NotificationPreferenceSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := nps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (nps *NotificationPreferenceSelect) IntsX(ctx context.Context) []int {
+	v, err := nps.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (nps *NotificationPreferenceSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = nps.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notificationpreference.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationPreferenceSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (nps *NotificationPreferenceSelect) IntX(ctx context.Context) int {
+	v, err := nps.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (nps *NotificationPreferenceSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(nps.fields) > 1 {
+		return nil, errors.New("ent: NotificationPreferenceSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := nps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (nps *NotificationPreferenceSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := nps.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (nps *NotificationPreferenceSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = nps.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notificationpreference.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationPreferenceSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (nps *NotificationPreferenceSelect) Float64X(ctx context.Context) float64 {
+	v, err := nps.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (nps *NotificationPreferenceSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(nps.fields) > 1 {
+		return nil, errors.New("ent: NotificationPreferenceSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := nps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (nps *NotificationPreferenceSelect) BoolsX(ctx context.Context) []bool {
+	v, err := nps.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (nps *NotificationPreferenceSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = nps.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{notificationpreference.Label}
+	default:
+		err = fmt.Errorf("ent: NotificationPreferenceSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (nps *NotificationPreferenceSelect) BoolX(ctx context.Context) bool {
+	v, err := nps.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (nps *NotificationPreferenceSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := nps.sql.Query()
+	if err := nps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
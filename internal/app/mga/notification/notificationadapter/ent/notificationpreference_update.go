@@ -0,0 +1,342 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notificationpreference"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/predicate"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (npu *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	npu.mutation.Where(ps...)
+	return npu
+}
+
+// SetItemCompleted sets the "item_completed" field.
+func (npu *NotificationPreferenceUpdate) SetItemCompleted(b bool) *NotificationPreferenceUpdate {
+	npu.mutation.SetItemCompleted(b)
+	return npu
+}
+
+// SetItemAssigned sets the "item_assigned" field.
+func (npu *NotificationPreferenceUpdate) SetItemAssigned(b bool) *NotificationPreferenceUpdate {
+	npu.mutation.SetItemAssigned(b)
+	return npu
+}
+
+// SetMentioned sets the "mentioned" field.
+func (npu *NotificationPreferenceUpdate) SetMentioned(b bool) *NotificationPreferenceUpdate {
+	npu.mutation.SetMentioned(b)
+	return npu
+}
+
+// SetItemOverdue sets the "item_overdue" field.
+func (npu *NotificationPreferenceUpdate) SetItemOverdue(b bool) *NotificationPreferenceUpdate {
+	npu.mutation.SetItemOverdue(b)
+	return npu
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npu *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return npu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (npu *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(npu.hooks) == 0 {
+		affected, err = npu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			npu.mutation = mutation
+			affected, err = npu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(npu.hooks) - 1; i >= 0; i-- {
+			if npu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = npu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, npu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npu *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := npu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (npu *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := npu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npu *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := npu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (npu *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notificationpreference.Table,
+			Columns: notificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notificationpreference.FieldID,
+			},
+		},
+	}
+	if ps := npu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npu.mutation.ItemCompleted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldItemCompleted,
+		})
+	}
+	if value, ok := npu.mutation.ItemAssigned(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldItemAssigned,
+		})
+	}
+	if value, ok := npu.mutation.Mentioned(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldMentioned,
+		})
+	}
+	if value, ok := npu.mutation.ItemOverdue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldItemOverdue,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, npu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// SetItemCompleted sets the "item_completed" field.
+func (npuo *NotificationPreferenceUpdateOne) SetItemCompleted(b bool) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetItemCompleted(b)
+	return npuo
+}
+
+// SetItemAssigned sets the "item_assigned" field.
+func (npuo *NotificationPreferenceUpdateOne) SetItemAssigned(b bool) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetItemAssigned(b)
+	return npuo
+}
+
+// SetMentioned sets the "mentioned" field.
+func (npuo *NotificationPreferenceUpdateOne) SetMentioned(b bool) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetMentioned(b)
+	return npuo
+}
+
+// SetItemOverdue sets the "item_overdue" field.
+func (npuo *NotificationPreferenceUpdateOne) SetItemOverdue(b bool) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetItemOverdue(b)
+	return npuo
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npuo *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return npuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (npuo *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	npuo.fields = append([]string{field}, fields...)
+	return npuo
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (npuo *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	var (
+		err  error
+		node *NotificationPreference
+	)
+	if len(npuo.hooks) == 0 {
+		node, err = npuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			npuo.mutation = mutation
+			node, err = npuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(npuo.hooks) - 1; i >= 0; i-- {
+			if npuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = npuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, npuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npuo *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := npuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (npuo *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := npuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npuo *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := npuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (npuo *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notificationpreference.Table,
+			Columns: notificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: notificationpreference.FieldID,
+			},
+		},
+	}
+	id, ok := npuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing NotificationPreference.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := npuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := npuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npuo.mutation.ItemCompleted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldItemCompleted,
+		})
+	}
+	if value, ok := npuo.mutation.ItemAssigned(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldItemAssigned,
+		})
+	}
+	if value, ok := npuo.mutation.Mentioned(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldMentioned,
+		})
+	}
+	if value, ok := npuo.mutation.ItemOverdue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldItemOverdue,
+		})
+	}
+	_node = &NotificationPreference{config: npuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, npuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
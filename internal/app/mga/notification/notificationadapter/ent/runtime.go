@@ -0,0 +1,53 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notificationpreference"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescUID is the schema descriptor for uid field.
+	notificationDescUID := notificationFields[0].Descriptor()
+	// notification.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	notification.UIDValidator = func() func(string) error {
+		validators := notificationDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationDescUser is the schema descriptor for user field.
+	notificationDescUser := notificationFields[1].Descriptor()
+	// notification.UserValidator is a validator for the "user" field. It is called by the builders before save.
+	notification.UserValidator = notificationDescUser.Validators[0].(func(string) error)
+	// notificationDescKind is the schema descriptor for kind field.
+	notificationDescKind := notificationFields[2].Descriptor()
+	// notification.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	notification.KindValidator = notificationDescKind.Validators[0].(func(string) error)
+	// notificationDescRead is the schema descriptor for read field.
+	notificationDescRead := notificationFields[8].Descriptor()
+	// notification.DefaultRead holds the default value on creation for the read field.
+	notification.DefaultRead = notificationDescRead.Default.(bool)
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescUser is the schema descriptor for user field.
+	notificationpreferenceDescUser := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.UserValidator is a validator for the "user" field. It is called by the builders before save.
+	notificationpreference.UserValidator = notificationpreferenceDescUser.Validators[0].(func(string) error)
+}
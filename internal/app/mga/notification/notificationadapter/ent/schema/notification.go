@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification holds the schema definition for the Notification entity.
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("user").
+			NotEmpty().
+			Immutable(),
+		field.String("kind").
+			NotEmpty().
+			Immutable(),
+		field.String("item_id").
+			Immutable(),
+		field.String("list_id").
+			Immutable(),
+		field.String("comment_id").
+			Immutable(),
+		field.String("title").
+			Immutable(),
+		field.String("actor").
+			Immutable(),
+		field.Bool("read").
+			Default(false),
+		field.Time("created_at").
+			Immutable(),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user", "read"),
+		index.Fields("user", "created_at"),
+	}
+}
@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationPreference holds the schema definition for the NotificationPreference entity.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Bool("item_completed"),
+		field.Bool("item_assigned"),
+		field.Bool("mentioned"),
+		field.Bool("item_overdue"),
+	}
+}
@@ -0,0 +1,30 @@
+package notificationadapter
+
+import (
+	"context"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/mail"
+)
+
+// Mailer sends notification emails through a mail sender.
+type Mailer struct {
+	sender mail.Sender
+}
+
+// NewMailer returns a new Mailer.
+func NewMailer(sender mail.Sender) Mailer {
+	return Mailer{
+		sender: sender,
+	}
+}
+
+// Send sends an email.
+func (m Mailer) Send(ctx context.Context, email notification.Email) error {
+	return m.sender.Send(ctx, mail.Message{
+		To:      []string{email.To},
+		Subject: email.Subject,
+		Text:    email.Text,
+		HTML:    email.HTML,
+	})
+}
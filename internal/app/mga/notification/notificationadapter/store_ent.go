@@ -0,0 +1,212 @@
+package notificationadapter
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent"
+	entnotification "github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/notificationpreference"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationadapter/ent/predicate"
+)
+
+// EntStore is a notification store backed by Ent ORM.
+type EntStore struct {
+	client *ent.Client
+}
+
+// NewEntStore returns a new notification store backed by Ent ORM.
+func NewEntStore(client *ent.Client) EntStore {
+	return EntStore{
+		client: client,
+	}
+}
+
+// StoreNotification stores a new notification or replaces an existing one.
+func (s EntStore) StoreNotification(ctx context.Context, n notification.Notification) error {
+	existing, err := s.client.Notification.Query().Where(entnotification.UID(n.ID)).First(ctx)
+	if ent.IsNotFound(err) {
+		_, err := s.client.Notification.Create().
+			SetUID(n.ID).
+			SetUser(n.User).
+			SetKind(string(n.Kind)).
+			SetItemID(n.ItemID).
+			SetListID(n.ListID).
+			SetCommentID(n.CommentID).
+			SetTitle(n.Title).
+			SetActor(n.Actor).
+			SetRead(n.Read).
+			SetCreatedAt(n.CreatedAt).
+			SetNillableReadAt(n.ReadAt).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	update := s.client.Notification.UpdateOneID(existing.ID).SetRead(n.Read)
+
+	if n.ReadAt != nil {
+		update.SetReadAt(*n.ReadAt)
+	} else {
+		update.ClearReadAt()
+	}
+
+	_, err = update.Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetNotifications returns the notifications of a user matching a filter, newest first.
+func (s EntStore) GetNotifications(ctx context.Context, user string, filter notification.Filter) ([]notification.Notification, error) {
+	predicates := []predicate.Notification{entnotification.User(user)}
+
+	if filter.UnreadOnly {
+		predicates = append(predicates, entnotification.Read(false))
+	}
+
+	query := s.client.Notification.Query().
+		Where(predicates...).
+		Order(ent.Desc(entnotification.FieldCreatedAt), ent.Desc(entnotification.FieldUID))
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	notificationModels, err := query.All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	notifications := make([]notification.Notification, 0, len(notificationModels))
+
+	for _, notificationModel := range notificationModels {
+		notifications = append(notifications, unmarshalNotification(notificationModel))
+	}
+
+	return notifications, nil
+}
+
+// GetNotification returns a notification of a user.
+func (s EntStore) GetNotification(ctx context.Context, user string, id string) (notification.Notification, error) {
+	notificationModel, err := s.client.Notification.Query().
+		Where(entnotification.UID(id), entnotification.User(user)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return notification.Notification{}, errors.WithStack(notification.NotFoundError{ID: id})
+	}
+	if err != nil {
+		return notification.Notification{}, errors.WithStack(err)
+	}
+
+	return unmarshalNotification(notificationModel), nil
+}
+
+// CountUnread returns the number of unread notifications of a user.
+func (s EntStore) CountUnread(ctx context.Context, user string) (int, error) {
+	count, err := s.client.Notification.Query().
+		Where(entnotification.User(user), entnotification.Read(false)).
+		Count(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+// MarkAllAsRead marks the unread notifications of a user as read and returns their number.
+func (s EntStore) MarkAllAsRead(ctx context.Context, user string, readAt time.Time) (int, error) {
+	count, err := s.client.Notification.Update().
+		Where(entnotification.User(user), entnotification.Read(false)).
+		SetRead(true).
+		SetReadAt(readAt).
+		Save(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+// GetPreferences returns the preferences of a user (false if the user hasn't changed them).
+func (s EntStore) GetPreferences(ctx context.Context, user string) (notification.Preferences, bool, error) {
+	preferenceModel, err := s.client.NotificationPreference.Query().
+		Where(notificationpreference.User(user)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return notification.Preferences{}, false, nil
+	}
+	if err != nil {
+		return notification.Preferences{}, false, errors.WithStack(err)
+	}
+
+	return notification.Preferences{
+		ItemCompleted: preferenceModel.ItemCompleted,
+		ItemAssigned:  preferenceModel.ItemAssigned,
+		Mentioned:     preferenceModel.Mentioned,
+		ItemOverdue:   preferenceModel.ItemOverdue,
+	}, true, nil
+}
+
+// StorePreferences stores the preferences of a user.
+func (s EntStore) StorePreferences(ctx context.Context, user string, preferences notification.Preferences) error {
+	existing, err := s.client.NotificationPreference.Query().
+		Where(notificationpreference.User(user)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		_, err := s.client.NotificationPreference.Create().
+			SetUser(user).
+			SetItemCompleted(preferences.ItemCompleted).
+			SetItemAssigned(preferences.ItemAssigned).
+			SetMentioned(preferences.Mentioned).
+			SetItemOverdue(preferences.ItemOverdue).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.client.NotificationPreference.UpdateOneID(existing.ID).
+		SetItemCompleted(preferences.ItemCompleted).
+		SetItemAssigned(preferences.ItemAssigned).
+		SetMentioned(preferences.Mentioned).
+		SetItemOverdue(preferences.ItemOverdue).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func unmarshalNotification(notificationModel *ent.Notification) notification.Notification {
+	return notification.Notification{
+		ID:        notificationModel.UID,
+		User:      notificationModel.User,
+		Kind:      notification.Kind(notificationModel.Kind),
+		ItemID:    notificationModel.ItemID,
+		ListID:    notificationModel.ListID,
+		CommentID: notificationModel.CommentID,
+		Title:     notificationModel.Title,
+		Actor:     notificationModel.Actor,
+		Read:      notificationModel.Read,
+		CreatedAt: notificationModel.CreatedAt,
+		ReadAt:    notificationModel.ReadAt,
+	}
+}
@@ -0,0 +1,85 @@
+package notificationdriver
+
+import (
+	"context"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+)
+
+// LoggingMiddleware is a service level logging middleware.
+func LoggingMiddleware(logger notification.Logger) notification.Middleware {
+	return func(next notification.Service) notification.Service {
+		return loggingMiddleware{
+			next:   next,
+			logger: logger,
+		}
+	}
+}
+
+type loggingMiddleware struct {
+	next   notification.Service
+	logger notification.Logger
+}
+
+func (mw loggingMiddleware) ListNotifications(ctx context.Context, filter notification.Filter) ([]notification.Notification, error) { // nolint: lll
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing notifications", map[string]interface{}{"unread_only": filter.UnreadOnly})
+
+	return mw.next.ListNotifications(ctx, filter)
+}
+
+func (mw loggingMiddleware) CountUnread(ctx context.Context) (int, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("counting unread notifications")
+
+	return mw.next.CountUnread(ctx)
+}
+
+func (mw loggingMiddleware) MarkAsRead(ctx context.Context, id string) (notification.Notification, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("marking notification as read", map[string]interface{}{"notification_id": id})
+
+	return mw.next.MarkAsRead(ctx, id)
+}
+
+func (mw loggingMiddleware) MarkAsUnread(ctx context.Context, id string) (notification.Notification, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("marking notification as unread", map[string]interface{}{"notification_id": id})
+
+	return mw.next.MarkAsUnread(ctx, id)
+}
+
+func (mw loggingMiddleware) MarkAllAsRead(ctx context.Context) (int, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("marking all notifications as read")
+
+	count, err := mw.next.MarkAllAsRead(ctx)
+	if err != nil {
+		return count, err
+	}
+
+	logger.Info("marked all notifications as read", map[string]interface{}{"count": count})
+
+	return count, nil
+}
+
+func (mw loggingMiddleware) GetPreferences(ctx context.Context) (notification.Preferences, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting notification preferences")
+
+	return mw.next.GetPreferences(ctx)
+}
+
+func (mw loggingMiddleware) UpdatePreferences(ctx context.Context, update notification.PreferencesUpdate) (notification.Preferences, error) { // nolint: lll
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("updating notification preferences")
+
+	return mw.next.UpdatePreferences(ctx, update)
+}
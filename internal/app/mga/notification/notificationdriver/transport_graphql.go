@@ -0,0 +1,286 @@
+package notificationdriver
+
+import (
+	"context"
+
+	graphql2 "github.com/99designs/gqlgen/graphql"
+	kitxgraphql "github.com/sagikazarmark/kitx/transport/graphql"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/generated/api/notification/v1/graphql"
+)
+
+// MakeGraphQLSchema mounts all of the service endpoints into a GraphQL executable schema.
+func MakeGraphQLSchema(endpoints Endpoints, options ...kitxgraphql.ServerOption) graphql2.ExecutableSchema {
+	return graphql.NewExecutableSchema(graphql.Config{
+		Resolvers: MakeGraphQLResolver(endpoints, options...),
+	})
+}
+
+// MakeGraphQLResolver mounts all of the service endpoints into a GraphQL resolver.
+func MakeGraphQLResolver(endpoints Endpoints, options ...kitxgraphql.ServerOption) graphql.ResolverRoot {
+	errorEncoder := func(_ context.Context, err error) error {
+		return err
+	}
+
+	return &resolver{
+		NotificationsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListNotifications,
+			decodeListNotificationsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListNotificationsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UnreadNotificationCountHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.CountUnread,
+			decodeCountUnreadGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeCountGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MarkNotificationAsReadHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.MarkAsRead,
+			decodeMarkAsReadGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeNotificationGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MarkNotificationAsUnreadHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.MarkAsUnread,
+			decodeMarkAsUnreadGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeNotificationGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MarkAllNotificationsAsReadHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.MarkAllAsRead,
+			decodeMarkAllAsReadGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeCountGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		NotificationPreferencesHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.GetPreferences,
+			decodeGetPreferencesGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodePreferencesGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateNotificationPreferencesHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.UpdatePreferences,
+			decodeUpdatePreferencesGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodePreferencesGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+	}
+}
+
+// notificationsGraphQLRequest holds the arguments of the notifications query.
+type notificationsGraphQLRequest struct {
+	unreadOnly *bool
+	limit      *int
+}
+
+func decodeListNotificationsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(notificationsGraphQLRequest)
+
+	var filter notification.Filter
+
+	if req.unreadOnly != nil {
+		filter.UnreadOnly = *req.unreadOnly
+	}
+
+	if req.limit != nil {
+		filter.Limit = *req.limit
+	}
+
+	return ListNotificationsRequest{
+		Filter: filter,
+	}, nil
+}
+
+func encodeListNotificationsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListNotificationsResponse).Notifications, nil
+}
+
+func decodeCountUnreadGraphQLRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return CountUnreadRequest{}, nil
+}
+
+func decodeMarkAllAsReadGraphQLRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return MarkAllAsReadRequest{}, nil
+}
+
+func encodeCountGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	var count int
+
+	switch resp := response.(type) {
+	case CountUnreadResponse:
+		count = resp.Count
+	case MarkAllAsReadResponse:
+		count = resp.Count
+	}
+
+	return count, nil
+}
+
+func decodeMarkAsReadGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return MarkAsReadRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func decodeMarkAsUnreadGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return MarkAsUnreadRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func encodeNotificationGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	var n notification.Notification
+
+	switch resp := response.(type) {
+	case MarkAsReadResponse:
+		n = resp.Notification
+	case MarkAsUnreadResponse:
+		n = resp.Notification
+	}
+
+	return &n, nil
+}
+
+func decodeGetPreferencesGraphQLRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return GetPreferencesRequest{}, nil
+}
+
+func decodeUpdatePreferencesGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return UpdatePreferencesRequest{
+		Update: request.(notification.PreferencesUpdate),
+	}, nil
+}
+
+func encodePreferencesGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	var preferences notification.Preferences
+
+	switch resp := response.(type) {
+	case GetPreferencesResponse:
+		preferences = resp.Preferences
+	case UpdatePreferencesResponse:
+		preferences = resp.Preferences
+	}
+
+	return &preferences, nil
+}
+
+type resolver struct {
+	NotificationsHandler                 kitxgraphql.Handler
+	UnreadNotificationCountHandler       kitxgraphql.Handler
+	MarkNotificationAsReadHandler        kitxgraphql.Handler
+	MarkNotificationAsUnreadHandler      kitxgraphql.Handler
+	MarkAllNotificationsAsReadHandler    kitxgraphql.Handler
+	NotificationPreferencesHandler       kitxgraphql.Handler
+	UpdateNotificationPreferencesHandler kitxgraphql.Handler
+}
+
+func (r *resolver) Mutation() graphql.MutationResolver {
+	return &mutationResolver{r}
+}
+
+func (r *resolver) Query() graphql.QueryResolver {
+	return &queryResolver{r}
+}
+
+func (r *resolver) Notification() graphql.NotificationResolver {
+	return &notificationResolver{r}
+}
+
+type queryResolver struct{ *resolver }
+
+func (r *queryResolver) Notifications(ctx context.Context, unreadOnly *bool, limit *int) ([]notification.Notification, error) { // nolint: lll
+	_, resp, err := r.NotificationsHandler.ServeGraphQL(ctx, notificationsGraphQLRequest{unreadOnly: unreadOnly, limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]notification.Notification), nil
+}
+
+func (r *queryResolver) UnreadNotificationCount(ctx context.Context) (int, error) {
+	_, resp, err := r.UnreadNotificationCountHandler.ServeGraphQL(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.(int), nil
+}
+
+func (r *queryResolver) NotificationPreferences(ctx context.Context) (*notification.Preferences, error) {
+	_, resp, err := r.NotificationPreferencesHandler.ServeGraphQL(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*notification.Preferences), nil
+}
+
+type mutationResolver struct{ *resolver }
+
+func (r *mutationResolver) MarkNotificationAsRead(ctx context.Context, id string) (*notification.Notification, error) {
+	_, resp, err := r.MarkNotificationAsReadHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*notification.Notification), nil
+}
+
+func (r *mutationResolver) MarkNotificationAsUnread(ctx context.Context, id string) (*notification.Notification, error) {
+	_, resp, err := r.MarkNotificationAsUnreadHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*notification.Notification), nil
+}
+
+func (r *mutationResolver) MarkAllNotificationsAsRead(ctx context.Context) (int, error) {
+	_, resp, err := r.MarkAllNotificationsAsReadHandler.ServeGraphQL(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.(int), nil
+}
+
+func (r *mutationResolver) UpdateNotificationPreferences(ctx context.Context, input notification.PreferencesUpdate) (*notification.Preferences, error) { // nolint: lll
+	_, resp, err := r.UpdateNotificationPreferencesHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*notification.Preferences), nil
+}
+
+type notificationResolver struct{ *resolver }
+
+// nolint: gochecknoglobals
+var notificationKindsGraphQL = map[notification.Kind]graphql.NotificationKind{
+	notification.ItemCompleted: graphql.NotificationKindItemCompleted,
+	notification.ItemAssigned:  graphql.NotificationKindItemAssigned,
+	notification.Mentioned:     graphql.NotificationKindMentioned,
+	notification.ItemOverdue:   graphql.NotificationKindItemOverdue,
+}
+
+func (r *notificationResolver) Kind(_ context.Context, obj *notification.Notification) (graphql.NotificationKind, error) { // nolint: lll
+	return notificationKindsGraphQL[obj.Kind], nil
+}
+
+func (r *notificationResolver) CommentID(_ context.Context, obj *notification.Notification) (*string, error) {
+	if obj.CommentID == "" {
+		return nil, nil
+	}
+
+	return &obj.CommentID, nil
+}
+
+func (r *notificationResolver) Actor(_ context.Context, obj *notification.Notification) (*string, error) {
+	if obj.Actor == "" {
+		return nil, nil
+	}
+
+	return &obj.Actor, nil
+}
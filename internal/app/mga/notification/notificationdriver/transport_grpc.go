@@ -0,0 +1,231 @@
+package notificationdriver
+
+import (
+	"context"
+	"time"
+
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	appkitgrpc "github.com/sagikazarmark/appkit/transport/grpc"
+	kitxgrpc "github.com/sagikazarmark/kitx/transport/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/sagikazarmark/modern-go-application/api/notification/v1"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+)
+
+// MakeGRPCServer makes a set of endpoints available as a gRPC server.
+func MakeGRPCServer(endpoints Endpoints, options ...kitgrpc.ServerOption) api.NotificationServiceServer {
+	errorEncoder := kitxgrpc.NewStatusErrorResponseEncoder(appkitgrpc.NewDefaultStatusConverter())
+
+	return api.NotificationServiceKitServer{
+		ListNotificationsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListNotifications,
+			decodeListNotificationsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListNotificationsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CountUnreadHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.CountUnread,
+			decodeCountUnreadGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeCountUnreadGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MarkAsReadHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.MarkAsRead,
+			decodeMarkAsReadGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeMarkAsReadGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MarkAsUnreadHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.MarkAsUnread,
+			decodeMarkAsUnreadGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeMarkAsUnreadGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MarkAllAsReadHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.MarkAllAsRead,
+			decodeMarkAllAsReadGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeMarkAllAsReadGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetPreferencesHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.GetPreferences,
+			decodeGetPreferencesGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeGetPreferencesGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdatePreferencesHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.UpdatePreferences,
+			decodeUpdatePreferencesGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUpdatePreferencesGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+	}
+}
+
+func decodeListNotificationsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListNotificationsRequest)
+
+	return ListNotificationsRequest{
+		Filter: notification.Filter{
+			UnreadOnly: req.GetUnreadOnly(),
+			Limit:      int(req.GetLimit()),
+		},
+	}, nil
+}
+
+func encodeListNotificationsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListNotificationsResponse)
+
+	grpcResp := &api.ListNotificationsResponse{
+		Notifications: make([]*api.Notification, 0, len(resp.Notifications)),
+	}
+
+	for _, n := range resp.Notifications {
+		grpcResp.Notifications = append(grpcResp.Notifications, marshalNotificationGRPC(n))
+	}
+
+	return grpcResp, nil
+}
+
+func decodeCountUnreadGRPCRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return CountUnreadRequest{}, nil
+}
+
+func encodeCountUnreadGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(CountUnreadResponse)
+
+	return &api.CountUnreadResponse{
+		Count: int32(resp.Count),
+	}, nil
+}
+
+func decodeMarkAsReadGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.MarkAsReadRequest)
+
+	return MarkAsReadRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeMarkAsReadGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(MarkAsReadResponse)
+
+	return &api.MarkAsReadResponse{
+		Notification: marshalNotificationGRPC(resp.Notification),
+	}, nil
+}
+
+func decodeMarkAsUnreadGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.MarkAsUnreadRequest)
+
+	return MarkAsUnreadRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeMarkAsUnreadGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(MarkAsUnreadResponse)
+
+	return &api.MarkAsUnreadResponse{
+		Notification: marshalNotificationGRPC(resp.Notification),
+	}, nil
+}
+
+func decodeMarkAllAsReadGRPCRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return MarkAllAsReadRequest{}, nil
+}
+
+func encodeMarkAllAsReadGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(MarkAllAsReadResponse)
+
+	return &api.MarkAllAsReadResponse{
+		Count: int32(resp.Count),
+	}, nil
+}
+
+func decodeGetPreferencesGRPCRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return GetPreferencesRequest{}, nil
+}
+
+func encodeGetPreferencesGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetPreferencesResponse)
+
+	return &api.GetPreferencesResponse{
+		Preferences: marshalPreferencesGRPC(resp.Preferences),
+	}, nil
+}
+
+func decodeUpdatePreferencesGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UpdatePreferencesRequest)
+
+	var update notification.PreferencesUpdate
+
+	if req.ItemCompleted != nil {
+		update.ItemCompleted = &req.ItemCompleted.Value
+	}
+
+	if req.ItemAssigned != nil {
+		update.ItemAssigned = &req.ItemAssigned.Value
+	}
+
+	if req.Mentioned != nil {
+		update.Mentioned = &req.Mentioned.Value
+	}
+
+	if req.ItemOverdue != nil {
+		update.ItemOverdue = &req.ItemOverdue.Value
+	}
+
+	return UpdatePreferencesRequest{
+		Update: update,
+	}, nil
+}
+
+func encodeUpdatePreferencesGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UpdatePreferencesResponse)
+
+	return &api.UpdatePreferencesResponse{
+		Preferences: marshalPreferencesGRPC(resp.Preferences),
+	}, nil
+}
+
+// nolint: gochecknoglobals
+var notificationKindsGRPC = map[notification.Kind]api.NotificationKind{
+	notification.ItemCompleted: api.NotificationKind_NOTIFICATION_KIND_ITEM_COMPLETED,
+	notification.ItemAssigned:  api.NotificationKind_NOTIFICATION_KIND_ITEM_ASSIGNED,
+	notification.Mentioned:     api.NotificationKind_NOTIFICATION_KIND_MENTIONED,
+	notification.ItemOverdue:   api.NotificationKind_NOTIFICATION_KIND_ITEM_OVERDUE,
+}
+
+func marshalNotificationGRPC(n notification.Notification) *api.Notification {
+	return &api.Notification{
+		Id:        n.ID,
+		Kind:      notificationKindsGRPC[n.Kind],
+		ItemId:    n.ItemID,
+		ListId:    n.ListID,
+		CommentId: n.CommentID,
+		Title:     n.Title,
+		Actor:     n.Actor,
+		Read:      n.Read,
+		CreatedAt: timestamppb.New(n.CreatedAt),
+		ReadAt:    marshalTimestampGRPC(n.ReadAt),
+	}
+}
+
+func marshalPreferencesGRPC(preferences notification.Preferences) *api.Preferences {
+	return &api.Preferences{
+		ItemCompleted: preferences.ItemCompleted,
+		ItemAssigned:  preferences.ItemAssigned,
+		Mentioned:     preferences.Mentioned,
+		ItemOverdue:   preferences.ItemOverdue,
+	}
+}
+
+func marshalTimestampGRPC(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
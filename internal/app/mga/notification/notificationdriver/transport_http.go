@@ -0,0 +1,321 @@
+package notificationdriver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"emperror.dev/errors"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	appkithttp "github.com/sagikazarmark/appkit/transport/http"
+	kitxhttp "github.com/sagikazarmark/kitx/transport/http"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+)
+
+// streamKeepAlive is the interval of comments sent to keep idle notification streams open.
+const streamKeepAlive = 30 * time.Second
+
+// RegisterHTTPHandlers mounts all of the service endpoints into a router.
+func RegisterHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodGet).Path("").Handler(kithttp.NewServer(
+		endpoints.ListNotifications,
+		decodeListNotificationsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListNotificationsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/unread-count").Handler(kithttp.NewServer(
+		endpoints.CountUnread,
+		kithttp.NopRequestDecoder,
+		kitxhttp.ErrorResponseEncoder(encodeCountUnreadHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/read").Handler(kithttp.NewServer(
+		endpoints.MarkAllAsRead,
+		kithttp.NopRequestDecoder,
+		kitxhttp.ErrorResponseEncoder(encodeMarkAllAsReadHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/preferences").Handler(kithttp.NewServer(
+		endpoints.GetPreferences,
+		kithttp.NopRequestDecoder,
+		kitxhttp.ErrorResponseEncoder(encodeGetPreferencesHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPatch).Path("/preferences").Handler(kithttp.NewServer(
+		endpoints.UpdatePreferences,
+		decodeUpdatePreferencesHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUpdatePreferencesHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/read").Handler(kithttp.NewServer(
+		endpoints.MarkAsRead,
+		decodeMarkAsReadHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeMarkAsReadHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/unread").Handler(kithttp.NewServer(
+		endpoints.MarkAsUnread,
+		decodeMarkAsUnreadHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeMarkAsUnreadHTTPResponse, errorEncoder),
+		options...,
+	))
+}
+
+// MakeStreamHTTPHandler returns a handler streaming the new notifications of the user as server-sent events.
+// The handler expects the user in the request context.
+func MakeStreamHTTPHandler(subscriber notification.Subscriber) http.Handler {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			_ = errorEncoder(ctx, w, errors.New("streaming is not supported"))
+
+			return
+		}
+
+		notifications, err := subscriber.Subscribe(ctx)
+		if err != nil {
+			_ = errorEncoder(ctx, w, err)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		ticker := time.NewTicker(streamKeepAlive)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case n, ok := <-notifications:
+				if !ok {
+					return
+				}
+
+				data, err := json.Marshal(marshalNotificationHTTP(n))
+				if err != nil {
+					return
+				}
+
+				_, err = fmt.Fprintf(w, "event: notification\nid: %s\ndata: %s\n\n", n.ID, data)
+				if err != nil {
+					return
+				}
+
+			case <-ticker.C:
+				_, err := fmt.Fprint(w, ": keep-alive\n\n")
+				if err != nil {
+					return
+				}
+			}
+
+			flusher.Flush()
+		}
+	})
+}
+
+// notificationHTTP is the HTTP representation of a notification.
+type notificationHTTP struct {
+	ID        string     `json:"id"`
+	Kind      string     `json:"kind"`
+	ItemID    string     `json:"itemId"`
+	ListID    string     `json:"listId,omitempty"`
+	CommentID string     `json:"commentId,omitempty"`
+	Title     string     `json:"title"`
+	Actor     string     `json:"actor,omitempty"`
+	Read      bool       `json:"read"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ReadAt    *time.Time `json:"readAt,omitempty"`
+}
+
+// preferencesHTTP is the HTTP representation of notification preferences.
+type preferencesHTTP struct {
+	ItemCompleted bool `json:"itemCompleted"`
+	ItemAssigned  bool `json:"itemAssigned"`
+	Mentioned     bool `json:"mentioned"`
+	ItemOverdue   bool `json:"itemOverdue"`
+}
+
+// updatePreferencesHTTPRequest is the HTTP representation of a preferences update (omitted fields are left unchanged).
+type updatePreferencesHTTPRequest struct {
+	ItemCompleted *bool `json:"itemCompleted"`
+	ItemAssigned  *bool `json:"itemAssigned"`
+	Mentioned     *bool `json:"mentioned"`
+	ItemOverdue   *bool `json:"itemOverdue"`
+}
+
+// countHTTP is the HTTP representation of a number of notifications.
+type countHTTP struct {
+	Count int `json:"count"`
+}
+
+func decodeListNotificationsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	query := r.URL.Query()
+
+	var filter notification.Filter
+
+	if v := query.Get("unread"); v != "" {
+		unread, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "unread")
+		}
+
+		filter.UnreadOnly = unread
+	}
+
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "limit")
+		}
+
+		filter.Limit = limit
+	}
+
+	return ListNotificationsRequest{
+		Filter: filter,
+	}, nil
+}
+
+func encodeListNotificationsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListNotificationsResponse)
+
+	notifications := make([]notificationHTTP, 0, len(resp.Notifications))
+
+	for _, n := range resp.Notifications {
+		notifications = append(notifications, marshalNotificationHTTP(n))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, notifications)
+}
+
+func encodeCountUnreadHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(CountUnreadResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, countHTTP{Count: resp.Count})
+}
+
+func encodeMarkAllAsReadHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(MarkAllAsReadResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, countHTTP{Count: resp.Count})
+}
+
+func decodeMarkAsReadHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarkAsReadRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeMarkAsReadHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(MarkAsReadResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalNotificationHTTP(resp.Notification))
+}
+
+func decodeMarkAsUnreadHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarkAsUnreadRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeMarkAsUnreadHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(MarkAsUnreadResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalNotificationHTTP(resp.Notification))
+}
+
+func encodeGetPreferencesHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(GetPreferencesResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalPreferencesHTTP(resp.Preferences))
+}
+
+func decodeUpdatePreferencesHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest updatePreferencesHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return UpdatePreferencesRequest{
+		Update: notification.PreferencesUpdate{
+			ItemCompleted: apiRequest.ItemCompleted,
+			ItemAssigned:  apiRequest.ItemAssigned,
+			Mentioned:     apiRequest.Mentioned,
+			ItemOverdue:   apiRequest.ItemOverdue,
+		},
+	}, nil
+}
+
+func encodeUpdatePreferencesHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UpdatePreferencesResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalPreferencesHTTP(resp.Preferences))
+}
+
+func marshalNotificationHTTP(n notification.Notification) notificationHTTP {
+	return notificationHTTP{
+		ID:        n.ID,
+		Kind:      string(n.Kind),
+		ItemID:    n.ItemID,
+		ListID:    n.ListID,
+		CommentID: n.CommentID,
+		Title:     n.Title,
+		Actor:     n.Actor,
+		Read:      n.Read,
+		CreatedAt: n.CreatedAt,
+		ReadAt:    n.ReadAt,
+	}
+}
+
+func marshalPreferencesHTTP(preferences notification.Preferences) preferencesHTTP {
+	return preferencesHTTP{
+		ItemCompleted: preferences.ItemCompleted,
+		ItemAssigned:  preferences.ItemAssigned,
+		Mentioned:     preferences.Mentioned,
+		ItemOverdue:   preferences.ItemOverdue,
+	}
+}
+
+func getIDParamFromRequest(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+
+	id, ok := vars["id"]
+	if !ok || id == "" {
+		return "", errors.NewWithDetails("missing parameter from the URL", "param", "id")
+	}
+
+	return id, nil
+}
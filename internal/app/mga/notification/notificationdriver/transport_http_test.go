@@ -0,0 +1,47 @@
+package notificationdriver_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/notification/notificationdriver"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func TestMakeStreamHTTPHandler_Shutdown(t *testing.T) {
+	broker := notification.NewBroker()
+
+	server := httptest.NewUnstartedServer(principal.HTTPHandler(MakeStreamHTTPHandler(broker)))
+	server.Config.RegisterOnShutdown(broker.Close)
+	server.Start()
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("x-user-id", "jane")
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	broker.Publish(notification.Notification{ID: "1", User: "jane"})
+
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event: notification\n", line, "the stream is open")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	assert.NoError(t, server.Config.Shutdown(ctx), "open streams do not block shutting down")
+}
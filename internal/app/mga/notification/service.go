@@ -0,0 +1,392 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+const (
+	// defaultLimit is the number of notifications listed when the filter has no limit.
+	defaultLimit = 50
+
+	// maxLimit is the maximum number of notifications listed at once.
+	maxLimit = 200
+)
+
+// Kind is the type of event a notification is about.
+type Kind string
+
+// Notification kinds.
+const (
+	// ItemCompleted notifies the assignee of an item about someone else completing it.
+	ItemCompleted Kind = "itemCompleted"
+
+	// ItemAssigned notifies a user about someone else assigning an item to them.
+	ItemAssigned Kind = "itemAssigned"
+
+	// Mentioned notifies a user about someone else mentioning them in a comment.
+	Mentioned Kind = "mentioned"
+
+	// ItemOverdue notifies the assignee of an item about the item being past its due date.
+	ItemOverdue Kind = "itemOverdue"
+)
+
+// Notification tells a user about something that happened to a todo item.
+type Notification struct {
+	ID string
+
+	// User is the recipient of the notification.
+	User string
+
+	Kind   Kind
+	ItemID string
+	ListID string
+
+	// CommentID is the comment a user is mentioned in.
+	CommentID string
+
+	// Title is the title of the item at the time of the event.
+	Title string
+
+	// Actor is the user who caused the notification (empty for eg. overdue items).
+	Actor string
+
+	Read      bool
+	CreatedAt time.Time
+	ReadAt    *time.Time
+}
+
+// Filter filters the notifications of a user.
+type Filter struct {
+	// UnreadOnly lists unread notifications only.
+	UnreadOnly bool
+
+	// Limit is the maximum number of notifications listed (defaults to 50, at most 200).
+	Limit int
+}
+
+// Preferences tell which kinds of notifications a user receives.
+type Preferences struct {
+	ItemCompleted bool
+	ItemAssigned  bool
+	Mentioned     bool
+	ItemOverdue   bool
+}
+
+// DefaultPreferences returns the preferences of users who haven't changed them: every notification is enabled.
+func DefaultPreferences() Preferences {
+	return Preferences{
+		ItemCompleted: true,
+		ItemAssigned:  true,
+		Mentioned:     true,
+		ItemOverdue:   true,
+	}
+}
+
+// Enabled tells whether notifications of a kind are enabled.
+func (p Preferences) Enabled(kind Kind) bool {
+	switch kind {
+	case ItemCompleted:
+		return p.ItemCompleted
+	case ItemAssigned:
+		return p.ItemAssigned
+	case Mentioned:
+		return p.Mentioned
+	case ItemOverdue:
+		return p.ItemOverdue
+	}
+
+	return false
+}
+
+// PreferencesUpdate contains updates of the preferences of a user (nil fields are left unchanged).
+type PreferencesUpdate struct {
+	ItemCompleted *bool
+	ItemAssigned  *bool
+	Mentioned     *bool
+	ItemOverdue   *bool
+}
+
+func (u PreferencesUpdate) update(preferences Preferences) Preferences {
+	if u.ItemCompleted != nil {
+		preferences.ItemCompleted = *u.ItemCompleted
+	}
+
+	if u.ItemAssigned != nil {
+		preferences.ItemAssigned = *u.ItemAssigned
+	}
+
+	if u.Mentioned != nil {
+		preferences.Mentioned = *u.Mentioned
+	}
+
+	if u.ItemOverdue != nil {
+		preferences.ItemOverdue = *u.ItemOverdue
+	}
+
+	return preferences
+}
+
+// +kit:endpoint:errorStrategy=service
+
+// Service manages the notifications of the user making the request.
+type Service interface {
+	// ListNotifications returns the notifications of the user, newest first.
+	ListNotifications(ctx context.Context, filter Filter) (notifications []Notification, err error)
+
+	// CountUnread returns the number of unread notifications of the user.
+	CountUnread(ctx context.Context) (count int, err error)
+
+	// MarkAsRead marks a notification as read.
+	MarkAsRead(ctx context.Context, id string) (notification Notification, err error)
+
+	// MarkAsUnread marks a notification as unread.
+	MarkAsUnread(ctx context.Context, id string) (notification Notification, err error)
+
+	// MarkAllAsRead marks every notification of the user as read and returns the number of notifications marked.
+	MarkAllAsRead(ctx context.Context) (count int, err error)
+
+	// GetPreferences returns the notification preferences of the user.
+	GetPreferences(ctx context.Context) (preferences Preferences, err error)
+
+	// UpdatePreferences updates the notification preferences of the user.
+	UpdatePreferences(ctx context.Context, update PreferencesUpdate) (preferences Preferences, err error)
+}
+
+// NewService returns a new Service.
+func NewService(store Store, preferenceStore PreferenceStore) Service {
+	return service{
+		store:           store,
+		preferenceStore: preferenceStore,
+	}
+}
+
+type service struct {
+	store           Store
+	preferenceStore PreferenceStore
+}
+
+// IDGenerator generates a new ID.
+type IDGenerator interface {
+	// Generate generates a new ID.
+	Generate() (string, error)
+}
+
+// Store persists notifications.
+type Store interface {
+	// StoreNotification stores a new notification or replaces an existing one.
+	StoreNotification(ctx context.Context, notification Notification) error
+
+	// GetNotifications returns the notifications of a user matching a filter, newest first.
+	GetNotifications(ctx context.Context, user string, filter Filter) ([]Notification, error)
+
+	// GetNotification returns a notification of a user.
+	// A NotFoundError is returned if the notification does not exist or belongs to someone else.
+	GetNotification(ctx context.Context, user string, id string) (Notification, error)
+
+	// CountUnread returns the number of unread notifications of a user.
+	CountUnread(ctx context.Context, user string) (int, error)
+
+	// MarkAllAsRead marks the unread notifications of a user as read and returns their number.
+	MarkAllAsRead(ctx context.Context, user string, readAt time.Time) (int, error)
+}
+
+// PreferenceStore persists notification preferences.
+type PreferenceStore interface {
+	// GetPreferences returns the preferences of a user (false if the user hasn't changed them).
+	GetPreferences(ctx context.Context, user string) (Preferences, bool, error)
+
+	// StorePreferences stores the preferences of a user.
+	StorePreferences(ctx context.Context, user string, preferences Preferences) error
+}
+
+// NotFoundError is returned if a notification cannot be found.
+type NotFoundError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (NotFoundError) Error() string {
+	return "notification not found"
+}
+
+// Details returns error details.
+func (e NotFoundError) Details() []interface{} {
+	return []interface{}{"notification_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (NotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (NotFoundError) ServiceError() bool {
+	return true
+}
+
+type validationError struct {
+	violations map[string][]string
+}
+
+func (validationError) Error() string {
+	return "invalid request"
+}
+
+func (e validationError) Violations() map[string][]string {
+	return e.violations
+}
+
+// Validation tells a client that this error is related to a resource being invalid.
+// Can be used to translate the error to eg. status code.
+func (validationError) Validation() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (validationError) ServiceError() bool {
+	return true
+}
+
+// currentUser returns the user making the request: notifications are personal.
+func currentUser(ctx context.Context) (string, error) {
+	user, ok := principal.FromContext(ctx)
+	if !ok {
+		return "", errors.WithStack(validationError{violations: map[string][]string{
+			"user": {
+				"notifications require an authenticated user",
+			},
+		}})
+	}
+
+	return user, nil
+}
+
+func (s service) ListNotifications(ctx context.Context, filter Filter) ([]Notification, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Limit < 0 || filter.Limit > maxLimit {
+		return nil, errors.WithStack(validationError{violations: map[string][]string{
+			"limit": {
+				"limit must be between 1 and 200",
+			},
+		}})
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultLimit
+	}
+
+	return s.store.GetNotifications(ctx, user, filter)
+}
+
+func (s service) CountUnread(ctx context.Context) (int, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.store.CountUnread(ctx, user)
+}
+
+func (s service) MarkAsRead(ctx context.Context, id string) (Notification, error) {
+	return s.mark(ctx, id, true)
+}
+
+func (s service) MarkAsUnread(ctx context.Context, id string) (Notification, error) {
+	return s.mark(ctx, id, false)
+}
+
+func (s service) mark(ctx context.Context, id string, read bool) (Notification, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	notification, err := s.store.GetNotification(ctx, user, id)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	if notification.Read == read {
+		return notification, nil
+	}
+
+	notification.Read = read
+	notification.ReadAt = nil
+
+	if read {
+		now := time.Now()
+		notification.ReadAt = &now
+	}
+
+	err = s.store.StoreNotification(ctx, notification)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	return notification, nil
+}
+
+func (s service) MarkAllAsRead(ctx context.Context) (int, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.store.MarkAllAsRead(ctx, user, time.Now())
+}
+
+func (s service) GetPreferences(ctx context.Context) (Preferences, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return Preferences{}, err
+	}
+
+	return getPreferences(ctx, s.preferenceStore, user)
+}
+
+func (s service) UpdatePreferences(ctx context.Context, update PreferencesUpdate) (Preferences, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return Preferences{}, err
+	}
+
+	preferences, err := getPreferences(ctx, s.preferenceStore, user)
+	if err != nil {
+		return Preferences{}, err
+	}
+
+	preferences = update.update(preferences)
+
+	err = s.preferenceStore.StorePreferences(ctx, user, preferences)
+	if err != nil {
+		return Preferences{}, err
+	}
+
+	return preferences, nil
+}
+
+// getPreferences returns the preferences of a user falling back to the defaults.
+func getPreferences(ctx context.Context, store PreferenceStore, user string) (Preferences, error) {
+	preferences, ok, err := store.GetPreferences(ctx, user)
+	if err != nil {
+		return Preferences{}, err
+	}
+
+	if !ok {
+		return DefaultPreferences(), nil
+	}
+
+	return preferences, nil
+}
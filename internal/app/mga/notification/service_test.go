@@ -0,0 +1,127 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/notification"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func TestService_Notifications(t *testing.T) {
+	store := NewInMemoryStore()
+	service := NewService(store, store)
+
+	now := time.Now()
+
+	for _, notification := range []Notification{
+		{ID: "1", User: "jane", Kind: ItemAssigned, ItemID: "10", Title: "Mow the lawn", Actor: "john", CreatedAt: now.Add(-2 * time.Minute)},
+		{ID: "2", User: "jane", Kind: Mentioned, ItemID: "10", Title: "Mow the lawn", Actor: "john", CreatedAt: now.Add(-time.Minute)},
+		{ID: "3", User: "jane", Kind: ItemOverdue, ItemID: "11", Title: "Buy milk", CreatedAt: now},
+		{ID: "4", User: "john", Kind: ItemCompleted, ItemID: "12", Title: "Wash the car", Actor: "jane", CreatedAt: now},
+	} {
+		require.NoError(t, store.StoreNotification(context.Background(), notification))
+	}
+
+	ctx := principal.ToContext(context.Background(), "jane")
+
+	notifications, err := service.ListNotifications(ctx, Filter{})
+	require.NoError(t, err)
+	require.Len(t, notifications, 3)
+	assert.Equal(t, "3", notifications[0].ID)
+	assert.Equal(t, "1", notifications[2].ID)
+
+	notifications, err = service.ListNotifications(ctx, Filter{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, notifications, 2)
+
+	count, err := service.CountUnread(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	notification, err := service.MarkAsRead(ctx, "2")
+	require.NoError(t, err)
+	assert.True(t, notification.Read)
+	assert.NotNil(t, notification.ReadAt)
+
+	notifications, err = service.ListNotifications(ctx, Filter{UnreadOnly: true})
+	require.NoError(t, err)
+	require.Len(t, notifications, 2)
+	assert.Equal(t, "3", notifications[0].ID)
+	assert.Equal(t, "1", notifications[1].ID)
+
+	notification, err = service.MarkAsUnread(ctx, "2")
+	require.NoError(t, err)
+	assert.False(t, notification.Read)
+	assert.Nil(t, notification.ReadAt)
+
+	count, err = service.MarkAllAsRead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	count, err = service.CountUnread(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// Notifications of other users are left alone
+	count, err = service.CountUnread(principal.ToContext(context.Background(), "john"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = service.MarkAsRead(ctx, "4")
+	assert.True(t, errors.As(err, &NotFoundError{}))
+}
+
+func TestService_ListNotifications_InvalidLimit(t *testing.T) {
+	store := NewInMemoryStore()
+	service := NewService(store, store)
+
+	_, err := service.ListNotifications(principal.ToContext(context.Background(), "jane"), Filter{Limit: 201})
+	require.Error(t, err)
+
+	var verr interface{ Validation() bool }
+	assert.True(t, errors.As(err, &verr))
+}
+
+func TestService_RequiresUser(t *testing.T) {
+	store := NewInMemoryStore()
+	service := NewService(store, store)
+
+	_, err := service.CountUnread(context.Background())
+	require.Error(t, err)
+
+	var verr interface{ Validation() bool }
+	assert.True(t, errors.As(err, &verr))
+}
+
+func TestService_Preferences(t *testing.T) {
+	store := NewInMemoryStore()
+	service := NewService(store, store)
+
+	ctx := principal.ToContext(context.Background(), "jane")
+
+	preferences, err := service.GetPreferences(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPreferences(), preferences)
+
+	disabled := false
+
+	preferences, err = service.UpdatePreferences(ctx, PreferencesUpdate{Mentioned: &disabled})
+	require.NoError(t, err)
+	assert.False(t, preferences.Mentioned)
+	assert.True(t, preferences.ItemAssigned)
+	assert.False(t, preferences.Enabled(Mentioned))
+
+	preferences, err = service.GetPreferences(ctx)
+	require.NoError(t, err)
+	assert.False(t, preferences.Mentioned)
+
+	preferences, err = service.GetPreferences(principal.ToContext(context.Background(), "john"))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPreferences(), preferences)
+}
@@ -0,0 +1,136 @@
+package notification
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InMemoryStore keeps notifications in the memory.
+// Use it in tests or for development/demo purposes.
+type InMemoryStore struct {
+	notifications map[string]Notification
+	preferences   map[string]Preferences
+	mu            sync.RWMutex
+}
+
+// NewInMemoryStore returns a new in-memory notification store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		notifications: make(map[string]Notification),
+		preferences:   make(map[string]Preferences),
+	}
+}
+
+// StoreNotification stores a new notification or replaces an existing one.
+func (s *InMemoryStore) StoreNotification(_ context.Context, notification Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.notifications[notification.ID] = notification
+
+	return nil
+}
+
+// GetNotifications returns the notifications of a user matching a filter, newest first.
+func (s *InMemoryStore) GetNotifications(_ context.Context, user string, filter Filter) ([]Notification, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	notifications := make([]Notification, 0)
+
+	for _, notification := range s.notifications {
+		if notification.User != user || (filter.UnreadOnly && notification.Read) {
+			continue
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	sort.Slice(notifications, func(i, j int) bool {
+		if !notifications[i].CreatedAt.Equal(notifications[j].CreatedAt) {
+			return notifications[i].CreatedAt.After(notifications[j].CreatedAt)
+		}
+
+		return notifications[i].ID > notifications[j].ID
+	})
+
+	if filter.Limit > 0 && len(notifications) > filter.Limit {
+		notifications = notifications[:filter.Limit]
+	}
+
+	return notifications, nil
+}
+
+// GetNotification returns a notification of a user.
+func (s *InMemoryStore) GetNotification(_ context.Context, user string, id string) (Notification, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	notification, ok := s.notifications[id]
+	if !ok || notification.User != user {
+		return Notification{}, NotFoundError{ID: id}
+	}
+
+	return notification, nil
+}
+
+// CountUnread returns the number of unread notifications of a user.
+func (s *InMemoryStore) CountUnread(_ context.Context, user string) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var count int
+
+	for _, notification := range s.notifications {
+		if notification.User == user && !notification.Read {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// MarkAllAsRead marks the unread notifications of a user as read and returns their number.
+func (s *InMemoryStore) MarkAllAsRead(_ context.Context, user string, readAt time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var count int
+
+	for id, notification := range s.notifications {
+		if notification.User != user || notification.Read {
+			continue
+		}
+
+		notification.Read = true
+		notification.ReadAt = &readAt
+
+		s.notifications[id] = notification
+
+		count++
+	}
+
+	return count, nil
+}
+
+// GetPreferences returns the preferences of a user (false if the user hasn't changed them).
+func (s *InMemoryStore) GetPreferences(_ context.Context, user string) (Preferences, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	preferences, ok := s.preferences[user]
+
+	return preferences, ok, nil
+}
+
+// StorePreferences stores the preferences of a user.
+func (s *InMemoryStore) StorePreferences(_ context.Context, user string, preferences Preferences) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.preferences[user] = preferences
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package todo
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func (s service) AssignItem(ctx context.Context, id string, assignee string) (Item, error) {
+	assignee = strings.TrimSpace(assignee)
+
+	if assignee == "" {
+		return Item{}, errors.WithStack(validationError{violations: map[string][]string{
+			"assignee": {
+				"assignee cannot be empty",
+			},
+		}})
+	}
+
+	return s.assignItem(ctx, id, assignee)
+}
+
+func (s service) UnassignItem(ctx context.Context, id string) (Item, error) {
+	return s.assignItem(ctx, id, "")
+}
+
+func (s service) assignItem(ctx context.Context, id string, assignee string) (Item, error) {
+	item, err := s.store.GetOne(ctx, id)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "assign item")
+	}
+
+	if item.Assignee == assignee {
+		return item, nil
+	}
+
+	item.Assignee = assignee
+
+	err = s.store.Store(ctx, item)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "assign item")
+	}
+
+	return item, nil
+}
+
+// mineFilter resolves listing the items of the current user to their assignee.
+func mineFilter(ctx context.Context, filter ItemFilter) (ItemFilter, error) {
+	if !filter.Mine {
+		return filter, nil
+	}
+
+	user, err := currentUser(ctx, "assignee", filter.Assignee)
+	if err != nil {
+		return filter, err
+	}
+
+	filter.Assignee = user
+	filter.Mine = false
+
+	return filter, nil
+}
+
+// currentUser returns the user a filter for the data of the current user (mine) resolves to.
+// The field set to another user conflicts with the filter.
+func currentUser(ctx context.Context, field string, value string) (string, error) {
+	user, ok := principal.FromContext(ctx)
+	if !ok {
+		return "", errors.WithStack(validationError{violations: map[string][]string{
+			"mine": {
+				"mine requires an authenticated user",
+			},
+		}})
+	}
+
+	if value != "" && value != user {
+		return "", errors.WithStack(validationError{violations: map[string][]string{
+			field: {
+				field + " cannot be set together with mine",
+			},
+		}})
+	}
+
+	return user, nil
+}
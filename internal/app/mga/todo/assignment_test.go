@@ -0,0 +1,88 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func TestService_AssignItem(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Mow the lawn", Assignee: " jane "})
+	require.NoError(t, err)
+	assert.Equal(t, "jane", item.Assignee)
+
+	item, err = service.AssignItem(ctx, item.ID, "john")
+	require.NoError(t, err)
+	assert.Equal(t, "john", item.Assignee)
+
+	// Assigning an item to its assignee again is a no-op
+	_, err = service.AssignItem(ctx, item.ID, "john")
+	require.NoError(t, err)
+
+	require.Len(t, events.assigned, 2)
+	assert.Equal(t, "jane", events.assigned[0].Assignee)
+	assert.Empty(t, events.assigned[0].PreviousAssignee)
+	assert.Equal(t, "john", events.assigned[1].Assignee)
+	assert.Equal(t, "jane", events.assigned[1].PreviousAssignee)
+	assert.Equal(t, "john", events.assigned[1].AssignedBy)
+
+	item, err = service.UnassignItem(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Empty(t, item.Assignee)
+	assert.Len(t, events.assigned, 2, "unassigning an item is not an assignment")
+
+	_, err = service.AssignItem(ctx, item.ID, " ")
+	assert.True(t, isValidationError(err))
+
+	history, err := service.ItemHistory(ctx, item.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+	assert.Equal(t, "AssignItem", history[1].Operation)
+	assert.Equal(t, []FieldChange{{Field: "assignee", Before: "jane", After: "john"}}, history[1].Changes)
+	assert.Empty(t, history[2].Changes)
+	assert.Equal(t, "UnassignItem", history[3].Operation)
+
+	_, err = service.Undo(ctx, 1)
+	require.NoError(t, err)
+
+	item, err = service.GetItem(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "john", item.Assignee, "unassigning an item can be undone")
+}
+
+func TestService_ListItems_Assignee(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _ := newTestService(testServiceConfig{})
+
+	mine, err := service.AddItem(ctx, NewItem{Title: "Mow the lawn", Assignee: "john"})
+	require.NoError(t, err)
+
+	theirs, err := service.AddItem(ctx, NewItem{Title: "Buy milk", Assignee: "jane"})
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{Title: "Clean the house"})
+	require.NoError(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{Mine: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{mine.ID}, itemIDs(items))
+
+	items, err = service.ListItems(ctx, ItemFilter{Assignee: "jane"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{theirs.ID}, itemIDs(items))
+
+	_, err = service.ListItems(ctx, ItemFilter{Mine: true, Assignee: "jane"})
+	assert.True(t, isValidationError(err), "mine conflicts with the items of other users")
+
+	_, err = service.ListItems(context.Background(), ItemFilter{Mine: true})
+	assert.True(t, isValidationError(err), "mine requires a user")
+}
@@ -0,0 +1,571 @@
+package todo
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// Attachment defaults.
+const (
+	// DefaultAttachmentMaxSize is the default size limit of attachments.
+	DefaultAttachmentMaxSize = 10 << 20
+
+	// DefaultAttachmentLinkExpiry is how long download links are valid by default.
+	DefaultAttachmentLinkExpiry = 15 * time.Minute
+
+	maxAttachmentNameLength = 255
+)
+
+// Attachment is a file attached to an item.
+type Attachment struct {
+	ID     string
+	ItemID string
+
+	// Name is the file name of the attachment.
+	Name string
+
+	ContentType string
+
+	// Size is the length of the content in bytes.
+	Size int64
+
+	// Checksum is the hex encoded SHA-256 hash of the content.
+	Checksum string
+
+	// Uploader is the user who attached the file (empty for anonymous users).
+	Uploader string
+
+	CreatedAt time.Time
+
+	// Link is a signed download link of the attachment.
+	// It's set by the service: storing the attachment ignores it.
+	Link AttachmentLink
+}
+
+// AttachmentLink authorizes downloading an attachment until it expires.
+type AttachmentLink struct {
+	ExpiresAt time.Time
+
+	// Signature is the hex encoded HMAC of the attachment ID and the expiry.
+	Signature string
+}
+
+// NewAttachment contains the details of a new Attachment.
+type NewAttachment struct {
+	Name string
+
+	// ContentType is detected from the content if it's empty or application/octet-stream.
+	ContentType string
+
+	// Size is the length of the content (if it's known upfront).
+	Size int64
+
+	// Checksum is the expected hex encoded SHA-256 hash of the content (optional).
+	Checksum string
+
+	// Content is read once while the attachment is stored.
+	Content io.Reader
+}
+
+// AttachmentDownload is the content of an attachment.
+type AttachmentDownload struct {
+	Attachment Attachment
+
+	// Content must be closed by the caller.
+	Content io.ReadCloser
+}
+
+// AttachmentStore persists the details of attachments.
+type AttachmentStore interface {
+	// StoreAttachment stores the details of an attachment.
+	StoreAttachment(ctx context.Context, attachment Attachment) error
+
+	// GetAttachments returns the attachments of an item, oldest first.
+	GetAttachments(ctx context.Context, itemID string) ([]Attachment, error)
+
+	// GetOneAttachment returns a single attachment by its ID.
+	GetOneAttachment(ctx context.Context, id string) (Attachment, error)
+
+	// DeleteOneAttachment deletes a single attachment by its ID.
+	DeleteOneAttachment(ctx context.Context, id string) error
+
+	// GetOrphanAttachments returns the attachments of permanently deleted items.
+	GetOrphanAttachments(ctx context.Context) ([]Attachment, error)
+}
+
+// BlobStore stores the content of attachments.
+type BlobStore interface {
+	// Put stores an object. Size is the length of the content or -1 if it's unknown.
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+
+	// Get returns the content of an object. The caller must close the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete deletes an object. Deleting an object that does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// AttachmentConfig configures attachments.
+type AttachmentConfig struct {
+	// Blobs stores the content of attachments. Attachments are disabled without a blob store.
+	Blobs BlobStore
+
+	// MaxSize limits the size of attachments. Defaults to DefaultAttachmentMaxSize.
+	MaxSize int64
+
+	// ContentTypes are the accepted content types (eg. image/png or image/*). Any type is accepted if it's empty.
+	ContentTypes []string
+
+	// LinkExpiry is how long download links are valid. Defaults to DefaultAttachmentLinkExpiry.
+	LinkExpiry time.Duration
+
+	// SigningKey signs download links. It's required with a blob store.
+	SigningKey []byte
+}
+
+func (c AttachmentConfig) withDefaults() AttachmentConfig {
+	if c.MaxSize <= 0 {
+		c.MaxSize = DefaultAttachmentMaxSize
+	}
+
+	if c.LinkExpiry <= 0 {
+		c.LinkExpiry = DefaultAttachmentLinkExpiry
+	}
+
+	return c
+}
+
+// allowContentType tells whether a content type is accepted.
+func (c AttachmentConfig) allowContentType(contentType string) bool {
+	if len(c.ContentTypes) == 0 {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, allowed := range c.ContentTypes {
+		allowed = strings.ToLower(allowed)
+
+		if allowed == mediaType || allowed == "*/*" {
+			return true
+		}
+
+		if strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AttachmentNotFoundError is returned if an attachment cannot be found.
+type AttachmentNotFoundError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (AttachmentNotFoundError) Error() string {
+	return "attachment not found"
+}
+
+// Details returns error details.
+func (e AttachmentNotFoundError) Details() []interface{} {
+	return []interface{}{"attachment_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (AttachmentNotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (AttachmentNotFoundError) ServiceError() bool {
+	return true
+}
+
+// AttachmentLinkError is returned if a download link is invalid or expired.
+// It's reported as a missing resource, so links do not reveal whether an attachment exists.
+type AttachmentLinkError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (AttachmentLinkError) Error() string {
+	return "download link is invalid or expired"
+}
+
+// Details returns error details.
+func (e AttachmentLinkError) Details() []interface{} {
+	return []interface{}{"attachment_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (AttachmentLinkError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (AttachmentLinkError) ServiceError() bool {
+	return true
+}
+
+func attachmentError(field string, violation string) error {
+	return errors.WithStack(validationError{violations: map[string][]string{
+		field: {violation},
+	}})
+}
+
+func validateAttachmentName(name string) error {
+	switch {
+	case strings.TrimSpace(name) == "":
+		return attachmentError("name", "name cannot be empty")
+
+	case len(name) > maxAttachmentNameLength:
+		return attachmentError("name", "name cannot be longer than 255 bytes")
+
+	case name == "." || name == ".." || strings.ContainsAny(name, `/\`) || strings.IndexFunc(name, unicode.IsControl) >= 0:
+		return attachmentError("name", "name must be a file name")
+	}
+
+	return nil
+}
+
+// attachmentKey returns the blob key of an attachment.
+func attachmentKey(id string) string {
+	return "attachments/" + id
+}
+
+// uploadReader hashes and counts the content of an upload and stops reading past the size limit.
+type uploadReader struct {
+	r     io.Reader
+	hash  hash.Hash
+	size  int64
+	limit int64
+
+	tooLarge bool
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+
+	r.size += int64(n)
+	_, _ = r.hash.Write(p[:n])
+
+	if r.size > r.limit {
+		r.tooLarge = true
+
+		return n, errors.New("attachment is too large")
+	}
+
+	return n, err
+}
+
+func (s service) AddAttachment(ctx context.Context, itemID string, newAttachment NewAttachment) (Attachment, error) {
+	if s.attachments.Blobs == nil {
+		return Attachment{}, errors.New("attachments are disabled")
+	}
+
+	err := validateAttachmentName(newAttachment.Name)
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	if newAttachment.Size > s.attachments.MaxSize {
+		return Attachment{}, attachmentError("size", "attachment cannot be larger than "+formatSize(s.attachments.MaxSize))
+	}
+
+	if newAttachment.Content == nil {
+		return Attachment{}, attachmentError("content", "content cannot be empty")
+	}
+
+	_, err = s.store.GetOne(ctx, itemID)
+	if err != nil {
+		return Attachment{}, errors.WithMessage(err, "add attachment")
+	}
+
+	content := bufio.NewReaderSize(newAttachment.Content, 512)
+
+	contentType := newAttachment.ContentType
+	if contentType == "" || strings.HasPrefix(contentType, "application/octet-stream") {
+		// Peek returns an error when the content is shorter: the detection uses whatever is available
+		head, _ := content.Peek(512)
+
+		contentType = http.DetectContentType(head)
+	}
+
+	if !s.attachments.allowContentType(contentType) {
+		return Attachment{}, attachmentError("contentType", "content type "+contentType+" is not accepted")
+	}
+
+	id, err := s.idgenerator.Generate()
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	uploader, _ := principal.FromContext(ctx)
+
+	attachment := Attachment{
+		ID:          id,
+		ItemID:      itemID,
+		Name:        newAttachment.Name,
+		ContentType: contentType,
+		Uploader:    uploader,
+		CreatedAt:   time.Now(),
+	}
+
+	size := newAttachment.Size
+	if size <= 0 {
+		size = -1
+	}
+
+	upload := &uploadReader{
+		r:     content,
+		hash:  sha256.New(),
+		limit: s.attachments.MaxSize,
+	}
+
+	key := attachmentKey(id)
+
+	err = s.attachments.Blobs.Put(ctx, key, upload, size, contentType)
+
+	// Whatever is stored is removed if the upload turns out to be invalid
+	invalid := func(field string, violation string) (Attachment, error) {
+		_ = s.attachments.Blobs.Delete(ctx, key)
+
+		return Attachment{}, attachmentError(field, violation)
+	}
+
+	switch {
+	case upload.tooLarge:
+		return invalid("size", "attachment cannot be larger than "+formatSize(s.attachments.MaxSize))
+
+	case size > 0 && upload.size != size:
+		return invalid("size", "content length does not match the size of the attachment")
+
+	case err != nil:
+		_ = s.attachments.Blobs.Delete(ctx, key)
+
+		return Attachment{}, errors.WrapIf(err, "store attachment content")
+
+	case upload.size == 0:
+		return invalid("content", "content cannot be empty")
+	}
+
+	attachment.Size = upload.size
+	attachment.Checksum = hex.EncodeToString(upload.hash.Sum(nil))
+
+	if newAttachment.Checksum != "" && !strings.EqualFold(newAttachment.Checksum, attachment.Checksum) {
+		return invalid("checksum", "checksum does not match the content")
+	}
+
+	err = s.attachmentStore.StoreAttachment(ctx, attachment)
+	if err != nil {
+		_ = s.attachments.Blobs.Delete(ctx, key)
+
+		return Attachment{}, errors.WithMessage(err, "add attachment")
+	}
+
+	// The content is removed if the attachment is rolled back (eg. recording the change fails)
+	afterTransaction(ctx, func(ctx context.Context, committed bool) {
+		if !committed {
+			_ = s.attachments.Blobs.Delete(ctx, key)
+		}
+	})
+
+	return s.withLink(attachment), nil
+}
+
+func (s service) ListAttachments(ctx context.Context, itemID string) ([]Attachment, error) {
+	_, err := s.store.GetOne(ctx, itemID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "list attachments")
+	}
+
+	attachments, err := s.attachmentStore.GetAttachments(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, attachment := range attachments {
+		attachments[i] = s.withLink(attachment)
+	}
+
+	return attachments, nil
+}
+
+func (s service) GetAttachment(ctx context.Context, id string) (Attachment, error) {
+	attachment, err := s.getAttachment(ctx, id)
+	if err != nil {
+		return Attachment{}, errors.WithMessage(err, "get attachment")
+	}
+
+	return s.withLink(attachment), nil
+}
+
+func (s service) DownloadAttachment(ctx context.Context, id string, link AttachmentLink) (AttachmentDownload, error) {
+	if s.attachments.Blobs == nil {
+		return AttachmentDownload{}, errors.New("attachments are disabled")
+	}
+
+	expectedSignature := s.signAttachmentLink(id, link.ExpiresAt)
+
+	if !hmac.Equal([]byte(link.Signature), []byte(expectedSignature)) || !time.Now().Before(link.ExpiresAt) {
+		return AttachmentDownload{}, errors.WithStack(AttachmentLinkError{ID: id})
+	}
+
+	attachment, err := s.getAttachment(ctx, id)
+	if err != nil {
+		return AttachmentDownload{}, errors.WithMessage(err, "download attachment")
+	}
+
+	content, err := s.attachments.Blobs.Get(ctx, attachmentKey(id))
+	if err != nil {
+		return AttachmentDownload{}, errors.WrapIf(err, "download attachment")
+	}
+
+	return AttachmentDownload{
+		Attachment: s.withLink(attachment),
+		Content:    content,
+	}, nil
+}
+
+func (s service) DeleteAttachment(ctx context.Context, id string) error {
+	_, err := s.getAttachment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.attachmentStore.DeleteOneAttachment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// The content is deleted once the deletion is committed (a failure leaves the content behind)
+	if s.attachments.Blobs != nil {
+		afterTransaction(ctx, func(ctx context.Context, committed bool) {
+			if committed {
+				_ = s.attachments.Blobs.Delete(ctx, attachmentKey(id))
+			}
+		})
+	}
+
+	return nil
+}
+
+// getAttachment returns an attachment of an item that is not deleted.
+func (s service) getAttachment(ctx context.Context, id string) (Attachment, error) {
+	attachment, err := s.attachmentStore.GetOneAttachment(ctx, id)
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	// Attachments of deleted items are hidden with the item
+	_, err = s.store.GetOne(ctx, attachment.ItemID)
+	if errors.As(err, &NotFoundError{}) {
+		return Attachment{}, errors.WithStack(AttachmentNotFoundError{ID: id})
+	}
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+// withLink sets a fresh download link on an attachment.
+func (s service) withLink(attachment Attachment) Attachment {
+	expiresAt := time.Now().Add(s.attachments.LinkExpiry).Truncate(time.Second)
+
+	attachment.Link = AttachmentLink{
+		ExpiresAt: expiresAt,
+		Signature: s.signAttachmentLink(attachment.ID, expiresAt),
+	}
+
+	return attachment
+}
+
+func (s service) signAttachmentLink(id string, expiresAt time.Time) string {
+	mac := hmac.New(sha256.New, s.attachments.SigningKey)
+	_, _ = mac.Write([]byte(id + "\n" + strconv.FormatInt(expiresAt.Unix(), 10)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// collectAttachments deletes the attachments of purged items.
+// Failures are left to the scheduled collection.
+func (s service) collectAttachments(ctx context.Context) {
+	_ = NewAttachmentCollector(s.attachmentStore, s.attachments.Blobs).Collect(ctx)
+}
+
+// formatSize formats a size in bytes for humans.
+func formatSize(size int64) string {
+	switch {
+	case size >= 1<<20 && size%(1<<20) == 0:
+		return strconv.FormatInt(size>>20, 10) + " MiB"
+
+	case size >= 1<<10 && size%(1<<10) == 0:
+		return strconv.FormatInt(size>>10, 10) + " KiB"
+
+	default:
+		return strconv.FormatInt(size, 10) + " bytes"
+	}
+}
+
+// AttachmentCollector deletes the attachments of permanently deleted items.
+type AttachmentCollector struct {
+	store AttachmentStore
+	blobs BlobStore
+}
+
+// NewAttachmentCollector returns a new AttachmentCollector.
+func NewAttachmentCollector(store AttachmentStore, blobs BlobStore) AttachmentCollector {
+	return AttachmentCollector{
+		store: store,
+		blobs: blobs,
+	}
+}
+
+// Collect deletes the content and the details of orphan attachments.
+func (c AttachmentCollector) Collect(ctx context.Context) error {
+	attachments, err := c.store.GetOrphanAttachments(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "collect attachments")
+	}
+
+	for _, attachment := range attachments {
+		if c.blobs != nil {
+			err := c.blobs.Delete(ctx, attachmentKey(attachment.ID))
+			if err != nil {
+				return errors.WrapIfWithDetails(err, "delete attachment content", "attachment_id", attachment.ID)
+			}
+		}
+
+		err := c.store.DeleteOneAttachment(ctx, attachment.ID)
+		if err != nil {
+			return errors.WithMessage(err, "collect attachments")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package todo_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/blob"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func newAttachmentService(t *testing.T) (Service, *InMemoryStore, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	blobs, err := blob.NewFileStore(dir)
+	require.NoError(t, err)
+
+	service, store := newTestService(testServiceConfig{
+		Attachments: AttachmentConfig{
+			Blobs:        blobs,
+			MaxSize:      1024,
+			ContentTypes: []string{"text/plain", "image/*"},
+			SigningKey:   []byte("secret"),
+		},
+	})
+
+	return service, store, dir
+}
+
+// blobFiles returns the files stored in a blob store directory.
+func blobFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var files []string
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			files = append(files, path)
+		}
+
+		return err
+	})
+	require.NoError(t, err)
+
+	return files
+}
+
+func download(t *testing.T, service Service, attachment Attachment) string {
+	t.Helper()
+
+	d, err := service.DownloadAttachment(context.Background(), attachment.ID, attachment.Link)
+	require.NoError(t, err)
+
+	defer d.Content.Close()
+
+	content, err := io.ReadAll(d.Content)
+	require.NoError(t, err)
+
+	return string(content)
+}
+
+func TestService_Attachments(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _, dir := newAttachmentService(t)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Pay the bills"})
+	require.NoError(t, err)
+
+	sum := sha256.Sum256([]byte("electricity: 42"))
+
+	attachment, err := service.AddAttachment(ctx, item.ID, NewAttachment{
+		Name:     "bills.txt",
+		Size:     15,
+		Checksum: hex.EncodeToString(sum[:]),
+		Content:  strings.NewReader("electricity: 42"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bills.txt", attachment.Name)
+	assert.Equal(t, "text/plain; charset=utf-8", attachment.ContentType, "content type is detected")
+	assert.Equal(t, int64(15), attachment.Size)
+	assert.Equal(t, hex.EncodeToString(sum[:]), attachment.Checksum)
+	assert.Equal(t, "john", attachment.Uploader)
+	assert.True(t, attachment.Link.ExpiresAt.After(time.Now()))
+	assert.NotEmpty(t, attachment.Link.Signature)
+
+	assert.Equal(t, "electricity: 42", download(t, service, attachment))
+
+	attachments, err := service.ListAttachments(ctx, item.ID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, attachment.ID, attachments[0].ID)
+
+	// Every link is valid on its own
+	assert.Equal(t, "electricity: 42", download(t, service, attachments[0]))
+
+	err = service.DeleteAttachment(ctx, attachment.ID)
+	require.NoError(t, err)
+
+	_, err = service.GetAttachment(ctx, attachment.ID)
+	assert.True(t, errors.As(err, &AttachmentNotFoundError{}))
+	assert.Empty(t, blobFiles(t, dir))
+
+	entries, err := service.ItemHistory(ctx, item.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, []FieldChange{{Field: "attachment", After: attachment.ID + ": bills.txt"}}, entries[1].Changes)
+	assert.Equal(t, "DeleteAttachment", entries[2].Operation)
+}
+
+// unreliableHistoryStore fails recording history when asked to.
+type unreliableHistoryStore struct {
+	*InMemoryStore
+
+	fail *bool
+}
+
+func (s unreliableHistoryStore) AddHistoryEntry(ctx context.Context, entry HistoryEntry) error {
+	if *s.fail {
+		return errors.New("history is unavailable")
+	}
+
+	return s.InMemoryStore.AddHistoryEntry(ctx, entry)
+}
+
+func TestService_Attachments_Rollback(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	dir := t.TempDir()
+
+	blobs, err := blob.NewFileStore(dir)
+	require.NoError(t, err)
+
+	var fail bool
+
+	service, _ := newTestService(testServiceConfig{
+		Store: func(store *InMemoryStore) ServiceStore { return unreliableHistoryStore{store, &fail} },
+		Attachments: AttachmentConfig{
+			Blobs:      blobs,
+			SigningKey: []byte("secret"),
+		},
+	})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Pay the bills"})
+	require.NoError(t, err)
+
+	attachment, err := service.AddAttachment(ctx, item.ID, NewAttachment{
+		Name:    "bills.txt",
+		Content: strings.NewReader("electricity: 42"),
+	})
+	require.NoError(t, err)
+
+	fail = true
+
+	_, err = service.AddAttachment(ctx, item.ID, NewAttachment{
+		Name:    "receipt.txt",
+		Content: strings.NewReader("paid"),
+	})
+	require.Error(t, err)
+	assert.Len(t, blobFiles(t, dir), 1, "the content of a rolled back attachment is removed")
+
+	err = service.DeleteAttachment(ctx, attachment.ID)
+	require.Error(t, err)
+
+	attachments, err := service.ListAttachments(ctx, item.ID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, "electricity: 42", download(t, service, attachments[0]), "the content is kept until the deletion is committed")
+}
+
+func TestService_AddAttachment_Invalid(t *testing.T) {
+	ctx := context.Background()
+	service, _, dir := newAttachmentService(t)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Pay the bills"})
+	require.NoError(t, err)
+
+	tests := map[string]NewAttachment{
+		"empty name": {
+			Content: strings.NewReader("content"),
+		},
+		"path as name": {
+			Name:    "../bills.txt",
+			Content: strings.NewReader("content"),
+		},
+		"too large": {
+			Name:    "bills.txt",
+			Size:    2048,
+			Content: strings.NewReader(strings.Repeat("a", 2048)),
+		},
+		"too large content of unknown size": {
+			Name:    "bills.txt",
+			Content: strings.NewReader(strings.Repeat("a", 2048)),
+		},
+		"size mismatch": {
+			Name:    "bills.txt",
+			Size:    10,
+			Content: strings.NewReader("content"),
+		},
+		"empty content": {
+			Name:        "bills.txt",
+			ContentType: "text/plain",
+			Content:     strings.NewReader(""),
+		},
+		"content type not accepted": {
+			Name:    "bills.pdf",
+			Content: strings.NewReader("%PDF-1.4"),
+		},
+		"checksum mismatch": {
+			Name:     "bills.txt",
+			Checksum: strings.Repeat("0", 64),
+			Content:  strings.NewReader("content"),
+		},
+	}
+
+	for name, newAttachment := range tests {
+		name, newAttachment := name, newAttachment
+
+		t.Run(name, func(t *testing.T) {
+			_, err := service.AddAttachment(ctx, item.ID, newAttachment)
+			require.Error(t, err)
+			assert.True(t, isValidationError(err), err.Error())
+		})
+	}
+
+	assert.Empty(t, blobFiles(t, dir), "invalid uploads are not kept")
+
+	_, err = service.AddAttachment(ctx, "missing", NewAttachment{Name: "bills.txt", Content: strings.NewReader("content")})
+	assert.True(t, errors.As(err, &NotFoundError{}))
+}
+
+func TestService_DownloadAttachment_InvalidLink(t *testing.T) {
+	ctx := context.Background()
+	service, _, _ := newAttachmentService(t)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Pay the bills"})
+	require.NoError(t, err)
+
+	attachment, err := service.AddAttachment(ctx, item.ID, NewAttachment{
+		Name:    "bills.png",
+		Content: bytes.NewReader([]byte("\x89PNG\x0D\x0A\x1A\x0A")),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", attachment.ContentType)
+
+	links := map[string]AttachmentLink{
+		"missing": {},
+		"tampered signature": {
+			ExpiresAt: attachment.Link.ExpiresAt,
+			Signature: strings.Repeat("0", 64),
+		},
+		"extended expiry": {
+			ExpiresAt: attachment.Link.ExpiresAt.Add(time.Hour),
+			Signature: attachment.Link.Signature,
+		},
+	}
+
+	for name, link := range links {
+		link := link
+
+		t.Run(name, func(t *testing.T) {
+			_, err := service.DownloadAttachment(ctx, attachment.ID, link)
+			assert.True(t, errors.As(err, &AttachmentLinkError{}))
+		})
+	}
+}
+
+func TestService_PurgeItem_DeletesAttachments(t *testing.T) {
+	ctx := context.Background()
+	service, store, dir := newAttachmentService(t)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Pay the bills"})
+	require.NoError(t, err)
+
+	attachment, err := service.AddAttachment(ctx, item.ID, NewAttachment{
+		Name:    "bills.txt",
+		Content: strings.NewReader("electricity: 42"),
+	})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, item.ID, false)
+	require.NoError(t, err)
+
+	_, err = service.GetAttachment(ctx, attachment.ID)
+	assert.True(t, errors.As(err, &AttachmentNotFoundError{}), "attachments are hidden with the item")
+	assert.Len(t, blobFiles(t, dir), 1, "attachments are kept in the trash")
+
+	err = service.PurgeItem(ctx, item.ID)
+	require.NoError(t, err)
+
+	assert.Empty(t, blobFiles(t, dir))
+
+	orphans, err := store.GetOrphanAttachments(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+}
+
+func TestAttachmentCollector(t *testing.T) {
+	ctx := context.Background()
+	service, store, dir := newAttachmentService(t)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Pay the bills"})
+	require.NoError(t, err)
+
+	_, err = service.AddAttachment(ctx, item.ID, NewAttachment{
+		Name:    "bills.txt",
+		Content: strings.NewReader("electricity: 42"),
+	})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, item.ID, false)
+	require.NoError(t, err)
+
+	// The trash is purged by a job, bypassing the service
+	_, err = store.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	orphans, err := store.GetOrphanAttachments(ctx)
+	require.NoError(t, err)
+	require.Len(t, orphans, 1)
+
+	blobs, err := blob.NewFileStore(dir)
+	require.NoError(t, err)
+
+	err = NewAttachmentCollector(store, blobs).Collect(ctx)
+	require.NoError(t, err)
+
+	assert.Empty(t, blobFiles(t, dir))
+
+	orphans, err = store.GetOrphanAttachments(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+}
@@ -0,0 +1,337 @@
+package todo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+)
+
+// maxBulkItems is the maximum number of items changed by a single bulk operation.
+const maxBulkItems = 100
+
+// BulkMode tells how a bulk operation handles failing changes.
+type BulkMode string
+
+const (
+	// BulkAtomic applies either every change or none of them.
+	BulkAtomic BulkMode = "atomic"
+
+	// BulkBestEffort applies every change that succeeds.
+	BulkBestEffort BulkMode = "bestEffort"
+)
+
+// BulkStatus is the outcome of a single change of a bulk operation.
+type BulkStatus string
+
+const (
+	// BulkSucceeded means the change has been applied.
+	BulkSucceeded BulkStatus = "succeeded"
+
+	// BulkFailed means the change failed.
+	BulkFailed BulkStatus = "failed"
+
+	// BulkAborted means the change has not been applied because another change failed (in atomic mode).
+	BulkAborted BulkStatus = "aborted"
+)
+
+// BulkItemUpdate is a change of an item in a bulk operation.
+type BulkItemUpdate struct {
+	ID string
+
+	// Update changes the fields of the item.
+	Update ItemUpdate
+
+	// Tags are added to the item.
+	Tags []string
+
+	// Untags are removed from the item.
+	Untags []string
+}
+
+// BulkResult is the outcome of a single change of a bulk operation, in the order of the changes.
+type BulkResult struct {
+	// ID of the changed item (empty for items that have not been added).
+	ID string
+
+	Status BulkStatus
+
+	// Item is the changed item (zero for deleted items and changes that have not been applied).
+	Item Item
+
+	// Err is the reason the change failed.
+	Err error
+}
+
+// Transactor runs functions in a store transaction.
+type Transactor interface {
+	// Transaction runs a function in a transaction: changes are rolled back if the function returns an error.
+	// Stores called with the context passed to the function take part in the transaction.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+func (s service) BulkAddItems(ctx context.Context, newItems []NewItem, mode BulkMode) ([]BulkResult, error) {
+	return bulkService{service: s, transactor: s.transactor}.BulkAddItems(ctx, newItems, mode)
+}
+
+func (s service) BulkUpdateItems(ctx context.Context, updates []BulkItemUpdate, mode BulkMode) ([]BulkResult, error) {
+	return bulkService{service: s, transactor: s.transactor}.BulkUpdateItems(ctx, updates, mode)
+}
+
+func (s service) BulkDeleteItems(ctx context.Context, ids []string, cascade bool, mode BulkMode) ([]BulkResult, error) {
+	return bulkService{service: s, transactor: s.transactor}.BulkDeleteItems(ctx, ids, cascade, mode)
+}
+
+// BulkMiddleware applies bulk operations through the single item operations of the underlying service,
+// so every change goes through the same middleware as changing the items one by one.
+// Events fired by the changes are dispatched once the changes are committed.
+func BulkMiddleware(transactor Transactor, events Events) Middleware {
+	return func(next Service) Service {
+		return bulkMiddleware{
+			Service: DefaultMiddleware{Service: next},
+			bulk: bulkService{
+				service:    next,
+				transactor: transactor,
+				events:     events,
+			},
+		}
+	}
+}
+
+type bulkMiddleware struct {
+	Service
+
+	bulk bulkService
+}
+
+func (mw bulkMiddleware) BulkAddItems(ctx context.Context, newItems []NewItem, mode BulkMode) ([]BulkResult, error) {
+	return mw.bulk.BulkAddItems(ctx, newItems, mode)
+}
+
+func (mw bulkMiddleware) BulkUpdateItems(
+	ctx context.Context,
+	updates []BulkItemUpdate,
+	mode BulkMode,
+) ([]BulkResult, error) {
+	return mw.bulk.BulkUpdateItems(ctx, updates, mode)
+}
+
+func (mw bulkMiddleware) BulkDeleteItems(
+	ctx context.Context,
+	ids []string,
+	cascade bool,
+	mode BulkMode,
+) ([]BulkResult, error) {
+	return mw.bulk.BulkDeleteItems(ctx, ids, cascade, mode)
+}
+
+// bulkService applies bulk operations one item at a time.
+type bulkService struct {
+	service    Service
+	transactor Transactor
+
+	// events dispatches the events fired by committed changes (optional).
+	events Events
+}
+
+func (s bulkService) BulkAddItems(ctx context.Context, newItems []NewItem, mode BulkMode) ([]BulkResult, error) {
+	results := make([]BulkResult, len(newItems))
+
+	err := s.run(ctx, mode, results, func(ctx context.Context, i int) (Item, error) {
+		return s.service.AddItem(ctx, newItems[i])
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "add items")
+	}
+
+	return results, nil
+}
+
+func (s bulkService) BulkUpdateItems(ctx context.Context, updates []BulkItemUpdate, mode BulkMode) ([]BulkResult, error) {
+	results := make([]BulkResult, len(updates))
+
+	for i, update := range updates {
+		results[i].ID = update.ID
+	}
+
+	err := s.run(ctx, mode, results, func(ctx context.Context, i int) (Item, error) {
+		update := updates[i]
+
+		item, err := s.service.GetItem(ctx, update.ID)
+		if err != nil {
+			return item, err
+		}
+
+		if update.Update != (ItemUpdate{}) {
+			item, err = s.service.UpdateItem(ctx, update.ID, update.Update)
+			if err != nil {
+				return item, err
+			}
+		}
+
+		if len(update.Tags) > 0 {
+			item, err = s.service.TagItem(ctx, update.ID, update.Tags)
+			if err != nil {
+				return item, err
+			}
+		}
+
+		if len(update.Untags) > 0 {
+			item, err = s.service.UntagItem(ctx, update.ID, update.Untags)
+			if err != nil {
+				return item, err
+			}
+		}
+
+		return item, nil
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "update items")
+	}
+
+	return results, nil
+}
+
+func (s bulkService) BulkDeleteItems(
+	ctx context.Context,
+	ids []string,
+	cascade bool,
+	mode BulkMode,
+) ([]BulkResult, error) {
+	results := make([]BulkResult, len(ids))
+
+	for i, id := range ids {
+		results[i].ID = id
+	}
+
+	err := s.run(ctx, mode, results, func(ctx context.Context, i int) (Item, error) {
+		// Deleting an unknown item succeeds otherwise
+		_, err := s.service.GetItem(ctx, ids[i])
+		if err != nil {
+			return Item{}, err
+		}
+
+		return Item{}, s.service.DeleteItem(ctx, ids[i], cascade)
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "delete items")
+	}
+
+	return results, nil
+}
+
+// errBulkFailed rolls back the transaction of an atomic bulk operation.
+const errBulkFailed = errors.Sentinel("bulk operation failed")
+
+// run applies the changes of a bulk operation and records their outcome in results.
+// Results may contain the IDs of the changed items in advance.
+// Changes are atomic unless the mode says otherwise.
+func (s bulkService) run(
+	ctx context.Context,
+	mode BulkMode,
+	results []BulkResult,
+	apply func(ctx context.Context, i int) (Item, error),
+) error {
+	if mode == "" {
+		mode = BulkAtomic
+	}
+
+	err := validateBulk(mode, len(results))
+	if err != nil {
+		return err
+	}
+
+	applyOne := func(ctx context.Context, i int) {
+		item, err := apply(ctx, i)
+		if err != nil {
+			results[i].Status, results[i].Err = BulkFailed, err
+
+			return
+		}
+
+		results[i].Status, results[i].Item = BulkSucceeded, item
+
+		if results[i].ID == "" {
+			results[i].ID = item.ID
+		}
+	}
+
+	if mode == BulkBestEffort {
+		for i := range results {
+			txCtx, queue := withEventQueue(ctx)
+
+			err := s.transactor.Transaction(txCtx, func(ctx context.Context) error {
+				applyOne(ctx, i)
+
+				return results[i].Err
+			})
+			if err != nil {
+				results[i].Status, results[i].Item, results[i].Err = BulkFailed, Item{}, err
+
+				continue
+			}
+
+			err = queue.dispatch(ctx, s.events)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	ids := make([]string, len(results))
+
+	for i := range results {
+		ids[i] = results[i].ID
+	}
+
+	txCtx, queue := withEventQueue(ctx)
+
+	err = s.transactor.Transaction(txCtx, func(ctx context.Context) error {
+		for i := range results {
+			applyOne(ctx, i)
+
+			if results[i].Err != nil {
+				return errBulkFailed
+			}
+		}
+
+		return nil
+	})
+	if errors.Is(err, errBulkFailed) {
+		for i := range results {
+			if results[i].Status != BulkFailed {
+				results[i] = BulkResult{ID: ids[i], Status: BulkAborted}
+			}
+		}
+
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return queue.dispatch(ctx, s.events)
+}
+
+func validateBulk(mode BulkMode, count int) error {
+	violations := make(map[string][]string)
+
+	if mode != BulkAtomic && mode != BulkBestEffort {
+		violations["mode"] = append(violations["mode"], "mode must be either atomic or bestEffort")
+	}
+
+	if count == 0 {
+		violations["items"] = append(violations["items"], "at least one item is required")
+	}
+
+	if count > maxBulkItems {
+		violations["items"] = append(violations["items"], "at most 100 items can be changed at once")
+	}
+
+	if len(violations) > 0 {
+		return errors.WithStack(validationError{violations: violations})
+	}
+
+	return nil
+}
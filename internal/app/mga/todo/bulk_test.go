@@ -0,0 +1,187 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+func bulkStatuses(results []BulkResult) []BulkStatus {
+	statuses := make([]BulkStatus, 0, len(results))
+
+	for _, result := range results {
+		statuses = append(statuses, result.Status)
+	}
+
+	return statuses
+}
+
+func TestService_BulkAddItems(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	results, err := service.BulkAddItems(ctx, []NewItem{{Title: "Buy milk"}, {Title: "Buy bread"}}, "")
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, []BulkStatus{BulkSucceeded, BulkSucceeded}, bulkStatuses(results))
+	assert.Equal(t, results[0].ID, results[0].Item.ID)
+	assert.Equal(t, "Buy bread", results[1].Item.Title)
+
+	assert.Len(t, events.added, 2)
+}
+
+func TestService_BulkAddItems_Atomic(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	results, err := service.BulkAddItems(
+		ctx,
+		[]NewItem{{Title: "Buy milk"}, {Title: ""}, {Title: "Buy bread"}},
+		BulkAtomic,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []BulkStatus{BulkAborted, BulkFailed, BulkAborted}, bulkStatuses(results))
+	assert.True(t, isValidationError(results[1].Err))
+	assert.Empty(t, results[0].ID, "aborted items are not added")
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items, "nothing is changed when a change fails")
+	assert.Empty(t, events.added, "events are dispatched once the changes are committed")
+}
+
+func TestService_BulkAddItems_BestEffort(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	results, err := service.BulkAddItems(
+		ctx,
+		[]NewItem{{Title: "Buy milk"}, {Title: ""}, {Title: "Buy bread"}},
+		BulkBestEffort,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []BulkStatus{BulkSucceeded, BulkFailed, BulkSucceeded}, bulkStatuses(results))
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{results[0].ID, results[2].ID}, itemIDs(items))
+	assert.Len(t, events.added, 2)
+}
+
+func TestService_BulkUpdateItems(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	milk, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	bread, err := service.AddItem(ctx, NewItem{Title: "Buy bread"})
+	require.NoError(t, err)
+
+	_, err = service.TagItem(ctx, bread.ID, []string{"errands"})
+	require.NoError(t, err)
+
+	events.updated = nil
+
+	completed := true
+
+	results, err := service.BulkUpdateItems(
+		ctx,
+		[]BulkItemUpdate{
+			{ID: milk.ID, Update: ItemUpdate{Completed: &completed}, Tags: []string{"shopping"}},
+			{ID: bread.ID, Tags: []string{"shopping"}, Untags: []string{"errands"}},
+		},
+		BulkAtomic,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []BulkStatus{BulkSucceeded, BulkSucceeded}, bulkStatuses(results))
+	assert.True(t, results[0].Item.Completed)
+	assert.Equal(t, []string{"shopping"}, tagNames(results[1].Item.Tags))
+
+	assert.Equal(
+		t,
+		[]MarkedAsComplete{{ID: milk.ID, ListID: milk.ListID, Title: milk.Title}},
+		events.completed,
+	)
+	assert.Equal(
+		t,
+		[]ItemUpdated{{ID: milk.ID, ListID: milk.ListID}, {ID: bread.ID, ListID: bread.ListID}},
+		events.updated,
+		"one event is dispatched for every changed item",
+	)
+}
+
+func TestService_BulkUpdateItems_Atomic(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	completed := true
+
+	results, err := service.BulkUpdateItems(
+		ctx,
+		[]BulkItemUpdate{
+			{ID: item.ID, Update: ItemUpdate{Completed: &completed}},
+			{ID: "unknown", Update: ItemUpdate{Completed: &completed}},
+		},
+		BulkAtomic,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []BulkStatus{BulkAborted, BulkFailed}, bulkStatuses(results))
+	assert.Equal(t, item.ID, results[0].ID)
+	assert.True(t, errors.As(results[1].Err, &NotFoundError{}))
+
+	item, err = service.GetItem(ctx, item.ID)
+	require.NoError(t, err)
+	assert.False(t, item.Completed)
+	assert.Empty(t, events.completed)
+}
+
+func TestService_BulkDeleteItems(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	parent, err := service.AddItem(ctx, NewItem{Title: "Clean the house"})
+	require.NoError(t, err)
+
+	subtask, err := service.AddItem(ctx, NewItem{Title: "Vacuum", ParentID: parent.ID})
+	require.NoError(t, err)
+
+	results, err := service.BulkDeleteItems(ctx, []string{parent.ID, "unknown"}, true, BulkBestEffort)
+	require.NoError(t, err)
+	assert.Equal(t, []BulkStatus{BulkSucceeded, BulkFailed}, bulkStatuses(results))
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	require.Len(t, events.deleted, 2)
+	assert.Equal(t, []string{parent.ID, subtask.ID}, []string{events.deleted[0].ID, events.deleted[1].ID})
+}
+
+func TestService_Bulk_Validation(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.BulkAddItems(ctx, []NewItem{{Title: "Buy milk"}}, "everything")
+	assert.True(t, isValidationError(err))
+
+	_, err = service.BulkDeleteItems(ctx, nil, false, BulkAtomic)
+	assert.True(t, isValidationError(err))
+
+	_, err = service.BulkUpdateItems(ctx, make([]BulkItemUpdate, 101), BulkBestEffort)
+	assert.True(t, isValidationError(err))
+}
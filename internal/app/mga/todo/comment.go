@@ -0,0 +1,286 @@
+package todo
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// Comment is a remark about an item.
+type Comment struct {
+	ID     string
+	ItemID string
+
+	// Author is the user who wrote the comment (empty for anonymous users).
+	Author string
+
+	// Body is the text of the comment in Markdown.
+	Body string
+
+	// Mentions are the users mentioned in the comment (eg. @jane) in order of appearance.
+	// They are maintained by the service.
+	Mentions []string
+
+	// Edits are the earlier versions of the comment, oldest first.
+	Edits []CommentEdit
+
+	CreatedAt time.Time
+
+	// UpdatedAt is the time of the last edit (if the comment has been edited).
+	UpdatedAt *time.Time
+}
+
+// CommentEdit is an earlier version of a comment.
+type CommentEdit struct {
+	// Body is the text of the comment before the edit.
+	Body string `json:"body"`
+
+	// EditedAt is the time the text got replaced.
+	EditedAt time.Time `json:"editedAt"`
+}
+
+// NewComment contains the details of a new Comment.
+type NewComment struct {
+	Body string
+}
+
+// CommentStore persists the comments of items.
+type CommentStore interface {
+	// StoreComment stores a comment.
+	StoreComment(ctx context.Context, comment Comment) error
+
+	// GetComments returns the comments of an item, oldest first.
+	GetComments(ctx context.Context, itemID string) ([]Comment, error)
+
+	// GetOneComment returns a single comment by its ID.
+	GetOneComment(ctx context.Context, id string) (Comment, error)
+
+	// DeleteOneComment deletes a single comment by its ID.
+	DeleteOneComment(ctx context.Context, id string) error
+}
+
+// CommentNotFoundError is returned if a comment cannot be found.
+type CommentNotFoundError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (CommentNotFoundError) Error() string {
+	return "comment not found"
+}
+
+// Details returns error details.
+func (e CommentNotFoundError) Details() []interface{} {
+	return []interface{}{"comment_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (CommentNotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (CommentNotFoundError) ServiceError() bool {
+	return true
+}
+
+func validateComment(comment Comment) error {
+	if strings.TrimSpace(comment.Body) == "" {
+		return errors.WithStack(validationError{violations: map[string][]string{
+			"body": {
+				"body cannot be empty",
+			},
+		}})
+	}
+
+	return nil
+}
+
+func (s service) AddComment(ctx context.Context, itemID string, newComment NewComment) (Comment, error) {
+	id, err := s.idgenerator.Generate()
+	if err != nil {
+		return Comment{}, err
+	}
+
+	author, _ := principal.FromContext(ctx)
+
+	comment := Comment{
+		ID:        id,
+		ItemID:    itemID,
+		Author:    author,
+		Body:      newComment.Body,
+		Mentions:  parseMentions(newComment.Body),
+		CreatedAt: time.Now(),
+	}
+
+	err = validateComment(comment)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	_, err = s.store.GetOne(ctx, itemID)
+	if err != nil {
+		return Comment{}, errors.WithMessage(err, "add comment")
+	}
+
+	err = s.commentStore.StoreComment(ctx, comment)
+	if err != nil {
+		return Comment{}, errors.WithMessage(err, "add comment")
+	}
+
+	return comment, nil
+}
+
+func (s service) ListComments(ctx context.Context, itemID string) ([]Comment, error) {
+	_, err := s.store.GetOne(ctx, itemID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "list comments")
+	}
+
+	return s.commentStore.GetComments(ctx, itemID)
+}
+
+func (s service) GetComment(ctx context.Context, id string) (Comment, error) {
+	comment, err := s.getComment(ctx, id)
+	if err != nil {
+		return Comment{}, errors.WithMessage(err, "get comment")
+	}
+
+	return comment, nil
+}
+
+func (s service) UpdateComment(ctx context.Context, id string, body string) (Comment, error) {
+	comment, err := s.getComment(ctx, id)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	err = checkCommentAuthor(ctx, comment, "edit")
+	if err != nil {
+		return Comment{}, err
+	}
+
+	if body == comment.Body {
+		return comment, nil
+	}
+
+	now := time.Now()
+
+	updatedComment := comment
+	updatedComment.Body = body
+	updatedComment.Mentions = parseMentions(body)
+	updatedComment.Edits = append(append([]CommentEdit(nil), comment.Edits...), CommentEdit{
+		Body:     comment.Body,
+		EditedAt: now,
+	})
+	updatedComment.UpdatedAt = &now
+
+	err = validateComment(updatedComment)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	err = s.commentStore.StoreComment(ctx, updatedComment)
+	if err != nil {
+		return Comment{}, errors.WithMessage(err, "update comment")
+	}
+
+	return updatedComment, nil
+}
+
+func (s service) DeleteComment(ctx context.Context, id string) error {
+	comment, err := s.getComment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = checkCommentAuthor(ctx, comment, "delete")
+	if err != nil {
+		return err
+	}
+
+	return s.commentStore.DeleteOneComment(ctx, id)
+}
+
+// getComment returns a comment of an item that is not deleted.
+func (s service) getComment(ctx context.Context, id string) (Comment, error) {
+	comment, err := s.commentStore.GetOneComment(ctx, id)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	// Comments of deleted items are hidden with the item
+	_, err = s.store.GetOne(ctx, comment.ItemID)
+	if errors.As(err, &NotFoundError{}) {
+		return Comment{}, errors.WithStack(CommentNotFoundError{ID: id})
+	}
+	if err != nil {
+		return Comment{}, err
+	}
+
+	return comment, nil
+}
+
+// checkCommentAuthor makes sure that only the author changes a comment.
+func checkCommentAuthor(ctx context.Context, comment Comment, action string) error {
+	if author, _ := principal.FromContext(ctx); author != comment.Author {
+		return errors.WithStack(validationError{violations: map[string][]string{
+			"author": {
+				"only the author can " + action + " the comment",
+			},
+		}})
+	}
+
+	return nil
+}
+
+// nolint: gochecknoglobals
+var (
+	// mentionRegexp matches @mentions that are not part of a word (eg. an email address).
+	mentionRegexp = regexp.MustCompile(`(?:^|[^\w.@])@(\w(?:[\w.-]*\w)?)`)
+
+	codeSpanRegexp = regexp.MustCompile("`[^`]*`")
+)
+
+// parseMentions returns the distinct users mentioned in a Markdown text.
+// Mentions in code are ignored.
+func parseMentions(text string) []string {
+	var (
+		mentions []string
+		fenced   bool
+	)
+
+	seen := make(map[string]bool)
+
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+
+			continue
+		}
+
+		if fenced {
+			continue
+		}
+
+		line = codeSpanRegexp.ReplaceAllString(line, "")
+
+		for _, match := range mentionRegexp.FindAllStringSubmatch(line, -1) {
+			if seen[match[1]] {
+				continue
+			}
+
+			seen[match[1]] = true
+			mentions = append(mentions, match[1])
+		}
+	}
+
+	return mentions
+}
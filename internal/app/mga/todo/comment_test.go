@@ -0,0 +1,167 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func TestService_Comments(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk", Notes: "Whole milk, **not** skimmed"})
+	require.NoError(t, err)
+	assert.Equal(t, "Whole milk, **not** skimmed", item.Notes)
+
+	comment, err := service.AddComment(ctx, item.ID, NewComment{Body: "Which shop?"})
+	require.NoError(t, err)
+	assert.Equal(t, "john", comment.Author)
+	assert.False(t, comment.CreatedAt.IsZero())
+	assert.Nil(t, comment.UpdatedAt)
+
+	_, err = service.AddComment(ctx, item.ID, NewComment{Body: "The one around the corner"})
+	require.NoError(t, err)
+
+	comment, err = service.UpdateComment(ctx, comment.ID, "Which shop is open?")
+	require.NoError(t, err)
+	assert.Equal(t, "Which shop is open?", comment.Body)
+	require.Len(t, comment.Edits, 1, "earlier versions are kept")
+	assert.Equal(t, "Which shop?", comment.Edits[0].Body)
+	require.NotNil(t, comment.UpdatedAt)
+
+	comments, err := service.ListComments(ctx, item.ID)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	assert.Equal(t, comment, comments[0])
+	assert.Equal(t, "The one around the corner", comments[1].Body)
+
+	err = service.DeleteComment(ctx, comments[1].ID)
+	require.NoError(t, err)
+
+	_, err = service.GetComment(ctx, comments[1].ID)
+	assert.True(t, errors.As(err, &CommentNotFoundError{}))
+
+	entries, err := service.ItemHistory(ctx, item.ID)
+	require.NoError(t, err)
+
+	var operations []string
+
+	for _, entry := range entries {
+		operations = append(operations, entry.Operation)
+	}
+
+	assert.Equal(t, []string{"AddItem", "AddComment", "AddComment", "UpdateComment", "DeleteComment"}, operations)
+	assert.Contains(t, entries[0].Changes, FieldChange{Field: "notes", After: "Whole milk, **not** skimmed"})
+	assert.Equal(
+		t,
+		[]FieldChange{{Field: "comment", Before: comment.ID + ": Which shop?", After: comment.ID + ": Which shop is open?"}},
+		entries[3].Changes,
+	)
+}
+
+func TestService_AddComment_Invalid(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	_, err = service.AddComment(ctx, item.ID, NewComment{Body: " \n"})
+	assert.True(t, isValidationError(err))
+
+	_, err = service.AddComment(ctx, "unknown", NewComment{Body: "Hello"})
+	assert.True(t, errors.As(err, &NotFoundError{}))
+}
+
+func TestService_UpdateComment_OtherAuthor(t *testing.T) {
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(context.Background(), NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	comment, err := service.AddComment(principal.ToContext(context.Background(), "john"), item.ID, NewComment{Body: "Hello"})
+	require.NoError(t, err)
+
+	ctx := principal.ToContext(context.Background(), "jane")
+
+	_, err = service.UpdateComment(ctx, comment.ID, "Bye")
+	assert.True(t, isValidationError(err))
+
+	err = service.DeleteComment(ctx, comment.ID)
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_Comments_DeletedItem(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	comment, err := service.AddComment(ctx, item.ID, NewComment{Body: "Hello"})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, item.ID, false)
+	require.NoError(t, err)
+
+	_, err = service.GetComment(ctx, comment.ID)
+	assert.True(t, errors.As(err, &CommentNotFoundError{}), "comments are hidden with their item")
+
+	_, err = service.RestoreItem(ctx, item.ID)
+	require.NoError(t, err)
+
+	_, err = service.GetComment(ctx, comment.ID)
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, item.ID, false)
+	require.NoError(t, err)
+
+	err = service.PurgeItem(ctx, item.ID)
+	require.NoError(t, err)
+
+	_, err = service.RestoreItem(ctx, item.ID)
+	require.Error(t, err)
+
+	_, err = service.GetComment(ctx, comment.ID)
+	assert.True(t, errors.As(err, &CommentNotFoundError{}), "comments are purged with their item")
+}
+
+func TestService_Comments_Mentions(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	comment, err := service.AddComment(ctx, item.ID, NewComment{
+		Body: "@jane can you ask @bob.smith? (cc @john, not `@code` or mail@example.com) @jane",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jane", "bob.smith", "john"}, comment.Mentions)
+
+	assert.Equal(
+		t,
+		[]UserMentioned{
+			{User: "jane", Author: "john", CommentID: comment.ID, ItemID: item.ID, ListID: DefaultListID, Title: "Buy milk"},
+			{User: "bob.smith", Author: "john", CommentID: comment.ID, ItemID: item.ID, ListID: DefaultListID, Title: "Buy milk"},
+		},
+		events.mentioned,
+		"authors mentioning themselves are not notified",
+	)
+
+	events.mentioned = nil
+
+	_, err = service.UpdateComment(ctx, comment.ID, "@jane can you ask @alice?\n```\n@bob\n```")
+	require.NoError(t, err)
+
+	require.Len(t, events.mentioned, 1, "only new mentions are notified")
+	assert.Equal(t, "alice", events.mentioned[0].User)
+}
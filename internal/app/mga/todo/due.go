@@ -0,0 +1,102 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// DueNotification identifies a notification sent about the due date of an item.
+type DueNotification string
+
+// Due date notifications.
+const (
+	DueSoonNotification DueNotification = "due_soon"
+	OverdueNotification DueNotification = "overdue"
+)
+
+// DueItemStore keeps track of the notifications sent about due dates.
+// Notifications of an item are reset when its due date changes.
+type DueItemStore interface {
+	// GetItemsToNotify returns incomplete items due before a point in time
+	// that have not been notified about yet.
+	GetItemsToNotify(ctx context.Context, notification DueNotification, dueBefore time.Time) ([]Item, error)
+
+	// MarkNotified records that a notification has been sent about an item.
+	MarkNotified(ctx context.Context, id string, notification DueNotification) error
+}
+
+// DueItemNotifier dispatches events about items that are due soon or overdue.
+type DueItemNotifier struct {
+	store  DueItemStore
+	events Events
+	window time.Duration
+}
+
+// NewDueItemNotifier returns a new DueItemNotifier.
+// Items due within the window are considered to be due soon.
+func NewDueItemNotifier(store DueItemStore, events Events, window time.Duration) DueItemNotifier {
+	return DueItemNotifier{
+		store:  store,
+		events: events,
+		window: window,
+	}
+}
+
+// Notify dispatches an event for every item that became due soon or overdue since the last run.
+// Each item is notified about at most once per event type and due date.
+func (n DueItemNotifier) Notify(ctx context.Context) error {
+	now := time.Now()
+
+	items, err := n.store.GetItemsToNotify(ctx, OverdueNotification, now)
+	if err != nil {
+		return errors.WithMessage(err, "get overdue items")
+	}
+
+	for _, item := range items {
+		err := n.events.ItemOverdue(ctx, ItemOverdue{
+			ID:       item.ID,
+			ListID:   item.ListID,
+			Title:    item.Title,
+			DueAt:    *item.DueAt,
+			Assignee: item.Assignee,
+		})
+		if err != nil {
+			return errors.WithMessage(err, "notify overdue item")
+		}
+
+		// Overdue items are past the point of being due soon
+		for _, notification := range []DueNotification{OverdueNotification, DueSoonNotification} {
+			err := n.store.MarkNotified(ctx, item.ID, notification)
+			if err != nil {
+				return errors.WithMessage(err, "mark item notified")
+			}
+		}
+	}
+
+	items, err = n.store.GetItemsToNotify(ctx, DueSoonNotification, now.Add(n.window))
+	if err != nil {
+		return errors.WithMessage(err, "get items due soon")
+	}
+
+	for _, item := range items {
+		err := n.events.ItemDueSoon(ctx, ItemDueSoon{
+			ID:       item.ID,
+			ListID:   item.ListID,
+			Title:    item.Title,
+			DueAt:    *item.DueAt,
+			Assignee: item.Assignee,
+		})
+		if err != nil {
+			return errors.WithMessage(err, "notify item due soon")
+		}
+
+		err = n.store.MarkNotified(ctx, item.ID, DueSoonNotification)
+		if err != nil {
+			return errors.WithMessage(err, "mark item notified")
+		}
+	}
+
+	return nil
+}
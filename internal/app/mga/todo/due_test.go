@@ -0,0 +1,133 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+type recordingEvents struct {
+	completed []MarkedAsComplete
+	dueSoon   []ItemDueSoon
+	overdue   []ItemOverdue
+	undone    []OperationUndone
+	redone    []OperationRedone
+	added     []ItemAdded
+	updated   []ItemUpdated
+	deleted   []ItemDeleted
+	mentioned []UserMentioned
+	invited   []CollaboratorInvited
+	assigned  []ItemAssigned
+}
+
+func (e *recordingEvents) MarkedAsComplete(_ context.Context, event MarkedAsComplete) error {
+	e.completed = append(e.completed, event)
+
+	return nil
+}
+
+func (e *recordingEvents) ItemDueSoon(_ context.Context, event ItemDueSoon) error {
+	e.dueSoon = append(e.dueSoon, event)
+
+	return nil
+}
+
+func (e *recordingEvents) ItemOverdue(_ context.Context, event ItemOverdue) error {
+	e.overdue = append(e.overdue, event)
+
+	return nil
+}
+
+func (e *recordingEvents) OperationUndone(_ context.Context, event OperationUndone) error {
+	e.undone = append(e.undone, event)
+
+	return nil
+}
+
+func (e *recordingEvents) OperationRedone(_ context.Context, event OperationRedone) error {
+	e.redone = append(e.redone, event)
+
+	return nil
+}
+
+func (e *recordingEvents) ItemAdded(_ context.Context, event ItemAdded) error {
+	e.added = append(e.added, event)
+
+	return nil
+}
+
+func (e *recordingEvents) ItemUpdated(_ context.Context, event ItemUpdated) error {
+	e.updated = append(e.updated, event)
+
+	return nil
+}
+
+func (e *recordingEvents) ItemDeleted(_ context.Context, event ItemDeleted) error {
+	e.deleted = append(e.deleted, event)
+
+	return nil
+}
+
+func (e *recordingEvents) UserMentioned(_ context.Context, event UserMentioned) error {
+	e.mentioned = append(e.mentioned, event)
+
+	return nil
+}
+
+func (e *recordingEvents) CollaboratorInvited(_ context.Context, event CollaboratorInvited) error {
+	e.invited = append(e.invited, event)
+
+	return nil
+}
+
+func (e *recordingEvents) ItemAssigned(_ context.Context, event ItemAssigned) error {
+	e.assigned = append(e.assigned, event)
+
+	return nil
+}
+
+func TestDueItemNotifier_Notify(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryStore()
+	events := &recordingEvents{}
+
+	now := time.Now()
+	overdue := now.Add(-time.Hour)
+	dueSoon := now.Add(30 * time.Minute)
+	dueLater := now.Add(3 * time.Hour)
+
+	require.NoError(t, store.Store(ctx, Item{ID: "overdue", DueAt: &overdue}))
+	require.NoError(t, store.Store(ctx, Item{ID: "done", Completed: true, DueAt: &overdue}))
+	require.NoError(t, store.Store(ctx, Item{ID: "soon", DueAt: &dueSoon}))
+	require.NoError(t, store.Store(ctx, Item{ID: "later", DueAt: &dueLater}))
+	require.NoError(t, store.Store(ctx, Item{ID: "none"}))
+
+	notifier := NewDueItemNotifier(store, events, time.Hour)
+
+	require.NoError(t, notifier.Notify(ctx))
+
+	require.Len(t, events.overdue, 1)
+	assert.Equal(t, "overdue", events.overdue[0].ID)
+	assert.True(t, overdue.Equal(events.overdue[0].DueAt))
+
+	require.Len(t, events.dueSoon, 1)
+	assert.Equal(t, "soon", events.dueSoon[0].ID)
+
+	// Items are notified only once
+	require.NoError(t, notifier.Notify(ctx))
+
+	assert.Len(t, events.overdue, 1)
+	assert.Len(t, events.dueSoon, 1)
+
+	// Changing the due date resets notifications
+	require.NoError(t, store.Store(ctx, Item{ID: "later", DueAt: &dueSoon}))
+	require.NoError(t, notifier.Notify(ctx))
+
+	require.Len(t, events.dueSoon, 2)
+	assert.Equal(t, "later", events.dueSoon[1].ID)
+}
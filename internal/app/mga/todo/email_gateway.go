@@ -0,0 +1,378 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// emailTitleFallback is the title of items created from emails without a subject and a body.
+const emailTitleFallback = "(no subject)"
+
+// EmailGatewayConfig configures the email gateway.
+type EmailGatewayConfig struct {
+	// Mailboxes map the addresses accepting emails to the list items are added to (DefaultListID if empty).
+	Mailboxes map[string]string
+
+	// Senders map the addresses allowed to send emails to the users owning the items created from them.
+	Senders map[string]string
+
+	// RateLimit is the number of emails a sender can send per RateInterval (unlimited if zero).
+	RateLimit int
+
+	// RateInterval is the period of the rate limit.
+	RateInterval time.Duration
+
+	// Attachments enables storing the attachments of emails (they are skipped otherwise).
+	Attachments bool
+}
+
+// InboundEmail is an email received by the email gateway.
+type InboundEmail struct {
+	// From is the address of the sender.
+	From string
+
+	// To are the addresses of the mailboxes receiving the email.
+	To []string
+
+	Subject     string
+	Body        string
+	Attachments []InboundAttachment
+}
+
+// InboundAttachment is a file attached to an inbound email.
+type InboundAttachment struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// InboundResult is the outcome of receiving an email.
+type InboundResult struct {
+	// Items are the items created from the email (one per list).
+	Items []Item
+
+	// SkippedAttachments are the names of attachments that could not be stored (eg. due to their content type).
+	SkippedAttachments []string
+}
+
+// EmailGateway creates items from emails.
+//
+// Emails are accepted from known senders only: items are added on behalf of the owner of the sender address
+// and assigned to them. The subject of an email becomes the title of the item, the body becomes its notes,
+// attachments are attached to it.
+// Every item of an email is created in a single transaction: an email is either delivered to every mailbox or none of them.
+type EmailGateway struct {
+	service     Service
+	transactor  Transactor
+	events      Events
+	mailboxes   map[string]string
+	senders     map[string]string
+	limiter     *rateLimiter
+	attachments bool
+}
+
+// NewEmailGateway returns a new EmailGateway.
+// Events fired while receiving an email are dispatched to events once the email is delivered.
+func NewEmailGateway(service Service, transactor Transactor, events Events, config EmailGatewayConfig) EmailGateway {
+	gateway := EmailGateway{
+		service:     service,
+		transactor:  transactor,
+		events:      events,
+		mailboxes:   make(map[string]string, len(config.Mailboxes)),
+		senders:     make(map[string]string, len(config.Senders)),
+		attachments: config.Attachments,
+	}
+
+	// Addresses are compared case-insensitively
+	for address, listID := range config.Mailboxes {
+		if listID == "" {
+			listID = DefaultListID
+		}
+
+		gateway.mailboxes[strings.ToLower(address)] = listID
+	}
+
+	for address, owner := range config.Senders {
+		gateway.senders[strings.ToLower(address)] = owner
+	}
+
+	if config.RateLimit > 0 {
+		gateway.limiter = newRateLimiter(config.RateLimit, config.RateInterval)
+	}
+
+	return gateway
+}
+
+// UnknownSenderError is returned if an email comes from an address that is not allowed to send emails.
+type UnknownSenderError struct {
+	Address string
+}
+
+// Error implements the error interface.
+func (UnknownSenderError) Error() string {
+	return "unknown sender"
+}
+
+// Details returns error details.
+func (e UnknownSenderError) Details() []interface{} {
+	return []interface{}{"address", e.Address}
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (UnknownSenderError) ServiceError() bool {
+	return true
+}
+
+// UnknownMailboxError is returned if an email is sent to an address that does not accept emails.
+type UnknownMailboxError struct {
+	Address string
+}
+
+// Error implements the error interface.
+func (UnknownMailboxError) Error() string {
+	return "unknown mailbox"
+}
+
+// Details returns error details.
+func (e UnknownMailboxError) Details() []interface{} {
+	return []interface{}{"address", e.Address}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (UnknownMailboxError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (UnknownMailboxError) ServiceError() bool {
+	return true
+}
+
+// RateLimitError is returned if a sender sends too many emails.
+type RateLimitError struct {
+	Address string
+}
+
+// Error implements the error interface.
+func (RateLimitError) Error() string {
+	return "too many emails"
+}
+
+// Details returns error details.
+func (e RateLimitError) Details() []interface{} {
+	return []interface{}{"address", e.Address}
+}
+
+// Temporary tells a client that the request can be retried later.
+func (RateLimitError) Temporary() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (RateLimitError) ServiceError() bool {
+	return true
+}
+
+// CheckSender checks if an address is allowed to send emails.
+func (g EmailGateway) CheckSender(_ context.Context, address string) error {
+	if _, ok := g.senders[strings.ToLower(address)]; !ok {
+		return errors.WithStack(UnknownSenderError{Address: address})
+	}
+
+	return nil
+}
+
+// CheckMailbox checks if an address accepts emails.
+func (g EmailGateway) CheckMailbox(_ context.Context, address string) error {
+	if _, ok := g.mailboxes[strings.ToLower(address)]; !ok {
+		return errors.WithStack(UnknownMailboxError{Address: address})
+	}
+
+	return nil
+}
+
+// Receive creates an item from an email in the list of every mailbox it's sent to.
+func (g EmailGateway) Receive(ctx context.Context, email InboundEmail) (InboundResult, error) {
+	owner, ok := g.senders[strings.ToLower(email.From)]
+	if !ok {
+		return InboundResult{}, errors.WithStack(UnknownSenderError{Address: email.From})
+	}
+
+	var listIDs []string
+
+	seen := make(map[string]bool)
+
+	for _, address := range email.To {
+		listID, ok := g.mailboxes[strings.ToLower(address)]
+		if !ok {
+			return InboundResult{}, errors.WithStack(UnknownMailboxError{Address: address})
+		}
+
+		if !seen[listID] {
+			seen[listID] = true
+			listIDs = append(listIDs, listID)
+		}
+	}
+
+	if len(listIDs) == 0 {
+		return InboundResult{}, errors.New("email has no recipients")
+	}
+
+	if g.limiter != nil && !g.limiter.allow(strings.ToLower(email.From), time.Now()) {
+		return InboundResult{}, errors.WithStack(RateLimitError{Address: email.From})
+	}
+
+	ctx = principal.ToContext(ctx, owner)
+
+	title, notes := emailItemText(email)
+
+	var result InboundResult
+
+	skipped := make(map[string]bool)
+
+	err := transaction(ctx, g.transactor, g.events, func(ctx context.Context) error {
+		for _, listID := range listIDs {
+			item, err := g.service.AddItem(ctx, NewItem{
+				ListID:   listID,
+				Title:    title,
+				Notes:    notes,
+				Assignee: owner,
+			})
+			if err != nil {
+				return errors.WithMessage(err, "add item from email")
+			}
+
+			result.Items = append(result.Items, item)
+
+			if !g.attachments {
+				continue
+			}
+
+			for _, attachment := range email.Attachments {
+				_, err := g.service.AddAttachment(ctx, item.ID, NewAttachment{
+					Name:        attachment.Name,
+					ContentType: attachment.ContentType,
+					Size:        int64(len(attachment.Content)),
+					Content:     bytes.NewReader(attachment.Content),
+				})
+
+				// Attachments the service refuses don't prevent the item from being created
+				var serr interface{ ServiceError() bool }
+				if errors.As(err, &serr) && serr.ServiceError() {
+					if !skipped[attachment.Name] {
+						skipped[attachment.Name] = true
+						result.SkippedAttachments = append(result.SkippedAttachments, attachment.Name)
+					}
+
+					continue
+				}
+
+				if err != nil {
+					return errors.WithMessage(err, "add attachment from email")
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		// Nothing is kept from an email that cannot be delivered to every mailbox
+		return InboundResult{}, err
+	}
+
+	if !g.attachments {
+		for _, attachment := range email.Attachments {
+			result.SkippedAttachments = append(result.SkippedAttachments, attachment.Name)
+		}
+	}
+
+	return result, nil
+}
+
+// emailItemText returns the title and the notes of an item created from an email.
+// The first line of the body is used as a title if the email has no subject.
+func emailItemText(email InboundEmail) (string, string) {
+	title := strings.Join(strings.Fields(email.Subject), " ")
+	notes := strings.TrimSpace(strings.ReplaceAll(email.Body, "\r\n", "\n"))
+
+	if title == "" && notes != "" {
+		lines := strings.SplitN(notes, "\n", 2)
+
+		title = strings.TrimSpace(lines[0])
+		notes = ""
+
+		if len(lines) > 1 {
+			notes = strings.TrimSpace(lines[1])
+		}
+	}
+
+	if title == "" {
+		title = emailTitleFallback
+	}
+
+	return title, notes
+}
+
+// rateLimiter is a token bucket rate limiter with a bucket per key.
+// Buckets are kept for every key ever seen: keys are expected to come from a bounded set (eg. known senders).
+type rateLimiter struct {
+	limit    float64
+	interval time.Duration
+
+	mu      sync.Mutex
+	buckets map[string]rateBucket
+}
+
+type rateBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+func newRateLimiter(limit int, interval time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:    float64(limit),
+		interval: interval,
+		buckets:  make(map[string]rateBucket),
+	}
+}
+
+// allow takes a token from the bucket of a key if there is any left.
+func (l *rateLimiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = rateBucket{tokens: l.limit, updatedAt: now}
+	}
+
+	// Buckets refill continuously: a full bucket takes an interval to refill
+	if elapsed := now.Sub(bucket.updatedAt); elapsed > 0 && l.interval > 0 {
+		bucket.tokens += l.limit * float64(elapsed) / float64(l.interval)
+		if bucket.tokens > l.limit {
+			bucket.tokens = l.limit
+		}
+	}
+
+	bucket.updatedAt = now
+
+	allowed := bucket.tokens >= 1
+	if allowed {
+		bucket.tokens--
+	}
+
+	l.buckets[key] = bucket
+
+	return allowed
+}
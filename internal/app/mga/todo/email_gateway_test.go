@@ -0,0 +1,182 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func TestEmailGateway_Receive(t *testing.T) {
+	service, store, _ := newAttachmentService(t)
+
+	list, err := service.CreateList(principal.ToContext(context.Background(), "john"), NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	gateway := NewEmailGateway(service, store, nil, EmailGatewayConfig{
+		Mailboxes: map[string]string{
+			"Todo@example.com":      "",
+			"groceries@example.com": list.ID,
+		},
+		Senders:     map[string]string{"john@example.com": "john"},
+		Attachments: true,
+	})
+
+	ctx := context.Background()
+
+	result, err := gateway.Receive(ctx, InboundEmail{
+		From:    "John@Example.com",
+		To:      []string{"todo@example.com", "groceries@example.com"},
+		Subject: "  Buy   milk ",
+		Body:    "Two liters.\r\n\r\nThe organic one.\r\n",
+		Attachments: []InboundAttachment{
+			{Name: "list.txt", ContentType: "text/plain", Content: []byte("milk")},
+			{Name: "list.pdf", ContentType: "application/pdf", Content: []byte("%PDF")},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, []string{"list.pdf"}, result.SkippedAttachments)
+
+	assert.Equal(t, DefaultListID, result.Items[0].ListID)
+	assert.Equal(t, list.ID, result.Items[1].ListID)
+
+	for _, item := range result.Items {
+		assert.Equal(t, "Buy milk", item.Title)
+		assert.Equal(t, "Two liters.\n\nThe organic one.", item.Notes)
+		assert.Equal(t, "john", item.Assignee)
+
+		attachments, err := service.ListAttachments(principal.ToContext(ctx, "john"), item.ID)
+		require.NoError(t, err)
+		require.Len(t, attachments, 1)
+		assert.Equal(t, "list.txt", attachments[0].Name)
+	}
+}
+
+func TestEmailGateway_Receive_NoSubject(t *testing.T) {
+	service, store, _ := newAttachmentService(t)
+
+	gateway := NewEmailGateway(service, store, nil, EmailGatewayConfig{
+		Mailboxes: map[string]string{"todo@example.com": ""},
+		Senders:   map[string]string{"john@example.com": "john"},
+	})
+
+	ctx := context.Background()
+
+	result, err := gateway.Receive(ctx, InboundEmail{
+		From: "john@example.com",
+		To:   []string{"todo@example.com"},
+		Body: "\n Mow the lawn \nIt's getting long.",
+		Attachments: []InboundAttachment{
+			{Name: "lawn.txt", ContentType: "text/plain", Content: []byte("grass")},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "Mow the lawn", result.Items[0].Title)
+	assert.Equal(t, "It's getting long.", result.Items[0].Notes)
+	assert.Equal(t, []string{"lawn.txt"}, result.SkippedAttachments, "attachments are skipped if disabled")
+
+	result, err = gateway.Receive(ctx, InboundEmail{
+		From: "john@example.com",
+		To:   []string{"todo@example.com"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "(no subject)", result.Items[0].Title)
+	assert.Empty(t, result.Items[0].Notes)
+}
+
+func TestEmailGateway_Receive_Rollback(t *testing.T) {
+	events := &recordingEvents{}
+	service, store := newTestService(testServiceConfig{Events: events})
+
+	gateway := NewEmailGateway(service, store, events, EmailGatewayConfig{
+		Mailboxes: map[string]string{
+			"todo@example.com":  "",
+			"lists@example.com": "missing",
+		},
+		Senders: map[string]string{"john@example.com": "john"},
+	})
+
+	ctx := context.Background()
+
+	_, err := gateway.Receive(ctx, InboundEmail{
+		From:    "john@example.com",
+		To:      []string{"todo@example.com", "lists@example.com"},
+		Subject: "Buy milk",
+	})
+	require.Error(t, err)
+
+	items, err := service.ListItems(principal.ToContext(ctx, "john"), ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items, "an email is delivered to every mailbox or none of them")
+	assert.Empty(t, events.added)
+}
+
+func TestEmailGateway_UnknownAddresses(t *testing.T) {
+	service, store, _ := newAttachmentService(t)
+
+	gateway := NewEmailGateway(service, store, nil, EmailGatewayConfig{
+		Mailboxes: map[string]string{"todo@example.com": ""},
+		Senders:   map[string]string{"john@example.com": "john"},
+	})
+
+	ctx := context.Background()
+
+	require.NoError(t, gateway.CheckSender(ctx, "JOHN@example.com"))
+	require.NoError(t, gateway.CheckMailbox(ctx, "todo@EXAMPLE.com"))
+
+	err := gateway.CheckSender(ctx, "joe@example.com")
+	assert.True(t, errors.As(err, &UnknownSenderError{}))
+
+	err = gateway.CheckMailbox(ctx, "lists@example.com")
+	assert.True(t, errors.As(err, &UnknownMailboxError{}))
+
+	_, err = gateway.Receive(ctx, InboundEmail{From: "joe@example.com", To: []string{"todo@example.com"}, Subject: "Hi"})
+	assert.True(t, errors.As(err, &UnknownSenderError{}))
+
+	_, err = gateway.Receive(ctx, InboundEmail{From: "john@example.com", To: []string{"lists@example.com"}, Subject: "Hi"})
+	assert.True(t, errors.As(err, &UnknownMailboxError{}))
+
+	items, err := service.ListItems(principal.ToContext(ctx, "john"), ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestEmailGateway_RateLimit(t *testing.T) {
+	service, store, _ := newAttachmentService(t)
+
+	gateway := NewEmailGateway(service, store, nil, EmailGatewayConfig{
+		Mailboxes:    map[string]string{"todo@example.com": ""},
+		Senders:      map[string]string{"john@example.com": "john", "jane@example.com": "jane"},
+		RateLimit:    2,
+		RateInterval: time.Hour,
+	})
+
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := gateway.Receive(ctx, InboundEmail{From: "john@example.com", To: []string{"todo@example.com"}, Subject: "Hi"})
+		require.NoError(t, err)
+	}
+
+	_, err := gateway.Receive(ctx, InboundEmail{From: "john@example.com", To: []string{"todo@example.com"}, Subject: "Hi"})
+
+	var rerr RateLimitError
+	require.True(t, errors.As(err, &rerr))
+	assert.True(t, rerr.Temporary())
+
+	// Senders are limited separately
+	_, err = gateway.Receive(ctx, InboundEmail{From: "jane@example.com", To: []string{"todo@example.com"}, Subject: "Hi"})
+	require.NoError(t, err)
+}
@@ -2,6 +2,8 @@ package todo
 
 import (
 	"context"
+	"strings"
+	"time"
 )
 
 // LogEventHandler handles todo events and logs them.
@@ -27,3 +29,144 @@ func (h LogEventHandler) MarkedAsComplete(ctx context.Context, event MarkedAsCom
 
 	return nil
 }
+
+// ItemDueSoon logs an ItemDueSoon event.
+func (h LogEventHandler) ItemDueSoon(ctx context.Context, event ItemDueSoon) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("todo is due soon", map[string]interface{}{
+		"event":   "ItemDueSoon",
+		"todo_id": event.ID,
+		"due_at":  event.DueAt.Format(time.RFC3339),
+	})
+
+	return nil
+}
+
+// ItemOverdue logs an ItemOverdue event.
+func (h LogEventHandler) ItemOverdue(ctx context.Context, event ItemOverdue) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("todo is overdue", map[string]interface{}{
+		"event":   "ItemOverdue",
+		"todo_id": event.ID,
+		"due_at":  event.DueAt.Format(time.RFC3339),
+	})
+
+	return nil
+}
+
+// OperationUndone logs an OperationUndone event.
+func (h LogEventHandler) OperationUndone(ctx context.Context, event OperationUndone) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("operation undone", map[string]interface{}{
+		"event":        "OperationUndone",
+		"operation_id": event.ID,
+		"operation":    event.Operation,
+		"todo_ids":     strings.Join(event.ItemIDs, ","),
+	})
+
+	return nil
+}
+
+// OperationRedone logs an OperationRedone event.
+func (h LogEventHandler) OperationRedone(ctx context.Context, event OperationRedone) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("operation redone", map[string]interface{}{
+		"event":        "OperationRedone",
+		"operation_id": event.ID,
+		"operation":    event.Operation,
+		"todo_ids":     strings.Join(event.ItemIDs, ","),
+	})
+
+	return nil
+}
+
+// ItemAdded logs an ItemAdded event.
+func (h LogEventHandler) ItemAdded(ctx context.Context, event ItemAdded) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("todo added", map[string]interface{}{
+		"event":   "ItemAdded",
+		"todo_id": event.ID,
+		"list_id": event.ListID,
+	})
+
+	return nil
+}
+
+// ItemUpdated logs an ItemUpdated event.
+func (h LogEventHandler) ItemUpdated(ctx context.Context, event ItemUpdated) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("todo updated", map[string]interface{}{
+		"event":   "ItemUpdated",
+		"todo_id": event.ID,
+		"list_id": event.ListID,
+	})
+
+	return nil
+}
+
+// ItemDeleted logs an ItemDeleted event.
+func (h LogEventHandler) ItemDeleted(ctx context.Context, event ItemDeleted) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("todo deleted", map[string]interface{}{
+		"event":   "ItemDeleted",
+		"todo_id": event.ID,
+		"list_id": event.ListID,
+	})
+
+	return nil
+}
+
+// UserMentioned logs a UserMentioned event.
+func (h LogEventHandler) UserMentioned(ctx context.Context, event UserMentioned) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("user mentioned", map[string]interface{}{
+		"event":      "UserMentioned",
+		"user":       event.User,
+		"author":     event.Author,
+		"comment_id": event.CommentID,
+		"todo_id":    event.ItemID,
+		"list_id":    event.ListID,
+	})
+
+	return nil
+}
+
+// CollaboratorInvited logs a CollaboratorInvited event.
+func (h LogEventHandler) CollaboratorInvited(ctx context.Context, event CollaboratorInvited) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("collaborator invited", map[string]interface{}{
+		"event":           "CollaboratorInvited",
+		"collaborator_id": event.ID,
+		"list_id":         event.ListID,
+		"email":           event.Email,
+		"role":            string(event.Role),
+		"invited_by":      event.InvitedBy,
+	})
+
+	return nil
+}
+
+// ItemAssigned logs an ItemAssigned event.
+func (h LogEventHandler) ItemAssigned(ctx context.Context, event ItemAssigned) error {
+	logger := h.logger.WithContext(ctx)
+
+	logger.Info("todo assigned", map[string]interface{}{
+		"event":             "ItemAssigned",
+		"todo_id":           event.ID,
+		"list_id":           event.ListID,
+		"assignee":          event.Assignee,
+		"previous_assignee": event.PreviousAssignee,
+		"assigned_by":       event.AssignedBy,
+	})
+
+	return nil
+}
@@ -0,0 +1,849 @@
+package todo
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// +mga:event:dispatcher
+
+// Events dispatches todo events.
+type Events interface {
+	// MarkedAsComplete dispatches a MarkedAsComplete event.
+	MarkedAsComplete(ctx context.Context, event MarkedAsComplete) error
+
+	// ItemDueSoon dispatches an ItemDueSoon event.
+	ItemDueSoon(ctx context.Context, event ItemDueSoon) error
+
+	// ItemOverdue dispatches an ItemOverdue event.
+	ItemOverdue(ctx context.Context, event ItemOverdue) error
+
+	// OperationUndone dispatches an OperationUndone event.
+	OperationUndone(ctx context.Context, event OperationUndone) error
+
+	// OperationRedone dispatches an OperationRedone event.
+	OperationRedone(ctx context.Context, event OperationRedone) error
+
+	// ItemAdded dispatches an ItemAdded event.
+	ItemAdded(ctx context.Context, event ItemAdded) error
+
+	// ItemUpdated dispatches an ItemUpdated event.
+	ItemUpdated(ctx context.Context, event ItemUpdated) error
+
+	// ItemDeleted dispatches an ItemDeleted event.
+	ItemDeleted(ctx context.Context, event ItemDeleted) error
+
+	// UserMentioned dispatches a UserMentioned event.
+	UserMentioned(ctx context.Context, event UserMentioned) error
+
+	// CollaboratorInvited dispatches a CollaboratorInvited event.
+	CollaboratorInvited(ctx context.Context, event CollaboratorInvited) error
+
+	// ItemAssigned dispatches an ItemAssigned event.
+	ItemAssigned(ctx context.Context, event ItemAssigned) error
+}
+
+// +mga:event:handler
+
+// MarkedAsComplete event is triggered when an item gets marked as complete.
+type MarkedAsComplete struct {
+	ID       string
+	ListID   string
+	Title    string
+	Assignee string
+
+	// CompletedBy is the user who completed the item (empty if it got completed automatically or anonymously).
+	CompletedBy string
+}
+
+// +mga:event:handler
+
+// ItemDueSoon event is triggered when an incomplete item is about to be due.
+type ItemDueSoon struct {
+	ID       string
+	ListID   string
+	Title    string
+	DueAt    time.Time
+	Assignee string
+}
+
+// +mga:event:handler
+
+// ItemOverdue event is triggered when an incomplete item is past its due date.
+type ItemOverdue struct {
+	ID       string
+	ListID   string
+	Title    string
+	DueAt    time.Time
+	Assignee string
+}
+
+// +mga:event:handler
+
+// OperationUndone event is triggered when an operation gets undone.
+type OperationUndone struct {
+	ID        string
+	Operation string
+	ItemIDs   []string
+}
+
+// +mga:event:handler
+
+// OperationRedone event is triggered when an undone operation gets redone.
+type OperationRedone struct {
+	ID        string
+	Operation string
+	ItemIDs   []string
+}
+
+// +mga:event:handler
+
+// ItemAdded event is triggered when an item gets added (or restored from the trash).
+type ItemAdded struct {
+	ID     string
+	ListID string
+}
+
+// +mga:event:handler
+
+// ItemUpdated event is triggered when an item gets changed (including its tags).
+type ItemUpdated struct {
+	ID     string
+	ListID string
+}
+
+// +mga:event:handler
+
+// ItemDeleted event is triggered when an item gets moved to the trash (or permanently deleted from it).
+type ItemDeleted struct {
+	ID     string
+	ListID string
+}
+
+// +mga:event:handler
+
+// UserMentioned event is triggered when a user gets mentioned in a comment of an item.
+type UserMentioned struct {
+	User      string
+	Author    string
+	CommentID string
+	ItemID    string
+	ListID    string
+	Title     string
+}
+
+// +mga:event:handler
+
+// CollaboratorInvited event is triggered when a collaborator gets invited to a list (or their role changes).
+type CollaboratorInvited struct {
+	ID        string
+	ListID    string
+	Email     string
+	Role      CollaboratorRole
+	InvitedBy string
+}
+
+// +mga:event:handler
+
+// ItemAssigned event is triggered when an item gets assigned to a user (including reassignments).
+type ItemAssigned struct {
+	ID               string
+	ListID           string
+	Title            string
+	Assignee         string
+	PreviousAssignee string
+	AssignedBy       string
+}
+
+// EventMiddleware fires todo events.
+func EventMiddleware(store TrashStore, events Events) Middleware {
+	return func(next Service) Service {
+		return eventMiddleware{
+			Service: DefaultMiddleware{Service: next},
+			next:    next,
+
+			store:  store,
+			events: events,
+		}
+	}
+}
+
+type eventMiddleware struct {
+	Service
+	next Service
+
+	store  TrashStore
+	events Events
+}
+
+// eventsFor returns the events of a context: events fired in a transaction are queued until it's committed.
+func (mw eventMiddleware) eventsFor(ctx context.Context) Events {
+	if queue, ok := ctx.Value(eventQueueKey{}).(*eventQueue); ok {
+		return queue
+	}
+
+	return mw.events
+}
+
+func (mw eventMiddleware) AddItem(ctx context.Context, newItem NewItem) (Item, error) {
+	item, err := mw.next.AddItem(ctx, newItem)
+	if err != nil {
+		return item, err
+	}
+
+	err = mw.eventsFor(ctx).ItemAdded(ctx, ItemAdded{ID: item.ID, ListID: item.ListID})
+	if err != nil {
+		return item, errors.WithMessage(err, "add item")
+	}
+
+	return item, errors.WithMessage(mw.itemAssigned(ctx, item, ""), "add item")
+}
+
+func (mw eventMiddleware) UpdateItem(ctx context.Context, id string, itemUpdate ItemUpdate) (Item, error) {
+	var fireComplete bool
+	if itemUpdate.Completed != nil && *itemUpdate.Completed {
+		fireComplete = true
+	}
+
+	item, err := mw.next.UpdateItem(ctx, id, itemUpdate)
+	if err != nil {
+		return item, err
+	}
+
+	if fireComplete {
+		err = mw.eventsFor(ctx).MarkedAsComplete(ctx, newMarkedAsComplete(ctx, item))
+		if err != nil {
+			// TODO: rollback item store here? retry?
+			return item, errors.WithMessage(err, "mark item as complete")
+		}
+	}
+
+	err = mw.eventsFor(ctx).ItemUpdated(ctx, ItemUpdated{ID: item.ID, ListID: item.ListID})
+	if err != nil {
+		return item, errors.WithMessage(err, "update item")
+	}
+
+	return item, nil
+}
+
+func (mw eventMiddleware) TagItem(ctx context.Context, id string, tags []string) (Item, error) {
+	item, err := mw.next.TagItem(ctx, id, tags)
+	if err != nil {
+		return item, err
+	}
+
+	err = mw.eventsFor(ctx).ItemUpdated(ctx, ItemUpdated{ID: item.ID, ListID: item.ListID})
+	if err != nil {
+		return item, errors.WithMessage(err, "tag item")
+	}
+
+	return item, nil
+}
+
+func (mw eventMiddleware) UntagItem(ctx context.Context, id string, tags []string) (Item, error) {
+	item, err := mw.next.UntagItem(ctx, id, tags)
+	if err != nil {
+		return item, err
+	}
+
+	err = mw.eventsFor(ctx).ItemUpdated(ctx, ItemUpdated{ID: item.ID, ListID: item.ListID})
+	if err != nil {
+		return item, errors.WithMessage(err, "untag item")
+	}
+
+	return item, nil
+}
+
+func (mw eventMiddleware) AssignItem(ctx context.Context, id string, assignee string) (Item, error) {
+	before, err := mw.next.GetItem(ctx, id)
+	if err != nil {
+		return before, err
+	}
+
+	item, err := mw.next.AssignItem(ctx, id, assignee)
+	if err != nil {
+		return item, err
+	}
+
+	if item.Assignee == before.Assignee {
+		return item, nil
+	}
+
+	err = mw.itemAssigned(ctx, item, before.Assignee)
+	if err != nil {
+		return item, errors.WithMessage(err, "assign item")
+	}
+
+	err = mw.eventsFor(ctx).ItemUpdated(ctx, ItemUpdated{ID: item.ID, ListID: item.ListID})
+	if err != nil {
+		return item, errors.WithMessage(err, "assign item")
+	}
+
+	return item, nil
+}
+
+func (mw eventMiddleware) UnassignItem(ctx context.Context, id string) (Item, error) {
+	before, err := mw.next.GetItem(ctx, id)
+	if err != nil {
+		return before, err
+	}
+
+	item, err := mw.next.UnassignItem(ctx, id)
+	if err != nil {
+		return item, err
+	}
+
+	if before.Assignee == "" {
+		return item, nil
+	}
+
+	err = mw.eventsFor(ctx).ItemUpdated(ctx, ItemUpdated{ID: item.ID, ListID: item.ListID})
+	if err != nil {
+		return item, errors.WithMessage(err, "unassign item")
+	}
+
+	return item, nil
+}
+
+// itemAssigned fires an ItemAssigned event if the item is assigned to someone.
+func (mw eventMiddleware) itemAssigned(ctx context.Context, item Item, previousAssignee string) error {
+	if item.Assignee == "" {
+		return nil
+	}
+
+	assignedBy, _ := principal.FromContext(ctx)
+
+	event := ItemAssigned{
+		ID:               item.ID,
+		ListID:           item.ListID,
+		Title:            item.Title,
+		Assignee:         item.Assignee,
+		PreviousAssignee: previousAssignee,
+		AssignedBy:       assignedBy,
+	}
+
+	return mw.eventsFor(ctx).ItemAssigned(ctx, event)
+}
+
+func (mw eventMiddleware) DeleteItems(ctx context.Context, listID string) error {
+	items, err := mw.next.ListItems(ctx, ItemFilter{ListID: listID})
+	if err != nil {
+		return err
+	}
+
+	err = mw.next.DeleteItems(ctx, listID)
+	if err != nil {
+		return err
+	}
+
+	return errors.WithMessage(mw.itemsDeleted(ctx, items), "delete items")
+}
+
+func (mw eventMiddleware) DeleteItem(ctx context.Context, id string, cascade bool) error {
+	item, err := mw.next.GetItem(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	items := []Item{item}
+
+	// Subtasks deleted together with the item are reported as well
+	if cascade {
+		trees, err := mw.next.ListItemTree(ctx, ItemFilter{ListID: item.ListID, ParentID: id}, 0)
+		if err != nil {
+			return err
+		}
+
+		items = append(items, flattenItemTrees(trees)...)
+	}
+
+	err = mw.next.DeleteItem(ctx, id, cascade)
+	if err != nil {
+		return err
+	}
+
+	return errors.WithMessage(mw.itemsDeleted(ctx, items), "delete item")
+}
+
+// itemsDeleted fires ItemDeleted events for deleted items.
+func (mw eventMiddleware) itemsDeleted(ctx context.Context, items []Item) error {
+	for _, item := range items {
+		err := mw.eventsFor(ctx).ItemDeleted(ctx, ItemDeleted{ID: item.ID, ListID: item.ListID})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (mw eventMiddleware) MoveItem(ctx context.Context, id string, move ItemMove) (Item, error) {
+	item, err := mw.next.MoveItem(ctx, id, move)
+	if err != nil {
+		return item, err
+	}
+
+	err = mw.eventsFor(ctx).ItemUpdated(ctx, ItemUpdated{ID: item.ID, ListID: item.ListID})
+	if err != nil {
+		return item, errors.WithMessage(err, "move item")
+	}
+
+	return item, nil
+}
+
+func (mw eventMiddleware) RestoreItem(ctx context.Context, id string) (Item, error) {
+	item, err := mw.next.RestoreItem(ctx, id)
+	if err != nil {
+		return item, err
+	}
+
+	// Subtasks are restored together with the item
+	trees, err := mw.next.ListItemTree(ctx, ItemFilter{ListID: item.ListID, ParentID: item.ID}, 0)
+	if err != nil {
+		return item, errors.WithMessage(err, "restore item")
+	}
+
+	for _, i := range append([]Item{item}, flattenItemTrees(trees)...) {
+		err := mw.eventsFor(ctx).ItemAdded(ctx, ItemAdded{ID: i.ID, ListID: i.ListID})
+		if err != nil {
+			return item, errors.WithMessage(err, "restore item")
+		}
+	}
+
+	return item, nil
+}
+
+func (mw eventMiddleware) PurgeItem(ctx context.Context, id string) error {
+	items, err := mw.deletedItemTree(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = mw.next.PurgeItem(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return errors.WithMessage(mw.itemsDeleted(ctx, items), "purge item")
+}
+
+func (mw eventMiddleware) EmptyTrash(ctx context.Context, listID string) error {
+	items, err := mw.next.ListTrash(ctx, listID)
+	if err != nil {
+		return err
+	}
+
+	err = mw.next.EmptyTrash(ctx, listID)
+	if err != nil {
+		return err
+	}
+
+	return errors.WithMessage(mw.itemsDeleted(ctx, items), "empty trash")
+}
+
+// deletedItemTree returns an item in the trash and its subtasks in the trash.
+// Unknown items are left for the service to report.
+func (mw eventMiddleware) deletedItemTree(ctx context.Context, id string) ([]Item, error) {
+	item, err := mw.store.GetOneDeleted(ctx, id)
+	if errors.As(err, &NotFoundError{}) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	subtasks, err := deletedSubtasks(ctx, mw.store, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]Item{item}, subtasks...), nil
+}
+
+func (mw eventMiddleware) AddComment(ctx context.Context, itemID string, newComment NewComment) (Comment, error) {
+	comment, err := mw.next.AddComment(ctx, itemID, newComment)
+	if err != nil {
+		return comment, err
+	}
+
+	return comment, errors.WithMessage(mw.usersMentioned(ctx, comment, nil), "add comment")
+}
+
+func (mw eventMiddleware) UpdateComment(ctx context.Context, id string, body string) (Comment, error) {
+	before, err := mw.next.GetComment(ctx, id)
+	if err != nil {
+		return before, err
+	}
+
+	comment, err := mw.next.UpdateComment(ctx, id, body)
+	if err != nil {
+		return comment, err
+	}
+
+	return comment, errors.WithMessage(mw.usersMentioned(ctx, comment, before.Mentions), "update comment")
+}
+
+func (mw eventMiddleware) InviteCollaborator(
+	ctx context.Context,
+	listID string,
+	invitation Invitation,
+) (Collaborator, error) {
+	collaborator, err := mw.next.InviteCollaborator(ctx, listID, invitation)
+	if err != nil {
+		return collaborator, err
+	}
+
+	invitedBy, _ := principal.FromContext(ctx)
+
+	event := CollaboratorInvited{
+		ID:        collaborator.ID,
+		ListID:    collaborator.ListID,
+		Email:     collaborator.Email,
+		Role:      collaborator.Role,
+		InvitedBy: invitedBy,
+	}
+
+	return collaborator, errors.WithMessage(mw.eventsFor(ctx).CollaboratorInvited(ctx, event), "invite collaborator")
+}
+
+// usersMentioned fires UserMentioned events for the users mentioned in a comment.
+// Users mentioned earlier and the author mentioning themselves are skipped.
+func (mw eventMiddleware) usersMentioned(ctx context.Context, comment Comment, mentionedBefore []string) error {
+	skip := map[string]bool{comment.Author: true}
+
+	for _, user := range mentionedBefore {
+		skip[user] = true
+	}
+
+	var item *Item
+
+	for _, user := range comment.Mentions {
+		if skip[user] {
+			continue
+		}
+
+		if item == nil {
+			i, err := mw.next.GetItem(ctx, comment.ItemID)
+			if err != nil {
+				return err
+			}
+
+			item = &i
+		}
+
+		event := UserMentioned{
+			User:      user,
+			Author:    comment.Author,
+			CommentID: comment.ID,
+			ItemID:    item.ID,
+			ListID:    item.ListID,
+			Title:     item.Title,
+		}
+
+		err := mw.eventsFor(ctx).UserMentioned(ctx, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (mw eventMiddleware) Undo(ctx context.Context, steps int) ([]Operation, error) {
+	operations, err := mw.next.Undo(ctx, steps)
+	if err != nil {
+		return operations, err
+	}
+
+	for _, operation := range operations {
+		event := OperationUndone{
+			ID:        operation.ID,
+			Operation: operation.Name,
+			ItemIDs:   operation.ItemIDs(),
+		}
+
+		err = mw.eventsFor(ctx).OperationUndone(ctx, event)
+		if err != nil {
+			return operations, errors.WithMessage(err, "undo")
+		}
+
+		err = mw.markedAsComplete(ctx, operation, true)
+		if err != nil {
+			return operations, errors.WithMessage(err, "undo")
+		}
+
+		err = mw.itemsChanged(ctx, operation, true)
+		if err != nil {
+			return operations, errors.WithMessage(err, "undo")
+		}
+	}
+
+	return operations, nil
+}
+
+func (mw eventMiddleware) Redo(ctx context.Context, steps int) ([]Operation, error) {
+	operations, err := mw.next.Redo(ctx, steps)
+	if err != nil {
+		return operations, err
+	}
+
+	for _, operation := range operations {
+		event := OperationRedone{
+			ID:        operation.ID,
+			Operation: operation.Name,
+			ItemIDs:   operation.ItemIDs(),
+		}
+
+		err = mw.eventsFor(ctx).OperationRedone(ctx, event)
+		if err != nil {
+			return operations, errors.WithMessage(err, "redo")
+		}
+
+		err = mw.markedAsComplete(ctx, operation, false)
+		if err != nil {
+			return operations, errors.WithMessage(err, "redo")
+		}
+
+		err = mw.itemsChanged(ctx, operation, false)
+		if err != nil {
+			return operations, errors.WithMessage(err, "redo")
+		}
+	}
+
+	return operations, nil
+}
+
+// markedAsComplete fires MarkedAsComplete events for items an undone (or redone) operation completes.
+func (mw eventMiddleware) markedAsComplete(ctx context.Context, operation Operation, undo bool) error {
+	for _, change := range operation.Changes {
+		from, to := change.Before, change.After
+		if undo {
+			from, to = to, from
+		}
+
+		if to == nil || !to.Completed || (from != nil && from.Completed) {
+			continue
+		}
+
+		err := mw.eventsFor(ctx).MarkedAsComplete(ctx, newMarkedAsComplete(ctx, *to))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// itemsChanged fires item events for the items an undone (or redone) operation changes:
+// items coming back from the trash are added, items moved to the trash are deleted.
+func (mw eventMiddleware) itemsChanged(ctx context.Context, operation Operation, undo bool) error {
+	for _, change := range operation.Changes {
+		from, to := change.Before, change.After
+		if undo {
+			from, to = to, from
+		}
+
+		var err error
+
+		switch {
+		case to == nil:
+			err = mw.eventsFor(ctx).ItemDeleted(ctx, ItemDeleted{ID: from.ID, ListID: from.ListID})
+
+		case from == nil:
+			err = mw.eventsFor(ctx).ItemAdded(ctx, ItemAdded{ID: to.ID, ListID: to.ListID})
+
+		default:
+			err = mw.eventsFor(ctx).ItemUpdated(ctx, ItemUpdated{ID: to.ID, ListID: to.ListID})
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newMarkedAsComplete returns the MarkedAsComplete event of an item completed by the user making the request.
+func newMarkedAsComplete(ctx context.Context, item Item) MarkedAsComplete {
+	completedBy, _ := principal.FromContext(ctx)
+
+	return MarkedAsComplete{
+		ID:          item.ID,
+		ListID:      item.ListID,
+		Title:       item.Title,
+		Assignee:    item.Assignee,
+		CompletedBy: completedBy,
+	}
+}
+
+type eventQueueKey struct{}
+
+// withEventQueue returns a context collecting the events fired in it instead of dispatching them.
+func withEventQueue(ctx context.Context) (context.Context, *eventQueue) {
+	queue := &eventQueue{}
+
+	return context.WithValue(ctx, eventQueueKey{}, queue), queue
+}
+
+// transaction runs a function in a transaction and dispatches the events fired in it once it's committed.
+// In a transaction already collecting events, the events are left to that transaction.
+func transaction(ctx context.Context, transactor Transactor, events Events, fn func(ctx context.Context) error) error {
+	if queue, ok := ctx.Value(eventQueueKey{}).(*eventQueue); ok {
+		hooks := len(queue.hooks)
+
+		err := transactor.Transaction(ctx, fn)
+		if err != nil {
+			// The changes of a failed nested transaction are rolled back even if the outer one is committed
+			queue.end(ctx, hooks, false)
+		}
+
+		return err
+	}
+
+	txCtx, queue := withEventQueue(ctx)
+
+	err := transactor.Transaction(txCtx, fn)
+
+	queue.end(ctx, 0, err == nil)
+
+	if err != nil {
+		return err
+	}
+
+	return queue.dispatch(ctx, events)
+}
+
+// afterTransaction runs a function once the transaction of a context ends
+// (committed tells whether the changes made in the transaction are kept).
+// Outside of transactions the function runs right away.
+func afterTransaction(ctx context.Context, fn func(ctx context.Context, committed bool)) {
+	queue, ok := ctx.Value(eventQueueKey{}).(*eventQueue)
+	if !ok {
+		fn(ctx, true)
+
+		return
+	}
+
+	queue.hooks = append(queue.hooks, fn)
+}
+
+// eventQueue collects events to be dispatched later
+// (and functions to run when the transaction collecting them ends).
+// An event fired several times (eg. updating the same item twice) is dispatched once.
+type eventQueue struct {
+	events []queuedEvent
+	hooks  []func(ctx context.Context, committed bool)
+}
+
+// end runs the functions waiting for the end of the transaction, starting from a position.
+func (q *eventQueue) end(ctx context.Context, from int, committed bool) {
+	hooks := append([]func(ctx context.Context, committed bool){}, q.hooks[from:]...)
+	q.hooks = q.hooks[:from]
+
+	for _, hook := range hooks {
+		hook(ctx, committed)
+	}
+}
+
+type queuedEvent struct {
+	event    interface{}
+	dispatch func(ctx context.Context, events Events) error
+}
+
+func (q *eventQueue) add(event interface{}, dispatch func(ctx context.Context, events Events) error) {
+	for _, e := range q.events {
+		if reflect.DeepEqual(e.event, event) {
+			return
+		}
+	}
+
+	q.events = append(q.events, queuedEvent{event: event, dispatch: dispatch})
+}
+
+// dispatch dispatches the collected events in order (if there is anything to dispatch them to).
+func (q *eventQueue) dispatch(ctx context.Context, events Events) error {
+	if events == nil {
+		return nil
+	}
+
+	for _, e := range q.events {
+		err := e.dispatch(ctx, events)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (q *eventQueue) MarkedAsComplete(_ context.Context, event MarkedAsComplete) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.MarkedAsComplete(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) ItemDueSoon(_ context.Context, event ItemDueSoon) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.ItemDueSoon(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) ItemOverdue(_ context.Context, event ItemOverdue) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.ItemOverdue(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) OperationUndone(_ context.Context, event OperationUndone) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.OperationUndone(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) OperationRedone(_ context.Context, event OperationRedone) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.OperationRedone(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) ItemAdded(_ context.Context, event ItemAdded) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.ItemAdded(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) ItemUpdated(_ context.Context, event ItemUpdated) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.ItemUpdated(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) ItemDeleted(_ context.Context, event ItemDeleted) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.ItemDeleted(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) UserMentioned(_ context.Context, event UserMentioned) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.UserMentioned(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) CollaboratorInvited(_ context.Context, event CollaboratorInvited) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.CollaboratorInvited(ctx, event) })
+
+	return nil
+}
+
+func (q *eventQueue) ItemAssigned(_ context.Context, event ItemAssigned) error {
+	q.add(event, func(ctx context.Context, events Events) error { return events.ItemAssigned(ctx, event) })
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package todo
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// Format is a file format items are exported to and imported from.
+type Format string
+
+// Supported formats.
+const (
+	// FormatJSON is a JSON array of items.
+	FormatJSON Format = "json"
+
+	// FormatCSV is a CSV file with a header row.
+	FormatCSV Format = "csv"
+
+	// FormatTodoTxt is the todo.txt format (http://todotxt.org).
+	FormatTodoTxt Format = "todotxt"
+
+	// FormatICalendar is an iCalendar file of VTODO components (RFC 5545).
+	FormatICalendar Format = "ical"
+)
+
+func (f Format) valid() bool {
+	return f == FormatJSON || f == FormatCSV || f == FormatTodoTxt || f == FormatICalendar
+}
+
+// ContentType returns the media type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJSON:
+		return "application/json"
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatICalendar:
+		return "text/calendar; charset=utf-8"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// Extension returns the usual file extension of the format.
+func (f Format) Extension() string {
+	switch f {
+	case FormatTodoTxt:
+		return ".txt"
+	case FormatICalendar:
+		return ".ics"
+	default:
+		return "." + string(f)
+	}
+}
+
+func formatError() validationError {
+	return validationError{violations: map[string][]string{
+		"format": {
+			"format must be one of json, csv, todotxt or ical",
+		},
+	}}
+}
+
+// Export is the content of a list in a given format.
+type Export struct {
+	Format Format
+
+	// Items of the list: parents come before their subtasks.
+	Items []Item
+
+	// ExportedAt is the time of the export.
+	ExportedAt time.Time
+}
+
+// WriteTo writes the export item by item.
+func (e Export) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+
+	var err error
+
+	switch e.Format {
+	case FormatJSON:
+		err = encodeJSONItems(cw, e.Items)
+	case FormatCSV:
+		err = encodeCSVItems(cw, e.Items)
+	case FormatTodoTxt:
+		err = encodeTodoTxtItems(cw, e.Items)
+	case FormatICalendar:
+		err = encodeICalendarItems(cw, e.Items, e.ExportedAt)
+	default:
+		err = errors.WithStack(formatError())
+	}
+
+	return cw.n, err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+
+	return n, err
+}
+
+func (s service) ExportItems(ctx context.Context, listID string, format Format) (Export, error) {
+	if !format.valid() {
+		return Export{}, errors.WithStack(formatError())
+	}
+
+	if listID == "" {
+		listID = DefaultListID
+	}
+
+	_, err := s.getList(ctx, listID)
+	if err != nil {
+		return Export{}, err
+	}
+
+	trees, err := s.ListItemTree(ctx, ItemFilter{ListID: listID}, 0)
+	if err != nil {
+		return Export{}, err
+	}
+
+	return Export{
+		Format:     format,
+		Items:      flattenItemTrees(trees),
+		ExportedAt: time.Now().UTC(),
+	}, nil
+}
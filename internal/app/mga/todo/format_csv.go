@@ -0,0 +1,215 @@
+package todo
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// csvColumns are the columns of CSV exports.
+// Tags are separated by commas, recurrence is an iCalendar RRULE starting at the due date.
+// nolint: gochecknoglobals
+var csvColumns = []string{
+	"id", "parent_id", "title", "notes", "completed", "due_at", "priority", "tags", "recurrence", "auto_complete", "assignee",
+}
+
+// encodeCSVItems writes items as CSV with a header row.
+func encodeCSVItems(w io.Writer, items []Item) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write(csvColumns)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		var dueAt, priority, recurrence string
+
+		if item.DueAt != nil {
+			dueAt = item.DueAt.UTC().Format(time.RFC3339)
+		}
+
+		if item.Priority != PriorityNone {
+			priority = item.Priority.String()
+		}
+
+		if item.Recurrence != nil {
+			recurrence = item.Recurrence.Rule
+		}
+
+		err := writer.Write([]string{
+			item.ID,
+			item.ParentID,
+			item.Title,
+			item.Notes,
+			strconv.FormatBool(item.Completed),
+			dueAt,
+			priority,
+			strings.Join(itemTagNames(item), ","),
+			recurrence,
+			strconv.FormatBool(item.AutoComplete),
+			item.Assignee,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Flush item by item so that large exports are streamed
+		writer.Flush()
+
+		err = writer.Error()
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// decodeCSVItems reads items from CSV with a header row. Columns are matched by name, unknown columns are ignored.
+func decodeCSVItems(data []byte) ([]importRecord, []LineError) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, []LineError{{Line: 1, Message: "a header row is required"}}
+	}
+
+	columns := make(map[string]int, len(header))
+
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	if _, ok := columns["title"]; !ok {
+		return nil, []LineError{{Line: 1, Message: "a title column is required"}}
+	}
+
+	var (
+		records  []importRecord
+		lineErrs []LineError
+	)
+
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			lineErrs = append(lineErrs, LineError{Line: parseErr.StartLine, Message: parseErr.Err.Error()})
+
+			continue
+		}
+		if err != nil {
+			return records, append(lineErrs, LineError{Line: 1, Message: err.Error()})
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		record, messages := decodeCSVRow(row, columns)
+		record.line = line
+
+		for _, message := range messages {
+			lineErrs = append(lineErrs, LineError{Line: line, Message: message})
+		}
+
+		records = append(records, record)
+	}
+
+	return records, lineErrs
+}
+
+func decodeCSVRow(row []string, columns map[string]int) (importRecord, []string) {
+	value := func(column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(row) {
+			return ""
+		}
+
+		return strings.TrimSpace(row[i])
+	}
+
+	parseBool := func(column string) (bool, string) {
+		if value(column) == "" {
+			return false, ""
+		}
+
+		b, err := strconv.ParseBool(value(column))
+		if err != nil {
+			return false, fmt.Sprintf("%s must be either true or false", column)
+		}
+
+		return b, ""
+	}
+
+	var messages []string
+
+	record := importRecord{
+		id:       value("id"),
+		parentID: value("parent_id"),
+		title:    value("title"),
+		notes:    value("notes"),
+		assignee: value("assignee"),
+	}
+
+	var message string
+
+	record.completed, message = parseBool("completed")
+	if message != "" {
+		messages = append(messages, message)
+	}
+
+	record.autoComplete, message = parseBool("auto_complete")
+	if message != "" {
+		messages = append(messages, message)
+	}
+
+	if dueAt := value("due_at"); dueAt != "" {
+		t, err := parseImportTime(dueAt)
+		if err != nil {
+			messages = append(messages, "due_at must be a date (2006-01-02) or an RFC 3339 time")
+		}
+
+		record.dueAt = &t
+	}
+
+	priority, err := ParsePriority(value("priority"))
+	if err != nil {
+		messages = append(messages, priorityViolation)
+	}
+
+	record.priority = priority
+
+	for _, tag := range strings.Split(value("tags"), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			record.tags = append(record.tags, tag)
+		}
+	}
+
+	if rule := value("recurrence"); rule != "" {
+		record.recurrence = &Recurrence{Rule: rule}
+	}
+
+	return record, messages
+}
+
+// parseImportTime parses an RFC 3339 time or a date (midnight in UTC).
+func parseImportTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+
+	return time.Parse("2006-01-02", value)
+}
@@ -0,0 +1,438 @@
+package todo
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icalTimeFormat = "20060102T150405Z"
+	icalDateFormat = "20060102"
+
+	// icalLineLength is the maximum length of a content line (in octets, without the line break).
+	icalLineLength = 75
+)
+
+// iCalendar priorities from 1 (the highest) to 9 (the lowest).
+// nolint: gochecknoglobals
+var icalPriorities = map[Priority]int{
+	PriorityUrgent: 1,
+	PriorityHigh:   3,
+	PriorityMedium: 5,
+	PriorityLow:    7,
+}
+
+// encodeICalendarItems writes items as VTODO components of an iCalendar file (RFC 5545).
+// Tags become categories, subtasks are related to their parents.
+func encodeICalendarItems(w io.Writer, items []Item, exportedAt time.Time) error {
+	iw := icalWriter{w: w}
+
+	iw.line("BEGIN", "VCALENDAR")
+	iw.line("VERSION", "2.0")
+	iw.line("PRODID", "-//modern-go-application//todo//EN")
+
+	for _, item := range items {
+		iw.line("BEGIN", "VTODO")
+		iw.line("UID", item.ID)
+		iw.line("DTSTAMP", exportedAt.UTC().Format(icalTimeFormat))
+		iw.line("SUMMARY", escapeICalText(item.Title))
+
+		if item.Notes != "" {
+			iw.line("DESCRIPTION", escapeICalText(item.Notes))
+		}
+
+		if item.Completed {
+			iw.line("STATUS", "COMPLETED")
+		} else {
+			iw.line("STATUS", "NEEDS-ACTION")
+		}
+
+		if item.DueAt != nil {
+			iw.line("DUE", item.DueAt.UTC().Format(icalTimeFormat))
+		}
+
+		if priority, ok := icalPriorities[item.Priority]; ok {
+			iw.line("PRIORITY", strconv.Itoa(priority))
+		}
+
+		if tags := itemTagNames(item); len(tags) > 0 {
+			for i, tag := range tags {
+				tags[i] = escapeICalText(tag)
+			}
+
+			iw.line("CATEGORIES", strings.Join(tags, ","))
+		}
+
+		if item.ParentID != "" {
+			iw.line("RELATED-TO;RELTYPE=PARENT", item.ParentID)
+		}
+
+		if recurrence := item.Recurrence; recurrence != nil {
+			if !recurrence.Start.IsZero() {
+				if recurrence.Timezone != "" && recurrence.Timezone != "UTC" {
+					loc, err := time.LoadLocation(recurrence.Timezone)
+					if err == nil {
+						iw.line("DTSTART;TZID="+recurrence.Timezone, recurrence.Start.In(loc).Format("20060102T150405"))
+					}
+				} else {
+					iw.line("DTSTART", recurrence.Start.UTC().Format(icalTimeFormat))
+				}
+			}
+
+			iw.line("RRULE", recurrence.Rule)
+		}
+
+		iw.line("END", "VTODO")
+	}
+
+	iw.line("END", "VCALENDAR")
+
+	return iw.err
+}
+
+// icalWriter writes folded content lines.
+type icalWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (w *icalWriter) line(name string, value string) {
+	if w.err != nil {
+		return
+	}
+
+	line := name + ":" + value
+
+	var buf strings.Builder
+
+	// Long lines are folded: continuation lines start with a space
+	for limit := icalLineLength; len(line) > limit; limit = icalLineLength - 1 {
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+
+		buf.WriteString(line[:i] + "\r\n ")
+		line = line[i:]
+	}
+
+	buf.WriteString(line + "\r\n")
+
+	_, w.err = io.WriteString(w.w, buf.String())
+}
+
+func escapeICalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+func unescapeICalText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
+
+// icalLine is an unfolded content line.
+type icalLine struct {
+	number int
+	name   string
+	params map[string]string
+	value  string
+}
+
+// decodeICalendarItems reads the VTODO components of an iCalendar file.
+// Other components (and components nested in VTODOs, eg. alarms) are ignored.
+func decodeICalendarItems(data []byte) ([]importRecord, []LineError) {
+	lines, lineErrs := readICalLines(data)
+
+	if len(lines) == 0 || lines[0].name != "BEGIN" || !strings.EqualFold(lines[0].value, "VCALENDAR") {
+		return nil, append(lineErrs, LineError{Line: 1, Message: "iCalendar files must start with BEGIN:VCALENDAR"})
+	}
+
+	var (
+		records []importRecord
+		record  *importRecord
+
+		// nesting of components in the current VTODO
+		depth int
+	)
+
+	for _, line := range lines {
+		switch {
+		case record == nil && line.name == "BEGIN" && strings.EqualFold(line.value, "VTODO"):
+			record = &importRecord{line: line.number}
+
+		case record == nil:
+			continue
+
+		case line.name == "BEGIN":
+			depth++
+
+		case line.name == "END" && depth > 0:
+			depth--
+
+		case line.name == "END":
+			records = append(records, *record)
+			record = nil
+
+		case depth > 0:
+			continue
+
+		default:
+			message := decodeICalProperty(record, line)
+			if message != "" {
+				lineErrs = append(lineErrs, LineError{Line: line.number, Message: message})
+			}
+		}
+	}
+
+	if record != nil {
+		lineErrs = append(lineErrs, LineError{Line: record.line, Message: "VTODO is not closed"})
+	}
+
+	return records, lineErrs
+}
+
+// decodeICalProperty sets a property of a VTODO on a record and returns a message if it's invalid.
+func decodeICalProperty(record *importRecord, line icalLine) string {
+	switch line.name {
+	case "UID":
+		record.id = line.value
+
+	case "SUMMARY":
+		record.title = unescapeICalText(line.value)
+
+	case "DESCRIPTION":
+		record.notes = unescapeICalText(line.value)
+
+	case "STATUS":
+		record.completed = strings.EqualFold(line.value, "COMPLETED")
+
+	case "COMPLETED":
+		record.completed = true
+
+	case "DUE":
+		dueAt, err := parseICalTime(line)
+		if err != nil {
+			return err.Error()
+		}
+
+		record.dueAt = &dueAt
+
+	case "PRIORITY":
+		priority, err := strconv.Atoi(line.value)
+		if err != nil || priority < 0 || priority > 9 {
+			return "PRIORITY must be between 0 and 9"
+		}
+
+		record.priority = parseICalPriority(priority)
+
+	case "CATEGORIES":
+		for _, tag := range splitICalList(line.value) {
+			if tag = strings.TrimSpace(unescapeICalText(tag)); tag != "" {
+				record.tags = append(record.tags, tag)
+			}
+		}
+
+	case "RELATED-TO":
+		if reltype := line.params["RELTYPE"]; reltype == "" || strings.EqualFold(reltype, "PARENT") {
+			record.parentID = line.value
+		}
+
+	case "RRULE":
+		if record.recurrence == nil {
+			record.recurrence = &Recurrence{}
+		}
+
+		record.recurrence.Rule = line.value
+
+	case "DTSTART":
+		start, err := parseICalTime(line)
+		if err != nil {
+			return err.Error()
+		}
+
+		if record.recurrence == nil {
+			record.recurrence = &Recurrence{}
+		}
+
+		record.recurrence.Start = start
+		record.recurrence.Timezone = line.params["TZID"]
+	}
+
+	return ""
+}
+
+// readICalLines unfolds and parses the content lines of an iCalendar file.
+func readICalLines(data []byte) ([]icalLine, []LineError) {
+	var (
+		lines    []icalLine
+		lineErrs []LineError
+		raw      []string
+		numbers  []int
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 1024*1024)
+
+	number := 0
+
+	for scanner.Scan() {
+		number++
+
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+
+		if text == "" {
+			continue
+		}
+
+		if (text[0] == ' ' || text[0] == '\t') && len(raw) > 0 {
+			raw[len(raw)-1] += text[1:]
+
+			continue
+		}
+
+		raw = append(raw, text)
+		numbers = append(numbers, number)
+	}
+
+	if err := scanner.Err(); err != nil {
+		lineErrs = append(lineErrs, LineError{Line: number + 1, Message: err.Error()})
+	}
+
+	for i, text := range raw {
+		line, ok := parseICalLine(text)
+		if !ok {
+			lineErrs = append(lineErrs, LineError{Line: numbers[i], Message: "invalid content line"})
+
+			continue
+		}
+
+		line.number = numbers[i]
+		lines = append(lines, line)
+	}
+
+	return lines, lineErrs
+}
+
+// parseICalLine parses a content line: name *(";" param) ":" value.
+func parseICalLine(text string) (icalLine, bool) {
+	var quoted bool
+
+	colon := -1
+
+	for i, c := range text {
+		if c == '"' {
+			quoted = !quoted
+		}
+
+		if c == ':' && !quoted {
+			colon = i
+
+			break
+		}
+	}
+
+	if colon < 1 {
+		return icalLine{}, false
+	}
+
+	parts := strings.Split(text[:colon], ";")
+
+	line := icalLine{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  text[colon+1:],
+	}
+
+	for _, param := range parts[1:] {
+		i := strings.Index(param, "=")
+		if i < 1 {
+			return icalLine{}, false
+		}
+
+		line.params[strings.ToUpper(param[:i])] = strings.Trim(param[i+1:], `"`)
+	}
+
+	return line, true
+}
+
+// parseICalTime parses a date or a date-time (in UTC, in a timezone or floating, which is read as UTC).
+func parseICalTime(line icalLine) (time.Time, error) {
+	loc := time.UTC
+
+	if tzid := line.params["TZID"]; tzid != "" {
+		var err error
+
+		loc, err = time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown timezone %q", tzid)
+		}
+	}
+
+	var (
+		t   time.Time
+		err error
+	)
+
+	switch {
+	case strings.EqualFold(line.params["VALUE"], "DATE") || len(line.value) == len(icalDateFormat):
+		t, err = time.ParseInLocation(icalDateFormat, line.value, loc)
+	case strings.HasSuffix(line.value, "Z"):
+		t, err = time.Parse(icalTimeFormat, line.value)
+	default:
+		t, err = time.ParseInLocation("20060102T150405", line.value, loc)
+	}
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %q", line.name, line.value)
+	}
+
+	return t, nil
+}
+
+// parseICalPriority maps iCalendar priorities: 1 is urgent, 2-4 is high, 5 is medium and 6-9 is low.
+func parseICalPriority(priority int) Priority {
+	switch {
+	case priority == 0:
+		return PriorityNone
+	case priority == 1:
+		return PriorityUrgent
+	case priority < 5:
+		return PriorityHigh
+	case priority == 5:
+		return PriorityMedium
+	default:
+		return PriorityLow
+	}
+}
+
+// splitICalList splits a list value on commas that are not escaped.
+func splitICalList(value string) []string {
+	var (
+		items   []string
+		current strings.Builder
+		escaped bool
+	)
+
+	for _, c := range value {
+		switch {
+		case escaped:
+			current.WriteRune('\\')
+			current.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == ',':
+			items = append(items, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(c)
+		}
+	}
+
+	return append(items, current.String())
+}
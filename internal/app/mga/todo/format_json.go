@@ -0,0 +1,198 @@
+package todo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// jsonItem is the JSON representation of an exported item.
+type jsonItem struct {
+	ID           string          `json:"id,omitempty"`
+	ParentID     string          `json:"parentId,omitempty"`
+	Title        string          `json:"title"`
+	Notes        string          `json:"notes,omitempty"`
+	Assignee     string          `json:"assignee,omitempty"`
+	Completed    bool            `json:"completed"`
+	DueAt        *time.Time      `json:"dueAt,omitempty"`
+	Priority     string          `json:"priority,omitempty"`
+	Tags         []string        `json:"tags,omitempty"`
+	Recurrence   *jsonRecurrence `json:"recurrence,omitempty"`
+	AutoComplete bool            `json:"autoComplete,omitempty"`
+}
+
+type jsonRecurrence struct {
+	Rule     string     `json:"rule"`
+	Timezone string     `json:"timezone,omitempty"`
+	Start    *time.Time `json:"start,omitempty"`
+}
+
+// encodeJSONItems writes items as a JSON array, one item per line.
+func encodeJSONItems(w io.Writer, items []Item) error {
+	_, err := io.WriteString(w, "[")
+	if err != nil {
+		return err
+	}
+
+	for i, item := range items {
+		apiItem := jsonItem{
+			ID:           item.ID,
+			ParentID:     item.ParentID,
+			Title:        item.Title,
+			Notes:        item.Notes,
+			Assignee:     item.Assignee,
+			Completed:    item.Completed,
+			DueAt:        item.DueAt,
+			Tags:         itemTagNames(item),
+			AutoComplete: item.AutoComplete,
+		}
+
+		if item.Priority != PriorityNone {
+			apiItem.Priority = item.Priority.String()
+		}
+
+		if item.Recurrence != nil {
+			apiItem.Recurrence = &jsonRecurrence{
+				Rule:     item.Recurrence.Rule,
+				Timezone: item.Recurrence.Timezone,
+			}
+
+			if !item.Recurrence.Start.IsZero() {
+				start := item.Recurrence.Start
+				apiItem.Recurrence.Start = &start
+			}
+		}
+
+		line, err := json.Marshal(apiItem)
+		if err != nil {
+			return err
+		}
+
+		separator := ",\n  "
+		if i == 0 {
+			separator = "\n  "
+		}
+
+		_, err = io.WriteString(w, separator+string(line))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "\n]\n")
+
+	return err
+}
+
+// decodeJSONItems reads items from a JSON array.
+func decodeJSONItems(data []byte) ([]importRecord, []LineError) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	lineAt := func(offset int64) int {
+		return bytes.Count(data[:offset], []byte("\n")) + 1
+	}
+
+	token, err := decoder.Token()
+	if delim, ok := token.(json.Delim); err != nil || !ok || delim != '[' {
+		return nil, []LineError{{Line: 1, Message: "items must be a JSON array"}}
+	}
+
+	var (
+		records  []importRecord
+		lineErrs []LineError
+	)
+
+	for decoder.More() {
+		line := lineAt(skipJSONSeparators(data, decoder.InputOffset()))
+
+		var apiItem jsonItem
+
+		err := decoder.Decode(&apiItem)
+		if err != nil {
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				return records, append(lineErrs, LineError{Line: lineAt(syntaxErr.Offset), Message: syntaxErr.Error()})
+			}
+
+			message := err.Error()
+
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) {
+				message = fmt.Sprintf("invalid value for %s", typeErr.Field)
+			}
+
+			lineErrs = append(lineErrs, LineError{Line: line, Message: message})
+
+			continue
+		}
+
+		record := importRecord{
+			line:         line,
+			id:           apiItem.ID,
+			parentID:     apiItem.ParentID,
+			title:        apiItem.Title,
+			notes:        apiItem.Notes,
+			assignee:     apiItem.Assignee,
+			completed:    apiItem.Completed,
+			dueAt:        apiItem.DueAt,
+			tags:         apiItem.Tags,
+			autoComplete: apiItem.AutoComplete,
+		}
+
+		record.priority, err = ParsePriority(apiItem.Priority)
+		if err != nil {
+			lineErrs = append(lineErrs, LineError{Line: line, Message: priorityViolation})
+		}
+
+		if apiItem.Recurrence != nil {
+			record.recurrence = &Recurrence{
+				Rule:     apiItem.Recurrence.Rule,
+				Timezone: apiItem.Recurrence.Timezone,
+			}
+
+			if apiItem.Recurrence.Start != nil {
+				record.recurrence.Start = *apiItem.Recurrence.Start
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	_, err = decoder.Token()
+	if err != nil {
+		lineErrs = append(lineErrs, LineError{
+			Line:    lineAt(decoder.InputOffset()),
+			Message: fmt.Sprintf("unterminated array: %s", err),
+		})
+	}
+
+	return records, lineErrs
+}
+
+// skipJSONSeparators returns the offset of the next value in a JSON array.
+func skipJSONSeparators(data []byte, offset int64) int64 {
+	for offset < int64(len(data)) && bytes.IndexByte([]byte(" \t\r\n,"), data[offset]) >= 0 {
+		offset++
+	}
+
+	return offset
+}
+
+// itemTagNames returns the names of the tags of an item.
+func itemTagNames(item Item) []string {
+	if len(item.Tags) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(item.Tags))
+
+	for _, tag := range item.Tags {
+		names = append(names, tag.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,200 @@
+package todo
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Priorities of todo.txt from A (the most important) to D.
+// nolint: gochecknoglobals
+var todoTxtPriorities = map[Priority]string{
+	PriorityUrgent: "A",
+	PriorityHigh:   "B",
+	PriorityMedium: "C",
+	PriorityLow:    "D",
+}
+
+// nolint: gochecknoglobals
+var (
+	todoTxtDate     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	todoTxtPriority = regexp.MustCompile(`^\(([A-Z])\)$`)
+)
+
+// encodeTodoTxtItems writes items in the todo.txt format.
+// Tags become projects (with underscores instead of spaces), due dates are written as dates (in UTC)
+// and recurrence is not exported.
+// IDs are written as id and parent key-value pairs so that subtasks can be imported.
+func encodeTodoTxtItems(w io.Writer, items []Item) error {
+	for _, item := range items {
+		var parts []string
+
+		priority := todoTxtPriorities[item.Priority]
+
+		if item.Completed {
+			parts = append(parts, "x")
+		} else if priority != "" {
+			parts = append(parts, "("+priority+")")
+		}
+
+		parts = append(parts, strings.Join(strings.Fields(item.Title), " "))
+
+		for _, tag := range itemTagNames(item) {
+			parts = append(parts, "+"+strings.Join(strings.Fields(tag), "_"))
+		}
+
+		if item.DueAt != nil {
+			parts = append(parts, "due:"+item.DueAt.UTC().Format("2006-01-02"))
+		}
+
+		// Completed items keep their priority as a key-value pair
+		if item.Completed && priority != "" {
+			parts = append(parts, "pri:"+priority)
+		}
+
+		parts = append(parts, "id:"+item.ID)
+
+		if item.ParentID != "" {
+			parts = append(parts, "parent:"+item.ParentID)
+		}
+
+		_, err := io.WriteString(w, strings.Join(parts, " ")+"\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeTodoTxtItems reads items in the todo.txt format, one item per (non-empty) line.
+// Projects and contexts become tags.
+func decodeTodoTxtItems(data []byte) ([]importRecord, []LineError) {
+	var (
+		records  []importRecord
+		lineErrs []LineError
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		record, messages := decodeTodoTxtLine(scanner.Text())
+		record.line = line
+
+		for _, message := range messages {
+			lineErrs = append(lineErrs, LineError{Line: line, Message: message})
+		}
+
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		lineErrs = append(lineErrs, LineError{Line: line + 1, Message: err.Error()})
+	}
+
+	return records, lineErrs
+}
+
+func decodeTodoTxtLine(text string) (importRecord, []string) {
+	var (
+		record   importRecord
+		messages []string
+		title    []string
+	)
+
+	fields := strings.Fields(text)
+
+	if fields[0] == "x" {
+		record.completed = true
+		fields = fields[1:]
+	} else if m := todoTxtPriority.FindStringSubmatch(fields[0]); m != nil {
+		record.priority = parseTodoTxtPriority(m[1])
+		fields = fields[1:]
+	}
+
+	// Completion and creation dates
+	for i := 0; i < 2 && len(fields) > 0 && todoTxtDate.MatchString(fields[0]); i++ {
+		fields = fields[1:]
+	}
+
+	for _, field := range fields {
+		if len(field) > 1 && (field[0] == '+' || field[0] == '@') {
+			record.tags = append(record.tags, field[1:])
+
+			continue
+		}
+
+		key, value, ok := cutTodoTxtPair(field)
+		if !ok {
+			title = append(title, field)
+
+			continue
+		}
+
+		switch key {
+		case "due":
+			dueAt, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				messages = append(messages, fmt.Sprintf("invalid due date %q", value))
+			}
+
+			record.dueAt = &dueAt
+
+		case "pri":
+			if !todoTxtPriority.MatchString("(" + value + ")") {
+				messages = append(messages, fmt.Sprintf("invalid priority %q", value))
+			}
+
+			record.priority = parseTodoTxtPriority(value)
+
+		case "id":
+			record.id = value
+
+		case "parent":
+			record.parentID = value
+		}
+	}
+
+	record.title = strings.Join(title, " ")
+
+	return record, messages
+}
+
+// cutTodoTxtPair splits known key-value pairs (other words are part of the title).
+func cutTodoTxtPair(field string) (string, string, bool) {
+	i := strings.Index(field, ":")
+	if i < 1 || i == len(field)-1 {
+		return "", "", false
+	}
+
+	key := field[:i]
+
+	switch key {
+	case "due", "pri", "id", "parent":
+		return key, field[i+1:], true
+	default:
+		return "", "", false
+	}
+}
+
+// parseTodoTxtPriority maps priorities below D to low.
+func parseTodoTxtPriority(letter string) Priority {
+	for priority, l := range todoTxtPriorities {
+		if l == letter {
+			return priority
+		}
+	}
+
+	return PriorityLow
+}
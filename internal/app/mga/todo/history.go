@@ -0,0 +1,601 @@
+package todo
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/sagikazarmark/kitx/correlation"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// HistoryEntry records a change of an item.
+type HistoryEntry struct {
+	ID     string
+	ItemID string
+
+	// Actor is the user who made the change (empty for anonymous users).
+	Actor string
+
+	// Operation is the name of the service method that changed the item.
+	Operation string
+
+	// CorrelationID is the ID of the request that changed the item.
+	CorrelationID string
+
+	// Changes lists the fields that changed, ordered by field name.
+	Changes []FieldChange
+
+	CreatedAt time.Time
+}
+
+// FieldChange is the change of a single field of an item.
+// Values are empty when the item did not exist before (or after) the change.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// HistoryStore persists the change history of items.
+type HistoryStore interface {
+	// AddHistoryEntry stores a history entry.
+	AddHistoryEntry(ctx context.Context, entry HistoryEntry) error
+
+	// GetHistory returns the history entries of an item, oldest first.
+	GetHistory(ctx context.Context, itemID string) ([]HistoryEntry, error)
+}
+
+func (s service) ItemHistory(ctx context.Context, id string) ([]HistoryEntry, error) {
+	entries, err := s.historyStore.GetHistory(ctx, id)
+	if err != nil {
+		return nil, errors.WithMessage(err, "get item history")
+	}
+
+	// History is kept after an item is purged, but unknown items are reported as such
+	if len(entries) == 0 {
+		_, err := s.store.GetOne(ctx, id)
+		if errors.As(err, &NotFoundError{}) {
+			_, err = s.trashStore.GetOneDeleted(ctx, id)
+		}
+		if err != nil {
+			return nil, errors.WithMessage(err, "get item history")
+		}
+	}
+
+	return entries, nil
+}
+
+// HistoryMiddleware records a history entry for every change of an item.
+// Entries are written in the same transaction as the change. Events fired by the change
+// are dispatched to events once the transaction is committed.
+func HistoryMiddleware(idgenerator IDGenerator, store HistoryStore, transactor Transactor, events Events) Middleware {
+	return func(next Service) Service {
+		return historyMiddleware{
+			Service: DefaultMiddleware{Service: next},
+			next:    next,
+
+			idgenerator: idgenerator,
+			store:       store,
+			transactor:  transactor,
+			events:      events,
+		}
+	}
+}
+
+type historyMiddleware struct {
+	Service
+	next Service
+
+	idgenerator IDGenerator
+	store       HistoryStore
+	transactor  Transactor
+	events      Events
+}
+
+// transaction runs a change and records its history in a transaction.
+func (mw historyMiddleware) transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return transaction(ctx, mw.transactor, mw.events, fn)
+}
+
+func (mw historyMiddleware) AddItem(ctx context.Context, newItem NewItem) (Item, error) {
+	var item Item
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		item, err = mw.next.AddItem(ctx, newItem)
+		if err != nil {
+			return err
+		}
+
+		return mw.record(ctx, "AddItem", item.ID, nil, &item)
+	})
+
+	return item, err
+}
+
+func (mw historyMiddleware) UpdateItem(ctx context.Context, id string, itemUpdate ItemUpdate) (Item, error) {
+	return mw.changeItem(ctx, "UpdateItem", id, func(ctx context.Context) (Item, error) {
+		return mw.next.UpdateItem(ctx, id, itemUpdate)
+	})
+}
+
+func (mw historyMiddleware) DeleteItems(ctx context.Context, listID string) error {
+	return mw.transaction(ctx, func(ctx context.Context) error {
+		items, err := mw.next.ListItems(ctx, ItemFilter{ListID: listID})
+		if err != nil {
+			return err
+		}
+
+		err = mw.next.DeleteItems(ctx, listID)
+		if err != nil {
+			return err
+		}
+
+		for i := range items {
+			err := mw.record(ctx, "DeleteItems", items[i].ID, &items[i], nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (mw historyMiddleware) DeleteItem(ctx context.Context, id string, cascade bool) error {
+	return mw.transaction(ctx, func(ctx context.Context) error {
+		before, err := mw.next.GetItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		items := []Item{before}
+
+		// Subtasks deleted together with the item get an entry as well
+		if cascade {
+			trees, err := mw.next.ListItemTree(ctx, ItemFilter{ListID: before.ListID, ParentID: id}, 0)
+			if err != nil {
+				return err
+			}
+
+			items = append(items, flattenItemTrees(trees)...)
+		}
+
+		err = mw.next.DeleteItem(ctx, id, cascade)
+		if err != nil {
+			return err
+		}
+
+		for i := range items {
+			err := mw.record(ctx, "DeleteItem", items[i].ID, &items[i], nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (mw historyMiddleware) RestoreItem(ctx context.Context, id string) (Item, error) {
+	var item Item
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		item, err = mw.next.RestoreItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return mw.record(ctx, "RestoreItem", id, nil, &item)
+	})
+
+	return item, err
+}
+
+func (mw historyMiddleware) PurgeItem(ctx context.Context, id string) error {
+	return mw.transaction(ctx, func(ctx context.Context) error {
+		err := mw.next.PurgeItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return mw.record(ctx, "PurgeItem", id, nil, nil)
+	})
+}
+
+func (mw historyMiddleware) EmptyTrash(ctx context.Context, listID string) error {
+	return mw.transaction(ctx, func(ctx context.Context) error {
+		items, err := mw.next.ListTrash(ctx, listID)
+		if err != nil {
+			return err
+		}
+
+		err = mw.next.EmptyTrash(ctx, listID)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			err := mw.record(ctx, "EmptyTrash", item.ID, nil, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (mw historyMiddleware) TagItem(ctx context.Context, id string, tags []string) (Item, error) {
+	return mw.changeItem(ctx, "TagItem", id, func(ctx context.Context) (Item, error) {
+		return mw.next.TagItem(ctx, id, tags)
+	})
+}
+
+func (mw historyMiddleware) UntagItem(ctx context.Context, id string, tags []string) (Item, error) {
+	return mw.changeItem(ctx, "UntagItem", id, func(ctx context.Context) (Item, error) {
+		return mw.next.UntagItem(ctx, id, tags)
+	})
+}
+
+func (mw historyMiddleware) AssignItem(ctx context.Context, id string, assignee string) (Item, error) {
+	return mw.changeItem(ctx, "AssignItem", id, func(ctx context.Context) (Item, error) {
+		return mw.next.AssignItem(ctx, id, assignee)
+	})
+}
+
+func (mw historyMiddleware) UnassignItem(ctx context.Context, id string) (Item, error) {
+	return mw.changeItem(ctx, "UnassignItem", id, func(ctx context.Context) (Item, error) {
+		return mw.next.UnassignItem(ctx, id)
+	})
+}
+
+func (mw historyMiddleware) MoveItem(ctx context.Context, id string, move ItemMove) (Item, error) {
+	return mw.changeItem(ctx, "MoveItem", id, func(ctx context.Context) (Item, error) {
+		return mw.next.MoveItem(ctx, id, move)
+	})
+}
+
+func (mw historyMiddleware) Undo(ctx context.Context, steps int) ([]Operation, error) {
+	var operations []Operation
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		operations, err = mw.next.Undo(ctx, steps)
+		if err != nil {
+			return err
+		}
+
+		for _, operation := range operations {
+			for _, change := range operation.Changes {
+				err := mw.record(ctx, "Undo", change.ItemID(), change.After, change.Before)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return operations, err
+}
+
+func (mw historyMiddleware) Redo(ctx context.Context, steps int) ([]Operation, error) {
+	var operations []Operation
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		operations, err = mw.next.Redo(ctx, steps)
+		if err != nil {
+			return err
+		}
+
+		for _, operation := range operations {
+			for _, change := range operation.Changes {
+				err := mw.record(ctx, "Redo", change.ItemID(), change.Before, change.After)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return operations, err
+}
+
+func (mw historyMiddleware) AddComment(ctx context.Context, itemID string, newComment NewComment) (Comment, error) {
+	var comment Comment
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		comment, err = mw.next.AddComment(ctx, itemID, newComment)
+		if err != nil {
+			return err
+		}
+
+		return mw.recordChanges(ctx, "AddComment", itemID, diffComments(nil, &comment))
+	})
+
+	return comment, err
+}
+
+func (mw historyMiddleware) UpdateComment(ctx context.Context, id string, body string) (Comment, error) {
+	var comment Comment
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		before, err := mw.next.GetComment(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		comment, err = mw.next.UpdateComment(ctx, id, body)
+		if err != nil {
+			return err
+		}
+
+		changes := diffComments(&before, &comment)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		return mw.recordChanges(ctx, "UpdateComment", comment.ItemID, changes)
+	})
+
+	return comment, err
+}
+
+func (mw historyMiddleware) DeleteComment(ctx context.Context, id string) error {
+	return mw.transaction(ctx, func(ctx context.Context) error {
+		before, err := mw.next.GetComment(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = mw.next.DeleteComment(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return mw.recordChanges(ctx, "DeleteComment", before.ItemID, diffComments(&before, nil))
+	})
+}
+
+func (mw historyMiddleware) AddAttachment(
+	ctx context.Context,
+	itemID string,
+	newAttachment NewAttachment,
+) (Attachment, error) {
+	var attachment Attachment
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		attachment, err = mw.next.AddAttachment(ctx, itemID, newAttachment)
+		if err != nil {
+			return err
+		}
+
+		return mw.recordChanges(ctx, "AddAttachment", itemID, diffAttachments(nil, &attachment))
+	})
+
+	return attachment, err
+}
+
+func (mw historyMiddleware) DeleteAttachment(ctx context.Context, id string) error {
+	return mw.transaction(ctx, func(ctx context.Context) error {
+		before, err := mw.next.GetAttachment(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = mw.next.DeleteAttachment(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return mw.recordChanges(ctx, "DeleteAttachment", before.ItemID, diffAttachments(&before, nil))
+	})
+}
+
+// changeItem applies a change to an existing item and records it.
+func (mw historyMiddleware) changeItem(
+	ctx context.Context,
+	operation string,
+	id string,
+	change func(ctx context.Context) (Item, error),
+) (Item, error) {
+	var item Item
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		before, err := mw.next.GetItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		item, err = change(ctx)
+		if err != nil {
+			return err
+		}
+
+		return mw.record(ctx, operation, id, &before, &item)
+	})
+
+	return item, err
+}
+
+// record stores a history entry about a change of an item.
+func (mw historyMiddleware) record(
+	ctx context.Context,
+	operation string,
+	itemID string,
+	before *Item,
+	after *Item,
+) error {
+	return mw.recordChanges(ctx, operation, itemID, diffItems(before, after))
+}
+
+// recordChanges stores a history entry about changes of an item.
+func (mw historyMiddleware) recordChanges(
+	ctx context.Context,
+	operation string,
+	itemID string,
+	changes []FieldChange,
+) error {
+	id, err := mw.idgenerator.Generate()
+	if err != nil {
+		return errors.WithMessage(err, "record history")
+	}
+
+	actor, _ := principal.FromContext(ctx)
+	correlationID, _ := correlation.FromContext(ctx)
+
+	entry := HistoryEntry{
+		ID:            id,
+		ItemID:        itemID,
+		Actor:         actor,
+		Operation:     operation,
+		CorrelationID: correlationID,
+		Changes:       changes,
+		CreatedAt:     time.Now(),
+	}
+
+	err = mw.store.AddHistoryEntry(ctx, entry)
+	if err != nil {
+		return errors.WithMessage(err, "record history")
+	}
+
+	return nil
+}
+
+// diffItems returns the fields that differ between two versions of an item.
+// A nil item has no fields.
+func diffItems(before *Item, after *Item) []FieldChange {
+	b, a := itemFields(before), itemFields(after)
+
+	names := make(map[string]bool, len(b)+len(a))
+
+	for name := range b {
+		names[name] = true
+	}
+
+	for name := range a {
+		names[name] = true
+	}
+
+	var changes []FieldChange
+
+	for name := range names {
+		if b[name] != a[name] {
+			changes = append(changes, FieldChange{Field: name, Before: b[name], After: a[name]})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+
+	return changes
+}
+
+// itemFields returns the tracked fields of an item formatted as strings.
+func itemFields(item *Item) map[string]string {
+	if item == nil {
+		return nil
+	}
+
+	fields := map[string]string{
+		"title":        item.Title,
+		"notes":        item.Notes,
+		"assignee":     item.Assignee,
+		"completed":    strconv.FormatBool(item.Completed),
+		"order":        strconv.Itoa(item.Order),
+		"listId":       item.ListID,
+		"parentId":     item.ParentID,
+		"autoComplete": strconv.FormatBool(item.AutoComplete),
+		"priority":     item.Priority.String(),
+		"rank":         item.Rank,
+		"dueAt":        "",
+		"recurrence":   "",
+		"tags":         "",
+	}
+
+	if item.DueAt != nil {
+		fields["dueAt"] = item.DueAt.UTC().Format(time.RFC3339)
+	}
+
+	if item.Recurrence != nil {
+		fields["recurrence"] = item.Recurrence.Rule
+	}
+
+	tags := make([]string, 0, len(item.Tags))
+
+	for _, tag := range item.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	fields["tags"] = strings.Join(tags, ",")
+
+	return fields
+}
+
+// diffComments returns the change of a comment as the change of the comment field of its item.
+// Values are prefixed with the ID of the comment. A nil comment does not exist.
+func diffComments(before *Comment, after *Comment) []FieldChange {
+	var change FieldChange
+
+	change.Field = "comment"
+
+	if before != nil {
+		change.Before = before.ID + ": " + before.Body
+	}
+
+	if after != nil {
+		change.After = after.ID + ": " + after.Body
+	}
+
+	if change.Before == change.After {
+		return nil
+	}
+
+	return []FieldChange{change}
+}
+
+func diffAttachments(before *Attachment, after *Attachment) []FieldChange {
+	var change FieldChange
+
+	change.Field = "attachment"
+
+	if before != nil {
+		change.Before = before.ID + ": " + before.Name
+	}
+
+	if after != nil {
+		change.After = after.ID + ": " + after.Name
+	}
+
+	return []FieldChange{change}
+}
+
+// flattenItemTrees returns the items of item trees on every level, parents first.
+func flattenItemTrees(trees []ItemTree) []Item {
+	var items []Item
+
+	for _, tree := range trees {
+		items = append(items, tree.Item)
+		items = append(items, flattenItemTrees(tree.Children)...)
+	}
+
+	return items
+}
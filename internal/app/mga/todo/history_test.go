@@ -0,0 +1,116 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/sagikazarmark/kitx/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func TestHistoryMiddleware(t *testing.T) {
+	ctx := correlation.ToContext(principal.ToContext(context.Background(), "john"), "cid")
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	title := "Buy oat milk"
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Title: &title})
+	require.NoError(t, err)
+
+	_, err = service.TagItem(ctx, item.ID, []string{"shopping"})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, item.ID, false)
+	require.NoError(t, err)
+
+	_, err = service.RestoreItem(ctx, item.ID)
+	require.NoError(t, err)
+
+	entries, err := service.ItemHistory(ctx, item.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+
+	var operations []string
+
+	for _, entry := range entries {
+		operations = append(operations, entry.Operation)
+
+		assert.Equal(t, item.ID, entry.ItemID)
+		assert.Equal(t, "john", entry.Actor)
+		assert.Equal(t, "cid", entry.CorrelationID)
+		assert.False(t, entry.CreatedAt.IsZero())
+	}
+
+	assert.Equal(t, []string{"AddItem", "UpdateItem", "TagItem", "DeleteItem", "RestoreItem"}, operations)
+
+	assert.Contains(t, entries[0].Changes, FieldChange{Field: "title", After: "Buy milk"})
+	assert.Equal(t, []FieldChange{{Field: "title", Before: "Buy milk", After: "Buy oat milk"}}, entries[1].Changes)
+	assert.Equal(t, []FieldChange{{Field: "tags", Before: "", After: "shopping"}}, entries[2].Changes)
+	assert.Contains(t, entries[3].Changes, FieldChange{Field: "title", Before: "Buy oat milk"})
+
+	// Failed changes are not recorded
+	_, err = service.UpdateItem(ctx, "unknown", ItemUpdate{Title: &title})
+	assert.Error(t, err)
+
+	_, err = service.ItemHistory(ctx, "unknown")
+	assert.True(t, errors.As(err, &NotFoundError{}))
+}
+
+func TestHistoryMiddleware_Purge(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	err = service.DeleteItems(ctx, "")
+	require.NoError(t, err)
+
+	err = service.EmptyTrash(ctx, "")
+	require.NoError(t, err)
+
+	// History is kept after the item is gone
+	entries, err := service.ItemHistory(ctx, item.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "DeleteItems", entries[1].Operation)
+	assert.Equal(t, "EmptyTrash", entries[2].Operation)
+	assert.Empty(t, entries[2].Actor)
+	assert.Empty(t, entries[2].Changes)
+}
+
+type failingHistoryStore struct {
+	*InMemoryStore
+}
+
+func (failingHistoryStore) AddHistoryEntry(_ context.Context, _ HistoryEntry) error {
+	return errors.New("history is unavailable")
+}
+
+func TestHistoryMiddleware_RecordFails(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(testServiceConfig{
+		Store: func(store *InMemoryStore) ServiceStore { return failingHistoryStore{store} },
+	})
+
+	err := store.Store(ctx, Item{ID: "01", ListID: DefaultListID, Title: "Buy milk"})
+	require.NoError(t, err)
+
+	title := "Buy oat milk"
+
+	_, err = service.UpdateItem(ctx, "01", ItemUpdate{Title: &title})
+	require.EqualError(t, err, "record history: history is unavailable")
+
+	// Changes are not kept without history
+	item, err := service.GetItem(ctx, "01")
+	require.NoError(t, err)
+	assert.Equal(t, "Buy milk", item.Title)
+}
@@ -0,0 +1,405 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// ImportStrategy tells what happens to the existing items of a list during an import.
+type ImportStrategy string
+
+const (
+	// ImportMerge keeps the existing items and skips imported items with the same title.
+	ImportMerge ImportStrategy = "merge"
+
+	// ImportReplace moves the existing items to the trash.
+	ImportReplace ImportStrategy = "replace"
+)
+
+// ImportOptions tells how items are imported.
+type ImportOptions struct {
+	// ListID is the list the items are imported to. Defaults to DefaultListID.
+	ListID string
+
+	Format Format
+
+	// Strategy defaults to ImportMerge.
+	Strategy ImportStrategy
+
+	// DryRun validates the import and reports its outcome without changing anything.
+	DryRun bool
+}
+
+// ImportReport is the outcome of an import.
+type ImportReport struct {
+	// Added is the number of imported items.
+	Added int
+
+	// Skipped is the number of items already in the list (when merging).
+	Skipped int
+
+	// Deleted is the number of existing items moved to the trash (when replacing).
+	Deleted int
+
+	// DryRun tells that nothing has been changed.
+	DryRun bool
+}
+
+// LineError is a problem with a single line (or record) of an import.
+type LineError struct {
+	Line    int
+	Message string
+}
+
+// ImportError is returned when an import is invalid. Nothing is imported in this case.
+type ImportError struct {
+	Errors []LineError
+}
+
+// Error implements the error interface.
+func (ImportError) Error() string {
+	return "invalid import"
+}
+
+// Violations returns the problems by line.
+func (e ImportError) Violations() map[string][]string {
+	violations := make(map[string][]string, len(e.Errors))
+
+	for _, lineErr := range e.Errors {
+		field := fmt.Sprintf("line %d", lineErr.Line)
+
+		violations[field] = append(violations[field], lineErr.Message)
+	}
+
+	return violations
+}
+
+// Validation tells a client that this error is related to a resource being invalid.
+// Can be used to translate the error to eg. status code.
+func (ImportError) Validation() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (ImportError) ServiceError() bool {
+	return true
+}
+
+// importRecord is an item read from an import.
+type importRecord struct {
+	line int
+
+	// id and parentID are the IDs used in the import (optional).
+	id       string
+	parentID string
+
+	title        string
+	notes        string
+	assignee     string
+	completed    bool
+	dueAt        *time.Time
+	priority     Priority
+	tags         []string
+	recurrence   *Recurrence
+	autoComplete bool
+}
+
+func (s service) ImportItems(ctx context.Context, data io.Reader, options ImportOptions) (ImportReport, error) {
+	return importer{service: s, transactor: s.transactor}.ImportItems(ctx, data, options)
+}
+
+// ImportMiddleware imports items through the single item operations of the underlying service,
+// so every imported item goes through the same middleware as adding items one by one.
+// Events fired by the import are dispatched once it's committed.
+func ImportMiddleware(transactor Transactor, events Events) Middleware {
+	return func(next Service) Service {
+		return importMiddleware{
+			Service: DefaultMiddleware{Service: next},
+			importer: importer{
+				service:    next,
+				transactor: transactor,
+				events:     events,
+			},
+		}
+	}
+}
+
+type importMiddleware struct {
+	Service
+
+	importer importer
+}
+
+func (mw importMiddleware) ImportItems(ctx context.Context, data io.Reader, options ImportOptions) (ImportReport, error) {
+	return mw.importer.ImportItems(ctx, data, options)
+}
+
+// importer imports items one at a time.
+type importer struct {
+	service    Service
+	transactor Transactor
+
+	// events dispatches the events fired by committed imports (optional).
+	events Events
+}
+
+// errImportDryRun rolls back the transaction of a dry run.
+const errImportDryRun = errors.Sentinel("import dry run")
+
+func (s importer) ImportItems(ctx context.Context, data io.Reader, options ImportOptions) (ImportReport, error) {
+	if options.Strategy == "" {
+		options.Strategy = ImportMerge
+	}
+
+	err := validateImport(options)
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	if options.ListID == "" {
+		options.ListID = DefaultListID
+	}
+
+	_, err = s.service.GetList(ctx, options.ListID)
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return ImportReport{}, errors.WrapIf(err, "read import")
+	}
+
+	records, lineErrs := decodeItems(options.Format, content)
+	lineErrs = append(lineErrs, validateImportRecords(records)...)
+
+	if len(lineErrs) > 0 {
+		sort.SliceStable(lineErrs, func(i, j int) bool { return lineErrs[i].Line < lineErrs[j].Line })
+
+		return ImportReport{}, errors.WithStack(ImportError{Errors: lineErrs})
+	}
+
+	report := ImportReport{DryRun: options.DryRun}
+
+	txCtx, queue := withEventQueue(ctx)
+
+	err = s.transactor.Transaction(txCtx, func(ctx context.Context) error {
+		var err error
+
+		report, err = s.importRecords(ctx, records, options)
+		if err != nil {
+			return err
+		}
+
+		if options.DryRun {
+			return errImportDryRun
+		}
+
+		return nil
+	})
+	if errors.Is(err, errImportDryRun) {
+		return report, nil
+	}
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	err = queue.dispatch(ctx, s.events)
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+func (s importer) importRecords(ctx context.Context, records []importRecord, options ImportOptions) (ImportReport, error) {
+	report := ImportReport{DryRun: options.DryRun}
+
+	existing, err := s.service.ListItems(ctx, ItemFilter{ListID: options.ListID})
+	if err != nil {
+		return report, err
+	}
+
+	titles := make(map[string]string, len(existing))
+
+	if options.Strategy == ImportReplace {
+		report.Deleted = len(existing)
+
+		if len(existing) > 0 {
+			err := s.service.DeleteItems(ctx, options.ListID)
+			if err != nil {
+				return report, err
+			}
+		}
+	} else {
+		for _, item := range existing {
+			titles[item.Title] = item.ID
+		}
+	}
+
+	// IDs of the imported items by their ID in the import
+	ids := make(map[string]string, len(records))
+
+	for _, record := range records {
+		if id, ok := titles[record.title]; ok {
+			report.Skipped++
+
+			if record.id != "" {
+				ids[record.id] = id
+			}
+
+			continue
+		}
+
+		item, err := s.importRecord(ctx, record, options.ListID, ids[record.parentID])
+		if err != nil {
+			return report, importLineError(record.line, err)
+		}
+
+		report.Added++
+
+		if record.id != "" {
+			ids[record.id] = item.ID
+		}
+	}
+
+	return report, nil
+}
+
+func (s importer) importRecord(ctx context.Context, record importRecord, listID string, parentID string) (Item, error) {
+	newItem := NewItem{
+		ListID:       listID,
+		ParentID:     parentID,
+		Title:        record.title,
+		Notes:        record.notes,
+		Assignee:     record.assignee,
+		DueAt:        record.dueAt,
+		Priority:     record.priority,
+		AutoComplete: record.autoComplete,
+	}
+
+	// Completing an item moves its series on: completed items are imported on their own
+	if !record.completed {
+		newItem.Recurrence = record.recurrence
+	}
+
+	item, err := s.service.AddItem(ctx, newItem)
+	if err != nil {
+		return item, err
+	}
+
+	if len(record.tags) > 0 {
+		item, err = s.service.TagItem(ctx, item.ID, record.tags)
+		if err != nil {
+			return item, err
+		}
+	}
+
+	if record.completed {
+		completed := true
+
+		item, err = s.service.UpdateItem(ctx, item.ID, ItemUpdate{Completed: &completed})
+		if err != nil {
+			return item, err
+		}
+	}
+
+	return item, nil
+}
+
+// importLineError attaches the line of a record to the errors exposed to clients.
+func importLineError(line int, err error) error {
+	var serr interface{ ServiceError() bool }
+	if !errors.As(err, &serr) || !serr.ServiceError() {
+		return err
+	}
+
+	message := err.Error()
+
+	var verr interface{ Violations() map[string][]string }
+	if errors.As(err, &verr) {
+		var violations []string
+
+		for _, v := range verr.Violations() {
+			violations = append(violations, v...)
+		}
+
+		sort.Strings(violations)
+
+		message = strings.Join(violations, "; ")
+	}
+
+	return errors.WithStack(ImportError{Errors: []LineError{{Line: line, Message: message}}})
+}
+
+func decodeItems(format Format, data []byte) ([]importRecord, []LineError) {
+	switch format {
+	case FormatJSON:
+		return decodeJSONItems(data)
+	case FormatCSV:
+		return decodeCSVItems(data)
+	case FormatTodoTxt:
+		return decodeTodoTxtItems(data)
+	case FormatICalendar:
+		return decodeICalendarItems(data)
+	default:
+		return nil, nil
+	}
+}
+
+func validateImport(options ImportOptions) error {
+	violations := make(map[string][]string)
+
+	if !options.Format.valid() {
+		violations = formatError().violations
+	}
+
+	if options.Strategy != ImportMerge && options.Strategy != ImportReplace {
+		violations["strategy"] = append(violations["strategy"], "strategy must be either merge or replace")
+	}
+
+	if len(violations) > 0 {
+		return errors.WithStack(validationError{violations: violations})
+	}
+
+	return nil
+}
+
+// validateImportRecords checks the records before importing any of them.
+func validateImportRecords(records []importRecord) []LineError {
+	var lineErrs []LineError
+
+	ids := make(map[string]bool, len(records))
+
+	for _, record := range records {
+		if strings.TrimSpace(record.title) == "" {
+			lineErrs = append(lineErrs, LineError{Line: record.line, Message: "title cannot be empty"})
+		}
+
+		if record.parentID != "" && !ids[record.parentID] {
+			lineErrs = append(lineErrs, LineError{
+				Line:    record.line,
+				Message: fmt.Sprintf("parent %q must be listed before its subtasks", record.parentID),
+			})
+		}
+
+		if record.id == "" {
+			continue
+		}
+
+		if ids[record.id] {
+			lineErrs = append(lineErrs, LineError{Line: record.line, Message: fmt.Sprintf("duplicate ID %q", record.id)})
+		}
+
+		ids[record.id] = true
+	}
+
+	return lineErrs
+}
@@ -0,0 +1,202 @@
+package todo_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+func exportItems(t *testing.T, service Service, format Format) string {
+	t.Helper()
+
+	export, err := service.ExportItems(context.Background(), "", format)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	_, err = export.WriteTo(&buf)
+	require.NoError(t, err)
+
+	return buf.String()
+}
+
+func TestService_ExportImport_RoundTrip(t *testing.T) {
+	for _, format := range []Format{FormatJSON, FormatCSV, FormatTodoTxt, FormatICalendar} {
+		format := format
+
+		t.Run(string(format), func(t *testing.T) {
+			ctx := context.Background()
+			source, _ := newTestService(testServiceConfig{})
+
+			dueAt := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+			parent, err := source.AddItem(ctx, NewItem{Title: "Plan the trip", DueAt: &dueAt, Priority: PriorityHigh})
+			require.NoError(t, err)
+
+			_, err = source.TagItem(ctx, parent.ID, []string{"travel"})
+			require.NoError(t, err)
+
+			_, err = source.AddItem(ctx, NewItem{ParentID: parent.ID, Title: "Book flights, hotel"})
+			require.NoError(t, err)
+
+			data := exportItems(t, source, format)
+
+			events := &recordingEvents{}
+			target, _ := newTestService(testServiceConfig{Events: events})
+
+			report, err := target.ImportItems(ctx, strings.NewReader(data), ImportOptions{Format: format})
+			require.NoError(t, err)
+			assert.Equal(t, ImportReport{Added: 2}, report)
+			assert.Len(t, events.added, 2)
+
+			items, err := target.ListItems(ctx, ItemFilter{})
+			require.NoError(t, err)
+			require.Len(t, items, 2)
+
+			assert.Equal(t, "Plan the trip", items[0].Title)
+			assert.Equal(t, PriorityHigh, items[0].Priority)
+			require.NotNil(t, items[0].DueAt)
+			assert.True(t, dueAt.Equal(*items[0].DueAt))
+			require.Len(t, items[0].Tags, 1)
+			assert.Equal(t, "travel", items[0].Tags[0].Name)
+
+			assert.Equal(t, "Book flights, hotel", items[1].Title)
+			assert.Equal(t, items[0].ID, items[1].ParentID)
+		})
+	}
+}
+
+func TestService_ExportItems_InvalidFormat(t *testing.T) {
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.ExportItems(context.Background(), "", "xml")
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_ImportItems_LineErrors(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	data := "title,completed,priority\nBuy milk,true,\n,false,\nBuy bread,maybe,critical\n"
+
+	_, err := service.ImportItems(ctx, strings.NewReader(data), ImportOptions{Format: FormatCSV})
+	require.Error(t, err)
+	assert.True(t, isValidationError(err))
+
+	var importErr ImportError
+	require.True(t, errors.As(err, &importErr))
+	assert.Equal(
+		t,
+		map[string][]string{
+			"line 3": {"title cannot be empty"},
+			"line 4": {"completed must be either true or false", "priority must be one of none, low, medium, high or urgent"},
+		},
+		importErr.Violations(),
+	)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items, "nothing is imported when a line is invalid")
+	assert.Empty(t, events.added)
+}
+
+func TestService_ImportItems_DryRun(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	report, err := service.ImportItems(
+		ctx,
+		strings.NewReader("Buy milk\nx Buy bread\n"),
+		ImportOptions{Format: FormatTodoTxt, DryRun: true},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, ImportReport{Added: 2, DryRun: true}, report)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	assert.Empty(t, events.added)
+}
+
+func TestService_ImportItems_Merge(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	report, err := service.ImportItems(
+		ctx,
+		strings.NewReader(`[{"title": "Buy milk"}, {"title": "Buy bread"}]`),
+		ImportOptions{Format: FormatJSON},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, ImportReport{Added: 1, Skipped: 1}, report)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+}
+
+func TestService_ImportItems_Replace(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	report, err := service.ImportItems(
+		ctx,
+		strings.NewReader(`[{"title": "Buy milk"}, {"title": "Buy bread"}]`),
+		ImportOptions{Format: FormatJSON, Strategy: ImportReplace},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, ImportReport{Added: 2, Deleted: 1}, report)
+
+	trash, err := service.ListTrash(ctx, DefaultListID)
+	require.NoError(t, err)
+	assert.Len(t, trash, 1)
+}
+
+func TestService_ImportItems_ICalendar(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	data := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTODO",
+		"UID:1",
+		"SUMMARY:Water the plants\\, all",
+		"  of them",
+		"DUE;VALUE=DATE:20210301",
+		"PRIORITY:2",
+		"CATEGORIES:home,garden",
+		"BEGIN:VALARM",
+		"SUMMARY:Ignored",
+		"END:VALARM",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	_, err := service.ImportItems(ctx, strings.NewReader(data), ImportOptions{Format: FormatICalendar})
+	require.NoError(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	assert.Equal(t, "Water the plants, all of them", items[0].Title)
+	assert.Equal(t, PriorityHigh, items[0].Priority)
+	assert.Len(t, items[0].Tags, 2)
+}
@@ -0,0 +1,240 @@
+package todo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+)
+
+// DefaultListID is the ID of the list items belong to unless told otherwise.
+const DefaultListID = "default"
+
+// defaultListName is the name of the default list when it gets created.
+const defaultListName = "Default"
+
+// List is a named collection of items.
+type List struct {
+	ID          string
+	Name        string
+	Description string
+	Archived    bool
+}
+
+// NewList contains the details of a new List.
+type NewList struct {
+	Name        string
+	Description string
+}
+
+// ListUpdate contains updates of an existing list.
+type ListUpdate struct {
+	Name        *string
+	Description *string
+	Archived    *bool
+}
+
+func (u ListUpdate) update(list List) List {
+	if u.Name != nil {
+		list.Name = *u.Name
+	}
+
+	if u.Description != nil {
+		list.Description = *u.Description
+	}
+
+	if u.Archived != nil {
+		list.Archived = *u.Archived
+	}
+
+	return list
+}
+
+// ListStore persists lists.
+type ListStore interface {
+	// StoreList stores a list.
+	StoreList(ctx context.Context, list List) error
+
+	// GetAllLists returns all lists.
+	GetAllLists(ctx context.Context) ([]List, error)
+
+	// GetOneList returns a single list by its ID.
+	GetOneList(ctx context.Context, id string) (List, error)
+
+	// DeleteOneList deletes a single list by its ID.
+	DeleteOneList(ctx context.Context, id string) error
+}
+
+// ListNotFoundError is returned if a list cannot be found.
+type ListNotFoundError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (ListNotFoundError) Error() string {
+	return "list not found"
+}
+
+// Details returns error details.
+func (e ListNotFoundError) Details() []interface{} {
+	return []interface{}{"list_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (ListNotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (ListNotFoundError) ServiceError() bool {
+	return true
+}
+
+func (s service) CreateList(ctx context.Context, newList NewList) (List, error) {
+	if newList.Name == "" {
+		return List{}, errors.WithStack(validationError{violations: map[string][]string{
+			"name": {
+				"name cannot be empty",
+			},
+		}})
+	}
+
+	id, err := s.idgenerator.Generate()
+	if err != nil {
+		return List{}, err
+	}
+
+	list := List{
+		ID:          id,
+		Name:        newList.Name,
+		Description: newList.Description,
+	}
+
+	err = s.listStore.StoreList(ctx, list)
+	if err != nil {
+		return List{}, errors.WithMessage(err, "create list")
+	}
+
+	return list, nil
+}
+
+func (s service) ListLists(ctx context.Context) ([]List, error) {
+	// Make sure the default list is always listed
+	_, err := s.getList(ctx, DefaultListID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listStore.GetAllLists(ctx)
+}
+
+func (s service) GetList(ctx context.Context, id string) (List, error) {
+	list, err := s.getList(ctx, id)
+	if err != nil {
+		return List{}, errors.WithMessage(err, "get list")
+	}
+
+	return list, nil
+}
+
+func (s service) UpdateList(ctx context.Context, id string, listUpdate ListUpdate) (List, error) {
+	list, err := s.getList(ctx, id)
+	if err != nil {
+		return List{}, err
+	}
+
+	updatedList := listUpdate.update(list)
+
+	if updatedList.Name == "" {
+		return List{}, errors.WithStack(validationError{violations: map[string][]string{
+			"name": {
+				"name cannot be empty",
+			},
+		}})
+	}
+
+	if updatedList.ID == DefaultListID && updatedList.Archived {
+		return List{}, errors.WithStack(validationError{violations: map[string][]string{
+			"archived": {
+				"the default list cannot be archived",
+			},
+		}})
+	}
+
+	if list == updatedList {
+		return list, nil
+	}
+
+	err = s.listStore.StoreList(ctx, updatedList)
+	if err != nil {
+		return List{}, errors.WithMessage(err, "update list")
+	}
+
+	return updatedList, nil
+}
+
+func (s service) DeleteList(ctx context.Context, id string) error {
+	if id == DefaultListID {
+		return errors.WithStack(validationError{violations: map[string][]string{
+			"id": {
+				"the default list cannot be deleted",
+			},
+		}})
+	}
+
+	_, err := s.listStore.GetOneList(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete list")
+	}
+
+	err = s.trashStore.PurgeAll(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete list items")
+	}
+
+	s.collectAttachments(ctx)
+
+	err = s.listStore.DeleteOneList(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete list")
+	}
+
+	return nil
+}
+
+// getList returns a list, creating the default list on first use.
+func (s service) getList(ctx context.Context, id string) (List, error) {
+	list, err := s.listStore.GetOneList(ctx, id)
+	if id == DefaultListID && errors.As(err, &ListNotFoundError{}) {
+		list = List{
+			ID:   DefaultListID,
+			Name: defaultListName,
+		}
+
+		err = s.listStore.StoreList(ctx, list)
+	}
+	if err != nil {
+		return List{}, err
+	}
+
+	return list, nil
+}
+
+// getWritableList returns a list items can be added to.
+func (s service) getWritableList(ctx context.Context, id string) (List, error) {
+	list, err := s.getList(ctx, id)
+	if err != nil {
+		return List{}, err
+	}
+
+	if list.Archived {
+		return List{}, errors.WithStack(validationError{violations: map[string][]string{
+			"listId": {
+				"list is archived",
+			},
+		}})
+	}
+
+	return list, nil
+}
@@ -0,0 +1,166 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+func itemIDs(items []Item) []string {
+	ids := make([]string, 0, len(items))
+
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	return ids
+}
+
+func isValidationError(err error) bool {
+	var verr interface{ Validation() bool }
+
+	return errors.As(err, &verr) && verr.Validation()
+}
+
+func TestService_DefaultList(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultListID, item.ListID)
+
+	lists, err := service.ListLists(ctx)
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+	assert.Equal(t, DefaultListID, lists[0].ID)
+
+	err = service.DeleteList(ctx, DefaultListID)
+	assert.True(t, isValidationError(err))
+
+	archived := true
+
+	_, err = service.UpdateList(ctx, DefaultListID, ListUpdate{Archived: &archived})
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_CreateList(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.CreateList(ctx, NewList{})
+	require.Error(t, err)
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries", Description: "Weekly shopping"})
+	require.NoError(t, err)
+
+	got, err := service.GetList(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, list, got)
+
+	_, err = service.GetList(ctx, "missing")
+	assert.True(t, errors.As(err, &ListNotFoundError{}))
+}
+
+func TestService_ItemsAreScopedToLists(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Work"})
+	require.NoError(t, err)
+
+	home, err := service.AddItem(ctx, NewItem{Title: "Water the plants"})
+	require.NoError(t, err)
+
+	work, err := service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Write the report"})
+	require.NoError(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{home.ID}, itemIDs(items))
+
+	items, err = service.ListItems(ctx, ItemFilter{ListID: list.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{work.ID}, itemIDs(items))
+
+	// Deleting the items of the default list keeps the other lists intact
+	require.NoError(t, service.DeleteItems(ctx, ""))
+
+	items, err = service.ListItems(ctx, ItemFilter{ListID: list.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{work.ID}, itemIDs(items))
+
+	_, err = service.AddItem(ctx, NewItem{ListID: "missing", Title: "Nowhere"})
+	assert.True(t, errors.As(err, &ListNotFoundError{}))
+}
+
+func TestService_MoveItem(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Someday"})
+	require.NoError(t, err)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Learn to juggle"})
+	require.NoError(t, err)
+
+	item, err = service.UpdateItem(ctx, item.ID, ItemUpdate{ListID: &list.ID})
+	require.NoError(t, err)
+	assert.Equal(t, list.ID, item.ListID)
+
+	items, err := service.ListItems(ctx, ItemFilter{ListID: list.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{item.ID}, itemIDs(items))
+
+	missing := "missing"
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{ListID: &missing})
+	assert.True(t, errors.As(err, &ListNotFoundError{}))
+}
+
+func TestService_ArchivedList(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Old projects"})
+	require.NoError(t, err)
+
+	archived := true
+
+	list, err = service.UpdateList(ctx, list.ID, ListUpdate{Archived: &archived})
+	require.NoError(t, err)
+	assert.True(t, list.Archived)
+
+	_, err = service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Too late"})
+	assert.True(t, isValidationError(err))
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Too late as well"})
+	require.NoError(t, err)
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{ListID: &list.ID})
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_DeleteList(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Party"})
+	require.NoError(t, err)
+
+	item, err := service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Order pizza"})
+	require.NoError(t, err)
+
+	require.NoError(t, service.DeleteList(ctx, list.ID))
+
+	_, err = service.GetList(ctx, list.ID)
+	assert.True(t, errors.As(err, &ListNotFoundError{}))
+
+	_, err = service.GetItem(ctx, item.ID)
+	assert.True(t, errors.As(err, &NotFoundError{}))
+}
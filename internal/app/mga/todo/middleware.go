@@ -2,38 +2,371 @@ package todo
 
 import (
 	"context"
-
-	"github.com/sagikazarmark/todobackend-go-kit/todo"
+	"io"
 )
 
 // Middleware is a service middleware.
-type Middleware func(todo.Service) todo.Service
+type Middleware func(Service) Service
+
+// FeatureMiddleware wraps a service in the middlewares implementing the features built on top of it
+// (recurrence, events, subtasks, history, undo, bulk changes, import, templates and sharing)
+// in the order they rely on each other.
+// The IDs of the records kept by the middlewares (eg. history entries) are generated by idgenerator.
+func FeatureMiddleware(idgenerator IDGenerator, store ServiceStore, events Events) Middleware {
+	return func(service Service) Service {
+		service = RecurrenceMiddleware()(service)
+		service = EventMiddleware(store, events)(service)
+		service = SubtaskMiddleware()(service)
+		service = HistoryMiddleware(idgenerator, store, store, events)(service)
+		service = OperationLogMiddleware(idgenerator, store, store, events)(service)
+		service = BulkMiddleware(store, events)(service)
+		service = ImportMiddleware(store, events)(service)
+		service = TemplateMiddleware(store, events)(service)
+		service = ShareMiddleware(idgenerator, store, store, events)(service)
+
+		return service
+	}
+}
 
 // DefaultMiddleware helps implementing partial middleware.
 type DefaultMiddleware struct {
-	Service todo.Service
+	Service Service
 }
 
-func (m DefaultMiddleware) AddItem(ctx context.Context, newItem todo.NewItem) (todo.Item, error) {
+func (m DefaultMiddleware) AddItem(ctx context.Context, newItem NewItem) (Item, error) {
 	return m.Service.AddItem(ctx, newItem)
 }
 
-func (m DefaultMiddleware) ListItems(ctx context.Context) ([]todo.Item, error) {
-	return m.Service.ListItems(ctx)
+func (m DefaultMiddleware) ListItems(ctx context.Context, filter ItemFilter) ([]Item, error) {
+	return m.Service.ListItems(ctx, filter)
 }
 
-func (m DefaultMiddleware) DeleteItems(ctx context.Context) error {
-	return m.Service.DeleteItems(ctx)
+func (m DefaultMiddleware) DeleteItems(ctx context.Context, listID string) error {
+	return m.Service.DeleteItems(ctx, listID)
 }
 
-func (m DefaultMiddleware) GetItem(ctx context.Context, id string) (todo.Item, error) {
+func (m DefaultMiddleware) GetItem(ctx context.Context, id string) (Item, error) {
 	return m.Service.GetItem(ctx, id)
 }
 
-func (m DefaultMiddleware) UpdateItem(ctx context.Context, id string, itemUpdate todo.ItemUpdate) (todo.Item, error) {
+func (m DefaultMiddleware) UpdateItem(ctx context.Context, id string, itemUpdate ItemUpdate) (Item, error) {
 	return m.Service.UpdateItem(ctx, id, itemUpdate)
 }
 
-func (m DefaultMiddleware) DeleteItem(ctx context.Context, id string) error {
-	return m.Service.DeleteItem(ctx, id)
+func (m DefaultMiddleware) ListItemTree(ctx context.Context, filter ItemFilter, depth int) ([]ItemTree, error) {
+	return m.Service.ListItemTree(ctx, filter, depth)
+}
+
+func (m DefaultMiddleware) DeleteItem(ctx context.Context, id string, cascade bool) error {
+	return m.Service.DeleteItem(ctx, id, cascade)
+}
+
+func (m DefaultMiddleware) ListTrash(ctx context.Context, listID string) ([]Item, error) {
+	return m.Service.ListTrash(ctx, listID)
+}
+
+func (m DefaultMiddleware) RestoreItem(ctx context.Context, id string) (Item, error) {
+	return m.Service.RestoreItem(ctx, id)
+}
+
+func (m DefaultMiddleware) PurgeItem(ctx context.Context, id string) error {
+	return m.Service.PurgeItem(ctx, id)
+}
+
+func (m DefaultMiddleware) EmptyTrash(ctx context.Context, listID string) error {
+	return m.Service.EmptyTrash(ctx, listID)
+}
+
+func (m DefaultMiddleware) CreateList(ctx context.Context, newList NewList) (List, error) {
+	return m.Service.CreateList(ctx, newList)
+}
+
+func (m DefaultMiddleware) ListLists(ctx context.Context) ([]List, error) {
+	return m.Service.ListLists(ctx)
+}
+
+func (m DefaultMiddleware) GetList(ctx context.Context, id string) (List, error) {
+	return m.Service.GetList(ctx, id)
+}
+
+func (m DefaultMiddleware) UpdateList(ctx context.Context, id string, listUpdate ListUpdate) (List, error) {
+	return m.Service.UpdateList(ctx, id, listUpdate)
+}
+
+func (m DefaultMiddleware) DeleteList(ctx context.Context, id string) error {
+	return m.Service.DeleteList(ctx, id)
+}
+
+func (m DefaultMiddleware) CreateTag(ctx context.Context, newTag NewTag) (Tag, error) {
+	return m.Service.CreateTag(ctx, newTag)
+}
+
+func (m DefaultMiddleware) ListTags(ctx context.Context) ([]Tag, error) {
+	return m.Service.ListTags(ctx)
+}
+
+func (m DefaultMiddleware) GetTag(ctx context.Context, id string) (Tag, error) {
+	return m.Service.GetTag(ctx, id)
+}
+
+func (m DefaultMiddleware) UpdateTag(ctx context.Context, id string, tagUpdate TagUpdate) (Tag, error) {
+	return m.Service.UpdateTag(ctx, id, tagUpdate)
+}
+
+func (m DefaultMiddleware) MergeTags(ctx context.Context, sourceID string, targetID string) (Tag, error) {
+	return m.Service.MergeTags(ctx, sourceID, targetID)
+}
+
+func (m DefaultMiddleware) DeleteTag(ctx context.Context, id string) error {
+	return m.Service.DeleteTag(ctx, id)
+}
+
+func (m DefaultMiddleware) TagItem(ctx context.Context, id string, tags []string) (Item, error) {
+	return m.Service.TagItem(ctx, id, tags)
+}
+
+func (m DefaultMiddleware) UntagItem(ctx context.Context, id string, tags []string) (Item, error) {
+	return m.Service.UntagItem(ctx, id, tags)
+}
+
+func (m DefaultMiddleware) AssignItem(ctx context.Context, id string, assignee string) (Item, error) {
+	return m.Service.AssignItem(ctx, id, assignee)
+}
+
+func (m DefaultMiddleware) UnassignItem(ctx context.Context, id string) (Item, error) {
+	return m.Service.UnassignItem(ctx, id)
+}
+
+func (m DefaultMiddleware) MoveItem(ctx context.Context, id string, move ItemMove) (Item, error) {
+	return m.Service.MoveItem(ctx, id, move)
+}
+
+func (m DefaultMiddleware) ItemHistory(ctx context.Context, id string) ([]HistoryEntry, error) {
+	return m.Service.ItemHistory(ctx, id)
+}
+
+func (m DefaultMiddleware) Undo(ctx context.Context, steps int) ([]Operation, error) {
+	return m.Service.Undo(ctx, steps)
+}
+
+func (m DefaultMiddleware) Redo(ctx context.Context, steps int) ([]Operation, error) {
+	return m.Service.Redo(ctx, steps)
+}
+
+func (m DefaultMiddleware) BulkAddItems(ctx context.Context, newItems []NewItem, mode BulkMode) ([]BulkResult, error) {
+	return m.Service.BulkAddItems(ctx, newItems, mode)
+}
+
+func (m DefaultMiddleware) BulkUpdateItems(
+	ctx context.Context,
+	updates []BulkItemUpdate,
+	mode BulkMode,
+) ([]BulkResult, error) {
+	return m.Service.BulkUpdateItems(ctx, updates, mode)
+}
+
+func (m DefaultMiddleware) BulkDeleteItems(
+	ctx context.Context,
+	ids []string,
+	cascade bool,
+	mode BulkMode,
+) ([]BulkResult, error) {
+	return m.Service.BulkDeleteItems(ctx, ids, cascade, mode)
+}
+
+func (m DefaultMiddleware) ExportItems(ctx context.Context, listID string, format Format) (Export, error) {
+	return m.Service.ExportItems(ctx, listID, format)
+}
+
+func (m DefaultMiddleware) ImportItems(ctx context.Context, data io.Reader, options ImportOptions) (ImportReport, error) {
+	return m.Service.ImportItems(ctx, data, options)
+}
+
+func (m DefaultMiddleware) SearchItems(ctx context.Context, query SearchQuery) ([]SearchResult, error) {
+	return m.Service.SearchItems(ctx, query)
+}
+
+func (m DefaultMiddleware) AddComment(ctx context.Context, itemID string, newComment NewComment) (Comment, error) {
+	return m.Service.AddComment(ctx, itemID, newComment)
+}
+
+func (m DefaultMiddleware) ListComments(ctx context.Context, itemID string) ([]Comment, error) {
+	return m.Service.ListComments(ctx, itemID)
+}
+
+func (m DefaultMiddleware) GetComment(ctx context.Context, id string) (Comment, error) {
+	return m.Service.GetComment(ctx, id)
+}
+
+func (m DefaultMiddleware) UpdateComment(ctx context.Context, id string, body string) (Comment, error) {
+	return m.Service.UpdateComment(ctx, id, body)
+}
+
+func (m DefaultMiddleware) DeleteComment(ctx context.Context, id string) error {
+	return m.Service.DeleteComment(ctx, id)
+}
+
+func (m DefaultMiddleware) AddAttachment(
+	ctx context.Context,
+	itemID string,
+	newAttachment NewAttachment,
+) (Attachment, error) {
+	return m.Service.AddAttachment(ctx, itemID, newAttachment)
+}
+
+func (m DefaultMiddleware) ListAttachments(ctx context.Context, itemID string) ([]Attachment, error) {
+	return m.Service.ListAttachments(ctx, itemID)
+}
+
+func (m DefaultMiddleware) GetAttachment(ctx context.Context, id string) (Attachment, error) {
+	return m.Service.GetAttachment(ctx, id)
+}
+
+func (m DefaultMiddleware) DownloadAttachment(
+	ctx context.Context,
+	id string,
+	link AttachmentLink,
+) (AttachmentDownload, error) {
+	return m.Service.DownloadAttachment(ctx, id, link)
+}
+
+func (m DefaultMiddleware) DeleteAttachment(ctx context.Context, id string) error {
+	return m.Service.DeleteAttachment(ctx, id)
+}
+
+func (m DefaultMiddleware) CreateShareToken(
+	ctx context.Context,
+	listID string,
+	newShareToken NewShareToken,
+) (ShareToken, error) {
+	return m.Service.CreateShareToken(ctx, listID, newShareToken)
+}
+
+func (m DefaultMiddleware) ListShareTokens(ctx context.Context, listID string) ([]ShareToken, error) {
+	return m.Service.ListShareTokens(ctx, listID)
+}
+
+func (m DefaultMiddleware) RevokeShareToken(ctx context.Context, id string) error {
+	return m.Service.RevokeShareToken(ctx, id)
+}
+
+func (m DefaultMiddleware) ListShareAccess(ctx context.Context, listID string) ([]ShareAccessEntry, error) {
+	return m.Service.ListShareAccess(ctx, listID)
+}
+
+func (m DefaultMiddleware) InviteCollaborator(
+	ctx context.Context,
+	listID string,
+	invitation Invitation,
+) (Collaborator, error) {
+	return m.Service.InviteCollaborator(ctx, listID, invitation)
+}
+
+func (m DefaultMiddleware) ListCollaborators(ctx context.Context, listID string) ([]Collaborator, error) {
+	return m.Service.ListCollaborators(ctx, listID)
+}
+
+func (m DefaultMiddleware) RemoveCollaborator(ctx context.Context, listID string, email string) error {
+	return m.Service.RemoveCollaborator(ctx, listID, email)
+}
+
+func (m DefaultMiddleware) GetSharedList(ctx context.Context, token string) (SharedList, error) {
+	return m.Service.GetSharedList(ctx, token)
+}
+
+func (m DefaultMiddleware) AddSharedItem(ctx context.Context, token string, newItem NewItem) (Item, error) {
+	return m.Service.AddSharedItem(ctx, token, newItem)
+}
+
+func (m DefaultMiddleware) UpdateSharedItem(
+	ctx context.Context,
+	token string,
+	id string,
+	itemUpdate ItemUpdate,
+) (Item, error) {
+	return m.Service.UpdateSharedItem(ctx, token, id, itemUpdate)
+}
+
+func (m DefaultMiddleware) StartTimer(ctx context.Context, itemID string) (TimeEntry, error) {
+	return m.Service.StartTimer(ctx, itemID)
+}
+
+func (m DefaultMiddleware) StopTimer(ctx context.Context) (TimeEntry, error) {
+	return m.Service.StopTimer(ctx)
+}
+
+func (m DefaultMiddleware) GetTimer(ctx context.Context) (TimeEntry, error) {
+	return m.Service.GetTimer(ctx)
+}
+
+func (m DefaultMiddleware) ListTimeEntries(ctx context.Context, filter TimeEntryFilter) ([]TimeEntry, error) {
+	return m.Service.ListTimeEntries(ctx, filter)
+}
+
+func (m DefaultMiddleware) TimeTotals(ctx context.Context, filter TimeEntryFilter) (TimeTotals, error) {
+	return m.Service.TimeTotals(ctx, filter)
+}
+
+func (m DefaultMiddleware) ExportTimesheet(ctx context.Context, filter TimeEntryFilter) (Timesheet, error) {
+	return m.Service.ExportTimesheet(ctx, filter)
+}
+
+func (m DefaultMiddleware) CreateTemplate(ctx context.Context, newTemplate NewTemplate) (Template, error) {
+	return m.Service.CreateTemplate(ctx, newTemplate)
+}
+
+func (m DefaultMiddleware) ListTemplates(ctx context.Context) ([]Template, error) {
+	return m.Service.ListTemplates(ctx)
+}
+
+func (m DefaultMiddleware) GetTemplate(ctx context.Context, id string) (Template, error) {
+	return m.Service.GetTemplate(ctx, id)
+}
+
+func (m DefaultMiddleware) UpdateTemplate(
+	ctx context.Context,
+	id string,
+	templateUpdate TemplateUpdate,
+) (Template, error) {
+	return m.Service.UpdateTemplate(ctx, id, templateUpdate)
+}
+
+func (m DefaultMiddleware) DeleteTemplate(ctx context.Context, id string) error {
+	return m.Service.DeleteTemplate(ctx, id)
+}
+
+func (m DefaultMiddleware) InstantiateTemplate(
+	ctx context.Context,
+	id string,
+	instantiation TemplateInstantiation,
+) (TemplateInstance, error) {
+	return m.Service.InstantiateTemplate(ctx, id, instantiation)
+}
+
+func (m DefaultMiddleware) CreateRule(ctx context.Context, newRule NewRule) (Rule, error) {
+	return m.Service.CreateRule(ctx, newRule)
+}
+
+func (m DefaultMiddleware) ListRules(ctx context.Context) ([]Rule, error) {
+	return m.Service.ListRules(ctx)
+}
+
+func (m DefaultMiddleware) GetRule(ctx context.Context, id string) (Rule, error) {
+	return m.Service.GetRule(ctx, id)
+}
+
+func (m DefaultMiddleware) UpdateRule(ctx context.Context, id string, ruleUpdate RuleUpdate) (Rule, error) {
+	return m.Service.UpdateRule(ctx, id, ruleUpdate)
+}
+
+func (m DefaultMiddleware) DeleteRule(ctx context.Context, id string) error {
+	return m.Service.DeleteRule(ctx, id)
+}
+
+func (m DefaultMiddleware) EvaluateRule(ctx context.Context, id string, itemID string) (RuleEvaluation, error) {
+	return m.Service.EvaluateRule(ctx, id, itemID)
+}
+
+func (m DefaultMiddleware) ListRuleExecutions(ctx context.Context, id string) ([]RuleExecution, error) {
+	return m.Service.ListRuleExecutions(ctx, id)
 }
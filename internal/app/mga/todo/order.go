@@ -0,0 +1,220 @@
+package todo
+
+import (
+	"context"
+	"sort"
+
+	"emperror.dev/errors"
+)
+
+// ItemSort tells how items are ordered.
+type ItemSort string
+
+// Item orders.
+const (
+	// SortManual orders items by their position set by moving them around.
+	SortManual ItemSort = "manual"
+
+	// SortSmart orders incomplete items first, then by priority (most important first),
+	// due date (earliest first, items without a due date last) and finally by their position.
+	SortSmart ItemSort = "smart"
+)
+
+func (s ItemSort) valid() bool {
+	return s == "" || s == SortManual || s == SortSmart
+}
+
+// sortItems orders items in place.
+func sortItems(items []Item, order ItemSort) {
+	less := lessManual
+
+	if order == SortSmart {
+		less = lessSmart
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return less(items[i], items[j]) })
+}
+
+func lessManual(a Item, b Item) bool {
+	if a.Rank != b.Rank {
+		return a.Rank < b.Rank
+	}
+
+	if a.Order != b.Order {
+		return a.Order < b.Order
+	}
+
+	return a.ID < b.ID
+}
+
+func lessSmart(a Item, b Item) bool {
+	if a.Completed != b.Completed {
+		return !a.Completed
+	}
+
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+
+	if (a.DueAt == nil) != (b.DueAt == nil) {
+		return a.DueAt != nil
+	}
+
+	if a.DueAt != nil && !a.DueAt.Equal(*b.DueAt) {
+		return a.DueAt.Before(*b.DueAt)
+	}
+
+	return lessManual(a, b)
+}
+
+// ItemMove tells where an item is moved in the manual order of its list.
+// Exactly one of the fields should be set.
+type ItemMove struct {
+	// Before moves the item right before another item.
+	Before string
+
+	// After moves the item right after another item.
+	After string
+}
+
+func (s service) MoveItem(ctx context.Context, id string, move ItemMove) (Item, error) {
+	targetID := move.Before
+	if targetID == "" {
+		targetID = move.After
+	}
+
+	if (move.Before == "") == (move.After == "") || targetID == id {
+		return Item{}, errors.WithStack(validationError{violations: map[string][]string{
+			"move": {
+				"an item must be moved either before or after another item",
+			},
+		}})
+	}
+
+	item, err := s.store.GetOne(ctx, id)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "move item")
+	}
+
+	target, err := s.store.GetOne(ctx, targetID)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "get target item")
+	}
+
+	if target.ListID != item.ListID {
+		return Item{}, errors.WithStack(validationError{violations: map[string][]string{
+			"move": {
+				"an item can only be moved next to an item in the same list",
+			},
+		}})
+	}
+
+	items, err := s.getOrderedItems(ctx, item)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "move item")
+	}
+
+	prev, next := neighbours(items, target.ID, move.After != "")
+
+	// Items added before ranks existed (or at the same time) have no room between them
+	if (prev != nil && prev.Rank == "") || (next != nil && next.Rank == "") ||
+		(prev != nil && next != nil && prev.Rank >= next.Rank) {
+		err := s.rerankItems(ctx, items)
+		if err != nil {
+			return Item{}, errors.WithMessage(err, "rerank items")
+		}
+
+		prev, next = neighbours(items, target.ID, move.After != "")
+	}
+
+	var prevRank, nextRank string
+
+	if prev != nil {
+		prevRank = prev.Rank
+	}
+
+	if next != nil {
+		nextRank = next.Rank
+	}
+
+	// Only the moved item is written (unless the list had to be reranked)
+	item.Rank = rankBetween(prevRank, nextRank)
+
+	err = s.store.Store(ctx, item)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "move item")
+	}
+
+	return item, nil
+}
+
+// getOrderedItems returns the other items in the list of an item in manual order.
+func (s service) getOrderedItems(ctx context.Context, item Item) ([]Item, error) {
+	items, err := s.store.GetAll(ctx, ItemFilter{ListID: item.ListID})
+	if err != nil {
+		return nil, err
+	}
+
+	sortItems(items, SortManual)
+
+	for i := range items {
+		if items[i].ID == item.ID {
+			return append(items[:i], items[i+1:]...), nil
+		}
+	}
+
+	return items, nil
+}
+
+// neighbours returns the items an item is moved between.
+func neighbours(items []Item, targetID string, after bool) (prev *Item, next *Item) {
+	for i := range items {
+		if items[i].ID != targetID {
+			continue
+		}
+
+		if after {
+			i++
+		}
+
+		if i > 0 {
+			prev = &items[i-1]
+		}
+
+		if i < len(items) {
+			next = &items[i]
+		}
+
+		return prev, next
+	}
+
+	return nil, nil
+}
+
+// rerankItems spreads the ranks of (already ordered) items evenly, storing the items whose rank changed.
+func (s service) rerankItems(ctx context.Context, items []Item) error {
+	for i, rank := range spreadRanks(len(items)) {
+		if items[i].Rank == rank {
+			continue
+		}
+
+		items[i].Rank = rank
+
+		err := s.store.Store(ctx, items[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextRank returns the rank of an item appended to the end of a list.
+func (s service) nextRank(ctx context.Context, listID string) (string, error) {
+	rank, err := s.store.GetMaxRank(ctx, listID)
+	if err != nil {
+		return "", errors.WithMessage(err, "get max rank")
+	}
+
+	return rankAfter(rank), nil
+}
@@ -0,0 +1,179 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+func TestParsePriority(t *testing.T) {
+	priority, err := ParsePriority("High")
+	require.NoError(t, err)
+	assert.Equal(t, PriorityHigh, priority)
+	assert.Equal(t, "high", priority.String())
+
+	priority, err = ParsePriority("")
+	require.NoError(t, err)
+	assert.Equal(t, PriorityNone, priority)
+
+	_, err = ParsePriority("critical")
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_Priority(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.AddItem(ctx, NewItem{Title: "Buy milk", Priority: Priority(10)})
+	assert.True(t, isValidationError(err))
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk", Priority: PriorityLow})
+	require.NoError(t, err)
+	assert.Equal(t, PriorityLow, item.Priority)
+
+	priority := PriorityUrgent
+
+	item, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Priority: &priority})
+	require.NoError(t, err)
+	assert.Equal(t, PriorityUrgent, item.Priority)
+}
+
+func TestService_ListItems_Sort(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	now := time.Now()
+	tomorrow := now.Add(24 * time.Hour)
+
+	items := []NewItem{
+		{Title: "Water the plants"},
+		{Title: "Pay the bills", Priority: PriorityHigh, DueAt: &tomorrow},
+		{Title: "Buy milk", Priority: PriorityHigh, DueAt: &now},
+		{Title: "Call mom", Priority: PriorityHigh},
+		{Title: "File taxes", Priority: PriorityUrgent},
+		{Title: "Clean the house", Priority: PriorityLow},
+	}
+
+	for _, newItem := range items {
+		_, err := service.AddItem(ctx, newItem)
+		require.NoError(t, err)
+	}
+
+	completed := true
+
+	_, err := service.UpdateItem(ctx, "05", ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	_, err = service.ListItems(ctx, ItemFilter{Sort: "alphabetical"})
+	assert.True(t, isValidationError(err))
+
+	manual, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"01", "02", "03", "04", "05", "06"}, itemIDs(manual))
+
+	smart, err := service.ListItems(ctx, ItemFilter{Sort: SortSmart})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"03", "02", "04", "06", "01", "05"}, itemIDs(smart))
+}
+
+func TestService_MoveItem_Order(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	for i := 0; i < 12; i++ {
+		_, err := service.AddItem(ctx, NewItem{Title: "Item"})
+		require.NoError(t, err)
+	}
+
+	// Items are listed in the order they were added
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"01", "02", "03", "04", "05", "06", "07", "08", "09", "10", "11", "12"}, itemIDs(items))
+
+	_, err = service.MoveItem(ctx, "01", ItemMove{})
+	assert.True(t, isValidationError(err))
+
+	_, err = service.MoveItem(ctx, "01", ItemMove{Before: "02", After: "03"})
+	assert.True(t, isValidationError(err))
+
+	_, err = service.MoveItem(ctx, "01", ItemMove{Before: "01"})
+	assert.True(t, isValidationError(err))
+
+	_, err = service.MoveItem(ctx, "01", ItemMove{Before: "13"})
+	assert.Error(t, err)
+
+	_, err = service.MoveItem(ctx, "12", ItemMove{Before: "01"})
+	require.NoError(t, err)
+
+	_, err = service.MoveItem(ctx, "01", ItemMove{After: "12"})
+	require.NoError(t, err)
+
+	_, err = service.MoveItem(ctx, "02", ItemMove{After: "11"})
+	require.NoError(t, err)
+
+	// Moving items between the same two items over and over again
+	for i := 0; i < 20; i++ {
+		_, err = service.MoveItem(ctx, "03", ItemMove{After: "12"})
+		require.NoError(t, err)
+
+		_, err = service.MoveItem(ctx, "04", ItemMove{After: "12"})
+		require.NoError(t, err)
+	}
+
+	items, err = service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"12", "04", "03", "01", "05", "06", "07", "08", "09", "10", "11", "02"}, itemIDs(items))
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	other, err := service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Buy milk"})
+	require.NoError(t, err)
+
+	_, err = service.MoveItem(ctx, other.ID, ItemMove{Before: "01"})
+	assert.True(t, isValidationError(err), "items cannot be moved next to items in other lists")
+}
+
+func TestEventMiddleware_MoveItem(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	first, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	second, err := service.AddItem(ctx, NewItem{Title: "Buy bread"})
+	require.NoError(t, err)
+
+	_, err = service.MoveItem(ctx, second.ID, ItemMove{Before: first.ID})
+	require.NoError(t, err)
+
+	assert.Equal(t, []ItemUpdated{{ID: second.ID, ListID: DefaultListID}}, events.updated)
+}
+
+func TestService_MoveItem_Unranked(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(testServiceConfig{})
+
+	// Items stored before ranks were introduced
+	for _, id := range []string{"01", "02", "03"} {
+		err := store.Store(ctx, Item{ID: id, ListID: DefaultListID, Title: "Item"})
+		require.NoError(t, err)
+	}
+
+	_, err := service.MoveItem(ctx, "03", ItemMove{Before: "02"})
+	require.NoError(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"01", "03", "02"}, itemIDs(items))
+
+	for _, item := range items {
+		assert.NotEmpty(t, item.Rank)
+	}
+}
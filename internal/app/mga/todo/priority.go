@@ -0,0 +1,58 @@
+package todo
+
+import (
+	"strings"
+
+	"emperror.dev/errors"
+)
+
+// Priority tells how important an item is.
+type Priority int
+
+// Priority levels from the least to the most important.
+const (
+	PriorityNone Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+	PriorityUrgent
+)
+
+var priorityNames = []string{"none", "low", "medium", "high", "urgent"}
+
+// String returns the name of the priority.
+func (p Priority) String() string {
+	if !p.valid() {
+		return "unknown"
+	}
+
+	return priorityNames[p]
+}
+
+func (p Priority) valid() bool {
+	return p >= PriorityNone && p <= PriorityUrgent
+}
+
+// ParsePriority returns a priority by its name (case insensitive).
+// An empty name means no priority.
+func ParsePriority(name string) (Priority, error) {
+	if name == "" {
+		return PriorityNone, nil
+	}
+
+	for i, n := range priorityNames {
+		if strings.EqualFold(n, name) {
+			return Priority(i), nil
+		}
+	}
+
+	return PriorityNone, errors.WithStack(priorityError())
+}
+
+const priorityViolation = "priority must be one of none, low, medium, high or urgent"
+
+func priorityError() validationError {
+	return validationError{violations: map[string][]string{
+		"priority": {priorityViolation},
+	}}
+}
@@ -0,0 +1,121 @@
+package todo
+
+import (
+	"strings"
+)
+
+// Ranks position items in their manual order.
+//
+// A rank is a string of base36 digits compared lexicographically:
+// there is always room for a new rank between two existing ones,
+// so moving an item only changes the rank of that item.
+// Ranks never end with the lowest digit, otherwise no rank would fit right before them.
+
+const (
+	rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	rankBase   = len(rankDigits)
+
+	// rankWidth is the number of digits used for ranks of appended and reranked items.
+	rankWidth = 6
+
+	// rankStep is the gap left between appended items (in units of the last digit).
+	rankStep = 36 * 36
+)
+
+// rankBetween returns a rank between two ranks.
+// An empty prev means the beginning, an empty next means the end of the list.
+func rankBetween(prev string, next string) string {
+	var rank strings.Builder
+
+	for i := 0; ; i++ {
+		p := rankDigit(prev, i, 0)
+		n := rankDigit(next, i, rankBase)
+
+		if p == n {
+			rank.WriteByte(rankDigits[p])
+
+			continue
+		}
+
+		if mid := (p + n) / 2; mid > p {
+			rank.WriteByte(rankDigits[mid])
+
+			return rank.String()
+		}
+
+		// There is no room on this digit: anything starting with prev's digit is before next
+		rank.WriteByte(rankDigits[p])
+		next = ""
+	}
+}
+
+// rankAfter returns a rank after the last rank of a list.
+// Unlike the midpoint, a fixed step keeps ranks short when items are appended one after the other.
+func rankAfter(last string) string {
+	if last == "" {
+		return rankBetween("", "")
+	}
+
+	value := 0
+
+	for i := 0; i < rankWidth; i++ {
+		value = value*rankBase + rankDigit(last, i, 0)
+	}
+
+	value += rankStep
+
+	if value >= pow(rankBase, rankWidth) {
+		return rankBetween(last, "")
+	}
+
+	return formatRank(value, rankWidth)
+}
+
+// spreadRanks returns n evenly spaced ranks.
+func spreadRanks(n int) []string {
+	width := rankWidth
+
+	for pow(rankBase, width) <= (n+1)*rankStep {
+		width++
+	}
+
+	space := pow(rankBase, width)
+	ranks := make([]string, 0, n)
+
+	for i := 1; i <= n; i++ {
+		ranks = append(ranks, formatRank(space/(n+1)*i, width))
+	}
+
+	return ranks
+}
+
+// rankDigit returns the value of a digit of a rank or the default value when the rank is shorter.
+func rankDigit(rank string, i int, def int) int {
+	if i >= len(rank) {
+		return def
+	}
+
+	return strings.IndexByte(rankDigits, rank[i])
+}
+
+func formatRank(value int, width int) string {
+	rank := make([]byte, width)
+
+	for i := width - 1; i >= 0; i-- {
+		rank[i] = rankDigits[value%rankBase]
+		value /= rankBase
+	}
+
+	// Trailing zeros don't change the order
+	return strings.TrimRight(string(rank), rankDigits[:1])
+}
+
+func pow(x int, y int) int {
+	result := 1
+
+	for i := 0; i < y; i++ {
+		result *= x
+	}
+
+	return result
+}
@@ -0,0 +1,84 @@
+package todo
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		prev string
+		next string
+	}{
+		{"", ""},
+		{"", "i"},
+		{"i", ""},
+		{"a", "b"},
+		{"a", "a1"},
+		{"a0i", "a1"},
+		{"z", ""},
+		{"", "01"},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.prev+"_"+test.next, func(t *testing.T) {
+			rank := rankBetween(test.prev, test.next)
+
+			assert.Greater(t, rank, test.prev)
+
+			if test.next != "" {
+				assert.Less(t, rank, test.next)
+			}
+
+			assert.NotEqual(t, byte('0'), rank[len(rank)-1])
+		})
+	}
+}
+
+func TestRankBetween_Repeated(t *testing.T) {
+	prev, next := "a", "b"
+
+	// Moving items between the same two items over and over again
+	for i := 0; i < 100; i++ {
+		rank := rankBetween(prev, next)
+		require.Greater(t, rank, prev)
+		require.Less(t, rank, next)
+
+		if i%2 == 0 {
+			next = rank
+		} else {
+			prev = rank
+		}
+	}
+}
+
+func TestRankAfter(t *testing.T) {
+	rank := ""
+
+	for i := 0; i < 10000; i++ {
+		next := rankAfter(rank)
+		require.Greater(t, next, rank)
+
+		rank = next
+	}
+
+	assert.LessOrEqual(t, len(rank), rankWidth)
+}
+
+func TestSpreadRanks(t *testing.T) {
+	for _, n := range []int{0, 1, 10, 100000} {
+		ranks := spreadRanks(n)
+
+		require.Len(t, ranks, n)
+		assert.True(t, sort.StringsAreSorted(ranks))
+
+		for i := 1; i < len(ranks); i++ {
+			require.NotEqual(t, ranks[i-1], ranks[i])
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package todo
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/teambition/rrule-go"
+)
+
+// Recurrence describes the schedule of a recurring item.
+//
+// Every occurrence of a series is a separate item linked together by the series ID.
+// When an occurrence is marked as complete, the next one is added to the list.
+type Recurrence struct {
+	// Rule is an iCalendar RRULE (eg. FREQ=WEEKLY;BYDAY=MO,WE).
+	Rule string
+
+	// Timezone is the IANA name of the timezone the rule is evaluated in.
+	// Defaults to UTC.
+	Timezone string
+
+	// Start is the first occurrence of the series (DTSTART).
+	// Defaults to the due date of the first item.
+	Start time.Time
+
+	// SeriesID links the occurrences of the series.
+	// Defaults to the ID of the first item.
+	SeriesID string
+
+	// Occurrence is the scheduled time of the item within the series.
+	// It may differ from the due date when a single occurrence is rescheduled.
+	Occurrence time.Time
+}
+
+// Next returns the next occurrence after the one of the item.
+// It returns false when the series has ended.
+func (r Recurrence) Next() (time.Time, bool, error) {
+	rule, err := r.rrule()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	next := rule.After(r.Occurrence, false)
+
+	return next, !next.IsZero(), nil
+}
+
+func (r Recurrence) rrule() (*rrule.RRule, error) {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return nil, errors.WithStack(recurrenceError{violations: []string{"unknown timezone"}})
+	}
+
+	option, err := rrule.StrToROptionInLocation(strings.TrimPrefix(r.Rule, "RRULE:"), loc)
+	if err != nil {
+		return nil, errors.WithStack(recurrenceError{violations: []string{"invalid rule: " + err.Error()}})
+	}
+
+	// Occurrences are calculated in the timezone of the start date
+	// to keep the same wall clock time across DST changes.
+	option.Dtstart = r.Start.In(loc)
+
+	rule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, errors.WithStack(recurrenceError{violations: []string{"invalid rule: " + err.Error()}})
+	}
+
+	return rule, nil
+}
+
+// normalize validates the recurrence and fills the defaults for an item.
+func (r Recurrence) normalize(id string, dueAt *time.Time) (Recurrence, error) {
+	if r.Rule == "" {
+		return r, errors.WithStack(recurrenceError{violations: []string{"rule cannot be empty"}})
+	}
+
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+
+	if r.Start.IsZero() {
+		if dueAt == nil {
+			return r, errors.WithStack(recurrenceError{violations: []string{"recurring items must have a due date"}})
+		}
+
+		r.Start = *dueAt
+	}
+
+	if r.Occurrence.IsZero() {
+		r.Occurrence = r.Start
+	}
+
+	if r.SeriesID == "" {
+		r.SeriesID = id
+	}
+
+	if _, err := r.rrule(); err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+type recurrenceError struct {
+	violations []string
+}
+
+func (recurrenceError) Error() string {
+	return "invalid recurrence"
+}
+
+func (e recurrenceError) Violations() map[string][]string {
+	return map[string][]string{"recurrence": e.violations}
+}
+
+// Validation tells a client that this error is related to a resource being invalid.
+// Can be used to translate the error to eg. status code.
+func (recurrenceError) Validation() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (recurrenceError) ServiceError() bool {
+	return true
+}
+
+// RecurrenceMiddleware manages the occurrences of recurring items.
+//
+// When an occurrence is marked as complete, the next one gets added to the list.
+// Skipping an occurrence moves the item to the next occurrence of the series.
+func RecurrenceMiddleware() Middleware {
+	return func(next Service) Service {
+		return recurrenceMiddleware{
+			Service: DefaultMiddleware{Service: next},
+			next:    next,
+		}
+	}
+}
+
+type recurrenceMiddleware struct {
+	Service
+	next Service
+}
+
+func (mw recurrenceMiddleware) UpdateItem(ctx context.Context, id string, itemUpdate ItemUpdate) (Item, error) {
+	if !itemUpdate.SkipOccurrence && (itemUpdate.Completed == nil || !*itemUpdate.Completed) {
+		return mw.next.UpdateItem(ctx, id, itemUpdate)
+	}
+
+	item, err := mw.next.GetItem(ctx, id)
+	if err != nil {
+		return item, err
+	}
+
+	if itemUpdate.SkipOccurrence {
+		return mw.skipOccurrence(ctx, item, itemUpdate)
+	}
+
+	updatedItem, err := mw.next.UpdateItem(ctx, id, itemUpdate)
+	if err != nil {
+		return updatedItem, err
+	}
+
+	// Only the first completion of an occurrence continues the series
+	if item.Completed || updatedItem.Recurrence == nil {
+		return updatedItem, nil
+	}
+
+	err = mw.addNextOccurrence(ctx, updatedItem)
+	if err != nil {
+		return updatedItem, errors.WithMessage(err, "add next occurrence")
+	}
+
+	return updatedItem, nil
+}
+
+func (mw recurrenceMiddleware) skipOccurrence(ctx context.Context, item Item, itemUpdate ItemUpdate) (Item, error) {
+	if item.Recurrence == nil {
+		return item, errors.WithStack(recurrenceError{violations: []string{"only recurring items can be skipped"}})
+	}
+
+	occurrence, ok, err := item.Recurrence.Next()
+	if err != nil {
+		return item, err
+	}
+
+	if !ok {
+		return item, errors.WithStack(recurrenceError{violations: []string{"the series has no more occurrences"}})
+	}
+
+	recurrence := *item.Recurrence
+	recurrence.Occurrence = occurrence
+
+	itemUpdate.SkipOccurrence = false
+	itemUpdate.DueAt = &occurrence
+	itemUpdate.ClearDueAt = false
+	itemUpdate.Recurrence = &recurrence
+
+	return mw.next.UpdateItem(ctx, item.ID, itemUpdate)
+}
+
+func (mw recurrenceMiddleware) addNextOccurrence(ctx context.Context, item Item) error {
+	occurrence, ok, err := item.Recurrence.Next()
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return nil
+	}
+
+	// Completing an occurrence again should not add the next one twice
+	items, err := mw.next.ListItems(ctx, ItemFilter{
+		ListID:   item.ListID,
+		SeriesID: item.Recurrence.SeriesID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, i := range items {
+		if i.Recurrence != nil && !i.Recurrence.Occurrence.Before(occurrence) {
+			return nil
+		}
+	}
+
+	recurrence := *item.Recurrence
+	recurrence.Occurrence = occurrence
+
+	_, err = mw.next.AddItem(ctx, NewItem{
+		ListID:       item.ListID,
+		ParentID:     item.ParentID,
+		Title:        item.Title,
+		Order:        item.Order,
+		DueAt:        &occurrence,
+		Priority:     item.Priority,
+		Recurrence:   &recurrence,
+		AutoComplete: item.AutoComplete,
+	})
+
+	return err
+}
@@ -0,0 +1,325 @@
+package todo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+type sequenceIDGenerator struct {
+	next int
+}
+
+func (g *sequenceIDGenerator) Generate() (string, error) {
+	g.next++
+
+	return fmt.Sprintf("%02d", g.next), nil
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+
+	return loc
+}
+
+func TestRecurrence_Next(t *testing.T) {
+	budapest := mustLoadLocation(t, "Europe/Budapest")
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := map[string]struct {
+		recurrence  Recurrence
+		occurrences []time.Time
+		ended       bool
+	}{
+		"daily": {
+			recurrence: Recurrence{
+				Rule:     "FREQ=DAILY",
+				Timezone: "UTC",
+				Start:    time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC),
+			},
+			occurrences: []time.Time{
+				time.Date(2021, 6, 2, 9, 0, 0, 0, time.UTC),
+				time.Date(2021, 6, 3, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"weekly across spring DST change": {
+			recurrence: Recurrence{
+				Rule:     "RRULE:FREQ=WEEKLY;BYDAY=MO,WE",
+				Timezone: "Europe/Budapest",
+				// Given in UTC: the wall clock time in the timezone of the rule is kept
+				Start: time.Date(2021, 3, 24, 8, 0, 0, 0, time.UTC),
+			},
+			occurrences: []time.Time{
+				time.Date(2021, 3, 29, 9, 0, 0, 0, budapest),
+				time.Date(2021, 3, 31, 9, 0, 0, 0, budapest),
+			},
+		},
+		"daily across autumn DST change": {
+			recurrence: Recurrence{
+				Rule:     "FREQ=DAILY",
+				Timezone: "America/New_York",
+				Start:    time.Date(2021, 11, 6, 9, 0, 0, 0, newYork),
+			},
+			occurrences: []time.Time{
+				time.Date(2021, 11, 7, 9, 0, 0, 0, newYork),
+				time.Date(2021, 11, 8, 9, 0, 0, 0, newYork),
+			},
+		},
+		"monthly on the last friday": {
+			recurrence: Recurrence{
+				Rule:     "FREQ=MONTHLY;BYDAY=-1FR",
+				Timezone: "Europe/Budapest",
+				Start:    time.Date(2021, 2, 26, 18, 0, 0, 0, budapest),
+			},
+			occurrences: []time.Time{
+				time.Date(2021, 3, 26, 18, 0, 0, 0, budapest),
+				time.Date(2021, 4, 30, 18, 0, 0, 0, budapest),
+				time.Date(2021, 5, 28, 18, 0, 0, 0, budapest),
+			},
+		},
+		"ends after count": {
+			recurrence: Recurrence{
+				Rule:     "FREQ=DAILY;COUNT=2",
+				Timezone: "UTC",
+				Start:    time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC),
+			},
+			occurrences: []time.Time{
+				time.Date(2021, 6, 2, 9, 0, 0, 0, time.UTC),
+			},
+			ended: true,
+		},
+		"ends at until": {
+			recurrence: Recurrence{
+				Rule:     "FREQ=WEEKLY;UNTIL=20210615T000000Z",
+				Timezone: "UTC",
+				Start:    time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC),
+			},
+			occurrences: []time.Time{
+				time.Date(2021, 6, 8, 9, 0, 0, 0, time.UTC),
+			},
+			ended: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+
+		t.Run(name, func(t *testing.T) {
+			recurrence := test.recurrence
+			recurrence.Occurrence = recurrence.Start
+
+			for _, expected := range test.occurrences {
+				next, ok, err := recurrence.Next()
+				require.NoError(t, err)
+				require.True(t, ok)
+
+				assert.True(t, expected.Equal(next), "expected %s, got %s", expected, next)
+
+				recurrence.Occurrence = next
+			}
+
+			_, ok, err := recurrence.Next()
+			require.NoError(t, err)
+			assert.Equal(t, !test.ended, ok)
+		})
+	}
+}
+
+func TestRecurrence_Next_Invalid(t *testing.T) {
+	tests := map[string]Recurrence{
+		"invalid rule":     {Rule: "FREQ=SOMETIMES", Timezone: "UTC"},
+		"unknown timezone": {Rule: "FREQ=DAILY", Timezone: "Mars/Olympus_Mons"},
+	}
+
+	for name, recurrence := range tests {
+		name, recurrence := name, recurrence
+
+		t.Run(name, func(t *testing.T) {
+			_, _, err := recurrence.Next()
+			require.Error(t, err)
+
+			var verr interface{ Validation() bool }
+			require.True(t, errors.As(err, &verr))
+			assert.True(t, verr.Validation())
+		})
+	}
+}
+
+func TestRecurrenceMiddleware_CompleteAddsNextOccurrence(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	budapest := mustLoadLocation(t, "Europe/Budapest")
+	dueAt := time.Date(2021, 3, 26, 9, 0, 0, 0, budapest)
+
+	item, err := service.AddItem(ctx, NewItem{
+		Title: "Take out the trash",
+		Order: 1,
+		DueAt: &dueAt,
+		Recurrence: &Recurrence{
+			Rule:     "FREQ=WEEKLY;BYDAY=FR",
+			Timezone: "Europe/Budapest",
+		},
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, item.Recurrence)
+	assert.Equal(t, item.ID, item.Recurrence.SeriesID)
+	assert.True(t, dueAt.Equal(item.Recurrence.Start))
+	assert.True(t, dueAt.Equal(item.Recurrence.Occurrence))
+
+	completed := true
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	// Completing the same occurrence again should not add another one
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{SeriesID: item.ID})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	next := items[1]
+	expected := time.Date(2021, 4, 2, 9, 0, 0, 0, budapest)
+
+	assert.Equal(t, "Take out the trash", next.Title)
+	assert.Equal(t, 1, next.Order)
+	assert.False(t, next.Completed)
+	require.NotNil(t, next.DueAt)
+	assert.True(t, expected.Equal(*next.DueAt), "expected %s, got %s", expected, *next.DueAt)
+	assert.Equal(t, item.ID, next.Recurrence.SeriesID)
+	assert.True(t, expected.Equal(next.Recurrence.Occurrence))
+}
+
+func TestRecurrenceMiddleware_SkipOccurrence(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	dueAt := time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	item, err := service.AddItem(ctx, NewItem{
+		Title:      "Water the plants",
+		DueAt:      &dueAt,
+		Recurrence: &Recurrence{Rule: "FREQ=DAILY;COUNT=2"},
+	})
+	require.NoError(t, err)
+
+	item, err = service.UpdateItem(ctx, item.ID, ItemUpdate{SkipOccurrence: true})
+	require.NoError(t, err)
+
+	expected := time.Date(2021, 6, 2, 9, 0, 0, 0, time.UTC)
+
+	require.NotNil(t, item.DueAt)
+	assert.True(t, expected.Equal(*item.DueAt))
+	assert.True(t, expected.Equal(item.Recurrence.Occurrence))
+
+	// The series has no more occurrences
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{SkipOccurrence: true})
+	require.Error(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+}
+
+func TestRecurrenceMiddleware_EditSingleOccurrence(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	dueAt := time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	item, err := service.AddItem(ctx, NewItem{
+		Title:      "Standup",
+		DueAt:      &dueAt,
+		Recurrence: &Recurrence{Rule: "FREQ=DAILY"},
+	})
+	require.NoError(t, err)
+
+	// Moving a single occurrence keeps the schedule of the series
+	movedDueAt := dueAt.Add(3 * time.Hour)
+	title := "Standup (moved)"
+	completed := true
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Title: &title, DueAt: &movedDueAt})
+	require.NoError(t, err)
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{SeriesID: item.ID})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	expected := time.Date(2021, 6, 2, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "Standup (moved)", items[1].Title)
+	assert.True(t, expected.Equal(*items[1].DueAt), "expected %s, got %s", expected, *items[1].DueAt)
+}
+
+func TestRecurrenceMiddleware_EndRecurrence(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	dueAt := time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	item, err := service.AddItem(ctx, NewItem{
+		Title:      "Stretch",
+		DueAt:      &dueAt,
+		Recurrence: &Recurrence{Rule: "FREQ=DAILY"},
+	})
+	require.NoError(t, err)
+
+	item, err = service.UpdateItem(ctx, item.ID, ItemUpdate{EndRecurrence: true})
+	require.NoError(t, err)
+	assert.Nil(t, item.Recurrence)
+
+	completed := true
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+}
+
+func TestService_AddItem_InvalidRecurrence(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	dueAt := time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]NewItem{
+		"missing due date": {Title: "Chore", Recurrence: &Recurrence{Rule: "FREQ=DAILY"}},
+		"invalid rule":     {Title: "Chore", DueAt: &dueAt, Recurrence: &Recurrence{Rule: "FREQ=SOMETIMES"}},
+		"unknown timezone": {
+			Title:      "Chore",
+			DueAt:      &dueAt,
+			Recurrence: &Recurrence{Rule: "FREQ=DAILY", Timezone: "Mars/Olympus_Mons"},
+		},
+	}
+
+	for name, newItem := range tests {
+		name, newItem := name, newItem
+
+		t.Run(name, func(t *testing.T) {
+			_, err := service.AddItem(ctx, newItem)
+			require.Error(t, err)
+
+			var verr interface{ Validation() bool }
+			require.True(t, errors.As(err, &verr))
+		})
+	}
+}
@@ -0,0 +1,679 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+const (
+	// maxRuleConditions is the maximum number of conditions of a rule.
+	maxRuleConditions = 20
+
+	// maxRuleActions is the maximum number of actions of a rule.
+	maxRuleActions = 10
+)
+
+// RuleTrigger is the event a rule runs on.
+type RuleTrigger string
+
+// Rule triggers.
+const (
+	RuleOnItemAdded        RuleTrigger = "ItemAdded"
+	RuleOnItemUpdated      RuleTrigger = "ItemUpdated"
+	RuleOnMarkedAsComplete RuleTrigger = "MarkedAsComplete"
+	RuleOnItemDueSoon      RuleTrigger = "ItemDueSoon"
+	RuleOnItemOverdue      RuleTrigger = "ItemOverdue"
+	RuleOnItemAssigned     RuleTrigger = "ItemAssigned"
+)
+
+// ruleEventFields are the event fields conditions can refer to (as event.<name>) per trigger.
+// nolint: gochecknoglobals
+var ruleEventFields = map[RuleTrigger][]string{
+	RuleOnItemAdded:        {"listId"},
+	RuleOnItemUpdated:      {"listId"},
+	RuleOnMarkedAsComplete: {},
+	RuleOnItemDueSoon:      {"dueAt"},
+	RuleOnItemOverdue:      {"dueAt"},
+	RuleOnItemAssigned:     {"assignee", "previousAssignee", "assignedBy"},
+}
+
+// ruleItemFields are the item fields conditions can refer to (as item.<name>).
+// nolint: gochecknoglobals
+var ruleItemFields = []string{"title", "notes", "listId", "parentId", "completed", "priority", "tags", "assignee"}
+
+// RuleOperator compares a field to the value of a condition.
+type RuleOperator string
+
+// Rule operators. Text is compared case insensitively.
+// Multi-valued fields (eg. tags) match if any of their values matches.
+const (
+	RuleEquals     RuleOperator = "equals"
+	RuleNotEquals  RuleOperator = "notEquals"
+	RuleContains   RuleOperator = "contains"
+	RuleIsEmpty    RuleOperator = "isEmpty"
+	RuleIsNotEmpty RuleOperator = "isNotEmpty"
+)
+
+// RuleActionType is the operation an action runs on the item a rule runs on.
+type RuleActionType string
+
+// Rule action types.
+const (
+	// RuleAddItem adds a new item titled by the value of the action ({title} is replaced by the title of the item).
+	// The item is added to the list of the action (defaults to the list of the item).
+	RuleAddItem RuleActionType = "addItem"
+
+	// RuleSetPriority changes the priority of the item to the value of the action.
+	RuleSetPriority RuleActionType = "setPriority"
+
+	// RuleComplete marks the item as complete.
+	RuleComplete RuleActionType = "complete"
+
+	// RuleTag attaches the tag named by the value of the action to the item.
+	RuleTag RuleActionType = "tag"
+
+	// RuleUntag detaches the tag named by the value of the action from the item.
+	RuleUntag RuleActionType = "untag"
+
+	// RuleAssign assigns the item to the user in the value of the action.
+	RuleAssign RuleActionType = "assign"
+
+	// RuleUnassign removes the assignee of the item.
+	RuleUnassign RuleActionType = "unassign"
+
+	// RuleMoveToList moves the item to the list of the action.
+	RuleMoveToList RuleActionType = "moveToList"
+)
+
+// Rule runs actions on an item when an event matching its conditions happens to the item.
+type Rule struct {
+	ID      string
+	Name    string
+	Trigger RuleTrigger
+
+	// Conditions must all match for the actions to run.
+	Conditions []RuleCondition
+
+	// Actions run in order, in a single transaction.
+	Actions []RuleAction
+
+	Enabled bool
+
+	// DryRun logs the executions of the rule without running its actions.
+	DryRun bool
+
+	// Owner is the user who created the rule: actions run on their behalf (eg. tags are attached from their tags).
+	Owner string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RuleCondition compares a field of the event or the item a rule runs on to a value.
+type RuleCondition struct {
+	// Field is either an event field (eg. event.assignedBy) or an item field (eg. item.tags).
+	Field    string       `json:"field"`
+	Operator RuleOperator `json:"operator"`
+	Value    string       `json:"value,omitempty"`
+}
+
+// RuleAction is an operation run on the item a rule runs on.
+type RuleAction struct {
+	Type RuleActionType `json:"type"`
+
+	// Value is the argument of the action (eg. a priority or a tag name).
+	Value string `json:"value,omitempty"`
+
+	// ListID is the list items are added or moved to.
+	ListID string `json:"listId,omitempty"`
+}
+
+// NewRule contains the details of a new Rule.
+type NewRule struct {
+	Name       string
+	Trigger    RuleTrigger
+	Conditions []RuleCondition
+	Actions    []RuleAction
+
+	// Enabled defaults to true.
+	Enabled *bool
+	DryRun  bool
+}
+
+// RuleUpdate contains updates of an existing rule.
+type RuleUpdate struct {
+	Name    *string
+	Trigger *RuleTrigger
+
+	// Conditions replace the conditions of the rule (nil keeps them).
+	Conditions []RuleCondition
+
+	// Actions replace the actions of the rule (nil keeps them).
+	Actions []RuleAction
+
+	Enabled *bool
+	DryRun  *bool
+}
+
+func (u RuleUpdate) update(rule Rule) Rule {
+	if u.Name != nil {
+		rule.Name = *u.Name
+	}
+
+	if u.Trigger != nil {
+		rule.Trigger = *u.Trigger
+	}
+
+	if u.Conditions != nil {
+		rule.Conditions = u.Conditions
+	}
+
+	if u.Actions != nil {
+		rule.Actions = u.Actions
+	}
+
+	if u.Enabled != nil {
+		rule.Enabled = *u.Enabled
+	}
+
+	if u.DryRun != nil {
+		rule.DryRun = *u.DryRun
+	}
+
+	return rule
+}
+
+// RuleEvent is an event rules run on.
+type RuleEvent struct {
+	Trigger RuleTrigger
+	ItemID  string
+
+	// Fields are the details of the event conditions refer to as event.<name>.
+	Fields map[string]string
+}
+
+// RuleEvaluation tells whether a rule matches an event and which actions it runs.
+type RuleEvaluation struct {
+	Matched    bool
+	Conditions []RuleConditionResult
+
+	// Actions are the actions the rule runs (empty if it doesn't match).
+	Actions []RuleAction
+}
+
+// RuleConditionResult tells whether a condition matches.
+type RuleConditionResult struct {
+	Condition RuleCondition
+	Matched   bool
+
+	// Values are the values of the field the condition compares.
+	Values []string
+}
+
+// RuleExecutionStatus is the outcome of a rule execution.
+type RuleExecutionStatus string
+
+// Rule execution statuses.
+const (
+	// RuleApplied means every action of the rule ran.
+	RuleApplied RuleExecutionStatus = "applied"
+
+	// RuleFailed means an action failed: the changes of the rule are rolled back.
+	RuleFailed RuleExecutionStatus = "failed"
+
+	// RuleDryRun means the rule matched, but its actions did not run because the rule is in dry-run mode.
+	RuleDryRun RuleExecutionStatus = "dryRun"
+
+	// RuleSkipped means the rule matched, but did not run to prevent a loop.
+	RuleSkipped RuleExecutionStatus = "skipped"
+)
+
+// RuleExecution records a rule matching an event.
+type RuleExecution struct {
+	ID      string
+	RuleID  string
+	Trigger RuleTrigger
+	ItemID  string
+
+	// CorrelationID identifies the chain of changes the execution is part of.
+	CorrelationID string
+
+	Status RuleExecutionStatus
+
+	// Error tells why the rule failed or got skipped.
+	Error string
+
+	ExecutedAt time.Time
+}
+
+// RuleExecutionFilter filters rule executions.
+type RuleExecutionFilter struct {
+	RuleID        string
+	CorrelationID string
+}
+
+func (f RuleExecutionFilter) match(execution RuleExecution) bool {
+	if f.RuleID != "" && execution.RuleID != f.RuleID {
+		return false
+	}
+
+	if f.CorrelationID != "" && execution.CorrelationID != f.CorrelationID {
+		return false
+	}
+
+	return true
+}
+
+// RuleStore persists rules and their executions.
+type RuleStore interface {
+	// StoreRule stores a rule.
+	StoreRule(ctx context.Context, rule Rule) error
+
+	// GetAllRules returns all rules, oldest first.
+	GetAllRules(ctx context.Context) ([]Rule, error)
+
+	// GetOneRule returns a single rule by its ID.
+	GetOneRule(ctx context.Context, id string) (Rule, error)
+
+	// DeleteOneRule deletes a single rule by its ID with its executions.
+	DeleteOneRule(ctx context.Context, id string) error
+
+	// StoreRuleExecution stores a rule execution.
+	StoreRuleExecution(ctx context.Context, execution RuleExecution) error
+
+	// GetRuleExecutions returns the rule executions matching a filter, oldest first.
+	GetRuleExecutions(ctx context.Context, filter RuleExecutionFilter) ([]RuleExecution, error)
+}
+
+// RuleNotFoundError is returned if a rule cannot be found.
+type RuleNotFoundError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (RuleNotFoundError) Error() string {
+	return "rule not found"
+}
+
+// Details returns error details.
+func (e RuleNotFoundError) Details() []interface{} {
+	return []interface{}{"rule_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (RuleNotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (RuleNotFoundError) ServiceError() bool {
+	return true
+}
+
+func (s service) CreateRule(ctx context.Context, newRule NewRule) (Rule, error) {
+	now := time.Now()
+	owner, _ := principal.FromContext(ctx)
+
+	rule := normalizeRule(Rule{
+		Name:       newRule.Name,
+		Trigger:    newRule.Trigger,
+		Conditions: newRule.Conditions,
+		Actions:    newRule.Actions,
+		Enabled:    newRule.Enabled == nil || *newRule.Enabled,
+		DryRun:     newRule.DryRun,
+		Owner:      owner,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	})
+
+	err := validateRule(rule)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	rule.ID, err = s.idgenerator.Generate()
+	if err != nil {
+		return Rule{}, err
+	}
+
+	err = s.ruleStore.StoreRule(ctx, rule)
+	if err != nil {
+		return Rule{}, errors.WithMessage(err, "create rule")
+	}
+
+	return rule, nil
+}
+
+func (s service) ListRules(ctx context.Context) ([]Rule, error) {
+	return s.ruleStore.GetAllRules(ctx)
+}
+
+func (s service) GetRule(ctx context.Context, id string) (Rule, error) {
+	rule, err := s.ruleStore.GetOneRule(ctx, id)
+	if err != nil {
+		return Rule{}, errors.WithMessage(err, "get rule")
+	}
+
+	return rule, nil
+}
+
+func (s service) UpdateRule(ctx context.Context, id string, ruleUpdate RuleUpdate) (Rule, error) {
+	rule, err := s.ruleStore.GetOneRule(ctx, id)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	rule = normalizeRule(ruleUpdate.update(rule))
+	rule.UpdatedAt = time.Now()
+
+	err = validateRule(rule)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	err = s.ruleStore.StoreRule(ctx, rule)
+	if err != nil {
+		return Rule{}, errors.WithMessage(err, "update rule")
+	}
+
+	return rule, nil
+}
+
+func (s service) DeleteRule(ctx context.Context, id string) error {
+	_, err := s.ruleStore.GetOneRule(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete rule")
+	}
+
+	err = s.ruleStore.DeleteOneRule(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete rule")
+	}
+
+	return nil
+}
+
+func (s service) EvaluateRule(ctx context.Context, id string, itemID string) (RuleEvaluation, error) {
+	rule, err := s.ruleStore.GetOneRule(ctx, id)
+	if err != nil {
+		return RuleEvaluation{}, errors.WithMessage(err, "evaluate rule")
+	}
+
+	item, err := s.GetItem(ctx, itemID)
+	if err != nil {
+		return RuleEvaluation{}, errors.WithMessage(err, "evaluate rule")
+	}
+
+	return rule.evaluate(item, simulateRuleEvent(rule.Trigger, item)), nil
+}
+
+func (s service) ListRuleExecutions(ctx context.Context, id string) ([]RuleExecution, error) {
+	_, err := s.ruleStore.GetOneRule(ctx, id)
+	if err != nil {
+		return nil, errors.WithMessage(err, "list rule executions")
+	}
+
+	return s.ruleStore.GetRuleExecutions(ctx, RuleExecutionFilter{RuleID: id})
+}
+
+// simulateRuleEvent returns the event a trigger would fire for an item in its current state.
+func simulateRuleEvent(trigger RuleTrigger, item Item) RuleEvent {
+	event := RuleEvent{
+		Trigger: trigger,
+		ItemID:  item.ID,
+		Fields:  make(map[string]string),
+	}
+
+	switch trigger {
+	case RuleOnItemAdded, RuleOnItemUpdated:
+		event.Fields["listId"] = item.ListID
+
+	case RuleOnItemDueSoon, RuleOnItemOverdue:
+		if item.DueAt != nil {
+			event.Fields["dueAt"] = item.DueAt.Format(time.RFC3339)
+		}
+
+	case RuleOnItemAssigned:
+		event.Fields["assignee"] = item.Assignee
+
+	case RuleOnMarkedAsComplete:
+	}
+
+	return event
+}
+
+// evaluate tells whether the rule matches an event of an item.
+func (r Rule) evaluate(item Item, event RuleEvent) RuleEvaluation {
+	evaluation := RuleEvaluation{
+		Matched:    event.Trigger == r.Trigger,
+		Conditions: make([]RuleConditionResult, 0, len(r.Conditions)),
+	}
+
+	for _, condition := range r.Conditions {
+		values := ruleFieldValues(condition.Field, item, event)
+		matched := condition.match(values)
+
+		evaluation.Conditions = append(evaluation.Conditions, RuleConditionResult{
+			Condition: condition,
+			Matched:   matched,
+			Values:    values,
+		})
+
+		evaluation.Matched = evaluation.Matched && matched
+	}
+
+	if evaluation.Matched {
+		evaluation.Actions = r.Actions
+	}
+
+	return evaluation
+}
+
+// ruleFieldValues returns the values of an event or an item field.
+func ruleFieldValues(field string, item Item, event RuleEvent) []string {
+	if name := strings.TrimPrefix(field, "event."); name != field {
+		return []string{event.Fields[name]}
+	}
+
+	switch strings.TrimPrefix(field, "item.") {
+	case "title":
+		return []string{item.Title}
+	case "notes":
+		return []string{item.Notes}
+	case "listId":
+		return []string{item.ListID}
+	case "parentId":
+		return []string{item.ParentID}
+	case "completed":
+		return []string{strconv.FormatBool(item.Completed)}
+	case "priority":
+		return []string{item.Priority.String()}
+	case "tags":
+		tags := make([]string, 0, len(item.Tags))
+
+		for _, tag := range item.Tags {
+			tags = append(tags, tag.Name)
+		}
+
+		return tags
+	case "assignee":
+		return []string{item.Assignee}
+	}
+
+	return nil
+}
+
+func (c RuleCondition) match(values []string) bool {
+	switch c.Operator {
+	case RuleEquals:
+		return anyRuleValue(values, func(v string) bool { return strings.EqualFold(v, c.Value) })
+
+	case RuleNotEquals:
+		return !anyRuleValue(values, func(v string) bool { return strings.EqualFold(v, c.Value) })
+
+	case RuleContains:
+		value := strings.ToLower(c.Value)
+
+		return anyRuleValue(values, func(v string) bool { return strings.Contains(strings.ToLower(v), value) })
+
+	case RuleIsEmpty:
+		return !anyRuleValue(values, func(v string) bool { return v != "" })
+
+	case RuleIsNotEmpty:
+		return anyRuleValue(values, func(v string) bool { return v != "" })
+	}
+
+	return false
+}
+
+func anyRuleValue(values []string, fn func(v string) bool) bool {
+	for _, v := range values {
+		if fn(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeRule trims the names and the values of a rule.
+func normalizeRule(rule Rule) Rule {
+	rule.Name = strings.TrimSpace(rule.Name)
+
+	conditions := make([]RuleCondition, 0, len(rule.Conditions))
+
+	for _, condition := range rule.Conditions {
+		condition.Field = strings.TrimSpace(condition.Field)
+		condition.Value = strings.TrimSpace(condition.Value)
+
+		conditions = append(conditions, condition)
+	}
+
+	actions := make([]RuleAction, 0, len(rule.Actions))
+
+	for _, action := range rule.Actions {
+		action.Value = strings.TrimSpace(action.Value)
+		action.ListID = strings.TrimSpace(action.ListID)
+
+		actions = append(actions, action)
+	}
+
+	rule.Conditions = conditions
+	rule.Actions = actions
+
+	return rule
+}
+
+func validateRule(rule Rule) error {
+	violations := make(map[string][]string)
+
+	if rule.Name == "" {
+		violations["name"] = append(violations["name"], "name cannot be empty")
+	}
+
+	eventFields, ok := ruleEventFields[rule.Trigger]
+	if !ok {
+		violations["trigger"] = append(violations["trigger"], fmt.Sprintf("unknown trigger %q", rule.Trigger))
+	}
+
+	if len(rule.Conditions) > maxRuleConditions {
+		violations["conditions"] = append(violations["conditions"], "a rule can have at most 20 conditions")
+	}
+
+	for i, condition := range rule.Conditions {
+		field := fmt.Sprintf("conditions[%d]", i)
+
+		if !validRuleField(condition.Field, eventFields) {
+			violations[field] = append(violations[field], fmt.Sprintf("unknown field %q", condition.Field))
+		}
+
+		switch condition.Operator {
+		case RuleEquals, RuleNotEquals, RuleIsEmpty, RuleIsNotEmpty:
+		case RuleContains:
+			if condition.Value == "" {
+				violations[field] = append(violations[field], "value cannot be empty")
+			}
+		default:
+			violations[field] = append(violations[field], fmt.Sprintf("unknown operator %q", condition.Operator))
+		}
+	}
+
+	if len(rule.Actions) == 0 {
+		violations["actions"] = append(violations["actions"], "a rule must have at least one action")
+	}
+
+	if len(rule.Actions) > maxRuleActions {
+		violations["actions"] = append(violations["actions"], "a rule can have at most 10 actions")
+	}
+
+	for i, action := range rule.Actions {
+		field := fmt.Sprintf("actions[%d]", i)
+
+		for _, violation := range validateRuleAction(action) {
+			violations[field] = append(violations[field], violation)
+		}
+	}
+
+	if len(violations) > 0 {
+		return errors.WithStack(validationError{violations: violations})
+	}
+
+	return nil
+}
+
+func validRuleField(field string, eventFields []string) bool {
+	names := ruleItemFields
+
+	name := strings.TrimPrefix(field, "item.")
+	if name == field {
+		name = strings.TrimPrefix(field, "event.")
+		if name == field {
+			return false
+		}
+
+		names = eventFields
+	}
+
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateRuleAction(action RuleAction) []string {
+	switch action.Type {
+	case RuleAddItem, RuleTag, RuleUntag, RuleAssign:
+		if action.Value == "" {
+			return []string{"value cannot be empty"}
+		}
+
+	case RuleSetPriority:
+		if _, err := ParsePriority(action.Value); err != nil || action.Value == "" {
+			return []string{priorityViolation}
+		}
+
+	case RuleMoveToList:
+		if action.ListID == "" {
+			return []string{"listId cannot be empty"}
+		}
+
+	case RuleComplete, RuleUnassign:
+
+	default:
+		return []string{fmt.Sprintf("unknown action %q", action.Type)}
+	}
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package todo
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/sagikazarmark/kitx/correlation"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// maxRuleChainLength is the maximum number of rules applied in a chain of changes caused by a single change.
+const maxRuleChainLength = 20
+
+// RuleEngine runs automation rules on todo events.
+//
+// Changes made by rules fire events themselves, which may trigger further rules.
+// Rules triggered (directly or indirectly) by the same change share its correlation ID:
+// a rule runs at most once per item in such a chain and a chain can apply at most 20 rules.
+type RuleEngine struct {
+	service     Service
+	store       RuleStore
+	transactor  Transactor
+	events      Events
+	idgenerator IDGenerator
+}
+
+// NewRuleEngine returns a new RuleEngine.
+// Actions run through the service, events fired by them are dispatched once they are committed.
+func NewRuleEngine(
+	service Service,
+	store RuleStore,
+	transactor Transactor,
+	events Events,
+	idgenerator IDGenerator,
+) RuleEngine {
+	return RuleEngine{
+		service:     service,
+		store:       store,
+		transactor:  transactor,
+		events:      events,
+		idgenerator: idgenerator,
+	}
+}
+
+// ItemAdded runs the rules triggered by an ItemAdded event.
+func (e RuleEngine) ItemAdded(ctx context.Context, event ItemAdded) error {
+	return e.run(ctx, RuleEvent{
+		Trigger: RuleOnItemAdded,
+		ItemID:  event.ID,
+		Fields:  map[string]string{"listId": event.ListID},
+	})
+}
+
+// ItemUpdated runs the rules triggered by an ItemUpdated event.
+func (e RuleEngine) ItemUpdated(ctx context.Context, event ItemUpdated) error {
+	return e.run(ctx, RuleEvent{
+		Trigger: RuleOnItemUpdated,
+		ItemID:  event.ID,
+		Fields:  map[string]string{"listId": event.ListID},
+	})
+}
+
+// MarkedAsComplete runs the rules triggered by a MarkedAsComplete event.
+func (e RuleEngine) MarkedAsComplete(ctx context.Context, event MarkedAsComplete) error {
+	return e.run(ctx, RuleEvent{
+		Trigger: RuleOnMarkedAsComplete,
+		ItemID:  event.ID,
+		Fields:  map[string]string{},
+	})
+}
+
+// ItemDueSoon runs the rules triggered by an ItemDueSoon event.
+func (e RuleEngine) ItemDueSoon(ctx context.Context, event ItemDueSoon) error {
+	return e.run(ctx, RuleEvent{
+		Trigger: RuleOnItemDueSoon,
+		ItemID:  event.ID,
+		Fields:  map[string]string{"dueAt": event.DueAt.Format(time.RFC3339)},
+	})
+}
+
+// ItemOverdue runs the rules triggered by an ItemOverdue event.
+func (e RuleEngine) ItemOverdue(ctx context.Context, event ItemOverdue) error {
+	return e.run(ctx, RuleEvent{
+		Trigger: RuleOnItemOverdue,
+		ItemID:  event.ID,
+		Fields:  map[string]string{"dueAt": event.DueAt.Format(time.RFC3339)},
+	})
+}
+
+// ItemAssigned runs the rules triggered by an ItemAssigned event.
+func (e RuleEngine) ItemAssigned(ctx context.Context, event ItemAssigned) error {
+	return e.run(ctx, RuleEvent{
+		Trigger: RuleOnItemAssigned,
+		ItemID:  event.ID,
+		Fields: map[string]string{
+			"assignee":         event.Assignee,
+			"previousAssignee": event.PreviousAssignee,
+			"assignedBy":       event.AssignedBy,
+		},
+	})
+}
+
+// run runs the enabled rules matching an event in the order they were created.
+// Failing actions are recorded in the execution log instead of being retried.
+func (e RuleEngine) run(ctx context.Context, event RuleEvent) error {
+	rules, err := e.store.GetAllRules(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "run rules")
+	}
+
+	triggered := make([]Rule, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule.Enabled && rule.Trigger == event.Trigger {
+			triggered = append(triggered, rule)
+		}
+	}
+
+	if len(triggered) == 0 {
+		return nil
+	}
+
+	item, err := e.service.GetItem(ctx, event.ItemID)
+	if errors.As(err, &NotFoundError{}) { // the item got deleted in the meantime
+		return nil
+	}
+	if err != nil {
+		return errors.WithMessage(err, "run rules")
+	}
+
+	correlationID, ok := correlation.FromContext(ctx)
+	if !ok || correlationID == "" {
+		correlationID, err = e.idgenerator.Generate()
+		if err != nil {
+			return errors.WithMessage(err, "run rules")
+		}
+
+		ctx = correlation.ToContext(ctx, correlationID)
+	}
+
+	chain, err := e.store.GetRuleExecutions(ctx, RuleExecutionFilter{CorrelationID: correlationID})
+	if err != nil {
+		return errors.WithMessage(err, "run rules")
+	}
+
+	for _, rule := range triggered {
+		if !rule.evaluate(item, event).Matched {
+			continue
+		}
+
+		execution, queue := e.execute(ctx, rule, item, chain)
+
+		execution.RuleID = rule.ID
+		execution.Trigger = event.Trigger
+		execution.ItemID = item.ID
+		execution.CorrelationID = correlationID
+		execution.ExecutedAt = time.Now()
+
+		execution.ID, err = e.idgenerator.Generate()
+		if err != nil {
+			return errors.WithMessage(err, "run rules")
+		}
+
+		err = e.store.StoreRuleExecution(ctx, execution)
+		if err != nil {
+			return errors.WithMessage(err, "run rules")
+		}
+
+		chain = append(chain, execution)
+
+		if execution.Status != RuleApplied {
+			continue
+		}
+
+		// Events are dispatched once the execution is logged, so rules triggered by them see it in the chain
+		err = queue.dispatch(ctx, e.events)
+		if err != nil {
+			return errors.WithMessage(err, "run rules")
+		}
+
+		// Subsequent rules see the changes made by the rule
+		item, err = e.service.GetItem(ctx, item.ID)
+		if errors.As(err, &NotFoundError{}) {
+			return nil
+		}
+		if err != nil {
+			return errors.WithMessage(err, "run rules")
+		}
+	}
+
+	return nil
+}
+
+// execute runs the actions of a matching rule unless it would lead to a loop.
+// Events fired by the actions are returned to be dispatched by the caller.
+func (e RuleEngine) execute(
+	ctx context.Context,
+	rule Rule,
+	item Item,
+	chain []RuleExecution,
+) (RuleExecution, *eventQueue) {
+	var applied int
+
+	for _, execution := range chain {
+		if execution.Status != RuleApplied {
+			continue
+		}
+
+		if execution.RuleID == rule.ID && execution.ItemID == item.ID {
+			return RuleExecution{Status: RuleSkipped, Error: "rule already applied to the item by the same change"}, nil
+		}
+
+		applied++
+	}
+
+	if applied >= maxRuleChainLength {
+		return RuleExecution{Status: RuleSkipped, Error: "too many rules applied by the same change"}, nil
+	}
+
+	if rule.DryRun {
+		return RuleExecution{Status: RuleDryRun}, nil
+	}
+
+	txCtx, queue := withEventQueue(ruleContext(ctx, rule))
+
+	err := e.transactor.Transaction(txCtx, func(ctx context.Context) error {
+		for _, action := range rule.Actions {
+			err := e.apply(ctx, action, item)
+			if err != nil {
+				return errors.WithMessagef(err, "%s action", action.Type)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return RuleExecution{Status: RuleFailed, Error: err.Error()}, nil
+	}
+
+	return RuleExecution{Status: RuleApplied}, queue
+}
+
+// apply runs a single action on an item.
+func (e RuleEngine) apply(ctx context.Context, action RuleAction, item Item) error {
+	var err error
+
+	switch action.Type {
+	case RuleAddItem:
+		listID := action.ListID
+		if listID == "" {
+			listID = item.ListID
+		}
+
+		_, err = e.service.AddItem(ctx, NewItem{
+			ListID: listID,
+			Title:  strings.ReplaceAll(action.Value, "{title}", item.Title),
+		})
+
+	case RuleSetPriority:
+		priority, perr := ParsePriority(action.Value)
+		if perr != nil {
+			return perr
+		}
+
+		_, err = e.service.UpdateItem(ctx, item.ID, ItemUpdate{Priority: &priority})
+
+	case RuleComplete:
+		completed := true
+
+		_, err = e.service.UpdateItem(ctx, item.ID, ItemUpdate{Completed: &completed})
+
+	case RuleTag:
+		_, err = e.service.TagItem(ctx, item.ID, []string{action.Value})
+
+	case RuleUntag:
+		_, err = e.service.UntagItem(ctx, item.ID, []string{action.Value})
+
+	case RuleAssign:
+		_, err = e.service.AssignItem(ctx, item.ID, action.Value)
+
+	case RuleUnassign:
+		_, err = e.service.UnassignItem(ctx, item.ID)
+
+	case RuleMoveToList:
+		listID := action.ListID
+
+		_, err = e.service.UpdateItem(ctx, item.ID, ItemUpdate{ListID: &listID})
+
+	default:
+		err = errors.Errorf("unknown action %q", action.Type)
+	}
+
+	return err
+}
+
+// ruleContext runs the actions of a rule on behalf of its owner.
+func ruleContext(ctx context.Context, rule Rule) context.Context {
+	if rule.Owner == "" {
+		return ctx
+	}
+
+	return principal.ToContext(ctx, rule.Owner)
+}
@@ -0,0 +1,310 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// loopbackEvents feeds events back to a rule engine, so changes made by rules trigger further rules.
+type loopbackEvents struct {
+	recordingEvents
+
+	engine *RuleEngine
+}
+
+func (e *loopbackEvents) ItemAdded(ctx context.Context, event ItemAdded) error {
+	_ = e.recordingEvents.ItemAdded(ctx, event)
+
+	return e.engine.ItemAdded(ctx, event)
+}
+
+func (e *loopbackEvents) ItemUpdated(ctx context.Context, event ItemUpdated) error {
+	_ = e.recordingEvents.ItemUpdated(ctx, event)
+
+	return e.engine.ItemUpdated(ctx, event)
+}
+
+func newRuleService() (Service, *InMemoryStore) {
+	events := &loopbackEvents{}
+	service, store := newTestService(testServiceConfig{Events: events})
+
+	engine := NewRuleEngine(service, store, store, events, &sequenceIDGenerator{})
+	events.engine = &engine
+
+	return service, store
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestService_Rule(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newRuleService()
+
+	rule, err := service.CreateRule(ctx, NewRule{
+		Name:       " Urgent ",
+		Trigger:    RuleOnItemAdded,
+		Conditions: []RuleCondition{{Field: "item.title", Operator: RuleContains, Value: " urgent "}},
+		Actions:    []RuleAction{{Type: RuleSetPriority, Value: "high"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Urgent", rule.Name)
+	assert.Equal(t, "urgent", rule.Conditions[0].Value)
+	assert.True(t, rule.Enabled, "rules are enabled by default")
+
+	updated, err := service.UpdateRule(ctx, rule.ID, RuleUpdate{Enabled: boolPtr(false), DryRun: boolPtr(true)})
+	require.NoError(t, err)
+	assert.False(t, updated.Enabled)
+	assert.True(t, updated.DryRun)
+	assert.Equal(t, rule.Actions, updated.Actions, "actions are kept when they are not updated")
+
+	rules, err := service.ListRules(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Rule{updated}, rules)
+
+	require.NoError(t, service.DeleteRule(ctx, rule.ID))
+
+	_, err = service.GetRule(ctx, rule.ID)
+	assert.True(t, errors.As(err, &RuleNotFoundError{}))
+
+	_, err = service.ListRuleExecutions(ctx, rule.ID)
+	assert.True(t, errors.As(err, &RuleNotFoundError{}))
+}
+
+func TestService_Rule_Validation(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newRuleService()
+
+	complete := []RuleAction{{Type: RuleComplete}}
+
+	tests := map[string]NewRule{
+		"empty name":      {Name: " ", Trigger: RuleOnItemAdded, Actions: complete},
+		"unknown trigger": {Name: "Rule", Trigger: "ItemRenamed", Actions: complete},
+		"unknown field":   {Name: "Rule", Trigger: RuleOnItemAdded, Actions: complete, Conditions: []RuleCondition{{Field: "item.color", Operator: RuleIsEmpty}}},
+		"event field":     {Name: "Rule", Trigger: RuleOnItemAdded, Actions: complete, Conditions: []RuleCondition{{Field: "event.assignedBy", Operator: RuleIsEmpty}}},
+		"operator":        {Name: "Rule", Trigger: RuleOnItemAdded, Actions: complete, Conditions: []RuleCondition{{Field: "item.title", Operator: "matches"}}},
+		"no actions":      {Name: "Rule", Trigger: RuleOnItemAdded},
+		"unknown action":  {Name: "Rule", Trigger: RuleOnItemAdded, Actions: []RuleAction{{Type: "delete"}}},
+		"priority":        {Name: "Rule", Trigger: RuleOnItemAdded, Actions: []RuleAction{{Type: RuleSetPriority, Value: "asap"}}},
+		"empty tag":       {Name: "Rule", Trigger: RuleOnItemAdded, Actions: []RuleAction{{Type: RuleTag}}},
+		"no list":         {Name: "Rule", Trigger: RuleOnItemAdded, Actions: []RuleAction{{Type: RuleMoveToList}}},
+		"too many":        {Name: "Rule", Trigger: RuleOnItemAdded, Actions: make([]RuleAction, 11)},
+	}
+
+	for name, newRule := range tests {
+		newRule := newRule
+
+		t.Run(name, func(t *testing.T) {
+			_, err := service.CreateRule(ctx, newRule)
+			assert.True(t, isValidationError(err))
+		})
+	}
+}
+
+func TestService_EvaluateRule(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newRuleService()
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Pay the bills", Priority: PriorityHigh})
+	require.NoError(t, err)
+
+	_, err = service.TagItem(ctx, item.ID, []string{"Finance", "home"})
+	require.NoError(t, err)
+
+	rule, err := service.CreateRule(ctx, NewRule{
+		Name:    "Finance",
+		Trigger: RuleOnItemUpdated,
+		Conditions: []RuleCondition{
+			{Field: "item.tags", Operator: RuleEquals, Value: "finance"},
+			{Field: "item.priority", Operator: RuleNotEquals, Value: "low"},
+			{Field: "item.assignee", Operator: RuleIsEmpty},
+		},
+		Actions: []RuleAction{{Type: RuleAssign, Value: "john"}},
+	})
+	require.NoError(t, err)
+
+	evaluation, err := service.EvaluateRule(ctx, rule.ID, item.ID)
+	require.NoError(t, err)
+
+	assert.True(t, evaluation.Matched)
+	assert.Equal(t, rule.Actions, evaluation.Actions)
+	require.Len(t, evaluation.Conditions, 3)
+	assert.Equal(t, []string{"Finance", "home"}, evaluation.Conditions[0].Values)
+
+	unassigned, err := service.GetItem(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Empty(t, unassigned.Assignee, "evaluation does not run the actions")
+
+	_, err = service.UntagItem(ctx, item.ID, []string{"Finance"})
+	require.NoError(t, err)
+
+	evaluation, err = service.EvaluateRule(ctx, rule.ID, item.ID)
+	require.NoError(t, err)
+
+	assert.False(t, evaluation.Matched)
+	assert.False(t, evaluation.Conditions[0].Matched)
+	assert.True(t, evaluation.Conditions[1].Matched)
+	assert.Empty(t, evaluation.Actions)
+}
+
+func TestRuleEngine(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newRuleService()
+
+	rule, err := service.CreateRule(ctx, NewRule{
+		Name:       "Urgent",
+		Trigger:    RuleOnItemAdded,
+		Conditions: []RuleCondition{{Field: "item.title", Operator: RuleContains, Value: "URGENT"}},
+		Actions: []RuleAction{
+			{Type: RuleSetPriority, Value: "high"},
+			{Type: RuleTag, Value: "urgent"},
+		},
+	})
+	require.NoError(t, err)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Call the plumber (urgent)"})
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{Title: "Water the plants"})
+	require.NoError(t, err)
+
+	item, err = service.GetItem(ctx, item.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, PriorityHigh, item.Priority)
+	require.Len(t, item.Tags, 1)
+	assert.Equal(t, "urgent", item.Tags[0].Name)
+
+	executions, err := service.ListRuleExecutions(ctx, rule.ID)
+	require.NoError(t, err)
+	require.Len(t, executions, 1, "only matching events are logged")
+
+	assert.Equal(t, RuleApplied, executions[0].Status)
+	assert.Equal(t, item.ID, executions[0].ItemID)
+	assert.Equal(t, RuleOnItemAdded, executions[0].Trigger)
+	assert.NotEmpty(t, executions[0].CorrelationID)
+}
+
+func TestRuleEngine_Loop(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newRuleService()
+
+	rule, err := service.CreateRule(ctx, NewRule{
+		Name:    "Review",
+		Trigger: RuleOnItemUpdated,
+		Actions: []RuleAction{{Type: RuleTag, Value: "review"}},
+	})
+	require.NoError(t, err)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Write the report"})
+	require.NoError(t, err)
+
+	title := "Write the annual report"
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Title: &title})
+	require.NoError(t, err)
+
+	executions, err := service.ListRuleExecutions(ctx, rule.ID)
+	require.NoError(t, err)
+	require.Len(t, executions, 2)
+
+	assert.Equal(t, RuleApplied, executions[0].Status)
+	assert.Equal(t, RuleSkipped, executions[1].Status, "the update made by the rule does not trigger it again")
+	assert.Equal(t, executions[0].CorrelationID, executions[1].CorrelationID)
+}
+
+func TestRuleEngine_ChainLength(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newRuleService()
+
+	rule, err := service.CreateRule(ctx, NewRule{
+		Name:    "Follow up",
+		Trigger: RuleOnItemAdded,
+		Actions: []RuleAction{{Type: RuleAddItem, Value: "Follow up: {title}"}},
+	})
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{Title: "Send the offer"})
+	require.NoError(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Len(t, items, 21, "every item adds a new one until the chain gets too long")
+	assert.Equal(t, "Follow up: Send the offer", items[1].Title)
+
+	executions, err := service.ListRuleExecutions(ctx, rule.ID)
+	require.NoError(t, err)
+	require.Len(t, executions, 21)
+	assert.Equal(t, RuleSkipped, executions[20].Status)
+}
+
+func TestRuleEngine_DryRun(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newRuleService()
+
+	rule, err := service.CreateRule(ctx, NewRule{
+		Name:    "Complete",
+		Trigger: RuleOnItemAdded,
+		Actions: []RuleAction{{Type: RuleComplete}},
+		DryRun:  true,
+	})
+	require.NoError(t, err)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	item, err = service.GetItem(ctx, item.ID)
+	require.NoError(t, err)
+	assert.False(t, item.Completed)
+
+	executions, err := service.ListRuleExecutions(ctx, rule.ID)
+	require.NoError(t, err)
+	require.Len(t, executions, 1)
+	assert.Equal(t, RuleDryRun, executions[0].Status)
+
+	_, err = service.UpdateRule(ctx, rule.ID, RuleUpdate{Enabled: boolPtr(false)})
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{Title: "Buy bread"})
+	require.NoError(t, err)
+
+	executions, err = service.ListRuleExecutions(ctx, rule.ID)
+	require.NoError(t, err)
+	assert.Len(t, executions, 1, "disabled rules do not run")
+}
+
+func TestRuleEngine_Failure(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newRuleService()
+
+	rule, err := service.CreateRule(ctx, NewRule{
+		Name:    "Archive",
+		Trigger: RuleOnItemAdded,
+		Actions: []RuleAction{
+			{Type: RuleTag, Value: "archived"},
+			{Type: RuleMoveToList, ListID: "missing"},
+		},
+	})
+	require.NoError(t, err)
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Old task"})
+	require.NoError(t, err, "failing rules do not fail the change triggering them")
+
+	item, err = service.GetItem(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Empty(t, item.Tags, "the changes of a failing rule are rolled back")
+
+	executions, err := service.ListRuleExecutions(ctx, rule.ID)
+	require.NoError(t, err)
+	require.Len(t, executions, 1)
+	assert.Equal(t, RuleFailed, executions[0].Status)
+	assert.NotEmpty(t, executions[0].Error)
+}
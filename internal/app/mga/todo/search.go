@@ -0,0 +1,266 @@
+package todo
+
+import (
+	"context"
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"emperror.dev/errors"
+)
+
+const (
+	// minSearchTermLength is the length of the shortest word searched for.
+	// It matches the shortest word indexed by MySQL (innodb_ft_min_token_size).
+	minSearchTermLength = 3
+
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+
+	// snippetContextWords is the number of words kept before the first match in a long field.
+	snippetContextWords = 5
+
+	// maxSnippetLength is the length (in bytes) of the excerpt of a long field returned as a highlight.
+	maxSnippetLength = 200
+)
+
+// SearchQuery tells which items to search for.
+type SearchQuery struct {
+	// Query is the text searched for: items containing every word of it (as the start of a word) match.
+	Query string
+
+	// ListID searches the items of a list. Items of every list are searched when it's empty.
+	ListID string
+
+	// Limit is the maximum number of results. Defaults to 20.
+	Limit int
+}
+
+// SearchResult is an item matching a search.
+type SearchResult struct {
+	Item Item
+
+	// Highlights are the matching fields of the item.
+	Highlights []Highlight
+}
+
+// Highlight is a field of an item with the matching words wrapped in <mark> tags.
+// The rest of the fragment is HTML escaped.
+// Long fields (eg. notes) are cut to an excerpt around the first match, marked with ellipses.
+type Highlight struct {
+	Field    string
+	Fragment string
+}
+
+// SearchStore searches items by the words of their title and notes.
+type SearchStore interface {
+	// Search returns the items containing every term (as the prefix of a word), the most relevant first.
+	// Items of every list are searched when the list ID is empty.
+	Search(ctx context.Context, terms []string, listID string, limit int) ([]Item, error)
+}
+
+func (s service) SearchItems(ctx context.Context, query SearchQuery) ([]SearchResult, error) {
+	terms := searchTerms(query.Query)
+
+	violations := make(map[string][]string)
+
+	if len(terms) == 0 {
+		violations["query"] = append(violations["query"], "query must contain a word of at least 3 characters")
+	}
+
+	if query.Limit < 0 || query.Limit > maxSearchLimit {
+		violations["limit"] = append(violations["limit"], "limit must be between 1 and 100")
+	}
+
+	if len(violations) > 0 {
+		return nil, errors.WithStack(validationError{violations: violations})
+	}
+
+	if query.Limit == 0 {
+		query.Limit = defaultSearchLimit
+	}
+
+	if query.ListID != "" {
+		_, err := s.getList(ctx, query.ListID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := s.searchStore.Search(ctx, terms, query.ListID, query.Limit)
+	if err != nil {
+		return nil, errors.WithMessage(err, "search items")
+	}
+
+	results := make([]SearchResult, 0, len(items))
+
+	for _, item := range items {
+		result := SearchResult{Item: item}
+
+		if fragment, ok := highlight(item.Title, terms); ok {
+			result.Highlights = append(result.Highlights, Highlight{Field: "title", Fragment: fragment})
+		}
+
+		if fragment, ok := highlight(snippet(item.Notes, terms), terms); ok {
+			result.Highlights = append(result.Highlights, Highlight{Field: "notes", Fragment: fragment})
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// searchText returns the text of an item that is searched.
+func searchText(item Item) string {
+	if item.Notes == "" {
+		return item.Title
+	}
+
+	return item.Title + "\n" + item.Notes
+}
+
+// searchToken is a word of a text.
+type searchToken struct {
+	term string
+
+	// start and end are the byte offsets of the word in the text.
+	start int
+	end   int
+}
+
+// tokenize splits a text into lower case words (runs of letters and digits).
+func tokenize(text string) []searchToken {
+	var (
+		tokens []searchToken
+		start  = -1
+	)
+
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+
+		switch {
+		case isWord && start < 0:
+			start = i
+		case !isWord && start >= 0:
+			tokens = append(tokens, searchToken{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+
+	if start >= 0 {
+		tokens = append(tokens, searchToken{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+
+	return tokens
+}
+
+// searchTerms returns the distinct words of a query that are long enough to be searched for.
+func searchTerms(query string) []string {
+	var terms []string
+
+	seen := make(map[string]bool)
+
+	for _, token := range tokenize(query) {
+		if utf8.RuneCountInString(token.term) < minSearchTermLength || seen[token.term] {
+			continue
+		}
+
+		seen[token.term] = true
+		terms = append(terms, token.term)
+	}
+
+	return terms
+}
+
+// highlight wraps the words of a text starting with any of the terms in <mark> tags.
+func highlight(text string, terms []string) (string, bool) {
+	var (
+		buf     strings.Builder
+		last    int
+		matched bool
+	)
+
+	for _, token := range tokenize(text) {
+		if !matchesAnyTerm(token.term, terms) {
+			continue
+		}
+
+		buf.WriteString(html.EscapeString(text[last:token.start]))
+		buf.WriteString("<mark>" + html.EscapeString(text[token.start:token.end]) + "</mark>")
+
+		last = token.end
+		matched = true
+	}
+
+	buf.WriteString(html.EscapeString(text[last:]))
+
+	return buf.String(), matched
+}
+
+// snippet returns an excerpt of a long text starting a few words before the first word matching any of the terms.
+// Cut ends are marked with ellipses.
+func snippet(text string, terms []string) string {
+	if len(text) <= maxSnippetLength {
+		return text
+	}
+
+	tokens := tokenize(text)
+
+	first := 0
+
+	for i, token := range tokens {
+		if matchesAnyTerm(token.term, terms) {
+			first = i
+
+			break
+		}
+	}
+
+	start := 0
+	if first > snippetContextWords {
+		start = tokens[first-snippetContextWords].start
+	}
+
+	end := len(text)
+
+	// The excerpt ends with the last word that fits
+	if end-start > maxSnippetLength {
+		end = start
+
+		for _, token := range tokens {
+			if token.start < start {
+				continue
+			}
+
+			if token.end-start > maxSnippetLength {
+				break
+			}
+
+			end = token.end
+		}
+	}
+
+	excerpt := text[start:end]
+
+	if start > 0 {
+		excerpt = "…" + excerpt
+	}
+
+	if end < len(text) {
+		excerpt += "…"
+	}
+
+	return excerpt
+}
+
+func matchesAnyTerm(word string, terms []string) bool {
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+
+	return false
+}
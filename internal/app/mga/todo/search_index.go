@@ -0,0 +1,160 @@
+package todo
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// BM25 parameters: term frequency saturation and document length normalization.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// prefixMatchWeight is the weight of words that only start with a term (compared to exact matches).
+	prefixMatchWeight = 0.5
+)
+
+// searchIndex is an inverted index of documents (eg. the titles of items).
+type searchIndex struct {
+	// postings are the frequencies of terms by document ID.
+	postings map[string]map[string]int
+
+	// lengths are the number of words in the documents.
+	lengths map[string]int
+
+	// docTerms are the distinct terms of the documents.
+	docTerms map[string][]string
+
+	// terms are the indexed terms in order, so that they can be looked up by prefix.
+	terms []string
+
+	totalLength int
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		postings: make(map[string]map[string]int),
+		lengths:  make(map[string]int),
+		docTerms: make(map[string][]string),
+	}
+}
+
+// add indexes (or reindexes) a document.
+func (idx *searchIndex) add(id string, text string) {
+	idx.remove(id)
+
+	tokens := tokenize(text)
+
+	idx.lengths[id] = len(tokens)
+	idx.totalLength += len(tokens)
+
+	for _, token := range tokens {
+		docs, ok := idx.postings[token.term]
+		if !ok {
+			docs = make(map[string]int)
+			idx.postings[token.term] = docs
+
+			i := sort.SearchStrings(idx.terms, token.term)
+			idx.terms = append(idx.terms, "")
+			copy(idx.terms[i+1:], idx.terms[i:])
+			idx.terms[i] = token.term
+		}
+
+		if docs[id] == 0 {
+			idx.docTerms[id] = append(idx.docTerms[id], token.term)
+		}
+
+		docs[id]++
+	}
+}
+
+// remove removes a document from the index.
+func (idx *searchIndex) remove(id string) {
+	length, ok := idx.lengths[id]
+	if !ok {
+		return
+	}
+
+	delete(idx.lengths, id)
+	idx.totalLength -= length
+
+	for _, term := range idx.docTerms[id] {
+		delete(idx.postings[term], id)
+
+		if len(idx.postings[term]) > 0 {
+			continue
+		}
+
+		delete(idx.postings, term)
+
+		i := sort.SearchStrings(idx.terms, term)
+		idx.terms = append(idx.terms[:i], idx.terms[i+1:]...)
+	}
+
+	delete(idx.docTerms, id)
+}
+
+// search returns the scores of the documents containing every term (as the prefix of a word).
+func (idx *searchIndex) search(terms []string) map[string]float64 {
+	if len(terms) == 0 || len(idx.lengths) == 0 {
+		return nil
+	}
+
+	var scores map[string]float64
+
+	for _, term := range terms {
+		termScores := idx.scoreTerm(term)
+
+		// Documents must match every term
+		if scores != nil {
+			for id := range scores {
+				if _, ok := termScores[id]; !ok {
+					delete(scores, id)
+
+					continue
+				}
+
+				scores[id] += termScores[id]
+			}
+		} else {
+			scores = termScores
+		}
+
+		if len(scores) == 0 {
+			return nil
+		}
+	}
+
+	return scores
+}
+
+// scoreTerm returns the BM25 scores of the documents containing words starting with a term.
+func (idx *searchIndex) scoreTerm(term string) map[string]float64 {
+	scores := make(map[string]float64)
+
+	count := float64(len(idx.lengths))
+	avgLength := float64(idx.totalLength) / count
+
+	for i := sort.SearchStrings(idx.terms, term); i < len(idx.terms) && strings.HasPrefix(idx.terms[i], term); i++ {
+		word := idx.terms[i]
+		docs := idx.postings[word]
+
+		df := float64(len(docs))
+		idf := math.Log(1 + (count-df+0.5)/(df+0.5))
+
+		weight := 1.0
+		if word != term {
+			weight = prefixMatchWeight
+		}
+
+		for id, frequency := range docs {
+			tf := float64(frequency)
+			norm := 1 - bm25B + bm25B*float64(idx.lengths[id])/avgLength
+
+			scores[id] += weight * idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+		}
+	}
+
+	return scores
+}
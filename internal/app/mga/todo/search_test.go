@@ -0,0 +1,176 @@
+package todo_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+func searchResultTitles(results []SearchResult) []string {
+	titles := make([]string, 0, len(results))
+
+	for _, result := range results {
+		titles = append(titles, result.Item.Title)
+	}
+
+	return titles
+}
+
+func TestService_SearchItems(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	for _, title := range []string{"Buy milk", "Buy bread and milk", "Call the plumber", "Milk the cow, milk the goat"} {
+		_, err := service.AddItem(ctx, NewItem{Title: title})
+		require.NoError(t, err)
+	}
+
+	results, err := service.SearchItems(ctx, SearchQuery{Query: "MILK"})
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		[]string{"Buy milk", "Milk the cow, milk the goat", "Buy bread and milk"},
+		searchResultTitles(results),
+		"items are ordered by relevance",
+	)
+	assert.Equal(
+		t,
+		[]Highlight{{Field: "title", Fragment: "<mark>Milk</mark> the cow, <mark>milk</mark> the goat"}},
+		results[1].Highlights,
+	)
+
+	_, err = service.SearchItems(ctx, SearchQuery{Query: "bu mi"})
+	require.Error(t, err, "short words are not searched for")
+	assert.True(t, isValidationError(err))
+
+	results, err = service.SearchItems(ctx, SearchQuery{Query: "bre mil"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Buy bread and milk"}, searchResultTitles(results), "every word must match")
+
+	results, err = service.SearchItems(ctx, SearchQuery{Query: "milk", Limit: 1})
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+}
+
+func TestService_SearchItems_Highlight(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.AddItem(ctx, NewItem{Title: "Fix <b>bold</b> titles & bolts"})
+	require.NoError(t, err)
+
+	results, err := service.SearchItems(ctx, SearchQuery{Query: "bol"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(
+		t,
+		"Fix &lt;b&gt;<mark>bold</mark>&lt;/b&gt; titles &amp; <mark>bolts</mark>",
+		results[0].Highlights[0].Fragment,
+	)
+}
+
+func TestService_SearchItems_List(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Milk"})
+	require.NoError(t, err)
+
+	results, err := service.SearchItems(ctx, SearchQuery{Query: "milk"})
+	require.NoError(t, err)
+	assert.Len(t, results, 2, "every list is searched by default")
+
+	results, err = service.SearchItems(ctx, SearchQuery{Query: "milk", ListID: list.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Milk"}, searchResultTitles(results))
+
+	_, err = service.SearchItems(ctx, SearchQuery{Query: "milk", ListID: "unknown"})
+	assert.True(t, errors.As(err, &ListNotFoundError{}))
+}
+
+func TestService_SearchItems_Changes(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	title := "Buy bread"
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Title: &title})
+	require.NoError(t, err)
+
+	results, err := service.SearchItems(ctx, SearchQuery{Query: "milk"})
+	require.NoError(t, err)
+	assert.Empty(t, results, "changed items are reindexed")
+
+	err = service.DeleteItem(ctx, item.ID, false)
+	require.NoError(t, err)
+
+	results, err = service.SearchItems(ctx, SearchQuery{Query: "bread"})
+	require.NoError(t, err)
+	assert.Empty(t, results, "deleted items are not searched")
+
+	_, err = service.RestoreItem(ctx, item.ID)
+	require.NoError(t, err)
+
+	// Rolled back changes are not searched either
+	err = store.Transaction(ctx, func(ctx context.Context) error {
+		_, err := service.AddItem(ctx, NewItem{Title: "Bake bread"})
+		require.NoError(t, err)
+
+		return errors.New("rollback")
+	})
+	require.Error(t, err)
+
+	results, err = service.SearchItems(ctx, SearchQuery{Query: "bread"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Buy bread"}, searchResultTitles(results))
+}
+
+func TestService_SearchItems_Limit(t *testing.T) {
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.SearchItems(context.Background(), SearchQuery{Query: "milk", Limit: 101})
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_SearchItems_Notes(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.AddItem(ctx, NewItem{Title: "Groceries", Notes: "Buy milk and bread"})
+	require.NoError(t, err)
+
+	results, err := service.SearchItems(ctx, SearchQuery{Query: "milk"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, []Highlight{{Field: "notes", Fragment: "Buy <mark>milk</mark> and bread"}}, results[0].Highlights)
+
+	notes := strings.Repeat("Lorem ipsum dolor sit amet. ", 10) + "Fix the plumbing. " + strings.Repeat("Lorem ipsum dolor sit amet. ", 10)
+
+	_, err = service.AddItem(ctx, NewItem{Title: "Call the landlord", Notes: notes})
+	require.NoError(t, err)
+
+	results, err = service.SearchItems(ctx, SearchQuery{Query: "plumbing"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Highlights, 1)
+
+	fragment := results[0].Highlights[0].Fragment
+
+	assert.True(t, strings.HasPrefix(fragment, "…dolor sit amet. Fix the <mark>plumbing</mark>. Lorem"), fragment)
+	assert.True(t, strings.HasSuffix(fragment, "…"), "long notes are cut to an excerpt")
+}
@@ -2,67 +2,906 @@ package todo
 
 import (
 	"context"
+	"io"
+	"reflect"
+	"strings"
+	"time"
 
 	"emperror.dev/errors"
-	"github.com/sagikazarmark/todobackend-go-kit/todo"
 )
 
-// +mga:event:dispatcher
+// +kit:endpoint:errorStrategy=service
 
-// Events dispatches todo events.
-type Events interface {
-	// MarkedAsComplete dispatches a MarkedAsComplete event.
-	MarkedAsComplete(ctx context.Context, event MarkedAsComplete) error
+// Service manages a todo list.
+type Service interface {
+	// AddItem adds a new item to the list.
+	AddItem(ctx context.Context, newItem NewItem) (item Item, err error)
+
+	// ListItems returns a list of items.
+	ListItems(ctx context.Context, filter ItemFilter) (items []Item, err error)
+
+	// DeleteItems moves all items of a list to the trash.
+	DeleteItems(ctx context.Context, listID string) error
+
+	// GetItem returns the details of an item.
+	GetItem(ctx context.Context, id string) (item Item, err error)
+
+	// UpdateItem updates an existing item.
+	UpdateItem(ctx context.Context, id string, itemUpdate ItemUpdate) (item Item, err error)
+
+	// ListItemTree returns items matching a filter with their subtasks nested under them.
+	// Depth limits the number of subtask levels returned (zero means no limit).
+	ListItemTree(ctx context.Context, filter ItemFilter, depth int) (trees []ItemTree, err error)
+
+	// DeleteItem moves an item to the trash.
+	// Items with subtasks are only deleted (together with their subtasks) when cascade is true.
+	DeleteItem(ctx context.Context, id string, cascade bool) error
+
+	// ListTrash returns the deleted items of a list.
+	ListTrash(ctx context.Context, listID string) (items []Item, err error)
+
+	// RestoreItem restores a deleted item together with its deleted subtasks.
+	RestoreItem(ctx context.Context, id string) (item Item, err error)
+
+	// PurgeItem permanently deletes an item (together with its subtasks) from the trash.
+	PurgeItem(ctx context.Context, id string) error
+
+	// EmptyTrash permanently deletes the deleted items of a list.
+	EmptyTrash(ctx context.Context, listID string) error
+
+	// CreateList creates a new list.
+	CreateList(ctx context.Context, newList NewList) (list List, err error)
+
+	// ListLists returns all lists.
+	ListLists(ctx context.Context) (lists []List, err error)
+
+	// GetList returns the details of a list.
+	GetList(ctx context.Context, id string) (list List, err error)
+
+	// UpdateList updates an existing list.
+	UpdateList(ctx context.Context, id string, listUpdate ListUpdate) (list List, err error)
+
+	// DeleteList permanently deletes a list with all of its items.
+	DeleteList(ctx context.Context, id string) error
+
+	// CreateTag creates a new tag.
+	CreateTag(ctx context.Context, newTag NewTag) (tag Tag, err error)
+
+	// ListTags returns all tags with their usage counts.
+	ListTags(ctx context.Context) (tags []Tag, err error)
+
+	// GetTag returns the details of a tag.
+	GetTag(ctx context.Context, id string) (tag Tag, err error)
+
+	// UpdateTag updates (eg. renames) an existing tag.
+	UpdateTag(ctx context.Context, id string, tagUpdate TagUpdate) (tag Tag, err error)
+
+	// MergeTags moves the items of a tag to another one and deletes the source tag.
+	MergeTags(ctx context.Context, sourceID string, targetID string) (tag Tag, err error)
+
+	// DeleteTag deletes a tag, removing it from every item.
+	DeleteTag(ctx context.Context, id string) error
+
+	// TagItem attaches tags to an item by their names. Unknown tags are created.
+	TagItem(ctx context.Context, id string, tags []string) (item Item, err error)
+
+	// UntagItem detaches tags from an item by their names.
+	UntagItem(ctx context.Context, id string, tags []string) (item Item, err error)
+
+	// AssignItem assigns an item to a user, replacing its current assignee.
+	AssignItem(ctx context.Context, id string, assignee string) (item Item, err error)
+
+	// UnassignItem removes the assignee of an item.
+	UnassignItem(ctx context.Context, id string) (item Item, err error)
+
+	// MoveItem moves an item before or after another item in the manual order of its list.
+	MoveItem(ctx context.Context, id string, move ItemMove) (item Item, err error)
+
+	// ItemHistory returns the changes of an item, oldest first.
+	ItemHistory(ctx context.Context, id string) (entries []HistoryEntry, err error)
+
+	// Undo reverts the last operations of the current user, most recent first.
+	Undo(ctx context.Context, steps int) (operations []Operation, err error)
+
+	// Redo reapplies the last undone operations of the current user.
+	Redo(ctx context.Context, steps int) (operations []Operation, err error)
+
+	// BulkAddItems adds several items at once.
+	BulkAddItems(ctx context.Context, newItems []NewItem, mode BulkMode) (results []BulkResult, err error)
+
+	// BulkUpdateItems updates several items at once.
+	BulkUpdateItems(ctx context.Context, updates []BulkItemUpdate, mode BulkMode) (results []BulkResult, err error)
+
+	// BulkDeleteItems moves several items to the trash at once.
+	BulkDeleteItems(ctx context.Context, ids []string, cascade bool, mode BulkMode) (results []BulkResult, err error)
+
+	// ExportItems returns the items of a list to be written in a file format.
+	ExportItems(ctx context.Context, listID string, format Format) (export Export, err error)
+
+	// ImportItems adds the items read from a file to a list.
+	ImportItems(ctx context.Context, data io.Reader, options ImportOptions) (report ImportReport, err error)
+
+	// SearchItems returns the items matching a search query, the most relevant first.
+	SearchItems(ctx context.Context, query SearchQuery) (results []SearchResult, err error)
+
+	// AddComment adds a comment to an item.
+	AddComment(ctx context.Context, itemID string, newComment NewComment) (comment Comment, err error)
+
+	// ListComments returns the comments of an item, oldest first.
+	ListComments(ctx context.Context, itemID string) (comments []Comment, err error)
+
+	// GetComment returns the details of a comment.
+	GetComment(ctx context.Context, id string) (comment Comment, err error)
+
+	// UpdateComment replaces the text of a comment, keeping the earlier versions in its edit history.
+	// Only the author can edit a comment.
+	UpdateComment(ctx context.Context, id string, body string) (comment Comment, err error)
+
+	// DeleteComment deletes a comment. Only the author can delete a comment.
+	DeleteComment(ctx context.Context, id string) error
+
+	// AddAttachment attaches a file to an item.
+	AddAttachment(ctx context.Context, itemID string, newAttachment NewAttachment) (attachment Attachment, err error)
+
+	// ListAttachments returns the attachments of an item, oldest first.
+	ListAttachments(ctx context.Context, itemID string) (attachments []Attachment, err error)
+
+	// GetAttachment returns the details of an attachment.
+	GetAttachment(ctx context.Context, id string) (attachment Attachment, err error)
+
+	// DownloadAttachment returns the content of an attachment if the download link is valid.
+	DownloadAttachment(ctx context.Context, id string, link AttachmentLink) (download AttachmentDownload, err error)
+
+	// DeleteAttachment deletes an attachment with its content.
+	DeleteAttachment(ctx context.Context, id string) error
+
+	// CreateShareToken creates a token granting access to a list to anyone holding it.
+	CreateShareToken(ctx context.Context, listID string, newShareToken NewShareToken) (token ShareToken, err error)
+
+	// ListShareTokens returns the share tokens of a list (including expired and revoked ones), oldest first.
+	ListShareTokens(ctx context.Context, listID string) (tokens []ShareToken, err error)
+
+	// RevokeShareToken revokes a share token, so that it no longer grants access.
+	RevokeShareToken(ctx context.Context, id string) error
+
+	// ListShareAccess returns the accesses to a list through share tokens, oldest first.
+	ListShareAccess(ctx context.Context, listID string) (entries []ShareAccessEntry, err error)
+
+	// InviteCollaborator adds a collaborator to a list, or changes their role if they are invited already.
+	InviteCollaborator(ctx context.Context, listID string, invitation Invitation) (collaborator Collaborator, err error)
+
+	// ListCollaborators returns the collaborators of a list, oldest first.
+	ListCollaborators(ctx context.Context, listID string) (collaborators []Collaborator, err error)
+
+	// RemoveCollaborator removes a collaborator from a list by their email address.
+	RemoveCollaborator(ctx context.Context, listID string, email string) error
+
+	// GetSharedList returns a list with its items through a share token.
+	GetSharedList(ctx context.Context, token string) (shared SharedList, err error)
+
+	// AddSharedItem adds an item to a list through a share token granting edit access.
+	AddSharedItem(ctx context.Context, token string, newItem NewItem) (item Item, err error)
+
+	// UpdateSharedItem updates an item of a list through a share token granting edit access.
+	UpdateSharedItem(ctx context.Context, token string, id string, itemUpdate ItemUpdate) (item Item, err error)
+
+	// StartTimer starts tracking the time the current user spends on an item.
+	// The running timer of the user (if any) is stopped.
+	StartTimer(ctx context.Context, itemID string) (entry TimeEntry, err error)
+
+	// StopTimer stops the running timer of the current user.
+	StopTimer(ctx context.Context) (entry TimeEntry, err error)
+
+	// GetTimer returns the running timer of the current user.
+	GetTimer(ctx context.Context) (entry TimeEntry, err error)
+
+	// ListTimeEntries returns the time entries matching a filter, oldest first.
+	ListTimeEntries(ctx context.Context, filter TimeEntryFilter) (entries []TimeEntry, err error)
+
+	// TimeTotals returns the time tracked by the time entries matching a filter per item and per day.
+	TimeTotals(ctx context.Context, filter TimeEntryFilter) (totals TimeTotals, err error)
+
+	// ExportTimesheet returns the time entries matching a filter to be written as a CSV timesheet.
+	ExportTimesheet(ctx context.Context, filter TimeEntryFilter) (timesheet Timesheet, err error)
+
+	// CreateTemplate creates a new template.
+	CreateTemplate(ctx context.Context, newTemplate NewTemplate) (template Template, err error)
+
+	// ListTemplates returns all templates.
+	ListTemplates(ctx context.Context) (templates []Template, err error)
+
+	// GetTemplate returns the details of a template.
+	GetTemplate(ctx context.Context, id string) (template Template, err error)
+
+	// UpdateTemplate updates an existing template.
+	UpdateTemplate(ctx context.Context, id string, templateUpdate TemplateUpdate) (template Template, err error)
+
+	// DeleteTemplate deletes a template. Lists and items created from it are kept.
+	DeleteTemplate(ctx context.Context, id string) error
+
+	// InstantiateTemplate creates the items of a template in a new list or appends them to an existing one.
+	// Either every item is created or none of them.
+	InstantiateTemplate(
+		ctx context.Context,
+		id string,
+		instantiation TemplateInstantiation,
+	) (instance TemplateInstance, err error)
+
+	// CreateRule creates a new automation rule.
+	CreateRule(ctx context.Context, newRule NewRule) (rule Rule, err error)
+
+	// ListRules returns all rules.
+	ListRules(ctx context.Context) (rules []Rule, err error)
+
+	// GetRule returns the details of a rule.
+	GetRule(ctx context.Context, id string) (rule Rule, err error)
+
+	// UpdateRule updates an existing rule.
+	UpdateRule(ctx context.Context, id string, ruleUpdate RuleUpdate) (rule Rule, err error)
+
+	// DeleteRule deletes a rule with its execution log.
+	DeleteRule(ctx context.Context, id string) error
+
+	// EvaluateRule tells whether a rule would run on an item without running its actions.
+	EvaluateRule(ctx context.Context, id string, itemID string) (evaluation RuleEvaluation, err error)
+
+	// ListRuleExecutions returns the execution log of a rule, oldest first.
+	ListRuleExecutions(ctx context.Context, id string) (executions []RuleExecution, err error)
 }
 
-// +mga:event:handler
+// Item is a note describing a task to be done.
+type Item struct {
+	ID        string
+	ListID    string
+	ParentID  string
+	Title     string
+	Completed bool
+	Order     int
+	DueAt     *time.Time
+	Priority  Priority
 
-// MarkedAsComplete event is triggered when an item gets marked as complete.
-type MarkedAsComplete struct {
-	ID string
+	// Notes describe the item in detail (in Markdown).
+	Notes string
+
+	// Assignee is the user responsible for the item (empty if it's unassigned).
+	Assignee string
+
+	// Rank is the position of the item in the manual order of its list.
+	// Ranks are compared as strings and are maintained by the service.
+	Rank string
+
+	// Recurrence is the schedule of the series the item belongs to (if any).
+	Recurrence *Recurrence
+
+	// AutoComplete marks the item as complete once all of its subtasks are complete.
+	AutoComplete bool
+
+	// Tags of the item ordered by name.
+	// They are managed by tagging and untagging the item: storing the item ignores them.
+	Tags []Tag
+
+	// DeletedAt is the time the item was moved to the trash (if it's deleted).
+	// Stores maintain it: storing the item ignores it.
+	DeletedAt *time.Time
+}
+
+// Overdue tells whether the item is incomplete and past its due date.
+func (i Item) Overdue(now time.Time) bool {
+	return !i.Completed && i.DueAt != nil && i.DueAt.Before(now)
+}
+
+// NewItem contains the details of a new Item.
+type NewItem struct {
+	// ListID is the list the item is added to. Defaults to DefaultListID.
+	ListID string
+
+	// ParentID makes the item a subtask of another item in the same list.
+	ParentID string
+
+	Title        string
+	Notes        string
+	Assignee     string
+	Order        int
+	DueAt        *time.Time
+	Priority     Priority
+	Recurrence   *Recurrence
+	AutoComplete bool
+}
+
+func (i NewItem) toItem(id string) Item {
+	return Item{
+		ID:           id,
+		ListID:       i.ListID,
+		ParentID:     i.ParentID,
+		Title:        i.Title,
+		Notes:        i.Notes,
+		Assignee:     i.Assignee,
+		Order:        i.Order,
+		DueAt:        i.DueAt,
+		Priority:     i.Priority,
+		Recurrence:   i.Recurrence,
+		AutoComplete: i.AutoComplete,
+	}
 }
 
-// EventMiddleware fires todo events.
-func EventMiddleware(events Events) Middleware {
-	return func(next todo.Service) todo.Service {
-		return eventMiddleware{
-			Service: DefaultMiddleware{Service: next},
-			next:    next,
+// ItemUpdate contains updates of an existing item.
+type ItemUpdate struct {
+	Title     *string
+	Notes     *string
+	Completed *bool
+	Order     *int
+	DueAt     *time.Time
+	Priority  *Priority
+
+	// ClearDueAt removes the due date of the item. Takes precedence over DueAt.
+	ClearDueAt bool
+
+	// ListID moves the item (with its subtasks) to another list.
+	// Subtasks become top level items in the new list unless a new parent is set as well.
+	ListID *string
+
+	// ParentID moves the item under another item. An empty ID makes it a top level item.
+	ParentID *string
+
+	AutoComplete *bool
+
+	// Recurrence changes the schedule of the item.
+	// The series ID and the start of the series are kept unless set explicitly.
+	Recurrence *Recurrence
+
+	// EndRecurrence removes the item from its series, so no further occurrences are added.
+	// Takes precedence over Recurrence.
+	EndRecurrence bool
+
+	// SkipOccurrence moves the item to the next occurrence of its series.
+	SkipOccurrence bool
+}
 
-			events: events,
+func (i ItemUpdate) update(item Item) Item {
+	if i.Title != nil {
+		item.Title = *i.Title
+	}
+
+	if i.Notes != nil {
+		item.Notes = *i.Notes
+	}
+
+	if i.Completed != nil {
+		item.Completed = *i.Completed
+	}
+
+	if i.Order != nil {
+		item.Order = *i.Order
+	}
+
+	if i.DueAt != nil {
+		item.DueAt = i.DueAt
+	}
+
+	if i.ClearDueAt {
+		item.DueAt = nil
+	}
+
+	if i.Priority != nil {
+		item.Priority = *i.Priority
+	}
+
+	if i.ListID != nil {
+		item.ListID = *i.ListID
+	}
+
+	if i.ParentID != nil {
+		item.ParentID = *i.ParentID
+	}
+
+	if i.AutoComplete != nil {
+		item.AutoComplete = *i.AutoComplete
+	}
+
+	if i.Recurrence != nil {
+		recurrence := *i.Recurrence
+
+		if item.Recurrence != nil {
+			if recurrence.SeriesID == "" {
+				recurrence.SeriesID = item.Recurrence.SeriesID
+			}
+
+			if recurrence.Start.IsZero() {
+				recurrence.Start = item.Recurrence.Start
+			}
+
+			if recurrence.Occurrence.IsZero() {
+				recurrence.Occurrence = item.Recurrence.Occurrence
+			}
 		}
+
+		item.Recurrence = &recurrence
 	}
+
+	if i.EndRecurrence {
+		item.Recurrence = nil
+	}
+
+	return item
+}
+
+// ItemFilter narrows down the list of items.
+// Empty fields are ignored.
+type ItemFilter struct {
+	// ListID lists the items of a list.
+	// The service defaults it to DefaultListID, stores return items of all lists when it's empty.
+	ListID string
+
+	// DueAfter lists items due after a point in time.
+	DueAfter *time.Time
+
+	// DueBefore lists items due before a point in time.
+	DueBefore *time.Time
+
+	// Overdue lists incomplete items past their due date.
+	Overdue bool
+
+	// SeriesID lists the occurrences of a recurring series.
+	SeriesID string
+
+	// ParentID lists the subtasks of an item.
+	ParentID string
+
+	// Tags lists items tagged with any of the tags (by name).
+	Tags []string
+
+	// MatchAllTags lists items tagged with all of the tags instead.
+	MatchAllTags bool
+
+	// Assignee lists the items assigned to a user.
+	Assignee string
+
+	// Mine lists the items assigned to the current user.
+	// The service resolves it to Assignee: stores ignore it.
+	Mine bool
+
+	// Sort orders the items (manual order by default).
+	// Stores ignore it: items are ordered by the service.
+	Sort ItemSort
 }
 
-type eventMiddleware struct {
-	todo.Service
-	next todo.Service
+func (f ItemFilter) match(item Item, now time.Time) bool {
+	if f.ListID != "" && item.ListID != f.ListID {
+		return false
+	}
+
+	if (f.DueAfter != nil || f.DueBefore != nil) && item.DueAt == nil {
+		return false
+	}
+
+	if f.DueAfter != nil && !item.DueAt.After(*f.DueAfter) {
+		return false
+	}
+
+	if f.DueBefore != nil && !item.DueAt.Before(*f.DueBefore) {
+		return false
+	}
+
+	if f.Overdue && !item.Overdue(now) {
+		return false
+	}
 
-	events Events
+	if f.SeriesID != "" && (item.Recurrence == nil || item.Recurrence.SeriesID != f.SeriesID) {
+		return false
+	}
+
+	if f.ParentID != "" && item.ParentID != f.ParentID {
+		return false
+	}
+
+	if f.Assignee != "" && item.Assignee != f.Assignee {
+		return false
+	}
+
+	if len(f.Tags) > 0 && !f.matchTags(item) {
+		return false
+	}
+
+	return true
 }
 
-func (mw eventMiddleware) UpdateItem(ctx context.Context, id string, itemUpdate todo.ItemUpdate) (todo.Item, error) {
-	var fireComplete bool
-	if itemUpdate.Completed != nil && *itemUpdate.Completed {
-		fireComplete = true
+func (f ItemFilter) matchTags(item Item) bool {
+	tags := make(map[string]bool, len(item.Tags))
+
+	for _, tag := range item.Tags {
+		tags[tag.Name] = true
 	}
 
-	item, err := mw.next.UpdateItem(ctx, id, itemUpdate)
+	for _, name := range f.Tags {
+		if tags[name] && !f.MatchAllTags {
+			return true
+		}
+
+		if !tags[name] && f.MatchAllTags {
+			return false
+		}
+	}
+
+	return f.MatchAllTags
+}
+
+// ServiceStore is the combination of the stores a Service persists its state in.
+// Features of the service add their store to this interface instead of adding more arguments to NewService.
+type ServiceStore interface {
+	Store
+	ListStore
+	TagStore
+	TrashStore
+	HistoryStore
+	OperationStore
+	SearchStore
+	CommentStore
+	AttachmentStore
+	ShareStore
+	TimeStore
+	TemplateStore
+	RuleStore
+	Transactor
+}
+
+// NewService returns a new Service.
+func NewService(idgenerator IDGenerator, store ServiceStore, attachments AttachmentConfig) Service {
+	return &service{
+		idgenerator:     idgenerator,
+		store:           store,
+		listStore:       store,
+		tagStore:        store,
+		trashStore:      store,
+		historyStore:    store,
+		operationStore:  store,
+		searchStore:     store,
+		commentStore:    store,
+		attachmentStore: store,
+		shareStore:      store,
+		timeStore:       store,
+		templateStore:   store,
+		ruleStore:       store,
+		transactor:      store,
+		attachments:     attachments.withDefaults(),
+	}
+}
+
+type service struct {
+	idgenerator     IDGenerator
+	store           Store
+	listStore       ListStore
+	tagStore        TagStore
+	trashStore      TrashStore
+	historyStore    HistoryStore
+	operationStore  OperationStore
+	searchStore     SearchStore
+	commentStore    CommentStore
+	attachmentStore AttachmentStore
+	shareStore      ShareStore
+	timeStore       TimeStore
+	templateStore   TemplateStore
+	ruleStore       RuleStore
+	transactor      Transactor
+	attachments     AttachmentConfig
+}
+
+// IDGenerator generates a new ID.
+type IDGenerator interface {
+	// Generate generates a new ID.
+	Generate() (string, error)
+}
+
+// Store persists items.
+// Deleted items are kept in the trash (see TrashStore) and are ignored by every operation.
+type Store interface {
+	// Store stores an item.
+	Store(ctx context.Context, item Item) error
+
+	// GetAll returns all items matching a filter.
+	GetAll(ctx context.Context, filter ItemFilter) ([]Item, error)
+
+	// DeleteAll moves all items of a list to the trash.
+	DeleteAll(ctx context.Context, listID string) error
+
+	// GetOne returns a single item by its ID.
+	GetOne(ctx context.Context, id string) (Item, error)
+
+	// DeleteOne moves a single item to the trash by its ID.
+	DeleteOne(ctx context.Context, id string) error
+
+	// GetMaxRank returns the highest rank in a list (or an empty string if the list has no ranked items).
+	GetMaxRank(ctx context.Context, listID string) (string, error)
+}
+
+// NotFoundError is returned if an item cannot be found.
+type NotFoundError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (NotFoundError) Error() string {
+	return "item not found"
+}
+
+// Details returns error details.
+func (e NotFoundError) Details() []interface{} {
+	return []interface{}{"item_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (NotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (NotFoundError) ServiceError() bool {
+	return true
+}
+
+type validationError struct {
+	violations map[string][]string
+}
+
+func (validationError) Error() string {
+	return "invalid item"
+}
+
+func (e validationError) Violations() map[string][]string {
+	return e.violations
+}
+
+// Validation tells a client that this error is related to a resource being invalid.
+// Can be used to translate the error to eg. status code.
+func (validationError) Validation() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (validationError) ServiceError() bool {
+	return true
+}
+
+func (s service) AddItem(ctx context.Context, newItem NewItem) (Item, error) {
+	id, err := s.idgenerator.Generate()
 	if err != nil {
-		return item, err
+		return Item{}, err
 	}
 
-	if fireComplete {
-		event := MarkedAsComplete{
-			ID: item.ID,
+	if newItem.Title == "" {
+		return Item{}, errors.WithStack(validationError{violations: map[string][]string{
+			"title": {
+				"title cannot be empty",
+			},
+		}})
+	}
+
+	if newItem.ParentID != "" {
+		parent, err := s.getParent(ctx, newItem.ParentID)
+		if err != nil {
+			return Item{}, err
+		}
+
+		// Subtasks are added to the list of their parent by default
+		if newItem.ListID == "" {
+			newItem.ListID = parent.ListID
 		}
 
-		err = mw.events.MarkedAsComplete(ctx, event)
+		if parent.ListID != newItem.ListID {
+			return Item{}, errors.WithStack(parentListError())
+		}
+	}
+
+	if newItem.ListID == "" {
+		newItem.ListID = DefaultListID
+	}
+
+	if !newItem.Priority.valid() {
+		return Item{}, errors.WithStack(priorityError())
+	}
+
+	newItem.Assignee = strings.TrimSpace(newItem.Assignee)
+
+	_, err = s.getWritableList(ctx, newItem.ListID)
+	if err != nil {
+		return Item{}, err
+	}
+
+	item := newItem.toItem(id)
+
+	// New items are added to the end of the list
+	item.Rank, err = s.nextRank(ctx, item.ListID)
+	if err != nil {
+		return Item{}, err
+	}
+
+	if item.Recurrence != nil {
+		recurrence, err := item.Recurrence.normalize(item.ID, item.DueAt)
 		if err != nil {
-			// TODO: rollback item store here? retry?
-			return item, errors.WithMessage(err, "mark item as complete")
+			return Item{}, err
 		}
+
+		item.Recurrence = &recurrence
+	}
+
+	err = s.store.Store(ctx, item)
+	if err != nil {
+		return Item{}, err
+	}
+
+	return item, nil
+}
+
+func (s service) ListItems(ctx context.Context, filter ItemFilter) ([]Item, error) {
+	if filter.ListID == "" {
+		filter.ListID = DefaultListID
+	}
+
+	if filter.DueAfter != nil && filter.DueBefore != nil && !filter.DueAfter.Before(*filter.DueBefore) {
+		return nil, errors.WithStack(validationError{violations: map[string][]string{
+			"dueBefore": {
+				"dueBefore must be later than dueAfter",
+			},
+		}})
+	}
+
+	if !filter.Sort.valid() {
+		return nil, errors.WithStack(validationError{violations: map[string][]string{
+			"sort": {
+				"sort must be either manual or smart",
+			},
+		}})
+	}
+
+	filter, err := mineFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.store.GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	sortItems(items, filter.Sort)
+
+	return items, nil
+}
+
+func (s service) DeleteItems(ctx context.Context, listID string) error {
+	if listID == "" {
+		listID = DefaultListID
+	}
+
+	_, err := s.getList(ctx, listID)
+	if err != nil {
+		return err
+	}
+
+	return s.store.DeleteAll(ctx, listID)
+}
+
+func (s service) GetItem(ctx context.Context, id string) (Item, error) {
+	item, err := s.store.GetOne(ctx, id)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "get item")
 	}
 
 	return item, nil
 }
+
+func (s service) UpdateItem(ctx context.Context, id string, itemUpdate ItemUpdate) (Item, error) {
+	item, err := s.store.GetOne(ctx, id)
+	if err != nil {
+		return Item{}, err
+	}
+
+	updatedItem := itemUpdate.update(item)
+
+	if !updatedItem.Priority.valid() {
+		return Item{}, errors.WithStack(priorityError())
+	}
+
+	if updatedItem.ParentID != item.ParentID && updatedItem.ParentID != "" {
+		parent, err := s.getParent(ctx, updatedItem.ParentID)
+		if err != nil {
+			return Item{}, errors.WithMessage(err, "move item")
+		}
+
+		// Subtasks follow their new parent unless they are explicitly moved to a list
+		if itemUpdate.ListID == nil {
+			updatedItem.ListID = parent.ListID
+		}
+
+		if parent.ListID != updatedItem.ListID {
+			return Item{}, errors.WithStack(parentListError())
+		}
+
+		err = s.checkParent(ctx, updatedItem)
+		if err != nil {
+			return Item{}, err
+		}
+	} else if updatedItem.ListID != item.ListID {
+		// The parent stays in the old list
+		updatedItem.ParentID = ""
+	}
+
+	if updatedItem.ListID != item.ListID {
+		_, err := s.getWritableList(ctx, updatedItem.ListID)
+		if err != nil {
+			return Item{}, errors.WithMessage(err, "move item")
+		}
+
+		// Moved items are added to the end of the new list
+		updatedItem.Rank, err = s.nextRank(ctx, updatedItem.ListID)
+		if err != nil {
+			return Item{}, errors.WithMessage(err, "move item")
+		}
+	}
+
+	if itemUpdate.Recurrence != nil && updatedItem.Recurrence != nil {
+		recurrence, err := updatedItem.Recurrence.normalize(updatedItem.ID, updatedItem.DueAt)
+		if err != nil {
+			return Item{}, err
+		}
+
+		updatedItem.Recurrence = &recurrence
+	}
+
+	if reflect.DeepEqual(item, updatedItem) {
+		return item, nil
+	}
+
+	err = s.store.Store(ctx, updatedItem)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "update item")
+	}
+
+	if updatedItem.Completed && !item.Completed {
+		err := s.stopItemTimers(ctx, updatedItem.ID)
+		if err != nil {
+			return Item{}, errors.WithMessage(err, "stop timers")
+		}
+	}
+
+	if updatedItem.ListID != item.ListID {
+		err := s.moveSubtasks(ctx, updatedItem)
+		if err != nil {
+			return Item{}, errors.WithMessage(err, "move subtasks")
+		}
+	}
+
+	return updatedItem, nil
+}
+
+func (s service) DeleteItem(ctx context.Context, id string, cascade bool) error {
+	subtasks, err := s.getSubtasks(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete item")
+	}
+
+	if len(subtasks) > 0 && !cascade {
+		return errors.WithStack(validationError{violations: map[string][]string{
+			"id": {
+				"item has subtasks",
+			},
+		}})
+	}
+
+	// Subtasks are deleted bottom-up, so parents are never left with dangling children
+	for i := len(subtasks) - 1; i >= 0; i-- {
+		err := s.store.DeleteOne(ctx, subtasks[i].ID)
+		if err != nil {
+			return errors.WithMessage(err, "delete subtask")
+		}
+	}
+
+	err = s.store.DeleteOne(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete item")
+	}
+
+	return nil
+}
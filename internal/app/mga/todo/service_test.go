@@ -0,0 +1,37 @@
+package todo_test
+
+import (
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// testServiceConfig configures the service returned by newTestService.
+type testServiceConfig struct {
+	// Events receives the events of the service (they are discarded by default).
+	Events Events
+
+	// Store wraps the in-memory store of the service (eg. to make some of its methods fail).
+	Store func(store *InMemoryStore) ServiceStore
+
+	Attachments AttachmentConfig
+}
+
+// newTestService returns a service backed by an in-memory store,
+// wrapped in the same middlewares as the application.
+func newTestService(config testServiceConfig) (Service, *InMemoryStore) {
+	events := config.Events
+	if events == nil {
+		events = &recordingEvents{}
+	}
+
+	memoryStore := NewInMemoryStore()
+
+	var store ServiceStore = memoryStore
+	if config.Store != nil {
+		store = config.Store(memoryStore)
+	}
+
+	service := NewService(&sequenceIDGenerator{}, store, config.Attachments)
+	service = FeatureMiddleware(&sequenceIDGenerator{}, store, events)(service)
+
+	return service, memoryStore
+}
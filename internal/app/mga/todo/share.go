@@ -0,0 +1,747 @@
+package todo
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/mail"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/sagikazarmark/kitx/correlation"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// ShareAccess is the level of access a share token grants to a list.
+type ShareAccess string
+
+const (
+	// ShareRead allows viewing the list and its items.
+	ShareRead ShareAccess = "read"
+
+	// ShareEdit allows adding and changing items of the list as well.
+	ShareEdit ShareAccess = "edit"
+)
+
+// shareTokenSize is the number of random bytes in a share token.
+const shareTokenSize = 32
+
+// ShareToken grants access to a list to anyone holding the token, without authentication.
+type ShareToken struct {
+	ID     string
+	ListID string
+	Access ShareAccess
+
+	// Token is the secret part of the share link.
+	// It is only returned when the token is created: stores keep its hash only.
+	Token string
+
+	// TokenHash is the hex encoded SHA-256 hash of the secret token.
+	TokenHash string
+
+	// CreatedBy is the user who shared the list.
+	CreatedBy string
+
+	CreatedAt time.Time
+
+	// ExpiresAt is the time the token stops working (if it expires at all).
+	ExpiresAt *time.Time
+
+	// RevokedAt is the time the token got revoked (if it's revoked).
+	RevokedAt *time.Time
+}
+
+// Active tells whether the token grants access at a point in time.
+func (t ShareToken) Active(now time.Time) bool {
+	return t.RevokedAt == nil && (t.ExpiresAt == nil || t.ExpiresAt.After(now))
+}
+
+// NewShareToken contains the details of a new ShareToken.
+type NewShareToken struct {
+	// Access defaults to ShareRead.
+	Access ShareAccess
+
+	ExpiresAt *time.Time
+}
+
+// CollaboratorRole is the role of a collaborator in a list.
+// Roles are informational: they are recorded and published with the invitation,
+// but they are not enforced (access to lists is granted by share tokens).
+type CollaboratorRole string
+
+const (
+	// CollaboratorViewer is invited to view the list.
+	CollaboratorViewer CollaboratorRole = "viewer"
+
+	// CollaboratorEditor is invited to change the list.
+	CollaboratorEditor CollaboratorRole = "editor"
+)
+
+// Collaborator is a person invited to a list by their email address.
+type Collaborator struct {
+	ID     string
+	ListID string
+
+	// Email identifies the collaborator (in lower case).
+	Email string
+
+	Role CollaboratorRole
+
+	// InvitedBy is the user who invited the collaborator.
+	InvitedBy string
+
+	CreatedAt time.Time
+}
+
+// Invitation contains the details of inviting a collaborator to a list.
+type Invitation struct {
+	Email string
+
+	// Role defaults to CollaboratorViewer.
+	Role CollaboratorRole
+}
+
+// ShareAccessEntry records an access to a list through a share token.
+type ShareAccessEntry struct {
+	ID      string
+	ShareID string
+	ListID  string
+
+	// Operation is the name of the service method called with the token.
+	Operation string
+
+	// ItemID is the item changed by the operation (if any).
+	ItemID string
+
+	// CorrelationID is the ID of the request made with the token.
+	CorrelationID string
+
+	// Denied tells whether the token did not allow the operation.
+	Denied bool
+
+	AccessedAt time.Time
+}
+
+// SharedList is a list viewed through a share token.
+type SharedList struct {
+	List  List
+	Items []Item
+
+	// Access is the level of access granted by the token.
+	Access ShareAccess
+
+	// ExpiresAt is the time the token stops working (if it expires at all).
+	ExpiresAt *time.Time
+}
+
+// ShareStore persists share tokens, collaborators and the access log of shared lists.
+// Share tokens and collaborators are deleted together with their list.
+type ShareStore interface {
+	// StoreShareToken stores a share token (without the secret token).
+	StoreShareToken(ctx context.Context, token ShareToken) error
+
+	// GetShareTokens returns the share tokens of a list, oldest first.
+	GetShareTokens(ctx context.Context, listID string) ([]ShareToken, error)
+
+	// GetOneShareToken returns a single share token by its ID.
+	GetOneShareToken(ctx context.Context, id string) (ShareToken, error)
+
+	// GetShareTokenByHash returns a single share token by the hash of the secret token.
+	GetShareTokenByHash(ctx context.Context, hash string) (ShareToken, error)
+
+	// StoreCollaborator stores a collaborator.
+	StoreCollaborator(ctx context.Context, collaborator Collaborator) error
+
+	// GetCollaborators returns the collaborators of a list, oldest first.
+	GetCollaborators(ctx context.Context, listID string) ([]Collaborator, error)
+
+	// GetCollaboratorByEmail returns a single collaborator of a list by their email address.
+	GetCollaboratorByEmail(ctx context.Context, listID string, email string) (Collaborator, error)
+
+	// DeleteOneCollaborator deletes a single collaborator by their ID.
+	DeleteOneCollaborator(ctx context.Context, id string) error
+
+	// AddShareAccess stores an access log entry.
+	AddShareAccess(ctx context.Context, entry ShareAccessEntry) error
+
+	// GetShareAccess returns the access log of a list, oldest first.
+	GetShareAccess(ctx context.Context, listID string) ([]ShareAccessEntry, error)
+}
+
+// ShareTokenNotFoundError is returned if a share token cannot be found.
+type ShareTokenNotFoundError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (ShareTokenNotFoundError) Error() string {
+	return "share token not found"
+}
+
+// Details returns error details.
+func (e ShareTokenNotFoundError) Details() []interface{} {
+	return []interface{}{"share_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (ShareTokenNotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (ShareTokenNotFoundError) ServiceError() bool {
+	return true
+}
+
+// ShareLinkError is returned if a share token is unknown, expired or revoked.
+// It is reported as a missing resource, so that it does not reveal whether the token has ever existed.
+type ShareLinkError struct{}
+
+// Error implements the error interface.
+func (ShareLinkError) Error() string {
+	return "share link is invalid or expired"
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (ShareLinkError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (ShareLinkError) ServiceError() bool {
+	return true
+}
+
+// ShareForbiddenError is returned if a share token does not allow an operation.
+type ShareForbiddenError struct {
+	ShareID   string
+	Operation string
+}
+
+// Error implements the error interface.
+func (ShareForbiddenError) Error() string {
+	return "share link does not allow changes"
+}
+
+// Details returns error details.
+func (e ShareForbiddenError) Details() []interface{} {
+	return []interface{}{"share_id", e.ShareID, "operation", e.Operation}
+}
+
+// Forbidden tells a client that this error is related to a lack of permission.
+// Can be used to translate the error to eg. status code.
+func (ShareForbiddenError) Forbidden() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (ShareForbiddenError) ServiceError() bool {
+	return true
+}
+
+// CollaboratorNotFoundError is returned if a collaborator cannot be found.
+type CollaboratorNotFoundError struct {
+	ListID string
+	Email  string
+}
+
+// Error implements the error interface.
+func (CollaboratorNotFoundError) Error() string {
+	return "collaborator not found"
+}
+
+// Details returns error details.
+func (e CollaboratorNotFoundError) Details() []interface{} {
+	return []interface{}{"list_id", e.ListID, "email", e.Email}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (CollaboratorNotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (CollaboratorNotFoundError) ServiceError() bool {
+	return true
+}
+
+// shareUser returns the user managing (or reviewing) the sharing of a list.
+func shareUser(ctx context.Context) (string, error) {
+	user, ok := principal.FromContext(ctx)
+	if !ok {
+		return "", errors.WithStack(validationError{violations: map[string][]string{
+			"user": {
+				"sharing a list requires an authenticated user",
+			},
+		}})
+	}
+
+	return user, nil
+}
+
+// hashShareToken returns the hash of a secret token stores look tokens up by.
+func hashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (s service) CreateShareToken(ctx context.Context, listID string, newShareToken NewShareToken) (ShareToken, error) {
+	if newShareToken.Access == "" {
+		newShareToken.Access = ShareRead
+	}
+
+	if newShareToken.Access != ShareRead && newShareToken.Access != ShareEdit {
+		return ShareToken{}, errors.WithStack(validationError{violations: map[string][]string{
+			"access": {
+				"access must be either read or edit",
+			},
+		}})
+	}
+
+	now := time.Now()
+
+	if newShareToken.ExpiresAt != nil && !newShareToken.ExpiresAt.After(now) {
+		return ShareToken{}, errors.WithStack(validationError{violations: map[string][]string{
+			"expiresAt": {
+				"expiry must be in the future",
+			},
+		}})
+	}
+
+	createdBy, err := shareUser(ctx)
+	if err != nil {
+		return ShareToken{}, err
+	}
+
+	_, err = s.getList(ctx, listID)
+	if err != nil {
+		return ShareToken{}, errors.WithMessage(err, "create share token")
+	}
+
+	id, err := s.idgenerator.Generate()
+	if err != nil {
+		return ShareToken{}, err
+	}
+
+	secret := make([]byte, shareTokenSize)
+
+	_, err = rand.Read(secret)
+	if err != nil {
+		return ShareToken{}, errors.WrapIf(err, "generate share token")
+	}
+
+	token := ShareToken{
+		ID:        id,
+		ListID:    listID,
+		Access:    newShareToken.Access,
+		Token:     base64.RawURLEncoding.EncodeToString(secret),
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		ExpiresAt: newShareToken.ExpiresAt,
+	}
+	token.TokenHash = hashShareToken(token.Token)
+
+	storedToken := token
+	storedToken.Token = ""
+
+	err = s.shareStore.StoreShareToken(ctx, storedToken)
+	if err != nil {
+		return ShareToken{}, errors.WithMessage(err, "create share token")
+	}
+
+	return token, nil
+}
+
+func (s service) ListShareTokens(ctx context.Context, listID string) ([]ShareToken, error) {
+	_, err := shareUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getList(ctx, listID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "list share tokens")
+	}
+
+	return s.shareStore.GetShareTokens(ctx, listID)
+}
+
+func (s service) RevokeShareToken(ctx context.Context, id string) error {
+	user, err := shareUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	token, err := s.shareStore.GetOneShareToken(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "revoke share token")
+	}
+
+	// Tokens created before their creator got recorded can be revoked by anyone.
+	// Tokens of other users are not found, so that their IDs cannot be probed.
+	if token.CreatedBy != "" && token.CreatedBy != user {
+		return errors.WithStack(ShareTokenNotFoundError{ID: id})
+	}
+
+	if token.RevokedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	token.RevokedAt = &now
+
+	err = s.shareStore.StoreShareToken(ctx, token)
+	if err != nil {
+		return errors.WithMessage(err, "revoke share token")
+	}
+
+	return nil
+}
+
+func (s service) ListShareAccess(ctx context.Context, listID string) ([]ShareAccessEntry, error) {
+	_, err := shareUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getList(ctx, listID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "list share access")
+	}
+
+	return s.shareStore.GetShareAccess(ctx, listID)
+}
+
+func (s service) InviteCollaborator(ctx context.Context, listID string, invitation Invitation) (Collaborator, error) {
+	email := strings.ToLower(strings.TrimSpace(invitation.Email))
+
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return Collaborator{}, errors.WithStack(validationError{violations: map[string][]string{
+			"email": {
+				"email must be a valid email address",
+			},
+		}})
+	}
+
+	if invitation.Role == "" {
+		invitation.Role = CollaboratorViewer
+	}
+
+	if invitation.Role != CollaboratorViewer && invitation.Role != CollaboratorEditor {
+		return Collaborator{}, errors.WithStack(validationError{violations: map[string][]string{
+			"role": {
+				"role must be either viewer or editor",
+			},
+		}})
+	}
+
+	invitedBy, err := shareUser(ctx)
+	if err != nil {
+		return Collaborator{}, err
+	}
+
+	_, err = s.getList(ctx, listID)
+	if err != nil {
+		return Collaborator{}, errors.WithMessage(err, "invite collaborator")
+	}
+
+	// Inviting a collaborator again changes their role
+	collaborator, err := s.shareStore.GetCollaboratorByEmail(ctx, listID, email)
+	if errors.As(err, &CollaboratorNotFoundError{}) {
+		id, err := s.idgenerator.Generate()
+		if err != nil {
+			return Collaborator{}, err
+		}
+
+		collaborator = Collaborator{
+			ID:        id,
+			ListID:    listID,
+			Email:     email,
+			InvitedBy: invitedBy,
+			CreatedAt: time.Now(),
+		}
+	} else if err != nil {
+		return Collaborator{}, errors.WithMessage(err, "invite collaborator")
+	}
+
+	collaborator.Role = invitation.Role
+
+	err = s.shareStore.StoreCollaborator(ctx, collaborator)
+	if err != nil {
+		return Collaborator{}, errors.WithMessage(err, "invite collaborator")
+	}
+
+	return collaborator, nil
+}
+
+func (s service) ListCollaborators(ctx context.Context, listID string) ([]Collaborator, error) {
+	_, err := shareUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getList(ctx, listID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "list collaborators")
+	}
+
+	return s.shareStore.GetCollaborators(ctx, listID)
+}
+
+func (s service) RemoveCollaborator(ctx context.Context, listID string, email string) error {
+	_, err := shareUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	collaborator, err := s.shareStore.GetCollaboratorByEmail(ctx, listID, strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		return errors.WithMessage(err, "remove collaborator")
+	}
+
+	return s.shareStore.DeleteOneCollaborator(ctx, collaborator.ID)
+}
+
+// errSharedListsUnavailable is returned by a service accessing shared lists without ShareMiddleware.
+var errSharedListsUnavailable = errors.New("shared lists are served by the share middleware")
+
+// Shared lists are only served by ShareMiddleware, so that changes made through share tokens
+// go through every middleware wrapped by it.
+
+func (s service) GetSharedList(_ context.Context, _ string) (SharedList, error) {
+	return SharedList{}, errors.WithStack(errSharedListsUnavailable)
+}
+
+func (s service) AddSharedItem(_ context.Context, _ string, _ NewItem) (Item, error) {
+	return Item{}, errors.WithStack(errSharedListsUnavailable)
+}
+
+func (s service) UpdateSharedItem(_ context.Context, _ string, _ string, _ ItemUpdate) (Item, error) {
+	return Item{}, errors.WithStack(errSharedListsUnavailable)
+}
+
+// ShareMiddleware applies changes made through share tokens with the item operations of the underlying service,
+// so they go through the same middleware as any other change.
+// Changes made through share tokens run in a transaction: events they fire are dispatched once they are committed.
+func ShareMiddleware(idgenerator IDGenerator, store ShareStore, transactor Transactor, events Events) Middleware {
+	return func(next Service) Service {
+		return shareMiddleware{
+			Service: DefaultMiddleware{Service: next},
+			shared: shareService{
+				service:     next,
+				idgenerator: idgenerator,
+				store:       store,
+				transactor:  transactor,
+				events:      events,
+			},
+		}
+	}
+}
+
+type shareMiddleware struct {
+	Service
+
+	shared shareService
+}
+
+func (mw shareMiddleware) GetSharedList(ctx context.Context, token string) (SharedList, error) {
+	return mw.shared.GetSharedList(ctx, token)
+}
+
+func (mw shareMiddleware) AddSharedItem(ctx context.Context, token string, newItem NewItem) (Item, error) {
+	return mw.shared.AddSharedItem(ctx, token, newItem)
+}
+
+func (mw shareMiddleware) UpdateSharedItem(
+	ctx context.Context,
+	token string,
+	id string,
+	itemUpdate ItemUpdate,
+) (Item, error) {
+	return mw.shared.UpdateSharedItem(ctx, token, id, itemUpdate)
+}
+
+// shareService accesses lists through share tokens, recording every access.
+type shareService struct {
+	service     Service
+	idgenerator IDGenerator
+	store       ShareStore
+	transactor  Transactor
+	events      Events
+}
+
+func (s shareService) GetSharedList(ctx context.Context, token string) (SharedList, error) {
+	share, err := s.authorize(ctx, token, ShareRead, "GetSharedList", "")
+	if err != nil {
+		return SharedList{}, err
+	}
+
+	list, err := s.service.GetList(ctx, share.ListID)
+	if err != nil {
+		return SharedList{}, errors.WithMessage(err, "get shared list")
+	}
+
+	items, err := s.service.ListItems(ctx, ItemFilter{ListID: share.ListID})
+	if err != nil {
+		return SharedList{}, errors.WithMessage(err, "get shared list")
+	}
+
+	return SharedList{
+		List:      list,
+		Items:     items,
+		Access:    share.Access,
+		ExpiresAt: share.ExpiresAt,
+	}, nil
+}
+
+func (s shareService) AddSharedItem(ctx context.Context, token string, newItem NewItem) (Item, error) {
+	share, err := s.authorize(ctx, token, ShareEdit, "AddSharedItem", "")
+	if err != nil {
+		return Item{}, err
+	}
+
+	// Items can only be added to the shared list
+	newItem.ListID = share.ListID
+
+	err = s.checkParent(ctx, share, newItem.ParentID)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "add shared item")
+	}
+
+	return s.service.AddItem(sharedContext(ctx, share), newItem)
+}
+
+func (s shareService) UpdateSharedItem(ctx context.Context, token string, id string, itemUpdate ItemUpdate) (Item, error) {
+	share, err := s.authorize(ctx, token, ShareEdit, "UpdateSharedItem", id)
+	if err != nil {
+		return Item{}, err
+	}
+
+	item, err := s.service.GetItem(ctx, id)
+	if err == nil && item.ListID != share.ListID {
+		err = errors.WithStack(NotFoundError{ID: id})
+	}
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "update shared item")
+	}
+
+	if itemUpdate.ListID != nil && *itemUpdate.ListID != share.ListID {
+		return Item{}, errors.WithStack(sharedListError())
+	}
+
+	if itemUpdate.ParentID != nil {
+		err = s.checkParent(ctx, share, *itemUpdate.ParentID)
+		if err != nil {
+			return Item{}, errors.WithMessage(err, "update shared item")
+		}
+	}
+
+	err = transaction(ctx, s.transactor, s.events, func(ctx context.Context) error {
+		item, err = s.service.UpdateItem(sharedContext(ctx, share), id, itemUpdate)
+		if err != nil {
+			return err
+		}
+
+		if item.ListID != share.ListID {
+			return errors.WithStack(sharedListError())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Item{}, err
+	}
+
+	return item, nil
+}
+
+// checkParent makes sure that the parent of a shared item (if any) is in the shared list.
+// Items of other lists are not found, so that their IDs cannot be probed through the token.
+func (s shareService) checkParent(ctx context.Context, share ShareToken, parentID string) error {
+	if parentID == "" {
+		return nil
+	}
+
+	parent, err := s.service.GetItem(ctx, parentID)
+	if err == nil && parent.ListID != share.ListID {
+		err = errors.WithStack(NotFoundError{ID: parentID})
+	}
+
+	return err
+}
+
+func sharedListError() validationError {
+	return validationError{violations: map[string][]string{
+		"listId": {
+			"items cannot be moved out of a shared list",
+		},
+	}}
+}
+
+// authorize looks up an active share token and records the access.
+// Accesses the token does not allow are recorded as denied.
+func (s shareService) authorize(
+	ctx context.Context,
+	token string,
+	access ShareAccess,
+	operation string,
+	itemID string,
+) (ShareToken, error) {
+	share, err := s.store.GetShareTokenByHash(ctx, hashShareToken(token))
+	if errors.As(err, &ShareTokenNotFoundError{}) || (err == nil && !share.Active(time.Now())) {
+		return ShareToken{}, errors.WithStack(ShareLinkError{})
+	}
+	if err != nil {
+		return ShareToken{}, err
+	}
+
+	denied := access == ShareEdit && share.Access != ShareEdit
+
+	id, err := s.idgenerator.Generate()
+	if err != nil {
+		return ShareToken{}, errors.WithMessage(err, "record share access")
+	}
+
+	correlationID, _ := correlation.FromContext(ctx)
+
+	err = s.store.AddShareAccess(ctx, ShareAccessEntry{
+		ID:            id,
+		ShareID:       share.ID,
+		ListID:        share.ListID,
+		Operation:     operation,
+		ItemID:        itemID,
+		CorrelationID: correlationID,
+		Denied:        denied,
+		AccessedAt:    time.Now(),
+	})
+	if err != nil {
+		return ShareToken{}, errors.WithMessage(err, "record share access")
+	}
+
+	if denied {
+		return ShareToken{}, errors.WithStack(ShareForbiddenError{ShareID: share.ID, Operation: operation})
+	}
+
+	return share, nil
+}
+
+// sharedContext attributes changes made by anonymous token holders to the share token.
+func sharedContext(ctx context.Context, share ShareToken) context.Context {
+	if _, ok := principal.FromContext(ctx); ok {
+		return ctx
+	}
+
+	return principal.ToContext(ctx, "share:"+share.ID)
+}
@@ -0,0 +1,259 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/sagikazarmark/kitx/correlation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func TestService_ShareToken(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, store := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	item, err := service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Buy milk"})
+	require.NoError(t, err)
+
+	token, err := service.CreateShareToken(ctx, list.ID, NewShareToken{})
+	require.NoError(t, err)
+	assert.Equal(t, ShareRead, token.Access)
+	assert.Equal(t, "john", token.CreatedBy)
+	assert.NotEmpty(t, token.Token)
+
+	stored, err := store.GetOneShareToken(ctx, token.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stored.Token, "the secret token is not stored")
+	assert.NotEqual(t, token.Token, stored.TokenHash)
+
+	anonymous := correlation.ToContext(context.Background(), "request-1")
+
+	shared, err := service.GetSharedList(anonymous, token.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "Groceries", shared.List.Name)
+	assert.Equal(t, ShareRead, shared.Access)
+	require.Len(t, shared.Items, 1)
+	assert.Equal(t, item.ID, shared.Items[0].ID)
+
+	_, err = service.AddSharedItem(anonymous, token.Token, NewItem{Title: "Buy bread"})
+	assert.True(t, errors.As(err, &ShareForbiddenError{}))
+
+	entries, err := service.ListShareAccess(ctx, list.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "GetSharedList", entries[0].Operation)
+	assert.Equal(t, "request-1", entries[0].CorrelationID)
+	assert.False(t, entries[0].Denied)
+	assert.Equal(t, "AddSharedItem", entries[1].Operation)
+	assert.True(t, entries[1].Denied)
+}
+
+func TestService_ShareToken_Edit(t *testing.T) {
+	ctx := context.Background()
+	owner := principal.ToContext(ctx, "john")
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	otherList, err := service.CreateList(ctx, NewList{Name: "Chores"})
+	require.NoError(t, err)
+
+	other, err := service.AddItem(ctx, NewItem{ListID: otherList.ID, Title: "Do the dishes"})
+	require.NoError(t, err)
+
+	token, err := service.CreateShareToken(owner, list.ID, NewShareToken{Access: ShareEdit})
+	require.NoError(t, err)
+
+	item, err := service.AddSharedItem(ctx, token.Token, NewItem{ListID: otherList.ID, Title: "Buy milk"})
+	require.NoError(t, err)
+	assert.Equal(t, list.ID, item.ListID, "items are added to the shared list")
+
+	completed := true
+
+	item, err = service.UpdateSharedItem(ctx, token.Token, item.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+	assert.True(t, item.Completed)
+
+	history, err := service.ItemHistory(ctx, item.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "share:"+token.ID, history[1].Actor)
+
+	_, err = service.UpdateSharedItem(ctx, token.Token, item.ID, ItemUpdate{ListID: &otherList.ID})
+	assert.True(t, isValidationError(err), "items cannot be moved out of the shared list")
+
+	_, err = service.UpdateSharedItem(ctx, token.Token, other.ID, ItemUpdate{Completed: &completed})
+	assert.True(t, errors.As(err, &NotFoundError{}), "items of other lists are not shared")
+
+	_, err = service.UpdateSharedItem(ctx, token.Token, item.ID, ItemUpdate{ParentID: &other.ID})
+	assert.True(t, errors.As(err, &NotFoundError{}), "items cannot be moved under items of other lists")
+
+	_, err = service.AddSharedItem(ctx, token.Token, NewItem{ParentID: other.ID, Title: "Dry the dishes"})
+	assert.True(t, errors.As(err, &NotFoundError{}), "items cannot be added under items of other lists")
+
+	item, err = service.GetItem(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, list.ID, item.ListID)
+	assert.Empty(t, item.ParentID)
+}
+
+func TestService_ShareToken_Inactive(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	_, err = service.CreateShareToken(ctx, list.ID, NewShareToken{Access: "write"})
+	assert.True(t, isValidationError(err))
+
+	past := time.Now().Add(-time.Hour)
+
+	_, err = service.CreateShareToken(ctx, list.ID, NewShareToken{ExpiresAt: &past})
+	assert.True(t, isValidationError(err))
+
+	_, err = service.CreateShareToken(context.Background(), list.ID, NewShareToken{})
+	assert.True(t, isValidationError(err), "anonymous users cannot share lists")
+
+	token, err := service.CreateShareToken(ctx, list.ID, NewShareToken{})
+	require.NoError(t, err)
+	assert.Equal(t, "john", token.CreatedBy)
+
+	err = service.RevokeShareToken(context.Background(), token.ID)
+	assert.True(t, isValidationError(err), "anonymous users cannot revoke tokens")
+
+	err = service.RevokeShareToken(principal.ToContext(context.Background(), "jane"), token.ID)
+	assert.True(t, errors.As(err, &ShareTokenNotFoundError{}), "only the creator can revoke a token")
+
+	err = service.RevokeShareToken(ctx, token.ID)
+	require.NoError(t, err)
+
+	err = service.RevokeShareToken(ctx, token.ID)
+	require.NoError(t, err, "revoking a token is idempotent")
+
+	_, err = service.GetSharedList(ctx, token.Token)
+	assert.True(t, errors.As(err, &ShareLinkError{}))
+
+	_, err = service.GetSharedList(ctx, "unknown")
+	assert.True(t, errors.As(err, &ShareLinkError{}))
+
+	_, err = service.ListShareTokens(context.Background(), list.ID)
+	assert.True(t, isValidationError(err), "anonymous users cannot list tokens")
+
+	_, err = service.ListShareAccess(context.Background(), list.ID)
+	assert.True(t, isValidationError(err), "anonymous users cannot list accesses")
+
+	tokens, err := service.ListShareTokens(ctx, list.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.False(t, tokens[0].Active(time.Now()))
+	assert.NotNil(t, tokens[0].RevokedAt)
+
+	err = service.DeleteList(ctx, list.ID)
+	require.NoError(t, err)
+
+	err = service.RevokeShareToken(ctx, token.ID)
+	assert.True(t, errors.As(err, &ShareTokenNotFoundError{}), "tokens are deleted with their list")
+}
+
+func TestService_Collaborators(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	collaborator, err := service.InviteCollaborator(ctx, list.ID, Invitation{Email: " Jane@Example.com "})
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", collaborator.Email)
+	assert.Equal(t, CollaboratorViewer, collaborator.Role)
+	assert.Equal(t, "john", collaborator.InvitedBy)
+
+	// Inviting a collaborator again changes their role
+	collaborator, err = service.InviteCollaborator(ctx, list.ID, Invitation{Email: "jane@example.com", Role: CollaboratorEditor})
+	require.NoError(t, err)
+	assert.Equal(t, CollaboratorEditor, collaborator.Role)
+
+	_, err = service.ListCollaborators(context.Background(), list.ID)
+	assert.True(t, isValidationError(err), "anonymous users cannot list collaborators")
+
+	collaborators, err := service.ListCollaborators(ctx, list.ID)
+	require.NoError(t, err)
+	require.Len(t, collaborators, 1)
+	assert.Equal(t, collaborator, collaborators[0])
+
+	require.Len(t, events.invited, 2)
+	assert.Equal(t, "jane@example.com", events.invited[0].Email)
+	assert.Equal(t, "john", events.invited[0].InvitedBy)
+
+	_, err = service.InviteCollaborator(context.Background(), list.ID, Invitation{Email: "joe@example.com"})
+	assert.True(t, isValidationError(err), "anonymous users cannot invite collaborators")
+
+	for _, invitation := range []Invitation{{Email: "jane"}, {Email: "Jane <jane@example.com>"}, {Email: "jane@example.com", Role: "owner"}} {
+		_, err := service.InviteCollaborator(ctx, list.ID, invitation)
+		assert.True(t, isValidationError(err), invitation.Email)
+	}
+
+	err = service.RemoveCollaborator(context.Background(), list.ID, "jane@example.com")
+	assert.True(t, isValidationError(err), "anonymous users cannot remove collaborators")
+
+	err = service.RemoveCollaborator(ctx, list.ID, "JANE@example.com")
+	require.NoError(t, err)
+
+	err = service.RemoveCollaborator(ctx, list.ID, "jane@example.com")
+	assert.True(t, errors.As(err, &CollaboratorNotFoundError{}))
+
+	_, err = service.InviteCollaborator(ctx, "missing", Invitation{Email: "jane@example.com"})
+	assert.True(t, errors.As(err, &ListNotFoundError{}))
+}
+
+func TestService_Collaborators_RolesAreInformational(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	item, err := service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Buy milk"})
+	require.NoError(t, err)
+
+	_, err = service.InviteCollaborator(ctx, list.ID, Invitation{Email: "jane@example.com", Role: CollaboratorViewer})
+	require.NoError(t, err)
+
+	// Roles do not restrict access: a viewer changes items like any other user
+	completed := true
+
+	item, err = service.UpdateItem(principal.ToContext(context.Background(), "jane@example.com"), item.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+	assert.True(t, item.Completed)
+
+	// Nor do they grant access through share links
+	tokens, err := service.ListShareTokens(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
+
+func TestService_SharedList_WithoutMiddleware(t *testing.T) {
+	ctx := context.Background()
+	service := NewService(&sequenceIDGenerator{}, NewInMemoryStore(), AttachmentConfig{})
+
+	_, err := service.GetSharedList(ctx, "token")
+	assert.Error(t, err, "shared lists are only served through the share middleware")
+
+	_, err = service.AddSharedItem(ctx, "token", NewItem{Title: "Buy milk"})
+	assert.Error(t, err)
+
+	_, err = service.UpdateSharedItem(ctx, "token", "01", ItemUpdate{})
+	assert.Error(t, err)
+}
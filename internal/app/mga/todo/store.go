@@ -0,0 +1,1531 @@
+package todo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InMemoryStore keeps items in the memory.
+// Use it in tests or for development/demo purposes.
+type InMemoryStore struct {
+	items         map[string]Item
+	trash         map[string]Item
+	lists         map[string]List
+	tags          map[string]Tag
+	itemTags      map[string]map[string]bool
+	notifications map[string]map[DueNotification]bool
+	history       map[string][]HistoryEntry
+	operations    []Operation
+	comments      map[string]Comment
+	attachments   map[string]Attachment
+	shareTokens   map[string]ShareToken
+	collaborators map[string]Collaborator
+	shareAccess   []ShareAccessEntry
+	timeEntries   map[string]TimeEntry
+	templates     map[string]Template
+	rules         map[string]Rule
+	executions    []RuleExecution
+	searchIndex   *searchIndex
+	itemsOnce     sync.Once
+	mu            sync.RWMutex
+}
+
+// NewInMemoryStore returns a new in-memory item store.
+func NewInMemoryStore() *InMemoryStore {
+	store := &InMemoryStore{}
+
+	store.init()
+
+	return store
+}
+
+func (s *InMemoryStore) init() {
+	s.itemsOnce.Do(func() {
+		s.items = make(map[string]Item)
+		s.trash = make(map[string]Item)
+		s.lists = make(map[string]List)
+		s.tags = make(map[string]Tag)
+		s.itemTags = make(map[string]map[string]bool)
+		s.notifications = make(map[string]map[DueNotification]bool)
+		s.history = make(map[string][]HistoryEntry)
+		s.comments = make(map[string]Comment)
+		s.attachments = make(map[string]Attachment)
+		s.shareTokens = make(map[string]ShareToken)
+		s.collaborators = make(map[string]Collaborator)
+		s.timeEntries = make(map[string]TimeEntry)
+		s.templates = make(map[string]Template)
+		s.rules = make(map[string]Rule)
+		s.searchIndex = newSearchIndex()
+	})
+}
+
+// Transaction runs a function in a transaction: changes are rolled back if the function returns an error.
+// The store is locked for the whole transaction, so concurrent requests wait for it to finish.
+// Functions called in a transaction take part in it; a nested transaction only rolls back its own changes.
+func (s *InMemoryStore) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.init()
+
+	if !s.inTransaction(ctx) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		ctx = context.WithValue(ctx, inMemoryTransactionKey{}, s)
+	}
+
+	snapshot := s.snapshot()
+
+	err := fn(ctx)
+	if err != nil {
+		s.restore(snapshot)
+
+		return err
+	}
+
+	return nil
+}
+
+type inMemoryTransactionKey struct{}
+
+// inTransaction tells whether a context belongs to a transaction of the store (which holds the lock already).
+func (s *InMemoryStore) inTransaction(ctx context.Context) bool {
+	tx, _ := ctx.Value(inMemoryTransactionKey{}).(*InMemoryStore)
+
+	return tx == s
+}
+
+// lock locks the store for writing unless the context belongs to a transaction of the store.
+func (s *InMemoryStore) lock(ctx context.Context) {
+	if !s.inTransaction(ctx) {
+		s.mu.Lock()
+	}
+}
+
+func (s *InMemoryStore) unlock(ctx context.Context) {
+	if !s.inTransaction(ctx) {
+		s.mu.Unlock()
+	}
+}
+
+// rlock locks the store for reading unless the context belongs to a transaction of the store.
+func (s *InMemoryStore) rlock(ctx context.Context) {
+	if !s.inTransaction(ctx) {
+		s.mu.RLock()
+	}
+}
+
+func (s *InMemoryStore) runlock(ctx context.Context) {
+	if !s.inTransaction(ctx) {
+		s.mu.RUnlock()
+	}
+}
+
+// inMemorySnapshot is a copy of the contents of an InMemoryStore.
+type inMemorySnapshot struct {
+	items         map[string]Item
+	trash         map[string]Item
+	lists         map[string]List
+	tags          map[string]Tag
+	itemTags      map[string]map[string]bool
+	notifications map[string]map[DueNotification]bool
+	history       map[string][]HistoryEntry
+	operations    []Operation
+	comments      map[string]Comment
+	attachments   map[string]Attachment
+	shareTokens   map[string]ShareToken
+	collaborators map[string]Collaborator
+	shareAccess   []ShareAccessEntry
+	timeEntries   map[string]TimeEntry
+	templates     map[string]Template
+	rules         map[string]Rule
+	executions    []RuleExecution
+}
+
+// snapshot copies the contents of the store.
+// The caller must hold the lock.
+func (s *InMemoryStore) snapshot() inMemorySnapshot {
+	snapshot := inMemorySnapshot{
+		items:         make(map[string]Item, len(s.items)),
+		trash:         make(map[string]Item, len(s.trash)),
+		lists:         make(map[string]List, len(s.lists)),
+		tags:          make(map[string]Tag, len(s.tags)),
+		itemTags:      make(map[string]map[string]bool, len(s.itemTags)),
+		notifications: make(map[string]map[DueNotification]bool, len(s.notifications)),
+		history:       make(map[string][]HistoryEntry, len(s.history)),
+		operations:    append([]Operation(nil), s.operations...),
+		comments:      make(map[string]Comment, len(s.comments)),
+		attachments:   make(map[string]Attachment, len(s.attachments)),
+		shareTokens:   make(map[string]ShareToken, len(s.shareTokens)),
+		collaborators: make(map[string]Collaborator, len(s.collaborators)),
+		shareAccess:   append([]ShareAccessEntry(nil), s.shareAccess...),
+		timeEntries:   make(map[string]TimeEntry, len(s.timeEntries)),
+		templates:     make(map[string]Template, len(s.templates)),
+		rules:         make(map[string]Rule, len(s.rules)),
+		executions:    append([]RuleExecution(nil), s.executions...),
+	}
+
+	for id, item := range s.items {
+		snapshot.items[id] = item
+	}
+
+	for id, item := range s.trash {
+		snapshot.trash[id] = item
+	}
+
+	for id, list := range s.lists {
+		snapshot.lists[id] = list
+	}
+
+	for id, tag := range s.tags {
+		snapshot.tags[id] = tag
+	}
+
+	for id, tags := range s.itemTags {
+		snapshot.itemTags[id] = make(map[string]bool, len(tags))
+
+		for tagID, v := range tags {
+			snapshot.itemTags[id][tagID] = v
+		}
+	}
+
+	for id, notifications := range s.notifications {
+		snapshot.notifications[id] = make(map[DueNotification]bool, len(notifications))
+
+		for notification, v := range notifications {
+			snapshot.notifications[id][notification] = v
+		}
+	}
+
+	for id, entries := range s.history {
+		snapshot.history[id] = append([]HistoryEntry(nil), entries...)
+	}
+
+	for id, comment := range s.comments {
+		snapshot.comments[id] = comment
+	}
+
+	for id, attachment := range s.attachments {
+		snapshot.attachments[id] = attachment
+	}
+
+	for id, token := range s.shareTokens {
+		snapshot.shareTokens[id] = token
+	}
+
+	for id, collaborator := range s.collaborators {
+		snapshot.collaborators[id] = collaborator
+	}
+
+	for id, entry := range s.timeEntries {
+		snapshot.timeEntries[id] = entry
+	}
+
+	for id, template := range s.templates {
+		snapshot.templates[id] = template
+	}
+
+	for id, rule := range s.rules {
+		snapshot.rules[id] = rule
+	}
+
+	return snapshot
+}
+
+// restore replaces the contents of the store with a snapshot.
+// The caller must hold the lock.
+func (s *InMemoryStore) restore(snapshot inMemorySnapshot) {
+	s.items = snapshot.items
+	s.trash = snapshot.trash
+	s.lists = snapshot.lists
+	s.tags = snapshot.tags
+	s.itemTags = snapshot.itemTags
+	s.notifications = snapshot.notifications
+	s.history = snapshot.history
+	s.operations = snapshot.operations
+	s.comments = snapshot.comments
+	s.attachments = snapshot.attachments
+	s.shareTokens = snapshot.shareTokens
+	s.collaborators = snapshot.collaborators
+	s.shareAccess = snapshot.shareAccess
+	s.timeEntries = snapshot.timeEntries
+	s.templates = snapshot.templates
+	s.rules = snapshot.rules
+	s.executions = snapshot.executions
+
+	// The search index is rebuilt instead of being copied by every transaction
+	s.searchIndex = newSearchIndex()
+
+	for id, item := range s.items {
+		s.searchIndex.add(id, searchText(item))
+	}
+}
+
+// Store stores an item.
+func (s *InMemoryStore) Store(ctx context.Context, item Item) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	// Notifications are sent again when the due date changes
+	if existing, ok := s.items[item.ID]; ok && !sameTime(existing.DueAt, item.DueAt) {
+		delete(s.notifications, item.ID)
+	}
+
+	// Tags and deletion are managed separately
+	item.Tags = nil
+	item.DeletedAt = nil
+
+	if deleted, ok := s.trash[item.ID]; ok {
+		item.DeletedAt = deleted.DeletedAt
+		s.trash[item.ID] = item
+
+		return nil
+	}
+
+	s.items[item.ID] = item
+	s.searchIndex.add(item.ID, searchText(item))
+
+	return nil
+}
+
+// GetAll returns all items matching a filter.
+func (s *InMemoryStore) GetAll(ctx context.Context, filter ItemFilter) ([]Item, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	return s.getAll(func(item Item) bool { return filter.match(item, time.Now()) }), nil
+}
+
+// getAll returns the items matching a predicate.
+// The caller must hold the lock.
+func (s *InMemoryStore) getAll(match func(item Item) bool) []Item {
+	items := make([]Item, 0, len(s.items))
+
+	// This makes sure items are always returned in the same, sorted order
+	keys := make([]string, 0, len(s.items))
+	for k := range s.items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if item := s.withTags(s.items[key]); match(item) {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// DeleteAll moves all items of a list to the trash.
+func (s *InMemoryStore) DeleteAll(ctx context.Context, listID string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	now := time.Now()
+
+	for id, item := range s.items {
+		if item.ListID == listID {
+			s.moveToTrash(id, now)
+		}
+	}
+
+	return nil
+}
+
+// GetOne returns a single item by its ID.
+func (s *InMemoryStore) GetOne(ctx context.Context, id string) (Item, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	item, ok := s.items[id]
+	if !ok {
+		return item, NotFoundError{ID: id}
+	}
+
+	return s.withTags(item), nil
+}
+
+// DeleteOne moves a single item to the trash by its ID.
+func (s *InMemoryStore) DeleteOne(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	_, ok := s.items[id]
+	if !ok {
+		return nil
+	}
+
+	s.moveToTrash(id, time.Now())
+
+	return nil
+}
+
+// moveToTrash moves an item to the trash.
+// The caller must hold the lock.
+func (s *InMemoryStore) moveToTrash(id string, deletedAt time.Time) {
+	item := s.items[id]
+	item.DeletedAt = &deletedAt
+
+	s.trash[id] = item
+	delete(s.items, id)
+	s.searchIndex.remove(id)
+}
+
+// GetMaxRank returns the highest rank in a list.
+func (s *InMemoryStore) GetMaxRank(ctx context.Context, listID string) (string, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	var rank string
+
+	for _, item := range s.items {
+		if item.ListID == listID && item.Rank > rank {
+			rank = item.Rank
+		}
+	}
+
+	return rank, nil
+}
+
+// GetTrash returns the deleted items of a list.
+func (s *InMemoryStore) GetTrash(ctx context.Context, listID string) ([]Item, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	items := make([]Item, 0)
+
+	for _, item := range s.trash {
+		if item.ListID == listID {
+			items = append(items, s.withTags(item))
+		}
+	}
+
+	// This makes sure items are always returned in the same order (most recently deleted first)
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].DeletedAt.Equal(*items[j].DeletedAt) {
+			return items[i].DeletedAt.After(*items[j].DeletedAt)
+		}
+
+		return items[i].ID < items[j].ID
+	})
+
+	return items, nil
+}
+
+// GetOneDeleted returns a single deleted item by its ID.
+func (s *InMemoryStore) GetOneDeleted(ctx context.Context, id string) (Item, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	item, ok := s.trash[id]
+	if !ok {
+		return item, NotFoundError{ID: id}
+	}
+
+	return s.withTags(item), nil
+}
+
+// GetDeletedSubtasks returns the deleted subtasks of an item (one level deep).
+func (s *InMemoryStore) GetDeletedSubtasks(ctx context.Context, parentID string) ([]Item, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	items := make([]Item, 0)
+
+	for _, item := range s.trash {
+		if item.ParentID == parentID {
+			items = append(items, s.withTags(item))
+		}
+	}
+
+	// This makes sure items are always returned in the same order
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+
+	return items, nil
+}
+
+// RestoreOne restores a deleted item.
+func (s *InMemoryStore) RestoreOne(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	item, ok := s.trash[id]
+	if !ok {
+		return nil
+	}
+
+	item.DeletedAt = nil
+
+	s.items[id] = item
+	delete(s.trash, id)
+	s.searchIndex.add(id, searchText(item))
+
+	return nil
+}
+
+// PurgeOne permanently deletes an item.
+func (s *InMemoryStore) PurgeOne(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.purge(id)
+
+	return nil
+}
+
+// PurgeAll permanently deletes all items of a list, including deleted ones.
+func (s *InMemoryStore) PurgeAll(ctx context.Context, listID string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	for _, items := range []map[string]Item{s.items, s.trash} {
+		for id, item := range items {
+			if item.ListID == listID {
+				s.purge(id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// PurgeDeletedBefore permanently deletes items deleted before a point in time.
+func (s *InMemoryStore) PurgeDeletedBefore(ctx context.Context, deletedBefore time.Time) (int, error) {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	var count int
+
+	for id, item := range s.trash {
+		if item.DeletedAt.Before(deletedBefore) {
+			s.purge(id)
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// purge permanently deletes an item with its comments and time entries.
+// Attachments are left behind as orphans: their content is deleted separately.
+// The caller must hold the lock.
+func (s *InMemoryStore) purge(id string) {
+	delete(s.items, id)
+	delete(s.trash, id)
+	delete(s.itemTags, id)
+	delete(s.notifications, id)
+	s.searchIndex.remove(id)
+
+	for commentID, comment := range s.comments {
+		if comment.ItemID == id {
+			delete(s.comments, commentID)
+		}
+	}
+
+	for entryID, entry := range s.timeEntries {
+		if entry.ItemID == id {
+			delete(s.timeEntries, entryID)
+		}
+	}
+}
+
+// GetItemsToNotify returns incomplete items due before a point in time
+// that have not been notified about yet.
+func (s *InMemoryStore) GetItemsToNotify(
+	ctx context.Context,
+	notification DueNotification,
+	dueBefore time.Time,
+) ([]Item, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	return s.getAll(func(item Item) bool {
+		return !item.Completed &&
+			item.DueAt != nil &&
+			item.DueAt.Before(dueBefore) &&
+			!s.notifications[item.ID][notification]
+	}), nil
+}
+
+// MarkNotified records that a notification has been sent about an item.
+func (s *InMemoryStore) MarkNotified(ctx context.Context, id string, notification DueNotification) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	if _, ok := s.items[id]; !ok {
+		return NotFoundError{ID: id}
+	}
+
+	if s.notifications[id] == nil {
+		s.notifications[id] = make(map[DueNotification]bool)
+	}
+
+	s.notifications[id][notification] = true
+
+	return nil
+}
+
+// StoreList stores a list.
+func (s *InMemoryStore) StoreList(ctx context.Context, list List) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.lists[list.ID] = list
+
+	return nil
+}
+
+// GetAllLists returns all lists.
+func (s *InMemoryStore) GetAllLists(ctx context.Context) ([]List, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	lists := make([]List, 0, len(s.lists))
+
+	for _, list := range s.lists {
+		lists = append(lists, list)
+	}
+
+	// This makes sure lists are always returned in the same, sorted order
+	sort.Slice(lists, func(i, j int) bool { return lists[i].ID < lists[j].ID })
+
+	return lists, nil
+}
+
+// GetOneList returns a single list by its ID.
+func (s *InMemoryStore) GetOneList(ctx context.Context, id string) (List, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	list, ok := s.lists[id]
+	if !ok {
+		return list, ListNotFoundError{ID: id}
+	}
+
+	return list, nil
+}
+
+// DeleteOneList deletes a single list by its ID together with its share tokens and collaborators.
+func (s *InMemoryStore) DeleteOneList(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	delete(s.lists, id)
+
+	for tokenID, token := range s.shareTokens {
+		if token.ListID == id {
+			delete(s.shareTokens, tokenID)
+		}
+	}
+
+	for collaboratorID, collaborator := range s.collaborators {
+		if collaborator.ListID == id {
+			delete(s.collaborators, collaboratorID)
+		}
+	}
+
+	return nil
+}
+
+// withTags returns an item with its tags.
+// The caller must hold the lock.
+func (s *InMemoryStore) withTags(item Item) Item {
+	item.Tags = nil
+
+	for id := range s.itemTags[item.ID] {
+		tag := s.tags[id]
+		tag.ItemCount = 0
+
+		item.Tags = append(item.Tags, tag)
+	}
+
+	sort.Slice(item.Tags, func(i, j int) bool { return item.Tags[i].Name < item.Tags[j].Name })
+
+	return item
+}
+
+// countItems returns the number of items tagged with a tag.
+// The caller must hold the lock.
+func (s *InMemoryStore) countItems(tag Tag) Tag {
+	tag.ItemCount = 0
+
+	for id, tags := range s.itemTags {
+		// Deleted items are not counted
+		if _, ok := s.items[id]; ok && tags[tag.ID] {
+			tag.ItemCount++
+		}
+	}
+
+	return tag
+}
+
+// StoreTag stores a tag.
+func (s *InMemoryStore) StoreTag(ctx context.Context, tag Tag) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	// Usage counts are calculated
+	tag.ItemCount = 0
+
+	s.tags[tag.ID] = tag
+
+	return nil
+}
+
+// GetAllTags returns the tags of an owner with their usage counts, ordered by name.
+func (s *InMemoryStore) GetAllTags(ctx context.Context, owner string) ([]Tag, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	tags := make([]Tag, 0, len(s.tags))
+
+	for _, tag := range s.tags {
+		if tag.Owner == owner {
+			tags = append(tags, s.countItems(tag))
+		}
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+
+	return tags, nil
+}
+
+// GetOneTag returns a single tag by its ID with its usage count.
+func (s *InMemoryStore) GetOneTag(ctx context.Context, id string) (Tag, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	tag, ok := s.tags[id]
+	if !ok {
+		return tag, TagNotFoundError{ID: id}
+	}
+
+	return s.countItems(tag), nil
+}
+
+// GetTagsByName returns the tags of an owner with the given names.
+func (s *InMemoryStore) GetTagsByName(ctx context.Context, owner string, names []string) ([]Tag, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	var tags []Tag
+
+	for _, tag := range s.tags {
+		if tag.Owner == owner && wanted[tag.Name] {
+			tags = append(tags, tag)
+		}
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+
+	return tags, nil
+}
+
+// DeleteOneTag deletes a single tag by its ID, removing it from every item.
+func (s *InMemoryStore) DeleteOneTag(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	delete(s.tags, id)
+
+	for _, tags := range s.itemTags {
+		delete(tags, id)
+	}
+
+	return nil
+}
+
+// AttachTags attaches tags to an item.
+func (s *InMemoryStore) AttachTags(ctx context.Context, itemID string, tagIDs []string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	if _, ok := s.items[itemID]; !ok {
+		return NotFoundError{ID: itemID}
+	}
+
+	for _, id := range tagIDs {
+		if _, ok := s.tags[id]; !ok {
+			return TagNotFoundError{ID: id}
+		}
+	}
+
+	if s.itemTags[itemID] == nil {
+		s.itemTags[itemID] = make(map[string]bool)
+	}
+
+	for _, id := range tagIDs {
+		s.itemTags[itemID][id] = true
+	}
+
+	return nil
+}
+
+// DetachTags detaches tags from an item.
+func (s *InMemoryStore) DetachTags(ctx context.Context, itemID string, tagIDs []string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	for _, id := range tagIDs {
+		delete(s.itemTags[itemID], id)
+	}
+
+	return nil
+}
+
+// MergeTags moves the items of a tag to another one and deletes the source tag.
+func (s *InMemoryStore) MergeTags(ctx context.Context, sourceID string, targetID string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	if _, ok := s.tags[targetID]; !ok {
+		return TagNotFoundError{ID: targetID}
+	}
+
+	for _, tags := range s.itemTags {
+		if tags[sourceID] {
+			delete(tags, sourceID)
+			tags[targetID] = true
+		}
+	}
+
+	delete(s.tags, sourceID)
+
+	return nil
+}
+
+// AddHistoryEntry stores a history entry.
+func (s *InMemoryStore) AddHistoryEntry(ctx context.Context, entry HistoryEntry) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.history[entry.ItemID] = append(s.history[entry.ItemID], entry)
+
+	return nil
+}
+
+// GetHistory returns the history entries of an item, oldest first.
+func (s *InMemoryStore) GetHistory(ctx context.Context, itemID string) ([]HistoryEntry, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	return append([]HistoryEntry(nil), s.history[itemID]...), nil
+}
+
+// AddOperation stores an operation.
+func (s *InMemoryStore) AddOperation(ctx context.Context, operation Operation) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.operations = append(s.operations, operation)
+
+	return nil
+}
+
+// GetUndoableOperations returns the last operations of an actor that have not been undone, newest first.
+func (s *InMemoryStore) GetUndoableOperations(ctx context.Context, actor string, limit int) ([]Operation, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	var operations []Operation
+
+	for i := len(s.operations) - 1; i >= 0 && len(operations) < limit; i-- {
+		if s.operations[i].Actor == actor && !s.operations[i].Undone {
+			operations = append(operations, s.operations[i])
+		}
+	}
+
+	return operations, nil
+}
+
+// GetRedoableOperations returns the first undone operations of an actor, oldest first.
+func (s *InMemoryStore) GetRedoableOperations(ctx context.Context, actor string, limit int) ([]Operation, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	var operations []Operation
+
+	for i := 0; i < len(s.operations) && len(operations) < limit; i++ {
+		if s.operations[i].Actor == actor && s.operations[i].Undone {
+			operations = append(operations, s.operations[i])
+		}
+	}
+
+	return operations, nil
+}
+
+// MarkUndone records whether an operation has been undone.
+func (s *InMemoryStore) MarkUndone(ctx context.Context, id string, undone bool) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	for i := range s.operations {
+		if s.operations[i].ID == id {
+			s.operations[i].Undone = undone
+		}
+	}
+
+	return nil
+}
+
+// DiscardRedoableOperations deletes the undone operations of an actor.
+func (s *InMemoryStore) DiscardRedoableOperations(ctx context.Context, actor string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	operations := s.operations[:0]
+
+	for _, operation := range s.operations {
+		if operation.Actor != actor || !operation.Undone {
+			operations = append(operations, operation)
+		}
+	}
+
+	s.operations = operations
+
+	return nil
+}
+
+// Search returns the items containing every term (as the prefix of a word), the most relevant first.
+// Items of every list are searched when the list ID is empty.
+func (s *InMemoryStore) Search(ctx context.Context, terms []string, listID string, limit int) ([]Item, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	scores := s.searchIndex.search(terms)
+
+	items := make([]Item, 0, len(scores))
+
+	for id := range scores {
+		if item := s.items[id]; listID == "" || item.ListID == listID {
+			items = append(items, s.withTags(item))
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if scores[items[i].ID] != scores[items[j].ID] {
+			return scores[items[i].ID] > scores[items[j].ID]
+		}
+
+		return items[i].ID < items[j].ID
+	})
+
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return items, nil
+}
+
+// StoreComment stores a comment.
+func (s *InMemoryStore) StoreComment(ctx context.Context, comment Comment) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.comments[comment.ID] = comment
+
+	return nil
+}
+
+// GetComments returns the comments of an item, oldest first.
+func (s *InMemoryStore) GetComments(ctx context.Context, itemID string) ([]Comment, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	comments := make([]Comment, 0)
+
+	for _, comment := range s.comments {
+		if comment.ItemID == itemID {
+			comments = append(comments, comment)
+		}
+	}
+
+	sort.Slice(comments, func(i, j int) bool {
+		if !comments[i].CreatedAt.Equal(comments[j].CreatedAt) {
+			return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+		}
+
+		return comments[i].ID < comments[j].ID
+	})
+
+	return comments, nil
+}
+
+// GetOneComment returns a single comment by its ID.
+func (s *InMemoryStore) GetOneComment(ctx context.Context, id string) (Comment, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	comment, ok := s.comments[id]
+	if !ok {
+		return Comment{}, CommentNotFoundError{ID: id}
+	}
+
+	return comment, nil
+}
+
+// DeleteOneComment deletes a single comment by its ID.
+func (s *InMemoryStore) DeleteOneComment(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	delete(s.comments, id)
+
+	return nil
+}
+
+// StoreAttachment stores the details of an attachment.
+func (s *InMemoryStore) StoreAttachment(ctx context.Context, attachment Attachment) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	attachment.Link = AttachmentLink{}
+
+	s.attachments[attachment.ID] = attachment
+
+	return nil
+}
+
+// GetAttachments returns the attachments of an item, oldest first.
+func (s *InMemoryStore) GetAttachments(ctx context.Context, itemID string) ([]Attachment, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	attachments := make([]Attachment, 0)
+
+	for _, attachment := range s.attachments {
+		if attachment.ItemID == itemID {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	sortAttachments(attachments)
+
+	return attachments, nil
+}
+
+// GetOneAttachment returns a single attachment by its ID.
+func (s *InMemoryStore) GetOneAttachment(ctx context.Context, id string) (Attachment, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	attachment, ok := s.attachments[id]
+	if !ok {
+		return Attachment{}, AttachmentNotFoundError{ID: id}
+	}
+
+	return attachment, nil
+}
+
+// DeleteOneAttachment deletes a single attachment by its ID.
+func (s *InMemoryStore) DeleteOneAttachment(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	delete(s.attachments, id)
+
+	return nil
+}
+
+// GetOrphanAttachments returns the attachments of permanently deleted items.
+func (s *InMemoryStore) GetOrphanAttachments(ctx context.Context) ([]Attachment, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	attachments := make([]Attachment, 0)
+
+	for _, attachment := range s.attachments {
+		_, ok := s.items[attachment.ItemID]
+		_, deleted := s.trash[attachment.ItemID]
+
+		if !ok && !deleted {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	sortAttachments(attachments)
+
+	return attachments, nil
+}
+
+func sortAttachments(attachments []Attachment) {
+	sort.Slice(attachments, func(i, j int) bool {
+		if !attachments[i].CreatedAt.Equal(attachments[j].CreatedAt) {
+			return attachments[i].CreatedAt.Before(attachments[j].CreatedAt)
+		}
+
+		return attachments[i].ID < attachments[j].ID
+	})
+}
+
+// StoreShareToken stores a share token (without the secret token).
+func (s *InMemoryStore) StoreShareToken(ctx context.Context, token ShareToken) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	token.Token = ""
+
+	s.shareTokens[token.ID] = token
+
+	return nil
+}
+
+// GetShareTokens returns the share tokens of a list, oldest first.
+func (s *InMemoryStore) GetShareTokens(ctx context.Context, listID string) ([]ShareToken, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	tokens := make([]ShareToken, 0)
+
+	for _, token := range s.shareTokens {
+		if token.ListID == listID {
+			tokens = append(tokens, token)
+		}
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		if !tokens[i].CreatedAt.Equal(tokens[j].CreatedAt) {
+			return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+		}
+
+		return tokens[i].ID < tokens[j].ID
+	})
+
+	return tokens, nil
+}
+
+// GetOneShareToken returns a single share token by its ID.
+func (s *InMemoryStore) GetOneShareToken(ctx context.Context, id string) (ShareToken, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	token, ok := s.shareTokens[id]
+	if !ok {
+		return ShareToken{}, ShareTokenNotFoundError{ID: id}
+	}
+
+	return token, nil
+}
+
+// GetShareTokenByHash returns a single share token by the hash of the secret token.
+func (s *InMemoryStore) GetShareTokenByHash(ctx context.Context, hash string) (ShareToken, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	for _, token := range s.shareTokens {
+		if token.TokenHash == hash {
+			return token, nil
+		}
+	}
+
+	return ShareToken{}, ShareTokenNotFoundError{}
+}
+
+// StoreCollaborator stores a collaborator.
+func (s *InMemoryStore) StoreCollaborator(ctx context.Context, collaborator Collaborator) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.collaborators[collaborator.ID] = collaborator
+
+	return nil
+}
+
+// GetCollaborators returns the collaborators of a list, oldest first.
+func (s *InMemoryStore) GetCollaborators(ctx context.Context, listID string) ([]Collaborator, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	collaborators := make([]Collaborator, 0)
+
+	for _, collaborator := range s.collaborators {
+		if collaborator.ListID == listID {
+			collaborators = append(collaborators, collaborator)
+		}
+	}
+
+	sort.Slice(collaborators, func(i, j int) bool {
+		if !collaborators[i].CreatedAt.Equal(collaborators[j].CreatedAt) {
+			return collaborators[i].CreatedAt.Before(collaborators[j].CreatedAt)
+		}
+
+		return collaborators[i].ID < collaborators[j].ID
+	})
+
+	return collaborators, nil
+}
+
+// GetCollaboratorByEmail returns a single collaborator of a list by their email address.
+func (s *InMemoryStore) GetCollaboratorByEmail(ctx context.Context, listID string, email string) (Collaborator, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	for _, collaborator := range s.collaborators {
+		if collaborator.ListID == listID && collaborator.Email == email {
+			return collaborator, nil
+		}
+	}
+
+	return Collaborator{}, CollaboratorNotFoundError{ListID: listID, Email: email}
+}
+
+// DeleteOneCollaborator deletes a single collaborator by their ID.
+func (s *InMemoryStore) DeleteOneCollaborator(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	delete(s.collaborators, id)
+
+	return nil
+}
+
+// AddShareAccess stores an access log entry.
+func (s *InMemoryStore) AddShareAccess(ctx context.Context, entry ShareAccessEntry) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.shareAccess = append(s.shareAccess, entry)
+
+	return nil
+}
+
+// GetShareAccess returns the access log of a list, oldest first.
+func (s *InMemoryStore) GetShareAccess(ctx context.Context, listID string) ([]ShareAccessEntry, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	entries := make([]ShareAccessEntry, 0)
+
+	for _, entry := range s.shareAccess {
+		if entry.ListID == listID {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// StoreTimeEntry stores a time entry.
+func (s *InMemoryStore) StoreTimeEntry(ctx context.Context, entry TimeEntry) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	entry.Duration = 0
+
+	s.timeEntries[entry.ID] = entry
+
+	return nil
+}
+
+// GetTimeEntries returns the time entries matching a filter, oldest first.
+func (s *InMemoryStore) GetTimeEntries(ctx context.Context, filter TimeEntryFilter) ([]TimeEntry, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	entries := make([]TimeEntry, 0)
+
+	for _, entry := range s.timeEntries {
+		switch {
+		case filter.ItemID != "" && entry.ItemID != filter.ItemID,
+			filter.User != "" && entry.User != filter.User,
+			filter.Running && !entry.Running(),
+			filter.From != nil && entry.EndedAt != nil && !entry.EndedAt.After(*filter.From),
+			filter.To != nil && !entry.StartedAt.Before(*filter.To):
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].StartedAt.Equal(entries[j].StartedAt) {
+			return entries[i].StartedAt.Before(entries[j].StartedAt)
+		}
+
+		return entries[i].ID < entries[j].ID
+	})
+
+	return entries, nil
+}
+
+func sameTime(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
+// StoreTemplate stores a template.
+func (s *InMemoryStore) StoreTemplate(ctx context.Context, template Template) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.templates[template.ID] = template
+
+	return nil
+}
+
+// GetAllTemplates returns all templates.
+func (s *InMemoryStore) GetAllTemplates(ctx context.Context) ([]Template, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	templates := make([]Template, 0, len(s.templates))
+
+	for _, template := range s.templates {
+		templates = append(templates, template)
+	}
+
+	// This makes sure templates are always returned in the same, sorted order
+	sort.Slice(templates, func(i, j int) bool { return templates[i].ID < templates[j].ID })
+
+	return templates, nil
+}
+
+// GetOneTemplate returns a single template by its ID.
+func (s *InMemoryStore) GetOneTemplate(ctx context.Context, id string) (Template, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	template, ok := s.templates[id]
+	if !ok {
+		return template, TemplateNotFoundError{ID: id}
+	}
+
+	return template, nil
+}
+
+// DeleteOneTemplate deletes a single template by its ID.
+func (s *InMemoryStore) DeleteOneTemplate(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	delete(s.templates, id)
+
+	return nil
+}
+
+// StoreRule stores a rule.
+func (s *InMemoryStore) StoreRule(ctx context.Context, rule Rule) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.rules[rule.ID] = rule
+
+	return nil
+}
+
+// GetAllRules returns all rules.
+func (s *InMemoryStore) GetAllRules(ctx context.Context) ([]Rule, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	rules := make([]Rule, 0, len(s.rules))
+
+	for _, rule := range s.rules {
+		rules = append(rules, rule)
+	}
+
+	// This makes sure rules are always returned in the same, sorted order
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+
+	return rules, nil
+}
+
+// GetOneRule returns a single rule by its ID.
+func (s *InMemoryStore) GetOneRule(ctx context.Context, id string) (Rule, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	rule, ok := s.rules[id]
+	if !ok {
+		return rule, RuleNotFoundError{ID: id}
+	}
+
+	return rule, nil
+}
+
+// DeleteOneRule deletes a single rule by its ID with its executions.
+func (s *InMemoryStore) DeleteOneRule(ctx context.Context, id string) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	delete(s.rules, id)
+
+	executions := make([]RuleExecution, 0, len(s.executions))
+
+	for _, execution := range s.executions {
+		if execution.RuleID != id {
+			executions = append(executions, execution)
+		}
+	}
+
+	s.executions = executions
+
+	return nil
+}
+
+// StoreRuleExecution stores a rule execution.
+func (s *InMemoryStore) StoreRuleExecution(ctx context.Context, execution RuleExecution) error {
+	s.init()
+
+	s.lock(ctx)
+	defer s.unlock(ctx)
+
+	s.executions = append(s.executions, execution)
+
+	return nil
+}
+
+// GetRuleExecutions returns the rule executions matching a filter in the order they were stored.
+func (s *InMemoryStore) GetRuleExecutions(ctx context.Context, filter RuleExecutionFilter) ([]RuleExecution, error) {
+	s.init()
+
+	s.rlock(ctx)
+	defer s.runlock(ctx)
+
+	executions := make([]RuleExecution, 0)
+
+	for _, execution := range s.executions {
+		if filter.match(execution) {
+			executions = append(executions, execution)
+		}
+	}
+
+	return executions, nil
+}
@@ -0,0 +1,292 @@
+package todo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryStore_StoreAnItem(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewInMemoryStore()
+
+		item := Item{
+			ID:    "id",
+			Title: "Store me!",
+		}
+
+		err := store.Store(context.Background(), item)
+		require.NoError(t, err)
+
+		assert.Equal(t, item, store.items[item.ID])
+	})
+
+	t.Run("OverwriteAnExistingItem", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewInMemoryStore()
+
+		store.items["id"] = Item{
+			ID:        "id",
+			Title:     "Store me first!",
+			Completed: true,
+		}
+
+		item := Item{
+			ID:    "id",
+			Title: "Store me!",
+		}
+
+		err := store.Store(context.Background(), item)
+		require.NoError(t, err)
+
+		assert.Equal(t, item, store.items[item.ID])
+	})
+}
+
+func TestInMemoryStore_ListAllItems(t *testing.T) {
+	store := NewInMemoryStore()
+
+	store.items["id"] = Item{
+		ID:        "id",
+		Title:     "Store me first!",
+		Completed: true,
+	}
+
+	store.items["id2"] = Item{
+		ID:        "id2",
+		Title:     "Store me second!",
+		Completed: true,
+	}
+
+	items, err := store.GetAll(context.Background(), ItemFilter{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Item{store.items["id"], store.items["id2"]}, items)
+}
+
+func TestInMemoryStore_FilterItems(t *testing.T) {
+	store := NewInMemoryStore()
+
+	now := time.Now()
+	yesterday := now.AddDate(0, 0, -1)
+	tomorrow := now.AddDate(0, 0, 1)
+
+	store.items["id"] = Item{ID: "id", Title: "No due date"}
+	store.items["id2"] = Item{ID: "id2", Title: "Overdue", DueAt: &yesterday}
+	store.items["id3"] = Item{ID: "id3", Title: "Done", Completed: true, DueAt: &yesterday}
+	store.items["id4"] = Item{ID: "id4", ParentID: "id", Title: "Due tomorrow", DueAt: &tomorrow}
+
+	tests := map[string]struct {
+		filter   ItemFilter
+		expected []string
+	}{
+		"All": {
+			filter:   ItemFilter{},
+			expected: []string{"id", "id2", "id3", "id4"},
+		},
+		"DueAfter": {
+			filter:   ItemFilter{DueAfter: &now},
+			expected: []string{"id4"},
+		},
+		"DueBefore": {
+			filter:   ItemFilter{DueBefore: &now},
+			expected: []string{"id2", "id3"},
+		},
+		"Overdue": {
+			filter:   ItemFilter{Overdue: true},
+			expected: []string{"id2"},
+		},
+		"ParentID": {
+			filter:   ItemFilter{ParentID: "id"},
+			expected: []string{"id4"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			items, err := store.GetAll(context.Background(), test.filter)
+			require.NoError(t, err)
+
+			ids := make([]string, 0, len(items))
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func TestInMemoryStore_DeleteAllItems(t *testing.T) {
+	store := NewInMemoryStore()
+
+	store.items["id"] = Item{
+		ID:        "id",
+		ListID:    DefaultListID,
+		Title:     "Store me first!",
+		Completed: true,
+	}
+
+	store.items["id2"] = Item{
+		ID:        "id2",
+		ListID:    DefaultListID,
+		Title:     "Store me second!",
+		Completed: true,
+	}
+
+	store.items["id3"] = Item{
+		ID:     "id3",
+		ListID: "other",
+		Title:  "Keep me!",
+	}
+
+	err := store.DeleteAll(context.Background(), DefaultListID)
+	require.NoError(t, err)
+
+	assert.Len(t, store.items, 1)
+	assert.Contains(t, store.items, "id3")
+}
+
+func TestInMemoryStore_GetAnItem(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewInMemoryStore()
+
+		const id = "id"
+
+		store.items[id] = Item{
+			ID:    id,
+			Title: "Store me!",
+		}
+
+		item, err := store.GetOne(context.Background(), id)
+		require.NoError(t, err)
+
+		assert.Equal(t, store.items[id], item)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewInMemoryStore()
+
+		_, err := store.GetOne(context.Background(), "id")
+		require.Error(t, err)
+
+		require.IsType(t, NotFoundError{}, err)
+
+		e := err.(NotFoundError) // nolint:errorlint
+		assert.Equal(t, "id", e.ID)
+	})
+}
+
+func TestInMemoryStore_DeleteAnItem(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewInMemoryStore()
+
+		store.items["id"] = Item{
+			ID:        "id",
+			Title:     "Store me first!",
+			Completed: true,
+		}
+
+		store.items["id2"] = Item{
+			ID:        "id2",
+			Title:     "Store me second!",
+			Completed: true,
+		}
+
+		err := store.DeleteOne(context.Background(), "id2")
+		require.NoError(t, err)
+
+		assert.Len(t, store.items, 1)
+		assert.Contains(t, store.items, "id")
+	})
+
+	t.Run("Idempotent", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewInMemoryStore()
+
+		store.items["id"] = Item{
+			ID:        "id",
+			Title:     "Store me first!",
+			Completed: true,
+		}
+
+		err := store.DeleteOne(context.Background(), "id2")
+		require.NoError(t, err)
+
+		assert.Len(t, store.items, 1)
+		assert.Contains(t, store.items, "id")
+	})
+}
+
+func TestInMemoryStore_Transaction(t *testing.T) {
+	t.Parallel()
+
+	store := NewInMemoryStore()
+	ctx := context.Background()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	committed := make(chan error)
+
+	go func() {
+		committed <- store.Transaction(ctx, func(ctx context.Context) error {
+			err := store.Store(ctx, Item{ID: "id", ListID: DefaultListID, Title: "Buy milk"})
+			if err != nil {
+				return err
+			}
+
+			close(started)
+			<-release
+
+			return errors.New("failed")
+		})
+	}()
+
+	<-started
+
+	stored := make(chan error)
+
+	go func() {
+		stored <- store.Store(ctx, Item{ID: "other", ListID: DefaultListID, Title: "Buy bread"})
+	}()
+
+	select {
+	case <-stored:
+		t.Fatal("item was stored during a transaction")
+
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+
+	require.EqualError(t, <-committed, "failed")
+	require.NoError(t, <-stored)
+
+	_, err := store.GetOne(ctx, "id")
+	assert.True(t, errors.As(err, &NotFoundError{}), "changes of a failed transaction are rolled back")
+
+	_, err = store.GetOne(ctx, "other")
+	assert.NoError(t, err, "concurrent changes are kept")
+}
@@ -0,0 +1,223 @@
+package todo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+)
+
+// ItemTree is an item with its subtasks.
+type ItemTree struct {
+	Item
+
+	Children []ItemTree
+}
+
+func (s service) ListItemTree(ctx context.Context, filter ItemFilter, depth int) ([]ItemTree, error) {
+	roots, err := s.ListItems(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.ListID == "" {
+		filter.ListID = DefaultListID
+	}
+
+	// Subtasks are not filtered: they are part of their parent
+	items, err := s.store.GetAll(ctx, ItemFilter{ListID: filter.ListID})
+	if err != nil {
+		return nil, err
+	}
+
+	sortItems(items, filter.Sort)
+
+	children := make(map[string][]Item)
+
+	for _, item := range items {
+		if item.ParentID != "" {
+			children[item.ParentID] = append(children[item.ParentID], item)
+		}
+	}
+
+	trees := make([]ItemTree, 0, len(roots))
+
+	for _, root := range roots {
+		// Subtasks matching the filter are listed under their parent
+		if root.ParentID != filter.ParentID {
+			continue
+		}
+
+		trees = append(trees, newItemTree(root, children, depth))
+	}
+
+	return trees, nil
+}
+
+func newItemTree(item Item, children map[string][]Item, depth int) ItemTree {
+	tree := ItemTree{
+		Item:     item,
+		Children: []ItemTree{},
+	}
+
+	if depth == 1 {
+		return tree
+	}
+
+	for _, child := range children[item.ID] {
+		tree.Children = append(tree.Children, newItemTree(child, children, depth-1))
+	}
+
+	return tree
+}
+
+// getParent returns an item subtasks can be added to.
+func (s service) getParent(ctx context.Context, id string) (Item, error) {
+	parent, err := s.store.GetOne(ctx, id)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "get parent")
+	}
+
+	return parent, nil
+}
+
+// checkParent makes sure an item is not moved under itself or one of its subtasks.
+func (s service) checkParent(ctx context.Context, item Item) error {
+	for id := item.ParentID; id != ""; {
+		if id == item.ID {
+			return errors.WithStack(validationError{violations: map[string][]string{
+				"parentId": {
+					"an item cannot be moved under itself or its subtasks",
+				},
+			}})
+		}
+
+		parent, err := s.store.GetOne(ctx, id)
+		if err != nil {
+			return errors.WithMessage(err, "get parent")
+		}
+
+		id = parent.ParentID
+	}
+
+	return nil
+}
+
+// getSubtasks returns the subtasks of an item on every level, parents first.
+func (s service) getSubtasks(ctx context.Context, id string) ([]Item, error) {
+	var subtasks []Item
+
+	for parents := []string{id}; len(parents) > 0; {
+		items, err := s.store.GetAll(ctx, ItemFilter{ParentID: parents[0]})
+		if err != nil {
+			return nil, err
+		}
+
+		parents = parents[1:]
+
+		for _, item := range items {
+			subtasks = append(subtasks, item)
+			parents = append(parents, item.ID)
+		}
+	}
+
+	return subtasks, nil
+}
+
+// moveSubtasks moves the subtasks of an item to the list of the item.
+// Subtasks are added to the end of the list after the item.
+func (s service) moveSubtasks(ctx context.Context, item Item) error {
+	subtasks, err := s.getSubtasks(ctx, item.ID)
+	if err != nil {
+		return err
+	}
+
+	sortItems(subtasks, SortManual)
+
+	rank := item.Rank
+
+	for _, subtask := range subtasks {
+		rank = rankAfter(rank)
+
+		subtask.ListID = item.ListID
+		subtask.Rank = rank
+
+		err := s.store.Store(ctx, subtask)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parentListError() validationError {
+	return validationError{violations: map[string][]string{
+		"parentId": {
+			"subtasks must be in the same list as their parent",
+		},
+	}}
+}
+
+// SubtaskMiddleware completes items with auto-completion enabled once all of their subtasks are complete.
+//
+// The middleware should wrap the event middleware, so auto-completed items fire events as well.
+func SubtaskMiddleware() Middleware {
+	return func(next Service) Service {
+		return subtaskMiddleware{
+			Service: DefaultMiddleware{Service: next},
+			next:    next,
+		}
+	}
+}
+
+type subtaskMiddleware struct {
+	Service
+	next Service
+}
+
+func (mw subtaskMiddleware) UpdateItem(ctx context.Context, id string, itemUpdate ItemUpdate) (Item, error) {
+	item, err := mw.next.UpdateItem(ctx, id, itemUpdate)
+	if err != nil {
+		return item, err
+	}
+
+	if itemUpdate.Completed == nil || !*itemUpdate.Completed || item.ParentID == "" {
+		return item, nil
+	}
+
+	err = mw.completeParent(ctx, item.ParentID)
+	if err != nil {
+		return item, errors.WithMessage(err, "auto-complete parent")
+	}
+
+	return item, nil
+}
+
+func (mw subtaskMiddleware) completeParent(ctx context.Context, id string) error {
+	parent, err := mw.next.GetItem(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !parent.AutoComplete || parent.Completed {
+		return nil
+	}
+
+	subtasks, err := mw.next.ListItems(ctx, ItemFilter{ListID: parent.ListID, ParentID: parent.ID})
+	if err != nil {
+		return err
+	}
+
+	for _, subtask := range subtasks {
+		if !subtask.Completed {
+			return nil
+		}
+	}
+
+	completed := true
+
+	// Going through the middleware completes the grandparent as well when necessary
+	_, err = mw.UpdateItem(ctx, parent.ID, ItemUpdate{Completed: &completed})
+
+	return err
+}
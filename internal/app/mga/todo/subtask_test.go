@@ -0,0 +1,210 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+func TestService_ListItemTree(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	house, err := service.AddItem(ctx, NewItem{Title: "Clean the house"})
+	require.NoError(t, err)
+
+	kitchen, err := service.AddItem(ctx, NewItem{ParentID: house.ID, Title: "Clean the kitchen"})
+	require.NoError(t, err)
+
+	fridge, err := service.AddItem(ctx, NewItem{ParentID: kitchen.ID, Title: "Clean the fridge"})
+	require.NoError(t, err)
+
+	garden, err := service.AddItem(ctx, NewItem{Title: "Mow the lawn"})
+	require.NoError(t, err)
+
+	trees, err := service.ListItemTree(ctx, ItemFilter{}, 0)
+	require.NoError(t, err)
+
+	require.Len(t, trees, 2)
+	assert.Equal(t, house.ID, trees[0].ID)
+	require.Len(t, trees[0].Children, 1)
+	assert.Equal(t, kitchen.ID, trees[0].Children[0].ID)
+	require.Len(t, trees[0].Children[0].Children, 1)
+	assert.Equal(t, fridge.ID, trees[0].Children[0].Children[0].ID)
+	assert.Equal(t, garden.ID, trees[1].ID)
+	assert.Empty(t, trees[1].Children)
+
+	trees, err = service.ListItemTree(ctx, ItemFilter{}, 1)
+	require.NoError(t, err)
+
+	require.Len(t, trees, 2)
+	assert.Empty(t, trees[0].Children)
+
+	trees, err = service.ListItemTree(ctx, ItemFilter{ParentID: house.ID}, 0)
+	require.NoError(t, err)
+
+	require.Len(t, trees, 1)
+	assert.Equal(t, kitchen.ID, trees[0].ID)
+	require.Len(t, trees[0].Children, 1)
+
+	// The flat list contains every item
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Len(t, items, 4)
+}
+
+func TestService_AddSubtask(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Work"})
+	require.NoError(t, err)
+
+	parent, err := service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Prepare the release"})
+	require.NoError(t, err)
+
+	// Subtasks are added to the list of their parent
+	subtask, err := service.AddItem(ctx, NewItem{ParentID: parent.ID, Title: "Write the changelog"})
+	require.NoError(t, err)
+	assert.Equal(t, list.ID, subtask.ListID)
+
+	_, err = service.AddItem(ctx, NewItem{ListID: DefaultListID, ParentID: parent.ID, Title: "Tag the release"})
+	assert.True(t, isValidationError(err))
+
+	_, err = service.AddItem(ctx, NewItem{ParentID: "missing", Title: "Tag the release"})
+	assert.True(t, errors.As(err, &NotFoundError{}))
+}
+
+func TestService_MoveSubtask(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	parent, err := service.AddItem(ctx, NewItem{Title: "Plan the trip"})
+	require.NoError(t, err)
+
+	subtask, err := service.AddItem(ctx, NewItem{ParentID: parent.ID, Title: "Book the flights"})
+	require.NoError(t, err)
+
+	nested, err := service.AddItem(ctx, NewItem{ParentID: subtask.ID, Title: "Choose seats"})
+	require.NoError(t, err)
+
+	// Cycles are rejected
+	_, err = service.UpdateItem(ctx, parent.ID, ItemUpdate{ParentID: &nested.ID})
+	assert.True(t, isValidationError(err))
+
+	_, err = service.UpdateItem(ctx, parent.ID, ItemUpdate{ParentID: &parent.ID})
+	assert.True(t, isValidationError(err))
+
+	list, err := service.CreateList(ctx, NewList{Name: "Travel"})
+	require.NoError(t, err)
+
+	// Moving an item to another list detaches it from its parent and takes its subtasks along
+	subtask, err = service.UpdateItem(ctx, subtask.ID, ItemUpdate{ListID: &list.ID})
+	require.NoError(t, err)
+	assert.Empty(t, subtask.ParentID)
+
+	nested, err = service.GetItem(ctx, nested.ID)
+	require.NoError(t, err)
+	assert.Equal(t, list.ID, nested.ListID)
+	assert.Equal(t, subtask.ID, nested.ParentID)
+
+	// Moving an item under a parent in another list moves it to that list
+	parent, err = service.UpdateItem(ctx, parent.ID, ItemUpdate{ParentID: &nested.ID})
+	require.NoError(t, err)
+	assert.Equal(t, list.ID, parent.ListID)
+
+	top := ""
+
+	parent, err = service.UpdateItem(ctx, parent.ID, ItemUpdate{ParentID: &top})
+	require.NoError(t, err)
+	assert.Empty(t, parent.ParentID)
+}
+
+func TestService_DeleteItemWithSubtasks(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	parent, err := service.AddItem(ctx, NewItem{Title: "Move out"})
+	require.NoError(t, err)
+
+	subtask, err := service.AddItem(ctx, NewItem{ParentID: parent.ID, Title: "Pack the books"})
+	require.NoError(t, err)
+
+	nested, err := service.AddItem(ctx, NewItem{ParentID: subtask.ID, Title: "Buy boxes"})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, parent.ID, false)
+	assert.True(t, isValidationError(err))
+
+	_, err = service.GetItem(ctx, parent.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, service.DeleteItem(ctx, parent.ID, true))
+
+	for _, id := range []string{parent.ID, subtask.ID, nested.ID} {
+		_, err = service.GetItem(ctx, id)
+		assert.True(t, errors.As(err, &NotFoundError{}), id)
+	}
+}
+
+func TestSubtaskMiddleware_AutoComplete(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	root, err := service.AddItem(ctx, NewItem{Title: "Organize a party", AutoComplete: true})
+	require.NoError(t, err)
+
+	parent, err := service.AddItem(ctx, NewItem{ParentID: root.ID, Title: "Prepare food", AutoComplete: true})
+	require.NoError(t, err)
+
+	first, err := service.AddItem(ctx, NewItem{ParentID: parent.ID, Title: "Bake a cake"})
+	require.NoError(t, err)
+
+	second, err := service.AddItem(ctx, NewItem{ParentID: parent.ID, Title: "Order pizza"})
+	require.NoError(t, err)
+
+	manual, err := service.AddItem(ctx, NewItem{Title: "Clean up"})
+	require.NoError(t, err)
+
+	manualSubtask, err := service.AddItem(ctx, NewItem{ParentID: manual.ID, Title: "Take out the trash"})
+	require.NoError(t, err)
+
+	completed := true
+
+	_, err = service.UpdateItem(ctx, first.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	parent, err = service.GetItem(ctx, parent.ID)
+	require.NoError(t, err)
+	assert.False(t, parent.Completed)
+
+	_, err = service.UpdateItem(ctx, second.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	// Completing the last subtask completes the parents up the tree
+	for _, id := range []string{parent.ID, root.ID} {
+		item, err := service.GetItem(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, item.Completed, id)
+	}
+
+	_, err = service.UpdateItem(ctx, manualSubtask.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	manual, err = service.GetItem(ctx, manual.ID)
+	require.NoError(t, err)
+	assert.False(t, manual.Completed)
+
+	var completedIDs []string
+	for _, event := range events.completed {
+		completedIDs = append(completedIDs, event.ID)
+	}
+
+	assert.Equal(t, []string{first.ID, second.ID, parent.ID, root.ID, manualSubtask.ID}, completedIDs)
+}
@@ -0,0 +1,351 @@
+package todo
+
+import (
+	"context"
+	"regexp"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// Tag labels items across lists.
+// Tag names are unique per owner.
+type Tag struct {
+	ID    string
+	Owner string
+	Name  string
+	Color string
+
+	// ItemCount is the number of items tagged with the tag.
+	// It's only set when tags are queried directly (not on the tags of an item).
+	ItemCount int
+}
+
+// NewTag contains the details of a new Tag.
+type NewTag struct {
+	Name string
+
+	// Color is a hex color code (eg. #ff0000).
+	Color string
+}
+
+// TagUpdate contains updates of an existing tag.
+type TagUpdate struct {
+	Name  *string
+	Color *string
+}
+
+func (u TagUpdate) update(tag Tag) Tag {
+	if u.Name != nil {
+		tag.Name = *u.Name
+	}
+
+	if u.Color != nil {
+		tag.Color = *u.Color
+	}
+
+	return tag
+}
+
+// TagStore persists tags and their relations to items.
+type TagStore interface {
+	// StoreTag stores a tag.
+	StoreTag(ctx context.Context, tag Tag) error
+
+	// GetAllTags returns the tags of an owner with their usage counts, ordered by name.
+	GetAllTags(ctx context.Context, owner string) ([]Tag, error)
+
+	// GetOneTag returns a single tag by its ID with its usage count.
+	GetOneTag(ctx context.Context, id string) (Tag, error)
+
+	// GetTagsByName returns the tags of an owner with the given names.
+	// Names without a tag are skipped.
+	GetTagsByName(ctx context.Context, owner string, names []string) ([]Tag, error)
+
+	// DeleteOneTag deletes a single tag by its ID, removing it from every item.
+	DeleteOneTag(ctx context.Context, id string) error
+
+	// AttachTags attaches tags to an item.
+	AttachTags(ctx context.Context, itemID string, tagIDs []string) error
+
+	// DetachTags detaches tags from an item.
+	DetachTags(ctx context.Context, itemID string, tagIDs []string) error
+
+	// MergeTags moves the items of a tag to another one and deletes the source tag.
+	MergeTags(ctx context.Context, sourceID string, targetID string) error
+}
+
+// TagNotFoundError is returned if a tag cannot be found.
+type TagNotFoundError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (TagNotFoundError) Error() string {
+	return "tag not found"
+}
+
+// Details returns error details.
+func (e TagNotFoundError) Details() []interface{} {
+	return []interface{}{"tag_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (TagNotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (TagNotFoundError) ServiceError() bool {
+	return true
+}
+
+var tagColorRegexp = regexp.MustCompile("^#[0-9a-fA-F]{6}$")
+
+func validateTag(tag Tag) error {
+	violations := make(map[string][]string)
+
+	if tag.Name == "" {
+		violations["name"] = append(violations["name"], "name cannot be empty")
+	}
+
+	if tag.Color != "" && !tagColorRegexp.MatchString(tag.Color) {
+		violations["color"] = append(violations["color"], "color must be a hex color code (eg. #ff0000)")
+	}
+
+	if len(violations) > 0 {
+		return errors.WithStack(validationError{violations: violations})
+	}
+
+	return nil
+}
+
+func tagExistsError() validationError {
+	return validationError{violations: map[string][]string{
+		"name": {
+			"a tag with this name already exists",
+		},
+	}}
+}
+
+// tagOwner returns the owner of the tags managed in a context.
+// Anonymous users share the same set of tags.
+func tagOwner(ctx context.Context) string {
+	owner, _ := principal.FromContext(ctx)
+
+	return owner
+}
+
+func (s service) CreateTag(ctx context.Context, newTag NewTag) (Tag, error) {
+	id, err := s.idgenerator.Generate()
+	if err != nil {
+		return Tag{}, err
+	}
+
+	tag := Tag{
+		ID:    id,
+		Owner: tagOwner(ctx),
+		Name:  newTag.Name,
+		Color: newTag.Color,
+	}
+
+	err = validateTag(tag)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	existing, err := s.tagStore.GetTagsByName(ctx, tag.Owner, []string{tag.Name})
+	if err != nil {
+		return Tag{}, err
+	}
+
+	if len(existing) > 0 {
+		return Tag{}, errors.WithStack(tagExistsError())
+	}
+
+	err = s.tagStore.StoreTag(ctx, tag)
+	if err != nil {
+		return Tag{}, errors.WithMessage(err, "create tag")
+	}
+
+	return tag, nil
+}
+
+func (s service) ListTags(ctx context.Context) ([]Tag, error) {
+	return s.tagStore.GetAllTags(ctx, tagOwner(ctx))
+}
+
+func (s service) GetTag(ctx context.Context, id string) (Tag, error) {
+	tag, err := s.getTag(ctx, id)
+	if err != nil {
+		return Tag{}, errors.WithMessage(err, "get tag")
+	}
+
+	return tag, nil
+}
+
+func (s service) UpdateTag(ctx context.Context, id string, tagUpdate TagUpdate) (Tag, error) {
+	tag, err := s.getTag(ctx, id)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	updatedTag := tagUpdate.update(tag)
+
+	err = validateTag(updatedTag)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	if updatedTag == tag {
+		return tag, nil
+	}
+
+	if updatedTag.Name != tag.Name {
+		existing, err := s.tagStore.GetTagsByName(ctx, tag.Owner, []string{updatedTag.Name})
+		if err != nil {
+			return Tag{}, err
+		}
+
+		// Renaming a tag to an existing name is a merge
+		if len(existing) > 0 {
+			return Tag{}, errors.WithStack(tagExistsError())
+		}
+	}
+
+	err = s.tagStore.StoreTag(ctx, updatedTag)
+	if err != nil {
+		return Tag{}, errors.WithMessage(err, "update tag")
+	}
+
+	return updatedTag, nil
+}
+
+func (s service) MergeTags(ctx context.Context, sourceID string, targetID string) (Tag, error) {
+	if sourceID == targetID {
+		return Tag{}, errors.WithStack(validationError{violations: map[string][]string{
+			"targetId": {
+				"a tag cannot be merged into itself",
+			},
+		}})
+	}
+
+	_, err := s.getTag(ctx, sourceID)
+	if err != nil {
+		return Tag{}, errors.WithMessage(err, "merge tags")
+	}
+
+	_, err = s.getTag(ctx, targetID)
+	if err != nil {
+		return Tag{}, errors.WithMessage(err, "merge tags")
+	}
+
+	err = s.tagStore.MergeTags(ctx, sourceID, targetID)
+	if err != nil {
+		return Tag{}, errors.WithMessage(err, "merge tags")
+	}
+
+	// Return the target with its updated usage count
+	return s.getTag(ctx, targetID)
+}
+
+func (s service) DeleteTag(ctx context.Context, id string) error {
+	_, err := s.getTag(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete tag")
+	}
+
+	err = s.tagStore.DeleteOneTag(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete tag")
+	}
+
+	return nil
+}
+
+func (s service) TagItem(ctx context.Context, id string, tags []string) (Item, error) {
+	_, err := s.store.GetOne(ctx, id)
+	if err != nil {
+		return Item{}, err
+	}
+
+	owner := tagOwner(ctx)
+
+	existing, err := s.tagStore.GetTagsByName(ctx, owner, tags)
+	if err != nil {
+		return Item{}, err
+	}
+
+	tagIDs := make([]string, 0, len(tags))
+	known := make(map[string]bool, len(existing))
+
+	for _, tag := range existing {
+		tagIDs = append(tagIDs, tag.ID)
+		known[tag.Name] = true
+	}
+
+	// Unknown tags are created on the fly
+	for _, name := range tags {
+		if known[name] {
+			continue
+		}
+
+		tag, err := s.CreateTag(ctx, NewTag{Name: name})
+		if err != nil {
+			return Item{}, err
+		}
+
+		tagIDs = append(tagIDs, tag.ID)
+		known[name] = true
+	}
+
+	err = s.tagStore.AttachTags(ctx, id, tagIDs)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "tag item")
+	}
+
+	return s.store.GetOne(ctx, id)
+}
+
+func (s service) UntagItem(ctx context.Context, id string, tags []string) (Item, error) {
+	_, err := s.store.GetOne(ctx, id)
+	if err != nil {
+		return Item{}, err
+	}
+
+	existing, err := s.tagStore.GetTagsByName(ctx, tagOwner(ctx), tags)
+	if err != nil {
+		return Item{}, err
+	}
+
+	tagIDs := make([]string, 0, len(existing))
+
+	for _, tag := range existing {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	err = s.tagStore.DetachTags(ctx, id, tagIDs)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "untag item")
+	}
+
+	return s.store.GetOne(ctx, id)
+}
+
+// getTag returns a tag of the owner in the context.
+func (s service) getTag(ctx context.Context, id string) (Tag, error) {
+	tag, err := s.tagStore.GetOneTag(ctx, id)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	// Tags of other owners are not visible
+	if tag.Owner != tagOwner(ctx) {
+		return Tag{}, errors.WithStack(TagNotFoundError{ID: id})
+	}
+
+	return tag, nil
+}
@@ -0,0 +1,181 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func tagNames(tags []Tag) []string {
+	names := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+
+	return names
+}
+
+func TestService_CreateTag(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.CreateTag(ctx, NewTag{})
+	assert.True(t, isValidationError(err))
+
+	_, err = service.CreateTag(ctx, NewTag{Name: "work", Color: "red"})
+	assert.True(t, isValidationError(err))
+
+	tag, err := service.CreateTag(ctx, NewTag{Name: "work", Color: "#ff0000"})
+	require.NoError(t, err)
+
+	_, err = service.CreateTag(ctx, NewTag{Name: "work"})
+	assert.True(t, isValidationError(err), "tag names should be unique")
+
+	// Tag names are unique per owner
+	other, err := service.CreateTag(principal.ToContext(ctx, "john"), NewTag{Name: "work"})
+	require.NoError(t, err)
+
+	_, err = service.GetTag(ctx, other.ID)
+	assert.True(t, errors.As(err, &TagNotFoundError{}), "tags of other owners should not be visible")
+
+	tags, err := service.ListTags(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Tag{tag}, tags)
+}
+
+func TestService_TagItem(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Write report"})
+	require.NoError(t, err)
+
+	item, err = service.TagItem(ctx, item.ID, []string{"work", "urgent"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"urgent", "work"}, tagNames(item.Tags))
+
+	// Tagging is idempotent
+	item, err = service.TagItem(ctx, item.ID, []string{"work"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"urgent", "work"}, tagNames(item.Tags))
+
+	tags, err := service.ListTags(ctx)
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, 1, tags[0].ItemCount)
+
+	item, err = service.UntagItem(ctx, item.ID, []string{"urgent", "unknown"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work"}, tagNames(item.Tags))
+
+	_, err = service.TagItem(ctx, "missing", []string{"work"})
+	assert.True(t, errors.As(err, &NotFoundError{}))
+}
+
+func TestService_ListItems_Tags(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	var ids []string
+
+	for _, tags := range [][]string{{"work"}, {"work", "urgent"}, {"home"}, nil} {
+		item, err := service.AddItem(ctx, NewItem{Title: "Item"})
+		require.NoError(t, err)
+
+		if tags != nil {
+			_, err = service.TagItem(ctx, item.ID, tags)
+			require.NoError(t, err)
+		}
+
+		ids = append(ids, item.ID)
+	}
+
+	items, err := service.ListItems(ctx, ItemFilter{Tags: []string{"urgent", "home"}})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{ids[1], ids[2]}, itemIDs(items))
+
+	items, err = service.ListItems(ctx, ItemFilter{Tags: []string{"work", "urgent"}, MatchAllTags: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[1]}, itemIDs(items))
+}
+
+func TestService_UpdateTag(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	work, err := service.CreateTag(ctx, NewTag{Name: "work"})
+	require.NoError(t, err)
+
+	_, err = service.CreateTag(ctx, NewTag{Name: "office"})
+	require.NoError(t, err)
+
+	name := "office"
+
+	_, err = service.UpdateTag(ctx, work.ID, TagUpdate{Name: &name})
+	assert.True(t, isValidationError(err), "renaming to an existing name should fail")
+
+	name = "job"
+
+	tag, err := service.UpdateTag(ctx, work.ID, TagUpdate{Name: &name})
+	require.NoError(t, err)
+	assert.Equal(t, "job", tag.Name)
+}
+
+func TestService_MergeTags(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	item1, err := service.AddItem(ctx, NewItem{Title: "Write report"})
+	require.NoError(t, err)
+
+	item2, err := service.AddItem(ctx, NewItem{Title: "Call the client"})
+	require.NoError(t, err)
+
+	item1, err = service.TagItem(ctx, item1.ID, []string{"work", "job"})
+	require.NoError(t, err)
+
+	_, err = service.TagItem(ctx, item2.ID, []string{"job"})
+	require.NoError(t, err)
+
+	work, job := item1.Tags[1], item1.Tags[0]
+
+	_, err = service.MergeTags(ctx, work.ID, work.ID)
+	assert.True(t, isValidationError(err))
+
+	tag, err := service.MergeTags(ctx, job.ID, work.ID)
+	require.NoError(t, err)
+	assert.Equal(t, work.ID, tag.ID)
+	assert.Equal(t, 2, tag.ItemCount)
+
+	_, err = service.GetTag(ctx, job.ID)
+	assert.True(t, errors.As(err, &TagNotFoundError{}))
+
+	item2, err = service.GetItem(ctx, item2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work"}, tagNames(item2.Tags))
+}
+
+func TestService_DeleteTag(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Write report"})
+	require.NoError(t, err)
+
+	item, err = service.TagItem(ctx, item.ID, []string{"work"})
+	require.NoError(t, err)
+
+	err = service.DeleteTag(ctx, item.Tags[0].ID)
+	require.NoError(t, err)
+
+	item, err = service.GetItem(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Empty(t, item.Tags)
+}
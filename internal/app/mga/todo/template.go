@@ -0,0 +1,413 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// maxTemplateItems is the maximum number of item blueprints in a template.
+const maxTemplateItems = 100
+
+// Template is a named set of item blueprints (eg. a checklist followed every time).
+type Template struct {
+	ID          string
+	Name        string
+	Description string
+
+	// Items are the blueprints of the items created from the template, ordered by their order.
+	Items []TemplateItem
+}
+
+// TemplateItem is the blueprint of an item created from a template.
+type TemplateItem struct {
+	Title    string   `json:"title"`
+	Notes    string   `json:"notes,omitempty"`
+	Priority Priority `json:"priority,omitempty"`
+
+	// Order is the position of the item in the template: items are created in this order.
+	Order int `json:"order"`
+
+	// DueOffset makes the item due relative to the start of the instance (no due date if it's empty).
+	// Negative offsets make the item due before the start.
+	DueOffset *time.Duration `json:"dueOffset,omitempty"`
+
+	// Tags are attached to the item by their names.
+	Tags []string `json:"tags,omitempty"`
+}
+
+func (i TemplateItem) newItem(listID string, start time.Time) NewItem {
+	newItem := NewItem{
+		ListID:   listID,
+		Title:    i.Title,
+		Notes:    i.Notes,
+		Priority: i.Priority,
+		Order:    i.Order,
+	}
+
+	if i.DueOffset != nil {
+		dueAt := start.Add(*i.DueOffset)
+		newItem.DueAt = &dueAt
+	}
+
+	return newItem
+}
+
+// NewTemplate contains the details of a new Template.
+type NewTemplate struct {
+	Name        string
+	Description string
+	Items       []TemplateItem
+}
+
+// TemplateUpdate contains updates of an existing template.
+type TemplateUpdate struct {
+	Name        *string
+	Description *string
+
+	// Items replace the item blueprints of the template (nil keeps them).
+	Items []TemplateItem
+}
+
+func (u TemplateUpdate) update(template Template) Template {
+	if u.Name != nil {
+		template.Name = *u.Name
+	}
+
+	if u.Description != nil {
+		template.Description = *u.Description
+	}
+
+	if u.Items != nil {
+		template.Items = u.Items
+	}
+
+	return template
+}
+
+// TemplateInstantiation tells where the items of a template are created.
+type TemplateInstantiation struct {
+	// ListID appends the items to an existing list. A new list is created when it's empty.
+	ListID string
+
+	// ListName is the name of the new list (defaults to the name of the template).
+	ListName string
+
+	// Start is the time due offsets are relative to (defaults to now).
+	Start *time.Time
+}
+
+// TemplateInstance is the list and the items created from a template.
+type TemplateInstance struct {
+	List List
+
+	// Items are the created items in the order of the template.
+	Items []Item
+}
+
+// TemplateStore persists templates.
+type TemplateStore interface {
+	// StoreTemplate stores a template.
+	StoreTemplate(ctx context.Context, template Template) error
+
+	// GetAllTemplates returns all templates.
+	GetAllTemplates(ctx context.Context) ([]Template, error)
+
+	// GetOneTemplate returns a single template by its ID.
+	GetOneTemplate(ctx context.Context, id string) (Template, error)
+
+	// DeleteOneTemplate deletes a single template by its ID.
+	DeleteOneTemplate(ctx context.Context, id string) error
+}
+
+// TemplateNotFoundError is returned if a template cannot be found.
+type TemplateNotFoundError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (TemplateNotFoundError) Error() string {
+	return "template not found"
+}
+
+// Details returns error details.
+func (e TemplateNotFoundError) Details() []interface{} {
+	return []interface{}{"template_id", e.ID}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (TemplateNotFoundError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (TemplateNotFoundError) ServiceError() bool {
+	return true
+}
+
+func (s service) CreateTemplate(ctx context.Context, newTemplate NewTemplate) (Template, error) {
+	template := normalizeTemplate(Template{
+		Name:        newTemplate.Name,
+		Description: newTemplate.Description,
+		Items:       newTemplate.Items,
+	})
+
+	err := validateTemplate(template)
+	if err != nil {
+		return Template{}, err
+	}
+
+	template.ID, err = s.idgenerator.Generate()
+	if err != nil {
+		return Template{}, err
+	}
+
+	err = s.templateStore.StoreTemplate(ctx, template)
+	if err != nil {
+		return Template{}, errors.WithMessage(err, "create template")
+	}
+
+	return template, nil
+}
+
+func (s service) ListTemplates(ctx context.Context) ([]Template, error) {
+	return s.templateStore.GetAllTemplates(ctx)
+}
+
+func (s service) GetTemplate(ctx context.Context, id string) (Template, error) {
+	template, err := s.templateStore.GetOneTemplate(ctx, id)
+	if err != nil {
+		return Template{}, errors.WithMessage(err, "get template")
+	}
+
+	return template, nil
+}
+
+func (s service) UpdateTemplate(ctx context.Context, id string, templateUpdate TemplateUpdate) (Template, error) {
+	template, err := s.templateStore.GetOneTemplate(ctx, id)
+	if err != nil {
+		return Template{}, err
+	}
+
+	template = normalizeTemplate(templateUpdate.update(template))
+
+	err = validateTemplate(template)
+	if err != nil {
+		return Template{}, err
+	}
+
+	err = s.templateStore.StoreTemplate(ctx, template)
+	if err != nil {
+		return Template{}, errors.WithMessage(err, "update template")
+	}
+
+	return template, nil
+}
+
+func (s service) DeleteTemplate(ctx context.Context, id string) error {
+	_, err := s.templateStore.GetOneTemplate(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete template")
+	}
+
+	err = s.templateStore.DeleteOneTemplate(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "delete template")
+	}
+
+	return nil
+}
+
+func (s service) InstantiateTemplate(
+	ctx context.Context,
+	id string,
+	instantiation TemplateInstantiation,
+) (TemplateInstance, error) {
+	return templateInstantiator{service: s, transactor: s.transactor}.InstantiateTemplate(ctx, id, instantiation)
+}
+
+// TemplateMiddleware instantiates templates through the single item operations of the underlying service,
+// so every created item goes through the same middleware as adding items one by one.
+// Events fired by the instantiation are dispatched once it's committed.
+func TemplateMiddleware(transactor Transactor, events Events) Middleware {
+	return func(next Service) Service {
+		return templateMiddleware{
+			Service: DefaultMiddleware{Service: next},
+			instantiator: templateInstantiator{
+				service:    next,
+				transactor: transactor,
+				events:     events,
+			},
+		}
+	}
+}
+
+type templateMiddleware struct {
+	Service
+
+	instantiator templateInstantiator
+}
+
+func (mw templateMiddleware) InstantiateTemplate(
+	ctx context.Context,
+	id string,
+	instantiation TemplateInstantiation,
+) (TemplateInstance, error) {
+	return mw.instantiator.InstantiateTemplate(ctx, id, instantiation)
+}
+
+// templateInstantiator creates the items of templates one at a time.
+type templateInstantiator struct {
+	service    Service
+	transactor Transactor
+
+	// events dispatches the events fired by committed instantiations (optional).
+	events Events
+}
+
+func (s templateInstantiator) InstantiateTemplate(
+	ctx context.Context,
+	id string,
+	instantiation TemplateInstantiation,
+) (TemplateInstance, error) {
+	if instantiation.ListID != "" && instantiation.ListName != "" {
+		return TemplateInstance{}, errors.WithStack(validationError{violations: map[string][]string{
+			"listName": {
+				"listName cannot be set together with listId",
+			},
+		}})
+	}
+
+	template, err := s.service.GetTemplate(ctx, id)
+	if err != nil {
+		return TemplateInstance{}, err
+	}
+
+	start := time.Now()
+	if instantiation.Start != nil {
+		start = *instantiation.Start
+	}
+
+	var instance TemplateInstance
+
+	txCtx, queue := withEventQueue(ctx)
+
+	err = s.transactor.Transaction(txCtx, func(ctx context.Context) error {
+		instance = TemplateInstance{}
+
+		var err error
+
+		if instantiation.ListID != "" {
+			instance.List, err = s.service.GetList(ctx, instantiation.ListID)
+		} else {
+			name := instantiation.ListName
+			if name == "" {
+				name = template.Name
+			}
+
+			instance.List, err = s.service.CreateList(ctx, NewList{Name: name, Description: template.Description})
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, blueprint := range template.Items {
+			item, err := s.service.AddItem(ctx, blueprint.newItem(instance.List.ID, start))
+			if err != nil {
+				return err
+			}
+
+			if len(blueprint.Tags) > 0 {
+				item, err = s.service.TagItem(ctx, item.ID, blueprint.Tags)
+				if err != nil {
+					return err
+				}
+			}
+
+			instance.Items = append(instance.Items, item)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return TemplateInstance{}, errors.WithMessage(err, "instantiate template")
+	}
+
+	err = queue.dispatch(ctx, s.events)
+	if err != nil {
+		return instance, err
+	}
+
+	return instance, nil
+}
+
+// normalizeTemplate trims the names of a template and orders its items.
+func normalizeTemplate(template Template) Template {
+	template.Name = strings.TrimSpace(template.Name)
+
+	items := make([]TemplateItem, 0, len(template.Items))
+
+	for _, item := range template.Items {
+		item.Title = strings.TrimSpace(item.Title)
+
+		var tags []string
+
+		for _, tag := range item.Tags {
+			tags = append(tags, strings.TrimSpace(tag))
+		}
+
+		item.Tags = tags
+
+		items = append(items, item)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Order < items[j].Order })
+
+	template.Items = items
+
+	return template
+}
+
+func validateTemplate(template Template) error {
+	violations := make(map[string][]string)
+
+	if template.Name == "" {
+		violations["name"] = append(violations["name"], "name cannot be empty")
+	}
+
+	if len(template.Items) > maxTemplateItems {
+		violations["items"] = append(violations["items"], "a template can have at most 100 items")
+	}
+
+	for i, item := range template.Items {
+		field := fmt.Sprintf("items[%d]", i)
+
+		if item.Title == "" {
+			violations[field] = append(violations[field], "title cannot be empty")
+		}
+
+		if !item.Priority.valid() {
+			violations[field] = append(violations[field], priorityViolation)
+		}
+
+		for _, tag := range item.Tags {
+			if tag == "" {
+				violations[field] = append(violations[field], "tags cannot be empty")
+
+				break
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return errors.WithStack(validationError{violations: violations})
+	}
+
+	return nil
+}
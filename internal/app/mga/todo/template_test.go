@@ -0,0 +1,190 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
+
+func TestService_Template(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	template, err := service.CreateTemplate(ctx, NewTemplate{
+		Name:        " Weekly review ",
+		Description: "Every Friday",
+		Items: []TemplateItem{
+			{Title: "Plan next week", Order: 2},
+			{Title: " Clear the inbox ", Order: 1, Tags: []string{" review "}},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Weekly review", template.Name)
+	require.Len(t, template.Items, 2)
+	assert.Equal(t, "Clear the inbox", template.Items[0].Title, "items are ordered by their order")
+	assert.Equal(t, []string{"review"}, template.Items[0].Tags)
+
+	name := "Friday review"
+
+	updated, err := service.UpdateTemplate(ctx, template.ID, TemplateUpdate{Name: &name})
+	require.NoError(t, err)
+	assert.Equal(t, "Friday review", updated.Name)
+	assert.Equal(t, template.Items, updated.Items, "items are kept when they are not updated")
+
+	templates, err := service.ListTemplates(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Template{updated}, templates)
+
+	require.NoError(t, service.DeleteTemplate(ctx, template.ID))
+
+	_, err = service.GetTemplate(ctx, template.ID)
+	assert.True(t, errors.As(err, &TemplateNotFoundError{}))
+
+	err = service.DeleteTemplate(ctx, template.ID)
+	assert.True(t, errors.As(err, &TemplateNotFoundError{}))
+}
+
+func TestService_Template_Validation(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	tests := map[string]NewTemplate{
+		"empty name":     {Name: " "},
+		"empty title":    {Name: "Review", Items: []TemplateItem{{Title: " "}}},
+		"empty tag":      {Name: "Review", Items: []TemplateItem{{Title: "Plan", Tags: []string{""}}}},
+		"priority":       {Name: "Review", Items: []TemplateItem{{Title: "Plan", Priority: Priority(42)}}},
+		"too many items": {Name: "Review", Items: make([]TemplateItem, 101)},
+	}
+
+	for name, newTemplate := range tests {
+		newTemplate := newTemplate
+
+		t.Run(name, func(t *testing.T) {
+			_, err := service.CreateTemplate(ctx, newTemplate)
+			assert.True(t, isValidationError(err))
+		})
+	}
+}
+
+func TestService_InstantiateTemplate(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	template, err := service.CreateTemplate(ctx, NewTemplate{
+		Name:        "Moving house",
+		Description: "Checklist for moving",
+		Items: []TemplateItem{
+			{Title: "Hand over the keys", Order: 2, DueOffset: durationPtr(7 * 24 * time.Hour)},
+			{Title: "Book a van", Order: 1, Priority: PriorityHigh, DueOffset: durationPtr(-24 * time.Hour), Tags: []string{"errand"}},
+			{Title: "Change the address", Order: 3},
+		},
+	})
+	require.NoError(t, err)
+
+	start := time.Date(2021, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	instance, err := service.InstantiateTemplate(ctx, template.ID, TemplateInstantiation{Start: &start})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Moving house", instance.List.Name)
+	assert.Equal(t, "Checklist for moving", instance.List.Description)
+
+	require.Len(t, instance.Items, 3)
+	assert.Equal(t, "Book a van", instance.Items[0].Title)
+	assert.Equal(t, PriorityHigh, instance.Items[0].Priority)
+	require.NotNil(t, instance.Items[0].DueAt)
+	assert.True(t, start.Add(-24*time.Hour).Equal(*instance.Items[0].DueAt))
+	require.Len(t, instance.Items[0].Tags, 1)
+	assert.Equal(t, "errand", instance.Items[0].Tags[0].Name)
+
+	require.NotNil(t, instance.Items[1].DueAt)
+	assert.True(t, start.Add(7*24*time.Hour).Equal(*instance.Items[1].DueAt))
+	assert.Nil(t, instance.Items[2].DueAt)
+
+	items, err := service.ListItems(ctx, ItemFilter{ListID: instance.List.ID})
+	require.NoError(t, err)
+	assert.Len(t, items, 3)
+
+	require.Len(t, events.added, 3, "every created item fires an event")
+
+	for i, event := range events.added {
+		assert.Equal(t, ItemAdded{ID: instance.Items[i].ID, ListID: instance.List.ID}, event)
+	}
+}
+
+func TestService_InstantiateTemplate_ExistingList(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Home"})
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Water the plants"})
+	require.NoError(t, err)
+
+	template, err := service.CreateTemplate(ctx, NewTemplate{
+		Name:  "Cleaning",
+		Items: []TemplateItem{{Title: "Vacuum"}, {Title: "Dust"}},
+	})
+	require.NoError(t, err)
+
+	instance, err := service.InstantiateTemplate(ctx, template.ID, TemplateInstantiation{ListID: list.ID})
+	require.NoError(t, err)
+	assert.Equal(t, list, instance.List)
+
+	items, err := service.ListItems(ctx, ItemFilter{ListID: list.ID})
+	require.NoError(t, err)
+	assert.Len(t, items, 3)
+
+	lists, err := service.ListLists(ctx)
+	require.NoError(t, err)
+	assert.Len(t, lists, 2, "no new list is created")
+
+	named, err := service.InstantiateTemplate(ctx, template.ID, TemplateInstantiation{ListName: "Spring cleaning"})
+	require.NoError(t, err)
+	assert.Equal(t, "Spring cleaning", named.List.Name)
+
+	_, err = service.InstantiateTemplate(ctx, template.ID, TemplateInstantiation{ListID: list.ID, ListName: "Home"})
+	assert.True(t, isValidationError(err))
+
+	_, err = service.InstantiateTemplate(ctx, "missing", TemplateInstantiation{})
+	assert.True(t, errors.As(err, &TemplateNotFoundError{}))
+}
+
+func TestService_InstantiateTemplate_Atomic(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, store := newTestService(testServiceConfig{Events: events})
+
+	// Stored directly: the service would reject the invalid blueprint
+	require.NoError(t, store.StoreTemplate(ctx, Template{
+		ID:    "broken",
+		Name:  "Broken",
+		Items: []TemplateItem{{Title: "Valid"}, {Title: ""}},
+	}))
+
+	_, err := service.InstantiateTemplate(ctx, "broken", TemplateInstantiation{})
+	require.Error(t, err)
+
+	lists, err := service.ListLists(ctx)
+	require.NoError(t, err)
+	assert.Len(t, lists, 1, "the new list is rolled back")
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	assert.Empty(t, events.added, "no events are fired for rolled back items")
+}
@@ -0,0 +1,487 @@
+package todo
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// TimeEntry is the time a user spent working on an item.
+type TimeEntry struct {
+	ID     string
+	ItemID string
+
+	// User is the user who tracked the time.
+	User string
+
+	StartedAt time.Time
+
+	// EndedAt is the time the timer got stopped (empty while the timer is running).
+	EndedAt *time.Time
+
+	// Duration is the time tracked by the entry (until now while the timer is running).
+	// It is maintained by the service.
+	Duration time.Duration
+}
+
+// Running tells whether the timer of the entry is still running.
+func (e TimeEntry) Running() bool {
+	return e.EndedAt == nil
+}
+
+// withDuration calculates the duration of an entry (running timers are measured until now).
+func (e TimeEntry) withDuration(now time.Time) TimeEntry {
+	end := now
+	if e.EndedAt != nil {
+		end = *e.EndedAt
+	}
+
+	e.Duration = end.Sub(e.StartedAt)
+	if e.Duration < 0 {
+		e.Duration = 0
+	}
+
+	return e
+}
+
+// TimeEntryFilter filters time entries.
+type TimeEntryFilter struct {
+	ItemID string
+	User   string
+
+	// Mine only returns the entries of the current user.
+	// The service resolves it to User: stores ignore it.
+	Mine bool
+
+	// From only returns entries running after this time.
+	From *time.Time
+
+	// To only returns entries started before this time.
+	To *time.Time
+
+	// Running only returns running timers.
+	Running bool
+}
+
+// TimeTotals is the time tracked by time entries.
+// Totals are limited to the period of the filter.
+type TimeTotals struct {
+	// Items are the totals per item, the most time first.
+	Items []ItemTime
+
+	// Days are the totals per day (in UTC), oldest first.
+	Days []DayTime
+
+	Total time.Duration
+}
+
+// ItemTime is the time tracked on an item.
+type ItemTime struct {
+	ItemID string
+
+	// Title of the item (empty if the item has been purged).
+	Title string
+
+	Duration time.Duration
+}
+
+// DayTime is the time tracked on a day.
+type DayTime struct {
+	// Day is the midnight (UTC) starting the day.
+	Day time.Time
+
+	Duration time.Duration
+}
+
+// Timesheet is a report of time entries.
+type Timesheet struct {
+	Entries []TimeEntry
+
+	// Titles of the items by their ID.
+	Titles map[string]string
+}
+
+// timesheetColumns are the columns of CSV timesheets.
+// nolint: gochecknoglobals
+var timesheetColumns = []string{"date", "user", "item_id", "item", "started_at", "ended_at", "hours"}
+
+// WriteTo writes the timesheet as CSV with a header row.
+// Entries are dated by the day (in UTC) they started on, running timers have no end.
+func (t Timesheet) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	writer := csv.NewWriter(cw)
+
+	err := writer.Write(timesheetColumns)
+	if err != nil {
+		return cw.n, err
+	}
+
+	for _, entry := range t.Entries {
+		var endedAt string
+		if entry.EndedAt != nil {
+			endedAt = entry.EndedAt.UTC().Format(time.RFC3339)
+		}
+
+		err := writer.Write([]string{
+			entry.StartedAt.UTC().Format("2006-01-02"),
+			entry.User,
+			entry.ItemID,
+			t.Titles[entry.ItemID],
+			entry.StartedAt.UTC().Format(time.RFC3339),
+			endedAt,
+			strconv.FormatFloat(entry.Duration.Hours(), 'f', 2, 64),
+		})
+		if err != nil {
+			return cw.n, err
+		}
+	}
+
+	writer.Flush()
+
+	return cw.n, writer.Error()
+}
+
+// TimeStore persists time entries.
+type TimeStore interface {
+	// StoreTimeEntry stores a time entry.
+	StoreTimeEntry(ctx context.Context, entry TimeEntry) error
+
+	// GetTimeEntries returns the time entries matching a filter, oldest first.
+	GetTimeEntries(ctx context.Context, filter TimeEntryFilter) ([]TimeEntry, error)
+}
+
+// TimerNotRunningError is returned if a user has no running timer.
+type TimerNotRunningError struct {
+	User string
+}
+
+// Error implements the error interface.
+func (TimerNotRunningError) Error() string {
+	return "no timer is running"
+}
+
+// Details returns error details.
+func (e TimerNotRunningError) Details() []interface{} {
+	return []interface{}{"user", e.User}
+}
+
+// NotFound tells a client that this error is related to a resource being not found.
+// Can be used to translate the error to eg. status code.
+func (TimerNotRunningError) NotFound() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (TimerNotRunningError) ServiceError() bool {
+	return true
+}
+
+func (s service) StartTimer(ctx context.Context, itemID string) (TimeEntry, error) {
+	user, err := timerUser(ctx)
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	item, err := s.store.GetOne(ctx, itemID)
+	if err != nil {
+		return TimeEntry{}, errors.WithMessage(err, "start timer")
+	}
+
+	if item.Completed {
+		return TimeEntry{}, errors.WithStack(validationError{violations: map[string][]string{
+			"completed": {
+				"completed items cannot be timed",
+			},
+		}})
+	}
+
+	id, err := s.idgenerator.Generate()
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	now := time.Now()
+
+	entry := TimeEntry{
+		ID:        id,
+		ItemID:    item.ID,
+		User:      user,
+		StartedAt: now,
+	}
+
+	err = s.transactor.Transaction(ctx, func(ctx context.Context) error {
+		running, err := s.runningTimers(ctx, TimeEntryFilter{User: user})
+		if err != nil {
+			return err
+		}
+
+		// Starting the running timer again keeps it running
+		if len(running) == 1 && running[0].ItemID == item.ID {
+			entry = running[0]
+
+			return nil
+		}
+
+		err = s.stopTimers(ctx, running, now)
+		if err != nil {
+			return err
+		}
+
+		return s.timeStore.StoreTimeEntry(ctx, entry)
+	})
+	if err != nil {
+		return TimeEntry{}, errors.WithMessage(err, "start timer")
+	}
+
+	return entry.withDuration(now), nil
+}
+
+func (s service) StopTimer(ctx context.Context) (TimeEntry, error) {
+	entry, err := s.GetTimer(ctx)
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	now := time.Now()
+	entry.EndedAt = &now
+
+	err = s.timeStore.StoreTimeEntry(ctx, entry)
+	if err != nil {
+		return TimeEntry{}, errors.WithMessage(err, "stop timer")
+	}
+
+	return entry.withDuration(now), nil
+}
+
+func (s service) GetTimer(ctx context.Context) (TimeEntry, error) {
+	user, err := timerUser(ctx)
+	if err != nil {
+		return TimeEntry{}, err
+	}
+
+	running, err := s.runningTimers(ctx, TimeEntryFilter{User: user})
+	if err != nil {
+		return TimeEntry{}, errors.WithMessage(err, "get timer")
+	}
+
+	if len(running) == 0 {
+		return TimeEntry{}, errors.WithStack(TimerNotRunningError{User: user})
+	}
+
+	return running[0].withDuration(time.Now()), nil
+}
+
+func (s service) ListTimeEntries(ctx context.Context, filter TimeEntryFilter) ([]TimeEntry, error) {
+	filter, err := timeEntryFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.timeStore.GetTimeEntries(ctx, filter)
+	if err != nil {
+		return nil, errors.WithMessage(err, "list time entries")
+	}
+
+	now := time.Now()
+
+	for i, entry := range entries {
+		entries[i] = entry.withDuration(now)
+	}
+
+	return entries, nil
+}
+
+func (s service) TimeTotals(ctx context.Context, filter TimeEntryFilter) (TimeTotals, error) {
+	entries, err := s.ListTimeEntries(ctx, filter)
+	if err != nil {
+		return TimeTotals{}, err
+	}
+
+	now := time.Now()
+	items := make(map[string]time.Duration)
+	days := make(map[time.Time]time.Duration)
+
+	var totals TimeTotals
+
+	for _, entry := range entries {
+		start, end := entry.StartedAt, now
+		if entry.EndedAt != nil {
+			end = *entry.EndedAt
+		}
+
+		if filter.From != nil && filter.From.After(start) {
+			start = *filter.From
+		}
+
+		if filter.To != nil && filter.To.Before(end) {
+			end = *filter.To
+		}
+
+		if !start.Before(end) {
+			continue
+		}
+
+		items[entry.ItemID] += end.Sub(start)
+		totals.Total += end.Sub(start)
+
+		// Entries running over midnight count towards both days
+		for start.Before(end) {
+			day := start.UTC().Truncate(24 * time.Hour)
+
+			next := day.Add(24 * time.Hour)
+			if next.After(end) {
+				next = end
+			}
+
+			days[day] += next.Sub(start)
+			start = next
+		}
+	}
+
+	titles, err := s.itemTitles(ctx, entries)
+	if err != nil {
+		return TimeTotals{}, errors.WithMessage(err, "time totals")
+	}
+
+	for itemID, duration := range items {
+		totals.Items = append(totals.Items, ItemTime{ItemID: itemID, Title: titles[itemID], Duration: duration})
+	}
+
+	sort.Slice(totals.Items, func(i, j int) bool {
+		if totals.Items[i].Duration != totals.Items[j].Duration {
+			return totals.Items[i].Duration > totals.Items[j].Duration
+		}
+
+		return totals.Items[i].ItemID < totals.Items[j].ItemID
+	})
+
+	for day, duration := range days {
+		totals.Days = append(totals.Days, DayTime{Day: day, Duration: duration})
+	}
+
+	sort.Slice(totals.Days, func(i, j int) bool {
+		return totals.Days[i].Day.Before(totals.Days[j].Day)
+	})
+
+	return totals, nil
+}
+
+func (s service) ExportTimesheet(ctx context.Context, filter TimeEntryFilter) (Timesheet, error) {
+	entries, err := s.ListTimeEntries(ctx, filter)
+	if err != nil {
+		return Timesheet{}, err
+	}
+
+	titles, err := s.itemTitles(ctx, entries)
+	if err != nil {
+		return Timesheet{}, errors.WithMessage(err, "export timesheet")
+	}
+
+	return Timesheet{Entries: entries, Titles: titles}, nil
+}
+
+// runningTimers returns the running timers matching a filter.
+func (s service) runningTimers(ctx context.Context, filter TimeEntryFilter) ([]TimeEntry, error) {
+	filter.Running = true
+
+	return s.timeStore.GetTimeEntries(ctx, filter)
+}
+
+// stopTimers stops running timers at a point in time.
+func (s service) stopTimers(ctx context.Context, entries []TimeEntry, now time.Time) error {
+	for _, entry := range entries {
+		entry.EndedAt = &now
+
+		err := s.timeStore.StoreTimeEntry(ctx, entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stopItemTimers stops the running timers of an item (of every user).
+func (s service) stopItemTimers(ctx context.Context, itemID string) error {
+	running, err := s.runningTimers(ctx, TimeEntryFilter{ItemID: itemID})
+	if err != nil {
+		return err
+	}
+
+	return s.stopTimers(ctx, running, time.Now())
+}
+
+// itemTitles returns the titles of the items of time entries (including items in the trash).
+func (s service) itemTitles(ctx context.Context, entries []TimeEntry) (map[string]string, error) {
+	titles := make(map[string]string)
+
+	for _, entry := range entries {
+		if _, ok := titles[entry.ItemID]; ok {
+			continue
+		}
+
+		item, err := s.store.GetOne(ctx, entry.ItemID)
+		if errors.As(err, &NotFoundError{}) {
+			item, err = s.trashStore.GetOneDeleted(ctx, entry.ItemID)
+		}
+		if errors.As(err, &NotFoundError{}) {
+			titles[entry.ItemID] = ""
+
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		titles[entry.ItemID] = item.Title
+	}
+
+	return titles, nil
+}
+
+// timerUser returns the current user tracking time.
+func timerUser(ctx context.Context) (string, error) {
+	user, ok := principal.FromContext(ctx)
+	if !ok {
+		return "", errors.WithStack(validationError{violations: map[string][]string{
+			"user": {
+				"tracking time requires an authenticated user",
+			},
+		}})
+	}
+
+	return user, nil
+}
+
+// timeEntryFilter resolves listing the time entries of the current user and validates the period of a filter.
+func timeEntryFilter(ctx context.Context, filter TimeEntryFilter) (TimeEntryFilter, error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, errors.WithStack(validationError{violations: map[string][]string{
+			"to": {
+				"to must be after from",
+			},
+		}})
+	}
+
+	if !filter.Mine {
+		return filter, nil
+	}
+
+	user, err := currentUser(ctx, "user", filter.User)
+	if err != nil {
+		return filter, err
+	}
+
+	filter.User = user
+	filter.Mine = false
+
+	return filter, nil
+}
@@ -0,0 +1,224 @@
+package todo_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestService_Timer(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _ := newTestService(testServiceConfig{})
+
+	lawn, err := service.AddItem(ctx, NewItem{Title: "Mow the lawn"})
+	require.NoError(t, err)
+
+	milk, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	_, err = service.GetTimer(ctx)
+	assert.True(t, errors.As(err, &TimerNotRunningError{}))
+
+	entry, err := service.StartTimer(ctx, lawn.ID)
+	require.NoError(t, err)
+	assert.Equal(t, lawn.ID, entry.ItemID)
+	assert.Equal(t, "john", entry.User)
+	assert.True(t, entry.Running())
+
+	// Starting the running timer again keeps it running
+	again, err := service.StartTimer(ctx, lawn.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entry.ID, again.ID)
+
+	// Starting another timer stops the running one
+	_, err = service.StartTimer(ctx, milk.ID)
+	require.NoError(t, err)
+
+	timer, err := service.GetTimer(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, milk.ID, timer.ItemID)
+
+	entries, err := service.ListTimeEntries(ctx, TimeEntryFilter{Mine: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.False(t, entries[0].Running())
+	assert.True(t, entries[1].Running())
+
+	stopped, err := service.StopTimer(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, milk.ID, stopped.ItemID)
+	assert.False(t, stopped.Running())
+
+	_, err = service.StopTimer(ctx)
+	assert.True(t, errors.As(err, &TimerNotRunningError{}))
+}
+
+func TestService_Timer_PerUser(t *testing.T) {
+	john := principal.ToContext(context.Background(), "john")
+	jane := principal.ToContext(context.Background(), "jane")
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(john, NewItem{Title: "Mow the lawn"})
+	require.NoError(t, err)
+
+	_, err = service.StartTimer(john, item.ID)
+	require.NoError(t, err)
+
+	_, err = service.StartTimer(jane, item.ID)
+	require.NoError(t, err)
+
+	running, err := service.ListTimeEntries(john, TimeEntryFilter{Running: true})
+	require.NoError(t, err)
+	assert.Len(t, running, 2, "every user has their own timer")
+
+	_, err = service.StartTimer(context.Background(), item.ID)
+	assert.True(t, isValidationError(err))
+
+	_, err = service.ListTimeEntries(context.Background(), TimeEntryFilter{Mine: true})
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_Timer_CompletedItem(t *testing.T) {
+	john := principal.ToContext(context.Background(), "john")
+	jane := principal.ToContext(context.Background(), "jane")
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(john, NewItem{Title: "Mow the lawn"})
+	require.NoError(t, err)
+
+	_, err = service.StartTimer(john, item.ID)
+	require.NoError(t, err)
+
+	_, err = service.StartTimer(jane, item.ID)
+	require.NoError(t, err)
+
+	completed := true
+
+	_, err = service.UpdateItem(john, item.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	running, err := service.ListTimeEntries(john, TimeEntryFilter{Running: true})
+	require.NoError(t, err)
+	assert.Empty(t, running, "completing an item stops its timers")
+
+	_, err = service.StartTimer(john, item.ID)
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_TimeTotals(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, store := newTestService(testServiceConfig{})
+
+	lawn, err := service.AddItem(ctx, NewItem{Title: "Mow the lawn"})
+	require.NoError(t, err)
+
+	milk, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	day := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	entries := []TimeEntry{
+		{ID: "t1", ItemID: lawn.ID, User: "john", StartedAt: day.Add(9 * time.Hour), EndedAt: timePtr(day.Add(11 * time.Hour))},
+		{ID: "t2", ItemID: milk.ID, User: "john", StartedAt: day.Add(12 * time.Hour), EndedAt: timePtr(day.Add(12*time.Hour + 30*time.Minute))},
+		// Running over midnight
+		{ID: "t3", ItemID: lawn.ID, User: "john", StartedAt: day.Add(23 * time.Hour), EndedAt: timePtr(day.Add(25 * time.Hour))},
+		{ID: "t4", ItemID: milk.ID, User: "jane", StartedAt: day.Add(10 * time.Hour), EndedAt: timePtr(day.Add(14 * time.Hour))},
+	}
+
+	for _, entry := range entries {
+		require.NoError(t, store.StoreTimeEntry(ctx, entry))
+	}
+
+	totals, err := service.TimeTotals(ctx, TimeEntryFilter{Mine: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4*time.Hour+30*time.Minute, totals.Total)
+	assert.Equal(t, []ItemTime{
+		{ItemID: lawn.ID, Title: "Mow the lawn", Duration: 4 * time.Hour},
+		{ItemID: milk.ID, Title: "Buy milk", Duration: 30 * time.Minute},
+	}, totals.Items)
+	assert.Equal(t, []DayTime{
+		{Day: day, Duration: 3*time.Hour + 30*time.Minute},
+		{Day: day.Add(24 * time.Hour), Duration: time.Hour},
+	}, totals.Days)
+
+	// Totals are clipped to the period
+	totals, err = service.TimeTotals(ctx, TimeEntryFilter{From: timePtr(day.Add(10 * time.Hour)), To: timePtr(day.Add(24 * time.Hour))})
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour+30*time.Minute+4*time.Hour+time.Hour, totals.Total)
+	assert.Equal(t, []DayTime{{Day: day, Duration: totals.Total}}, totals.Days)
+
+	_, err = service.TimeTotals(ctx, TimeEntryFilter{From: timePtr(day), To: timePtr(day)})
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_ExportTimesheet(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, store := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Mow the lawn, then rest"})
+	require.NoError(t, err)
+
+	day := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, store.StoreTimeEntry(ctx, TimeEntry{
+		ID:        "t1",
+		ItemID:    item.ID,
+		User:      "john",
+		StartedAt: day.Add(9 * time.Hour),
+		EndedAt:   timePtr(day.Add(10*time.Hour + 15*time.Minute)),
+	}))
+
+	timesheet, err := service.ExportTimesheet(ctx, TimeEntryFilter{})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	_, err = timesheet.WriteTo(&buf)
+	require.NoError(t, err)
+
+	expected := "date,user,item_id,item,started_at,ended_at,hours\n" +
+		"2021-01-02,john," + item.ID + ",\"Mow the lawn, then rest\",2021-01-02T09:00:00Z,2021-01-02T10:15:00Z,1.25\n"
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestService_PurgeItem_DeletesTimeEntries(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Mow the lawn"})
+	require.NoError(t, err)
+
+	_, err = service.StartTimer(ctx, item.ID)
+	require.NoError(t, err)
+
+	_, err = service.StopTimer(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, service.DeleteItem(ctx, item.ID, false))
+
+	// Time tracked on deleted items still counts
+	totals, err := service.TimeTotals(ctx, TimeEntryFilter{})
+	require.NoError(t, err)
+	require.Len(t, totals.Items, 1)
+	assert.Equal(t, "Mow the lawn", totals.Items[0].Title)
+
+	require.NoError(t, service.PurgeItem(ctx, item.ID))
+
+	entries, err := service.ListTimeEntries(ctx, TimeEntryFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,124 @@
+package todoadapter
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/attachment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+)
+
+// StoreAttachment stores the details of an attachment.
+func (s EntStore) StoreAttachment(ctx context.Context, a todo.Attachment) error {
+	existing, err := s.db(ctx).Attachment.Query().Where(attachment.UID(a.ID)).First(ctx)
+	if ent.IsNotFound(err) {
+		itemID, err := s.db(ctx).TodoItem.Query().Where(todoitem.UID(a.ItemID)).OnlyID(ctx)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+
+		_, err = s.db(ctx).Attachment.Create().
+			SetUID(a.ID).
+			SetItemID(itemID).
+			SetName(a.Name).
+			SetContentType(a.ContentType).
+			SetSize(a.Size).
+			SetChecksum(a.Checksum).
+			SetUploader(a.Uploader).
+			SetCreatedAt(a.CreatedAt).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.db(ctx).Attachment.UpdateOneID(existing.ID).
+		SetName(a.Name).
+		SetContentType(a.ContentType).
+		SetSize(a.Size).
+		SetChecksum(a.Checksum).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetAttachments returns the attachments of an item, oldest first.
+func (s EntStore) GetAttachments(ctx context.Context, itemID string) ([]todo.Attachment, error) {
+	return s.getAttachments(ctx, s.db(ctx).Attachment.Query().Where(attachment.HasItemWith(todoitem.UID(itemID))))
+}
+
+// GetOneAttachment returns a single attachment by its ID.
+func (s EntStore) GetOneAttachment(ctx context.Context, id string) (todo.Attachment, error) {
+	attachmentModel, err := s.db(ctx).Attachment.Query().Where(attachment.UID(id)).WithItem().First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.Attachment{}, errors.WithStack(todo.AttachmentNotFoundError{ID: id})
+	}
+	if err != nil {
+		return todo.Attachment{}, errors.WithStack(err)
+	}
+
+	return unmarshalAttachment(attachmentModel), nil
+}
+
+// DeleteOneAttachment deletes a single attachment by its ID.
+func (s EntStore) DeleteOneAttachment(ctx context.Context, id string) error {
+	_, err := s.db(ctx).Attachment.Delete().Where(attachment.UID(id)).Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetOrphanAttachments returns the attachments of permanently deleted items.
+// Purging an item clears the item of its attachments.
+func (s EntStore) GetOrphanAttachments(ctx context.Context) ([]todo.Attachment, error) {
+	return s.getAttachments(ctx, s.db(ctx).Attachment.Query().Where(attachment.Not(attachment.HasItem())))
+}
+
+func (s EntStore) getAttachments(ctx context.Context, query *ent.AttachmentQuery) ([]todo.Attachment, error) {
+	attachmentModels, err := query.
+		Order(ent.Asc(attachment.FieldCreatedAt), ent.Asc(attachment.FieldUID)).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	attachments := make([]todo.Attachment, 0, len(attachmentModels))
+
+	for _, attachmentModel := range attachmentModels {
+		attachments = append(attachments, unmarshalAttachment(attachmentModel))
+	}
+
+	return attachments, nil
+}
+
+func unmarshalAttachment(attachmentModel *ent.Attachment) todo.Attachment {
+	a := todo.Attachment{
+		ID:          attachmentModel.UID,
+		Name:        attachmentModel.Name,
+		ContentType: attachmentModel.ContentType,
+		Size:        attachmentModel.Size,
+		Checksum:    attachmentModel.Checksum,
+		Uploader:    attachmentModel.Uploader,
+		CreatedAt:   attachmentModel.CreatedAt,
+	}
+
+	if attachmentModel.Edges.Item != nil {
+		a.ItemID = attachmentModel.Edges.Item.UID
+	}
+
+	return a
+}
@@ -0,0 +1,121 @@
+package todoadapter
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/comment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+)
+
+// StoreComment stores a comment.
+func (s EntStore) StoreComment(ctx context.Context, c todo.Comment) error {
+	existing, err := s.db(ctx).Comment.Query().Where(comment.UID(c.ID)).First(ctx)
+	if ent.IsNotFound(err) {
+		itemID, err := s.db(ctx).TodoItem.Query().Where(todoitem.UID(c.ItemID)).OnlyID(ctx)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+
+		_, err = s.db(ctx).Comment.Create().
+			SetUID(c.ID).
+			SetItemID(itemID).
+			SetAuthor(c.Author).
+			SetBody(c.Body).
+			SetMentions(c.Mentions).
+			SetEdits(c.Edits).
+			SetCreatedAt(c.CreatedAt).
+			SetNillableUpdatedAt(c.UpdatedAt).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	update := s.db(ctx).Comment.UpdateOneID(existing.ID).
+		SetBody(c.Body).
+		SetMentions(c.Mentions).
+		SetEdits(c.Edits)
+
+	if c.UpdatedAt != nil {
+		update.SetUpdatedAt(*c.UpdatedAt)
+	} else {
+		update.ClearUpdatedAt()
+	}
+
+	_, err = update.Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetComments returns the comments of an item, oldest first.
+func (s EntStore) GetComments(ctx context.Context, itemID string) ([]todo.Comment, error) {
+	commentModels, err := s.db(ctx).Comment.Query().
+		Where(comment.HasItemWith(todoitem.UID(itemID))).
+		Order(ent.Asc(comment.FieldCreatedAt), ent.Asc(comment.FieldUID)).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	comments := make([]todo.Comment, 0, len(commentModels))
+
+	for _, commentModel := range commentModels {
+		comments = append(comments, unmarshalComment(commentModel))
+	}
+
+	return comments, nil
+}
+
+// GetOneComment returns a single comment by its ID.
+func (s EntStore) GetOneComment(ctx context.Context, id string) (todo.Comment, error) {
+	commentModel, err := s.db(ctx).Comment.Query().Where(comment.UID(id)).WithItem().First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.Comment{}, errors.WithStack(todo.CommentNotFoundError{ID: id})
+	}
+	if err != nil {
+		return todo.Comment{}, errors.WithStack(err)
+	}
+
+	return unmarshalComment(commentModel), nil
+}
+
+// DeleteOneComment deletes a single comment by its ID.
+func (s EntStore) DeleteOneComment(ctx context.Context, id string) error {
+	_, err := s.db(ctx).Comment.Delete().Where(comment.UID(id)).Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func unmarshalComment(commentModel *ent.Comment) todo.Comment {
+	c := todo.Comment{
+		ID:        commentModel.UID,
+		Author:    commentModel.Author,
+		Body:      commentModel.Body,
+		Mentions:  commentModel.Mentions,
+		Edits:     commentModel.Edits,
+		CreatedAt: commentModel.CreatedAt,
+		UpdatedAt: commentModel.UpdatedAt,
+	}
+
+	if commentModel.Edges.Item != nil {
+		c.ItemID = commentModel.Edges.Item.UID
+	}
+
+	return c
+}
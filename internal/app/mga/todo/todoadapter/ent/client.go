@@ -9,10 +9,24 @@ import (
 
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/migrate"
 
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/attachment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/comment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/itemhistory"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/operation"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/rule"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/ruleexecution"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/shareaccess"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/tag"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/template"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -20,8 +34,34 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// Collaborator is the client for interacting with the Collaborator builders.
+	Collaborator *CollaboratorClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// ItemHistory is the client for interacting with the ItemHistory builders.
+	ItemHistory *ItemHistoryClient
+	// Operation is the client for interacting with the Operation builders.
+	Operation *OperationClient
+	// Rule is the client for interacting with the Rule builders.
+	Rule *RuleClient
+	// RuleExecution is the client for interacting with the RuleExecution builders.
+	RuleExecution *RuleExecutionClient
+	// ShareAccess is the client for interacting with the ShareAccess builders.
+	ShareAccess *ShareAccessClient
+	// ShareToken is the client for interacting with the ShareToken builders.
+	ShareToken *ShareTokenClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// Template is the client for interacting with the Template builders.
+	Template *TemplateClient
+	// TimeEntry is the client for interacting with the TimeEntry builders.
+	TimeEntry *TimeEntryClient
 	// TodoItem is the client for interacting with the TodoItem builders.
 	TodoItem *TodoItemClient
+	// TodoList is the client for interacting with the TodoList builders.
+	TodoList *TodoListClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -35,7 +75,20 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Attachment = NewAttachmentClient(c.config)
+	c.Collaborator = NewCollaboratorClient(c.config)
+	c.Comment = NewCommentClient(c.config)
+	c.ItemHistory = NewItemHistoryClient(c.config)
+	c.Operation = NewOperationClient(c.config)
+	c.Rule = NewRuleClient(c.config)
+	c.RuleExecution = NewRuleExecutionClient(c.config)
+	c.ShareAccess = NewShareAccessClient(c.config)
+	c.ShareToken = NewShareTokenClient(c.config)
+	c.Tag = NewTagClient(c.config)
+	c.Template = NewTemplateClient(c.config)
+	c.TimeEntry = NewTimeEntryClient(c.config)
 	c.TodoItem = NewTodoItemClient(c.config)
+	c.TodoList = NewTodoListClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -67,9 +120,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		TodoItem: NewTodoItemClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Attachment:    NewAttachmentClient(cfg),
+		Collaborator:  NewCollaboratorClient(cfg),
+		Comment:       NewCommentClient(cfg),
+		ItemHistory:   NewItemHistoryClient(cfg),
+		Operation:     NewOperationClient(cfg),
+		Rule:          NewRuleClient(cfg),
+		RuleExecution: NewRuleExecutionClient(cfg),
+		ShareAccess:   NewShareAccessClient(cfg),
+		ShareToken:    NewShareTokenClient(cfg),
+		Tag:           NewTagClient(cfg),
+		Template:      NewTemplateClient(cfg),
+		TimeEntry:     NewTimeEntryClient(cfg),
+		TodoItem:      NewTodoItemClient(cfg),
+		TodoList:      NewTodoListClient(cfg),
 	}, nil
 }
 
@@ -87,8 +153,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		config:   cfg,
-		TodoItem: NewTodoItemClient(cfg),
+		config:        cfg,
+		Attachment:    NewAttachmentClient(cfg),
+		Collaborator:  NewCollaboratorClient(cfg),
+		Comment:       NewCommentClient(cfg),
+		ItemHistory:   NewItemHistoryClient(cfg),
+		Operation:     NewOperationClient(cfg),
+		Rule:          NewRuleClient(cfg),
+		RuleExecution: NewRuleExecutionClient(cfg),
+		ShareAccess:   NewShareAccessClient(cfg),
+		ShareToken:    NewShareTokenClient(cfg),
+		Tag:           NewTagClient(cfg),
+		Template:      NewTemplateClient(cfg),
+		TimeEntry:     NewTimeEntryClient(cfg),
+		TodoItem:      NewTodoItemClient(cfg),
+		TodoList:      NewTodoListClient(cfg),
 	}, nil
 }
 
@@ -118,7 +197,1196 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Attachment.Use(hooks...)
+	c.Collaborator.Use(hooks...)
+	c.Comment.Use(hooks...)
+	c.ItemHistory.Use(hooks...)
+	c.Operation.Use(hooks...)
+	c.Rule.Use(hooks...)
+	c.RuleExecution.Use(hooks...)
+	c.ShareAccess.Use(hooks...)
+	c.ShareToken.Use(hooks...)
+	c.Tag.Use(hooks...)
+	c.Template.Use(hooks...)
+	c.TimeEntry.Use(hooks...)
 	c.TodoItem.Use(hooks...)
+	c.TodoList.Use(hooks...)
+}
+
+// AttachmentClient is a client for the Attachment schema.
+type AttachmentClient struct {
+	config
+}
+
+// NewAttachmentClient returns a client for the Attachment from the given config.
+func NewAttachmentClient(c config) *AttachmentClient {
+	return &AttachmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attachment.Hooks(f(g(h())))`.
+func (c *AttachmentClient) Use(hooks ...Hook) {
+	c.hooks.Attachment = append(c.hooks.Attachment, hooks...)
+}
+
+// Create returns a create builder for Attachment.
+func (c *AttachmentClient) Create() *AttachmentCreate {
+	mutation := newAttachmentMutation(c.config, OpCreate)
+	return &AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Attachment entities.
+func (c *AttachmentClient) CreateBulk(builders ...*AttachmentCreate) *AttachmentCreateBulk {
+	return &AttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Attachment.
+func (c *AttachmentClient) Update() *AttachmentUpdate {
+	mutation := newAttachmentMutation(c.config, OpUpdate)
+	return &AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttachmentClient) UpdateOne(a *Attachment) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachment(a))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttachmentClient) UpdateOneID(id int) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachmentID(id))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Attachment.
+func (c *AttachmentClient) Delete() *AttachmentDelete {
+	mutation := newAttachmentMutation(c.config, OpDelete)
+	return &AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AttachmentClient) DeleteOne(a *Attachment) *AttachmentDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AttachmentClient) DeleteOneID(id int) *AttachmentDeleteOne {
+	builder := c.Delete().Where(attachment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttachmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Attachment.
+func (c *AttachmentClient) Query() *AttachmentQuery {
+	return &AttachmentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Attachment entity by its id.
+func (c *AttachmentClient) Get(ctx context.Context, id int) (*Attachment, error) {
+	return c.Query().Where(attachment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttachmentClient) GetX(ctx context.Context, id int) *Attachment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a Attachment.
+func (c *AttachmentClient) QueryItem(a *Attachment) *TodoItemQuery {
+	query := &TodoItemQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attachment.Table, attachment.FieldID, id),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attachment.ItemTable, attachment.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttachmentClient) Hooks() []Hook {
+	return c.hooks.Attachment
+}
+
+// CollaboratorClient is a client for the Collaborator schema.
+type CollaboratorClient struct {
+	config
+}
+
+// NewCollaboratorClient returns a client for the Collaborator from the given config.
+func NewCollaboratorClient(c config) *CollaboratorClient {
+	return &CollaboratorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `collaborator.Hooks(f(g(h())))`.
+func (c *CollaboratorClient) Use(hooks ...Hook) {
+	c.hooks.Collaborator = append(c.hooks.Collaborator, hooks...)
+}
+
+// Create returns a create builder for Collaborator.
+func (c *CollaboratorClient) Create() *CollaboratorCreate {
+	mutation := newCollaboratorMutation(c.config, OpCreate)
+	return &CollaboratorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Collaborator entities.
+func (c *CollaboratorClient) CreateBulk(builders ...*CollaboratorCreate) *CollaboratorCreateBulk {
+	return &CollaboratorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Collaborator.
+func (c *CollaboratorClient) Update() *CollaboratorUpdate {
+	mutation := newCollaboratorMutation(c.config, OpUpdate)
+	return &CollaboratorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollaboratorClient) UpdateOne(co *Collaborator) *CollaboratorUpdateOne {
+	mutation := newCollaboratorMutation(c.config, OpUpdateOne, withCollaborator(co))
+	return &CollaboratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CollaboratorClient) UpdateOneID(id int) *CollaboratorUpdateOne {
+	mutation := newCollaboratorMutation(c.config, OpUpdateOne, withCollaboratorID(id))
+	return &CollaboratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Collaborator.
+func (c *CollaboratorClient) Delete() *CollaboratorDelete {
+	mutation := newCollaboratorMutation(c.config, OpDelete)
+	return &CollaboratorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *CollaboratorClient) DeleteOne(co *Collaborator) *CollaboratorDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *CollaboratorClient) DeleteOneID(id int) *CollaboratorDeleteOne {
+	builder := c.Delete().Where(collaborator.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CollaboratorDeleteOne{builder}
+}
+
+// Query returns a query builder for Collaborator.
+func (c *CollaboratorClient) Query() *CollaboratorQuery {
+	return &CollaboratorQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Collaborator entity by its id.
+func (c *CollaboratorClient) Get(ctx context.Context, id int) (*Collaborator, error) {
+	return c.Query().Where(collaborator.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CollaboratorClient) GetX(ctx context.Context, id int) *Collaborator {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryList queries the list edge of a Collaborator.
+func (c *CollaboratorClient) QueryList(co *Collaborator) *TodoListQuery {
+	query := &TodoListQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collaborator.Table, collaborator.FieldID, id),
+			sqlgraph.To(todolist.Table, todolist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, collaborator.ListTable, collaborator.ListColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CollaboratorClient) Hooks() []Hook {
+	return c.hooks.Collaborator
+}
+
+// CommentClient is a client for the Comment schema.
+type CommentClient struct {
+	config
+}
+
+// NewCommentClient returns a client for the Comment from the given config.
+func NewCommentClient(c config) *CommentClient {
+	return &CommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
+func (c *CommentClient) Use(hooks ...Hook) {
+	c.hooks.Comment = append(c.hooks.Comment, hooks...)
+}
+
+// Create returns a create builder for Comment.
+func (c *CommentClient) Create() *CommentCreate {
+	mutation := newCommentMutation(c.config, OpCreate)
+	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Comment entities.
+func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
+	return &CommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Comment.
+func (c *CommentClient) Update() *CommentUpdate {
+	mutation := newCommentMutation(c.config, OpUpdate)
+	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommentClient) UpdateOne(co *Comment) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(co))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommentClient) UpdateOneID(id int) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Comment.
+func (c *CommentClient) Delete() *CommentDelete {
+	mutation := newCommentMutation(c.config, OpDelete)
+	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *CommentClient) DeleteOne(co *Comment) *CommentDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *CommentClient) DeleteOneID(id int) *CommentDeleteOne {
+	builder := c.Delete().Where(comment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentDeleteOne{builder}
+}
+
+// Query returns a query builder for Comment.
+func (c *CommentClient) Query() *CommentQuery {
+	return &CommentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Comment entity by its id.
+func (c *CommentClient) Get(ctx context.Context, id int) (*Comment, error) {
+	return c.Query().Where(comment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a Comment.
+func (c *CommentClient) QueryItem(co *Comment) *TodoItemQuery {
+	query := &TodoItemQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.ItemTable, comment.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CommentClient) Hooks() []Hook {
+	return c.hooks.Comment
+}
+
+// ItemHistoryClient is a client for the ItemHistory schema.
+type ItemHistoryClient struct {
+	config
+}
+
+// NewItemHistoryClient returns a client for the ItemHistory from the given config.
+func NewItemHistoryClient(c config) *ItemHistoryClient {
+	return &ItemHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemhistory.Hooks(f(g(h())))`.
+func (c *ItemHistoryClient) Use(hooks ...Hook) {
+	c.hooks.ItemHistory = append(c.hooks.ItemHistory, hooks...)
+}
+
+// Create returns a create builder for ItemHistory.
+func (c *ItemHistoryClient) Create() *ItemHistoryCreate {
+	mutation := newItemHistoryMutation(c.config, OpCreate)
+	return &ItemHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemHistory entities.
+func (c *ItemHistoryClient) CreateBulk(builders ...*ItemHistoryCreate) *ItemHistoryCreateBulk {
+	return &ItemHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemHistory.
+func (c *ItemHistoryClient) Update() *ItemHistoryUpdate {
+	mutation := newItemHistoryMutation(c.config, OpUpdate)
+	return &ItemHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemHistoryClient) UpdateOne(ih *ItemHistory) *ItemHistoryUpdateOne {
+	mutation := newItemHistoryMutation(c.config, OpUpdateOne, withItemHistory(ih))
+	return &ItemHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemHistoryClient) UpdateOneID(id int) *ItemHistoryUpdateOne {
+	mutation := newItemHistoryMutation(c.config, OpUpdateOne, withItemHistoryID(id))
+	return &ItemHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemHistory.
+func (c *ItemHistoryClient) Delete() *ItemHistoryDelete {
+	mutation := newItemHistoryMutation(c.config, OpDelete)
+	return &ItemHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ItemHistoryClient) DeleteOne(ih *ItemHistory) *ItemHistoryDeleteOne {
+	return c.DeleteOneID(ih.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ItemHistoryClient) DeleteOneID(id int) *ItemHistoryDeleteOne {
+	builder := c.Delete().Where(itemhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemHistory.
+func (c *ItemHistoryClient) Query() *ItemHistoryQuery {
+	return &ItemHistoryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ItemHistory entity by its id.
+func (c *ItemHistoryClient) Get(ctx context.Context, id int) (*ItemHistory, error) {
+	return c.Query().Where(itemhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemHistoryClient) GetX(ctx context.Context, id int) *ItemHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ItemHistoryClient) Hooks() []Hook {
+	return c.hooks.ItemHistory
+}
+
+// OperationClient is a client for the Operation schema.
+type OperationClient struct {
+	config
+}
+
+// NewOperationClient returns a client for the Operation from the given config.
+func NewOperationClient(c config) *OperationClient {
+	return &OperationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `operation.Hooks(f(g(h())))`.
+func (c *OperationClient) Use(hooks ...Hook) {
+	c.hooks.Operation = append(c.hooks.Operation, hooks...)
+}
+
+// Create returns a create builder for Operation.
+func (c *OperationClient) Create() *OperationCreate {
+	mutation := newOperationMutation(c.config, OpCreate)
+	return &OperationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Operation entities.
+func (c *OperationClient) CreateBulk(builders ...*OperationCreate) *OperationCreateBulk {
+	return &OperationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Operation.
+func (c *OperationClient) Update() *OperationUpdate {
+	mutation := newOperationMutation(c.config, OpUpdate)
+	return &OperationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OperationClient) UpdateOne(o *Operation) *OperationUpdateOne {
+	mutation := newOperationMutation(c.config, OpUpdateOne, withOperation(o))
+	return &OperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OperationClient) UpdateOneID(id int) *OperationUpdateOne {
+	mutation := newOperationMutation(c.config, OpUpdateOne, withOperationID(id))
+	return &OperationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Operation.
+func (c *OperationClient) Delete() *OperationDelete {
+	mutation := newOperationMutation(c.config, OpDelete)
+	return &OperationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *OperationClient) DeleteOne(o *Operation) *OperationDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *OperationClient) DeleteOneID(id int) *OperationDeleteOne {
+	builder := c.Delete().Where(operation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OperationDeleteOne{builder}
+}
+
+// Query returns a query builder for Operation.
+func (c *OperationClient) Query() *OperationQuery {
+	return &OperationQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Operation entity by its id.
+func (c *OperationClient) Get(ctx context.Context, id int) (*Operation, error) {
+	return c.Query().Where(operation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OperationClient) GetX(ctx context.Context, id int) *Operation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OperationClient) Hooks() []Hook {
+	return c.hooks.Operation
+}
+
+// RuleClient is a client for the Rule schema.
+type RuleClient struct {
+	config
+}
+
+// NewRuleClient returns a client for the Rule from the given config.
+func NewRuleClient(c config) *RuleClient {
+	return &RuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rule.Hooks(f(g(h())))`.
+func (c *RuleClient) Use(hooks ...Hook) {
+	c.hooks.Rule = append(c.hooks.Rule, hooks...)
+}
+
+// Create returns a create builder for Rule.
+func (c *RuleClient) Create() *RuleCreate {
+	mutation := newRuleMutation(c.config, OpCreate)
+	return &RuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Rule entities.
+func (c *RuleClient) CreateBulk(builders ...*RuleCreate) *RuleCreateBulk {
+	return &RuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Rule.
+func (c *RuleClient) Update() *RuleUpdate {
+	mutation := newRuleMutation(c.config, OpUpdate)
+	return &RuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RuleClient) UpdateOne(r *Rule) *RuleUpdateOne {
+	mutation := newRuleMutation(c.config, OpUpdateOne, withRule(r))
+	return &RuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RuleClient) UpdateOneID(id int) *RuleUpdateOne {
+	mutation := newRuleMutation(c.config, OpUpdateOne, withRuleID(id))
+	return &RuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Rule.
+func (c *RuleClient) Delete() *RuleDelete {
+	mutation := newRuleMutation(c.config, OpDelete)
+	return &RuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *RuleClient) DeleteOne(r *Rule) *RuleDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *RuleClient) DeleteOneID(id int) *RuleDeleteOne {
+	builder := c.Delete().Where(rule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RuleDeleteOne{builder}
+}
+
+// Query returns a query builder for Rule.
+func (c *RuleClient) Query() *RuleQuery {
+	return &RuleQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Rule entity by its id.
+func (c *RuleClient) Get(ctx context.Context, id int) (*Rule, error) {
+	return c.Query().Where(rule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RuleClient) GetX(ctx context.Context, id int) *Rule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RuleClient) Hooks() []Hook {
+	return c.hooks.Rule
+}
+
+// RuleExecutionClient is a client for the RuleExecution schema.
+type RuleExecutionClient struct {
+	config
+}
+
+// NewRuleExecutionClient returns a client for the RuleExecution from the given config.
+func NewRuleExecutionClient(c config) *RuleExecutionClient {
+	return &RuleExecutionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ruleexecution.Hooks(f(g(h())))`.
+func (c *RuleExecutionClient) Use(hooks ...Hook) {
+	c.hooks.RuleExecution = append(c.hooks.RuleExecution, hooks...)
+}
+
+// Create returns a create builder for RuleExecution.
+func (c *RuleExecutionClient) Create() *RuleExecutionCreate {
+	mutation := newRuleExecutionMutation(c.config, OpCreate)
+	return &RuleExecutionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RuleExecution entities.
+func (c *RuleExecutionClient) CreateBulk(builders ...*RuleExecutionCreate) *RuleExecutionCreateBulk {
+	return &RuleExecutionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RuleExecution.
+func (c *RuleExecutionClient) Update() *RuleExecutionUpdate {
+	mutation := newRuleExecutionMutation(c.config, OpUpdate)
+	return &RuleExecutionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RuleExecutionClient) UpdateOne(re *RuleExecution) *RuleExecutionUpdateOne {
+	mutation := newRuleExecutionMutation(c.config, OpUpdateOne, withRuleExecution(re))
+	return &RuleExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RuleExecutionClient) UpdateOneID(id int) *RuleExecutionUpdateOne {
+	mutation := newRuleExecutionMutation(c.config, OpUpdateOne, withRuleExecutionID(id))
+	return &RuleExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RuleExecution.
+func (c *RuleExecutionClient) Delete() *RuleExecutionDelete {
+	mutation := newRuleExecutionMutation(c.config, OpDelete)
+	return &RuleExecutionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *RuleExecutionClient) DeleteOne(re *RuleExecution) *RuleExecutionDeleteOne {
+	return c.DeleteOneID(re.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *RuleExecutionClient) DeleteOneID(id int) *RuleExecutionDeleteOne {
+	builder := c.Delete().Where(ruleexecution.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RuleExecutionDeleteOne{builder}
+}
+
+// Query returns a query builder for RuleExecution.
+func (c *RuleExecutionClient) Query() *RuleExecutionQuery {
+	return &RuleExecutionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a RuleExecution entity by its id.
+func (c *RuleExecutionClient) Get(ctx context.Context, id int) (*RuleExecution, error) {
+	return c.Query().Where(ruleexecution.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RuleExecutionClient) GetX(ctx context.Context, id int) *RuleExecution {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RuleExecutionClient) Hooks() []Hook {
+	return c.hooks.RuleExecution
+}
+
+// ShareAccessClient is a client for the ShareAccess schema.
+type ShareAccessClient struct {
+	config
+}
+
+// NewShareAccessClient returns a client for the ShareAccess from the given config.
+func NewShareAccessClient(c config) *ShareAccessClient {
+	return &ShareAccessClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shareaccess.Hooks(f(g(h())))`.
+func (c *ShareAccessClient) Use(hooks ...Hook) {
+	c.hooks.ShareAccess = append(c.hooks.ShareAccess, hooks...)
+}
+
+// Create returns a create builder for ShareAccess.
+func (c *ShareAccessClient) Create() *ShareAccessCreate {
+	mutation := newShareAccessMutation(c.config, OpCreate)
+	return &ShareAccessCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShareAccess entities.
+func (c *ShareAccessClient) CreateBulk(builders ...*ShareAccessCreate) *ShareAccessCreateBulk {
+	return &ShareAccessCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShareAccess.
+func (c *ShareAccessClient) Update() *ShareAccessUpdate {
+	mutation := newShareAccessMutation(c.config, OpUpdate)
+	return &ShareAccessUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareAccessClient) UpdateOne(sa *ShareAccess) *ShareAccessUpdateOne {
+	mutation := newShareAccessMutation(c.config, OpUpdateOne, withShareAccess(sa))
+	return &ShareAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareAccessClient) UpdateOneID(id int) *ShareAccessUpdateOne {
+	mutation := newShareAccessMutation(c.config, OpUpdateOne, withShareAccessID(id))
+	return &ShareAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShareAccess.
+func (c *ShareAccessClient) Delete() *ShareAccessDelete {
+	mutation := newShareAccessMutation(c.config, OpDelete)
+	return &ShareAccessDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ShareAccessClient) DeleteOne(sa *ShareAccess) *ShareAccessDeleteOne {
+	return c.DeleteOneID(sa.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ShareAccessClient) DeleteOneID(id int) *ShareAccessDeleteOne {
+	builder := c.Delete().Where(shareaccess.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareAccessDeleteOne{builder}
+}
+
+// Query returns a query builder for ShareAccess.
+func (c *ShareAccessClient) Query() *ShareAccessQuery {
+	return &ShareAccessQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ShareAccess entity by its id.
+func (c *ShareAccessClient) Get(ctx context.Context, id int) (*ShareAccess, error) {
+	return c.Query().Where(shareaccess.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareAccessClient) GetX(ctx context.Context, id int) *ShareAccess {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ShareAccessClient) Hooks() []Hook {
+	return c.hooks.ShareAccess
+}
+
+// ShareTokenClient is a client for the ShareToken schema.
+type ShareTokenClient struct {
+	config
+}
+
+// NewShareTokenClient returns a client for the ShareToken from the given config.
+func NewShareTokenClient(c config) *ShareTokenClient {
+	return &ShareTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharetoken.Hooks(f(g(h())))`.
+func (c *ShareTokenClient) Use(hooks ...Hook) {
+	c.hooks.ShareToken = append(c.hooks.ShareToken, hooks...)
+}
+
+// Create returns a create builder for ShareToken.
+func (c *ShareTokenClient) Create() *ShareTokenCreate {
+	mutation := newShareTokenMutation(c.config, OpCreate)
+	return &ShareTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShareToken entities.
+func (c *ShareTokenClient) CreateBulk(builders ...*ShareTokenCreate) *ShareTokenCreateBulk {
+	return &ShareTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShareToken.
+func (c *ShareTokenClient) Update() *ShareTokenUpdate {
+	mutation := newShareTokenMutation(c.config, OpUpdate)
+	return &ShareTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareTokenClient) UpdateOne(st *ShareToken) *ShareTokenUpdateOne {
+	mutation := newShareTokenMutation(c.config, OpUpdateOne, withShareToken(st))
+	return &ShareTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareTokenClient) UpdateOneID(id int) *ShareTokenUpdateOne {
+	mutation := newShareTokenMutation(c.config, OpUpdateOne, withShareTokenID(id))
+	return &ShareTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShareToken.
+func (c *ShareTokenClient) Delete() *ShareTokenDelete {
+	mutation := newShareTokenMutation(c.config, OpDelete)
+	return &ShareTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ShareTokenClient) DeleteOne(st *ShareToken) *ShareTokenDeleteOne {
+	return c.DeleteOneID(st.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ShareTokenClient) DeleteOneID(id int) *ShareTokenDeleteOne {
+	builder := c.Delete().Where(sharetoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ShareToken.
+func (c *ShareTokenClient) Query() *ShareTokenQuery {
+	return &ShareTokenQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ShareToken entity by its id.
+func (c *ShareTokenClient) Get(ctx context.Context, id int) (*ShareToken, error) {
+	return c.Query().Where(sharetoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareTokenClient) GetX(ctx context.Context, id int) *ShareToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryList queries the list edge of a ShareToken.
+func (c *ShareTokenClient) QueryList(st *ShareToken) *TodoListQuery {
+	query := &TodoListQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := st.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharetoken.Table, sharetoken.FieldID, id),
+			sqlgraph.To(todolist.Table, todolist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharetoken.ListTable, sharetoken.ListColumn),
+		)
+		fromV = sqlgraph.Neighbors(st.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShareTokenClient) Hooks() []Hook {
+	return c.hooks.ShareToken
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Create returns a create builder for Tag.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(t *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(t))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagClient) UpdateOneID(id int) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *TagClient) DeleteOne(t *Tag) *TagDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *TagClient) DeleteOneID(id int) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDeleteOne{builder}
+}
+
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id int) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagClient) GetX(ctx context.Context, id int) *Tag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItems queries the items edge of a Tag.
+func (c *TagClient) QueryItems(t *Tag) *TodoItemQuery {
+	query := &TodoItemQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.ItemsTable, tag.ItemsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
+}
+
+// TemplateClient is a client for the Template schema.
+type TemplateClient struct {
+	config
+}
+
+// NewTemplateClient returns a client for the Template from the given config.
+func NewTemplateClient(c config) *TemplateClient {
+	return &TemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `template.Hooks(f(g(h())))`.
+func (c *TemplateClient) Use(hooks ...Hook) {
+	c.hooks.Template = append(c.hooks.Template, hooks...)
+}
+
+// Create returns a create builder for Template.
+func (c *TemplateClient) Create() *TemplateCreate {
+	mutation := newTemplateMutation(c.config, OpCreate)
+	return &TemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Template entities.
+func (c *TemplateClient) CreateBulk(builders ...*TemplateCreate) *TemplateCreateBulk {
+	return &TemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Template.
+func (c *TemplateClient) Update() *TemplateUpdate {
+	mutation := newTemplateMutation(c.config, OpUpdate)
+	return &TemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TemplateClient) UpdateOne(t *Template) *TemplateUpdateOne {
+	mutation := newTemplateMutation(c.config, OpUpdateOne, withTemplate(t))
+	return &TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TemplateClient) UpdateOneID(id int) *TemplateUpdateOne {
+	mutation := newTemplateMutation(c.config, OpUpdateOne, withTemplateID(id))
+	return &TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Template.
+func (c *TemplateClient) Delete() *TemplateDelete {
+	mutation := newTemplateMutation(c.config, OpDelete)
+	return &TemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *TemplateClient) DeleteOne(t *Template) *TemplateDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *TemplateClient) DeleteOneID(id int) *TemplateDeleteOne {
+	builder := c.Delete().Where(template.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for Template.
+func (c *TemplateClient) Query() *TemplateQuery {
+	return &TemplateQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Template entity by its id.
+func (c *TemplateClient) Get(ctx context.Context, id int) (*Template, error) {
+	return c.Query().Where(template.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TemplateClient) GetX(ctx context.Context, id int) *Template {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TemplateClient) Hooks() []Hook {
+	return c.hooks.Template
+}
+
+// TimeEntryClient is a client for the TimeEntry schema.
+type TimeEntryClient struct {
+	config
+}
+
+// NewTimeEntryClient returns a client for the TimeEntry from the given config.
+func NewTimeEntryClient(c config) *TimeEntryClient {
+	return &TimeEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `timeentry.Hooks(f(g(h())))`.
+func (c *TimeEntryClient) Use(hooks ...Hook) {
+	c.hooks.TimeEntry = append(c.hooks.TimeEntry, hooks...)
+}
+
+// Create returns a create builder for TimeEntry.
+func (c *TimeEntryClient) Create() *TimeEntryCreate {
+	mutation := newTimeEntryMutation(c.config, OpCreate)
+	return &TimeEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TimeEntry entities.
+func (c *TimeEntryClient) CreateBulk(builders ...*TimeEntryCreate) *TimeEntryCreateBulk {
+	return &TimeEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TimeEntry.
+func (c *TimeEntryClient) Update() *TimeEntryUpdate {
+	mutation := newTimeEntryMutation(c.config, OpUpdate)
+	return &TimeEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TimeEntryClient) UpdateOne(te *TimeEntry) *TimeEntryUpdateOne {
+	mutation := newTimeEntryMutation(c.config, OpUpdateOne, withTimeEntry(te))
+	return &TimeEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TimeEntryClient) UpdateOneID(id int) *TimeEntryUpdateOne {
+	mutation := newTimeEntryMutation(c.config, OpUpdateOne, withTimeEntryID(id))
+	return &TimeEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TimeEntry.
+func (c *TimeEntryClient) Delete() *TimeEntryDelete {
+	mutation := newTimeEntryMutation(c.config, OpDelete)
+	return &TimeEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *TimeEntryClient) DeleteOne(te *TimeEntry) *TimeEntryDeleteOne {
+	return c.DeleteOneID(te.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *TimeEntryClient) DeleteOneID(id int) *TimeEntryDeleteOne {
+	builder := c.Delete().Where(timeentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TimeEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for TimeEntry.
+func (c *TimeEntryClient) Query() *TimeEntryQuery {
+	return &TimeEntryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a TimeEntry entity by its id.
+func (c *TimeEntryClient) Get(ctx context.Context, id int) (*TimeEntry, error) {
+	return c.Query().Where(timeentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TimeEntryClient) GetX(ctx context.Context, id int) *TimeEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a TimeEntry.
+func (c *TimeEntryClient) QueryItem(te *TimeEntry) *TodoItemQuery {
+	query := &TodoItemQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := te.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(timeentry.Table, timeentry.FieldID, id),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, timeentry.ItemTable, timeentry.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(te.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TimeEntryClient) Hooks() []Hook {
+	return c.hooks.TimeEntry
 }
 
 // TodoItemClient is a client for the TodoItem schema.
@@ -206,7 +1474,257 @@ func (c *TodoItemClient) GetX(ctx context.Context, id int) *TodoItem {
 	return obj
 }
 
+// QueryList queries the list edge of a TodoItem.
+func (c *TodoItemClient) QueryList(ti *TodoItem) *TodoListQuery {
+	query := &TodoListQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ti.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, id),
+			sqlgraph.To(todolist.Table, todolist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todoitem.ListTable, todoitem.ListColumn),
+		)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a TodoItem.
+func (c *TodoItemClient) QueryParent(ti *TodoItem) *TodoItemQuery {
+	query := &TodoItemQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ti.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, id),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todoitem.ParentTable, todoitem.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a TodoItem.
+func (c *TodoItemClient) QueryChildren(ti *TodoItem) *TodoItemQuery {
+	query := &TodoItemQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ti.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, id),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoitem.ChildrenTable, todoitem.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a TodoItem.
+func (c *TodoItemClient) QueryTags(ti *TodoItem) *TagQuery {
+	query := &TagQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ti.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, todoitem.TagsTable, todoitem.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a TodoItem.
+func (c *TodoItemClient) QueryComments(ti *TodoItem) *CommentQuery {
+	query := &CommentQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ti.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoitem.CommentsTable, todoitem.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTimeEntries queries the time_entries edge of a TodoItem.
+func (c *TodoItemClient) QueryTimeEntries(ti *TodoItem) *TimeEntryQuery {
+	query := &TimeEntryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ti.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, id),
+			sqlgraph.To(timeentry.Table, timeentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoitem.TimeEntriesTable, todoitem.TimeEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a TodoItem.
+func (c *TodoItemClient) QueryAttachments(ti *TodoItem) *AttachmentQuery {
+	query := &AttachmentQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ti.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, id),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoitem.AttachmentsTable, todoitem.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TodoItemClient) Hooks() []Hook {
 	return c.hooks.TodoItem
 }
+
+// TodoListClient is a client for the TodoList schema.
+type TodoListClient struct {
+	config
+}
+
+// NewTodoListClient returns a client for the TodoList from the given config.
+func NewTodoListClient(c config) *TodoListClient {
+	return &TodoListClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todolist.Hooks(f(g(h())))`.
+func (c *TodoListClient) Use(hooks ...Hook) {
+	c.hooks.TodoList = append(c.hooks.TodoList, hooks...)
+}
+
+// Create returns a create builder for TodoList.
+func (c *TodoListClient) Create() *TodoListCreate {
+	mutation := newTodoListMutation(c.config, OpCreate)
+	return &TodoListCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoList entities.
+func (c *TodoListClient) CreateBulk(builders ...*TodoListCreate) *TodoListCreateBulk {
+	return &TodoListCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoList.
+func (c *TodoListClient) Update() *TodoListUpdate {
+	mutation := newTodoListMutation(c.config, OpUpdate)
+	return &TodoListUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoListClient) UpdateOne(tl *TodoList) *TodoListUpdateOne {
+	mutation := newTodoListMutation(c.config, OpUpdateOne, withTodoList(tl))
+	return &TodoListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoListClient) UpdateOneID(id int) *TodoListUpdateOne {
+	mutation := newTodoListMutation(c.config, OpUpdateOne, withTodoListID(id))
+	return &TodoListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TodoList.
+func (c *TodoListClient) Delete() *TodoListDelete {
+	mutation := newTodoListMutation(c.config, OpDelete)
+	return &TodoListDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *TodoListClient) DeleteOne(tl *TodoList) *TodoListDeleteOne {
+	return c.DeleteOneID(tl.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *TodoListClient) DeleteOneID(id int) *TodoListDeleteOne {
+	builder := c.Delete().Where(todolist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoListDeleteOne{builder}
+}
+
+// Query returns a query builder for TodoList.
+func (c *TodoListClient) Query() *TodoListQuery {
+	return &TodoListQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a TodoList entity by its id.
+func (c *TodoListClient) Get(ctx context.Context, id int) (*TodoList, error) {
+	return c.Query().Where(todolist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoListClient) GetX(ctx context.Context, id int) *TodoList {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItems queries the items edge of a TodoList.
+func (c *TodoListClient) QueryItems(tl *TodoList) *TodoItemQuery {
+	query := &TodoItemQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := tl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, id),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todolist.ItemsTable, todolist.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(tl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShareTokens queries the share_tokens edge of a TodoList.
+func (c *TodoListClient) QueryShareTokens(tl *TodoList) *ShareTokenQuery {
+	query := &ShareTokenQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := tl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, id),
+			sqlgraph.To(sharetoken.Table, sharetoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todolist.ShareTokensTable, todolist.ShareTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(tl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCollaborators queries the collaborators edge of a TodoList.
+func (c *TodoListClient) QueryCollaborators(tl *TodoList) *CollaboratorQuery {
+	query := &CollaboratorQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := tl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, id),
+			sqlgraph.To(collaborator.Table, collaborator.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todolist.CollaboratorsTable, todolist.CollaboratorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(tl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TodoListClient) Hooks() []Hook {
+	return c.hooks.TodoList
+}
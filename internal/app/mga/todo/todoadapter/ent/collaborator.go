@@ -0,0 +1,184 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// Collaborator is the model entity for the Collaborator schema.
+type Collaborator struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// InvitedBy holds the value of the "invited_by" field.
+	InvitedBy string `json:"invited_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CollaboratorQuery when eager-loading is set.
+	Edges                   CollaboratorEdges `json:"edges"`
+	todo_list_collaborators *int
+}
+
+// CollaboratorEdges holds the relations/edges for other nodes in the graph.
+type CollaboratorEdges struct {
+	// List holds the value of the list edge.
+	List *TodoList `json:"list,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ListOrErr returns the List value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CollaboratorEdges) ListOrErr() (*TodoList, error) {
+	if e.loadedTypes[0] {
+		if e.List == nil {
+			// The edge list was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todolist.Label}
+		}
+		return e.List, nil
+	}
+	return nil, &NotLoadedError{edge: "list"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Collaborator) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case collaborator.FieldID:
+			values[i] = new(sql.NullInt64)
+		case collaborator.FieldUID, collaborator.FieldEmail, collaborator.FieldRole, collaborator.FieldInvitedBy:
+			values[i] = new(sql.NullString)
+		case collaborator.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case collaborator.ForeignKeys[0]: // todo_list_collaborators
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Collaborator", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Collaborator fields.
+func (c *Collaborator) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case collaborator.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case collaborator.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				c.UID = value.String
+			}
+		case collaborator.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				c.Email = value.String
+			}
+		case collaborator.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				c.Role = value.String
+			}
+		case collaborator.FieldInvitedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by", values[i])
+			} else if value.Valid {
+				c.InvitedBy = value.String
+			}
+		case collaborator.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case collaborator.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field todo_list_collaborators", value)
+			} else if value.Valid {
+				c.todo_list_collaborators = new(int)
+				*c.todo_list_collaborators = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryList queries the "list" edge of the Collaborator entity.
+func (c *Collaborator) QueryList() *TodoListQuery {
+	return (&CollaboratorClient{config: c.config}).QueryList(c)
+}
+
+// Update returns a builder for updating this Collaborator.
+// Note that you need to call Collaborator.Unwrap() before calling this method if this Collaborator
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Collaborator) Update() *CollaboratorUpdateOne {
+	return (&CollaboratorClient{config: c.config}).UpdateOne(c)
+}
+
+// Unwrap unwraps the Collaborator entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Collaborator) Unwrap() *Collaborator {
+	tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Collaborator is not a transactional entity")
+	}
+	c.config.driver = tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Collaborator) String() string {
+	var builder strings.Builder
+	builder.WriteString("Collaborator(")
+	builder.WriteString(fmt.Sprintf("id=%v", c.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(c.UID)
+	builder.WriteString(", email=")
+	builder.WriteString(c.Email)
+	builder.WriteString(", role=")
+	builder.WriteString(c.Role)
+	builder.WriteString(", invited_by=")
+	builder.WriteString(c.InvitedBy)
+	builder.WriteString(", created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Collaborators is a parsable slice of Collaborator.
+type Collaborators []*Collaborator
+
+func (c Collaborators) config(cfg config) {
+	for _i := range c {
+		c[_i].config = cfg
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by entc, DO NOT EDIT.
+
+package collaborator
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the collaborator type in the database.
+	Label = "collaborator"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldInvitedBy holds the string denoting the invited_by field in the database.
+	FieldInvitedBy = "invited_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeList holds the string denoting the list edge name in mutations.
+	EdgeList = "list"
+	// Table holds the table name of the collaborator in the database.
+	Table = "collaborators"
+	// ListTable is the table that holds the list relation/edge.
+	ListTable = "collaborators"
+	// ListInverseTable is the table name for the TodoList entity.
+	// It exists in this package in order to avoid circular dependency with the "todolist" package.
+	ListInverseTable = "todo_lists"
+	// ListColumn is the table column denoting the list relation/edge.
+	ListColumn = "todo_list_collaborators"
+)
+
+// Columns holds all SQL columns for collaborator fields.
+var Columns = []string{
+	FieldID,
+	FieldUID,
+	FieldEmail,
+	FieldRole,
+	FieldInvitedBy,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "collaborators"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"todo_list_collaborators",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	UIDValidator func(string) error
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
+	// DefaultInvitedBy holds the default value on creation for the "invited_by" field.
+	DefaultInvitedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
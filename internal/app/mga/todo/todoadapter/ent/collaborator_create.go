@@ -0,0 +1,364 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// CollaboratorCreate is the builder for creating a Collaborator entity.
+type CollaboratorCreate struct {
+	config
+	mutation *CollaboratorMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (cc *CollaboratorCreate) SetUID(s string) *CollaboratorCreate {
+	cc.mutation.SetUID(s)
+	return cc
+}
+
+// SetEmail sets the "email" field.
+func (cc *CollaboratorCreate) SetEmail(s string) *CollaboratorCreate {
+	cc.mutation.SetEmail(s)
+	return cc
+}
+
+// SetRole sets the "role" field.
+func (cc *CollaboratorCreate) SetRole(s string) *CollaboratorCreate {
+	cc.mutation.SetRole(s)
+	return cc
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (cc *CollaboratorCreate) SetInvitedBy(s string) *CollaboratorCreate {
+	cc.mutation.SetInvitedBy(s)
+	return cc
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (cc *CollaboratorCreate) SetNillableInvitedBy(s *string) *CollaboratorCreate {
+	if s != nil {
+		cc.SetInvitedBy(*s)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CollaboratorCreate) SetCreatedAt(t time.Time) *CollaboratorCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CollaboratorCreate) SetNillableCreatedAt(t *time.Time) *CollaboratorCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetListID sets the "list" edge to the TodoList entity by ID.
+func (cc *CollaboratorCreate) SetListID(id int) *CollaboratorCreate {
+	cc.mutation.SetListID(id)
+	return cc
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (cc *CollaboratorCreate) SetList(t *TodoList) *CollaboratorCreate {
+	return cc.SetListID(t.ID)
+}
+
+// Mutation returns the CollaboratorMutation object of the builder.
+func (cc *CollaboratorCreate) Mutation() *CollaboratorMutation {
+	return cc.mutation
+}
+
+// Save creates the Collaborator in the database.
+func (cc *CollaboratorCreate) Save(ctx context.Context) (*Collaborator, error) {
+	var (
+		err  error
+		node *Collaborator
+	)
+	cc.defaults()
+	if len(cc.hooks) == 0 {
+		if err = cc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CollaboratorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cc.check(); err != nil {
+				return nil, err
+			}
+			cc.mutation = mutation
+			if node, err = cc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cc.hooks) - 1; i >= 0; i-- {
+			if cc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CollaboratorCreate) SaveX(ctx context.Context) *Collaborator {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CollaboratorCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CollaboratorCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CollaboratorCreate) defaults() {
+	if _, ok := cc.mutation.InvitedBy(); !ok {
+		v := collaborator.DefaultInvitedBy
+		cc.mutation.SetInvitedBy(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := collaborator.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CollaboratorCreate) check() error {
+	if _, ok := cc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := cc.mutation.UID(); ok {
+		if err := collaborator.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "email"`)}
+	}
+	if v, ok := cc.mutation.Email(); ok {
+		if err := collaborator.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "email": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "role"`)}
+	}
+	if _, ok := cc.mutation.InvitedBy(); !ok {
+		return &ValidationError{Name: "invited_by", err: errors.New(`ent: missing required field "invited_by"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
+	}
+	if _, ok := cc.mutation.ListID(); !ok {
+		return &ValidationError{Name: "list", err: errors.New("ent: missing required edge \"list\"")}
+	}
+	return nil
+}
+
+func (cc *CollaboratorCreate) sqlSave(ctx context.Context) (*Collaborator, error) {
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (cc *CollaboratorCreate) createSpec() (*Collaborator, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Collaborator{config: cc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: collaborator.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: collaborator.FieldID,
+			},
+		}
+	)
+	if value, ok := cc.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: collaborator.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := cc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: collaborator.FieldEmail,
+		})
+		_node.Email = value
+	}
+	if value, ok := cc.mutation.Role(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: collaborator.FieldRole,
+		})
+		_node.Role = value
+	}
+	if value, ok := cc.mutation.InvitedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: collaborator.FieldInvitedBy,
+		})
+		_node.InvitedBy = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: collaborator.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := cc.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collaborator.ListTable,
+			Columns: []string{collaborator.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.todo_list_collaborators = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CollaboratorCreateBulk is the builder for creating many Collaborator entities in bulk.
+type CollaboratorCreateBulk struct {
+	config
+	builders []*CollaboratorCreate
+}
+
+// Save creates the Collaborator entities in the database.
+func (ccb *CollaboratorCreateBulk) Save(ctx context.Context) ([]*Collaborator, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Collaborator, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CollaboratorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CollaboratorCreateBulk) SaveX(ctx context.Context) []*Collaborator {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CollaboratorCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CollaboratorCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
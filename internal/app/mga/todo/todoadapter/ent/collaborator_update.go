@@ -0,0 +1,398 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// CollaboratorUpdate is the builder for updating Collaborator entities.
+type CollaboratorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CollaboratorMutation
+}
+
+// Where appends a list predicates to the CollaboratorUpdate builder.
+func (cu *CollaboratorUpdate) Where(ps ...predicate.Collaborator) *CollaboratorUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetRole sets the "role" field.
+func (cu *CollaboratorUpdate) SetRole(s string) *CollaboratorUpdate {
+	cu.mutation.SetRole(s)
+	return cu
+}
+
+// SetListID sets the "list" edge to the TodoList entity by ID.
+func (cu *CollaboratorUpdate) SetListID(id int) *CollaboratorUpdate {
+	cu.mutation.SetListID(id)
+	return cu
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (cu *CollaboratorUpdate) SetList(t *TodoList) *CollaboratorUpdate {
+	return cu.SetListID(t.ID)
+}
+
+// Mutation returns the CollaboratorMutation object of the builder.
+func (cu *CollaboratorUpdate) Mutation() *CollaboratorMutation {
+	return cu.mutation
+}
+
+// ClearList clears the "list" edge to the TodoList entity.
+func (cu *CollaboratorUpdate) ClearList() *CollaboratorUpdate {
+	cu.mutation.ClearList()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CollaboratorUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cu.hooks) == 0 {
+		if err = cu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CollaboratorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cu.check(); err != nil {
+				return 0, err
+			}
+			cu.mutation = mutation
+			affected, err = cu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cu.hooks) - 1; i >= 0; i-- {
+			if cu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CollaboratorUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CollaboratorUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CollaboratorUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CollaboratorUpdate) check() error {
+	if _, ok := cu.mutation.ListID(); cu.mutation.ListCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"list\"")
+	}
+	return nil
+}
+
+func (cu *CollaboratorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   collaborator.Table,
+			Columns: collaborator.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: collaborator.FieldID,
+			},
+		},
+	}
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: collaborator.FieldRole,
+		})
+	}
+	if cu.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collaborator.ListTable,
+			Columns: []string{collaborator.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collaborator.ListTable,
+			Columns: []string{collaborator.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collaborator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CollaboratorUpdateOne is the builder for updating a single Collaborator entity.
+type CollaboratorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CollaboratorMutation
+}
+
+// SetRole sets the "role" field.
+func (cuo *CollaboratorUpdateOne) SetRole(s string) *CollaboratorUpdateOne {
+	cuo.mutation.SetRole(s)
+	return cuo
+}
+
+// SetListID sets the "list" edge to the TodoList entity by ID.
+func (cuo *CollaboratorUpdateOne) SetListID(id int) *CollaboratorUpdateOne {
+	cuo.mutation.SetListID(id)
+	return cuo
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (cuo *CollaboratorUpdateOne) SetList(t *TodoList) *CollaboratorUpdateOne {
+	return cuo.SetListID(t.ID)
+}
+
+// Mutation returns the CollaboratorMutation object of the builder.
+func (cuo *CollaboratorUpdateOne) Mutation() *CollaboratorMutation {
+	return cuo.mutation
+}
+
+// ClearList clears the "list" edge to the TodoList entity.
+func (cuo *CollaboratorUpdateOne) ClearList() *CollaboratorUpdateOne {
+	cuo.mutation.ClearList()
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CollaboratorUpdateOne) Select(field string, fields ...string) *CollaboratorUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Collaborator entity.
+func (cuo *CollaboratorUpdateOne) Save(ctx context.Context) (*Collaborator, error) {
+	var (
+		err  error
+		node *Collaborator
+	)
+	if len(cuo.hooks) == 0 {
+		if err = cuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CollaboratorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cuo.check(); err != nil {
+				return nil, err
+			}
+			cuo.mutation = mutation
+			node, err = cuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cuo.hooks) - 1; i >= 0; i-- {
+			if cuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CollaboratorUpdateOne) SaveX(ctx context.Context) *Collaborator {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CollaboratorUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CollaboratorUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CollaboratorUpdateOne) check() error {
+	if _, ok := cuo.mutation.ListID(); cuo.mutation.ListCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"list\"")
+	}
+	return nil
+}
+
+func (cuo *CollaboratorUpdateOne) sqlSave(ctx context.Context) (_node *Collaborator, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   collaborator.Table,
+			Columns: collaborator.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: collaborator.FieldID,
+			},
+		},
+	}
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Collaborator.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collaborator.FieldID)
+		for _, f := range fields {
+			if !collaborator.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != collaborator.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: collaborator.FieldRole,
+		})
+	}
+	if cuo.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collaborator.ListTable,
+			Columns: []string{collaborator.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collaborator.ListTable,
+			Columns: []string{collaborator.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Collaborator{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collaborator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -24,7 +24,20 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	TodoItem []ent.Hook
+	Attachment    []ent.Hook
+	Collaborator  []ent.Hook
+	Comment       []ent.Hook
+	ItemHistory   []ent.Hook
+	Operation     []ent.Hook
+	Rule          []ent.Hook
+	RuleExecution []ent.Hook
+	ShareAccess   []ent.Hook
+	ShareToken    []ent.Hook
+	Tag           []ent.Hook
+	Template      []ent.Hook
+	TimeEntry     []ent.Hook
+	TodoItem      []ent.Hook
+	TodoList      []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -8,7 +8,20 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/attachment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/comment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/itemhistory"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/operation"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/rule"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/ruleexecution"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/shareaccess"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/tag"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/template"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -29,7 +42,20 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		todoitem.Table: todoitem.ValidColumn,
+		attachment.Table:    attachment.ValidColumn,
+		collaborator.Table:  collaborator.ValidColumn,
+		comment.Table:       comment.ValidColumn,
+		itemhistory.Table:   itemhistory.ValidColumn,
+		operation.Table:     operation.ValidColumn,
+		rule.Table:          rule.ValidColumn,
+		ruleexecution.Table: ruleexecution.ValidColumn,
+		shareaccess.Table:   shareaccess.ValidColumn,
+		sharetoken.Table:    sharetoken.ValidColumn,
+		tag.Table:           tag.ValidColumn,
+		template.Table:      template.ValidColumn,
+		timeentry.Table:     timeentry.ValidColumn,
+		todoitem.Table:      todoitem.ValidColumn,
+		todolist.Table:      todolist.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -9,6 +9,162 @@ import (
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
 )
 
+// The AttachmentFunc type is an adapter to allow the use of ordinary
+// function as Attachment mutator.
+type AttachmentFunc func(context.Context, *ent.AttachmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttachmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AttachmentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttachmentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The CollaboratorFunc type is an adapter to allow the use of ordinary
+// function as Collaborator mutator.
+type CollaboratorFunc func(context.Context, *ent.CollaboratorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollaboratorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.CollaboratorMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollaboratorMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The CommentFunc type is an adapter to allow the use of ordinary
+// function as Comment mutator.
+type CommentFunc func(context.Context, *ent.CommentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.CommentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ItemHistoryFunc type is an adapter to allow the use of ordinary
+// function as ItemHistory mutator.
+type ItemHistoryFunc func(context.Context, *ent.ItemHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ItemHistoryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemHistoryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The OperationFunc type is an adapter to allow the use of ordinary
+// function as Operation mutator.
+type OperationFunc func(context.Context, *ent.OperationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OperationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.OperationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OperationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The RuleFunc type is an adapter to allow the use of ordinary
+// function as Rule mutator.
+type RuleFunc func(context.Context, *ent.RuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.RuleMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RuleMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The RuleExecutionFunc type is an adapter to allow the use of ordinary
+// function as RuleExecution mutator.
+type RuleExecutionFunc func(context.Context, *ent.RuleExecutionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RuleExecutionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.RuleExecutionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RuleExecutionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ShareAccessFunc type is an adapter to allow the use of ordinary
+// function as ShareAccess mutator.
+type ShareAccessFunc func(context.Context, *ent.ShareAccessMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareAccessFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ShareAccessMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareAccessMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ShareTokenFunc type is an adapter to allow the use of ordinary
+// function as ShareToken mutator.
+type ShareTokenFunc func(context.Context, *ent.ShareTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ShareTokenMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareTokenMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary
+// function as Tag mutator.
+type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TagMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TemplateFunc type is an adapter to allow the use of ordinary
+// function as Template mutator.
+type TemplateFunc func(context.Context, *ent.TemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TemplateMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TemplateMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TimeEntryFunc type is an adapter to allow the use of ordinary
+// function as TimeEntry mutator.
+type TimeEntryFunc func(context.Context, *ent.TimeEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TimeEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TimeEntryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TimeEntryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The TodoItemFunc type is an adapter to allow the use of ordinary
 // function as TodoItem mutator.
 type TodoItemFunc func(context.Context, *ent.TodoItemMutation) (ent.Value, error)
@@ -22,6 +178,19 @@ func (f TodoItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return f(ctx, mv)
 }
 
+// The TodoListFunc type is an adapter to allow the use of ordinary
+// function as TodoList mutator.
+type TodoListFunc func(context.Context, *ent.TodoListMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TodoListFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TodoListMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TodoListMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
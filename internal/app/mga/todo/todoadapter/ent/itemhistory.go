@@ -0,0 +1,168 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/itemhistory"
+)
+
+// ItemHistory is the model entity for the ItemHistory schema.
+type ItemHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// ItemUID holds the value of the "item_uid" field.
+	ItemUID string `json:"item_uid,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation string `json:"operation,omitempty"`
+	// CorrelationID holds the value of the "correlation_id" field.
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes []todo.FieldChange `json:"changes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemHistory) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemhistory.FieldChanges:
+			values[i] = new([]byte)
+		case itemhistory.FieldID:
+			values[i] = new(sql.NullInt64)
+		case itemhistory.FieldUID, itemhistory.FieldItemUID, itemhistory.FieldActor, itemhistory.FieldOperation, itemhistory.FieldCorrelationID:
+			values[i] = new(sql.NullString)
+		case itemhistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ItemHistory", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemHistory fields.
+func (ih *ItemHistory) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemhistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ih.ID = int(value.Int64)
+		case itemhistory.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				ih.UID = value.String
+			}
+		case itemhistory.FieldItemUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_uid", values[i])
+			} else if value.Valid {
+				ih.ItemUID = value.String
+			}
+		case itemhistory.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				ih.Actor = value.String
+			}
+		case itemhistory.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				ih.Operation = value.String
+			}
+		case itemhistory.FieldCorrelationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field correlation_id", values[i])
+			} else if value.Valid {
+				ih.CorrelationID = value.String
+			}
+		case itemhistory.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ih.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case itemhistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ih.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ItemHistory.
+// Note that you need to call ItemHistory.Unwrap() before calling this method if this ItemHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ih *ItemHistory) Update() *ItemHistoryUpdateOne {
+	return (&ItemHistoryClient{config: ih.config}).UpdateOne(ih)
+}
+
+// Unwrap unwraps the ItemHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ih *ItemHistory) Unwrap() *ItemHistory {
+	tx, ok := ih.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemHistory is not a transactional entity")
+	}
+	ih.config.driver = tx.drv
+	return ih
+}
+
+// String implements the fmt.Stringer.
+func (ih *ItemHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v", ih.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(ih.UID)
+	builder.WriteString(", item_uid=")
+	builder.WriteString(ih.ItemUID)
+	builder.WriteString(", actor=")
+	builder.WriteString(ih.Actor)
+	builder.WriteString(", operation=")
+	builder.WriteString(ih.Operation)
+	builder.WriteString(", correlation_id=")
+	builder.WriteString(ih.CorrelationID)
+	builder.WriteString(", changes=")
+	builder.WriteString(fmt.Sprintf("%v", ih.Changes))
+	builder.WriteString(", created_at=")
+	builder.WriteString(ih.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemHistories is a parsable slice of ItemHistory.
+type ItemHistories []*ItemHistory
+
+func (ih ItemHistories) config(cfg config) {
+	for _i := range ih {
+		ih[_i].config = cfg
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by entc, DO NOT EDIT.
+
+package itemhistory
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the itemhistory type in the database.
+	Label = "item_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldItemUID holds the string denoting the item_uid field in the database.
+	FieldItemUID = "item_uid"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldOperation holds the string denoting the operation field in the database.
+	FieldOperation = "operation"
+	// FieldCorrelationID holds the string denoting the correlation_id field in the database.
+	FieldCorrelationID = "correlation_id"
+	// FieldChanges holds the string denoting the changes field in the database.
+	FieldChanges = "changes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the itemhistory in the database.
+	Table = "item_history"
+)
+
+// Columns holds all SQL columns for itemhistory fields.
+var Columns = []string{
+	FieldID,
+	FieldUID,
+	FieldItemUID,
+	FieldActor,
+	FieldOperation,
+	FieldCorrelationID,
+	FieldChanges,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	UIDValidator func(string) error
+	// ItemUIDValidator is a validator for the "item_uid" field. It is called by the builders before save.
+	ItemUIDValidator func(string) error
+	// DefaultActor holds the default value on creation for the "actor" field.
+	DefaultActor string
+	// DefaultCorrelationID holds the default value on creation for the "correlation_id" field.
+	DefaultCorrelationID string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,798 @@
+// Code generated by entc, DO NOT EDIT.
+
+package itemhistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUID), v))
+	})
+}
+
+// ItemUID applies equality check predicate on the "item_uid" field. It's identical to ItemUIDEQ.
+func ItemUID(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemUID), v))
+	})
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// Operation applies equality check predicate on the "operation" field. It's identical to OperationEQ.
+func Operation(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOperation), v))
+	})
+}
+
+// CorrelationID applies equality check predicate on the "correlation_id" field. It's identical to CorrelationIDEQ.
+func CorrelationID(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUID), v))
+	})
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUID), v))
+	})
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUID), v...))
+	})
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUID), v...))
+	})
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUID), v))
+	})
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUID), v))
+	})
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUID), v))
+	})
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUID), v))
+	})
+}
+
+// UIDContains applies the Contains predicate on the "uid" field.
+func UIDContains(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUID), v))
+	})
+}
+
+// UIDHasPrefix applies the HasPrefix predicate on the "uid" field.
+func UIDHasPrefix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUID), v))
+	})
+}
+
+// UIDHasSuffix applies the HasSuffix predicate on the "uid" field.
+func UIDHasSuffix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUID), v))
+	})
+}
+
+// UIDEqualFold applies the EqualFold predicate on the "uid" field.
+func UIDEqualFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUID), v))
+	})
+}
+
+// UIDContainsFold applies the ContainsFold predicate on the "uid" field.
+func UIDContainsFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUID), v))
+	})
+}
+
+// ItemUIDEQ applies the EQ predicate on the "item_uid" field.
+func ItemUIDEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDNEQ applies the NEQ predicate on the "item_uid" field.
+func ItemUIDNEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDIn applies the In predicate on the "item_uid" field.
+func ItemUIDIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldItemUID), v...))
+	})
+}
+
+// ItemUIDNotIn applies the NotIn predicate on the "item_uid" field.
+func ItemUIDNotIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldItemUID), v...))
+	})
+}
+
+// ItemUIDGT applies the GT predicate on the "item_uid" field.
+func ItemUIDGT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDGTE applies the GTE predicate on the "item_uid" field.
+func ItemUIDGTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDLT applies the LT predicate on the "item_uid" field.
+func ItemUIDLT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDLTE applies the LTE predicate on the "item_uid" field.
+func ItemUIDLTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDContains applies the Contains predicate on the "item_uid" field.
+func ItemUIDContains(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDHasPrefix applies the HasPrefix predicate on the "item_uid" field.
+func ItemUIDHasPrefix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDHasSuffix applies the HasSuffix predicate on the "item_uid" field.
+func ItemUIDHasSuffix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDEqualFold applies the EqualFold predicate on the "item_uid" field.
+func ItemUIDEqualFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDContainsFold applies the ContainsFold predicate on the "item_uid" field.
+func ItemUIDContainsFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldItemUID), v))
+	})
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActor), v...))
+	})
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActor), v...))
+	})
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActor), v))
+	})
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActor), v))
+	})
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldActor), v))
+	})
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldActor), v))
+	})
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldActor), v))
+	})
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOperation), v))
+	})
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOperation), v))
+	})
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOperation), v...))
+	})
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOperation), v...))
+	})
+}
+
+// OperationGT applies the GT predicate on the "operation" field.
+func OperationGT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOperation), v))
+	})
+}
+
+// OperationGTE applies the GTE predicate on the "operation" field.
+func OperationGTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOperation), v))
+	})
+}
+
+// OperationLT applies the LT predicate on the "operation" field.
+func OperationLT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOperation), v))
+	})
+}
+
+// OperationLTE applies the LTE predicate on the "operation" field.
+func OperationLTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOperation), v))
+	})
+}
+
+// OperationContains applies the Contains predicate on the "operation" field.
+func OperationContains(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOperation), v))
+	})
+}
+
+// OperationHasPrefix applies the HasPrefix predicate on the "operation" field.
+func OperationHasPrefix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOperation), v))
+	})
+}
+
+// OperationHasSuffix applies the HasSuffix predicate on the "operation" field.
+func OperationHasSuffix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOperation), v))
+	})
+}
+
+// OperationEqualFold applies the EqualFold predicate on the "operation" field.
+func OperationEqualFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOperation), v))
+	})
+}
+
+// OperationContainsFold applies the ContainsFold predicate on the "operation" field.
+func OperationContainsFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOperation), v))
+	})
+}
+
+// CorrelationIDEQ applies the EQ predicate on the "correlation_id" field.
+func CorrelationIDEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDNEQ applies the NEQ predicate on the "correlation_id" field.
+func CorrelationIDNEQ(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDIn applies the In predicate on the "correlation_id" field.
+func CorrelationIDIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCorrelationID), v...))
+	})
+}
+
+// CorrelationIDNotIn applies the NotIn predicate on the "correlation_id" field.
+func CorrelationIDNotIn(vs ...string) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCorrelationID), v...))
+	})
+}
+
+// CorrelationIDGT applies the GT predicate on the "correlation_id" field.
+func CorrelationIDGT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDGTE applies the GTE predicate on the "correlation_id" field.
+func CorrelationIDGTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDLT applies the LT predicate on the "correlation_id" field.
+func CorrelationIDLT(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDLTE applies the LTE predicate on the "correlation_id" field.
+func CorrelationIDLTE(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDContains applies the Contains predicate on the "correlation_id" field.
+func CorrelationIDContains(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDHasPrefix applies the HasPrefix predicate on the "correlation_id" field.
+func CorrelationIDHasPrefix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDHasSuffix applies the HasSuffix predicate on the "correlation_id" field.
+func CorrelationIDHasSuffix(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDEqualFold applies the EqualFold predicate on the "correlation_id" field.
+func CorrelationIDEqualFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDContainsFold applies the ContainsFold predicate on the "correlation_id" field.
+func CorrelationIDContainsFold(v string) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ItemHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ItemHistory) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ItemHistory) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ItemHistory) predicate.ItemHistory {
+	return predicate.ItemHistory(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
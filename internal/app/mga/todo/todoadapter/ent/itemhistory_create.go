@@ -0,0 +1,376 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/itemhistory"
+)
+
+// ItemHistoryCreate is the builder for creating a ItemHistory entity.
+type ItemHistoryCreate struct {
+	config
+	mutation *ItemHistoryMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (ihc *ItemHistoryCreate) SetUID(s string) *ItemHistoryCreate {
+	ihc.mutation.SetUID(s)
+	return ihc
+}
+
+// SetItemUID sets the "item_uid" field.
+func (ihc *ItemHistoryCreate) SetItemUID(s string) *ItemHistoryCreate {
+	ihc.mutation.SetItemUID(s)
+	return ihc
+}
+
+// SetActor sets the "actor" field.
+func (ihc *ItemHistoryCreate) SetActor(s string) *ItemHistoryCreate {
+	ihc.mutation.SetActor(s)
+	return ihc
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (ihc *ItemHistoryCreate) SetNillableActor(s *string) *ItemHistoryCreate {
+	if s != nil {
+		ihc.SetActor(*s)
+	}
+	return ihc
+}
+
+// SetOperation sets the "operation" field.
+func (ihc *ItemHistoryCreate) SetOperation(s string) *ItemHistoryCreate {
+	ihc.mutation.SetOperation(s)
+	return ihc
+}
+
+// SetCorrelationID sets the "correlation_id" field.
+func (ihc *ItemHistoryCreate) SetCorrelationID(s string) *ItemHistoryCreate {
+	ihc.mutation.SetCorrelationID(s)
+	return ihc
+}
+
+// SetNillableCorrelationID sets the "correlation_id" field if the given value is not nil.
+func (ihc *ItemHistoryCreate) SetNillableCorrelationID(s *string) *ItemHistoryCreate {
+	if s != nil {
+		ihc.SetCorrelationID(*s)
+	}
+	return ihc
+}
+
+// SetChanges sets the "changes" field.
+func (ihc *ItemHistoryCreate) SetChanges(tc []todo.FieldChange) *ItemHistoryCreate {
+	ihc.mutation.SetChanges(tc)
+	return ihc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ihc *ItemHistoryCreate) SetCreatedAt(t time.Time) *ItemHistoryCreate {
+	ihc.mutation.SetCreatedAt(t)
+	return ihc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ihc *ItemHistoryCreate) SetNillableCreatedAt(t *time.Time) *ItemHistoryCreate {
+	if t != nil {
+		ihc.SetCreatedAt(*t)
+	}
+	return ihc
+}
+
+// Mutation returns the ItemHistoryMutation object of the builder.
+func (ihc *ItemHistoryCreate) Mutation() *ItemHistoryMutation {
+	return ihc.mutation
+}
+
+// Save creates the ItemHistory in the database.
+func (ihc *ItemHistoryCreate) Save(ctx context.Context) (*ItemHistory, error) {
+	var (
+		err  error
+		node *ItemHistory
+	)
+	ihc.defaults()
+	if len(ihc.hooks) == 0 {
+		if err = ihc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ihc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ItemHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ihc.check(); err != nil {
+				return nil, err
+			}
+			ihc.mutation = mutation
+			if node, err = ihc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ihc.hooks) - 1; i >= 0; i-- {
+			if ihc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ihc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ihc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ihc *ItemHistoryCreate) SaveX(ctx context.Context) *ItemHistory {
+	v, err := ihc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ihc *ItemHistoryCreate) Exec(ctx context.Context) error {
+	_, err := ihc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ihc *ItemHistoryCreate) ExecX(ctx context.Context) {
+	if err := ihc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ihc *ItemHistoryCreate) defaults() {
+	if _, ok := ihc.mutation.Actor(); !ok {
+		v := itemhistory.DefaultActor
+		ihc.mutation.SetActor(v)
+	}
+	if _, ok := ihc.mutation.CorrelationID(); !ok {
+		v := itemhistory.DefaultCorrelationID
+		ihc.mutation.SetCorrelationID(v)
+	}
+	if _, ok := ihc.mutation.CreatedAt(); !ok {
+		v := itemhistory.DefaultCreatedAt()
+		ihc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ihc *ItemHistoryCreate) check() error {
+	if _, ok := ihc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := ihc.mutation.UID(); ok {
+		if err := itemhistory.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := ihc.mutation.ItemUID(); !ok {
+		return &ValidationError{Name: "item_uid", err: errors.New(`ent: missing required field "item_uid"`)}
+	}
+	if v, ok := ihc.mutation.ItemUID(); ok {
+		if err := itemhistory.ItemUIDValidator(v); err != nil {
+			return &ValidationError{Name: "item_uid", err: fmt.Errorf(`ent: validator failed for field "item_uid": %w`, err)}
+		}
+	}
+	if _, ok := ihc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "actor"`)}
+	}
+	if _, ok := ihc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "operation"`)}
+	}
+	if _, ok := ihc.mutation.CorrelationID(); !ok {
+		return &ValidationError{Name: "correlation_id", err: errors.New(`ent: missing required field "correlation_id"`)}
+	}
+	if _, ok := ihc.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "changes"`)}
+	}
+	if _, ok := ihc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
+	}
+	return nil
+}
+
+func (ihc *ItemHistoryCreate) sqlSave(ctx context.Context) (*ItemHistory, error) {
+	_node, _spec := ihc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ihc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ihc *ItemHistoryCreate) createSpec() (*ItemHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemHistory{config: ihc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: itemhistory.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: itemhistory.FieldID,
+			},
+		}
+	)
+	if value, ok := ihc.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: itemhistory.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := ihc.mutation.ItemUID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: itemhistory.FieldItemUID,
+		})
+		_node.ItemUID = value
+	}
+	if value, ok := ihc.mutation.Actor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: itemhistory.FieldActor,
+		})
+		_node.Actor = value
+	}
+	if value, ok := ihc.mutation.Operation(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: itemhistory.FieldOperation,
+		})
+		_node.Operation = value
+	}
+	if value, ok := ihc.mutation.CorrelationID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: itemhistory.FieldCorrelationID,
+		})
+		_node.CorrelationID = value
+	}
+	if value, ok := ihc.mutation.Changes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: itemhistory.FieldChanges,
+		})
+		_node.Changes = value
+	}
+	if value, ok := ihc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: itemhistory.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ItemHistoryCreateBulk is the builder for creating many ItemHistory entities in bulk.
+type ItemHistoryCreateBulk struct {
+	config
+	builders []*ItemHistoryCreate
+}
+
+// Save creates the ItemHistory entities in the database.
+func (ihcb *ItemHistoryCreateBulk) Save(ctx context.Context) ([]*ItemHistory, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ihcb.builders))
+	nodes := make([]*ItemHistory, len(ihcb.builders))
+	mutators := make([]Mutator, len(ihcb.builders))
+	for i := range ihcb.builders {
+		func(i int, root context.Context) {
+			builder := ihcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ihcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ihcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ihcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ihcb *ItemHistoryCreateBulk) SaveX(ctx context.Context) []*ItemHistory {
+	v, err := ihcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ihcb *ItemHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := ihcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ihcb *ItemHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := ihcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
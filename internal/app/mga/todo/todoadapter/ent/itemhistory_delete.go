@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/itemhistory"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+)
+
+// ItemHistoryDelete is the builder for deleting a ItemHistory entity.
+type ItemHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemHistoryMutation
+}
+
+// Where appends a list predicates to the ItemHistoryDelete builder.
+func (ihd *ItemHistoryDelete) Where(ps ...predicate.ItemHistory) *ItemHistoryDelete {
+	ihd.mutation.Where(ps...)
+	return ihd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ihd *ItemHistoryDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ihd.hooks) == 0 {
+		affected, err = ihd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ItemHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ihd.mutation = mutation
+			affected, err = ihd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ihd.hooks) - 1; i >= 0; i-- {
+			if ihd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ihd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ihd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ihd *ItemHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := ihd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ihd *ItemHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: itemhistory.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: itemhistory.FieldID,
+			},
+		},
+	}
+	if ps := ihd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, ihd.driver, _spec)
+}
+
+// ItemHistoryDeleteOne is the builder for deleting a single ItemHistory entity.
+type ItemHistoryDeleteOne struct {
+	ihd *ItemHistoryDelete
+}
+
+// Exec executes the deletion query.
+func (ihdo *ItemHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := ihdo.ihd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemhistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ihdo *ItemHistoryDeleteOne) ExecX(ctx context.Context) {
+	ihdo.ihd.ExecX(ctx)
+}
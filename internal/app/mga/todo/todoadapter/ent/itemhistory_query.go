@@ -0,0 +1,913 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/itemhistory"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+)
+
+// ItemHistoryQuery is the builder for querying ItemHistory entities.
+type ItemHistoryQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ItemHistory
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemHistoryQuery builder.
+func (ihq *ItemHistoryQuery) Where(ps ...predicate.ItemHistory) *ItemHistoryQuery {
+	ihq.predicates = append(ihq.predicates, ps...)
+	return ihq
+}
+
+// Limit adds a limit step to the query.
+func (ihq *ItemHistoryQuery) Limit(limit int) *ItemHistoryQuery {
+	ihq.limit = &limit
+	return ihq
+}
+
+// Offset adds an offset step to the query.
+func (ihq *ItemHistoryQuery) Offset(offset int) *ItemHistoryQuery {
+	ihq.offset = &offset
+	return ihq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ihq *ItemHistoryQuery) Unique(unique bool) *ItemHistoryQuery {
+	ihq.unique = &unique
+	return ihq
+}
+
+// Order adds an order step to the query.
+func (ihq *ItemHistoryQuery) Order(o ...OrderFunc) *ItemHistoryQuery {
+	ihq.order = append(ihq.order, o...)
+	return ihq
+}
+
+// First returns the first ItemHistory entity from the query.
+// Returns a *NotFoundError when no ItemHistory was found.
+func (ihq *ItemHistoryQuery) First(ctx context.Context) (*ItemHistory, error) {
+	nodes, err := ihq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemhistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ihq *ItemHistoryQuery) FirstX(ctx context.Context) *ItemHistory {
+	node, err := ihq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemHistory ID from the query.
+// Returns a *NotFoundError when no ItemHistory ID was found.
+func (ihq *ItemHistoryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ihq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemhistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ihq *ItemHistoryQuery) FirstIDX(ctx context.Context) int {
+	id, err := ihq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one ItemHistory entity is not found.
+// Returns a *NotFoundError when no ItemHistory entities are found.
+func (ihq *ItemHistoryQuery) Only(ctx context.Context) (*ItemHistory, error) {
+	nodes, err := ihq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemhistory.Label}
+	default:
+		return nil, &NotSingularError{itemhistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ihq *ItemHistoryQuery) OnlyX(ctx context.Context) *ItemHistory {
+	node, err := ihq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemHistory ID in the query.
+// Returns a *NotSingularError when exactly one ItemHistory ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (ihq *ItemHistoryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ihq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemhistory.Label}
+	default:
+		err = &NotSingularError{itemhistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ihq *ItemHistoryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ihq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemHistories.
+func (ihq *ItemHistoryQuery) All(ctx context.Context) ([]*ItemHistory, error) {
+	if err := ihq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ihq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ihq *ItemHistoryQuery) AllX(ctx context.Context) []*ItemHistory {
+	nodes, err := ihq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemHistory IDs.
+func (ihq *ItemHistoryQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ihq.Select(itemhistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ihq *ItemHistoryQuery) IDsX(ctx context.Context) []int {
+	ids, err := ihq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ihq *ItemHistoryQuery) Count(ctx context.Context) (int, error) {
+	if err := ihq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ihq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ihq *ItemHistoryQuery) CountX(ctx context.Context) int {
+	count, err := ihq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ihq *ItemHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ihq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ihq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ihq *ItemHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := ihq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ihq *ItemHistoryQuery) Clone() *ItemHistoryQuery {
+	if ihq == nil {
+		return nil
+	}
+	return &ItemHistoryQuery{
+		config:     ihq.config,
+		limit:      ihq.limit,
+		offset:     ihq.offset,
+		order:      append([]OrderFunc{}, ihq.order...),
+		predicates: append([]predicate.ItemHistory{}, ihq.predicates...),
+		// clone intermediate query.
+		sql:  ihq.sql.Clone(),
+		path: ihq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemHistory.Query().
+//		GroupBy(itemhistory.FieldUID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ihq *ItemHistoryQuery) GroupBy(field string, fields ...string) *ItemHistoryGroupBy {
+	group := &ItemHistoryGroupBy{config: ihq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ihq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ihq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//	}
+//
+//	client.ItemHistory.Query().
+//		Select(itemhistory.FieldUID).
+//		Scan(ctx, &v)
+func (ihq *ItemHistoryQuery) Select(fields ...string) *ItemHistorySelect {
+	ihq.fields = append(ihq.fields, fields...)
+	return &ItemHistorySelect{ItemHistoryQuery: ihq}
+}
+
+func (ihq *ItemHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ihq.fields {
+		if !itemhistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ihq.path != nil {
+		prev, err := ihq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ihq.sql = prev
+	}
+	return nil
+}
+
+func (ihq *ItemHistoryQuery) sqlAll(ctx context.Context) ([]*ItemHistory, error) {
+	var (
+		nodes = []*ItemHistory{}
+		_spec = ihq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ItemHistory{config: ihq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ihq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ihq *ItemHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ihq.querySpec()
+	return sqlgraph.CountNodes(ctx, ihq.driver, _spec)
+}
+
+func (ihq *ItemHistoryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ihq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ihq *ItemHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   itemhistory.Table,
+			Columns: itemhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: itemhistory.FieldID,
+			},
+		},
+		From:   ihq.sql,
+		Unique: true,
+	}
+	if unique := ihq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ihq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemhistory.FieldID)
+		for i := range fields {
+			if fields[i] != itemhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ihq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ihq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ihq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ihq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ihq *ItemHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ihq.driver.Dialect())
+	t1 := builder.Table(itemhistory.Table)
+	columns := ihq.fields
+	if len(columns) == 0 {
+		columns = itemhistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ihq.sql != nil {
+		selector = ihq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range ihq.predicates {
+		p(selector)
+	}
+	for _, p := range ihq.order {
+		p(selector)
+	}
+	if offset := ihq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ihq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ItemHistoryGroupBy is the group-by builder for ItemHistory entities.
+type ItemHistoryGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ihgb *ItemHistoryGroupBy) Aggregate(fns ...AggregateFunc) *ItemHistoryGroupBy {
+	ihgb.fns = append(ihgb.fns, fns...)
+	return ihgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ihgb *ItemHistoryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ihgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ihgb.sql = query
+	return ihgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ihgb *ItemHistoryGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ihgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *ItemHistoryGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ihgb.fields) > 1 {
+		return nil, errors.New("ent: ItemHistoryGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ihgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ihgb *ItemHistoryGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ihgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *ItemHistoryGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ihgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{itemhistory.Label}
+	default:
+		err = fmt.Errorf("ent: ItemHistoryGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ihgb *ItemHistoryGroupBy) StringX(ctx context.Context) string {
+	v, err := ihgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *ItemHistoryGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ihgb.fields) > 1 {
+		return nil, errors.New("ent: ItemHistoryGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ihgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ihgb *ItemHistoryGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ihgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *ItemHistoryGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ihgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{itemhistory.Label}
+	default:
+		err = fmt.Errorf("ent: ItemHistoryGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ihgb *ItemHistoryGroupBy) IntX(ctx context.Context) int {
+	v, err := ihgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *ItemHistoryGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ihgb.fields) > 1 {
+		return nil, errors.New("ent: ItemHistoryGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ihgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ihgb *ItemHistoryGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ihgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *ItemHistoryGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ihgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{itemhistory.Label}
+	default:
+		err = fmt.Errorf("ent: ItemHistoryGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ihgb *ItemHistoryGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ihgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *ItemHistoryGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ihgb.fields) > 1 {
+		return nil, errors.New("ent: ItemHistoryGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ihgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ihgb *ItemHistoryGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ihgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *ItemHistoryGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ihgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{itemhistory.Label}
+	default:
+		err = fmt.Errorf("ent: ItemHistoryGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ihgb *ItemHistoryGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ihgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ihgb *ItemHistoryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ihgb.fields {
+		if !itemhistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ihgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ihgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ihgb *ItemHistoryGroupBy) sqlQuery() *sql.Selector {
+	selector := ihgb.sql.Select()
+	aggregation := make([]string, 0, len(ihgb.fns))
+	for _, fn := range ihgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ihgb.fields)+len(ihgb.fns))
+		for _, f := range ihgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ihgb.fields...)...)
+}
+
+// ItemHistorySelect is the builder for selecting fields of ItemHistory entities.
+type ItemHistorySelect struct {
+	*ItemHistoryQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ihs *ItemHistorySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ihs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ihs.sql = ihs.ItemHistoryQuery.sqlQuery(ctx)
+	return ihs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ihs *ItemHistorySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ihs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ihs *ItemHistorySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ihs.fields) > 1 {
+		return nil, errors.New("ent: ItemHistorySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ihs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ihs *ItemHistorySelect) StringsX(ctx context.Context) []string {
+	v, err := ihs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ihs *ItemHistorySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ihs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{itemhistory.Label}
+	default:
+		err = fmt.Errorf("ent: ItemHistorySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ihs *ItemHistorySelect) StringX(ctx context.Context) string {
+	v, err := ihs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ihs *ItemHistorySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ihs.fields) > 1 {
+		return nil, errors.New("ent: ItemHistorySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ihs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ihs *ItemHistorySelect) IntsX(ctx context.Context) []int {
+	v, err := ihs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ihs *ItemHistorySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ihs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{itemhistory.Label}
+	default:
+		err = fmt.Errorf("ent: ItemHistorySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ihs *ItemHistorySelect) IntX(ctx context.Context) int {
+	v, err := ihs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ihs *ItemHistorySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ihs.fields) > 1 {
+		return nil, errors.New("ent: ItemHistorySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ihs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ihs *ItemHistorySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ihs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ihs *ItemHistorySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ihs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{itemhistory.Label}
+	default:
+		err = fmt.Errorf("ent: ItemHistorySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ihs *ItemHistorySelect) Float64X(ctx context.Context) float64 {
+	v, err := ihs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ihs *ItemHistorySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ihs.fields) > 1 {
+		return nil, errors.New("ent: ItemHistorySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ihs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ihs *ItemHistorySelect) BoolsX(ctx context.Context) []bool {
+	v, err := ihs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ihs *ItemHistorySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ihs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{itemhistory.Label}
+	default:
+		err = fmt.Errorf("ent: ItemHistorySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ihs *ItemHistorySelect) BoolX(ctx context.Context) bool {
+	v, err := ihs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ihs *ItemHistorySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ihs.sql.Query()
+	if err := ihs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
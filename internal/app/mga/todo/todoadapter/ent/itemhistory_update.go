@@ -0,0 +1,238 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/itemhistory"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+)
+
+// ItemHistoryUpdate is the builder for updating ItemHistory entities.
+type ItemHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemHistoryMutation
+}
+
+// Where appends a list predicates to the ItemHistoryUpdate builder.
+func (ihu *ItemHistoryUpdate) Where(ps ...predicate.ItemHistory) *ItemHistoryUpdate {
+	ihu.mutation.Where(ps...)
+	return ihu
+}
+
+// Mutation returns the ItemHistoryMutation object of the builder.
+func (ihu *ItemHistoryUpdate) Mutation() *ItemHistoryMutation {
+	return ihu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ihu *ItemHistoryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ihu.hooks) == 0 {
+		affected, err = ihu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ItemHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ihu.mutation = mutation
+			affected, err = ihu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ihu.hooks) - 1; i >= 0; i-- {
+			if ihu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ihu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ihu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ihu *ItemHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ihu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ihu *ItemHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := ihu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ihu *ItemHistoryUpdate) ExecX(ctx context.Context) {
+	if err := ihu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ihu *ItemHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   itemhistory.Table,
+			Columns: itemhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: itemhistory.FieldID,
+			},
+		},
+	}
+	if ps := ihu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ihu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ItemHistoryUpdateOne is the builder for updating a single ItemHistory entity.
+type ItemHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemHistoryMutation
+}
+
+// Mutation returns the ItemHistoryMutation object of the builder.
+func (ihuo *ItemHistoryUpdateOne) Mutation() *ItemHistoryMutation {
+	return ihuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ihuo *ItemHistoryUpdateOne) Select(field string, fields ...string) *ItemHistoryUpdateOne {
+	ihuo.fields = append([]string{field}, fields...)
+	return ihuo
+}
+
+// Save executes the query and returns the updated ItemHistory entity.
+func (ihuo *ItemHistoryUpdateOne) Save(ctx context.Context) (*ItemHistory, error) {
+	var (
+		err  error
+		node *ItemHistory
+	)
+	if len(ihuo.hooks) == 0 {
+		node, err = ihuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ItemHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ihuo.mutation = mutation
+			node, err = ihuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ihuo.hooks) - 1; i >= 0; i-- {
+			if ihuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ihuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ihuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ihuo *ItemHistoryUpdateOne) SaveX(ctx context.Context) *ItemHistory {
+	node, err := ihuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ihuo *ItemHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := ihuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ihuo *ItemHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := ihuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ihuo *ItemHistoryUpdateOne) sqlSave(ctx context.Context) (_node *ItemHistory, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   itemhistory.Table,
+			Columns: itemhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: itemhistory.FieldID,
+			},
+		},
+	}
+	id, ok := ihuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing ItemHistory.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ihuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemhistory.FieldID)
+		for _, f := range fields {
+			if !itemhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ihuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &ItemHistory{config: ihuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ihuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
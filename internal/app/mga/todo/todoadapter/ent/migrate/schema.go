@@ -3,32 +3,486 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "checksum", Type: field.TypeString, Size: 64},
+		{Name: "uploader", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "todo_item_attachments", Type: field.TypeInt, Nullable: true},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachments_todo_items_attachments",
+				Columns:    []*schema.Column{AttachmentsColumns[8]},
+				RefColumns: []*schema.Column{TodoItemsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[7]},
+			},
+		},
+	}
+	// CollaboratorsColumns holds the columns for the "collaborators" table.
+	CollaboratorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "email", Type: field.TypeString, Size: 254},
+		{Name: "role", Type: field.TypeString},
+		{Name: "invited_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "todo_list_collaborators", Type: field.TypeInt, Nullable: true},
+	}
+	// CollaboratorsTable holds the schema information for the "collaborators" table.
+	CollaboratorsTable = &schema.Table{
+		Name:       "collaborators",
+		Columns:    CollaboratorsColumns,
+		PrimaryKey: []*schema.Column{CollaboratorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collaborators_todo_lists_collaborators",
+				Columns:    []*schema.Column{CollaboratorsColumns[6]},
+				RefColumns: []*schema.Column{TodoListsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collaborator_email_todo_list_collaborators",
+				Unique:  true,
+				Columns: []*schema.Column{CollaboratorsColumns[2], CollaboratorsColumns[6]},
+			},
+			{
+				Name:    "collaborator_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CollaboratorsColumns[5]},
+			},
+		},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "author", Type: field.TypeString, Default: ""},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "mentions", Type: field.TypeJSON, Nullable: true},
+		{Name: "edits", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "todo_item_comments", Type: field.TypeInt, Nullable: true},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_todo_items_comments",
+				Columns:    []*schema.Column{CommentsColumns[8]},
+				RefColumns: []*schema.Column{TodoItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[6]},
+			},
+		},
+	}
+	// ItemHistoryColumns holds the columns for the "item_history" table.
+	ItemHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "item_uid", Type: field.TypeString, Size: 26},
+		{Name: "actor", Type: field.TypeString, Default: ""},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "correlation_id", Type: field.TypeString, Default: ""},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ItemHistoryTable holds the schema information for the "item_history" table.
+	ItemHistoryTable = &schema.Table{
+		Name:       "item_history",
+		Columns:    ItemHistoryColumns,
+		PrimaryKey: []*schema.Column{ItemHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemhistory_item_uid_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ItemHistoryColumns[2], ItemHistoryColumns[7]},
+			},
+		},
+	}
+	// OperationsColumns holds the columns for the "operations" table.
+	OperationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "actor", Type: field.TypeString, Default: ""},
+		{Name: "name", Type: field.TypeString},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "undone", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OperationsTable holds the schema information for the "operations" table.
+	OperationsTable = &schema.Table{
+		Name:       "operations",
+		Columns:    OperationsColumns,
+		PrimaryKey: []*schema.Column{OperationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "operation_actor_undone_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OperationsColumns[2], OperationsColumns[5], OperationsColumns[6]},
+			},
+		},
+	}
+	// RulesColumns holds the columns for the "rules" table.
+	RulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "name", Type: field.TypeString},
+		{Name: "trigger", Type: field.TypeString},
+		{Name: "conditions", Type: field.TypeJSON, Nullable: true},
+		{Name: "actions", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "dry_run", Type: field.TypeBool, Default: false},
+		{Name: "owner", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RulesTable holds the schema information for the "rules" table.
+	RulesTable = &schema.Table{
+		Name:       "rules",
+		Columns:    RulesColumns,
+		PrimaryKey: []*schema.Column{RulesColumns[0]},
+	}
+	// RuleExecutionsColumns holds the columns for the "rule_executions" table.
+	RuleExecutionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "rule_uid", Type: field.TypeString, Size: 26},
+		{Name: "trigger", Type: field.TypeString},
+		{Name: "item_uid", Type: field.TypeString, Size: 26},
+		{Name: "correlation_id", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeString},
+		{Name: "error", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "executed_at", Type: field.TypeTime},
+	}
+	// RuleExecutionsTable holds the schema information for the "rule_executions" table.
+	RuleExecutionsTable = &schema.Table{
+		Name:       "rule_executions",
+		Columns:    RuleExecutionsColumns,
+		PrimaryKey: []*schema.Column{RuleExecutionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ruleexecution_rule_uid_executed_at",
+				Unique:  false,
+				Columns: []*schema.Column{RuleExecutionsColumns[2], RuleExecutionsColumns[8]},
+			},
+			{
+				Name:    "ruleexecution_correlation_id",
+				Unique:  false,
+				Columns: []*schema.Column{RuleExecutionsColumns[5]},
+			},
+		},
+	}
+	// ShareAccessLogColumns holds the columns for the "share_access_log" table.
+	ShareAccessLogColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "share_uid", Type: field.TypeString, Size: 26},
+		{Name: "list_uid", Type: field.TypeString, Size: 26},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "item_uid", Type: field.TypeString, Default: ""},
+		{Name: "correlation_id", Type: field.TypeString, Default: ""},
+		{Name: "denied", Type: field.TypeBool, Default: false},
+		{Name: "accessed_at", Type: field.TypeTime},
+	}
+	// ShareAccessLogTable holds the schema information for the "share_access_log" table.
+	ShareAccessLogTable = &schema.Table{
+		Name:       "share_access_log",
+		Columns:    ShareAccessLogColumns,
+		PrimaryKey: []*schema.Column{ShareAccessLogColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shareaccess_list_uid_accessed_at",
+				Unique:  false,
+				Columns: []*schema.Column{ShareAccessLogColumns[3], ShareAccessLogColumns[8]},
+			},
+		},
+	}
+	// ShareTokensColumns holds the columns for the "share_tokens" table.
+	ShareTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "access", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "todo_list_share_tokens", Type: field.TypeInt, Nullable: true},
+	}
+	// ShareTokensTable holds the schema information for the "share_tokens" table.
+	ShareTokensTable = &schema.Table{
+		Name:       "share_tokens",
+		Columns:    ShareTokensColumns,
+		PrimaryKey: []*schema.Column{ShareTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_tokens_todo_lists_share_tokens",
+				Columns:    []*schema.Column{ShareTokensColumns[8]},
+				RefColumns: []*schema.Column{TodoListsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sharetoken_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ShareTokensColumns[5]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "owner", Type: field.TypeString, Default: ""},
+		{Name: "name", Type: field.TypeString},
+		{Name: "color", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_owner_name",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[2], TagsColumns[3]},
+			},
+		},
+	}
+	// TemplatesColumns holds the columns for the "templates" table.
+	TemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "items", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TemplatesTable holds the schema information for the "templates" table.
+	TemplatesTable = &schema.Table{
+		Name:       "templates",
+		Columns:    TemplatesColumns,
+		PrimaryKey: []*schema.Column{TemplatesColumns[0]},
+	}
+	// TimeEntriesColumns holds the columns for the "time_entries" table.
+	TimeEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "user", Type: field.TypeString},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "todo_item_time_entries", Type: field.TypeInt, Nullable: true},
+	}
+	// TimeEntriesTable holds the schema information for the "time_entries" table.
+	TimeEntriesTable = &schema.Table{
+		Name:       "time_entries",
+		Columns:    TimeEntriesColumns,
+		PrimaryKey: []*schema.Column{TimeEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "time_entries_todo_items_time_entries",
+				Columns:    []*schema.Column{TimeEntriesColumns[5]},
+				RefColumns: []*schema.Column{TodoItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "timeentry_user_ended_at",
+				Unique:  false,
+				Columns: []*schema.Column{TimeEntriesColumns[2], TimeEntriesColumns[4]},
+			},
+			{
+				Name:    "timeentry_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{TimeEntriesColumns[3]},
+			},
+		},
+	}
 	// TodoItemsColumns holds the columns for the "todo_items" table.
 	TodoItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
 		{Name: "title", Type: field.TypeString, Size: 2147483647},
+		{Name: "notes", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "assignee", Type: field.TypeString, Default: ""},
 		{Name: "completed", Type: field.TypeBool},
 		{Name: "order", Type: field.TypeInt},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "due_soon_notified", Type: field.TypeBool, Default: false},
+		{Name: "overdue_notified", Type: field.TypeBool, Default: false},
+		{Name: "recurrence_rule", Type: field.TypeString, Nullable: true},
+		{Name: "recurrence_timezone", Type: field.TypeString, Nullable: true},
+		{Name: "recurrence_start", Type: field.TypeTime, Nullable: true},
+		{Name: "recurrence_occurrence", Type: field.TypeTime, Nullable: true},
+		{Name: "series_id", Type: field.TypeString, Nullable: true, Size: 26},
+		{Name: "auto_complete", Type: field.TypeBool, Default: false},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "rank", Type: field.TypeString, Default: ""},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "todo_item_children", Type: field.TypeInt, Nullable: true},
+		{Name: "todo_list_items", Type: field.TypeInt, Nullable: true},
 	}
 	// TodoItemsTable holds the schema information for the "todo_items" table.
 	TodoItemsTable = &schema.Table{
 		Name:       "todo_items",
 		Columns:    TodoItemsColumns,
 		PrimaryKey: []*schema.Column{TodoItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_items_todo_items_children",
+				Columns:    []*schema.Column{TodoItemsColumns[21]},
+				RefColumns: []*schema.Column{TodoItemsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "todo_items_todo_lists_items",
+				Columns:    []*schema.Column{TodoItemsColumns[22]},
+				RefColumns: []*schema.Column{TodoListsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todoitem_series_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoItemsColumns[14]},
+			},
+			{
+				Name:    "todoitem_rank",
+				Unique:  false,
+				Columns: []*schema.Column{TodoItemsColumns[17]},
+			},
+			{
+				Name:    "todoitem_assignee",
+				Unique:  false,
+				Columns: []*schema.Column{TodoItemsColumns[4]},
+			},
+			{
+				Name:    "todoitem_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodoItemsColumns[20]},
+			},
+		},
+	}
+	// TodoListsColumns holds the columns for the "todo_lists" table.
+	TodoListsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uid", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TodoListsTable holds the schema information for the "todo_lists" table.
+	TodoListsTable = &schema.Table{
+		Name:       "todo_lists",
+		Columns:    TodoListsColumns,
+		PrimaryKey: []*schema.Column{TodoListsColumns[0]},
+	}
+	// TagItemsColumns holds the columns for the "tag_items" table.
+	TagItemsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeInt},
+		{Name: "todo_item_id", Type: field.TypeInt},
+	}
+	// TagItemsTable holds the schema information for the "tag_items" table.
+	TagItemsTable = &schema.Table{
+		Name:       "tag_items",
+		Columns:    TagItemsColumns,
+		PrimaryKey: []*schema.Column{TagItemsColumns[0], TagItemsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_items_tag_id",
+				Columns:    []*schema.Column{TagItemsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_items_todo_item_id",
+				Columns:    []*schema.Column{TagItemsColumns[1]},
+				RefColumns: []*schema.Column{TodoItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AttachmentsTable,
+		CollaboratorsTable,
+		CommentsTable,
+		ItemHistoryTable,
+		OperationsTable,
+		RulesTable,
+		RuleExecutionsTable,
+		ShareAccessLogTable,
+		ShareTokensTable,
+		TagsTable,
+		TemplatesTable,
+		TimeEntriesTable,
 		TodoItemsTable,
+		TodoListsTable,
+		TagItemsTable,
 	}
 )
 
 func init() {
+	AttachmentsTable.ForeignKeys[0].RefTable = TodoItemsTable
+	CollaboratorsTable.ForeignKeys[0].RefTable = TodoListsTable
+	CommentsTable.ForeignKeys[0].RefTable = TodoItemsTable
+	ItemHistoryTable.Annotation = &entsql.Annotation{
+		Table: "item_history",
+	}
+	ShareAccessLogTable.Annotation = &entsql.Annotation{
+		Table: "share_access_log",
+	}
+	ShareTokensTable.ForeignKeys[0].RefTable = TodoListsTable
+	TimeEntriesTable.ForeignKeys[0].RefTable = TodoItemsTable
+	TodoItemsTable.ForeignKeys[0].RefTable = TodoItemsTable
+	TodoItemsTable.ForeignKeys[1].RefTable = TodoListsTable
+	TagItemsTable.ForeignKeys[0].RefTable = TagsTable
+	TagItemsTable.ForeignKeys[1].RefTable = TodoItemsTable
 }
@@ -0,0 +1,160 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/operation"
+)
+
+// Operation is the model entity for the Operation schema.
+type Operation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes []todo.ItemChange `json:"changes,omitempty"`
+	// Undone holds the value of the "undone" field.
+	Undone bool `json:"undone,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Operation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case operation.FieldChanges:
+			values[i] = new([]byte)
+		case operation.FieldUndone:
+			values[i] = new(sql.NullBool)
+		case operation.FieldID:
+			values[i] = new(sql.NullInt64)
+		case operation.FieldUID, operation.FieldActor, operation.FieldName:
+			values[i] = new(sql.NullString)
+		case operation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Operation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Operation fields.
+func (o *Operation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case operation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			o.ID = int(value.Int64)
+		case operation.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				o.UID = value.String
+			}
+		case operation.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				o.Actor = value.String
+			}
+		case operation.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				o.Name = value.String
+			}
+		case operation.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case operation.FieldUndone:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field undone", values[i])
+			} else if value.Valid {
+				o.Undone = value.Bool
+			}
+		case operation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				o.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Operation.
+// Note that you need to call Operation.Unwrap() before calling this method if this Operation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (o *Operation) Update() *OperationUpdateOne {
+	return (&OperationClient{config: o.config}).UpdateOne(o)
+}
+
+// Unwrap unwraps the Operation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (o *Operation) Unwrap() *Operation {
+	tx, ok := o.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Operation is not a transactional entity")
+	}
+	o.config.driver = tx.drv
+	return o
+}
+
+// String implements the fmt.Stringer.
+func (o *Operation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Operation(")
+	builder.WriteString(fmt.Sprintf("id=%v", o.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(o.UID)
+	builder.WriteString(", actor=")
+	builder.WriteString(o.Actor)
+	builder.WriteString(", name=")
+	builder.WriteString(o.Name)
+	builder.WriteString(", changes=")
+	builder.WriteString(fmt.Sprintf("%v", o.Changes))
+	builder.WriteString(", undone=")
+	builder.WriteString(fmt.Sprintf("%v", o.Undone))
+	builder.WriteString(", created_at=")
+	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Operations is a parsable slice of Operation.
+type Operations []*Operation
+
+func (o Operations) config(cfg config) {
+	for _i := range o {
+		o[_i].config = cfg
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by entc, DO NOT EDIT.
+
+package operation
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the operation type in the database.
+	Label = "operation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldChanges holds the string denoting the changes field in the database.
+	FieldChanges = "changes"
+	// FieldUndone holds the string denoting the undone field in the database.
+	FieldUndone = "undone"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the operation in the database.
+	Table = "operations"
+)
+
+// Columns holds all SQL columns for operation fields.
+var Columns = []string{
+	FieldID,
+	FieldUID,
+	FieldActor,
+	FieldName,
+	FieldChanges,
+	FieldUndone,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	UIDValidator func(string) error
+	// DefaultActor holds the default value on creation for the "actor" field.
+	DefaultActor string
+	// DefaultUndone holds the default value on creation for the "undone" field.
+	DefaultUndone bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
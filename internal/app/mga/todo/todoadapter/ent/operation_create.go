@@ -0,0 +1,354 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/operation"
+)
+
+// OperationCreate is the builder for creating a Operation entity.
+type OperationCreate struct {
+	config
+	mutation *OperationMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (oc *OperationCreate) SetUID(s string) *OperationCreate {
+	oc.mutation.SetUID(s)
+	return oc
+}
+
+// SetActor sets the "actor" field.
+func (oc *OperationCreate) SetActor(s string) *OperationCreate {
+	oc.mutation.SetActor(s)
+	return oc
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (oc *OperationCreate) SetNillableActor(s *string) *OperationCreate {
+	if s != nil {
+		oc.SetActor(*s)
+	}
+	return oc
+}
+
+// SetName sets the "name" field.
+func (oc *OperationCreate) SetName(s string) *OperationCreate {
+	oc.mutation.SetName(s)
+	return oc
+}
+
+// SetChanges sets the "changes" field.
+func (oc *OperationCreate) SetChanges(tc []todo.ItemChange) *OperationCreate {
+	oc.mutation.SetChanges(tc)
+	return oc
+}
+
+// SetUndone sets the "undone" field.
+func (oc *OperationCreate) SetUndone(b bool) *OperationCreate {
+	oc.mutation.SetUndone(b)
+	return oc
+}
+
+// SetNillableUndone sets the "undone" field if the given value is not nil.
+func (oc *OperationCreate) SetNillableUndone(b *bool) *OperationCreate {
+	if b != nil {
+		oc.SetUndone(*b)
+	}
+	return oc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oc *OperationCreate) SetCreatedAt(t time.Time) *OperationCreate {
+	oc.mutation.SetCreatedAt(t)
+	return oc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oc *OperationCreate) SetNillableCreatedAt(t *time.Time) *OperationCreate {
+	if t != nil {
+		oc.SetCreatedAt(*t)
+	}
+	return oc
+}
+
+// Mutation returns the OperationMutation object of the builder.
+func (oc *OperationCreate) Mutation() *OperationMutation {
+	return oc.mutation
+}
+
+// Save creates the Operation in the database.
+func (oc *OperationCreate) Save(ctx context.Context) (*Operation, error) {
+	var (
+		err  error
+		node *Operation
+	)
+	oc.defaults()
+	if len(oc.hooks) == 0 {
+		if err = oc.check(); err != nil {
+			return nil, err
+		}
+		node, err = oc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OperationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = oc.check(); err != nil {
+				return nil, err
+			}
+			oc.mutation = mutation
+			if node, err = oc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(oc.hooks) - 1; i >= 0; i-- {
+			if oc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = oc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, oc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oc *OperationCreate) SaveX(ctx context.Context) *Operation {
+	v, err := oc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oc *OperationCreate) Exec(ctx context.Context) error {
+	_, err := oc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oc *OperationCreate) ExecX(ctx context.Context) {
+	if err := oc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oc *OperationCreate) defaults() {
+	if _, ok := oc.mutation.Actor(); !ok {
+		v := operation.DefaultActor
+		oc.mutation.SetActor(v)
+	}
+	if _, ok := oc.mutation.Undone(); !ok {
+		v := operation.DefaultUndone
+		oc.mutation.SetUndone(v)
+	}
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		v := operation.DefaultCreatedAt()
+		oc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oc *OperationCreate) check() error {
+	if _, ok := oc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := oc.mutation.UID(); ok {
+		if err := operation.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "actor"`)}
+	}
+	if _, ok := oc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "name"`)}
+	}
+	if _, ok := oc.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "changes"`)}
+	}
+	if _, ok := oc.mutation.Undone(); !ok {
+		return &ValidationError{Name: "undone", err: errors.New(`ent: missing required field "undone"`)}
+	}
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
+	}
+	return nil
+}
+
+func (oc *OperationCreate) sqlSave(ctx context.Context) (*Operation, error) {
+	_node, _spec := oc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (oc *OperationCreate) createSpec() (*Operation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Operation{config: oc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: operation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: operation.FieldID,
+			},
+		}
+	)
+	if value, ok := oc.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: operation.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := oc.mutation.Actor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: operation.FieldActor,
+		})
+		_node.Actor = value
+	}
+	if value, ok := oc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: operation.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := oc.mutation.Changes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: operation.FieldChanges,
+		})
+		_node.Changes = value
+	}
+	if value, ok := oc.mutation.Undone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: operation.FieldUndone,
+		})
+		_node.Undone = value
+	}
+	if value, ok := oc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: operation.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OperationCreateBulk is the builder for creating many Operation entities in bulk.
+type OperationCreateBulk struct {
+	config
+	builders []*OperationCreate
+}
+
+// Save creates the Operation entities in the database.
+func (ocb *OperationCreateBulk) Save(ctx context.Context) ([]*Operation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ocb.builders))
+	nodes := make([]*Operation, len(ocb.builders))
+	mutators := make([]Mutator, len(ocb.builders))
+	for i := range ocb.builders {
+		func(i int, root context.Context) {
+			builder := ocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OperationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocb *OperationCreateBulk) SaveX(ctx context.Context) []*Operation {
+	v, err := ocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocb *OperationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocb *OperationCreateBulk) ExecX(ctx context.Context) {
+	if err := ocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,280 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/operation"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+)
+
+// OperationUpdate is the builder for updating Operation entities.
+type OperationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OperationMutation
+}
+
+// Where appends a list predicates to the OperationUpdate builder.
+func (ou *OperationUpdate) Where(ps ...predicate.Operation) *OperationUpdate {
+	ou.mutation.Where(ps...)
+	return ou
+}
+
+// SetUndone sets the "undone" field.
+func (ou *OperationUpdate) SetUndone(b bool) *OperationUpdate {
+	ou.mutation.SetUndone(b)
+	return ou
+}
+
+// SetNillableUndone sets the "undone" field if the given value is not nil.
+func (ou *OperationUpdate) SetNillableUndone(b *bool) *OperationUpdate {
+	if b != nil {
+		ou.SetUndone(*b)
+	}
+	return ou
+}
+
+// Mutation returns the OperationMutation object of the builder.
+func (ou *OperationUpdate) Mutation() *OperationMutation {
+	return ou.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ou *OperationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ou.hooks) == 0 {
+		affected, err = ou.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OperationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ou.mutation = mutation
+			affected, err = ou.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ou.hooks) - 1; i >= 0; i-- {
+			if ou.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ou.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ou.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ou *OperationUpdate) SaveX(ctx context.Context) int {
+	affected, err := ou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ou *OperationUpdate) Exec(ctx context.Context) error {
+	_, err := ou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ou *OperationUpdate) ExecX(ctx context.Context) {
+	if err := ou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ou *OperationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   operation.Table,
+			Columns: operation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: operation.FieldID,
+			},
+		},
+	}
+	if ps := ou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ou.mutation.Undone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: operation.FieldUndone,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OperationUpdateOne is the builder for updating a single Operation entity.
+type OperationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OperationMutation
+}
+
+// SetUndone sets the "undone" field.
+func (ouo *OperationUpdateOne) SetUndone(b bool) *OperationUpdateOne {
+	ouo.mutation.SetUndone(b)
+	return ouo
+}
+
+// SetNillableUndone sets the "undone" field if the given value is not nil.
+func (ouo *OperationUpdateOne) SetNillableUndone(b *bool) *OperationUpdateOne {
+	if b != nil {
+		ouo.SetUndone(*b)
+	}
+	return ouo
+}
+
+// Mutation returns the OperationMutation object of the builder.
+func (ouo *OperationUpdateOne) Mutation() *OperationMutation {
+	return ouo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ouo *OperationUpdateOne) Select(field string, fields ...string) *OperationUpdateOne {
+	ouo.fields = append([]string{field}, fields...)
+	return ouo
+}
+
+// Save executes the query and returns the updated Operation entity.
+func (ouo *OperationUpdateOne) Save(ctx context.Context) (*Operation, error) {
+	var (
+		err  error
+		node *Operation
+	)
+	if len(ouo.hooks) == 0 {
+		node, err = ouo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OperationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ouo.mutation = mutation
+			node, err = ouo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ouo.hooks) - 1; i >= 0; i-- {
+			if ouo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ouo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ouo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ouo *OperationUpdateOne) SaveX(ctx context.Context) *Operation {
+	node, err := ouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ouo *OperationUpdateOne) Exec(ctx context.Context) error {
+	_, err := ouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ouo *OperationUpdateOne) ExecX(ctx context.Context) {
+	if err := ouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ouo *OperationUpdateOne) sqlSave(ctx context.Context) (_node *Operation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   operation.Table,
+			Columns: operation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: operation.FieldID,
+			},
+		},
+	}
+	id, ok := ouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Operation.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, operation.FieldID)
+		for _, f := range fields {
+			if !operation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != operation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ouo.mutation.Undone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: operation.FieldUndone,
+		})
+	}
+	_node = &Operation{config: ouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -6,5 +6,44 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
+// Collaborator is the predicate function for collaborator builders.
+type Collaborator func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
+// ItemHistory is the predicate function for itemhistory builders.
+type ItemHistory func(*sql.Selector)
+
+// Operation is the predicate function for operation builders.
+type Operation func(*sql.Selector)
+
+// Rule is the predicate function for rule builders.
+type Rule func(*sql.Selector)
+
+// RuleExecution is the predicate function for ruleexecution builders.
+type RuleExecution func(*sql.Selector)
+
+// ShareAccess is the predicate function for shareaccess builders.
+type ShareAccess func(*sql.Selector)
+
+// ShareToken is the predicate function for sharetoken builders.
+type ShareToken func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// Template is the predicate function for template builders.
+type Template func(*sql.Selector)
+
+// TimeEntry is the predicate function for timeentry builders.
+type TimeEntry func(*sql.Selector)
+
 // TodoItem is the predicate function for todoitem builders.
 type TodoItem func(*sql.Selector)
+
+// TodoList is the predicate function for todolist builders.
+type TodoList func(*sql.Selector)
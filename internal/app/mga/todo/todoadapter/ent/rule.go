@@ -0,0 +1,202 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/rule"
+)
+
+// Rule is the model entity for the Rule schema.
+type Rule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger string `json:"trigger,omitempty"`
+	// Conditions holds the value of the "conditions" field.
+	Conditions []todo.RuleCondition `json:"conditions,omitempty"`
+	// Actions holds the value of the "actions" field.
+	Actions []todo.RuleAction `json:"actions,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// DryRun holds the value of the "dry_run" field.
+	DryRun bool `json:"dry_run,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Rule) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rule.FieldConditions, rule.FieldActions:
+			values[i] = new([]byte)
+		case rule.FieldEnabled, rule.FieldDryRun:
+			values[i] = new(sql.NullBool)
+		case rule.FieldID:
+			values[i] = new(sql.NullInt64)
+		case rule.FieldUID, rule.FieldName, rule.FieldTrigger, rule.FieldOwner:
+			values[i] = new(sql.NullString)
+		case rule.FieldCreatedAt, rule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Rule", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Rule fields.
+func (r *Rule) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case rule.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				r.UID = value.String
+			}
+		case rule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				r.Name = value.String
+			}
+		case rule.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				r.Trigger = value.String
+			}
+		case rule.FieldConditions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field conditions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Conditions); err != nil {
+					return fmt.Errorf("unmarshal field conditions: %w", err)
+				}
+			}
+		case rule.FieldActions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field actions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Actions); err != nil {
+					return fmt.Errorf("unmarshal field actions: %w", err)
+				}
+			}
+		case rule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				r.Enabled = value.Bool
+			}
+		case rule.FieldDryRun:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dry_run", values[i])
+			} else if value.Valid {
+				r.DryRun = value.Bool
+			}
+		case rule.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				r.Owner = value.String
+			}
+		case rule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case rule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Rule.
+// Note that you need to call Rule.Unwrap() before calling this method if this Rule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Rule) Update() *RuleUpdateOne {
+	return (&RuleClient{config: r.config}).UpdateOne(r)
+}
+
+// Unwrap unwraps the Rule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Rule) Unwrap() *Rule {
+	tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Rule is not a transactional entity")
+	}
+	r.config.driver = tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Rule) String() string {
+	var builder strings.Builder
+	builder.WriteString("Rule(")
+	builder.WriteString(fmt.Sprintf("id=%v", r.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(r.UID)
+	builder.WriteString(", name=")
+	builder.WriteString(r.Name)
+	builder.WriteString(", trigger=")
+	builder.WriteString(r.Trigger)
+	builder.WriteString(", conditions=")
+	builder.WriteString(fmt.Sprintf("%v", r.Conditions))
+	builder.WriteString(", actions=")
+	builder.WriteString(fmt.Sprintf("%v", r.Actions))
+	builder.WriteString(", enabled=")
+	builder.WriteString(fmt.Sprintf("%v", r.Enabled))
+	builder.WriteString(", dry_run=")
+	builder.WriteString(fmt.Sprintf("%v", r.DryRun))
+	builder.WriteString(", owner=")
+	builder.WriteString(r.Owner)
+	builder.WriteString(", created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Rules is a parsable slice of Rule.
+type Rules []*Rule
+
+func (r Rules) config(cfg config) {
+	for _i := range r {
+		r[_i].config = cfg
+	}
+}
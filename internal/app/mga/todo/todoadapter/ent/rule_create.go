@@ -0,0 +1,450 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/rule"
+)
+
+// RuleCreate is the builder for creating a Rule entity.
+type RuleCreate struct {
+	config
+	mutation *RuleMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (rc *RuleCreate) SetUID(s string) *RuleCreate {
+	rc.mutation.SetUID(s)
+	return rc
+}
+
+// SetName sets the "name" field.
+func (rc *RuleCreate) SetName(s string) *RuleCreate {
+	rc.mutation.SetName(s)
+	return rc
+}
+
+// SetTrigger sets the "trigger" field.
+func (rc *RuleCreate) SetTrigger(s string) *RuleCreate {
+	rc.mutation.SetTrigger(s)
+	return rc
+}
+
+// SetConditions sets the "conditions" field.
+func (rc *RuleCreate) SetConditions(tc []todo.RuleCondition) *RuleCreate {
+	rc.mutation.SetConditions(tc)
+	return rc
+}
+
+// SetActions sets the "actions" field.
+func (rc *RuleCreate) SetActions(ta []todo.RuleAction) *RuleCreate {
+	rc.mutation.SetActions(ta)
+	return rc
+}
+
+// SetEnabled sets the "enabled" field.
+func (rc *RuleCreate) SetEnabled(b bool) *RuleCreate {
+	rc.mutation.SetEnabled(b)
+	return rc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rc *RuleCreate) SetNillableEnabled(b *bool) *RuleCreate {
+	if b != nil {
+		rc.SetEnabled(*b)
+	}
+	return rc
+}
+
+// SetDryRun sets the "dry_run" field.
+func (rc *RuleCreate) SetDryRun(b bool) *RuleCreate {
+	rc.mutation.SetDryRun(b)
+	return rc
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (rc *RuleCreate) SetNillableDryRun(b *bool) *RuleCreate {
+	if b != nil {
+		rc.SetDryRun(*b)
+	}
+	return rc
+}
+
+// SetOwner sets the "owner" field.
+func (rc *RuleCreate) SetOwner(s string) *RuleCreate {
+	rc.mutation.SetOwner(s)
+	return rc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (rc *RuleCreate) SetNillableOwner(s *string) *RuleCreate {
+	if s != nil {
+		rc.SetOwner(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *RuleCreate) SetCreatedAt(t time.Time) *RuleCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *RuleCreate) SetNillableCreatedAt(t *time.Time) *RuleCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *RuleCreate) SetUpdatedAt(t time.Time) *RuleCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *RuleCreate) SetNillableUpdatedAt(t *time.Time) *RuleCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (rc *RuleCreate) Mutation() *RuleMutation {
+	return rc.mutation
+}
+
+// Save creates the Rule in the database.
+func (rc *RuleCreate) Save(ctx context.Context) (*Rule, error) {
+	var (
+		err  error
+		node *Rule
+	)
+	rc.defaults()
+	if len(rc.hooks) == 0 {
+		if err = rc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RuleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rc.check(); err != nil {
+				return nil, err
+			}
+			rc.mutation = mutation
+			if node, err = rc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rc.hooks) - 1; i >= 0; i-- {
+			if rc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RuleCreate) SaveX(ctx context.Context) *Rule {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RuleCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RuleCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RuleCreate) defaults() {
+	if _, ok := rc.mutation.Enabled(); !ok {
+		v := rule.DefaultEnabled
+		rc.mutation.SetEnabled(v)
+	}
+	if _, ok := rc.mutation.DryRun(); !ok {
+		v := rule.DefaultDryRun
+		rc.mutation.SetDryRun(v)
+	}
+	if _, ok := rc.mutation.Owner(); !ok {
+		v := rule.DefaultOwner
+		rc.mutation.SetOwner(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := rule.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := rule.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RuleCreate) check() error {
+	if _, ok := rc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := rc.mutation.UID(); ok {
+		if err := rule.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "name"`)}
+	}
+	if v, ok := rc.mutation.Name(); ok {
+		if err := rule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "name": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "trigger"`)}
+	}
+	if v, ok := rc.mutation.Trigger(); ok {
+		if err := rule.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "trigger": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "enabled"`)}
+	}
+	if _, ok := rc.mutation.DryRun(); !ok {
+		return &ValidationError{Name: "dry_run", err: errors.New(`ent: missing required field "dry_run"`)}
+	}
+	if _, ok := rc.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "owner"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "updated_at"`)}
+	}
+	return nil
+}
+
+func (rc *RuleCreate) sqlSave(ctx context.Context) (*Rule, error) {
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (rc *RuleCreate) createSpec() (*Rule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Rule{config: rc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: rule.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: rule.FieldID,
+			},
+		}
+	)
+	if value, ok := rc.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: rule.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := rc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: rule.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := rc.mutation.Trigger(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: rule.FieldTrigger,
+		})
+		_node.Trigger = value
+	}
+	if value, ok := rc.mutation.Conditions(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: rule.FieldConditions,
+		})
+		_node.Conditions = value
+	}
+	if value, ok := rc.mutation.Actions(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: rule.FieldActions,
+		})
+		_node.Actions = value
+	}
+	if value, ok := rc.mutation.Enabled(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: rule.FieldEnabled,
+		})
+		_node.Enabled = value
+	}
+	if value, ok := rc.mutation.DryRun(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: rule.FieldDryRun,
+		})
+		_node.DryRun = value
+	}
+	if value, ok := rc.mutation.Owner(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: rule.FieldOwner,
+		})
+		_node.Owner = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: rule.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: rule.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// RuleCreateBulk is the builder for creating many Rule entities in bulk.
+type RuleCreateBulk struct {
+	config
+	builders []*RuleCreate
+}
+
+// Save creates the Rule entities in the database.
+func (rcb *RuleCreateBulk) Save(ctx context.Context) ([]*Rule, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Rule, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RuleCreateBulk) SaveX(ctx context.Context) []*Rule {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RuleCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
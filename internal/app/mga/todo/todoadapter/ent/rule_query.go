@@ -0,0 +1,913 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/rule"
+)
+
+// RuleQuery is the builder for querying Rule entities.
+type RuleQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Rule
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RuleQuery builder.
+func (rq *RuleQuery) Where(ps ...predicate.Rule) *RuleQuery {
+	rq.predicates = append(rq.predicates, ps...)
+	return rq
+}
+
+// Limit adds a limit step to the query.
+func (rq *RuleQuery) Limit(limit int) *RuleQuery {
+	rq.limit = &limit
+	return rq
+}
+
+// Offset adds an offset step to the query.
+func (rq *RuleQuery) Offset(offset int) *RuleQuery {
+	rq.offset = &offset
+	return rq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rq *RuleQuery) Unique(unique bool) *RuleQuery {
+	rq.unique = &unique
+	return rq
+}
+
+// Order adds an order step to the query.
+func (rq *RuleQuery) Order(o ...OrderFunc) *RuleQuery {
+	rq.order = append(rq.order, o...)
+	return rq
+}
+
+// First returns the first Rule entity from the query.
+// Returns a *NotFoundError when no Rule was found.
+func (rq *RuleQuery) First(ctx context.Context) (*Rule, error) {
+	nodes, err := rq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{rule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rq *RuleQuery) FirstX(ctx context.Context) *Rule {
+	node, err := rq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Rule ID from the query.
+// Returns a *NotFoundError when no Rule ID was found.
+func (rq *RuleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{rule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rq *RuleQuery) FirstIDX(ctx context.Context) int {
+	id, err := rq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Rule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one Rule entity is not found.
+// Returns a *NotFoundError when no Rule entities are found.
+func (rq *RuleQuery) Only(ctx context.Context) (*Rule, error) {
+	nodes, err := rq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{rule.Label}
+	default:
+		return nil, &NotSingularError{rule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rq *RuleQuery) OnlyX(ctx context.Context) *Rule {
+	node, err := rq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Rule ID in the query.
+// Returns a *NotSingularError when exactly one Rule ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (rq *RuleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{rule.Label}
+	default:
+		err = &NotSingularError{rule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rq *RuleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Rules.
+func (rq *RuleQuery) All(ctx context.Context) ([]*Rule, error) {
+	if err := rq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rq *RuleQuery) AllX(ctx context.Context) []*Rule {
+	nodes, err := rq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Rule IDs.
+func (rq *RuleQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := rq.Select(rule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rq *RuleQuery) IDsX(ctx context.Context) []int {
+	ids, err := rq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rq *RuleQuery) Count(ctx context.Context) (int, error) {
+	if err := rq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rq *RuleQuery) CountX(ctx context.Context) int {
+	count, err := rq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rq *RuleQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rq *RuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := rq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rq *RuleQuery) Clone() *RuleQuery {
+	if rq == nil {
+		return nil
+	}
+	return &RuleQuery{
+		config:     rq.config,
+		limit:      rq.limit,
+		offset:     rq.offset,
+		order:      append([]OrderFunc{}, rq.order...),
+		predicates: append([]predicate.Rule{}, rq.predicates...),
+		// clone intermediate query.
+		sql:  rq.sql.Clone(),
+		path: rq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Rule.Query().
+//		GroupBy(rule.FieldUID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rq *RuleQuery) GroupBy(field string, fields ...string) *RuleGroupBy {
+	group := &RuleGroupBy{config: rq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//	}
+//
+//	client.Rule.Query().
+//		Select(rule.FieldUID).
+//		Scan(ctx, &v)
+func (rq *RuleQuery) Select(fields ...string) *RuleSelect {
+	rq.fields = append(rq.fields, fields...)
+	return &RuleSelect{RuleQuery: rq}
+}
+
+func (rq *RuleQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rq.fields {
+		if !rule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rq.path != nil {
+		prev, err := rq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rq.sql = prev
+	}
+	return nil
+}
+
+func (rq *RuleQuery) sqlAll(ctx context.Context) ([]*Rule, error) {
+	var (
+		nodes = []*Rule{}
+		_spec = rq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Rule{config: rq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rq *RuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rq.querySpec()
+	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+}
+
+func (rq *RuleQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rq *RuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   rule.Table,
+			Columns: rule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: rule.FieldID,
+			},
+		},
+		From:   rq.sql,
+		Unique: true,
+	}
+	if unique := rq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rule.FieldID)
+		for i := range fields {
+			if fields[i] != rule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rq *RuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rq.driver.Dialect())
+	t1 := builder.Table(rule.Table)
+	columns := rq.fields
+	if len(columns) == 0 {
+		columns = rule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rq.sql != nil {
+		selector = rq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range rq.predicates {
+		p(selector)
+	}
+	for _, p := range rq.order {
+		p(selector)
+	}
+	if offset := rq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RuleGroupBy is the group-by builder for Rule entities.
+type RuleGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rgb *RuleGroupBy) Aggregate(fns ...AggregateFunc) *RuleGroupBy {
+	rgb.fns = append(rgb.fns, fns...)
+	return rgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rgb *RuleGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rgb.sql = query
+	return rgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (rgb *RuleGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := rgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rgb *RuleGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(rgb.fields) > 1 {
+		return nil, errors.New("ent: RuleGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := rgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (rgb *RuleGroupBy) StringsX(ctx context.Context) []string {
+	v, err := rgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rgb *RuleGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = rgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{rule.Label}
+	default:
+		err = fmt.Errorf("ent: RuleGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (rgb *RuleGroupBy) StringX(ctx context.Context) string {
+	v, err := rgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rgb *RuleGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(rgb.fields) > 1 {
+		return nil, errors.New("ent: RuleGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := rgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (rgb *RuleGroupBy) IntsX(ctx context.Context) []int {
+	v, err := rgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rgb *RuleGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = rgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{rule.Label}
+	default:
+		err = fmt.Errorf("ent: RuleGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (rgb *RuleGroupBy) IntX(ctx context.Context) int {
+	v, err := rgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rgb *RuleGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(rgb.fields) > 1 {
+		return nil, errors.New("ent: RuleGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := rgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (rgb *RuleGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := rgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rgb *RuleGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = rgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{rule.Label}
+	default:
+		err = fmt.Errorf("ent: RuleGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (rgb *RuleGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := rgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rgb *RuleGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(rgb.fields) > 1 {
+		return nil, errors.New("ent: RuleGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := rgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (rgb *RuleGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := rgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rgb *RuleGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = rgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{rule.Label}
+	default:
+		err = fmt.Errorf("ent: RuleGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (rgb *RuleGroupBy) BoolX(ctx context.Context) bool {
+	v, err := rgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (rgb *RuleGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rgb.fields {
+		if !rule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rgb *RuleGroupBy) sqlQuery() *sql.Selector {
+	selector := rgb.sql.Select()
+	aggregation := make([]string, 0, len(rgb.fns))
+	for _, fn := range rgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rgb.fields)+len(rgb.fns))
+		for _, f := range rgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rgb.fields...)...)
+}
+
+// RuleSelect is the builder for selecting fields of Rule entities.
+type RuleSelect struct {
+	*RuleQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rs *RuleSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rs.sql = rs.RuleQuery.sqlQuery(ctx)
+	return rs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (rs *RuleSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := rs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (rs *RuleSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(rs.fields) > 1 {
+		return nil, errors.New("ent: RuleSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := rs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (rs *RuleSelect) StringsX(ctx context.Context) []string {
+	v, err := rs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (rs *RuleSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = rs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{rule.Label}
+	default:
+		err = fmt.Errorf("ent: RuleSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (rs *RuleSelect) StringX(ctx context.Context) string {
+	v, err := rs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (rs *RuleSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(rs.fields) > 1 {
+		return nil, errors.New("ent: RuleSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := rs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (rs *RuleSelect) IntsX(ctx context.Context) []int {
+	v, err := rs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (rs *RuleSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = rs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{rule.Label}
+	default:
+		err = fmt.Errorf("ent: RuleSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (rs *RuleSelect) IntX(ctx context.Context) int {
+	v, err := rs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (rs *RuleSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(rs.fields) > 1 {
+		return nil, errors.New("ent: RuleSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := rs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (rs *RuleSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := rs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (rs *RuleSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = rs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{rule.Label}
+	default:
+		err = fmt.Errorf("ent: RuleSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (rs *RuleSelect) Float64X(ctx context.Context) float64 {
+	v, err := rs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (rs *RuleSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(rs.fields) > 1 {
+		return nil, errors.New("ent: RuleSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := rs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (rs *RuleSelect) BoolsX(ctx context.Context) []bool {
+	v, err := rs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (rs *RuleSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = rs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{rule.Label}
+	default:
+		err = fmt.Errorf("ent: RuleSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (rs *RuleSelect) BoolX(ctx context.Context) bool {
+	v, err := rs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (rs *RuleSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rs.sql.Query()
+	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,562 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/rule"
+)
+
+// RuleUpdate is the builder for updating Rule entities.
+type RuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RuleMutation
+}
+
+// Where appends a list predicates to the RuleUpdate builder.
+func (ru *RuleUpdate) Where(ps ...predicate.Rule) *RuleUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetName sets the "name" field.
+func (ru *RuleUpdate) SetName(s string) *RuleUpdate {
+	ru.mutation.SetName(s)
+	return ru
+}
+
+// SetTrigger sets the "trigger" field.
+func (ru *RuleUpdate) SetTrigger(s string) *RuleUpdate {
+	ru.mutation.SetTrigger(s)
+	return ru
+}
+
+// SetConditions sets the "conditions" field.
+func (ru *RuleUpdate) SetConditions(tc []todo.RuleCondition) *RuleUpdate {
+	ru.mutation.SetConditions(tc)
+	return ru
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (ru *RuleUpdate) ClearConditions() *RuleUpdate {
+	ru.mutation.ClearConditions()
+	return ru
+}
+
+// SetActions sets the "actions" field.
+func (ru *RuleUpdate) SetActions(ta []todo.RuleAction) *RuleUpdate {
+	ru.mutation.SetActions(ta)
+	return ru
+}
+
+// ClearActions clears the value of the "actions" field.
+func (ru *RuleUpdate) ClearActions() *RuleUpdate {
+	ru.mutation.ClearActions()
+	return ru
+}
+
+// SetEnabled sets the "enabled" field.
+func (ru *RuleUpdate) SetEnabled(b bool) *RuleUpdate {
+	ru.mutation.SetEnabled(b)
+	return ru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ru *RuleUpdate) SetNillableEnabled(b *bool) *RuleUpdate {
+	if b != nil {
+		ru.SetEnabled(*b)
+	}
+	return ru
+}
+
+// SetDryRun sets the "dry_run" field.
+func (ru *RuleUpdate) SetDryRun(b bool) *RuleUpdate {
+	ru.mutation.SetDryRun(b)
+	return ru
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (ru *RuleUpdate) SetNillableDryRun(b *bool) *RuleUpdate {
+	if b != nil {
+		ru.SetDryRun(*b)
+	}
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *RuleUpdate) SetUpdatedAt(t time.Time) *RuleUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (ru *RuleUpdate) Mutation() *RuleMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RuleUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	ru.defaults()
+	if len(ru.hooks) == 0 {
+		if err = ru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RuleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ru.check(); err != nil {
+				return 0, err
+			}
+			ru.mutation = mutation
+			affected, err = ru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ru.hooks) - 1; i >= 0; i-- {
+			if ru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RuleUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RuleUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *RuleUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := rule.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *RuleUpdate) check() error {
+	if v, ok := ru.mutation.Name(); ok {
+		if err := rule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := ru.mutation.Trigger(); ok {
+		if err := rule.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf("ent: validator failed for field \"trigger\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (ru *RuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   rule.Table,
+			Columns: rule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: rule.FieldID,
+			},
+		},
+	}
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: rule.FieldName,
+		})
+	}
+	if value, ok := ru.mutation.Trigger(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: rule.FieldTrigger,
+		})
+	}
+	if value, ok := ru.mutation.Conditions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: rule.FieldConditions,
+		})
+	}
+	if ru.mutation.ConditionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: rule.FieldConditions,
+		})
+	}
+	if value, ok := ru.mutation.Actions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: rule.FieldActions,
+		})
+	}
+	if ru.mutation.ActionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: rule.FieldActions,
+		})
+	}
+	if value, ok := ru.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: rule.FieldEnabled,
+		})
+	}
+	if value, ok := ru.mutation.DryRun(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: rule.FieldDryRun,
+		})
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: rule.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RuleUpdateOne is the builder for updating a single Rule entity.
+type RuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RuleMutation
+}
+
+// SetName sets the "name" field.
+func (ruo *RuleUpdateOne) SetName(s string) *RuleUpdateOne {
+	ruo.mutation.SetName(s)
+	return ruo
+}
+
+// SetTrigger sets the "trigger" field.
+func (ruo *RuleUpdateOne) SetTrigger(s string) *RuleUpdateOne {
+	ruo.mutation.SetTrigger(s)
+	return ruo
+}
+
+// SetConditions sets the "conditions" field.
+func (ruo *RuleUpdateOne) SetConditions(tc []todo.RuleCondition) *RuleUpdateOne {
+	ruo.mutation.SetConditions(tc)
+	return ruo
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (ruo *RuleUpdateOne) ClearConditions() *RuleUpdateOne {
+	ruo.mutation.ClearConditions()
+	return ruo
+}
+
+// SetActions sets the "actions" field.
+func (ruo *RuleUpdateOne) SetActions(ta []todo.RuleAction) *RuleUpdateOne {
+	ruo.mutation.SetActions(ta)
+	return ruo
+}
+
+// ClearActions clears the value of the "actions" field.
+func (ruo *RuleUpdateOne) ClearActions() *RuleUpdateOne {
+	ruo.mutation.ClearActions()
+	return ruo
+}
+
+// SetEnabled sets the "enabled" field.
+func (ruo *RuleUpdateOne) SetEnabled(b bool) *RuleUpdateOne {
+	ruo.mutation.SetEnabled(b)
+	return ruo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ruo *RuleUpdateOne) SetNillableEnabled(b *bool) *RuleUpdateOne {
+	if b != nil {
+		ruo.SetEnabled(*b)
+	}
+	return ruo
+}
+
+// SetDryRun sets the "dry_run" field.
+func (ruo *RuleUpdateOne) SetDryRun(b bool) *RuleUpdateOne {
+	ruo.mutation.SetDryRun(b)
+	return ruo
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (ruo *RuleUpdateOne) SetNillableDryRun(b *bool) *RuleUpdateOne {
+	if b != nil {
+		ruo.SetDryRun(*b)
+	}
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *RuleUpdateOne) SetUpdatedAt(t time.Time) *RuleUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (ruo *RuleUpdateOne) Mutation() *RuleMutation {
+	return ruo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RuleUpdateOne) Select(field string, fields ...string) *RuleUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Rule entity.
+func (ruo *RuleUpdateOne) Save(ctx context.Context) (*Rule, error) {
+	var (
+		err  error
+		node *Rule
+	)
+	ruo.defaults()
+	if len(ruo.hooks) == 0 {
+		if err = ruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RuleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ruo.check(); err != nil {
+				return nil, err
+			}
+			ruo.mutation = mutation
+			node, err = ruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ruo.hooks) - 1; i >= 0; i-- {
+			if ruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RuleUpdateOne) SaveX(ctx context.Context) *Rule {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RuleUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *RuleUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := rule.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *RuleUpdateOne) check() error {
+	if v, ok := ruo.mutation.Name(); ok {
+		if err := rule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := ruo.mutation.Trigger(); ok {
+		if err := rule.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf("ent: validator failed for field \"trigger\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (ruo *RuleUpdateOne) sqlSave(ctx context.Context) (_node *Rule, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   rule.Table,
+			Columns: rule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: rule.FieldID,
+			},
+		},
+	}
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Rule.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rule.FieldID)
+		for _, f := range fields {
+			if !rule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: rule.FieldName,
+		})
+	}
+	if value, ok := ruo.mutation.Trigger(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: rule.FieldTrigger,
+		})
+	}
+	if value, ok := ruo.mutation.Conditions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: rule.FieldConditions,
+		})
+	}
+	if ruo.mutation.ConditionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: rule.FieldConditions,
+		})
+	}
+	if value, ok := ruo.mutation.Actions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: rule.FieldActions,
+		})
+	}
+	if ruo.mutation.ActionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: rule.FieldActions,
+		})
+	}
+	if value, ok := ruo.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: rule.FieldEnabled,
+		})
+	}
+	if value, ok := ruo.mutation.DryRun(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: rule.FieldDryRun,
+		})
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: rule.FieldUpdatedAt,
+		})
+	}
+	_node = &Rule{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/ruleexecution"
+)
+
+// RuleExecution is the model entity for the RuleExecution schema.
+type RuleExecution struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// RuleUID holds the value of the "rule_uid" field.
+	RuleUID string `json:"rule_uid,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger string `json:"trigger,omitempty"`
+	// ItemUID holds the value of the "item_uid" field.
+	ItemUID string `json:"item_uid,omitempty"`
+	// CorrelationID holds the value of the "correlation_id" field.
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// ExecutedAt holds the value of the "executed_at" field.
+	ExecutedAt time.Time `json:"executed_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RuleExecution) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ruleexecution.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ruleexecution.FieldUID, ruleexecution.FieldRuleUID, ruleexecution.FieldTrigger, ruleexecution.FieldItemUID, ruleexecution.FieldCorrelationID, ruleexecution.FieldStatus, ruleexecution.FieldError:
+			values[i] = new(sql.NullString)
+		case ruleexecution.FieldExecutedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type RuleExecution", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RuleExecution fields.
+func (re *RuleExecution) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ruleexecution.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			re.ID = int(value.Int64)
+		case ruleexecution.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				re.UID = value.String
+			}
+		case ruleexecution.FieldRuleUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_uid", values[i])
+			} else if value.Valid {
+				re.RuleUID = value.String
+			}
+		case ruleexecution.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				re.Trigger = value.String
+			}
+		case ruleexecution.FieldItemUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_uid", values[i])
+			} else if value.Valid {
+				re.ItemUID = value.String
+			}
+		case ruleexecution.FieldCorrelationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field correlation_id", values[i])
+			} else if value.Valid {
+				re.CorrelationID = value.String
+			}
+		case ruleexecution.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				re.Status = value.String
+			}
+		case ruleexecution.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				re.Error = value.String
+			}
+		case ruleexecution.FieldExecutedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field executed_at", values[i])
+			} else if value.Valid {
+				re.ExecutedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this RuleExecution.
+// Note that you need to call RuleExecution.Unwrap() before calling this method if this RuleExecution
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (re *RuleExecution) Update() *RuleExecutionUpdateOne {
+	return (&RuleExecutionClient{config: re.config}).UpdateOne(re)
+}
+
+// Unwrap unwraps the RuleExecution entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (re *RuleExecution) Unwrap() *RuleExecution {
+	tx, ok := re.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RuleExecution is not a transactional entity")
+	}
+	re.config.driver = tx.drv
+	return re
+}
+
+// String implements the fmt.Stringer.
+func (re *RuleExecution) String() string {
+	var builder strings.Builder
+	builder.WriteString("RuleExecution(")
+	builder.WriteString(fmt.Sprintf("id=%v", re.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(re.UID)
+	builder.WriteString(", rule_uid=")
+	builder.WriteString(re.RuleUID)
+	builder.WriteString(", trigger=")
+	builder.WriteString(re.Trigger)
+	builder.WriteString(", item_uid=")
+	builder.WriteString(re.ItemUID)
+	builder.WriteString(", correlation_id=")
+	builder.WriteString(re.CorrelationID)
+	builder.WriteString(", status=")
+	builder.WriteString(re.Status)
+	builder.WriteString(", error=")
+	builder.WriteString(re.Error)
+	builder.WriteString(", executed_at=")
+	builder.WriteString(re.ExecutedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RuleExecutions is a parsable slice of RuleExecution.
+type RuleExecutions []*RuleExecution
+
+func (re RuleExecutions) config(cfg config) {
+	for _i := range re {
+		re[_i].config = cfg
+	}
+}
@@ -0,0 +1,70 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ruleexecution
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the ruleexecution type in the database.
+	Label = "rule_execution"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldRuleUID holds the string denoting the rule_uid field in the database.
+	FieldRuleUID = "rule_uid"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldItemUID holds the string denoting the item_uid field in the database.
+	FieldItemUID = "item_uid"
+	// FieldCorrelationID holds the string denoting the correlation_id field in the database.
+	FieldCorrelationID = "correlation_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldExecutedAt holds the string denoting the executed_at field in the database.
+	FieldExecutedAt = "executed_at"
+	// Table holds the table name of the ruleexecution in the database.
+	Table = "rule_executions"
+)
+
+// Columns holds all SQL columns for ruleexecution fields.
+var Columns = []string{
+	FieldID,
+	FieldUID,
+	FieldRuleUID,
+	FieldTrigger,
+	FieldItemUID,
+	FieldCorrelationID,
+	FieldStatus,
+	FieldError,
+	FieldExecutedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	UIDValidator func(string) error
+	// RuleUIDValidator is a validator for the "rule_uid" field. It is called by the builders before save.
+	RuleUIDValidator func(string) error
+	// ItemUIDValidator is a validator for the "item_uid" field. It is called by the builders before save.
+	ItemUIDValidator func(string) error
+	// DefaultCorrelationID holds the default value on creation for the "correlation_id" field.
+	DefaultCorrelationID string
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultExecutedAt holds the default value on creation for the "executed_at" field.
+	DefaultExecutedAt func() time.Time
+)
@@ -0,0 +1,1034 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ruleexecution
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUID), v))
+	})
+}
+
+// RuleUID applies equality check predicate on the "rule_uid" field. It's identical to RuleUIDEQ.
+func RuleUID(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRuleUID), v))
+	})
+}
+
+// Trigger applies equality check predicate on the "trigger" field. It's identical to TriggerEQ.
+func Trigger(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrigger), v))
+	})
+}
+
+// ItemUID applies equality check predicate on the "item_uid" field. It's identical to ItemUIDEQ.
+func ItemUID(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemUID), v))
+	})
+}
+
+// CorrelationID applies equality check predicate on the "correlation_id" field. It's identical to CorrelationIDEQ.
+func CorrelationID(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCorrelationID), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldError), v))
+	})
+}
+
+// ExecutedAt applies equality check predicate on the "executed_at" field. It's identical to ExecutedAtEQ.
+func ExecutedAt(v time.Time) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExecutedAt), v))
+	})
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUID), v))
+	})
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUID), v))
+	})
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUID), v...))
+	})
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUID), v...))
+	})
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUID), v))
+	})
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUID), v))
+	})
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUID), v))
+	})
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUID), v))
+	})
+}
+
+// UIDContains applies the Contains predicate on the "uid" field.
+func UIDContains(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUID), v))
+	})
+}
+
+// UIDHasPrefix applies the HasPrefix predicate on the "uid" field.
+func UIDHasPrefix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUID), v))
+	})
+}
+
+// UIDHasSuffix applies the HasSuffix predicate on the "uid" field.
+func UIDHasSuffix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUID), v))
+	})
+}
+
+// UIDEqualFold applies the EqualFold predicate on the "uid" field.
+func UIDEqualFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUID), v))
+	})
+}
+
+// UIDContainsFold applies the ContainsFold predicate on the "uid" field.
+func UIDContainsFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUID), v))
+	})
+}
+
+// RuleUIDEQ applies the EQ predicate on the "rule_uid" field.
+func RuleUIDEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDNEQ applies the NEQ predicate on the "rule_uid" field.
+func RuleUIDNEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDIn applies the In predicate on the "rule_uid" field.
+func RuleUIDIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRuleUID), v...))
+	})
+}
+
+// RuleUIDNotIn applies the NotIn predicate on the "rule_uid" field.
+func RuleUIDNotIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRuleUID), v...))
+	})
+}
+
+// RuleUIDGT applies the GT predicate on the "rule_uid" field.
+func RuleUIDGT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDGTE applies the GTE predicate on the "rule_uid" field.
+func RuleUIDGTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDLT applies the LT predicate on the "rule_uid" field.
+func RuleUIDLT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDLTE applies the LTE predicate on the "rule_uid" field.
+func RuleUIDLTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDContains applies the Contains predicate on the "rule_uid" field.
+func RuleUIDContains(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDHasPrefix applies the HasPrefix predicate on the "rule_uid" field.
+func RuleUIDHasPrefix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDHasSuffix applies the HasSuffix predicate on the "rule_uid" field.
+func RuleUIDHasSuffix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDEqualFold applies the EqualFold predicate on the "rule_uid" field.
+func RuleUIDEqualFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRuleUID), v))
+	})
+}
+
+// RuleUIDContainsFold applies the ContainsFold predicate on the "rule_uid" field.
+func RuleUIDContainsFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRuleUID), v))
+	})
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTrigger), v...))
+	})
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTrigger), v...))
+	})
+}
+
+// TriggerGT applies the GT predicate on the "trigger" field.
+func TriggerGT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerGTE applies the GTE predicate on the "trigger" field.
+func TriggerGTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerLT applies the LT predicate on the "trigger" field.
+func TriggerLT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerLTE applies the LTE predicate on the "trigger" field.
+func TriggerLTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerContains applies the Contains predicate on the "trigger" field.
+func TriggerContains(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerHasPrefix applies the HasPrefix predicate on the "trigger" field.
+func TriggerHasPrefix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerHasSuffix applies the HasSuffix predicate on the "trigger" field.
+func TriggerHasSuffix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerEqualFold applies the EqualFold predicate on the "trigger" field.
+func TriggerEqualFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTrigger), v))
+	})
+}
+
+// TriggerContainsFold applies the ContainsFold predicate on the "trigger" field.
+func TriggerContainsFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTrigger), v))
+	})
+}
+
+// ItemUIDEQ applies the EQ predicate on the "item_uid" field.
+func ItemUIDEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDNEQ applies the NEQ predicate on the "item_uid" field.
+func ItemUIDNEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDIn applies the In predicate on the "item_uid" field.
+func ItemUIDIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldItemUID), v...))
+	})
+}
+
+// ItemUIDNotIn applies the NotIn predicate on the "item_uid" field.
+func ItemUIDNotIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldItemUID), v...))
+	})
+}
+
+// ItemUIDGT applies the GT predicate on the "item_uid" field.
+func ItemUIDGT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDGTE applies the GTE predicate on the "item_uid" field.
+func ItemUIDGTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDLT applies the LT predicate on the "item_uid" field.
+func ItemUIDLT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDLTE applies the LTE predicate on the "item_uid" field.
+func ItemUIDLTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDContains applies the Contains predicate on the "item_uid" field.
+func ItemUIDContains(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDHasPrefix applies the HasPrefix predicate on the "item_uid" field.
+func ItemUIDHasPrefix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDHasSuffix applies the HasSuffix predicate on the "item_uid" field.
+func ItemUIDHasSuffix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDEqualFold applies the EqualFold predicate on the "item_uid" field.
+func ItemUIDEqualFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDContainsFold applies the ContainsFold predicate on the "item_uid" field.
+func ItemUIDContainsFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldItemUID), v))
+	})
+}
+
+// CorrelationIDEQ applies the EQ predicate on the "correlation_id" field.
+func CorrelationIDEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDNEQ applies the NEQ predicate on the "correlation_id" field.
+func CorrelationIDNEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDIn applies the In predicate on the "correlation_id" field.
+func CorrelationIDIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCorrelationID), v...))
+	})
+}
+
+// CorrelationIDNotIn applies the NotIn predicate on the "correlation_id" field.
+func CorrelationIDNotIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCorrelationID), v...))
+	})
+}
+
+// CorrelationIDGT applies the GT predicate on the "correlation_id" field.
+func CorrelationIDGT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDGTE applies the GTE predicate on the "correlation_id" field.
+func CorrelationIDGTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDLT applies the LT predicate on the "correlation_id" field.
+func CorrelationIDLT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDLTE applies the LTE predicate on the "correlation_id" field.
+func CorrelationIDLTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDContains applies the Contains predicate on the "correlation_id" field.
+func CorrelationIDContains(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDHasPrefix applies the HasPrefix predicate on the "correlation_id" field.
+func CorrelationIDHasPrefix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDHasSuffix applies the HasSuffix predicate on the "correlation_id" field.
+func CorrelationIDHasSuffix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDEqualFold applies the EqualFold predicate on the "correlation_id" field.
+func CorrelationIDEqualFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDContainsFold applies the ContainsFold predicate on the "correlation_id" field.
+func CorrelationIDContainsFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCorrelationID), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldError), v))
+	})
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldError), v))
+	})
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldError), v...))
+	})
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldError), v...))
+	})
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldError), v))
+	})
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldError), v))
+	})
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldError), v))
+	})
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldError), v))
+	})
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldError), v))
+	})
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldError), v))
+	})
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldError), v))
+	})
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldError), v))
+	})
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldError), v))
+	})
+}
+
+// ExecutedAtEQ applies the EQ predicate on the "executed_at" field.
+func ExecutedAtEQ(v time.Time) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExecutedAt), v))
+	})
+}
+
+// ExecutedAtNEQ applies the NEQ predicate on the "executed_at" field.
+func ExecutedAtNEQ(v time.Time) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExecutedAt), v))
+	})
+}
+
+// ExecutedAtIn applies the In predicate on the "executed_at" field.
+func ExecutedAtIn(vs ...time.Time) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExecutedAt), v...))
+	})
+}
+
+// ExecutedAtNotIn applies the NotIn predicate on the "executed_at" field.
+func ExecutedAtNotIn(vs ...time.Time) predicate.RuleExecution {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExecutedAt), v...))
+	})
+}
+
+// ExecutedAtGT applies the GT predicate on the "executed_at" field.
+func ExecutedAtGT(v time.Time) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExecutedAt), v))
+	})
+}
+
+// ExecutedAtGTE applies the GTE predicate on the "executed_at" field.
+func ExecutedAtGTE(v time.Time) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExecutedAt), v))
+	})
+}
+
+// ExecutedAtLT applies the LT predicate on the "executed_at" field.
+func ExecutedAtLT(v time.Time) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExecutedAt), v))
+	})
+}
+
+// ExecutedAtLTE applies the LTE predicate on the "executed_at" field.
+func ExecutedAtLTE(v time.Time) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExecutedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RuleExecution) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RuleExecution) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RuleExecution) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,397 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/ruleexecution"
+)
+
+// RuleExecutionCreate is the builder for creating a RuleExecution entity.
+type RuleExecutionCreate struct {
+	config
+	mutation *RuleExecutionMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (rec *RuleExecutionCreate) SetUID(s string) *RuleExecutionCreate {
+	rec.mutation.SetUID(s)
+	return rec
+}
+
+// SetRuleUID sets the "rule_uid" field.
+func (rec *RuleExecutionCreate) SetRuleUID(s string) *RuleExecutionCreate {
+	rec.mutation.SetRuleUID(s)
+	return rec
+}
+
+// SetTrigger sets the "trigger" field.
+func (rec *RuleExecutionCreate) SetTrigger(s string) *RuleExecutionCreate {
+	rec.mutation.SetTrigger(s)
+	return rec
+}
+
+// SetItemUID sets the "item_uid" field.
+func (rec *RuleExecutionCreate) SetItemUID(s string) *RuleExecutionCreate {
+	rec.mutation.SetItemUID(s)
+	return rec
+}
+
+// SetCorrelationID sets the "correlation_id" field.
+func (rec *RuleExecutionCreate) SetCorrelationID(s string) *RuleExecutionCreate {
+	rec.mutation.SetCorrelationID(s)
+	return rec
+}
+
+// SetNillableCorrelationID sets the "correlation_id" field if the given value is not nil.
+func (rec *RuleExecutionCreate) SetNillableCorrelationID(s *string) *RuleExecutionCreate {
+	if s != nil {
+		rec.SetCorrelationID(*s)
+	}
+	return rec
+}
+
+// SetStatus sets the "status" field.
+func (rec *RuleExecutionCreate) SetStatus(s string) *RuleExecutionCreate {
+	rec.mutation.SetStatus(s)
+	return rec
+}
+
+// SetError sets the "error" field.
+func (rec *RuleExecutionCreate) SetError(s string) *RuleExecutionCreate {
+	rec.mutation.SetError(s)
+	return rec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rec *RuleExecutionCreate) SetNillableError(s *string) *RuleExecutionCreate {
+	if s != nil {
+		rec.SetError(*s)
+	}
+	return rec
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (rec *RuleExecutionCreate) SetExecutedAt(t time.Time) *RuleExecutionCreate {
+	rec.mutation.SetExecutedAt(t)
+	return rec
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (rec *RuleExecutionCreate) SetNillableExecutedAt(t *time.Time) *RuleExecutionCreate {
+	if t != nil {
+		rec.SetExecutedAt(*t)
+	}
+	return rec
+}
+
+// Mutation returns the RuleExecutionMutation object of the builder.
+func (rec *RuleExecutionCreate) Mutation() *RuleExecutionMutation {
+	return rec.mutation
+}
+
+// Save creates the RuleExecution in the database.
+func (rec *RuleExecutionCreate) Save(ctx context.Context) (*RuleExecution, error) {
+	var (
+		err  error
+		node *RuleExecution
+	)
+	rec.defaults()
+	if len(rec.hooks) == 0 {
+		if err = rec.check(); err != nil {
+			return nil, err
+		}
+		node, err = rec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RuleExecutionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rec.check(); err != nil {
+				return nil, err
+			}
+			rec.mutation = mutation
+			if node, err = rec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rec.hooks) - 1; i >= 0; i-- {
+			if rec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rec *RuleExecutionCreate) SaveX(ctx context.Context) *RuleExecution {
+	v, err := rec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rec *RuleExecutionCreate) Exec(ctx context.Context) error {
+	_, err := rec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rec *RuleExecutionCreate) ExecX(ctx context.Context) {
+	if err := rec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rec *RuleExecutionCreate) defaults() {
+	if _, ok := rec.mutation.CorrelationID(); !ok {
+		v := ruleexecution.DefaultCorrelationID
+		rec.mutation.SetCorrelationID(v)
+	}
+	if _, ok := rec.mutation.Error(); !ok {
+		v := ruleexecution.DefaultError
+		rec.mutation.SetError(v)
+	}
+	if _, ok := rec.mutation.ExecutedAt(); !ok {
+		v := ruleexecution.DefaultExecutedAt()
+		rec.mutation.SetExecutedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rec *RuleExecutionCreate) check() error {
+	if _, ok := rec.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := rec.mutation.UID(); ok {
+		if err := ruleexecution.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := rec.mutation.RuleUID(); !ok {
+		return &ValidationError{Name: "rule_uid", err: errors.New(`ent: missing required field "rule_uid"`)}
+	}
+	if v, ok := rec.mutation.RuleUID(); ok {
+		if err := ruleexecution.RuleUIDValidator(v); err != nil {
+			return &ValidationError{Name: "rule_uid", err: fmt.Errorf(`ent: validator failed for field "rule_uid": %w`, err)}
+		}
+	}
+	if _, ok := rec.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "trigger"`)}
+	}
+	if _, ok := rec.mutation.ItemUID(); !ok {
+		return &ValidationError{Name: "item_uid", err: errors.New(`ent: missing required field "item_uid"`)}
+	}
+	if v, ok := rec.mutation.ItemUID(); ok {
+		if err := ruleexecution.ItemUIDValidator(v); err != nil {
+			return &ValidationError{Name: "item_uid", err: fmt.Errorf(`ent: validator failed for field "item_uid": %w`, err)}
+		}
+	}
+	if _, ok := rec.mutation.CorrelationID(); !ok {
+		return &ValidationError{Name: "correlation_id", err: errors.New(`ent: missing required field "correlation_id"`)}
+	}
+	if _, ok := rec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "status"`)}
+	}
+	if _, ok := rec.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "error"`)}
+	}
+	if _, ok := rec.mutation.ExecutedAt(); !ok {
+		return &ValidationError{Name: "executed_at", err: errors.New(`ent: missing required field "executed_at"`)}
+	}
+	return nil
+}
+
+func (rec *RuleExecutionCreate) sqlSave(ctx context.Context) (*RuleExecution, error) {
+	_node, _spec := rec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (rec *RuleExecutionCreate) createSpec() (*RuleExecution, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RuleExecution{config: rec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: ruleexecution.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ruleexecution.FieldID,
+			},
+		}
+	)
+	if value, ok := rec.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ruleexecution.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := rec.mutation.RuleUID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ruleexecution.FieldRuleUID,
+		})
+		_node.RuleUID = value
+	}
+	if value, ok := rec.mutation.Trigger(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ruleexecution.FieldTrigger,
+		})
+		_node.Trigger = value
+	}
+	if value, ok := rec.mutation.ItemUID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ruleexecution.FieldItemUID,
+		})
+		_node.ItemUID = value
+	}
+	if value, ok := rec.mutation.CorrelationID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ruleexecution.FieldCorrelationID,
+		})
+		_node.CorrelationID = value
+	}
+	if value, ok := rec.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ruleexecution.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := rec.mutation.Error(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ruleexecution.FieldError,
+		})
+		_node.Error = value
+	}
+	if value, ok := rec.mutation.ExecutedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ruleexecution.FieldExecutedAt,
+		})
+		_node.ExecutedAt = value
+	}
+	return _node, _spec
+}
+
+// RuleExecutionCreateBulk is the builder for creating many RuleExecution entities in bulk.
+type RuleExecutionCreateBulk struct {
+	config
+	builders []*RuleExecutionCreate
+}
+
+// Save creates the RuleExecution entities in the database.
+func (recb *RuleExecutionCreateBulk) Save(ctx context.Context) ([]*RuleExecution, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(recb.builders))
+	nodes := make([]*RuleExecution, len(recb.builders))
+	mutators := make([]Mutator, len(recb.builders))
+	for i := range recb.builders {
+		func(i int, root context.Context) {
+			builder := recb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RuleExecutionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, recb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, recb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, recb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (recb *RuleExecutionCreateBulk) SaveX(ctx context.Context) []*RuleExecution {
+	v, err := recb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (recb *RuleExecutionCreateBulk) Exec(ctx context.Context) error {
+	_, err := recb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (recb *RuleExecutionCreateBulk) ExecX(ctx context.Context) {
+	if err := recb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,913 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/ruleexecution"
+)
+
+// RuleExecutionQuery is the builder for querying RuleExecution entities.
+type RuleExecutionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.RuleExecution
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RuleExecutionQuery builder.
+func (req *RuleExecutionQuery) Where(ps ...predicate.RuleExecution) *RuleExecutionQuery {
+	req.predicates = append(req.predicates, ps...)
+	return req
+}
+
+// Limit adds a limit step to the query.
+func (req *RuleExecutionQuery) Limit(limit int) *RuleExecutionQuery {
+	req.limit = &limit
+	return req
+}
+
+// Offset adds an offset step to the query.
+func (req *RuleExecutionQuery) Offset(offset int) *RuleExecutionQuery {
+	req.offset = &offset
+	return req
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (req *RuleExecutionQuery) Unique(unique bool) *RuleExecutionQuery {
+	req.unique = &unique
+	return req
+}
+
+// Order adds an order step to the query.
+func (req *RuleExecutionQuery) Order(o ...OrderFunc) *RuleExecutionQuery {
+	req.order = append(req.order, o...)
+	return req
+}
+
+// First returns the first RuleExecution entity from the query.
+// Returns a *NotFoundError when no RuleExecution was found.
+func (req *RuleExecutionQuery) First(ctx context.Context) (*RuleExecution, error) {
+	nodes, err := req.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ruleexecution.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (req *RuleExecutionQuery) FirstX(ctx context.Context) *RuleExecution {
+	node, err := req.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RuleExecution ID from the query.
+// Returns a *NotFoundError when no RuleExecution ID was found.
+func (req *RuleExecutionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = req.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ruleexecution.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (req *RuleExecutionQuery) FirstIDX(ctx context.Context) int {
+	id, err := req.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RuleExecution entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one RuleExecution entity is not found.
+// Returns a *NotFoundError when no RuleExecution entities are found.
+func (req *RuleExecutionQuery) Only(ctx context.Context) (*RuleExecution, error) {
+	nodes, err := req.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ruleexecution.Label}
+	default:
+		return nil, &NotSingularError{ruleexecution.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (req *RuleExecutionQuery) OnlyX(ctx context.Context) *RuleExecution {
+	node, err := req.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RuleExecution ID in the query.
+// Returns a *NotSingularError when exactly one RuleExecution ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (req *RuleExecutionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = req.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ruleexecution.Label}
+	default:
+		err = &NotSingularError{ruleexecution.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (req *RuleExecutionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := req.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RuleExecutions.
+func (req *RuleExecutionQuery) All(ctx context.Context) ([]*RuleExecution, error) {
+	if err := req.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return req.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (req *RuleExecutionQuery) AllX(ctx context.Context) []*RuleExecution {
+	nodes, err := req.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RuleExecution IDs.
+func (req *RuleExecutionQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := req.Select(ruleexecution.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (req *RuleExecutionQuery) IDsX(ctx context.Context) []int {
+	ids, err := req.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (req *RuleExecutionQuery) Count(ctx context.Context) (int, error) {
+	if err := req.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return req.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (req *RuleExecutionQuery) CountX(ctx context.Context) int {
+	count, err := req.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (req *RuleExecutionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := req.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return req.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (req *RuleExecutionQuery) ExistX(ctx context.Context) bool {
+	exist, err := req.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RuleExecutionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (req *RuleExecutionQuery) Clone() *RuleExecutionQuery {
+	if req == nil {
+		return nil
+	}
+	return &RuleExecutionQuery{
+		config:     req.config,
+		limit:      req.limit,
+		offset:     req.offset,
+		order:      append([]OrderFunc{}, req.order...),
+		predicates: append([]predicate.RuleExecution{}, req.predicates...),
+		// clone intermediate query.
+		sql:  req.sql.Clone(),
+		path: req.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RuleExecution.Query().
+//		GroupBy(ruleexecution.FieldUID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (req *RuleExecutionQuery) GroupBy(field string, fields ...string) *RuleExecutionGroupBy {
+	group := &RuleExecutionGroupBy{config: req.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := req.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return req.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//	}
+//
+//	client.RuleExecution.Query().
+//		Select(ruleexecution.FieldUID).
+//		Scan(ctx, &v)
+func (req *RuleExecutionQuery) Select(fields ...string) *RuleExecutionSelect {
+	req.fields = append(req.fields, fields...)
+	return &RuleExecutionSelect{RuleExecutionQuery: req}
+}
+
+func (req *RuleExecutionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range req.fields {
+		if !ruleexecution.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if req.path != nil {
+		prev, err := req.path(ctx)
+		if err != nil {
+			return err
+		}
+		req.sql = prev
+	}
+	return nil
+}
+
+func (req *RuleExecutionQuery) sqlAll(ctx context.Context) ([]*RuleExecution, error) {
+	var (
+		nodes = []*RuleExecution{}
+		_spec = req.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &RuleExecution{config: req.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, req.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (req *RuleExecutionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := req.querySpec()
+	return sqlgraph.CountNodes(ctx, req.driver, _spec)
+}
+
+func (req *RuleExecutionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := req.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (req *RuleExecutionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ruleexecution.Table,
+			Columns: ruleexecution.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ruleexecution.FieldID,
+			},
+		},
+		From:   req.sql,
+		Unique: true,
+	}
+	if unique := req.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := req.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ruleexecution.FieldID)
+		for i := range fields {
+			if fields[i] != ruleexecution.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := req.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := req.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := req.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := req.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (req *RuleExecutionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(req.driver.Dialect())
+	t1 := builder.Table(ruleexecution.Table)
+	columns := req.fields
+	if len(columns) == 0 {
+		columns = ruleexecution.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if req.sql != nil {
+		selector = req.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range req.predicates {
+		p(selector)
+	}
+	for _, p := range req.order {
+		p(selector)
+	}
+	if offset := req.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := req.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RuleExecutionGroupBy is the group-by builder for RuleExecution entities.
+type RuleExecutionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (regb *RuleExecutionGroupBy) Aggregate(fns ...AggregateFunc) *RuleExecutionGroupBy {
+	regb.fns = append(regb.fns, fns...)
+	return regb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (regb *RuleExecutionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := regb.path(ctx)
+	if err != nil {
+		return err
+	}
+	regb.sql = query
+	return regb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (regb *RuleExecutionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := regb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (regb *RuleExecutionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(regb.fields) > 1 {
+		return nil, errors.New("ent: RuleExecutionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := regb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (regb *RuleExecutionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := regb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (regb *RuleExecutionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = regb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ruleexecution.Label}
+	default:
+		err = fmt.Errorf("ent: RuleExecutionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (regb *RuleExecutionGroupBy) StringX(ctx context.Context) string {
+	v, err := regb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (regb *RuleExecutionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(regb.fields) > 1 {
+		return nil, errors.New("ent: RuleExecutionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := regb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (regb *RuleExecutionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := regb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (regb *RuleExecutionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = regb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ruleexecution.Label}
+	default:
+		err = fmt.Errorf("ent: RuleExecutionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (regb *RuleExecutionGroupBy) IntX(ctx context.Context) int {
+	v, err := regb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (regb *RuleExecutionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(regb.fields) > 1 {
+		return nil, errors.New("ent: RuleExecutionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := regb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (regb *RuleExecutionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := regb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (regb *RuleExecutionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = regb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ruleexecution.Label}
+	default:
+		err = fmt.Errorf("ent: RuleExecutionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (regb *RuleExecutionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := regb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (regb *RuleExecutionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(regb.fields) > 1 {
+		return nil, errors.New("ent: RuleExecutionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := regb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (regb *RuleExecutionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := regb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (regb *RuleExecutionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = regb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ruleexecution.Label}
+	default:
+		err = fmt.Errorf("ent: RuleExecutionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (regb *RuleExecutionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := regb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (regb *RuleExecutionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range regb.fields {
+		if !ruleexecution.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := regb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := regb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (regb *RuleExecutionGroupBy) sqlQuery() *sql.Selector {
+	selector := regb.sql.Select()
+	aggregation := make([]string, 0, len(regb.fns))
+	for _, fn := range regb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(regb.fields)+len(regb.fns))
+		for _, f := range regb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(regb.fields...)...)
+}
+
+// RuleExecutionSelect is the builder for selecting fields of RuleExecution entities.
+type RuleExecutionSelect struct {
+	*RuleExecutionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (res *RuleExecutionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := res.prepareQuery(ctx); err != nil {
+		return err
+	}
+	res.sql = res.RuleExecutionQuery.sqlQuery(ctx)
+	return res.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (res *RuleExecutionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := res.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (res *RuleExecutionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(res.fields) > 1 {
+		return nil, errors.New("ent: RuleExecutionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := res.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (res *RuleExecutionSelect) StringsX(ctx context.Context) []string {
+	v, err := res.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (res *RuleExecutionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = res.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ruleexecution.Label}
+	default:
+		err = fmt.Errorf("ent: RuleExecutionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (res *RuleExecutionSelect) StringX(ctx context.Context) string {
+	v, err := res.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (res *RuleExecutionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(res.fields) > 1 {
+		return nil, errors.New("ent: RuleExecutionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := res.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (res *RuleExecutionSelect) IntsX(ctx context.Context) []int {
+	v, err := res.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (res *RuleExecutionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = res.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ruleexecution.Label}
+	default:
+		err = fmt.Errorf("ent: RuleExecutionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (res *RuleExecutionSelect) IntX(ctx context.Context) int {
+	v, err := res.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (res *RuleExecutionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(res.fields) > 1 {
+		return nil, errors.New("ent: RuleExecutionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := res.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (res *RuleExecutionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := res.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (res *RuleExecutionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = res.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ruleexecution.Label}
+	default:
+		err = fmt.Errorf("ent: RuleExecutionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (res *RuleExecutionSelect) Float64X(ctx context.Context) float64 {
+	v, err := res.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (res *RuleExecutionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(res.fields) > 1 {
+		return nil, errors.New("ent: RuleExecutionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := res.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (res *RuleExecutionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := res.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (res *RuleExecutionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = res.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ruleexecution.Label}
+	default:
+		err = fmt.Errorf("ent: RuleExecutionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (res *RuleExecutionSelect) BoolX(ctx context.Context) bool {
+	v, err := res.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (res *RuleExecutionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := res.sql.Query()
+	if err := res.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
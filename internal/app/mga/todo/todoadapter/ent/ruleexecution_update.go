@@ -0,0 +1,238 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/ruleexecution"
+)
+
+// RuleExecutionUpdate is the builder for updating RuleExecution entities.
+type RuleExecutionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RuleExecutionMutation
+}
+
+// Where appends a list predicates to the RuleExecutionUpdate builder.
+func (reu *RuleExecutionUpdate) Where(ps ...predicate.RuleExecution) *RuleExecutionUpdate {
+	reu.mutation.Where(ps...)
+	return reu
+}
+
+// Mutation returns the RuleExecutionMutation object of the builder.
+func (reu *RuleExecutionUpdate) Mutation() *RuleExecutionMutation {
+	return reu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (reu *RuleExecutionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(reu.hooks) == 0 {
+		affected, err = reu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RuleExecutionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			reu.mutation = mutation
+			affected, err = reu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(reu.hooks) - 1; i >= 0; i-- {
+			if reu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = reu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, reu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (reu *RuleExecutionUpdate) SaveX(ctx context.Context) int {
+	affected, err := reu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (reu *RuleExecutionUpdate) Exec(ctx context.Context) error {
+	_, err := reu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (reu *RuleExecutionUpdate) ExecX(ctx context.Context) {
+	if err := reu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (reu *RuleExecutionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ruleexecution.Table,
+			Columns: ruleexecution.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ruleexecution.FieldID,
+			},
+		},
+	}
+	if ps := reu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, reu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ruleexecution.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RuleExecutionUpdateOne is the builder for updating a single RuleExecution entity.
+type RuleExecutionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RuleExecutionMutation
+}
+
+// Mutation returns the RuleExecutionMutation object of the builder.
+func (reuo *RuleExecutionUpdateOne) Mutation() *RuleExecutionMutation {
+	return reuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (reuo *RuleExecutionUpdateOne) Select(field string, fields ...string) *RuleExecutionUpdateOne {
+	reuo.fields = append([]string{field}, fields...)
+	return reuo
+}
+
+// Save executes the query and returns the updated RuleExecution entity.
+func (reuo *RuleExecutionUpdateOne) Save(ctx context.Context) (*RuleExecution, error) {
+	var (
+		err  error
+		node *RuleExecution
+	)
+	if len(reuo.hooks) == 0 {
+		node, err = reuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RuleExecutionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			reuo.mutation = mutation
+			node, err = reuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(reuo.hooks) - 1; i >= 0; i-- {
+			if reuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = reuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, reuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (reuo *RuleExecutionUpdateOne) SaveX(ctx context.Context) *RuleExecution {
+	node, err := reuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (reuo *RuleExecutionUpdateOne) Exec(ctx context.Context) error {
+	_, err := reuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (reuo *RuleExecutionUpdateOne) ExecX(ctx context.Context) {
+	if err := reuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (reuo *RuleExecutionUpdateOne) sqlSave(ctx context.Context) (_node *RuleExecution, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ruleexecution.Table,
+			Columns: ruleexecution.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ruleexecution.FieldID,
+			},
+		},
+	}
+	id, ok := reuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing RuleExecution.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := reuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ruleexecution.FieldID)
+		for _, f := range fields {
+			if !ruleexecution.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ruleexecution.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := reuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &RuleExecution{config: reuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, reuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ruleexecution.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -5,14 +5,563 @@ package ent
 import (
 	"time"
 
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/attachment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/comment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/itemhistory"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/operation"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/rule"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/ruleexecution"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/schema"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/shareaccess"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/tag"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/template"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescUID is the schema descriptor for uid field.
+	attachmentDescUID := attachmentFields[0].Descriptor()
+	// attachment.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	attachment.UIDValidator = func() func(string) error {
+		validators := attachmentDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescName is the schema descriptor for name field.
+	attachmentDescName := attachmentFields[1].Descriptor()
+	// attachment.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	attachment.NameValidator = func() func(string) error {
+		validators := attachmentDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescSize is the schema descriptor for size field.
+	attachmentDescSize := attachmentFields[3].Descriptor()
+	// attachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachment.SizeValidator = attachmentDescSize.Validators[0].(func(int64) error)
+	// attachmentDescChecksum is the schema descriptor for checksum field.
+	attachmentDescChecksum := attachmentFields[4].Descriptor()
+	// attachment.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	attachment.ChecksumValidator = attachmentDescChecksum.Validators[0].(func(string) error)
+	// attachmentDescUploader is the schema descriptor for uploader field.
+	attachmentDescUploader := attachmentFields[5].Descriptor()
+	// attachment.DefaultUploader holds the default value on creation for the uploader field.
+	attachment.DefaultUploader = attachmentDescUploader.Default.(string)
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentFields[6].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	collaboratorFields := schema.Collaborator{}.Fields()
+	_ = collaboratorFields
+	// collaboratorDescUID is the schema descriptor for uid field.
+	collaboratorDescUID := collaboratorFields[0].Descriptor()
+	// collaborator.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	collaborator.UIDValidator = func() func(string) error {
+		validators := collaboratorDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// collaboratorDescEmail is the schema descriptor for email field.
+	collaboratorDescEmail := collaboratorFields[1].Descriptor()
+	// collaborator.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	collaborator.EmailValidator = func() func(string) error {
+		validators := collaboratorDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// collaboratorDescInvitedBy is the schema descriptor for invited_by field.
+	collaboratorDescInvitedBy := collaboratorFields[3].Descriptor()
+	// collaborator.DefaultInvitedBy holds the default value on creation for the invited_by field.
+	collaborator.DefaultInvitedBy = collaboratorDescInvitedBy.Default.(string)
+	// collaboratorDescCreatedAt is the schema descriptor for created_at field.
+	collaboratorDescCreatedAt := collaboratorFields[4].Descriptor()
+	// collaborator.DefaultCreatedAt holds the default value on creation for the created_at field.
+	collaborator.DefaultCreatedAt = collaboratorDescCreatedAt.Default.(func() time.Time)
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescUID is the schema descriptor for uid field.
+	commentDescUID := commentFields[0].Descriptor()
+	// comment.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	comment.UIDValidator = func() func(string) error {
+		validators := commentDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// commentDescAuthor is the schema descriptor for author field.
+	commentDescAuthor := commentFields[1].Descriptor()
+	// comment.DefaultAuthor holds the default value on creation for the author field.
+	comment.DefaultAuthor = commentDescAuthor.Default.(string)
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[5].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	itemhistoryFields := schema.ItemHistory{}.Fields()
+	_ = itemhistoryFields
+	// itemhistoryDescUID is the schema descriptor for uid field.
+	itemhistoryDescUID := itemhistoryFields[0].Descriptor()
+	// itemhistory.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	itemhistory.UIDValidator = func() func(string) error {
+		validators := itemhistoryDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// itemhistoryDescItemUID is the schema descriptor for item_uid field.
+	itemhistoryDescItemUID := itemhistoryFields[1].Descriptor()
+	// itemhistory.ItemUIDValidator is a validator for the "item_uid" field. It is called by the builders before save.
+	itemhistory.ItemUIDValidator = func() func(string) error {
+		validators := itemhistoryDescItemUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(item_uid string) error {
+			for _, fn := range fns {
+				if err := fn(item_uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// itemhistoryDescActor is the schema descriptor for actor field.
+	itemhistoryDescActor := itemhistoryFields[2].Descriptor()
+	// itemhistory.DefaultActor holds the default value on creation for the actor field.
+	itemhistory.DefaultActor = itemhistoryDescActor.Default.(string)
+	// itemhistoryDescCorrelationID is the schema descriptor for correlation_id field.
+	itemhistoryDescCorrelationID := itemhistoryFields[4].Descriptor()
+	// itemhistory.DefaultCorrelationID holds the default value on creation for the correlation_id field.
+	itemhistory.DefaultCorrelationID = itemhistoryDescCorrelationID.Default.(string)
+	// itemhistoryDescCreatedAt is the schema descriptor for created_at field.
+	itemhistoryDescCreatedAt := itemhistoryFields[6].Descriptor()
+	// itemhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	itemhistory.DefaultCreatedAt = itemhistoryDescCreatedAt.Default.(func() time.Time)
+	operationFields := schema.Operation{}.Fields()
+	_ = operationFields
+	// operationDescUID is the schema descriptor for uid field.
+	operationDescUID := operationFields[0].Descriptor()
+	// operation.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	operation.UIDValidator = func() func(string) error {
+		validators := operationDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// operationDescActor is the schema descriptor for actor field.
+	operationDescActor := operationFields[1].Descriptor()
+	// operation.DefaultActor holds the default value on creation for the actor field.
+	operation.DefaultActor = operationDescActor.Default.(string)
+	// operationDescUndone is the schema descriptor for undone field.
+	operationDescUndone := operationFields[4].Descriptor()
+	// operation.DefaultUndone holds the default value on creation for the undone field.
+	operation.DefaultUndone = operationDescUndone.Default.(bool)
+	// operationDescCreatedAt is the schema descriptor for created_at field.
+	operationDescCreatedAt := operationFields[5].Descriptor()
+	// operation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	operation.DefaultCreatedAt = operationDescCreatedAt.Default.(func() time.Time)
+	ruleFields := schema.Rule{}.Fields()
+	_ = ruleFields
+	// ruleDescUID is the schema descriptor for uid field.
+	ruleDescUID := ruleFields[0].Descriptor()
+	// rule.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	rule.UIDValidator = func() func(string) error {
+		validators := ruleDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ruleDescName is the schema descriptor for name field.
+	ruleDescName := ruleFields[1].Descriptor()
+	// rule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	rule.NameValidator = ruleDescName.Validators[0].(func(string) error)
+	// ruleDescTrigger is the schema descriptor for trigger field.
+	ruleDescTrigger := ruleFields[2].Descriptor()
+	// rule.TriggerValidator is a validator for the "trigger" field. It is called by the builders before save.
+	rule.TriggerValidator = ruleDescTrigger.Validators[0].(func(string) error)
+	// ruleDescEnabled is the schema descriptor for enabled field.
+	ruleDescEnabled := ruleFields[5].Descriptor()
+	// rule.DefaultEnabled holds the default value on creation for the enabled field.
+	rule.DefaultEnabled = ruleDescEnabled.Default.(bool)
+	// ruleDescDryRun is the schema descriptor for dry_run field.
+	ruleDescDryRun := ruleFields[6].Descriptor()
+	// rule.DefaultDryRun holds the default value on creation for the dry_run field.
+	rule.DefaultDryRun = ruleDescDryRun.Default.(bool)
+	// ruleDescOwner is the schema descriptor for owner field.
+	ruleDescOwner := ruleFields[7].Descriptor()
+	// rule.DefaultOwner holds the default value on creation for the owner field.
+	rule.DefaultOwner = ruleDescOwner.Default.(string)
+	// ruleDescCreatedAt is the schema descriptor for created_at field.
+	ruleDescCreatedAt := ruleFields[8].Descriptor()
+	// rule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rule.DefaultCreatedAt = ruleDescCreatedAt.Default.(func() time.Time)
+	// ruleDescUpdatedAt is the schema descriptor for updated_at field.
+	ruleDescUpdatedAt := ruleFields[9].Descriptor()
+	// rule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rule.DefaultUpdatedAt = ruleDescUpdatedAt.Default.(func() time.Time)
+	// rule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rule.UpdateDefaultUpdatedAt = ruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ruleexecutionFields := schema.RuleExecution{}.Fields()
+	_ = ruleexecutionFields
+	// ruleexecutionDescUID is the schema descriptor for uid field.
+	ruleexecutionDescUID := ruleexecutionFields[0].Descriptor()
+	// ruleexecution.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	ruleexecution.UIDValidator = func() func(string) error {
+		validators := ruleexecutionDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ruleexecutionDescRuleUID is the schema descriptor for rule_uid field.
+	ruleexecutionDescRuleUID := ruleexecutionFields[1].Descriptor()
+	// ruleexecution.RuleUIDValidator is a validator for the "rule_uid" field. It is called by the builders before save.
+	ruleexecution.RuleUIDValidator = func() func(string) error {
+		validators := ruleexecutionDescRuleUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(rule_uid string) error {
+			for _, fn := range fns {
+				if err := fn(rule_uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ruleexecutionDescItemUID is the schema descriptor for item_uid field.
+	ruleexecutionDescItemUID := ruleexecutionFields[3].Descriptor()
+	// ruleexecution.ItemUIDValidator is a validator for the "item_uid" field. It is called by the builders before save.
+	ruleexecution.ItemUIDValidator = ruleexecutionDescItemUID.Validators[0].(func(string) error)
+	// ruleexecutionDescCorrelationID is the schema descriptor for correlation_id field.
+	ruleexecutionDescCorrelationID := ruleexecutionFields[4].Descriptor()
+	// ruleexecution.DefaultCorrelationID holds the default value on creation for the correlation_id field.
+	ruleexecution.DefaultCorrelationID = ruleexecutionDescCorrelationID.Default.(string)
+	// ruleexecutionDescError is the schema descriptor for error field.
+	ruleexecutionDescError := ruleexecutionFields[6].Descriptor()
+	// ruleexecution.DefaultError holds the default value on creation for the error field.
+	ruleexecution.DefaultError = ruleexecutionDescError.Default.(string)
+	// ruleexecutionDescExecutedAt is the schema descriptor for executed_at field.
+	ruleexecutionDescExecutedAt := ruleexecutionFields[7].Descriptor()
+	// ruleexecution.DefaultExecutedAt holds the default value on creation for the executed_at field.
+	ruleexecution.DefaultExecutedAt = ruleexecutionDescExecutedAt.Default.(func() time.Time)
+	shareaccessFields := schema.ShareAccess{}.Fields()
+	_ = shareaccessFields
+	// shareaccessDescUID is the schema descriptor for uid field.
+	shareaccessDescUID := shareaccessFields[0].Descriptor()
+	// shareaccess.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	shareaccess.UIDValidator = func() func(string) error {
+		validators := shareaccessDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// shareaccessDescShareUID is the schema descriptor for share_uid field.
+	shareaccessDescShareUID := shareaccessFields[1].Descriptor()
+	// shareaccess.ShareUIDValidator is a validator for the "share_uid" field. It is called by the builders before save.
+	shareaccess.ShareUIDValidator = func() func(string) error {
+		validators := shareaccessDescShareUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(share_uid string) error {
+			for _, fn := range fns {
+				if err := fn(share_uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// shareaccessDescListUID is the schema descriptor for list_uid field.
+	shareaccessDescListUID := shareaccessFields[2].Descriptor()
+	// shareaccess.ListUIDValidator is a validator for the "list_uid" field. It is called by the builders before save.
+	shareaccess.ListUIDValidator = func() func(string) error {
+		validators := shareaccessDescListUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(list_uid string) error {
+			for _, fn := range fns {
+				if err := fn(list_uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// shareaccessDescItemUID is the schema descriptor for item_uid field.
+	shareaccessDescItemUID := shareaccessFields[4].Descriptor()
+	// shareaccess.DefaultItemUID holds the default value on creation for the item_uid field.
+	shareaccess.DefaultItemUID = shareaccessDescItemUID.Default.(string)
+	// shareaccessDescCorrelationID is the schema descriptor for correlation_id field.
+	shareaccessDescCorrelationID := shareaccessFields[5].Descriptor()
+	// shareaccess.DefaultCorrelationID holds the default value on creation for the correlation_id field.
+	shareaccess.DefaultCorrelationID = shareaccessDescCorrelationID.Default.(string)
+	// shareaccessDescDenied is the schema descriptor for denied field.
+	shareaccessDescDenied := shareaccessFields[6].Descriptor()
+	// shareaccess.DefaultDenied holds the default value on creation for the denied field.
+	shareaccess.DefaultDenied = shareaccessDescDenied.Default.(bool)
+	// shareaccessDescAccessedAt is the schema descriptor for accessed_at field.
+	shareaccessDescAccessedAt := shareaccessFields[7].Descriptor()
+	// shareaccess.DefaultAccessedAt holds the default value on creation for the accessed_at field.
+	shareaccess.DefaultAccessedAt = shareaccessDescAccessedAt.Default.(func() time.Time)
+	sharetokenFields := schema.ShareToken{}.Fields()
+	_ = sharetokenFields
+	// sharetokenDescUID is the schema descriptor for uid field.
+	sharetokenDescUID := sharetokenFields[0].Descriptor()
+	// sharetoken.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	sharetoken.UIDValidator = func() func(string) error {
+		validators := sharetokenDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// sharetokenDescTokenHash is the schema descriptor for token_hash field.
+	sharetokenDescTokenHash := sharetokenFields[2].Descriptor()
+	// sharetoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	sharetoken.TokenHashValidator = func() func(string) error {
+		validators := sharetokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// sharetokenDescCreatedBy is the schema descriptor for created_by field.
+	sharetokenDescCreatedBy := sharetokenFields[3].Descriptor()
+	// sharetoken.DefaultCreatedBy holds the default value on creation for the created_by field.
+	sharetoken.DefaultCreatedBy = sharetokenDescCreatedBy.Default.(string)
+	// sharetokenDescCreatedAt is the schema descriptor for created_at field.
+	sharetokenDescCreatedAt := sharetokenFields[4].Descriptor()
+	// sharetoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sharetoken.DefaultCreatedAt = sharetokenDescCreatedAt.Default.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescUID is the schema descriptor for uid field.
+	tagDescUID := tagFields[0].Descriptor()
+	// tag.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	tag.UIDValidator = func() func(string) error {
+		validators := tagDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescOwner is the schema descriptor for owner field.
+	tagDescOwner := tagFields[1].Descriptor()
+	// tag.DefaultOwner holds the default value on creation for the owner field.
+	tag.DefaultOwner = tagDescOwner.Default.(string)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[2].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[3].Descriptor()
+	// tag.DefaultColor holds the default value on creation for the color field.
+	tag.DefaultColor = tagDescColor.Default.(string)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[4].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagFields[5].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	templateFields := schema.Template{}.Fields()
+	_ = templateFields
+	// templateDescUID is the schema descriptor for uid field.
+	templateDescUID := templateFields[0].Descriptor()
+	// template.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	template.UIDValidator = func() func(string) error {
+		validators := templateDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// templateDescName is the schema descriptor for name field.
+	templateDescName := templateFields[1].Descriptor()
+	// template.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	template.NameValidator = templateDescName.Validators[0].(func(string) error)
+	// templateDescDescription is the schema descriptor for description field.
+	templateDescDescription := templateFields[2].Descriptor()
+	// template.DefaultDescription holds the default value on creation for the description field.
+	template.DefaultDescription = templateDescDescription.Default.(string)
+	// templateDescCreatedAt is the schema descriptor for created_at field.
+	templateDescCreatedAt := templateFields[4].Descriptor()
+	// template.DefaultCreatedAt holds the default value on creation for the created_at field.
+	template.DefaultCreatedAt = templateDescCreatedAt.Default.(func() time.Time)
+	// templateDescUpdatedAt is the schema descriptor for updated_at field.
+	templateDescUpdatedAt := templateFields[5].Descriptor()
+	// template.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	template.DefaultUpdatedAt = templateDescUpdatedAt.Default.(func() time.Time)
+	// template.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	template.UpdateDefaultUpdatedAt = templateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	timeentryFields := schema.TimeEntry{}.Fields()
+	_ = timeentryFields
+	// timeentryDescUID is the schema descriptor for uid field.
+	timeentryDescUID := timeentryFields[0].Descriptor()
+	// timeentry.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	timeentry.UIDValidator = func() func(string) error {
+		validators := timeentryDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// timeentryDescUser is the schema descriptor for user field.
+	timeentryDescUser := timeentryFields[1].Descriptor()
+	// timeentry.UserValidator is a validator for the "user" field. It is called by the builders before save.
+	timeentry.UserValidator = timeentryDescUser.Validators[0].(func(string) error)
 	todoitemFields := schema.TodoItem{}.Fields()
 	_ = todoitemFields
 	// todoitemDescUID is the schema descriptor for uid field.
@@ -33,14 +582,88 @@ func init() {
 			return nil
 		}
 	}()
+	// todoitemDescNotes is the schema descriptor for notes field.
+	todoitemDescNotes := todoitemFields[2].Descriptor()
+	// todoitem.DefaultNotes holds the default value on creation for the notes field.
+	todoitem.DefaultNotes = todoitemDescNotes.Default.(string)
+	// todoitemDescAssignee is the schema descriptor for assignee field.
+	todoitemDescAssignee := todoitemFields[3].Descriptor()
+	// todoitem.DefaultAssignee holds the default value on creation for the assignee field.
+	todoitem.DefaultAssignee = todoitemDescAssignee.Default.(string)
+	// todoitemDescDueSoonNotified is the schema descriptor for due_soon_notified field.
+	todoitemDescDueSoonNotified := todoitemFields[7].Descriptor()
+	// todoitem.DefaultDueSoonNotified holds the default value on creation for the due_soon_notified field.
+	todoitem.DefaultDueSoonNotified = todoitemDescDueSoonNotified.Default.(bool)
+	// todoitemDescOverdueNotified is the schema descriptor for overdue_notified field.
+	todoitemDescOverdueNotified := todoitemFields[8].Descriptor()
+	// todoitem.DefaultOverdueNotified holds the default value on creation for the overdue_notified field.
+	todoitem.DefaultOverdueNotified = todoitemDescOverdueNotified.Default.(bool)
+	// todoitemDescSeriesID is the schema descriptor for series_id field.
+	todoitemDescSeriesID := todoitemFields[13].Descriptor()
+	// todoitem.SeriesIDValidator is a validator for the "series_id" field. It is called by the builders before save.
+	todoitem.SeriesIDValidator = todoitemDescSeriesID.Validators[0].(func(string) error)
+	// todoitemDescAutoComplete is the schema descriptor for auto_complete field.
+	todoitemDescAutoComplete := todoitemFields[14].Descriptor()
+	// todoitem.DefaultAutoComplete holds the default value on creation for the auto_complete field.
+	todoitem.DefaultAutoComplete = todoitemDescAutoComplete.Default.(bool)
+	// todoitemDescPriority is the schema descriptor for priority field.
+	todoitemDescPriority := todoitemFields[15].Descriptor()
+	// todoitem.DefaultPriority holds the default value on creation for the priority field.
+	todoitem.DefaultPriority = todoitemDescPriority.Default.(int)
+	// todoitemDescRank is the schema descriptor for rank field.
+	todoitemDescRank := todoitemFields[16].Descriptor()
+	// todoitem.DefaultRank holds the default value on creation for the rank field.
+	todoitem.DefaultRank = todoitemDescRank.Default.(string)
 	// todoitemDescCreatedAt is the schema descriptor for created_at field.
-	todoitemDescCreatedAt := todoitemFields[4].Descriptor()
+	todoitemDescCreatedAt := todoitemFields[17].Descriptor()
 	// todoitem.DefaultCreatedAt holds the default value on creation for the created_at field.
 	todoitem.DefaultCreatedAt = todoitemDescCreatedAt.Default.(func() time.Time)
 	// todoitemDescUpdatedAt is the schema descriptor for updated_at field.
-	todoitemDescUpdatedAt := todoitemFields[5].Descriptor()
+	todoitemDescUpdatedAt := todoitemFields[18].Descriptor()
 	// todoitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	todoitem.DefaultUpdatedAt = todoitemDescUpdatedAt.Default.(func() time.Time)
 	// todoitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	todoitem.UpdateDefaultUpdatedAt = todoitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	todolistFields := schema.TodoList{}.Fields()
+	_ = todolistFields
+	// todolistDescUID is the schema descriptor for uid field.
+	todolistDescUID := todolistFields[0].Descriptor()
+	// todolist.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	todolist.UIDValidator = func() func(string) error {
+		validators := todolistDescUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uid string) error {
+			for _, fn := range fns {
+				if err := fn(uid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// todolistDescName is the schema descriptor for name field.
+	todolistDescName := todolistFields[1].Descriptor()
+	// todolist.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	todolist.NameValidator = todolistDescName.Validators[0].(func(string) error)
+	// todolistDescDescription is the schema descriptor for description field.
+	todolistDescDescription := todolistFields[2].Descriptor()
+	// todolist.DefaultDescription holds the default value on creation for the description field.
+	todolist.DefaultDescription = todolistDescDescription.Default.(string)
+	// todolistDescArchived is the schema descriptor for archived field.
+	todolistDescArchived := todolistFields[3].Descriptor()
+	// todolist.DefaultArchived holds the default value on creation for the archived field.
+	todolist.DefaultArchived = todolistDescArchived.Default.(bool)
+	// todolistDescCreatedAt is the schema descriptor for created_at field.
+	todolistDescCreatedAt := todolistFields[4].Descriptor()
+	// todolist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todolist.DefaultCreatedAt = todolistDescCreatedAt.Default.(func() time.Time)
+	// todolistDescUpdatedAt is the schema descriptor for updated_at field.
+	todolistDescUpdatedAt := todolistFields[5].Descriptor()
+	// todolist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todolist.DefaultUpdatedAt = todolistDescUpdatedAt.Default.(func() time.Time)
+	// todolist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todolist.UpdateDefaultUpdatedAt = todolistDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
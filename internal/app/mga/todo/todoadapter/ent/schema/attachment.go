@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Attachment holds the schema definition for the Attachment entity.
+type Attachment struct {
+	ent.Schema
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("content_type"),
+		field.Int64("size").
+			NonNegative(),
+
+		// Hex encoded SHA-256 hash of the content
+		field.String("checksum").
+			MaxLen(64),
+		field.String("uploader").
+			Default("").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Attachment.
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Attachments without an item belong to purged items: their content is yet to be deleted
+		edge.From("item", TodoItem.Type).
+			Ref("attachments").
+			Unique(),
+	}
+}
+
+// Indexes of the Attachment.
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Collaborator holds the schema definition for the Collaborator entity.
+type Collaborator struct {
+	ent.Schema
+}
+
+// Fields of the Collaborator.
+func (Collaborator) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("email").
+			MaxLen(254).
+			NotEmpty().
+			Immutable(),
+		field.String("role"),
+		field.String("invited_by").
+			Default("").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Collaborator.
+func (Collaborator) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("list", TodoList.Type).
+			Ref("collaborators").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Collaborator.
+func (Collaborator) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email").
+			Edges("list").
+			Unique(),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// Comment holds the schema definition for the Comment entity.
+type Comment struct {
+	ent.Schema
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("author").
+			Default("").
+			Immutable(),
+		field.Text("body"),
+		field.JSON("mentions", []string{}).
+			Optional(),
+
+		// Earlier versions of the comment, oldest first
+		field.JSON("edits", []todo.CommentEdit{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", TodoItem.Type).
+			Ref("comments").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// ItemHistory holds the schema definition for the ItemHistory entity.
+type ItemHistory struct {
+	ent.Schema
+}
+
+// Annotations of the ItemHistory.
+func (ItemHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "item_history"},
+	}
+}
+
+// Fields of the ItemHistory.
+func (ItemHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// Entries are kept after the item is purged, so the item is not referenced by an edge
+		field.String("item_uid").
+			MaxLen(26).
+			NotEmpty().
+			Immutable(),
+		field.String("actor").
+			Default("").
+			Immutable(),
+		field.String("operation").
+			Immutable(),
+		field.String("correlation_id").
+			Default("").
+			Immutable(),
+		field.JSON("changes", []todo.FieldChange{}).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ItemHistory.
+func (ItemHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_uid", "created_at"),
+	}
+}
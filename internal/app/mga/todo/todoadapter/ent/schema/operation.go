@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// Operation holds the schema definition for the Operation entity.
+type Operation struct {
+	ent.Schema
+}
+
+// Fields of the Operation.
+func (Operation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("actor").
+			Default("").
+			Immutable(),
+		field.String("name").
+			Immutable(),
+
+		// Items are stored as snapshots, so they are not referenced by edges
+		field.JSON("changes", []todo.ItemChange{}).
+			Immutable(),
+		field.Bool("undone").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Operation.
+func (Operation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("actor", "undone", "created_at"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// Rule holds the schema definition for the Rule entity.
+type Rule struct {
+	ent.Schema
+}
+
+// Fields of the Rule.
+func (Rule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		field.String("trigger").
+			NotEmpty(),
+		field.JSON("conditions", []todo.RuleCondition{}).
+			Optional(),
+		field.JSON("actions", []todo.RuleAction{}).
+			Optional(),
+		field.Bool("enabled").
+			Default(true),
+		field.Bool("dry_run").
+			Default(false),
+		field.String("owner").
+			Default("").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
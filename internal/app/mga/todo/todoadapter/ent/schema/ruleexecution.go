@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RuleExecution holds the schema definition for the RuleExecution entity.
+type RuleExecution struct {
+	ent.Schema
+}
+
+// Fields of the RuleExecution.
+func (RuleExecution) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// Executions are deleted together with the rule by the store, items may be deleted any time
+		field.String("rule_uid").
+			MaxLen(26).
+			NotEmpty().
+			Immutable(),
+		field.String("trigger").
+			Immutable(),
+		field.String("item_uid").
+			MaxLen(26).
+			Immutable(),
+		field.String("correlation_id").
+			Default("").
+			Immutable(),
+		field.String("status").
+			Immutable(),
+		field.Text("error").
+			Default("").
+			Immutable(),
+		field.Time("executed_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the RuleExecution.
+func (RuleExecution) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("rule_uid", "executed_at"),
+		index.Fields("correlation_id"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ShareAccess holds the schema definition for the ShareAccess entity.
+type ShareAccess struct {
+	ent.Schema
+}
+
+// Annotations of the ShareAccess.
+func (ShareAccess) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "share_access_log"},
+	}
+}
+
+// Fields of the ShareAccess.
+func (ShareAccess) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+
+		// Entries are kept after the token (or the list) is deleted, so they are not referenced by edges
+		field.String("share_uid").
+			MaxLen(26).
+			NotEmpty().
+			Immutable(),
+		field.String("list_uid").
+			MaxLen(26).
+			NotEmpty().
+			Immutable(),
+		field.String("operation").
+			Immutable(),
+		field.String("item_uid").
+			Default("").
+			Immutable(),
+		field.String("correlation_id").
+			Default("").
+			Immutable(),
+		field.Bool("denied").
+			Default(false).
+			Immutable(),
+		field.Time("accessed_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ShareAccess.
+func (ShareAccess) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("list_uid", "accessed_at"),
+	}
+}
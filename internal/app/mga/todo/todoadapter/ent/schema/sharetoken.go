@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ShareToken holds the schema definition for the ShareToken entity.
+type ShareToken struct {
+	ent.Schema
+}
+
+// Fields of the ShareToken.
+func (ShareToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("access").
+			Immutable(),
+
+		// Hex encoded SHA-256 hash of the secret token: the token itself is never stored
+		field.String("token_hash").
+			MaxLen(64).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("created_by").
+			Default("").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ShareToken.
+func (ShareToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("list", TodoList.Type).
+			Ref("share_tokens").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ShareToken.
+func (ShareToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// Template holds the schema definition for the Template entity.
+type Template struct {
+	ent.Schema
+}
+
+// Fields of the Template.
+func (Template) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		field.Text("description").
+			Default(""),
+
+		// Item blueprints in order
+		field.JSON("items", []todo.TemplateItem{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TimeEntry holds the schema definition for the TimeEntry entity.
+type TimeEntry struct {
+	ent.Schema
+}
+
+// Fields of the TimeEntry.
+func (TimeEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("user").
+			NotEmpty().
+			Immutable(),
+		field.Time("started_at").
+			Immutable(),
+
+		// Empty while the timer is running
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the TimeEntry.
+func (TimeEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", TodoItem.Type).
+			Ref("time_entries").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the TimeEntry.
+func (TimeEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user", "ended_at"),
+		index.Fields("started_at"),
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // TodoItem holds the schema definition for the TodoItem entity.
@@ -21,17 +24,94 @@ func (TodoItem) Fields() []ent.Field {
 			Unique().
 			Immutable(),
 		field.Text("title"),
+		field.Text("notes").
+			Default(""),
+
+		// User responsible for the item (empty if it's unassigned)
+		field.String("assignee").
+			Default(""),
 		field.Bool("completed"),
 		field.Int("order"),
+		field.Time("due_at").
+			Optional().
+			Nillable(),
+		field.Bool("due_soon_notified").
+			Default(false),
+		field.Bool("overdue_notified").
+			Default(false),
+		field.String("recurrence_rule").
+			Optional().
+			Nillable(),
+		field.String("recurrence_timezone").
+			Optional().
+			Nillable(),
+		field.Time("recurrence_start").
+			Optional().
+			Nillable(),
+		field.Time("recurrence_occurrence").
+			Optional().
+			Nillable(),
+		field.String("series_id").
+			MaxLen(26).
+			Optional().
+			Nillable(),
+		field.Bool("auto_complete").
+			Default(false),
+		field.Int("priority").
+			Default(0),
+
+		// Position of the item in the manual order of its list (compared as strings)
+		field.String("rank").
+			Default(""),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+
+		// Deleted items are kept in the trash until they are restored or purged
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
 	}
 }
 
 // Edges of the TodoItem.
 func (TodoItem) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		// Items without a list belong to the default list
+		edge.From("list", TodoList.Type).
+			Ref("items").
+			Unique(),
+
+		// Subtasks of the item
+		edge.To("children", TodoItem.Type).
+			From("parent").
+			Unique(),
+
+		edge.From("tags", Tag.Type).
+			Ref("items"),
+
+		// Comments are deleted together with the item
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+
+		// Time entries are deleted together with the item
+		edge.To("time_entries", TimeEntry.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+
+		// Attachments outlive the item until their content is deleted
+		edge.To("attachments", Attachment.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.SetNull}),
+	}
+}
+
+// Indexes of the TodoItem.
+func (TodoItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("series_id"),
+		index.Fields("rank"),
+		index.Fields("assignee"),
+		index.Fields("deleted_at"),
+	}
 }
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoList holds the schema definition for the TodoList entity.
+type TodoList struct {
+	ent.Schema
+}
+
+// Fields of the TodoList.
+func (TodoList) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("uid").
+			MaxLen(26).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		field.Text("description").
+			Default(""),
+		field.Bool("archived").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TodoList.
+func (TodoList) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", TodoItem.Type),
+		edge.To("share_tokens", ShareToken.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("collaborators", Collaborator.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
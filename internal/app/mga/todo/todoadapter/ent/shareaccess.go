@@ -0,0 +1,174 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/shareaccess"
+)
+
+// ShareAccess is the model entity for the ShareAccess schema.
+type ShareAccess struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// ShareUID holds the value of the "share_uid" field.
+	ShareUID string `json:"share_uid,omitempty"`
+	// ListUID holds the value of the "list_uid" field.
+	ListUID string `json:"list_uid,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation string `json:"operation,omitempty"`
+	// ItemUID holds the value of the "item_uid" field.
+	ItemUID string `json:"item_uid,omitempty"`
+	// CorrelationID holds the value of the "correlation_id" field.
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// Denied holds the value of the "denied" field.
+	Denied bool `json:"denied,omitempty"`
+	// AccessedAt holds the value of the "accessed_at" field.
+	AccessedAt time.Time `json:"accessed_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareAccess) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shareaccess.FieldDenied:
+			values[i] = new(sql.NullBool)
+		case shareaccess.FieldID:
+			values[i] = new(sql.NullInt64)
+		case shareaccess.FieldUID, shareaccess.FieldShareUID, shareaccess.FieldListUID, shareaccess.FieldOperation, shareaccess.FieldItemUID, shareaccess.FieldCorrelationID:
+			values[i] = new(sql.NullString)
+		case shareaccess.FieldAccessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ShareAccess", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareAccess fields.
+func (sa *ShareAccess) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shareaccess.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sa.ID = int(value.Int64)
+		case shareaccess.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				sa.UID = value.String
+			}
+		case shareaccess.FieldShareUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_uid", values[i])
+			} else if value.Valid {
+				sa.ShareUID = value.String
+			}
+		case shareaccess.FieldListUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field list_uid", values[i])
+			} else if value.Valid {
+				sa.ListUID = value.String
+			}
+		case shareaccess.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				sa.Operation = value.String
+			}
+		case shareaccess.FieldItemUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_uid", values[i])
+			} else if value.Valid {
+				sa.ItemUID = value.String
+			}
+		case shareaccess.FieldCorrelationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field correlation_id", values[i])
+			} else if value.Valid {
+				sa.CorrelationID = value.String
+			}
+		case shareaccess.FieldDenied:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field denied", values[i])
+			} else if value.Valid {
+				sa.Denied = value.Bool
+			}
+		case shareaccess.FieldAccessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accessed_at", values[i])
+			} else if value.Valid {
+				sa.AccessedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ShareAccess.
+// Note that you need to call ShareAccess.Unwrap() before calling this method if this ShareAccess
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *ShareAccess) Update() *ShareAccessUpdateOne {
+	return (&ShareAccessClient{config: sa.config}).UpdateOne(sa)
+}
+
+// Unwrap unwraps the ShareAccess entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *ShareAccess) Unwrap() *ShareAccess {
+	tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareAccess is not a transactional entity")
+	}
+	sa.config.driver = tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *ShareAccess) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareAccess(")
+	builder.WriteString(fmt.Sprintf("id=%v", sa.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(sa.UID)
+	builder.WriteString(", share_uid=")
+	builder.WriteString(sa.ShareUID)
+	builder.WriteString(", list_uid=")
+	builder.WriteString(sa.ListUID)
+	builder.WriteString(", operation=")
+	builder.WriteString(sa.Operation)
+	builder.WriteString(", item_uid=")
+	builder.WriteString(sa.ItemUID)
+	builder.WriteString(", correlation_id=")
+	builder.WriteString(sa.CorrelationID)
+	builder.WriteString(", denied=")
+	builder.WriteString(fmt.Sprintf("%v", sa.Denied))
+	builder.WriteString(", accessed_at=")
+	builder.WriteString(sa.AccessedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareAccesses is a parsable slice of ShareAccess.
+type ShareAccesses []*ShareAccess
+
+func (sa ShareAccesses) config(cfg config) {
+	for _i := range sa {
+		sa[_i].config = cfg
+	}
+}
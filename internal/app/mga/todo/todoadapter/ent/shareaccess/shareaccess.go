@@ -0,0 +1,72 @@
+// Code generated by entc, DO NOT EDIT.
+
+package shareaccess
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the shareaccess type in the database.
+	Label = "share_access"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldShareUID holds the string denoting the share_uid field in the database.
+	FieldShareUID = "share_uid"
+	// FieldListUID holds the string denoting the list_uid field in the database.
+	FieldListUID = "list_uid"
+	// FieldOperation holds the string denoting the operation field in the database.
+	FieldOperation = "operation"
+	// FieldItemUID holds the string denoting the item_uid field in the database.
+	FieldItemUID = "item_uid"
+	// FieldCorrelationID holds the string denoting the correlation_id field in the database.
+	FieldCorrelationID = "correlation_id"
+	// FieldDenied holds the string denoting the denied field in the database.
+	FieldDenied = "denied"
+	// FieldAccessedAt holds the string denoting the accessed_at field in the database.
+	FieldAccessedAt = "accessed_at"
+	// Table holds the table name of the shareaccess in the database.
+	Table = "share_access_log"
+)
+
+// Columns holds all SQL columns for shareaccess fields.
+var Columns = []string{
+	FieldID,
+	FieldUID,
+	FieldShareUID,
+	FieldListUID,
+	FieldOperation,
+	FieldItemUID,
+	FieldCorrelationID,
+	FieldDenied,
+	FieldAccessedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	UIDValidator func(string) error
+	// ShareUIDValidator is a validator for the "share_uid" field. It is called by the builders before save.
+	ShareUIDValidator func(string) error
+	// ListUIDValidator is a validator for the "list_uid" field. It is called by the builders before save.
+	ListUIDValidator func(string) error
+	// DefaultItemUID holds the default value on creation for the "item_uid" field.
+	DefaultItemUID string
+	// DefaultCorrelationID holds the default value on creation for the "correlation_id" field.
+	DefaultCorrelationID string
+	// DefaultDenied holds the default value on creation for the "denied" field.
+	DefaultDenied bool
+	// DefaultAccessedAt holds the default value on creation for the "accessed_at" field.
+	DefaultAccessedAt func() time.Time
+)
@@ -0,0 +1,937 @@
+// Code generated by entc, DO NOT EDIT.
+
+package shareaccess
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUID), v))
+	})
+}
+
+// ShareUID applies equality check predicate on the "share_uid" field. It's identical to ShareUIDEQ.
+func ShareUID(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldShareUID), v))
+	})
+}
+
+// ListUID applies equality check predicate on the "list_uid" field. It's identical to ListUIDEQ.
+func ListUID(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldListUID), v))
+	})
+}
+
+// Operation applies equality check predicate on the "operation" field. It's identical to OperationEQ.
+func Operation(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOperation), v))
+	})
+}
+
+// ItemUID applies equality check predicate on the "item_uid" field. It's identical to ItemUIDEQ.
+func ItemUID(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemUID), v))
+	})
+}
+
+// CorrelationID applies equality check predicate on the "correlation_id" field. It's identical to CorrelationIDEQ.
+func CorrelationID(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCorrelationID), v))
+	})
+}
+
+// Denied applies equality check predicate on the "denied" field. It's identical to DeniedEQ.
+func Denied(v bool) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDenied), v))
+	})
+}
+
+// AccessedAt applies equality check predicate on the "accessed_at" field. It's identical to AccessedAtEQ.
+func AccessedAt(v time.Time) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccessedAt), v))
+	})
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUID), v))
+	})
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUID), v))
+	})
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUID), v...))
+	})
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUID), v...))
+	})
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUID), v))
+	})
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUID), v))
+	})
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUID), v))
+	})
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUID), v))
+	})
+}
+
+// UIDContains applies the Contains predicate on the "uid" field.
+func UIDContains(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUID), v))
+	})
+}
+
+// UIDHasPrefix applies the HasPrefix predicate on the "uid" field.
+func UIDHasPrefix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUID), v))
+	})
+}
+
+// UIDHasSuffix applies the HasSuffix predicate on the "uid" field.
+func UIDHasSuffix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUID), v))
+	})
+}
+
+// UIDEqualFold applies the EqualFold predicate on the "uid" field.
+func UIDEqualFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUID), v))
+	})
+}
+
+// UIDContainsFold applies the ContainsFold predicate on the "uid" field.
+func UIDContainsFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUID), v))
+	})
+}
+
+// ShareUIDEQ applies the EQ predicate on the "share_uid" field.
+func ShareUIDEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDNEQ applies the NEQ predicate on the "share_uid" field.
+func ShareUIDNEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDIn applies the In predicate on the "share_uid" field.
+func ShareUIDIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldShareUID), v...))
+	})
+}
+
+// ShareUIDNotIn applies the NotIn predicate on the "share_uid" field.
+func ShareUIDNotIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldShareUID), v...))
+	})
+}
+
+// ShareUIDGT applies the GT predicate on the "share_uid" field.
+func ShareUIDGT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDGTE applies the GTE predicate on the "share_uid" field.
+func ShareUIDGTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDLT applies the LT predicate on the "share_uid" field.
+func ShareUIDLT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDLTE applies the LTE predicate on the "share_uid" field.
+func ShareUIDLTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDContains applies the Contains predicate on the "share_uid" field.
+func ShareUIDContains(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDHasPrefix applies the HasPrefix predicate on the "share_uid" field.
+func ShareUIDHasPrefix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDHasSuffix applies the HasSuffix predicate on the "share_uid" field.
+func ShareUIDHasSuffix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDEqualFold applies the EqualFold predicate on the "share_uid" field.
+func ShareUIDEqualFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldShareUID), v))
+	})
+}
+
+// ShareUIDContainsFold applies the ContainsFold predicate on the "share_uid" field.
+func ShareUIDContainsFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldShareUID), v))
+	})
+}
+
+// ListUIDEQ applies the EQ predicate on the "list_uid" field.
+func ListUIDEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDNEQ applies the NEQ predicate on the "list_uid" field.
+func ListUIDNEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDIn applies the In predicate on the "list_uid" field.
+func ListUIDIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldListUID), v...))
+	})
+}
+
+// ListUIDNotIn applies the NotIn predicate on the "list_uid" field.
+func ListUIDNotIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldListUID), v...))
+	})
+}
+
+// ListUIDGT applies the GT predicate on the "list_uid" field.
+func ListUIDGT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDGTE applies the GTE predicate on the "list_uid" field.
+func ListUIDGTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDLT applies the LT predicate on the "list_uid" field.
+func ListUIDLT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDLTE applies the LTE predicate on the "list_uid" field.
+func ListUIDLTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDContains applies the Contains predicate on the "list_uid" field.
+func ListUIDContains(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDHasPrefix applies the HasPrefix predicate on the "list_uid" field.
+func ListUIDHasPrefix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDHasSuffix applies the HasSuffix predicate on the "list_uid" field.
+func ListUIDHasSuffix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDEqualFold applies the EqualFold predicate on the "list_uid" field.
+func ListUIDEqualFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldListUID), v))
+	})
+}
+
+// ListUIDContainsFold applies the ContainsFold predicate on the "list_uid" field.
+func ListUIDContainsFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldListUID), v))
+	})
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOperation), v))
+	})
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOperation), v))
+	})
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOperation), v...))
+	})
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOperation), v...))
+	})
+}
+
+// OperationGT applies the GT predicate on the "operation" field.
+func OperationGT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOperation), v))
+	})
+}
+
+// OperationGTE applies the GTE predicate on the "operation" field.
+func OperationGTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOperation), v))
+	})
+}
+
+// OperationLT applies the LT predicate on the "operation" field.
+func OperationLT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOperation), v))
+	})
+}
+
+// OperationLTE applies the LTE predicate on the "operation" field.
+func OperationLTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOperation), v))
+	})
+}
+
+// OperationContains applies the Contains predicate on the "operation" field.
+func OperationContains(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOperation), v))
+	})
+}
+
+// OperationHasPrefix applies the HasPrefix predicate on the "operation" field.
+func OperationHasPrefix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOperation), v))
+	})
+}
+
+// OperationHasSuffix applies the HasSuffix predicate on the "operation" field.
+func OperationHasSuffix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOperation), v))
+	})
+}
+
+// OperationEqualFold applies the EqualFold predicate on the "operation" field.
+func OperationEqualFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOperation), v))
+	})
+}
+
+// OperationContainsFold applies the ContainsFold predicate on the "operation" field.
+func OperationContainsFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOperation), v))
+	})
+}
+
+// ItemUIDEQ applies the EQ predicate on the "item_uid" field.
+func ItemUIDEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDNEQ applies the NEQ predicate on the "item_uid" field.
+func ItemUIDNEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDIn applies the In predicate on the "item_uid" field.
+func ItemUIDIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldItemUID), v...))
+	})
+}
+
+// ItemUIDNotIn applies the NotIn predicate on the "item_uid" field.
+func ItemUIDNotIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldItemUID), v...))
+	})
+}
+
+// ItemUIDGT applies the GT predicate on the "item_uid" field.
+func ItemUIDGT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDGTE applies the GTE predicate on the "item_uid" field.
+func ItemUIDGTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDLT applies the LT predicate on the "item_uid" field.
+func ItemUIDLT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDLTE applies the LTE predicate on the "item_uid" field.
+func ItemUIDLTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDContains applies the Contains predicate on the "item_uid" field.
+func ItemUIDContains(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDHasPrefix applies the HasPrefix predicate on the "item_uid" field.
+func ItemUIDHasPrefix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDHasSuffix applies the HasSuffix predicate on the "item_uid" field.
+func ItemUIDHasSuffix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDEqualFold applies the EqualFold predicate on the "item_uid" field.
+func ItemUIDEqualFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldItemUID), v))
+	})
+}
+
+// ItemUIDContainsFold applies the ContainsFold predicate on the "item_uid" field.
+func ItemUIDContainsFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldItemUID), v))
+	})
+}
+
+// CorrelationIDEQ applies the EQ predicate on the "correlation_id" field.
+func CorrelationIDEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDNEQ applies the NEQ predicate on the "correlation_id" field.
+func CorrelationIDNEQ(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDIn applies the In predicate on the "correlation_id" field.
+func CorrelationIDIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCorrelationID), v...))
+	})
+}
+
+// CorrelationIDNotIn applies the NotIn predicate on the "correlation_id" field.
+func CorrelationIDNotIn(vs ...string) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCorrelationID), v...))
+	})
+}
+
+// CorrelationIDGT applies the GT predicate on the "correlation_id" field.
+func CorrelationIDGT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDGTE applies the GTE predicate on the "correlation_id" field.
+func CorrelationIDGTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDLT applies the LT predicate on the "correlation_id" field.
+func CorrelationIDLT(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDLTE applies the LTE predicate on the "correlation_id" field.
+func CorrelationIDLTE(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDContains applies the Contains predicate on the "correlation_id" field.
+func CorrelationIDContains(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDHasPrefix applies the HasPrefix predicate on the "correlation_id" field.
+func CorrelationIDHasPrefix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDHasSuffix applies the HasSuffix predicate on the "correlation_id" field.
+func CorrelationIDHasSuffix(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDEqualFold applies the EqualFold predicate on the "correlation_id" field.
+func CorrelationIDEqualFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCorrelationID), v))
+	})
+}
+
+// CorrelationIDContainsFold applies the ContainsFold predicate on the "correlation_id" field.
+func CorrelationIDContainsFold(v string) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCorrelationID), v))
+	})
+}
+
+// DeniedEQ applies the EQ predicate on the "denied" field.
+func DeniedEQ(v bool) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDenied), v))
+	})
+}
+
+// DeniedNEQ applies the NEQ predicate on the "denied" field.
+func DeniedNEQ(v bool) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDenied), v))
+	})
+}
+
+// AccessedAtEQ applies the EQ predicate on the "accessed_at" field.
+func AccessedAtEQ(v time.Time) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccessedAt), v))
+	})
+}
+
+// AccessedAtNEQ applies the NEQ predicate on the "accessed_at" field.
+func AccessedAtNEQ(v time.Time) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAccessedAt), v))
+	})
+}
+
+// AccessedAtIn applies the In predicate on the "accessed_at" field.
+func AccessedAtIn(vs ...time.Time) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAccessedAt), v...))
+	})
+}
+
+// AccessedAtNotIn applies the NotIn predicate on the "accessed_at" field.
+func AccessedAtNotIn(vs ...time.Time) predicate.ShareAccess {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAccessedAt), v...))
+	})
+}
+
+// AccessedAtGT applies the GT predicate on the "accessed_at" field.
+func AccessedAtGT(v time.Time) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAccessedAt), v))
+	})
+}
+
+// AccessedAtGTE applies the GTE predicate on the "accessed_at" field.
+func AccessedAtGTE(v time.Time) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAccessedAt), v))
+	})
+}
+
+// AccessedAtLT applies the LT predicate on the "accessed_at" field.
+func AccessedAtLT(v time.Time) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAccessedAt), v))
+	})
+}
+
+// AccessedAtLTE applies the LTE predicate on the "accessed_at" field.
+func AccessedAtLTE(v time.Time) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAccessedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShareAccess) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShareAccess) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShareAccess) predicate.ShareAccess {
+	return predicate.ShareAccess(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,409 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/shareaccess"
+)
+
+// ShareAccessCreate is the builder for creating a ShareAccess entity.
+type ShareAccessCreate struct {
+	config
+	mutation *ShareAccessMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (sac *ShareAccessCreate) SetUID(s string) *ShareAccessCreate {
+	sac.mutation.SetUID(s)
+	return sac
+}
+
+// SetShareUID sets the "share_uid" field.
+func (sac *ShareAccessCreate) SetShareUID(s string) *ShareAccessCreate {
+	sac.mutation.SetShareUID(s)
+	return sac
+}
+
+// SetListUID sets the "list_uid" field.
+func (sac *ShareAccessCreate) SetListUID(s string) *ShareAccessCreate {
+	sac.mutation.SetListUID(s)
+	return sac
+}
+
+// SetOperation sets the "operation" field.
+func (sac *ShareAccessCreate) SetOperation(s string) *ShareAccessCreate {
+	sac.mutation.SetOperation(s)
+	return sac
+}
+
+// SetItemUID sets the "item_uid" field.
+func (sac *ShareAccessCreate) SetItemUID(s string) *ShareAccessCreate {
+	sac.mutation.SetItemUID(s)
+	return sac
+}
+
+// SetNillableItemUID sets the "item_uid" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableItemUID(s *string) *ShareAccessCreate {
+	if s != nil {
+		sac.SetItemUID(*s)
+	}
+	return sac
+}
+
+// SetCorrelationID sets the "correlation_id" field.
+func (sac *ShareAccessCreate) SetCorrelationID(s string) *ShareAccessCreate {
+	sac.mutation.SetCorrelationID(s)
+	return sac
+}
+
+// SetNillableCorrelationID sets the "correlation_id" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableCorrelationID(s *string) *ShareAccessCreate {
+	if s != nil {
+		sac.SetCorrelationID(*s)
+	}
+	return sac
+}
+
+// SetDenied sets the "denied" field.
+func (sac *ShareAccessCreate) SetDenied(b bool) *ShareAccessCreate {
+	sac.mutation.SetDenied(b)
+	return sac
+}
+
+// SetNillableDenied sets the "denied" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableDenied(b *bool) *ShareAccessCreate {
+	if b != nil {
+		sac.SetDenied(*b)
+	}
+	return sac
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (sac *ShareAccessCreate) SetAccessedAt(t time.Time) *ShareAccessCreate {
+	sac.mutation.SetAccessedAt(t)
+	return sac
+}
+
+// SetNillableAccessedAt sets the "accessed_at" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableAccessedAt(t *time.Time) *ShareAccessCreate {
+	if t != nil {
+		sac.SetAccessedAt(*t)
+	}
+	return sac
+}
+
+// Mutation returns the ShareAccessMutation object of the builder.
+func (sac *ShareAccessCreate) Mutation() *ShareAccessMutation {
+	return sac.mutation
+}
+
+// Save creates the ShareAccess in the database.
+func (sac *ShareAccessCreate) Save(ctx context.Context) (*ShareAccess, error) {
+	var (
+		err  error
+		node *ShareAccess
+	)
+	sac.defaults()
+	if len(sac.hooks) == 0 {
+		if err = sac.check(); err != nil {
+			return nil, err
+		}
+		node, err = sac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareAccessMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sac.check(); err != nil {
+				return nil, err
+			}
+			sac.mutation = mutation
+			if node, err = sac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sac.hooks) - 1; i >= 0; i-- {
+			if sac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *ShareAccessCreate) SaveX(ctx context.Context) *ShareAccess {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *ShareAccessCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *ShareAccessCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *ShareAccessCreate) defaults() {
+	if _, ok := sac.mutation.ItemUID(); !ok {
+		v := shareaccess.DefaultItemUID
+		sac.mutation.SetItemUID(v)
+	}
+	if _, ok := sac.mutation.CorrelationID(); !ok {
+		v := shareaccess.DefaultCorrelationID
+		sac.mutation.SetCorrelationID(v)
+	}
+	if _, ok := sac.mutation.Denied(); !ok {
+		v := shareaccess.DefaultDenied
+		sac.mutation.SetDenied(v)
+	}
+	if _, ok := sac.mutation.AccessedAt(); !ok {
+		v := shareaccess.DefaultAccessedAt()
+		sac.mutation.SetAccessedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *ShareAccessCreate) check() error {
+	if _, ok := sac.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := sac.mutation.UID(); ok {
+		if err := shareaccess.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.ShareUID(); !ok {
+		return &ValidationError{Name: "share_uid", err: errors.New(`ent: missing required field "share_uid"`)}
+	}
+	if v, ok := sac.mutation.ShareUID(); ok {
+		if err := shareaccess.ShareUIDValidator(v); err != nil {
+			return &ValidationError{Name: "share_uid", err: fmt.Errorf(`ent: validator failed for field "share_uid": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.ListUID(); !ok {
+		return &ValidationError{Name: "list_uid", err: errors.New(`ent: missing required field "list_uid"`)}
+	}
+	if v, ok := sac.mutation.ListUID(); ok {
+		if err := shareaccess.ListUIDValidator(v); err != nil {
+			return &ValidationError{Name: "list_uid", err: fmt.Errorf(`ent: validator failed for field "list_uid": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "operation"`)}
+	}
+	if _, ok := sac.mutation.ItemUID(); !ok {
+		return &ValidationError{Name: "item_uid", err: errors.New(`ent: missing required field "item_uid"`)}
+	}
+	if _, ok := sac.mutation.CorrelationID(); !ok {
+		return &ValidationError{Name: "correlation_id", err: errors.New(`ent: missing required field "correlation_id"`)}
+	}
+	if _, ok := sac.mutation.Denied(); !ok {
+		return &ValidationError{Name: "denied", err: errors.New(`ent: missing required field "denied"`)}
+	}
+	if _, ok := sac.mutation.AccessedAt(); !ok {
+		return &ValidationError{Name: "accessed_at", err: errors.New(`ent: missing required field "accessed_at"`)}
+	}
+	return nil
+}
+
+func (sac *ShareAccessCreate) sqlSave(ctx context.Context) (*ShareAccess, error) {
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (sac *ShareAccessCreate) createSpec() (*ShareAccess, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareAccess{config: sac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: shareaccess.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: shareaccess.FieldID,
+			},
+		}
+	)
+	if value, ok := sac.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: shareaccess.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := sac.mutation.ShareUID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: shareaccess.FieldShareUID,
+		})
+		_node.ShareUID = value
+	}
+	if value, ok := sac.mutation.ListUID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: shareaccess.FieldListUID,
+		})
+		_node.ListUID = value
+	}
+	if value, ok := sac.mutation.Operation(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: shareaccess.FieldOperation,
+		})
+		_node.Operation = value
+	}
+	if value, ok := sac.mutation.ItemUID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: shareaccess.FieldItemUID,
+		})
+		_node.ItemUID = value
+	}
+	if value, ok := sac.mutation.CorrelationID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: shareaccess.FieldCorrelationID,
+		})
+		_node.CorrelationID = value
+	}
+	if value, ok := sac.mutation.Denied(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: shareaccess.FieldDenied,
+		})
+		_node.Denied = value
+	}
+	if value, ok := sac.mutation.AccessedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: shareaccess.FieldAccessedAt,
+		})
+		_node.AccessedAt = value
+	}
+	return _node, _spec
+}
+
+// ShareAccessCreateBulk is the builder for creating many ShareAccess entities in bulk.
+type ShareAccessCreateBulk struct {
+	config
+	builders []*ShareAccessCreate
+}
+
+// Save creates the ShareAccess entities in the database.
+func (sacb *ShareAccessCreateBulk) Save(ctx context.Context) ([]*ShareAccess, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*ShareAccess, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareAccessMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *ShareAccessCreateBulk) SaveX(ctx context.Context) []*ShareAccess {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *ShareAccessCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *ShareAccessCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
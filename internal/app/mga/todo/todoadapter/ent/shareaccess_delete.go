@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/shareaccess"
+)
+
+// ShareAccessDelete is the builder for deleting a ShareAccess entity.
+type ShareAccessDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShareAccessMutation
+}
+
+// Where appends a list predicates to the ShareAccessDelete builder.
+func (sad *ShareAccessDelete) Where(ps ...predicate.ShareAccess) *ShareAccessDelete {
+	sad.mutation.Where(ps...)
+	return sad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sad *ShareAccessDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sad.hooks) == 0 {
+		affected, err = sad.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareAccessMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sad.mutation = mutation
+			affected, err = sad.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sad.hooks) - 1; i >= 0; i-- {
+			if sad.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sad.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sad.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sad *ShareAccessDelete) ExecX(ctx context.Context) int {
+	n, err := sad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sad *ShareAccessDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: shareaccess.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: shareaccess.FieldID,
+			},
+		},
+	}
+	if ps := sad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, sad.driver, _spec)
+}
+
+// ShareAccessDeleteOne is the builder for deleting a single ShareAccess entity.
+type ShareAccessDeleteOne struct {
+	sad *ShareAccessDelete
+}
+
+// Exec executes the deletion query.
+func (sado *ShareAccessDeleteOne) Exec(ctx context.Context) error {
+	n, err := sado.sad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{shareaccess.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sado *ShareAccessDeleteOne) ExecX(ctx context.Context) {
+	sado.sad.ExecX(ctx)
+}
@@ -0,0 +1,238 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/shareaccess"
+)
+
+// ShareAccessUpdate is the builder for updating ShareAccess entities.
+type ShareAccessUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareAccessMutation
+}
+
+// Where appends a list predicates to the ShareAccessUpdate builder.
+func (sau *ShareAccessUpdate) Where(ps ...predicate.ShareAccess) *ShareAccessUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// Mutation returns the ShareAccessMutation object of the builder.
+func (sau *ShareAccessUpdate) Mutation() *ShareAccessMutation {
+	return sau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *ShareAccessUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sau.hooks) == 0 {
+		affected, err = sau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareAccessMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sau.mutation = mutation
+			affected, err = sau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sau.hooks) - 1; i >= 0; i-- {
+			if sau.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *ShareAccessUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *ShareAccessUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *ShareAccessUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sau *ShareAccessUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   shareaccess.Table,
+			Columns: shareaccess.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: shareaccess.FieldID,
+			},
+		},
+	}
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ShareAccessUpdateOne is the builder for updating a single ShareAccess entity.
+type ShareAccessUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareAccessMutation
+}
+
+// Mutation returns the ShareAccessMutation object of the builder.
+func (sauo *ShareAccessUpdateOne) Mutation() *ShareAccessMutation {
+	return sauo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *ShareAccessUpdateOne) Select(field string, fields ...string) *ShareAccessUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated ShareAccess entity.
+func (sauo *ShareAccessUpdateOne) Save(ctx context.Context) (*ShareAccess, error) {
+	var (
+		err  error
+		node *ShareAccess
+	)
+	if len(sauo.hooks) == 0 {
+		node, err = sauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareAccessMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sauo.mutation = mutation
+			node, err = sauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sauo.hooks) - 1; i >= 0; i-- {
+			if sauo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sauo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sauo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *ShareAccessUpdateOne) SaveX(ctx context.Context) *ShareAccess {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *ShareAccessUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *ShareAccessUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sauo *ShareAccessUpdateOne) sqlSave(ctx context.Context) (_node *ShareAccess, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   shareaccess.Table,
+			Columns: shareaccess.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: shareaccess.FieldID,
+			},
+		},
+	}
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing ShareAccess.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shareaccess.FieldID)
+		for _, f := range fields {
+			if !shareaccess.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shareaccess.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &ShareAccess{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,210 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// ShareToken is the model entity for the ShareToken schema.
+type ShareToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Access holds the value of the "access" field.
+	Access string `json:"access,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"token_hash,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareTokenQuery when eager-loading is set.
+	Edges                  ShareTokenEdges `json:"edges"`
+	todo_list_share_tokens *int
+}
+
+// ShareTokenEdges holds the relations/edges for other nodes in the graph.
+type ShareTokenEdges struct {
+	// List holds the value of the list edge.
+	List *TodoList `json:"list,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ListOrErr returns the List value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareTokenEdges) ListOrErr() (*TodoList, error) {
+	if e.loadedTypes[0] {
+		if e.List == nil {
+			// The edge list was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todolist.Label}
+		}
+		return e.List, nil
+	}
+	return nil, &NotLoadedError{edge: "list"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareToken) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharetoken.FieldID:
+			values[i] = new(sql.NullInt64)
+		case sharetoken.FieldUID, sharetoken.FieldAccess, sharetoken.FieldTokenHash, sharetoken.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case sharetoken.FieldCreatedAt, sharetoken.FieldExpiresAt, sharetoken.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		case sharetoken.ForeignKeys[0]: // todo_list_share_tokens
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ShareToken", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareToken fields.
+func (st *ShareToken) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharetoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			st.ID = int(value.Int64)
+		case sharetoken.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				st.UID = value.String
+			}
+		case sharetoken.FieldAccess:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access", values[i])
+			} else if value.Valid {
+				st.Access = value.String
+			}
+		case sharetoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				st.TokenHash = value.String
+			}
+		case sharetoken.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				st.CreatedBy = value.String
+			}
+		case sharetoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				st.CreatedAt = value.Time
+			}
+		case sharetoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				st.ExpiresAt = new(time.Time)
+				*st.ExpiresAt = value.Time
+			}
+		case sharetoken.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				st.RevokedAt = new(time.Time)
+				*st.RevokedAt = value.Time
+			}
+		case sharetoken.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field todo_list_share_tokens", value)
+			} else if value.Valid {
+				st.todo_list_share_tokens = new(int)
+				*st.todo_list_share_tokens = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryList queries the "list" edge of the ShareToken entity.
+func (st *ShareToken) QueryList() *TodoListQuery {
+	return (&ShareTokenClient{config: st.config}).QueryList(st)
+}
+
+// Update returns a builder for updating this ShareToken.
+// Note that you need to call ShareToken.Unwrap() before calling this method if this ShareToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (st *ShareToken) Update() *ShareTokenUpdateOne {
+	return (&ShareTokenClient{config: st.config}).UpdateOne(st)
+}
+
+// Unwrap unwraps the ShareToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (st *ShareToken) Unwrap() *ShareToken {
+	tx, ok := st.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareToken is not a transactional entity")
+	}
+	st.config.driver = tx.drv
+	return st
+}
+
+// String implements the fmt.Stringer.
+func (st *ShareToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareToken(")
+	builder.WriteString(fmt.Sprintf("id=%v", st.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(st.UID)
+	builder.WriteString(", access=")
+	builder.WriteString(st.Access)
+	builder.WriteString(", token_hash=")
+	builder.WriteString(st.TokenHash)
+	builder.WriteString(", created_by=")
+	builder.WriteString(st.CreatedBy)
+	builder.WriteString(", created_at=")
+	builder.WriteString(st.CreatedAt.Format(time.ANSIC))
+	if v := st.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := st.RevokedAt; v != nil {
+		builder.WriteString(", revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareTokens is a parsable slice of ShareToken.
+type ShareTokens []*ShareToken
+
+func (st ShareTokens) config(cfg config) {
+	for _i := range st {
+		st[_i].config = cfg
+	}
+}
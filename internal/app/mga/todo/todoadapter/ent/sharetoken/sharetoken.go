@@ -0,0 +1,83 @@
+// Code generated by entc, DO NOT EDIT.
+
+package sharetoken
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the sharetoken type in the database.
+	Label = "share_token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldAccess holds the string denoting the access field in the database.
+	FieldAccess = "access"
+	// FieldTokenHash holds the string denoting the token_hash field in the database.
+	FieldTokenHash = "token_hash"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
+	// EdgeList holds the string denoting the list edge name in mutations.
+	EdgeList = "list"
+	// Table holds the table name of the sharetoken in the database.
+	Table = "share_tokens"
+	// ListTable is the table that holds the list relation/edge.
+	ListTable = "share_tokens"
+	// ListInverseTable is the table name for the TodoList entity.
+	// It exists in this package in order to avoid circular dependency with the "todolist" package.
+	ListInverseTable = "todo_lists"
+	// ListColumn is the table column denoting the list relation/edge.
+	ListColumn = "todo_list_share_tokens"
+)
+
+// Columns holds all SQL columns for sharetoken fields.
+var Columns = []string{
+	FieldID,
+	FieldUID,
+	FieldAccess,
+	FieldTokenHash,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldExpiresAt,
+	FieldRevokedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "share_tokens"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"todo_list_share_tokens",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	UIDValidator func(string) error
+	// TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	TokenHashValidator func(string) error
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,408 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// ShareTokenCreate is the builder for creating a ShareToken entity.
+type ShareTokenCreate struct {
+	config
+	mutation *ShareTokenMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (stc *ShareTokenCreate) SetUID(s string) *ShareTokenCreate {
+	stc.mutation.SetUID(s)
+	return stc
+}
+
+// SetAccess sets the "access" field.
+func (stc *ShareTokenCreate) SetAccess(s string) *ShareTokenCreate {
+	stc.mutation.SetAccess(s)
+	return stc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (stc *ShareTokenCreate) SetTokenHash(s string) *ShareTokenCreate {
+	stc.mutation.SetTokenHash(s)
+	return stc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (stc *ShareTokenCreate) SetCreatedBy(s string) *ShareTokenCreate {
+	stc.mutation.SetCreatedBy(s)
+	return stc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (stc *ShareTokenCreate) SetNillableCreatedBy(s *string) *ShareTokenCreate {
+	if s != nil {
+		stc.SetCreatedBy(*s)
+	}
+	return stc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (stc *ShareTokenCreate) SetCreatedAt(t time.Time) *ShareTokenCreate {
+	stc.mutation.SetCreatedAt(t)
+	return stc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (stc *ShareTokenCreate) SetNillableCreatedAt(t *time.Time) *ShareTokenCreate {
+	if t != nil {
+		stc.SetCreatedAt(*t)
+	}
+	return stc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (stc *ShareTokenCreate) SetExpiresAt(t time.Time) *ShareTokenCreate {
+	stc.mutation.SetExpiresAt(t)
+	return stc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (stc *ShareTokenCreate) SetNillableExpiresAt(t *time.Time) *ShareTokenCreate {
+	if t != nil {
+		stc.SetExpiresAt(*t)
+	}
+	return stc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (stc *ShareTokenCreate) SetRevokedAt(t time.Time) *ShareTokenCreate {
+	stc.mutation.SetRevokedAt(t)
+	return stc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (stc *ShareTokenCreate) SetNillableRevokedAt(t *time.Time) *ShareTokenCreate {
+	if t != nil {
+		stc.SetRevokedAt(*t)
+	}
+	return stc
+}
+
+// SetListID sets the "list" edge to the TodoList entity by ID.
+func (stc *ShareTokenCreate) SetListID(id int) *ShareTokenCreate {
+	stc.mutation.SetListID(id)
+	return stc
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (stc *ShareTokenCreate) SetList(t *TodoList) *ShareTokenCreate {
+	return stc.SetListID(t.ID)
+}
+
+// Mutation returns the ShareTokenMutation object of the builder.
+func (stc *ShareTokenCreate) Mutation() *ShareTokenMutation {
+	return stc.mutation
+}
+
+// Save creates the ShareToken in the database.
+func (stc *ShareTokenCreate) Save(ctx context.Context) (*ShareToken, error) {
+	var (
+		err  error
+		node *ShareToken
+	)
+	stc.defaults()
+	if len(stc.hooks) == 0 {
+		if err = stc.check(); err != nil {
+			return nil, err
+		}
+		node, err = stc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = stc.check(); err != nil {
+				return nil, err
+			}
+			stc.mutation = mutation
+			if node, err = stc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(stc.hooks) - 1; i >= 0; i-- {
+			if stc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = stc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, stc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (stc *ShareTokenCreate) SaveX(ctx context.Context) *ShareToken {
+	v, err := stc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stc *ShareTokenCreate) Exec(ctx context.Context) error {
+	_, err := stc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stc *ShareTokenCreate) ExecX(ctx context.Context) {
+	if err := stc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stc *ShareTokenCreate) defaults() {
+	if _, ok := stc.mutation.CreatedBy(); !ok {
+		v := sharetoken.DefaultCreatedBy
+		stc.mutation.SetCreatedBy(v)
+	}
+	if _, ok := stc.mutation.CreatedAt(); !ok {
+		v := sharetoken.DefaultCreatedAt()
+		stc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stc *ShareTokenCreate) check() error {
+	if _, ok := stc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := stc.mutation.UID(); ok {
+		if err := sharetoken.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.Access(); !ok {
+		return &ValidationError{Name: "access", err: errors.New(`ent: missing required field "access"`)}
+	}
+	if _, ok := stc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "token_hash"`)}
+	}
+	if v, ok := stc.mutation.TokenHash(); ok {
+		if err := sharetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "token_hash": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "created_by"`)}
+	}
+	if _, ok := stc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
+	}
+	if _, ok := stc.mutation.ListID(); !ok {
+		return &ValidationError{Name: "list", err: errors.New("ent: missing required edge \"list\"")}
+	}
+	return nil
+}
+
+func (stc *ShareTokenCreate) sqlSave(ctx context.Context) (*ShareToken, error) {
+	_node, _spec := stc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, stc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (stc *ShareTokenCreate) createSpec() (*ShareToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareToken{config: stc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: sharetoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharetoken.FieldID,
+			},
+		}
+	)
+	if value, ok := stc.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sharetoken.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := stc.mutation.Access(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sharetoken.FieldAccess,
+		})
+		_node.Access = value
+	}
+	if value, ok := stc.mutation.TokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sharetoken.FieldTokenHash,
+		})
+		_node.TokenHash = value
+	}
+	if value, ok := stc.mutation.CreatedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sharetoken.FieldCreatedBy,
+		})
+		_node.CreatedBy = value
+	}
+	if value, ok := stc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sharetoken.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := stc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sharetoken.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if value, ok := stc.mutation.RevokedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sharetoken.FieldRevokedAt,
+		})
+		_node.RevokedAt = &value
+	}
+	if nodes := stc.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ListTable,
+			Columns: []string{sharetoken.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.todo_list_share_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareTokenCreateBulk is the builder for creating many ShareToken entities in bulk.
+type ShareTokenCreateBulk struct {
+	config
+	builders []*ShareTokenCreate
+}
+
+// Save creates the ShareToken entities in the database.
+func (stcb *ShareTokenCreateBulk) Save(ctx context.Context) ([]*ShareToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(stcb.builders))
+	nodes := make([]*ShareToken, len(stcb.builders))
+	mutators := make([]Mutator, len(stcb.builders))
+	for i := range stcb.builders {
+		func(i int, root context.Context) {
+			builder := stcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, stcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, stcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, stcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stcb *ShareTokenCreateBulk) SaveX(ctx context.Context) []*ShareToken {
+	v, err := stcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stcb *ShareTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := stcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stcb *ShareTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := stcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+)
+
+// ShareTokenDelete is the builder for deleting a ShareToken entity.
+type ShareTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShareTokenMutation
+}
+
+// Where appends a list predicates to the ShareTokenDelete builder.
+func (std *ShareTokenDelete) Where(ps ...predicate.ShareToken) *ShareTokenDelete {
+	std.mutation.Where(ps...)
+	return std
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (std *ShareTokenDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(std.hooks) == 0 {
+		affected, err = std.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			std.mutation = mutation
+			affected, err = std.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(std.hooks) - 1; i >= 0; i-- {
+			if std.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = std.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, std.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (std *ShareTokenDelete) ExecX(ctx context.Context) int {
+	n, err := std.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (std *ShareTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: sharetoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharetoken.FieldID,
+			},
+		},
+	}
+	if ps := std.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, std.driver, _spec)
+}
+
+// ShareTokenDeleteOne is the builder for deleting a single ShareToken entity.
+type ShareTokenDeleteOne struct {
+	std *ShareTokenDelete
+}
+
+// Exec executes the deletion query.
+func (stdo *ShareTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := stdo.std.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sharetoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stdo *ShareTokenDeleteOne) ExecX(ctx context.Context) {
+	stdo.std.ExecX(ctx)
+}
@@ -0,0 +1,992 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// ShareTokenQuery is the builder for querying ShareToken entities.
+type ShareTokenQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ShareToken
+	// eager-loading edges.
+	withList *TodoListQuery
+	withFKs  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShareTokenQuery builder.
+func (stq *ShareTokenQuery) Where(ps ...predicate.ShareToken) *ShareTokenQuery {
+	stq.predicates = append(stq.predicates, ps...)
+	return stq
+}
+
+// Limit adds a limit step to the query.
+func (stq *ShareTokenQuery) Limit(limit int) *ShareTokenQuery {
+	stq.limit = &limit
+	return stq
+}
+
+// Offset adds an offset step to the query.
+func (stq *ShareTokenQuery) Offset(offset int) *ShareTokenQuery {
+	stq.offset = &offset
+	return stq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (stq *ShareTokenQuery) Unique(unique bool) *ShareTokenQuery {
+	stq.unique = &unique
+	return stq
+}
+
+// Order adds an order step to the query.
+func (stq *ShareTokenQuery) Order(o ...OrderFunc) *ShareTokenQuery {
+	stq.order = append(stq.order, o...)
+	return stq
+}
+
+// QueryList chains the current query on the "list" edge.
+func (stq *ShareTokenQuery) QueryList() *TodoListQuery {
+	query := &TodoListQuery{config: stq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := stq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := stq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharetoken.Table, sharetoken.FieldID, selector),
+			sqlgraph.To(todolist.Table, todolist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharetoken.ListTable, sharetoken.ListColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(stq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShareToken entity from the query.
+// Returns a *NotFoundError when no ShareToken was found.
+func (stq *ShareTokenQuery) First(ctx context.Context) (*ShareToken, error) {
+	nodes, err := stq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sharetoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (stq *ShareTokenQuery) FirstX(ctx context.Context) *ShareToken {
+	node, err := stq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShareToken ID from the query.
+// Returns a *NotFoundError when no ShareToken ID was found.
+func (stq *ShareTokenQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = stq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sharetoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (stq *ShareTokenQuery) FirstIDX(ctx context.Context) int {
+	id, err := stq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShareToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one ShareToken entity is not found.
+// Returns a *NotFoundError when no ShareToken entities are found.
+func (stq *ShareTokenQuery) Only(ctx context.Context) (*ShareToken, error) {
+	nodes, err := stq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sharetoken.Label}
+	default:
+		return nil, &NotSingularError{sharetoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (stq *ShareTokenQuery) OnlyX(ctx context.Context) *ShareToken {
+	node, err := stq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShareToken ID in the query.
+// Returns a *NotSingularError when exactly one ShareToken ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (stq *ShareTokenQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = stq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sharetoken.Label}
+	default:
+		err = &NotSingularError{sharetoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (stq *ShareTokenQuery) OnlyIDX(ctx context.Context) int {
+	id, err := stq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShareTokens.
+func (stq *ShareTokenQuery) All(ctx context.Context) ([]*ShareToken, error) {
+	if err := stq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return stq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (stq *ShareTokenQuery) AllX(ctx context.Context) []*ShareToken {
+	nodes, err := stq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShareToken IDs.
+func (stq *ShareTokenQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := stq.Select(sharetoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (stq *ShareTokenQuery) IDsX(ctx context.Context) []int {
+	ids, err := stq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (stq *ShareTokenQuery) Count(ctx context.Context) (int, error) {
+	if err := stq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return stq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (stq *ShareTokenQuery) CountX(ctx context.Context) int {
+	count, err := stq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (stq *ShareTokenQuery) Exist(ctx context.Context) (bool, error) {
+	if err := stq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return stq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (stq *ShareTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := stq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShareTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (stq *ShareTokenQuery) Clone() *ShareTokenQuery {
+	if stq == nil {
+		return nil
+	}
+	return &ShareTokenQuery{
+		config:     stq.config,
+		limit:      stq.limit,
+		offset:     stq.offset,
+		order:      append([]OrderFunc{}, stq.order...),
+		predicates: append([]predicate.ShareToken{}, stq.predicates...),
+		withList:   stq.withList.Clone(),
+		// clone intermediate query.
+		sql:  stq.sql.Clone(),
+		path: stq.path,
+	}
+}
+
+// WithList tells the query-builder to eager-load the nodes that are connected to
+// the "list" edge. The optional arguments are used to configure the query builder of the edge.
+func (stq *ShareTokenQuery) WithList(opts ...func(*TodoListQuery)) *ShareTokenQuery {
+	query := &TodoListQuery{config: stq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	stq.withList = query
+	return stq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShareToken.Query().
+//		GroupBy(sharetoken.FieldUID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (stq *ShareTokenQuery) GroupBy(field string, fields ...string) *ShareTokenGroupBy {
+	group := &ShareTokenGroupBy{config: stq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := stq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return stq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//	}
+//
+//	client.ShareToken.Query().
+//		Select(sharetoken.FieldUID).
+//		Scan(ctx, &v)
+func (stq *ShareTokenQuery) Select(fields ...string) *ShareTokenSelect {
+	stq.fields = append(stq.fields, fields...)
+	return &ShareTokenSelect{ShareTokenQuery: stq}
+}
+
+func (stq *ShareTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range stq.fields {
+		if !sharetoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if stq.path != nil {
+		prev, err := stq.path(ctx)
+		if err != nil {
+			return err
+		}
+		stq.sql = prev
+	}
+	return nil
+}
+
+func (stq *ShareTokenQuery) sqlAll(ctx context.Context) ([]*ShareToken, error) {
+	var (
+		nodes       = []*ShareToken{}
+		withFKs     = stq.withFKs
+		_spec       = stq.querySpec()
+		loadedTypes = [1]bool{
+			stq.withList != nil,
+		}
+	)
+	if stq.withList != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, sharetoken.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ShareToken{config: stq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, stq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := stq.withList; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*ShareToken)
+		for i := range nodes {
+			if nodes[i].todo_list_share_tokens == nil {
+				continue
+			}
+			fk := *nodes[i].todo_list_share_tokens
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(todolist.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_list_share_tokens" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.List = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (stq *ShareTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := stq.querySpec()
+	return sqlgraph.CountNodes(ctx, stq.driver, _spec)
+}
+
+func (stq *ShareTokenQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := stq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (stq *ShareTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharetoken.Table,
+			Columns: sharetoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharetoken.FieldID,
+			},
+		},
+		From:   stq.sql,
+		Unique: true,
+	}
+	if unique := stq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := stq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharetoken.FieldID)
+		for i := range fields {
+			if fields[i] != sharetoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := stq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := stq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := stq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := stq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (stq *ShareTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(stq.driver.Dialect())
+	t1 := builder.Table(sharetoken.Table)
+	columns := stq.fields
+	if len(columns) == 0 {
+		columns = sharetoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if stq.sql != nil {
+		selector = stq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range stq.predicates {
+		p(selector)
+	}
+	for _, p := range stq.order {
+		p(selector)
+	}
+	if offset := stq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := stq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ShareTokenGroupBy is the group-by builder for ShareToken entities.
+type ShareTokenGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (stgb *ShareTokenGroupBy) Aggregate(fns ...AggregateFunc) *ShareTokenGroupBy {
+	stgb.fns = append(stgb.fns, fns...)
+	return stgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (stgb *ShareTokenGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := stgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	stgb.sql = query
+	return stgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (stgb *ShareTokenGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := stgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (stgb *ShareTokenGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(stgb.fields) > 1 {
+		return nil, errors.New("ent: ShareTokenGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := stgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (stgb *ShareTokenGroupBy) StringsX(ctx context.Context) []string {
+	v, err := stgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (stgb *ShareTokenGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = stgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sharetoken.Label}
+	default:
+		err = fmt.Errorf("ent: ShareTokenGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (stgb *ShareTokenGroupBy) StringX(ctx context.Context) string {
+	v, err := stgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (stgb *ShareTokenGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(stgb.fields) > 1 {
+		return nil, errors.New("ent: ShareTokenGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := stgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (stgb *ShareTokenGroupBy) IntsX(ctx context.Context) []int {
+	v, err := stgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (stgb *ShareTokenGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = stgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sharetoken.Label}
+	default:
+		err = fmt.Errorf("ent: ShareTokenGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (stgb *ShareTokenGroupBy) IntX(ctx context.Context) int {
+	v, err := stgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (stgb *ShareTokenGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(stgb.fields) > 1 {
+		return nil, errors.New("ent: ShareTokenGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := stgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (stgb *ShareTokenGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := stgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (stgb *ShareTokenGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = stgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sharetoken.Label}
+	default:
+		err = fmt.Errorf("ent: ShareTokenGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (stgb *ShareTokenGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := stgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (stgb *ShareTokenGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(stgb.fields) > 1 {
+		return nil, errors.New("ent: ShareTokenGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := stgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (stgb *ShareTokenGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := stgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (stgb *ShareTokenGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = stgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sharetoken.Label}
+	default:
+		err = fmt.Errorf("ent: ShareTokenGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (stgb *ShareTokenGroupBy) BoolX(ctx context.Context) bool {
+	v, err := stgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (stgb *ShareTokenGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range stgb.fields {
+		if !sharetoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := stgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := stgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (stgb *ShareTokenGroupBy) sqlQuery() *sql.Selector {
+	selector := stgb.sql.Select()
+	aggregation := make([]string, 0, len(stgb.fns))
+	for _, fn := range stgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(stgb.fields)+len(stgb.fns))
+		for _, f := range stgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(stgb.fields...)...)
+}
+
+// ShareTokenSelect is the builder for selecting fields of ShareToken entities.
+type ShareTokenSelect struct {
+	*ShareTokenQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sts *ShareTokenSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := sts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sts.sql = sts.ShareTokenQuery.sqlQuery(ctx)
+	return sts.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sts *ShareTokenSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := sts.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (sts *ShareTokenSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(sts.fields) > 1 {
+		return nil, errors.New("ent: ShareTokenSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := sts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sts *ShareTokenSelect) StringsX(ctx context.Context) []string {
+	v, err := sts.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (sts *ShareTokenSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sts.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sharetoken.Label}
+	default:
+		err = fmt.Errorf("ent: ShareTokenSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sts *ShareTokenSelect) StringX(ctx context.Context) string {
+	v, err := sts.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (sts *ShareTokenSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(sts.fields) > 1 {
+		return nil, errors.New("ent: ShareTokenSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := sts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sts *ShareTokenSelect) IntsX(ctx context.Context) []int {
+	v, err := sts.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (sts *ShareTokenSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sts.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sharetoken.Label}
+	default:
+		err = fmt.Errorf("ent: ShareTokenSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sts *ShareTokenSelect) IntX(ctx context.Context) int {
+	v, err := sts.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (sts *ShareTokenSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sts.fields) > 1 {
+		return nil, errors.New("ent: ShareTokenSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := sts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sts *ShareTokenSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := sts.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (sts *ShareTokenSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sts.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sharetoken.Label}
+	default:
+		err = fmt.Errorf("ent: ShareTokenSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sts *ShareTokenSelect) Float64X(ctx context.Context) float64 {
+	v, err := sts.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (sts *ShareTokenSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(sts.fields) > 1 {
+		return nil, errors.New("ent: ShareTokenSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := sts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sts *ShareTokenSelect) BoolsX(ctx context.Context) []bool {
+	v, err := sts.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (sts *ShareTokenSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sts.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sharetoken.Label}
+	default:
+		err = fmt.Errorf("ent: ShareTokenSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sts *ShareTokenSelect) BoolX(ctx context.Context) bool {
+	v, err := sts.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sts *ShareTokenSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sts.sql.Query()
+	if err := sts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,451 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// ShareTokenUpdate is the builder for updating ShareToken entities.
+type ShareTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareTokenMutation
+}
+
+// Where appends a list predicates to the ShareTokenUpdate builder.
+func (stu *ShareTokenUpdate) Where(ps ...predicate.ShareToken) *ShareTokenUpdate {
+	stu.mutation.Where(ps...)
+	return stu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (stu *ShareTokenUpdate) SetRevokedAt(t time.Time) *ShareTokenUpdate {
+	stu.mutation.SetRevokedAt(t)
+	return stu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (stu *ShareTokenUpdate) SetNillableRevokedAt(t *time.Time) *ShareTokenUpdate {
+	if t != nil {
+		stu.SetRevokedAt(*t)
+	}
+	return stu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (stu *ShareTokenUpdate) ClearRevokedAt() *ShareTokenUpdate {
+	stu.mutation.ClearRevokedAt()
+	return stu
+}
+
+// SetListID sets the "list" edge to the TodoList entity by ID.
+func (stu *ShareTokenUpdate) SetListID(id int) *ShareTokenUpdate {
+	stu.mutation.SetListID(id)
+	return stu
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (stu *ShareTokenUpdate) SetList(t *TodoList) *ShareTokenUpdate {
+	return stu.SetListID(t.ID)
+}
+
+// Mutation returns the ShareTokenMutation object of the builder.
+func (stu *ShareTokenUpdate) Mutation() *ShareTokenMutation {
+	return stu.mutation
+}
+
+// ClearList clears the "list" edge to the TodoList entity.
+func (stu *ShareTokenUpdate) ClearList() *ShareTokenUpdate {
+	stu.mutation.ClearList()
+	return stu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (stu *ShareTokenUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(stu.hooks) == 0 {
+		if err = stu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = stu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = stu.check(); err != nil {
+				return 0, err
+			}
+			stu.mutation = mutation
+			affected, err = stu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(stu.hooks) - 1; i >= 0; i-- {
+			if stu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = stu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, stu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stu *ShareTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := stu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (stu *ShareTokenUpdate) Exec(ctx context.Context) error {
+	_, err := stu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stu *ShareTokenUpdate) ExecX(ctx context.Context) {
+	if err := stu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stu *ShareTokenUpdate) check() error {
+	if _, ok := stu.mutation.ListID(); stu.mutation.ListCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"list\"")
+	}
+	return nil
+}
+
+func (stu *ShareTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharetoken.Table,
+			Columns: sharetoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharetoken.FieldID,
+			},
+		},
+	}
+	if ps := stu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if stu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: sharetoken.FieldExpiresAt,
+		})
+	}
+	if value, ok := stu.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sharetoken.FieldRevokedAt,
+		})
+	}
+	if stu.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: sharetoken.FieldRevokedAt,
+		})
+	}
+	if stu.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ListTable,
+			Columns: []string{sharetoken.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stu.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ListTable,
+			Columns: []string{sharetoken.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ShareTokenUpdateOne is the builder for updating a single ShareToken entity.
+type ShareTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareTokenMutation
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (stuo *ShareTokenUpdateOne) SetRevokedAt(t time.Time) *ShareTokenUpdateOne {
+	stuo.mutation.SetRevokedAt(t)
+	return stuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (stuo *ShareTokenUpdateOne) SetNillableRevokedAt(t *time.Time) *ShareTokenUpdateOne {
+	if t != nil {
+		stuo.SetRevokedAt(*t)
+	}
+	return stuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (stuo *ShareTokenUpdateOne) ClearRevokedAt() *ShareTokenUpdateOne {
+	stuo.mutation.ClearRevokedAt()
+	return stuo
+}
+
+// SetListID sets the "list" edge to the TodoList entity by ID.
+func (stuo *ShareTokenUpdateOne) SetListID(id int) *ShareTokenUpdateOne {
+	stuo.mutation.SetListID(id)
+	return stuo
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (stuo *ShareTokenUpdateOne) SetList(t *TodoList) *ShareTokenUpdateOne {
+	return stuo.SetListID(t.ID)
+}
+
+// Mutation returns the ShareTokenMutation object of the builder.
+func (stuo *ShareTokenUpdateOne) Mutation() *ShareTokenMutation {
+	return stuo.mutation
+}
+
+// ClearList clears the "list" edge to the TodoList entity.
+func (stuo *ShareTokenUpdateOne) ClearList() *ShareTokenUpdateOne {
+	stuo.mutation.ClearList()
+	return stuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (stuo *ShareTokenUpdateOne) Select(field string, fields ...string) *ShareTokenUpdateOne {
+	stuo.fields = append([]string{field}, fields...)
+	return stuo
+}
+
+// Save executes the query and returns the updated ShareToken entity.
+func (stuo *ShareTokenUpdateOne) Save(ctx context.Context) (*ShareToken, error) {
+	var (
+		err  error
+		node *ShareToken
+	)
+	if len(stuo.hooks) == 0 {
+		if err = stuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = stuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = stuo.check(); err != nil {
+				return nil, err
+			}
+			stuo.mutation = mutation
+			node, err = stuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(stuo.hooks) - 1; i >= 0; i-- {
+			if stuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = stuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, stuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stuo *ShareTokenUpdateOne) SaveX(ctx context.Context) *ShareToken {
+	node, err := stuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (stuo *ShareTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := stuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stuo *ShareTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := stuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stuo *ShareTokenUpdateOne) check() error {
+	if _, ok := stuo.mutation.ListID(); stuo.mutation.ListCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"list\"")
+	}
+	return nil
+}
+
+func (stuo *ShareTokenUpdateOne) sqlSave(ctx context.Context) (_node *ShareToken, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sharetoken.Table,
+			Columns: sharetoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sharetoken.FieldID,
+			},
+		},
+	}
+	id, ok := stuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing ShareToken.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := stuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharetoken.FieldID)
+		for _, f := range fields {
+			if !sharetoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharetoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := stuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if stuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: sharetoken.FieldExpiresAt,
+		})
+	}
+	if value, ok := stuo.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sharetoken.FieldRevokedAt,
+		})
+	}
+	if stuo.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: sharetoken.FieldRevokedAt,
+		})
+	}
+	if stuo.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ListTable,
+			Columns: []string{sharetoken.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stuo.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharetoken.ListTable,
+			Columns: []string{sharetoken.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShareToken{config: stuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, stuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
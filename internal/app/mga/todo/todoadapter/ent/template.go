@@ -0,0 +1,158 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/template"
+)
+
+// Template is the model entity for the Template schema.
+type Template struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Items holds the value of the "items" field.
+	Items []todo.TemplateItem `json:"items,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Template) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case template.FieldItems:
+			values[i] = new([]byte)
+		case template.FieldID:
+			values[i] = new(sql.NullInt64)
+		case template.FieldUID, template.FieldName, template.FieldDescription:
+			values[i] = new(sql.NullString)
+		case template.FieldCreatedAt, template.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Template", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Template fields.
+func (t *Template) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case template.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int(value.Int64)
+		case template.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				t.UID = value.String
+			}
+		case template.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				t.Name = value.String
+			}
+		case template.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				t.Description = value.String
+			}
+		case template.FieldItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Items); err != nil {
+					return fmt.Errorf("unmarshal field items: %w", err)
+				}
+			}
+		case template.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		case template.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				t.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Template.
+// Note that you need to call Template.Unwrap() before calling this method if this Template
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Template) Update() *TemplateUpdateOne {
+	return (&TemplateClient{config: t.config}).UpdateOne(t)
+}
+
+// Unwrap unwraps the Template entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Template) Unwrap() *Template {
+	tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Template is not a transactional entity")
+	}
+	t.config.driver = tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Template) String() string {
+	var builder strings.Builder
+	builder.WriteString("Template(")
+	builder.WriteString(fmt.Sprintf("id=%v", t.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(t.UID)
+	builder.WriteString(", name=")
+	builder.WriteString(t.Name)
+	builder.WriteString(", description=")
+	builder.WriteString(t.Description)
+	builder.WriteString(", items=")
+	builder.WriteString(fmt.Sprintf("%v", t.Items))
+	builder.WriteString(", created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(t.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Templates is a parsable slice of Template.
+type Templates []*Template
+
+func (t Templates) config(cfg config) {
+	for _i := range t {
+		t[_i].config = cfg
+	}
+}
@@ -0,0 +1,177 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+)
+
+// TimeEntry is the model entity for the TimeEntry schema.
+type TimeEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// User holds the value of the "user" field.
+	User string `json:"user,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TimeEntryQuery when eager-loading is set.
+	Edges                  TimeEntryEdges `json:"edges"`
+	todo_item_time_entries *int
+}
+
+// TimeEntryEdges holds the relations/edges for other nodes in the graph.
+type TimeEntryEdges struct {
+	// Item holds the value of the item edge.
+	Item *TodoItem `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TimeEntryEdges) ItemOrErr() (*TodoItem, error) {
+	if e.loadedTypes[0] {
+		if e.Item == nil {
+			// The edge item was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todoitem.Label}
+		}
+		return e.Item, nil
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TimeEntry) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case timeentry.FieldID:
+			values[i] = new(sql.NullInt64)
+		case timeentry.FieldUID, timeentry.FieldUser:
+			values[i] = new(sql.NullString)
+		case timeentry.FieldStartedAt, timeentry.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		case timeentry.ForeignKeys[0]: // todo_item_time_entries
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type TimeEntry", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TimeEntry fields.
+func (te *TimeEntry) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case timeentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			te.ID = int(value.Int64)
+		case timeentry.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				te.UID = value.String
+			}
+		case timeentry.FieldUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user", values[i])
+			} else if value.Valid {
+				te.User = value.String
+			}
+		case timeentry.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				te.StartedAt = value.Time
+			}
+		case timeentry.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				te.EndedAt = new(time.Time)
+				*te.EndedAt = value.Time
+			}
+		case timeentry.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field todo_item_time_entries", value)
+			} else if value.Valid {
+				te.todo_item_time_entries = new(int)
+				*te.todo_item_time_entries = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryItem queries the "item" edge of the TimeEntry entity.
+func (te *TimeEntry) QueryItem() *TodoItemQuery {
+	return (&TimeEntryClient{config: te.config}).QueryItem(te)
+}
+
+// Update returns a builder for updating this TimeEntry.
+// Note that you need to call TimeEntry.Unwrap() before calling this method if this TimeEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (te *TimeEntry) Update() *TimeEntryUpdateOne {
+	return (&TimeEntryClient{config: te.config}).UpdateOne(te)
+}
+
+// Unwrap unwraps the TimeEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (te *TimeEntry) Unwrap() *TimeEntry {
+	tx, ok := te.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TimeEntry is not a transactional entity")
+	}
+	te.config.driver = tx.drv
+	return te
+}
+
+// String implements the fmt.Stringer.
+func (te *TimeEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("TimeEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v", te.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(te.UID)
+	builder.WriteString(", user=")
+	builder.WriteString(te.User)
+	builder.WriteString(", started_at=")
+	builder.WriteString(te.StartedAt.Format(time.ANSIC))
+	if v := te.EndedAt; v != nil {
+		builder.WriteString(", ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TimeEntries is a parsable slice of TimeEntry.
+type TimeEntries []*TimeEntry
+
+func (te TimeEntries) config(cfg config) {
+	for _i := range te {
+		te[_i].config = cfg
+	}
+}
@@ -0,0 +1,66 @@
+// Code generated by entc, DO NOT EDIT.
+
+package timeentry
+
+const (
+	// Label holds the string label denoting the timeentry type in the database.
+	Label = "time_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldUser holds the string denoting the user field in the database.
+	FieldUser = "user"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// Table holds the table name of the timeentry in the database.
+	Table = "time_entries"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "time_entries"
+	// ItemInverseTable is the table name for the TodoItem entity.
+	// It exists in this package in order to avoid circular dependency with the "todoitem" package.
+	ItemInverseTable = "todo_items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "todo_item_time_entries"
+)
+
+// Columns holds all SQL columns for timeentry fields.
+var Columns = []string{
+	FieldID,
+	FieldUID,
+	FieldUser,
+	FieldStartedAt,
+	FieldEndedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "time_entries"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"todo_item_time_entries",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	UIDValidator func(string) error
+	// UserValidator is a validator for the "user" field. It is called by the builders before save.
+	UserValidator func(string) error
+)
@@ -0,0 +1,322 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+)
+
+// TimeEntryCreate is the builder for creating a TimeEntry entity.
+type TimeEntryCreate struct {
+	config
+	mutation *TimeEntryMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (tec *TimeEntryCreate) SetUID(s string) *TimeEntryCreate {
+	tec.mutation.SetUID(s)
+	return tec
+}
+
+// SetUser sets the "user" field.
+func (tec *TimeEntryCreate) SetUser(s string) *TimeEntryCreate {
+	tec.mutation.SetUser(s)
+	return tec
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tec *TimeEntryCreate) SetStartedAt(t time.Time) *TimeEntryCreate {
+	tec.mutation.SetStartedAt(t)
+	return tec
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (tec *TimeEntryCreate) SetEndedAt(t time.Time) *TimeEntryCreate {
+	tec.mutation.SetEndedAt(t)
+	return tec
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (tec *TimeEntryCreate) SetNillableEndedAt(t *time.Time) *TimeEntryCreate {
+	if t != nil {
+		tec.SetEndedAt(*t)
+	}
+	return tec
+}
+
+// SetItemID sets the "item" edge to the TodoItem entity by ID.
+func (tec *TimeEntryCreate) SetItemID(id int) *TimeEntryCreate {
+	tec.mutation.SetItemID(id)
+	return tec
+}
+
+// SetItem sets the "item" edge to the TodoItem entity.
+func (tec *TimeEntryCreate) SetItem(t *TodoItem) *TimeEntryCreate {
+	return tec.SetItemID(t.ID)
+}
+
+// Mutation returns the TimeEntryMutation object of the builder.
+func (tec *TimeEntryCreate) Mutation() *TimeEntryMutation {
+	return tec.mutation
+}
+
+// Save creates the TimeEntry in the database.
+func (tec *TimeEntryCreate) Save(ctx context.Context) (*TimeEntry, error) {
+	var (
+		err  error
+		node *TimeEntry
+	)
+	if len(tec.hooks) == 0 {
+		if err = tec.check(); err != nil {
+			return nil, err
+		}
+		node, err = tec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TimeEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tec.check(); err != nil {
+				return nil, err
+			}
+			tec.mutation = mutation
+			if node, err = tec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tec.hooks) - 1; i >= 0; i-- {
+			if tec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tec *TimeEntryCreate) SaveX(ctx context.Context) *TimeEntry {
+	v, err := tec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tec *TimeEntryCreate) Exec(ctx context.Context) error {
+	_, err := tec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tec *TimeEntryCreate) ExecX(ctx context.Context) {
+	if err := tec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tec *TimeEntryCreate) check() error {
+	if _, ok := tec.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := tec.mutation.UID(); ok {
+		if err := timeentry.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required field "user"`)}
+	}
+	if v, ok := tec.mutation.User(); ok {
+		if err := timeentry.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "user": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "started_at"`)}
+	}
+	if _, ok := tec.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item", err: errors.New("ent: missing required edge \"item\"")}
+	}
+	return nil
+}
+
+func (tec *TimeEntryCreate) sqlSave(ctx context.Context) (*TimeEntry, error) {
+	_node, _spec := tec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (tec *TimeEntryCreate) createSpec() (*TimeEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TimeEntry{config: tec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: timeentry.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: timeentry.FieldID,
+			},
+		}
+	)
+	if value, ok := tec.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: timeentry.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := tec.mutation.User(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: timeentry.FieldUser,
+		})
+		_node.User = value
+	}
+	if value, ok := tec.mutation.StartedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: timeentry.FieldStartedAt,
+		})
+		_node.StartedAt = value
+	}
+	if value, ok := tec.mutation.EndedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: timeentry.FieldEndedAt,
+		})
+		_node.EndedAt = &value
+	}
+	if nodes := tec.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timeentry.ItemTable,
+			Columns: []string{timeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.todo_item_time_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TimeEntryCreateBulk is the builder for creating many TimeEntry entities in bulk.
+type TimeEntryCreateBulk struct {
+	config
+	builders []*TimeEntryCreate
+}
+
+// Save creates the TimeEntry entities in the database.
+func (tecb *TimeEntryCreateBulk) Save(ctx context.Context) ([]*TimeEntry, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(tecb.builders))
+	nodes := make([]*TimeEntry, len(tecb.builders))
+	mutators := make([]Mutator, len(tecb.builders))
+	for i := range tecb.builders {
+		func(i int, root context.Context) {
+			builder := tecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TimeEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tecb *TimeEntryCreateBulk) SaveX(ctx context.Context) []*TimeEntry {
+	v, err := tecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tecb *TimeEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := tecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tecb *TimeEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := tecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
+)
+
+// TimeEntryDelete is the builder for deleting a TimeEntry entity.
+type TimeEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *TimeEntryMutation
+}
+
+// Where appends a list predicates to the TimeEntryDelete builder.
+func (ted *TimeEntryDelete) Where(ps ...predicate.TimeEntry) *TimeEntryDelete {
+	ted.mutation.Where(ps...)
+	return ted
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ted *TimeEntryDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ted.hooks) == 0 {
+		affected, err = ted.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TimeEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ted.mutation = mutation
+			affected, err = ted.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ted.hooks) - 1; i >= 0; i-- {
+			if ted.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ted.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ted.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ted *TimeEntryDelete) ExecX(ctx context.Context) int {
+	n, err := ted.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ted *TimeEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: timeentry.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: timeentry.FieldID,
+			},
+		},
+	}
+	if ps := ted.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, ted.driver, _spec)
+}
+
+// TimeEntryDeleteOne is the builder for deleting a single TimeEntry entity.
+type TimeEntryDeleteOne struct {
+	ted *TimeEntryDelete
+}
+
+// Exec executes the deletion query.
+func (tedo *TimeEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := tedo.ted.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{timeentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tedo *TimeEntryDeleteOne) ExecX(ctx context.Context) {
+	tedo.ted.ExecX(ctx)
+}
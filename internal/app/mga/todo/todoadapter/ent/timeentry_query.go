@@ -0,0 +1,992 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+)
+
+// TimeEntryQuery is the builder for querying TimeEntry entities.
+type TimeEntryQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.TimeEntry
+	// eager-loading edges.
+	withItem *TodoItemQuery
+	withFKs  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TimeEntryQuery builder.
+func (teq *TimeEntryQuery) Where(ps ...predicate.TimeEntry) *TimeEntryQuery {
+	teq.predicates = append(teq.predicates, ps...)
+	return teq
+}
+
+// Limit adds a limit step to the query.
+func (teq *TimeEntryQuery) Limit(limit int) *TimeEntryQuery {
+	teq.limit = &limit
+	return teq
+}
+
+// Offset adds an offset step to the query.
+func (teq *TimeEntryQuery) Offset(offset int) *TimeEntryQuery {
+	teq.offset = &offset
+	return teq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (teq *TimeEntryQuery) Unique(unique bool) *TimeEntryQuery {
+	teq.unique = &unique
+	return teq
+}
+
+// Order adds an order step to the query.
+func (teq *TimeEntryQuery) Order(o ...OrderFunc) *TimeEntryQuery {
+	teq.order = append(teq.order, o...)
+	return teq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (teq *TimeEntryQuery) QueryItem() *TodoItemQuery {
+	query := &TodoItemQuery{config: teq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := teq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := teq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(timeentry.Table, timeentry.FieldID, selector),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, timeentry.ItemTable, timeentry.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(teq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TimeEntry entity from the query.
+// Returns a *NotFoundError when no TimeEntry was found.
+func (teq *TimeEntryQuery) First(ctx context.Context) (*TimeEntry, error) {
+	nodes, err := teq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{timeentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (teq *TimeEntryQuery) FirstX(ctx context.Context) *TimeEntry {
+	node, err := teq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TimeEntry ID from the query.
+// Returns a *NotFoundError when no TimeEntry ID was found.
+func (teq *TimeEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = teq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{timeentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (teq *TimeEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := teq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TimeEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one TimeEntry entity is not found.
+// Returns a *NotFoundError when no TimeEntry entities are found.
+func (teq *TimeEntryQuery) Only(ctx context.Context) (*TimeEntry, error) {
+	nodes, err := teq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{timeentry.Label}
+	default:
+		return nil, &NotSingularError{timeentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (teq *TimeEntryQuery) OnlyX(ctx context.Context) *TimeEntry {
+	node, err := teq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TimeEntry ID in the query.
+// Returns a *NotSingularError when exactly one TimeEntry ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (teq *TimeEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = teq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{timeentry.Label}
+	default:
+		err = &NotSingularError{timeentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (teq *TimeEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := teq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TimeEntries.
+func (teq *TimeEntryQuery) All(ctx context.Context) ([]*TimeEntry, error) {
+	if err := teq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return teq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (teq *TimeEntryQuery) AllX(ctx context.Context) []*TimeEntry {
+	nodes, err := teq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TimeEntry IDs.
+func (teq *TimeEntryQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := teq.Select(timeentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (teq *TimeEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := teq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (teq *TimeEntryQuery) Count(ctx context.Context) (int, error) {
+	if err := teq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return teq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (teq *TimeEntryQuery) CountX(ctx context.Context) int {
+	count, err := teq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (teq *TimeEntryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := teq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return teq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (teq *TimeEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := teq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TimeEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (teq *TimeEntryQuery) Clone() *TimeEntryQuery {
+	if teq == nil {
+		return nil
+	}
+	return &TimeEntryQuery{
+		config:     teq.config,
+		limit:      teq.limit,
+		offset:     teq.offset,
+		order:      append([]OrderFunc{}, teq.order...),
+		predicates: append([]predicate.TimeEntry{}, teq.predicates...),
+		withItem:   teq.withItem.Clone(),
+		// clone intermediate query.
+		sql:  teq.sql.Clone(),
+		path: teq.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (teq *TimeEntryQuery) WithItem(opts ...func(*TodoItemQuery)) *TimeEntryQuery {
+	query := &TodoItemQuery{config: teq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	teq.withItem = query
+	return teq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TimeEntry.Query().
+//		GroupBy(timeentry.FieldUID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (teq *TimeEntryQuery) GroupBy(field string, fields ...string) *TimeEntryGroupBy {
+	group := &TimeEntryGroupBy{config: teq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := teq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return teq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//	}
+//
+//	client.TimeEntry.Query().
+//		Select(timeentry.FieldUID).
+//		Scan(ctx, &v)
+func (teq *TimeEntryQuery) Select(fields ...string) *TimeEntrySelect {
+	teq.fields = append(teq.fields, fields...)
+	return &TimeEntrySelect{TimeEntryQuery: teq}
+}
+
+func (teq *TimeEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range teq.fields {
+		if !timeentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if teq.path != nil {
+		prev, err := teq.path(ctx)
+		if err != nil {
+			return err
+		}
+		teq.sql = prev
+	}
+	return nil
+}
+
+func (teq *TimeEntryQuery) sqlAll(ctx context.Context) ([]*TimeEntry, error) {
+	var (
+		nodes       = []*TimeEntry{}
+		withFKs     = teq.withFKs
+		_spec       = teq.querySpec()
+		loadedTypes = [1]bool{
+			teq.withItem != nil,
+		}
+	)
+	if teq.withItem != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, timeentry.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &TimeEntry{config: teq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, teq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := teq.withItem; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*TimeEntry)
+		for i := range nodes {
+			if nodes[i].todo_item_time_entries == nil {
+				continue
+			}
+			fk := *nodes[i].todo_item_time_entries
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(todoitem.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_item_time_entries" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Item = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (teq *TimeEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := teq.querySpec()
+	return sqlgraph.CountNodes(ctx, teq.driver, _spec)
+}
+
+func (teq *TimeEntryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := teq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (teq *TimeEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   timeentry.Table,
+			Columns: timeentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: timeentry.FieldID,
+			},
+		},
+		From:   teq.sql,
+		Unique: true,
+	}
+	if unique := teq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := teq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, timeentry.FieldID)
+		for i := range fields {
+			if fields[i] != timeentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := teq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := teq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := teq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := teq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (teq *TimeEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(teq.driver.Dialect())
+	t1 := builder.Table(timeentry.Table)
+	columns := teq.fields
+	if len(columns) == 0 {
+		columns = timeentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if teq.sql != nil {
+		selector = teq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range teq.predicates {
+		p(selector)
+	}
+	for _, p := range teq.order {
+		p(selector)
+	}
+	if offset := teq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := teq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TimeEntryGroupBy is the group-by builder for TimeEntry entities.
+type TimeEntryGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tegb *TimeEntryGroupBy) Aggregate(fns ...AggregateFunc) *TimeEntryGroupBy {
+	tegb.fns = append(tegb.fns, fns...)
+	return tegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (tegb *TimeEntryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := tegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	tegb.sql = query
+	return tegb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tegb *TimeEntryGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := tegb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TimeEntryGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(tegb.fields) > 1 {
+		return nil, errors.New("ent: TimeEntryGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := tegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tegb *TimeEntryGroupBy) StringsX(ctx context.Context) []string {
+	v, err := tegb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TimeEntryGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tegb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{timeentry.Label}
+	default:
+		err = fmt.Errorf("ent: TimeEntryGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tegb *TimeEntryGroupBy) StringX(ctx context.Context) string {
+	v, err := tegb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TimeEntryGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(tegb.fields) > 1 {
+		return nil, errors.New("ent: TimeEntryGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := tegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tegb *TimeEntryGroupBy) IntsX(ctx context.Context) []int {
+	v, err := tegb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TimeEntryGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tegb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{timeentry.Label}
+	default:
+		err = fmt.Errorf("ent: TimeEntryGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tegb *TimeEntryGroupBy) IntX(ctx context.Context) int {
+	v, err := tegb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TimeEntryGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tegb.fields) > 1 {
+		return nil, errors.New("ent: TimeEntryGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := tegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tegb *TimeEntryGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := tegb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TimeEntryGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tegb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{timeentry.Label}
+	default:
+		err = fmt.Errorf("ent: TimeEntryGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tegb *TimeEntryGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := tegb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TimeEntryGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(tegb.fields) > 1 {
+		return nil, errors.New("ent: TimeEntryGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := tegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tegb *TimeEntryGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := tegb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TimeEntryGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tegb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{timeentry.Label}
+	default:
+		err = fmt.Errorf("ent: TimeEntryGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tegb *TimeEntryGroupBy) BoolX(ctx context.Context) bool {
+	v, err := tegb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tegb *TimeEntryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range tegb.fields {
+		if !timeentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := tegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (tegb *TimeEntryGroupBy) sqlQuery() *sql.Selector {
+	selector := tegb.sql.Select()
+	aggregation := make([]string, 0, len(tegb.fns))
+	for _, fn := range tegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(tegb.fields)+len(tegb.fns))
+		for _, f := range tegb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(tegb.fields...)...)
+}
+
+// TimeEntrySelect is the builder for selecting fields of TimeEntry entities.
+type TimeEntrySelect struct {
+	*TimeEntryQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tes *TimeEntrySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := tes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	tes.sql = tes.TimeEntryQuery.sqlQuery(ctx)
+	return tes.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tes *TimeEntrySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := tes.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (tes *TimeEntrySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(tes.fields) > 1 {
+		return nil, errors.New("ent: TimeEntrySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := tes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tes *TimeEntrySelect) StringsX(ctx context.Context) []string {
+	v, err := tes.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (tes *TimeEntrySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tes.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{timeentry.Label}
+	default:
+		err = fmt.Errorf("ent: TimeEntrySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tes *TimeEntrySelect) StringX(ctx context.Context) string {
+	v, err := tes.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (tes *TimeEntrySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(tes.fields) > 1 {
+		return nil, errors.New("ent: TimeEntrySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := tes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tes *TimeEntrySelect) IntsX(ctx context.Context) []int {
+	v, err := tes.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (tes *TimeEntrySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tes.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{timeentry.Label}
+	default:
+		err = fmt.Errorf("ent: TimeEntrySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tes *TimeEntrySelect) IntX(ctx context.Context) int {
+	v, err := tes.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (tes *TimeEntrySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tes.fields) > 1 {
+		return nil, errors.New("ent: TimeEntrySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := tes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tes *TimeEntrySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := tes.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (tes *TimeEntrySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tes.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{timeentry.Label}
+	default:
+		err = fmt.Errorf("ent: TimeEntrySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tes *TimeEntrySelect) Float64X(ctx context.Context) float64 {
+	v, err := tes.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (tes *TimeEntrySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(tes.fields) > 1 {
+		return nil, errors.New("ent: TimeEntrySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := tes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tes *TimeEntrySelect) BoolsX(ctx context.Context) []bool {
+	v, err := tes.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (tes *TimeEntrySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tes.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{timeentry.Label}
+	default:
+		err = fmt.Errorf("ent: TimeEntrySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tes *TimeEntrySelect) BoolX(ctx context.Context) bool {
+	v, err := tes.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tes *TimeEntrySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := tes.sql.Query()
+	if err := tes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
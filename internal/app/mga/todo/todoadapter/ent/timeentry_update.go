@@ -0,0 +1,439 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+)
+
+// TimeEntryUpdate is the builder for updating TimeEntry entities.
+type TimeEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TimeEntryMutation
+}
+
+// Where appends a list predicates to the TimeEntryUpdate builder.
+func (teu *TimeEntryUpdate) Where(ps ...predicate.TimeEntry) *TimeEntryUpdate {
+	teu.mutation.Where(ps...)
+	return teu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (teu *TimeEntryUpdate) SetEndedAt(t time.Time) *TimeEntryUpdate {
+	teu.mutation.SetEndedAt(t)
+	return teu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (teu *TimeEntryUpdate) SetNillableEndedAt(t *time.Time) *TimeEntryUpdate {
+	if t != nil {
+		teu.SetEndedAt(*t)
+	}
+	return teu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (teu *TimeEntryUpdate) ClearEndedAt() *TimeEntryUpdate {
+	teu.mutation.ClearEndedAt()
+	return teu
+}
+
+// SetItemID sets the "item" edge to the TodoItem entity by ID.
+func (teu *TimeEntryUpdate) SetItemID(id int) *TimeEntryUpdate {
+	teu.mutation.SetItemID(id)
+	return teu
+}
+
+// SetItem sets the "item" edge to the TodoItem entity.
+func (teu *TimeEntryUpdate) SetItem(t *TodoItem) *TimeEntryUpdate {
+	return teu.SetItemID(t.ID)
+}
+
+// Mutation returns the TimeEntryMutation object of the builder.
+func (teu *TimeEntryUpdate) Mutation() *TimeEntryMutation {
+	return teu.mutation
+}
+
+// ClearItem clears the "item" edge to the TodoItem entity.
+func (teu *TimeEntryUpdate) ClearItem() *TimeEntryUpdate {
+	teu.mutation.ClearItem()
+	return teu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (teu *TimeEntryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(teu.hooks) == 0 {
+		if err = teu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = teu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TimeEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = teu.check(); err != nil {
+				return 0, err
+			}
+			teu.mutation = mutation
+			affected, err = teu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(teu.hooks) - 1; i >= 0; i-- {
+			if teu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = teu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, teu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teu *TimeEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := teu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (teu *TimeEntryUpdate) Exec(ctx context.Context) error {
+	_, err := teu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teu *TimeEntryUpdate) ExecX(ctx context.Context) {
+	if err := teu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teu *TimeEntryUpdate) check() error {
+	if _, ok := teu.mutation.ItemID(); teu.mutation.ItemCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"item\"")
+	}
+	return nil
+}
+
+func (teu *TimeEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   timeentry.Table,
+			Columns: timeentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: timeentry.FieldID,
+			},
+		},
+	}
+	if ps := teu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teu.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: timeentry.FieldEndedAt,
+		})
+	}
+	if teu.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: timeentry.FieldEndedAt,
+		})
+	}
+	if teu.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timeentry.ItemTable,
+			Columns: []string{timeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := teu.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timeentry.ItemTable,
+			Columns: []string{timeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, teu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timeentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TimeEntryUpdateOne is the builder for updating a single TimeEntry entity.
+type TimeEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TimeEntryMutation
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (teuo *TimeEntryUpdateOne) SetEndedAt(t time.Time) *TimeEntryUpdateOne {
+	teuo.mutation.SetEndedAt(t)
+	return teuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (teuo *TimeEntryUpdateOne) SetNillableEndedAt(t *time.Time) *TimeEntryUpdateOne {
+	if t != nil {
+		teuo.SetEndedAt(*t)
+	}
+	return teuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (teuo *TimeEntryUpdateOne) ClearEndedAt() *TimeEntryUpdateOne {
+	teuo.mutation.ClearEndedAt()
+	return teuo
+}
+
+// SetItemID sets the "item" edge to the TodoItem entity by ID.
+func (teuo *TimeEntryUpdateOne) SetItemID(id int) *TimeEntryUpdateOne {
+	teuo.mutation.SetItemID(id)
+	return teuo
+}
+
+// SetItem sets the "item" edge to the TodoItem entity.
+func (teuo *TimeEntryUpdateOne) SetItem(t *TodoItem) *TimeEntryUpdateOne {
+	return teuo.SetItemID(t.ID)
+}
+
+// Mutation returns the TimeEntryMutation object of the builder.
+func (teuo *TimeEntryUpdateOne) Mutation() *TimeEntryMutation {
+	return teuo.mutation
+}
+
+// ClearItem clears the "item" edge to the TodoItem entity.
+func (teuo *TimeEntryUpdateOne) ClearItem() *TimeEntryUpdateOne {
+	teuo.mutation.ClearItem()
+	return teuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (teuo *TimeEntryUpdateOne) Select(field string, fields ...string) *TimeEntryUpdateOne {
+	teuo.fields = append([]string{field}, fields...)
+	return teuo
+}
+
+// Save executes the query and returns the updated TimeEntry entity.
+func (teuo *TimeEntryUpdateOne) Save(ctx context.Context) (*TimeEntry, error) {
+	var (
+		err  error
+		node *TimeEntry
+	)
+	if len(teuo.hooks) == 0 {
+		if err = teuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = teuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TimeEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = teuo.check(); err != nil {
+				return nil, err
+			}
+			teuo.mutation = mutation
+			node, err = teuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(teuo.hooks) - 1; i >= 0; i-- {
+			if teuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = teuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, teuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teuo *TimeEntryUpdateOne) SaveX(ctx context.Context) *TimeEntry {
+	node, err := teuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (teuo *TimeEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := teuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teuo *TimeEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := teuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teuo *TimeEntryUpdateOne) check() error {
+	if _, ok := teuo.mutation.ItemID(); teuo.mutation.ItemCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"item\"")
+	}
+	return nil
+}
+
+func (teuo *TimeEntryUpdateOne) sqlSave(ctx context.Context) (_node *TimeEntry, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   timeentry.Table,
+			Columns: timeentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: timeentry.FieldID,
+			},
+		},
+	}
+	id, ok := teuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing TimeEntry.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := teuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, timeentry.FieldID)
+		for _, f := range fields {
+			if !timeentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != timeentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := teuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teuo.mutation.EndedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: timeentry.FieldEndedAt,
+		})
+	}
+	if teuo.mutation.EndedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: timeentry.FieldEndedAt,
+		})
+	}
+	if teuo.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timeentry.ItemTable,
+			Columns: []string{timeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := teuo.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timeentry.ItemTable,
+			Columns: []string{timeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TimeEntry{config: teuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, teuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timeentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
 )
 
 // TodoItem is the model entity for the TodoItem schema.
@@ -20,14 +21,141 @@ type TodoItem struct {
 	UID string `json:"uid,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// Assignee holds the value of the "assignee" field.
+	Assignee string `json:"assignee,omitempty"`
 	// Completed holds the value of the "completed" field.
 	Completed bool `json:"completed,omitempty"`
 	// Order holds the value of the "order" field.
 	Order int `json:"order,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// DueSoonNotified holds the value of the "due_soon_notified" field.
+	DueSoonNotified bool `json:"due_soon_notified,omitempty"`
+	// OverdueNotified holds the value of the "overdue_notified" field.
+	OverdueNotified bool `json:"overdue_notified,omitempty"`
+	// RecurrenceRule holds the value of the "recurrence_rule" field.
+	RecurrenceRule *string `json:"recurrence_rule,omitempty"`
+	// RecurrenceTimezone holds the value of the "recurrence_timezone" field.
+	RecurrenceTimezone *string `json:"recurrence_timezone,omitempty"`
+	// RecurrenceStart holds the value of the "recurrence_start" field.
+	RecurrenceStart *time.Time `json:"recurrence_start,omitempty"`
+	// RecurrenceOccurrence holds the value of the "recurrence_occurrence" field.
+	RecurrenceOccurrence *time.Time `json:"recurrence_occurrence,omitempty"`
+	// SeriesID holds the value of the "series_id" field.
+	SeriesID *string `json:"series_id,omitempty"`
+	// AutoComplete holds the value of the "auto_complete" field.
+	AutoComplete bool `json:"auto_complete,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank string `json:"rank,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoItemQuery when eager-loading is set.
+	Edges              TodoItemEdges `json:"edges"`
+	todo_item_children *int
+	todo_list_items    *int
+}
+
+// TodoItemEdges holds the relations/edges for other nodes in the graph.
+type TodoItemEdges struct {
+	// List holds the value of the list edge.
+	List *TodoList `json:"list,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *TodoItem `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*TodoItem `json:"children,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// TimeEntries holds the value of the time_entries edge.
+	TimeEntries []*TimeEntry `json:"time_entries,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+}
+
+// ListOrErr returns the List value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoItemEdges) ListOrErr() (*TodoList, error) {
+	if e.loadedTypes[0] {
+		if e.List == nil {
+			// The edge list was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todolist.Label}
+		}
+		return e.List, nil
+	}
+	return nil, &NotLoadedError{edge: "list"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoItemEdges) ParentOrErr() (*TodoItem, error) {
+	if e.loadedTypes[1] {
+		if e.Parent == nil {
+			// The edge parent was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todoitem.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoItemEdges) ChildrenOrErr() ([]*TodoItem, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoItemEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoItemEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[4] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// TimeEntriesOrErr returns the TimeEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoItemEdges) TimeEntriesOrErr() ([]*TimeEntry, error) {
+	if e.loadedTypes[5] {
+		return e.TimeEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "time_entries"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoItemEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[6] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -35,14 +163,18 @@ func (*TodoItem) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case todoitem.FieldCompleted:
+		case todoitem.FieldCompleted, todoitem.FieldDueSoonNotified, todoitem.FieldOverdueNotified, todoitem.FieldAutoComplete:
 			values[i] = new(sql.NullBool)
-		case todoitem.FieldID, todoitem.FieldOrder:
+		case todoitem.FieldID, todoitem.FieldOrder, todoitem.FieldPriority:
 			values[i] = new(sql.NullInt64)
-		case todoitem.FieldUID, todoitem.FieldTitle:
+		case todoitem.FieldUID, todoitem.FieldTitle, todoitem.FieldNotes, todoitem.FieldAssignee, todoitem.FieldRecurrenceRule, todoitem.FieldRecurrenceTimezone, todoitem.FieldSeriesID, todoitem.FieldRank:
 			values[i] = new(sql.NullString)
-		case todoitem.FieldCreatedAt, todoitem.FieldUpdatedAt:
+		case todoitem.FieldDueAt, todoitem.FieldRecurrenceStart, todoitem.FieldRecurrenceOccurrence, todoitem.FieldCreatedAt, todoitem.FieldUpdatedAt, todoitem.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
+		case todoitem.ForeignKeys[0]: // todo_item_children
+			values[i] = new(sql.NullInt64)
+		case todoitem.ForeignKeys[1]: // todo_list_items
+			values[i] = new(sql.NullInt64)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type TodoItem", columns[i])
 		}
@@ -76,6 +208,18 @@ func (ti *TodoItem) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				ti.Title = value.String
 			}
+		case todoitem.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				ti.Notes = value.String
+			}
+		case todoitem.FieldAssignee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee", values[i])
+			} else if value.Valid {
+				ti.Assignee = value.String
+			}
 		case todoitem.FieldCompleted:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field completed", values[i])
@@ -88,6 +232,78 @@ func (ti *TodoItem) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				ti.Order = int(value.Int64)
 			}
+		case todoitem.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				ti.DueAt = new(time.Time)
+				*ti.DueAt = value.Time
+			}
+		case todoitem.FieldDueSoonNotified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field due_soon_notified", values[i])
+			} else if value.Valid {
+				ti.DueSoonNotified = value.Bool
+			}
+		case todoitem.FieldOverdueNotified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field overdue_notified", values[i])
+			} else if value.Valid {
+				ti.OverdueNotified = value.Bool
+			}
+		case todoitem.FieldRecurrenceRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_rule", values[i])
+			} else if value.Valid {
+				ti.RecurrenceRule = new(string)
+				*ti.RecurrenceRule = value.String
+			}
+		case todoitem.FieldRecurrenceTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_timezone", values[i])
+			} else if value.Valid {
+				ti.RecurrenceTimezone = new(string)
+				*ti.RecurrenceTimezone = value.String
+			}
+		case todoitem.FieldRecurrenceStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_start", values[i])
+			} else if value.Valid {
+				ti.RecurrenceStart = new(time.Time)
+				*ti.RecurrenceStart = value.Time
+			}
+		case todoitem.FieldRecurrenceOccurrence:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_occurrence", values[i])
+			} else if value.Valid {
+				ti.RecurrenceOccurrence = new(time.Time)
+				*ti.RecurrenceOccurrence = value.Time
+			}
+		case todoitem.FieldSeriesID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value.Valid {
+				ti.SeriesID = new(string)
+				*ti.SeriesID = value.String
+			}
+		case todoitem.FieldAutoComplete:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_complete", values[i])
+			} else if value.Valid {
+				ti.AutoComplete = value.Bool
+			}
+		case todoitem.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				ti.Priority = int(value.Int64)
+			}
+		case todoitem.FieldRank:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				ti.Rank = value.String
+			}
 		case todoitem.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -100,11 +316,67 @@ func (ti *TodoItem) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				ti.UpdatedAt = value.Time
 			}
+		case todoitem.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ti.DeletedAt = new(time.Time)
+				*ti.DeletedAt = value.Time
+			}
+		case todoitem.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field todo_item_children", value)
+			} else if value.Valid {
+				ti.todo_item_children = new(int)
+				*ti.todo_item_children = int(value.Int64)
+			}
+		case todoitem.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field todo_list_items", value)
+			} else if value.Valid {
+				ti.todo_list_items = new(int)
+				*ti.todo_list_items = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// QueryList queries the "list" edge of the TodoItem entity.
+func (ti *TodoItem) QueryList() *TodoListQuery {
+	return (&TodoItemClient{config: ti.config}).QueryList(ti)
+}
+
+// QueryParent queries the "parent" edge of the TodoItem entity.
+func (ti *TodoItem) QueryParent() *TodoItemQuery {
+	return (&TodoItemClient{config: ti.config}).QueryParent(ti)
+}
+
+// QueryChildren queries the "children" edge of the TodoItem entity.
+func (ti *TodoItem) QueryChildren() *TodoItemQuery {
+	return (&TodoItemClient{config: ti.config}).QueryChildren(ti)
+}
+
+// QueryTags queries the "tags" edge of the TodoItem entity.
+func (ti *TodoItem) QueryTags() *TagQuery {
+	return (&TodoItemClient{config: ti.config}).QueryTags(ti)
+}
+
+// QueryComments queries the "comments" edge of the TodoItem entity.
+func (ti *TodoItem) QueryComments() *CommentQuery {
+	return (&TodoItemClient{config: ti.config}).QueryComments(ti)
+}
+
+// QueryTimeEntries queries the "time_entries" edge of the TodoItem entity.
+func (ti *TodoItem) QueryTimeEntries() *TimeEntryQuery {
+	return (&TodoItemClient{config: ti.config}).QueryTimeEntries(ti)
+}
+
+// QueryAttachments queries the "attachments" edge of the TodoItem entity.
+func (ti *TodoItem) QueryAttachments() *AttachmentQuery {
+	return (&TodoItemClient{config: ti.config}).QueryAttachments(ti)
+}
+
 // Update returns a builder for updating this TodoItem.
 // Note that you need to call TodoItem.Unwrap() before calling this method if this TodoItem
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -132,14 +404,56 @@ func (ti *TodoItem) String() string {
 	builder.WriteString(ti.UID)
 	builder.WriteString(", title=")
 	builder.WriteString(ti.Title)
+	builder.WriteString(", notes=")
+	builder.WriteString(ti.Notes)
+	builder.WriteString(", assignee=")
+	builder.WriteString(ti.Assignee)
 	builder.WriteString(", completed=")
 	builder.WriteString(fmt.Sprintf("%v", ti.Completed))
 	builder.WriteString(", order=")
 	builder.WriteString(fmt.Sprintf("%v", ti.Order))
+	if v := ti.DueAt; v != nil {
+		builder.WriteString(", due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", due_soon_notified=")
+	builder.WriteString(fmt.Sprintf("%v", ti.DueSoonNotified))
+	builder.WriteString(", overdue_notified=")
+	builder.WriteString(fmt.Sprintf("%v", ti.OverdueNotified))
+	if v := ti.RecurrenceRule; v != nil {
+		builder.WriteString(", recurrence_rule=")
+		builder.WriteString(*v)
+	}
+	if v := ti.RecurrenceTimezone; v != nil {
+		builder.WriteString(", recurrence_timezone=")
+		builder.WriteString(*v)
+	}
+	if v := ti.RecurrenceStart; v != nil {
+		builder.WriteString(", recurrence_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := ti.RecurrenceOccurrence; v != nil {
+		builder.WriteString(", recurrence_occurrence=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := ti.SeriesID; v != nil {
+		builder.WriteString(", series_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", auto_complete=")
+	builder.WriteString(fmt.Sprintf("%v", ti.AutoComplete))
+	builder.WriteString(", priority=")
+	builder.WriteString(fmt.Sprintf("%v", ti.Priority))
+	builder.WriteString(", rank=")
+	builder.WriteString(ti.Rank)
 	builder.WriteString(", created_at=")
 	builder.WriteString(ti.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", updated_at=")
 	builder.WriteString(ti.UpdatedAt.Format(time.ANSIC))
+	if v := ti.DeletedAt; v != nil {
+		builder.WriteString(", deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -15,16 +15,99 @@ const (
 	FieldUID = "uid"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldAssignee holds the string denoting the assignee field in the database.
+	FieldAssignee = "assignee"
 	// FieldCompleted holds the string denoting the completed field in the database.
 	FieldCompleted = "completed"
 	// FieldOrder holds the string denoting the order field in the database.
 	FieldOrder = "order"
+	// FieldDueAt holds the string denoting the due_at field in the database.
+	FieldDueAt = "due_at"
+	// FieldDueSoonNotified holds the string denoting the due_soon_notified field in the database.
+	FieldDueSoonNotified = "due_soon_notified"
+	// FieldOverdueNotified holds the string denoting the overdue_notified field in the database.
+	FieldOverdueNotified = "overdue_notified"
+	// FieldRecurrenceRule holds the string denoting the recurrence_rule field in the database.
+	FieldRecurrenceRule = "recurrence_rule"
+	// FieldRecurrenceTimezone holds the string denoting the recurrence_timezone field in the database.
+	FieldRecurrenceTimezone = "recurrence_timezone"
+	// FieldRecurrenceStart holds the string denoting the recurrence_start field in the database.
+	FieldRecurrenceStart = "recurrence_start"
+	// FieldRecurrenceOccurrence holds the string denoting the recurrence_occurrence field in the database.
+	FieldRecurrenceOccurrence = "recurrence_occurrence"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
+	// FieldAutoComplete holds the string denoting the auto_complete field in the database.
+	FieldAutoComplete = "auto_complete"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldRank holds the string denoting the rank field in the database.
+	FieldRank = "rank"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// EdgeList holds the string denoting the list edge name in mutations.
+	EdgeList = "list"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeTimeEntries holds the string denoting the time_entries edge name in mutations.
+	EdgeTimeEntries = "time_entries"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
 	// Table holds the table name of the todoitem in the database.
 	Table = "todo_items"
+	// ListTable is the table that holds the list relation/edge.
+	ListTable = "todo_items"
+	// ListInverseTable is the table name for the TodoList entity.
+	// It exists in this package in order to avoid circular dependency with the "todolist" package.
+	ListInverseTable = "todo_lists"
+	// ListColumn is the table column denoting the list relation/edge.
+	ListColumn = "todo_list_items"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "todo_items"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "todo_item_children"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "todo_items"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "todo_item_children"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_items"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "todo_item_comments"
+	// TimeEntriesTable is the table that holds the time_entries relation/edge.
+	TimeEntriesTable = "time_entries"
+	// TimeEntriesInverseTable is the table name for the TimeEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "timeentry" package.
+	TimeEntriesInverseTable = "time_entries"
+	// TimeEntriesColumn is the table column denoting the time_entries relation/edge.
+	TimeEntriesColumn = "todo_item_time_entries"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "todo_item_attachments"
 )
 
 // Columns holds all SQL columns for todoitem fields.
@@ -32,12 +115,39 @@ var Columns = []string{
 	FieldID,
 	FieldUID,
 	FieldTitle,
+	FieldNotes,
+	FieldAssignee,
 	FieldCompleted,
 	FieldOrder,
+	FieldDueAt,
+	FieldDueSoonNotified,
+	FieldOverdueNotified,
+	FieldRecurrenceRule,
+	FieldRecurrenceTimezone,
+	FieldRecurrenceStart,
+	FieldRecurrenceOccurrence,
+	FieldSeriesID,
+	FieldAutoComplete,
+	FieldPriority,
+	FieldRank,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the "todo_items"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"todo_item_children",
+	"todo_list_items",
+}
+
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "todo_item_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -45,12 +155,33 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
 var (
 	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
 	UIDValidator func(string) error
+	// DefaultNotes holds the default value on creation for the "notes" field.
+	DefaultNotes string
+	// DefaultAssignee holds the default value on creation for the "assignee" field.
+	DefaultAssignee string
+	// DefaultDueSoonNotified holds the default value on creation for the "due_soon_notified" field.
+	DefaultDueSoonNotified bool
+	// DefaultOverdueNotified holds the default value on creation for the "overdue_notified" field.
+	DefaultOverdueNotified bool
+	// SeriesIDValidator is a validator for the "series_id" field. It is called by the builders before save.
+	SeriesIDValidator func(string) error
+	// DefaultAutoComplete holds the default value on creation for the "auto_complete" field.
+	DefaultAutoComplete bool
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultRank holds the default value on creation for the "rank" field.
+	DefaultRank string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
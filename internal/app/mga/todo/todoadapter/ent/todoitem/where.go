@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
 )
 
@@ -106,6 +107,20 @@ func Title(v string) predicate.TodoItem {
 	})
 }
 
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
+func Assignee(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAssignee), v))
+	})
+}
+
 // Completed applies equality check predicate on the "completed" field. It's identical to CompletedEQ.
 func Completed(v bool) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
@@ -120,6 +135,83 @@ func Order(v int) predicate.TodoItem {
 	})
 }
 
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDueAt), v))
+	})
+}
+
+// DueSoonNotified applies equality check predicate on the "due_soon_notified" field. It's identical to DueSoonNotifiedEQ.
+func DueSoonNotified(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDueSoonNotified), v))
+	})
+}
+
+// OverdueNotified applies equality check predicate on the "overdue_notified" field. It's identical to OverdueNotifiedEQ.
+func OverdueNotified(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOverdueNotified), v))
+	})
+}
+
+// RecurrenceRule applies equality check predicate on the "recurrence_rule" field. It's identical to RecurrenceRuleEQ.
+func RecurrenceRule(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceTimezone applies equality check predicate on the "recurrence_timezone" field. It's identical to RecurrenceTimezoneEQ.
+func RecurrenceTimezone(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceStart applies equality check predicate on the "recurrence_start" field. It's identical to RecurrenceStartEQ.
+func RecurrenceStart(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecurrenceStart), v))
+	})
+}
+
+// RecurrenceOccurrence applies equality check predicate on the "recurrence_occurrence" field. It's identical to RecurrenceOccurrenceEQ.
+func RecurrenceOccurrence(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecurrenceOccurrence), v))
+	})
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeriesID), v))
+	})
+}
+
+// AutoComplete applies equality check predicate on the "auto_complete" field. It's identical to AutoCompleteEQ.
+func AutoComplete(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAutoComplete), v))
+	})
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriority), v))
+	})
+}
+
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRank), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
@@ -134,6 +226,13 @@ func UpdatedAt(v time.Time) predicate.TodoItem {
 	})
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
 // UIDEQ applies the EQ predicate on the "uid" field.
 func UIDEQ(v string) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
@@ -356,36 +455,133 @@ func TitleContainsFold(v string) predicate.TodoItem {
 	})
 }
 
-// CompletedEQ applies the EQ predicate on the "completed" field.
-func CompletedEQ(v bool) predicate.TodoItem {
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldCompleted), v))
+		s.Where(sql.EQ(s.C(FieldNotes), v))
 	})
 }
 
-// CompletedNEQ applies the NEQ predicate on the "completed" field.
-func CompletedNEQ(v bool) predicate.TodoItem {
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldCompleted), v))
+		s.Where(sql.NEQ(s.C(FieldNotes), v))
 	})
 }
 
-// OrderEQ applies the EQ predicate on the "order" field.
-func OrderEQ(v int) predicate.TodoItem {
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldOrder), v))
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNotes), v...))
 	})
 }
 
-// OrderNEQ applies the NEQ predicate on the "order" field.
-func OrderNEQ(v int) predicate.TodoItem {
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldOrder), v))
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNotes), v...))
 	})
 }
 
-// OrderIn applies the In predicate on the "order" field.
-func OrderIn(vs ...int) predicate.TodoItem {
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNotes), v))
+	})
+}
+
+// AssigneeEQ applies the EQ predicate on the "assignee" field.
+func AssigneeEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAssignee), v))
+	})
+}
+
+// AssigneeNEQ applies the NEQ predicate on the "assignee" field.
+func AssigneeNEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAssignee), v))
+	})
+}
+
+// AssigneeIn applies the In predicate on the "assignee" field.
+func AssigneeIn(vs ...string) predicate.TodoItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -397,12 +593,12 @@ func OrderIn(vs ...int) predicate.TodoItem {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.In(s.C(FieldOrder), v...))
+		s.Where(sql.In(s.C(FieldAssignee), v...))
 	})
 }
 
-// OrderNotIn applies the NotIn predicate on the "order" field.
-func OrderNotIn(vs ...int) predicate.TodoItem {
+// AssigneeNotIn applies the NotIn predicate on the "assignee" field.
+func AssigneeNotIn(vs ...string) predicate.TodoItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -414,54 +610,103 @@ func OrderNotIn(vs ...int) predicate.TodoItem {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.NotIn(s.C(FieldOrder), v...))
+		s.Where(sql.NotIn(s.C(FieldAssignee), v...))
 	})
 }
 
-// OrderGT applies the GT predicate on the "order" field.
-func OrderGT(v int) predicate.TodoItem {
+// AssigneeGT applies the GT predicate on the "assignee" field.
+func AssigneeGT(v string) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldOrder), v))
+		s.Where(sql.GT(s.C(FieldAssignee), v))
 	})
 }
 
-// OrderGTE applies the GTE predicate on the "order" field.
-func OrderGTE(v int) predicate.TodoItem {
+// AssigneeGTE applies the GTE predicate on the "assignee" field.
+func AssigneeGTE(v string) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldOrder), v))
+		s.Where(sql.GTE(s.C(FieldAssignee), v))
 	})
 }
 
-// OrderLT applies the LT predicate on the "order" field.
-func OrderLT(v int) predicate.TodoItem {
+// AssigneeLT applies the LT predicate on the "assignee" field.
+func AssigneeLT(v string) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldOrder), v))
+		s.Where(sql.LT(s.C(FieldAssignee), v))
 	})
 }
 
-// OrderLTE applies the LTE predicate on the "order" field.
-func OrderLTE(v int) predicate.TodoItem {
+// AssigneeLTE applies the LTE predicate on the "assignee" field.
+func AssigneeLTE(v string) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldOrder), v))
+		s.Where(sql.LTE(s.C(FieldAssignee), v))
 	})
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.TodoItem {
+// AssigneeContains applies the Contains predicate on the "assignee" field.
+func AssigneeContains(v string) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+		s.Where(sql.Contains(s.C(FieldAssignee), v))
 	})
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.TodoItem {
+// AssigneeHasPrefix applies the HasPrefix predicate on the "assignee" field.
+func AssigneeHasPrefix(v string) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+		s.Where(sql.HasPrefix(s.C(FieldAssignee), v))
 	})
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.TodoItem {
+// AssigneeHasSuffix applies the HasSuffix predicate on the "assignee" field.
+func AssigneeHasSuffix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAssignee), v))
+	})
+}
+
+// AssigneeEqualFold applies the EqualFold predicate on the "assignee" field.
+func AssigneeEqualFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAssignee), v))
+	})
+}
+
+// AssigneeContainsFold applies the ContainsFold predicate on the "assignee" field.
+func AssigneeContainsFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAssignee), v))
+	})
+}
+
+// CompletedEQ applies the EQ predicate on the "completed" field.
+func CompletedEQ(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCompleted), v))
+	})
+}
+
+// CompletedNEQ applies the NEQ predicate on the "completed" field.
+func CompletedNEQ(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCompleted), v))
+	})
+}
+
+// OrderEQ applies the EQ predicate on the "order" field.
+func OrderEQ(v int) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOrder), v))
+	})
+}
+
+// OrderNEQ applies the NEQ predicate on the "order" field.
+func OrderNEQ(v int) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOrder), v))
+	})
+}
+
+// OrderIn applies the In predicate on the "order" field.
+func OrderIn(vs ...int) predicate.TodoItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -473,12 +718,12 @@ func CreatedAtIn(vs ...time.Time) predicate.TodoItem {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+		s.Where(sql.In(s.C(FieldOrder), v...))
 	})
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.TodoItem {
+// OrderNotIn applies the NotIn predicate on the "order" field.
+func OrderNotIn(vs ...int) predicate.TodoItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -490,54 +735,54 @@ func CreatedAtNotIn(vs ...time.Time) predicate.TodoItem {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+		s.Where(sql.NotIn(s.C(FieldOrder), v...))
 	})
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.TodoItem {
+// OrderGT applies the GT predicate on the "order" field.
+func OrderGT(v int) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+		s.Where(sql.GT(s.C(FieldOrder), v))
 	})
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.TodoItem {
+// OrderGTE applies the GTE predicate on the "order" field.
+func OrderGTE(v int) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+		s.Where(sql.GTE(s.C(FieldOrder), v))
 	})
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.TodoItem {
+// OrderLT applies the LT predicate on the "order" field.
+func OrderLT(v int) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+		s.Where(sql.LT(s.C(FieldOrder), v))
 	})
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.TodoItem {
+// OrderLTE applies the LTE predicate on the "order" field.
+func OrderLTE(v int) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+		s.Where(sql.LTE(s.C(FieldOrder), v))
 	})
 }
 
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.TodoItem {
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+		s.Where(sql.EQ(s.C(FieldDueAt), v))
 	})
 }
 
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.TodoItem {
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+		s.Where(sql.NEQ(s.C(FieldDueAt), v))
 	})
 }
 
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.TodoItem {
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.TodoItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -549,12 +794,12 @@ func UpdatedAtIn(vs ...time.Time) predicate.TodoItem {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+		s.Where(sql.In(s.C(FieldDueAt), v...))
 	})
 }
 
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.TodoItem {
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.TodoItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -566,35 +811,1271 @@ func UpdatedAtNotIn(vs ...time.Time) predicate.TodoItem {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+		s.Where(sql.NotIn(s.C(FieldDueAt), v...))
 	})
 }
 
-// UpdatedAtGT applies the GT predicate on the "updated_at" field.
-func UpdatedAtGT(v time.Time) predicate.TodoItem {
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+		s.Where(sql.GT(s.C(FieldDueAt), v))
 	})
 }
 
-// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
-func UpdatedAtGTE(v time.Time) predicate.TodoItem {
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+		s.Where(sql.GTE(s.C(FieldDueAt), v))
 	})
 }
 
-// UpdatedAtLT applies the LT predicate on the "updated_at" field.
-func UpdatedAtLT(v time.Time) predicate.TodoItem {
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+		s.Where(sql.LT(s.C(FieldDueAt), v))
 	})
 }
 
-// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
-func UpdatedAtLTE(v time.Time) predicate.TodoItem {
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.TodoItem {
 	return predicate.TodoItem(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+		s.Where(sql.LTE(s.C(FieldDueAt), v))
+	})
+}
+
+// DueAtIsNil applies the IsNil predicate on the "due_at" field.
+func DueAtIsNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDueAt)))
+	})
+}
+
+// DueAtNotNil applies the NotNil predicate on the "due_at" field.
+func DueAtNotNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDueAt)))
+	})
+}
+
+// DueSoonNotifiedEQ applies the EQ predicate on the "due_soon_notified" field.
+func DueSoonNotifiedEQ(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDueSoonNotified), v))
+	})
+}
+
+// DueSoonNotifiedNEQ applies the NEQ predicate on the "due_soon_notified" field.
+func DueSoonNotifiedNEQ(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDueSoonNotified), v))
+	})
+}
+
+// OverdueNotifiedEQ applies the EQ predicate on the "overdue_notified" field.
+func OverdueNotifiedEQ(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOverdueNotified), v))
+	})
+}
+
+// OverdueNotifiedNEQ applies the NEQ predicate on the "overdue_notified" field.
+func OverdueNotifiedNEQ(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOverdueNotified), v))
+	})
+}
+
+// RecurrenceRuleEQ applies the EQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleNEQ applies the NEQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleNEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleIn applies the In predicate on the "recurrence_rule" field.
+func RecurrenceRuleIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRecurrenceRule), v...))
+	})
+}
+
+// RecurrenceRuleNotIn applies the NotIn predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRecurrenceRule), v...))
+	})
+}
+
+// RecurrenceRuleGT applies the GT predicate on the "recurrence_rule" field.
+func RecurrenceRuleGT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleGTE applies the GTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleGTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleLT applies the LT predicate on the "recurrence_rule" field.
+func RecurrenceRuleLT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleLTE applies the LTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleLTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleContains applies the Contains predicate on the "recurrence_rule" field.
+func RecurrenceRuleContains(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleHasPrefix applies the HasPrefix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasPrefix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleHasSuffix applies the HasSuffix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasSuffix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleIsNil applies the IsNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleIsNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRecurrenceRule)))
+	})
+}
+
+// RecurrenceRuleNotNil applies the NotNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRecurrenceRule)))
+	})
+}
+
+// RecurrenceRuleEqualFold applies the EqualFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleEqualFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceRuleContainsFold applies the ContainsFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleContainsFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRecurrenceRule), v))
+	})
+}
+
+// RecurrenceTimezoneEQ applies the EQ predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneNEQ applies the NEQ predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneNEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneIn applies the In predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRecurrenceTimezone), v...))
+	})
+}
+
+// RecurrenceTimezoneNotIn applies the NotIn predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneNotIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRecurrenceTimezone), v...))
+	})
+}
+
+// RecurrenceTimezoneGT applies the GT predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneGT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneGTE applies the GTE predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneGTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneLT applies the LT predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneLT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneLTE applies the LTE predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneLTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneContains applies the Contains predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneContains(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneHasPrefix applies the HasPrefix predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneHasPrefix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneHasSuffix applies the HasSuffix predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneHasSuffix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneIsNil applies the IsNil predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneIsNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRecurrenceTimezone)))
+	})
+}
+
+// RecurrenceTimezoneNotNil applies the NotNil predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneNotNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRecurrenceTimezone)))
+	})
+}
+
+// RecurrenceTimezoneEqualFold applies the EqualFold predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneEqualFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceTimezoneContainsFold applies the ContainsFold predicate on the "recurrence_timezone" field.
+func RecurrenceTimezoneContainsFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRecurrenceTimezone), v))
+	})
+}
+
+// RecurrenceStartEQ applies the EQ predicate on the "recurrence_start" field.
+func RecurrenceStartEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecurrenceStart), v))
+	})
+}
+
+// RecurrenceStartNEQ applies the NEQ predicate on the "recurrence_start" field.
+func RecurrenceStartNEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRecurrenceStart), v))
+	})
+}
+
+// RecurrenceStartIn applies the In predicate on the "recurrence_start" field.
+func RecurrenceStartIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRecurrenceStart), v...))
+	})
+}
+
+// RecurrenceStartNotIn applies the NotIn predicate on the "recurrence_start" field.
+func RecurrenceStartNotIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRecurrenceStart), v...))
+	})
+}
+
+// RecurrenceStartGT applies the GT predicate on the "recurrence_start" field.
+func RecurrenceStartGT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRecurrenceStart), v))
+	})
+}
+
+// RecurrenceStartGTE applies the GTE predicate on the "recurrence_start" field.
+func RecurrenceStartGTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRecurrenceStart), v))
+	})
+}
+
+// RecurrenceStartLT applies the LT predicate on the "recurrence_start" field.
+func RecurrenceStartLT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRecurrenceStart), v))
+	})
+}
+
+// RecurrenceStartLTE applies the LTE predicate on the "recurrence_start" field.
+func RecurrenceStartLTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRecurrenceStart), v))
+	})
+}
+
+// RecurrenceStartIsNil applies the IsNil predicate on the "recurrence_start" field.
+func RecurrenceStartIsNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRecurrenceStart)))
+	})
+}
+
+// RecurrenceStartNotNil applies the NotNil predicate on the "recurrence_start" field.
+func RecurrenceStartNotNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRecurrenceStart)))
+	})
+}
+
+// RecurrenceOccurrenceEQ applies the EQ predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRecurrenceOccurrence), v))
+	})
+}
+
+// RecurrenceOccurrenceNEQ applies the NEQ predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceNEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRecurrenceOccurrence), v))
+	})
+}
+
+// RecurrenceOccurrenceIn applies the In predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRecurrenceOccurrence), v...))
+	})
+}
+
+// RecurrenceOccurrenceNotIn applies the NotIn predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceNotIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRecurrenceOccurrence), v...))
+	})
+}
+
+// RecurrenceOccurrenceGT applies the GT predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceGT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRecurrenceOccurrence), v))
+	})
+}
+
+// RecurrenceOccurrenceGTE applies the GTE predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceGTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRecurrenceOccurrence), v))
+	})
+}
+
+// RecurrenceOccurrenceLT applies the LT predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceLT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRecurrenceOccurrence), v))
+	})
+}
+
+// RecurrenceOccurrenceLTE applies the LTE predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceLTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRecurrenceOccurrence), v))
+	})
+}
+
+// RecurrenceOccurrenceIsNil applies the IsNil predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceIsNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRecurrenceOccurrence)))
+	})
+}
+
+// RecurrenceOccurrenceNotNil applies the NotNil predicate on the "recurrence_occurrence" field.
+func RecurrenceOccurrenceNotNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRecurrenceOccurrence)))
+	})
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSeriesID), v...))
+	})
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSeriesID), v...))
+	})
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDContains applies the Contains predicate on the "series_id" field.
+func SeriesIDContains(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDHasPrefix applies the HasPrefix predicate on the "series_id" field.
+func SeriesIDHasPrefix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDHasSuffix applies the HasSuffix predicate on the "series_id" field.
+func SeriesIDHasSuffix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDIsNil applies the IsNil predicate on the "series_id" field.
+func SeriesIDIsNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSeriesID)))
+	})
+}
+
+// SeriesIDNotNil applies the NotNil predicate on the "series_id" field.
+func SeriesIDNotNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSeriesID)))
+	})
+}
+
+// SeriesIDEqualFold applies the EqualFold predicate on the "series_id" field.
+func SeriesIDEqualFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDContainsFold applies the ContainsFold predicate on the "series_id" field.
+func SeriesIDContainsFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSeriesID), v))
+	})
+}
+
+// AutoCompleteEQ applies the EQ predicate on the "auto_complete" field.
+func AutoCompleteEQ(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAutoComplete), v))
+	})
+}
+
+// AutoCompleteNEQ applies the NEQ predicate on the "auto_complete" field.
+func AutoCompleteNEQ(v bool) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAutoComplete), v))
+	})
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPriority), v...))
+	})
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPriority), v...))
+	})
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPriority), v))
+	})
+}
+
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRank), v))
+	})
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRank), v))
+	})
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRank), v...))
+	})
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...string) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRank), v...))
+	})
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRank), v))
+	})
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRank), v))
+	})
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRank), v))
+	})
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRank), v))
+	})
+}
+
+// RankContains applies the Contains predicate on the "rank" field.
+func RankContains(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRank), v))
+	})
+}
+
+// RankHasPrefix applies the HasPrefix predicate on the "rank" field.
+func RankHasPrefix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRank), v))
+	})
+}
+
+// RankHasSuffix applies the HasSuffix predicate on the "rank" field.
+func RankHasSuffix(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRank), v))
+	})
+}
+
+// RankEqualFold applies the EqualFold predicate on the "rank" field.
+func RankEqualFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRank), v))
+	})
+}
+
+// RankContainsFold applies the ContainsFold predicate on the "rank" field.
+func RankContainsFold(v string) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRank), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TodoItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TodoItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeletedAt)))
+	})
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeletedAt)))
+	})
+}
+
+// HasList applies the HasEdge predicate on the "list" edge.
+func HasList() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ListTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ListTable, ListColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListWith applies the HasEdge predicate on the "list" edge with a given conditions (other predicates).
+func HasListWith(preds ...predicate.TodoList) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ListInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ListTable, ListColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ParentTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.TodoItem) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ChildrenTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.TodoItem) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TagsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TagsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CommentsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CommentsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTimeEntries applies the HasEdge predicate on the "time_entries" edge.
+func HasTimeEntries() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TimeEntriesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TimeEntriesTable, TimeEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTimeEntriesWith applies the HasEdge predicate on the "time_entries" edge with a given conditions (other predicates).
+func HasTimeEntriesWith(preds ...predicate.TimeEntry) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TimeEntriesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TimeEntriesTable, TimeEntriesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AttachmentsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.TodoItem {
+	return predicate.TodoItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AttachmentsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
 	})
 }
 
@@ -10,7 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/attachment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/comment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/tag"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
 )
 
 // TodoItemCreate is the builder for creating a TodoItem entity.
@@ -32,6 +37,34 @@ func (tic *TodoItemCreate) SetTitle(s string) *TodoItemCreate {
 	return tic
 }
 
+// SetNotes sets the "notes" field.
+func (tic *TodoItemCreate) SetNotes(s string) *TodoItemCreate {
+	tic.mutation.SetNotes(s)
+	return tic
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableNotes(s *string) *TodoItemCreate {
+	if s != nil {
+		tic.SetNotes(*s)
+	}
+	return tic
+}
+
+// SetAssignee sets the "assignee" field.
+func (tic *TodoItemCreate) SetAssignee(s string) *TodoItemCreate {
+	tic.mutation.SetAssignee(s)
+	return tic
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableAssignee(s *string) *TodoItemCreate {
+	if s != nil {
+		tic.SetAssignee(*s)
+	}
+	return tic
+}
+
 // SetCompleted sets the "completed" field.
 func (tic *TodoItemCreate) SetCompleted(b bool) *TodoItemCreate {
 	tic.mutation.SetCompleted(b)
@@ -44,6 +77,160 @@ func (tic *TodoItemCreate) SetOrder(i int) *TodoItemCreate {
 	return tic
 }
 
+// SetDueAt sets the "due_at" field.
+func (tic *TodoItemCreate) SetDueAt(t time.Time) *TodoItemCreate {
+	tic.mutation.SetDueAt(t)
+	return tic
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableDueAt(t *time.Time) *TodoItemCreate {
+	if t != nil {
+		tic.SetDueAt(*t)
+	}
+	return tic
+}
+
+// SetDueSoonNotified sets the "due_soon_notified" field.
+func (tic *TodoItemCreate) SetDueSoonNotified(b bool) *TodoItemCreate {
+	tic.mutation.SetDueSoonNotified(b)
+	return tic
+}
+
+// SetNillableDueSoonNotified sets the "due_soon_notified" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableDueSoonNotified(b *bool) *TodoItemCreate {
+	if b != nil {
+		tic.SetDueSoonNotified(*b)
+	}
+	return tic
+}
+
+// SetOverdueNotified sets the "overdue_notified" field.
+func (tic *TodoItemCreate) SetOverdueNotified(b bool) *TodoItemCreate {
+	tic.mutation.SetOverdueNotified(b)
+	return tic
+}
+
+// SetNillableOverdueNotified sets the "overdue_notified" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableOverdueNotified(b *bool) *TodoItemCreate {
+	if b != nil {
+		tic.SetOverdueNotified(*b)
+	}
+	return tic
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (tic *TodoItemCreate) SetRecurrenceRule(s string) *TodoItemCreate {
+	tic.mutation.SetRecurrenceRule(s)
+	return tic
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableRecurrenceRule(s *string) *TodoItemCreate {
+	if s != nil {
+		tic.SetRecurrenceRule(*s)
+	}
+	return tic
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (tic *TodoItemCreate) SetRecurrenceTimezone(s string) *TodoItemCreate {
+	tic.mutation.SetRecurrenceTimezone(s)
+	return tic
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableRecurrenceTimezone(s *string) *TodoItemCreate {
+	if s != nil {
+		tic.SetRecurrenceTimezone(*s)
+	}
+	return tic
+}
+
+// SetRecurrenceStart sets the "recurrence_start" field.
+func (tic *TodoItemCreate) SetRecurrenceStart(t time.Time) *TodoItemCreate {
+	tic.mutation.SetRecurrenceStart(t)
+	return tic
+}
+
+// SetNillableRecurrenceStart sets the "recurrence_start" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableRecurrenceStart(t *time.Time) *TodoItemCreate {
+	if t != nil {
+		tic.SetRecurrenceStart(*t)
+	}
+	return tic
+}
+
+// SetRecurrenceOccurrence sets the "recurrence_occurrence" field.
+func (tic *TodoItemCreate) SetRecurrenceOccurrence(t time.Time) *TodoItemCreate {
+	tic.mutation.SetRecurrenceOccurrence(t)
+	return tic
+}
+
+// SetNillableRecurrenceOccurrence sets the "recurrence_occurrence" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableRecurrenceOccurrence(t *time.Time) *TodoItemCreate {
+	if t != nil {
+		tic.SetRecurrenceOccurrence(*t)
+	}
+	return tic
+}
+
+// SetSeriesID sets the "series_id" field.
+func (tic *TodoItemCreate) SetSeriesID(s string) *TodoItemCreate {
+	tic.mutation.SetSeriesID(s)
+	return tic
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableSeriesID(s *string) *TodoItemCreate {
+	if s != nil {
+		tic.SetSeriesID(*s)
+	}
+	return tic
+}
+
+// SetAutoComplete sets the "auto_complete" field.
+func (tic *TodoItemCreate) SetAutoComplete(b bool) *TodoItemCreate {
+	tic.mutation.SetAutoComplete(b)
+	return tic
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableAutoComplete(b *bool) *TodoItemCreate {
+	if b != nil {
+		tic.SetAutoComplete(*b)
+	}
+	return tic
+}
+
+// SetPriority sets the "priority" field.
+func (tic *TodoItemCreate) SetPriority(i int) *TodoItemCreate {
+	tic.mutation.SetPriority(i)
+	return tic
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillablePriority(i *int) *TodoItemCreate {
+	if i != nil {
+		tic.SetPriority(*i)
+	}
+	return tic
+}
+
+// SetRank sets the "rank" field.
+func (tic *TodoItemCreate) SetRank(s string) *TodoItemCreate {
+	tic.mutation.SetRank(s)
+	return tic
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableRank(s *string) *TodoItemCreate {
+	if s != nil {
+		tic.SetRank(*s)
+	}
+	return tic
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tic *TodoItemCreate) SetCreatedAt(t time.Time) *TodoItemCreate {
 	tic.mutation.SetCreatedAt(t)
@@ -72,6 +259,133 @@ func (tic *TodoItemCreate) SetNillableUpdatedAt(t *time.Time) *TodoItemCreate {
 	return tic
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (tic *TodoItemCreate) SetDeletedAt(t time.Time) *TodoItemCreate {
+	tic.mutation.SetDeletedAt(t)
+	return tic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableDeletedAt(t *time.Time) *TodoItemCreate {
+	if t != nil {
+		tic.SetDeletedAt(*t)
+	}
+	return tic
+}
+
+// SetListID sets the "list" edge to the TodoList entity by ID.
+func (tic *TodoItemCreate) SetListID(id int) *TodoItemCreate {
+	tic.mutation.SetListID(id)
+	return tic
+}
+
+// SetNillableListID sets the "list" edge to the TodoList entity by ID if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableListID(id *int) *TodoItemCreate {
+	if id != nil {
+		tic = tic.SetListID(*id)
+	}
+	return tic
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (tic *TodoItemCreate) SetList(t *TodoList) *TodoItemCreate {
+	return tic.SetListID(t.ID)
+}
+
+// SetParentID sets the "parent" edge to the TodoItem entity by ID.
+func (tic *TodoItemCreate) SetParentID(id int) *TodoItemCreate {
+	tic.mutation.SetParentID(id)
+	return tic
+}
+
+// SetNillableParentID sets the "parent" edge to the TodoItem entity by ID if the given value is not nil.
+func (tic *TodoItemCreate) SetNillableParentID(id *int) *TodoItemCreate {
+	if id != nil {
+		tic = tic.SetParentID(*id)
+	}
+	return tic
+}
+
+// SetParent sets the "parent" edge to the TodoItem entity.
+func (tic *TodoItemCreate) SetParent(t *TodoItem) *TodoItemCreate {
+	return tic.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the TodoItem entity by IDs.
+func (tic *TodoItemCreate) AddChildIDs(ids ...int) *TodoItemCreate {
+	tic.mutation.AddChildIDs(ids...)
+	return tic
+}
+
+// AddChildren adds the "children" edges to the TodoItem entity.
+func (tic *TodoItemCreate) AddChildren(t ...*TodoItem) *TodoItemCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tic.AddChildIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tic *TodoItemCreate) AddTagIDs(ids ...int) *TodoItemCreate {
+	tic.mutation.AddTagIDs(ids...)
+	return tic
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tic *TodoItemCreate) AddTags(t ...*Tag) *TodoItemCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tic.AddTagIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tic *TodoItemCreate) AddCommentIDs(ids ...int) *TodoItemCreate {
+	tic.mutation.AddCommentIDs(ids...)
+	return tic
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tic *TodoItemCreate) AddComments(c ...*Comment) *TodoItemCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tic.AddCommentIDs(ids...)
+}
+
+// AddTimeEntryIDs adds the "time_entries" edge to the TimeEntry entity by IDs.
+func (tic *TodoItemCreate) AddTimeEntryIDs(ids ...int) *TodoItemCreate {
+	tic.mutation.AddTimeEntryIDs(ids...)
+	return tic
+}
+
+// AddTimeEntries adds the "time_entries" edges to the TimeEntry entity.
+func (tic *TodoItemCreate) AddTimeEntries(t ...*TimeEntry) *TodoItemCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tic.AddTimeEntryIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (tic *TodoItemCreate) AddAttachmentIDs(ids ...int) *TodoItemCreate {
+	tic.mutation.AddAttachmentIDs(ids...)
+	return tic
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (tic *TodoItemCreate) AddAttachments(a ...*Attachment) *TodoItemCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tic.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TodoItemMutation object of the builder.
 func (tic *TodoItemCreate) Mutation() *TodoItemMutation {
 	return tic.mutation
@@ -143,6 +457,34 @@ func (tic *TodoItemCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (tic *TodoItemCreate) defaults() {
+	if _, ok := tic.mutation.Notes(); !ok {
+		v := todoitem.DefaultNotes
+		tic.mutation.SetNotes(v)
+	}
+	if _, ok := tic.mutation.Assignee(); !ok {
+		v := todoitem.DefaultAssignee
+		tic.mutation.SetAssignee(v)
+	}
+	if _, ok := tic.mutation.DueSoonNotified(); !ok {
+		v := todoitem.DefaultDueSoonNotified
+		tic.mutation.SetDueSoonNotified(v)
+	}
+	if _, ok := tic.mutation.OverdueNotified(); !ok {
+		v := todoitem.DefaultOverdueNotified
+		tic.mutation.SetOverdueNotified(v)
+	}
+	if _, ok := tic.mutation.AutoComplete(); !ok {
+		v := todoitem.DefaultAutoComplete
+		tic.mutation.SetAutoComplete(v)
+	}
+	if _, ok := tic.mutation.Priority(); !ok {
+		v := todoitem.DefaultPriority
+		tic.mutation.SetPriority(v)
+	}
+	if _, ok := tic.mutation.Rank(); !ok {
+		v := todoitem.DefaultRank
+		tic.mutation.SetRank(v)
+	}
 	if _, ok := tic.mutation.CreatedAt(); !ok {
 		v := todoitem.DefaultCreatedAt()
 		tic.mutation.SetCreatedAt(v)
@@ -166,12 +508,38 @@ func (tic *TodoItemCreate) check() error {
 	if _, ok := tic.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "title"`)}
 	}
+	if _, ok := tic.mutation.Notes(); !ok {
+		return &ValidationError{Name: "notes", err: errors.New(`ent: missing required field "notes"`)}
+	}
+	if _, ok := tic.mutation.Assignee(); !ok {
+		return &ValidationError{Name: "assignee", err: errors.New(`ent: missing required field "assignee"`)}
+	}
 	if _, ok := tic.mutation.Completed(); !ok {
 		return &ValidationError{Name: "completed", err: errors.New(`ent: missing required field "completed"`)}
 	}
 	if _, ok := tic.mutation.Order(); !ok {
 		return &ValidationError{Name: "order", err: errors.New(`ent: missing required field "order"`)}
 	}
+	if _, ok := tic.mutation.DueSoonNotified(); !ok {
+		return &ValidationError{Name: "due_soon_notified", err: errors.New(`ent: missing required field "due_soon_notified"`)}
+	}
+	if _, ok := tic.mutation.OverdueNotified(); !ok {
+		return &ValidationError{Name: "overdue_notified", err: errors.New(`ent: missing required field "overdue_notified"`)}
+	}
+	if v, ok := tic.mutation.SeriesID(); ok {
+		if err := todoitem.SeriesIDValidator(v); err != nil {
+			return &ValidationError{Name: "series_id", err: fmt.Errorf(`ent: validator failed for field "series_id": %w`, err)}
+		}
+	}
+	if _, ok := tic.mutation.AutoComplete(); !ok {
+		return &ValidationError{Name: "auto_complete", err: errors.New(`ent: missing required field "auto_complete"`)}
+	}
+	if _, ok := tic.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "priority"`)}
+	}
+	if _, ok := tic.mutation.Rank(); !ok {
+		return &ValidationError{Name: "rank", err: errors.New(`ent: missing required field "rank"`)}
+	}
 	if _, ok := tic.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
 	}
@@ -221,6 +589,22 @@ func (tic *TodoItemCreate) createSpec() (*TodoItem, *sqlgraph.CreateSpec) {
 		})
 		_node.Title = value
 	}
+	if value, ok := tic.mutation.Notes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldNotes,
+		})
+		_node.Notes = value
+	}
+	if value, ok := tic.mutation.Assignee(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldAssignee,
+		})
+		_node.Assignee = value
+	}
 	if value, ok := tic.mutation.Completed(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -237,6 +621,94 @@ func (tic *TodoItemCreate) createSpec() (*TodoItem, *sqlgraph.CreateSpec) {
 		})
 		_node.Order = value
 	}
+	if value, ok := tic.mutation.DueAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldDueAt,
+		})
+		_node.DueAt = &value
+	}
+	if value, ok := tic.mutation.DueSoonNotified(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todoitem.FieldDueSoonNotified,
+		})
+		_node.DueSoonNotified = value
+	}
+	if value, ok := tic.mutation.OverdueNotified(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todoitem.FieldOverdueNotified,
+		})
+		_node.OverdueNotified = value
+	}
+	if value, ok := tic.mutation.RecurrenceRule(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceRule,
+		})
+		_node.RecurrenceRule = &value
+	}
+	if value, ok := tic.mutation.RecurrenceTimezone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceTimezone,
+		})
+		_node.RecurrenceTimezone = &value
+	}
+	if value, ok := tic.mutation.RecurrenceStart(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceStart,
+		})
+		_node.RecurrenceStart = &value
+	}
+	if value, ok := tic.mutation.RecurrenceOccurrence(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceOccurrence,
+		})
+		_node.RecurrenceOccurrence = &value
+	}
+	if value, ok := tic.mutation.SeriesID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldSeriesID,
+		})
+		_node.SeriesID = &value
+	}
+	if value, ok := tic.mutation.AutoComplete(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todoitem.FieldAutoComplete,
+		})
+		_node.AutoComplete = value
+	}
+	if value, ok := tic.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todoitem.FieldPriority,
+		})
+		_node.Priority = value
+	}
+	if value, ok := tic.mutation.Rank(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldRank,
+		})
+		_node.Rank = value
+	}
 	if value, ok := tic.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -253,6 +725,149 @@ func (tic *TodoItemCreate) createSpec() (*TodoItem, *sqlgraph.CreateSpec) {
 		})
 		_node.UpdatedAt = value
 	}
+	if value, ok := tic.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
+	if nodes := tic.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ListTable,
+			Columns: []string{todoitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.todo_list_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tic.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ParentTable,
+			Columns: []string{todoitem.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.todo_item_children = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tic.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.ChildrenTable,
+			Columns: []string{todoitem.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tic.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoitem.TagsTable,
+			Columns: todoitem.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tic.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.CommentsTable,
+			Columns: []string{todoitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tic.mutation.TimeEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.TimeEntriesTable,
+			Columns: []string{todoitem.TimeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: timeentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tic.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.AttachmentsTable,
+			Columns: []string{todoitem.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
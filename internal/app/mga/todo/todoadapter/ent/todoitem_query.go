@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
@@ -11,8 +12,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/attachment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/comment"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/tag"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
 )
 
 // TodoItemQuery is the builder for querying TodoItem entities.
@@ -24,6 +30,15 @@ type TodoItemQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.TodoItem
+	// eager-loading edges.
+	withList        *TodoListQuery
+	withParent      *TodoItemQuery
+	withChildren    *TodoItemQuery
+	withTags        *TagQuery
+	withComments    *CommentQuery
+	withTimeEntries *TimeEntryQuery
+	withAttachments *AttachmentQuery
+	withFKs         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,6 +75,160 @@ func (tiq *TodoItemQuery) Order(o ...OrderFunc) *TodoItemQuery {
 	return tiq
 }
 
+// QueryList chains the current query on the "list" edge.
+func (tiq *TodoItemQuery) QueryList() *TodoListQuery {
+	query := &TodoListQuery{config: tiq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, selector),
+			sqlgraph.To(todolist.Table, todolist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todoitem.ListTable, todoitem.ListColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (tiq *TodoItemQuery) QueryParent() *TodoItemQuery {
+	query := &TodoItemQuery{config: tiq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, selector),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todoitem.ParentTable, todoitem.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (tiq *TodoItemQuery) QueryChildren() *TodoItemQuery {
+	query := &TodoItemQuery{config: tiq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, selector),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoitem.ChildrenTable, todoitem.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (tiq *TodoItemQuery) QueryTags() *TagQuery {
+	query := &TagQuery{config: tiq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, todoitem.TagsTable, todoitem.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComments chains the current query on the "comments" edge.
+func (tiq *TodoItemQuery) QueryComments() *CommentQuery {
+	query := &CommentQuery{config: tiq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoitem.CommentsTable, todoitem.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTimeEntries chains the current query on the "time_entries" edge.
+func (tiq *TodoItemQuery) QueryTimeEntries() *TimeEntryQuery {
+	query := &TimeEntryQuery{config: tiq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, selector),
+			sqlgraph.To(timeentry.Table, timeentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoitem.TimeEntriesTable, todoitem.TimeEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (tiq *TodoItemQuery) QueryAttachments() *AttachmentQuery {
+	query := &AttachmentQuery{config: tiq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoitem.Table, todoitem.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoitem.AttachmentsTable, todoitem.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first TodoItem entity from the query.
 // Returns a *NotFoundError when no TodoItem was found.
 func (tiq *TodoItemQuery) First(ctx context.Context) (*TodoItem, error) {
@@ -236,17 +405,101 @@ func (tiq *TodoItemQuery) Clone() *TodoItemQuery {
 		return nil
 	}
 	return &TodoItemQuery{
-		config:     tiq.config,
-		limit:      tiq.limit,
-		offset:     tiq.offset,
-		order:      append([]OrderFunc{}, tiq.order...),
-		predicates: append([]predicate.TodoItem{}, tiq.predicates...),
+		config:          tiq.config,
+		limit:           tiq.limit,
+		offset:          tiq.offset,
+		order:           append([]OrderFunc{}, tiq.order...),
+		predicates:      append([]predicate.TodoItem{}, tiq.predicates...),
+		withList:        tiq.withList.Clone(),
+		withParent:      tiq.withParent.Clone(),
+		withChildren:    tiq.withChildren.Clone(),
+		withTags:        tiq.withTags.Clone(),
+		withComments:    tiq.withComments.Clone(),
+		withTimeEntries: tiq.withTimeEntries.Clone(),
+		withAttachments: tiq.withAttachments.Clone(),
 		// clone intermediate query.
 		sql:  tiq.sql.Clone(),
 		path: tiq.path,
 	}
 }
 
+// WithList tells the query-builder to eager-load the nodes that are connected to
+// the "list" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TodoItemQuery) WithList(opts ...func(*TodoListQuery)) *TodoItemQuery {
+	query := &TodoListQuery{config: tiq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withList = query
+	return tiq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TodoItemQuery) WithParent(opts ...func(*TodoItemQuery)) *TodoItemQuery {
+	query := &TodoItemQuery{config: tiq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withParent = query
+	return tiq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TodoItemQuery) WithChildren(opts ...func(*TodoItemQuery)) *TodoItemQuery {
+	query := &TodoItemQuery{config: tiq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withChildren = query
+	return tiq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TodoItemQuery) WithTags(opts ...func(*TagQuery)) *TodoItemQuery {
+	query := &TagQuery{config: tiq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withTags = query
+	return tiq
+}
+
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TodoItemQuery) WithComments(opts ...func(*CommentQuery)) *TodoItemQuery {
+	query := &CommentQuery{config: tiq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withComments = query
+	return tiq
+}
+
+// WithTimeEntries tells the query-builder to eager-load the nodes that are connected to
+// the "time_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TodoItemQuery) WithTimeEntries(opts ...func(*TimeEntryQuery)) *TodoItemQuery {
+	query := &TimeEntryQuery{config: tiq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withTimeEntries = query
+	return tiq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TodoItemQuery) WithAttachments(opts ...func(*AttachmentQuery)) *TodoItemQuery {
+	query := &AttachmentQuery{config: tiq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withAttachments = query
+	return tiq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -310,9 +563,25 @@ func (tiq *TodoItemQuery) prepareQuery(ctx context.Context) error {
 
 func (tiq *TodoItemQuery) sqlAll(ctx context.Context) ([]*TodoItem, error) {
 	var (
-		nodes = []*TodoItem{}
-		_spec = tiq.querySpec()
+		nodes       = []*TodoItem{}
+		withFKs     = tiq.withFKs
+		_spec       = tiq.querySpec()
+		loadedTypes = [7]bool{
+			tiq.withList != nil,
+			tiq.withParent != nil,
+			tiq.withChildren != nil,
+			tiq.withTags != nil,
+			tiq.withComments != nil,
+			tiq.withTimeEntries != nil,
+			tiq.withAttachments != nil,
+		}
 	)
+	if tiq.withList != nil || tiq.withParent != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, todoitem.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &TodoItem{config: tiq.config}
 		nodes = append(nodes, node)
@@ -323,6 +592,7 @@ func (tiq *TodoItemQuery) sqlAll(ctx context.Context) ([]*TodoItem, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, tiq.driver, _spec); err != nil {
@@ -331,6 +601,246 @@ func (tiq *TodoItemQuery) sqlAll(ctx context.Context) ([]*TodoItem, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+
+	if query := tiq.withList; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*TodoItem)
+		for i := range nodes {
+			if nodes[i].todo_list_items == nil {
+				continue
+			}
+			fk := *nodes[i].todo_list_items
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(todolist.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_list_items" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.List = n
+			}
+		}
+	}
+
+	if query := tiq.withParent; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*TodoItem)
+		for i := range nodes {
+			if nodes[i].todo_item_children == nil {
+				continue
+			}
+			fk := *nodes[i].todo_item_children
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(todoitem.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_item_children" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Parent = n
+			}
+		}
+	}
+
+	if query := tiq.withChildren; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*TodoItem)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Children = []*TodoItem{}
+		}
+		query.withFKs = true
+		query.Where(predicate.TodoItem(func(s *sql.Selector) {
+			s.Where(sql.InValues(todoitem.ChildrenColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.todo_item_children
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "todo_item_children" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_item_children" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Children = append(node.Edges.Children, n)
+		}
+	}
+
+	if query := tiq.withTags; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*TodoItem, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+			node.Edges.Tags = []*Tag{}
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*TodoItem)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: true,
+				Table:   todoitem.TagsTable,
+				Columns: todoitem.TagsPrimaryKey,
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.InValues(todoitem.TagsPrimaryKey[1], fks...))
+			},
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{new(sql.NullInt64), new(sql.NullInt64)}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		if err := sqlgraph.QueryEdges(ctx, tiq.driver, _spec); err != nil {
+			return nil, fmt.Errorf(`query edges "tags": %w`, err)
+		}
+		query.Where(tag.IDIn(edgeids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := edges[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Tags = append(nodes[i].Edges.Tags, n)
+			}
+		}
+	}
+
+	if query := tiq.withComments; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*TodoItem)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Comments = []*Comment{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Comment(func(s *sql.Selector) {
+			s.Where(sql.InValues(todoitem.CommentsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.todo_item_comments
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "todo_item_comments" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_item_comments" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Comments = append(node.Edges.Comments, n)
+		}
+	}
+
+	if query := tiq.withTimeEntries; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*TodoItem)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.TimeEntries = []*TimeEntry{}
+		}
+		query.withFKs = true
+		query.Where(predicate.TimeEntry(func(s *sql.Selector) {
+			s.Where(sql.InValues(todoitem.TimeEntriesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.todo_item_time_entries
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "todo_item_time_entries" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_item_time_entries" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.TimeEntries = append(node.Edges.TimeEntries, n)
+		}
+	}
+
+	if query := tiq.withAttachments; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*TodoItem)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Attachments = []*Attachment{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Attachment(func(s *sql.Selector) {
+			s.Where(sql.InValues(todoitem.AttachmentsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.todo_item_attachments
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "todo_item_attachments" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_item_attachments" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Attachments = append(node.Edges.Attachments, n)
+		}
+	}
+
 	return nodes, nil
 }
 
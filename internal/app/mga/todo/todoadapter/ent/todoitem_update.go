@@ -10,8 +10,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/attachment"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/comment"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/tag"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
 )
 
 // TodoItemUpdate is the builder for updating TodoItem entities.
@@ -33,6 +38,34 @@ func (tiu *TodoItemUpdate) SetTitle(s string) *TodoItemUpdate {
 	return tiu
 }
 
+// SetNotes sets the "notes" field.
+func (tiu *TodoItemUpdate) SetNotes(s string) *TodoItemUpdate {
+	tiu.mutation.SetNotes(s)
+	return tiu
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableNotes(s *string) *TodoItemUpdate {
+	if s != nil {
+		tiu.SetNotes(*s)
+	}
+	return tiu
+}
+
+// SetAssignee sets the "assignee" field.
+func (tiu *TodoItemUpdate) SetAssignee(s string) *TodoItemUpdate {
+	tiu.mutation.SetAssignee(s)
+	return tiu
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableAssignee(s *string) *TodoItemUpdate {
+	if s != nil {
+		tiu.SetAssignee(*s)
+	}
+	return tiu
+}
+
 // SetCompleted sets the "completed" field.
 func (tiu *TodoItemUpdate) SetCompleted(b bool) *TodoItemUpdate {
 	tiu.mutation.SetCompleted(b)
@@ -52,6 +85,203 @@ func (tiu *TodoItemUpdate) AddOrder(i int) *TodoItemUpdate {
 	return tiu
 }
 
+// SetDueAt sets the "due_at" field.
+func (tiu *TodoItemUpdate) SetDueAt(t time.Time) *TodoItemUpdate {
+	tiu.mutation.SetDueAt(t)
+	return tiu
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableDueAt(t *time.Time) *TodoItemUpdate {
+	if t != nil {
+		tiu.SetDueAt(*t)
+	}
+	return tiu
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (tiu *TodoItemUpdate) ClearDueAt() *TodoItemUpdate {
+	tiu.mutation.ClearDueAt()
+	return tiu
+}
+
+// SetDueSoonNotified sets the "due_soon_notified" field.
+func (tiu *TodoItemUpdate) SetDueSoonNotified(b bool) *TodoItemUpdate {
+	tiu.mutation.SetDueSoonNotified(b)
+	return tiu
+}
+
+// SetNillableDueSoonNotified sets the "due_soon_notified" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableDueSoonNotified(b *bool) *TodoItemUpdate {
+	if b != nil {
+		tiu.SetDueSoonNotified(*b)
+	}
+	return tiu
+}
+
+// SetOverdueNotified sets the "overdue_notified" field.
+func (tiu *TodoItemUpdate) SetOverdueNotified(b bool) *TodoItemUpdate {
+	tiu.mutation.SetOverdueNotified(b)
+	return tiu
+}
+
+// SetNillableOverdueNotified sets the "overdue_notified" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableOverdueNotified(b *bool) *TodoItemUpdate {
+	if b != nil {
+		tiu.SetOverdueNotified(*b)
+	}
+	return tiu
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (tiu *TodoItemUpdate) SetRecurrenceRule(s string) *TodoItemUpdate {
+	tiu.mutation.SetRecurrenceRule(s)
+	return tiu
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableRecurrenceRule(s *string) *TodoItemUpdate {
+	if s != nil {
+		tiu.SetRecurrenceRule(*s)
+	}
+	return tiu
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (tiu *TodoItemUpdate) ClearRecurrenceRule() *TodoItemUpdate {
+	tiu.mutation.ClearRecurrenceRule()
+	return tiu
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (tiu *TodoItemUpdate) SetRecurrenceTimezone(s string) *TodoItemUpdate {
+	tiu.mutation.SetRecurrenceTimezone(s)
+	return tiu
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableRecurrenceTimezone(s *string) *TodoItemUpdate {
+	if s != nil {
+		tiu.SetRecurrenceTimezone(*s)
+	}
+	return tiu
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (tiu *TodoItemUpdate) ClearRecurrenceTimezone() *TodoItemUpdate {
+	tiu.mutation.ClearRecurrenceTimezone()
+	return tiu
+}
+
+// SetRecurrenceStart sets the "recurrence_start" field.
+func (tiu *TodoItemUpdate) SetRecurrenceStart(t time.Time) *TodoItemUpdate {
+	tiu.mutation.SetRecurrenceStart(t)
+	return tiu
+}
+
+// SetNillableRecurrenceStart sets the "recurrence_start" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableRecurrenceStart(t *time.Time) *TodoItemUpdate {
+	if t != nil {
+		tiu.SetRecurrenceStart(*t)
+	}
+	return tiu
+}
+
+// ClearRecurrenceStart clears the value of the "recurrence_start" field.
+func (tiu *TodoItemUpdate) ClearRecurrenceStart() *TodoItemUpdate {
+	tiu.mutation.ClearRecurrenceStart()
+	return tiu
+}
+
+// SetRecurrenceOccurrence sets the "recurrence_occurrence" field.
+func (tiu *TodoItemUpdate) SetRecurrenceOccurrence(t time.Time) *TodoItemUpdate {
+	tiu.mutation.SetRecurrenceOccurrence(t)
+	return tiu
+}
+
+// SetNillableRecurrenceOccurrence sets the "recurrence_occurrence" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableRecurrenceOccurrence(t *time.Time) *TodoItemUpdate {
+	if t != nil {
+		tiu.SetRecurrenceOccurrence(*t)
+	}
+	return tiu
+}
+
+// ClearRecurrenceOccurrence clears the value of the "recurrence_occurrence" field.
+func (tiu *TodoItemUpdate) ClearRecurrenceOccurrence() *TodoItemUpdate {
+	tiu.mutation.ClearRecurrenceOccurrence()
+	return tiu
+}
+
+// SetSeriesID sets the "series_id" field.
+func (tiu *TodoItemUpdate) SetSeriesID(s string) *TodoItemUpdate {
+	tiu.mutation.SetSeriesID(s)
+	return tiu
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableSeriesID(s *string) *TodoItemUpdate {
+	if s != nil {
+		tiu.SetSeriesID(*s)
+	}
+	return tiu
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (tiu *TodoItemUpdate) ClearSeriesID() *TodoItemUpdate {
+	tiu.mutation.ClearSeriesID()
+	return tiu
+}
+
+// SetAutoComplete sets the "auto_complete" field.
+func (tiu *TodoItemUpdate) SetAutoComplete(b bool) *TodoItemUpdate {
+	tiu.mutation.SetAutoComplete(b)
+	return tiu
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableAutoComplete(b *bool) *TodoItemUpdate {
+	if b != nil {
+		tiu.SetAutoComplete(*b)
+	}
+	return tiu
+}
+
+// SetPriority sets the "priority" field.
+func (tiu *TodoItemUpdate) SetPriority(i int) *TodoItemUpdate {
+	tiu.mutation.ResetPriority()
+	tiu.mutation.SetPriority(i)
+	return tiu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillablePriority(i *int) *TodoItemUpdate {
+	if i != nil {
+		tiu.SetPriority(*i)
+	}
+	return tiu
+}
+
+// AddPriority adds i to the "priority" field.
+func (tiu *TodoItemUpdate) AddPriority(i int) *TodoItemUpdate {
+	tiu.mutation.AddPriority(i)
+	return tiu
+}
+
+// SetRank sets the "rank" field.
+func (tiu *TodoItemUpdate) SetRank(s string) *TodoItemUpdate {
+	tiu.mutation.SetRank(s)
+	return tiu
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableRank(s *string) *TodoItemUpdate {
+	if s != nil {
+		tiu.SetRank(*s)
+	}
+	return tiu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tiu *TodoItemUpdate) SetCreatedAt(t time.Time) *TodoItemUpdate {
 	tiu.mutation.SetCreatedAt(t)
@@ -72,11 +302,261 @@ func (tiu *TodoItemUpdate) SetUpdatedAt(t time.Time) *TodoItemUpdate {
 	return tiu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (tiu *TodoItemUpdate) SetDeletedAt(t time.Time) *TodoItemUpdate {
+	tiu.mutation.SetDeletedAt(t)
+	return tiu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableDeletedAt(t *time.Time) *TodoItemUpdate {
+	if t != nil {
+		tiu.SetDeletedAt(*t)
+	}
+	return tiu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tiu *TodoItemUpdate) ClearDeletedAt() *TodoItemUpdate {
+	tiu.mutation.ClearDeletedAt()
+	return tiu
+}
+
+// SetListID sets the "list" edge to the TodoList entity by ID.
+func (tiu *TodoItemUpdate) SetListID(id int) *TodoItemUpdate {
+	tiu.mutation.SetListID(id)
+	return tiu
+}
+
+// SetNillableListID sets the "list" edge to the TodoList entity by ID if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableListID(id *int) *TodoItemUpdate {
+	if id != nil {
+		tiu = tiu.SetListID(*id)
+	}
+	return tiu
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (tiu *TodoItemUpdate) SetList(t *TodoList) *TodoItemUpdate {
+	return tiu.SetListID(t.ID)
+}
+
+// SetParentID sets the "parent" edge to the TodoItem entity by ID.
+func (tiu *TodoItemUpdate) SetParentID(id int) *TodoItemUpdate {
+	tiu.mutation.SetParentID(id)
+	return tiu
+}
+
+// SetNillableParentID sets the "parent" edge to the TodoItem entity by ID if the given value is not nil.
+func (tiu *TodoItemUpdate) SetNillableParentID(id *int) *TodoItemUpdate {
+	if id != nil {
+		tiu = tiu.SetParentID(*id)
+	}
+	return tiu
+}
+
+// SetParent sets the "parent" edge to the TodoItem entity.
+func (tiu *TodoItemUpdate) SetParent(t *TodoItem) *TodoItemUpdate {
+	return tiu.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the TodoItem entity by IDs.
+func (tiu *TodoItemUpdate) AddChildIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.AddChildIDs(ids...)
+	return tiu
+}
+
+// AddChildren adds the "children" edges to the TodoItem entity.
+func (tiu *TodoItemUpdate) AddChildren(t ...*TodoItem) *TodoItemUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiu.AddChildIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tiu *TodoItemUpdate) AddTagIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.AddTagIDs(ids...)
+	return tiu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tiu *TodoItemUpdate) AddTags(t ...*Tag) *TodoItemUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiu.AddTagIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tiu *TodoItemUpdate) AddCommentIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.AddCommentIDs(ids...)
+	return tiu
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tiu *TodoItemUpdate) AddComments(c ...*Comment) *TodoItemUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tiu.AddCommentIDs(ids...)
+}
+
+// AddTimeEntryIDs adds the "time_entries" edge to the TimeEntry entity by IDs.
+func (tiu *TodoItemUpdate) AddTimeEntryIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.AddTimeEntryIDs(ids...)
+	return tiu
+}
+
+// AddTimeEntries adds the "time_entries" edges to the TimeEntry entity.
+func (tiu *TodoItemUpdate) AddTimeEntries(t ...*TimeEntry) *TodoItemUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiu.AddTimeEntryIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (tiu *TodoItemUpdate) AddAttachmentIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.AddAttachmentIDs(ids...)
+	return tiu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (tiu *TodoItemUpdate) AddAttachments(a ...*Attachment) *TodoItemUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tiu.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TodoItemMutation object of the builder.
 func (tiu *TodoItemUpdate) Mutation() *TodoItemMutation {
 	return tiu.mutation
 }
 
+// ClearList clears the "list" edge to the TodoList entity.
+func (tiu *TodoItemUpdate) ClearList() *TodoItemUpdate {
+	tiu.mutation.ClearList()
+	return tiu
+}
+
+// ClearParent clears the "parent" edge to the TodoItem entity.
+func (tiu *TodoItemUpdate) ClearParent() *TodoItemUpdate {
+	tiu.mutation.ClearParent()
+	return tiu
+}
+
+// ClearChildren clears all "children" edges to the TodoItem entity.
+func (tiu *TodoItemUpdate) ClearChildren() *TodoItemUpdate {
+	tiu.mutation.ClearChildren()
+	return tiu
+}
+
+// RemoveChildIDs removes the "children" edge to TodoItem entities by IDs.
+func (tiu *TodoItemUpdate) RemoveChildIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.RemoveChildIDs(ids...)
+	return tiu
+}
+
+// RemoveChildren removes "children" edges to TodoItem entities.
+func (tiu *TodoItemUpdate) RemoveChildren(t ...*TodoItem) *TodoItemUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiu.RemoveChildIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tiu *TodoItemUpdate) ClearTags() *TodoItemUpdate {
+	tiu.mutation.ClearTags()
+	return tiu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tiu *TodoItemUpdate) RemoveTagIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.RemoveTagIDs(ids...)
+	return tiu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tiu *TodoItemUpdate) RemoveTags(t ...*Tag) *TodoItemUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiu.RemoveTagIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (tiu *TodoItemUpdate) ClearComments() *TodoItemUpdate {
+	tiu.mutation.ClearComments()
+	return tiu
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (tiu *TodoItemUpdate) RemoveCommentIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.RemoveCommentIDs(ids...)
+	return tiu
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (tiu *TodoItemUpdate) RemoveComments(c ...*Comment) *TodoItemUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tiu.RemoveCommentIDs(ids...)
+}
+
+// ClearTimeEntries clears all "time_entries" edges to the TimeEntry entity.
+func (tiu *TodoItemUpdate) ClearTimeEntries() *TodoItemUpdate {
+	tiu.mutation.ClearTimeEntries()
+	return tiu
+}
+
+// RemoveTimeEntryIDs removes the "time_entries" edge to TimeEntry entities by IDs.
+func (tiu *TodoItemUpdate) RemoveTimeEntryIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.RemoveTimeEntryIDs(ids...)
+	return tiu
+}
+
+// RemoveTimeEntries removes "time_entries" edges to TimeEntry entities.
+func (tiu *TodoItemUpdate) RemoveTimeEntries(t ...*TimeEntry) *TodoItemUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiu.RemoveTimeEntryIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (tiu *TodoItemUpdate) ClearAttachments() *TodoItemUpdate {
+	tiu.mutation.ClearAttachments()
+	return tiu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (tiu *TodoItemUpdate) RemoveAttachmentIDs(ids ...int) *TodoItemUpdate {
+	tiu.mutation.RemoveAttachmentIDs(ids...)
+	return tiu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (tiu *TodoItemUpdate) RemoveAttachments(a ...*Attachment) *TodoItemUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tiu.RemoveAttachmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tiu *TodoItemUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -85,6 +565,9 @@ func (tiu *TodoItemUpdate) Save(ctx context.Context) (int, error) {
 	)
 	tiu.defaults()
 	if len(tiu.hooks) == 0 {
+		if err = tiu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = tiu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -92,6 +575,9 @@ func (tiu *TodoItemUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = tiu.check(); err != nil {
+				return 0, err
+			}
 			tiu.mutation = mutation
 			affected, err = tiu.sqlSave(ctx)
 			mutation.done = true
@@ -140,6 +626,16 @@ func (tiu *TodoItemUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (tiu *TodoItemUpdate) check() error {
+	if v, ok := tiu.mutation.SeriesID(); ok {
+		if err := todoitem.SeriesIDValidator(v); err != nil {
+			return &ValidationError{Name: "series_id", err: fmt.Errorf("ent: validator failed for field \"series_id\": %w", err)}
+		}
+	}
+	return nil
+}
+
 func (tiu *TodoItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -165,6 +661,20 @@ func (tiu *TodoItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: todoitem.FieldTitle,
 		})
 	}
+	if value, ok := tiu.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldNotes,
+		})
+	}
+	if value, ok := tiu.mutation.Assignee(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldAssignee,
+		})
+	}
 	if value, ok := tiu.mutation.Completed(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -186,87 +696,1035 @@ func (tiu *TodoItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: todoitem.FieldOrder,
 		})
 	}
-	if value, ok := tiu.mutation.CreatedAt(); ok {
+	if value, ok := tiu.mutation.DueAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
 			Value:  value,
-			Column: todoitem.FieldCreatedAt,
+			Column: todoitem.FieldDueAt,
+		})
+	}
+	if tiu.mutation.DueAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todoitem.FieldDueAt,
+		})
+	}
+	if value, ok := tiu.mutation.DueSoonNotified(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todoitem.FieldDueSoonNotified,
 		})
 	}
-	if value, ok := tiu.mutation.UpdatedAt(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: todoitem.FieldUpdatedAt,
-		})
+	if value, ok := tiu.mutation.OverdueNotified(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todoitem.FieldOverdueNotified,
+		})
+	}
+	if value, ok := tiu.mutation.RecurrenceRule(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceRule,
+		})
+	}
+	if tiu.mutation.RecurrenceRuleCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: todoitem.FieldRecurrenceRule,
+		})
+	}
+	if value, ok := tiu.mutation.RecurrenceTimezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceTimezone,
+		})
+	}
+	if tiu.mutation.RecurrenceTimezoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: todoitem.FieldRecurrenceTimezone,
+		})
+	}
+	if value, ok := tiu.mutation.RecurrenceStart(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceStart,
+		})
+	}
+	if tiu.mutation.RecurrenceStartCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todoitem.FieldRecurrenceStart,
+		})
+	}
+	if value, ok := tiu.mutation.RecurrenceOccurrence(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceOccurrence,
+		})
+	}
+	if tiu.mutation.RecurrenceOccurrenceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todoitem.FieldRecurrenceOccurrence,
+		})
+	}
+	if value, ok := tiu.mutation.SeriesID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldSeriesID,
+		})
+	}
+	if tiu.mutation.SeriesIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: todoitem.FieldSeriesID,
+		})
+	}
+	if value, ok := tiu.mutation.AutoComplete(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todoitem.FieldAutoComplete,
+		})
+	}
+	if value, ok := tiu.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todoitem.FieldPriority,
+		})
+	}
+	if value, ok := tiu.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todoitem.FieldPriority,
+		})
+	}
+	if value, ok := tiu.mutation.Rank(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldRank,
+		})
+	}
+	if value, ok := tiu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldCreatedAt,
+		})
+	}
+	if value, ok := tiu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldUpdatedAt,
+		})
+	}
+	if value, ok := tiu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldDeletedAt,
+		})
+	}
+	if tiu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todoitem.FieldDeletedAt,
+		})
+	}
+	if tiu.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ListTable,
+			Columns: []string{todoitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ListTable,
+			Columns: []string{todoitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ParentTable,
+			Columns: []string{todoitem.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ParentTable,
+			Columns: []string{todoitem.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.ChildrenTable,
+			Columns: []string{todoitem.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tiu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.ChildrenTable,
+			Columns: []string{todoitem.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.ChildrenTable,
+			Columns: []string{todoitem.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoitem.TagsTable,
+			Columns: todoitem.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tiu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoitem.TagsTable,
+			Columns: todoitem.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoitem.TagsTable,
+			Columns: todoitem.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.CommentsTable,
+			Columns: []string{todoitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tiu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.CommentsTable,
+			Columns: []string{todoitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.CommentsTable,
+			Columns: []string{todoitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiu.mutation.TimeEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.TimeEntriesTable,
+			Columns: []string{todoitem.TimeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: timeentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.RemovedTimeEntriesIDs(); len(nodes) > 0 && !tiu.mutation.TimeEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.TimeEntriesTable,
+			Columns: []string{todoitem.TimeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: timeentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.TimeEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.TimeEntriesTable,
+			Columns: []string{todoitem.TimeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: timeentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.AttachmentsTable,
+			Columns: []string{todoitem.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !tiu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.AttachmentsTable,
+			Columns: []string{todoitem.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.AttachmentsTable,
+			Columns: []string{todoitem.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todoitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TodoItemUpdateOne is the builder for updating a single TodoItem entity.
+type TodoItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoItemMutation
+}
+
+// SetTitle sets the "title" field.
+func (tiuo *TodoItemUpdateOne) SetTitle(s string) *TodoItemUpdateOne {
+	tiuo.mutation.SetTitle(s)
+	return tiuo
+}
+
+// SetNotes sets the "notes" field.
+func (tiuo *TodoItemUpdateOne) SetNotes(s string) *TodoItemUpdateOne {
+	tiuo.mutation.SetNotes(s)
+	return tiuo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableNotes(s *string) *TodoItemUpdateOne {
+	if s != nil {
+		tiuo.SetNotes(*s)
+	}
+	return tiuo
+}
+
+// SetAssignee sets the "assignee" field.
+func (tiuo *TodoItemUpdateOne) SetAssignee(s string) *TodoItemUpdateOne {
+	tiuo.mutation.SetAssignee(s)
+	return tiuo
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableAssignee(s *string) *TodoItemUpdateOne {
+	if s != nil {
+		tiuo.SetAssignee(*s)
+	}
+	return tiuo
+}
+
+// SetCompleted sets the "completed" field.
+func (tiuo *TodoItemUpdateOne) SetCompleted(b bool) *TodoItemUpdateOne {
+	tiuo.mutation.SetCompleted(b)
+	return tiuo
+}
+
+// SetOrder sets the "order" field.
+func (tiuo *TodoItemUpdateOne) SetOrder(i int) *TodoItemUpdateOne {
+	tiuo.mutation.ResetOrder()
+	tiuo.mutation.SetOrder(i)
+	return tiuo
+}
+
+// AddOrder adds i to the "order" field.
+func (tiuo *TodoItemUpdateOne) AddOrder(i int) *TodoItemUpdateOne {
+	tiuo.mutation.AddOrder(i)
+	return tiuo
+}
+
+// SetDueAt sets the "due_at" field.
+func (tiuo *TodoItemUpdateOne) SetDueAt(t time.Time) *TodoItemUpdateOne {
+	tiuo.mutation.SetDueAt(t)
+	return tiuo
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableDueAt(t *time.Time) *TodoItemUpdateOne {
+	if t != nil {
+		tiuo.SetDueAt(*t)
+	}
+	return tiuo
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (tiuo *TodoItemUpdateOne) ClearDueAt() *TodoItemUpdateOne {
+	tiuo.mutation.ClearDueAt()
+	return tiuo
+}
+
+// SetDueSoonNotified sets the "due_soon_notified" field.
+func (tiuo *TodoItemUpdateOne) SetDueSoonNotified(b bool) *TodoItemUpdateOne {
+	tiuo.mutation.SetDueSoonNotified(b)
+	return tiuo
+}
+
+// SetNillableDueSoonNotified sets the "due_soon_notified" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableDueSoonNotified(b *bool) *TodoItemUpdateOne {
+	if b != nil {
+		tiuo.SetDueSoonNotified(*b)
+	}
+	return tiuo
+}
+
+// SetOverdueNotified sets the "overdue_notified" field.
+func (tiuo *TodoItemUpdateOne) SetOverdueNotified(b bool) *TodoItemUpdateOne {
+	tiuo.mutation.SetOverdueNotified(b)
+	return tiuo
+}
+
+// SetNillableOverdueNotified sets the "overdue_notified" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableOverdueNotified(b *bool) *TodoItemUpdateOne {
+	if b != nil {
+		tiuo.SetOverdueNotified(*b)
+	}
+	return tiuo
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (tiuo *TodoItemUpdateOne) SetRecurrenceRule(s string) *TodoItemUpdateOne {
+	tiuo.mutation.SetRecurrenceRule(s)
+	return tiuo
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableRecurrenceRule(s *string) *TodoItemUpdateOne {
+	if s != nil {
+		tiuo.SetRecurrenceRule(*s)
+	}
+	return tiuo
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (tiuo *TodoItemUpdateOne) ClearRecurrenceRule() *TodoItemUpdateOne {
+	tiuo.mutation.ClearRecurrenceRule()
+	return tiuo
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (tiuo *TodoItemUpdateOne) SetRecurrenceTimezone(s string) *TodoItemUpdateOne {
+	tiuo.mutation.SetRecurrenceTimezone(s)
+	return tiuo
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableRecurrenceTimezone(s *string) *TodoItemUpdateOne {
+	if s != nil {
+		tiuo.SetRecurrenceTimezone(*s)
+	}
+	return tiuo
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (tiuo *TodoItemUpdateOne) ClearRecurrenceTimezone() *TodoItemUpdateOne {
+	tiuo.mutation.ClearRecurrenceTimezone()
+	return tiuo
+}
+
+// SetRecurrenceStart sets the "recurrence_start" field.
+func (tiuo *TodoItemUpdateOne) SetRecurrenceStart(t time.Time) *TodoItemUpdateOne {
+	tiuo.mutation.SetRecurrenceStart(t)
+	return tiuo
+}
+
+// SetNillableRecurrenceStart sets the "recurrence_start" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableRecurrenceStart(t *time.Time) *TodoItemUpdateOne {
+	if t != nil {
+		tiuo.SetRecurrenceStart(*t)
+	}
+	return tiuo
+}
+
+// ClearRecurrenceStart clears the value of the "recurrence_start" field.
+func (tiuo *TodoItemUpdateOne) ClearRecurrenceStart() *TodoItemUpdateOne {
+	tiuo.mutation.ClearRecurrenceStart()
+	return tiuo
+}
+
+// SetRecurrenceOccurrence sets the "recurrence_occurrence" field.
+func (tiuo *TodoItemUpdateOne) SetRecurrenceOccurrence(t time.Time) *TodoItemUpdateOne {
+	tiuo.mutation.SetRecurrenceOccurrence(t)
+	return tiuo
+}
+
+// SetNillableRecurrenceOccurrence sets the "recurrence_occurrence" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableRecurrenceOccurrence(t *time.Time) *TodoItemUpdateOne {
+	if t != nil {
+		tiuo.SetRecurrenceOccurrence(*t)
+	}
+	return tiuo
+}
+
+// ClearRecurrenceOccurrence clears the value of the "recurrence_occurrence" field.
+func (tiuo *TodoItemUpdateOne) ClearRecurrenceOccurrence() *TodoItemUpdateOne {
+	tiuo.mutation.ClearRecurrenceOccurrence()
+	return tiuo
+}
+
+// SetSeriesID sets the "series_id" field.
+func (tiuo *TodoItemUpdateOne) SetSeriesID(s string) *TodoItemUpdateOne {
+	tiuo.mutation.SetSeriesID(s)
+	return tiuo
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableSeriesID(s *string) *TodoItemUpdateOne {
+	if s != nil {
+		tiuo.SetSeriesID(*s)
+	}
+	return tiuo
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (tiuo *TodoItemUpdateOne) ClearSeriesID() *TodoItemUpdateOne {
+	tiuo.mutation.ClearSeriesID()
+	return tiuo
+}
+
+// SetAutoComplete sets the "auto_complete" field.
+func (tiuo *TodoItemUpdateOne) SetAutoComplete(b bool) *TodoItemUpdateOne {
+	tiuo.mutation.SetAutoComplete(b)
+	return tiuo
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableAutoComplete(b *bool) *TodoItemUpdateOne {
+	if b != nil {
+		tiuo.SetAutoComplete(*b)
+	}
+	return tiuo
+}
+
+// SetPriority sets the "priority" field.
+func (tiuo *TodoItemUpdateOne) SetPriority(i int) *TodoItemUpdateOne {
+	tiuo.mutation.ResetPriority()
+	tiuo.mutation.SetPriority(i)
+	return tiuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillablePriority(i *int) *TodoItemUpdateOne {
+	if i != nil {
+		tiuo.SetPriority(*i)
+	}
+	return tiuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (tiuo *TodoItemUpdateOne) AddPriority(i int) *TodoItemUpdateOne {
+	tiuo.mutation.AddPriority(i)
+	return tiuo
+}
+
+// SetRank sets the "rank" field.
+func (tiuo *TodoItemUpdateOne) SetRank(s string) *TodoItemUpdateOne {
+	tiuo.mutation.SetRank(s)
+	return tiuo
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableRank(s *string) *TodoItemUpdateOne {
+	if s != nil {
+		tiuo.SetRank(*s)
+	}
+	return tiuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tiuo *TodoItemUpdateOne) SetCreatedAt(t time.Time) *TodoItemUpdateOne {
+	tiuo.mutation.SetCreatedAt(t)
+	return tiuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableCreatedAt(t *time.Time) *TodoItemUpdateOne {
+	if t != nil {
+		tiuo.SetCreatedAt(*t)
+	}
+	return tiuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tiuo *TodoItemUpdateOne) SetUpdatedAt(t time.Time) *TodoItemUpdateOne {
+	tiuo.mutation.SetUpdatedAt(t)
+	return tiuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tiuo *TodoItemUpdateOne) SetDeletedAt(t time.Time) *TodoItemUpdateOne {
+	tiuo.mutation.SetDeletedAt(t)
+	return tiuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableDeletedAt(t *time.Time) *TodoItemUpdateOne {
+	if t != nil {
+		tiuo.SetDeletedAt(*t)
+	}
+	return tiuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tiuo *TodoItemUpdateOne) ClearDeletedAt() *TodoItemUpdateOne {
+	tiuo.mutation.ClearDeletedAt()
+	return tiuo
+}
+
+// SetListID sets the "list" edge to the TodoList entity by ID.
+func (tiuo *TodoItemUpdateOne) SetListID(id int) *TodoItemUpdateOne {
+	tiuo.mutation.SetListID(id)
+	return tiuo
+}
+
+// SetNillableListID sets the "list" edge to the TodoList entity by ID if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableListID(id *int) *TodoItemUpdateOne {
+	if id != nil {
+		tiuo = tiuo.SetListID(*id)
+	}
+	return tiuo
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (tiuo *TodoItemUpdateOne) SetList(t *TodoList) *TodoItemUpdateOne {
+	return tiuo.SetListID(t.ID)
+}
+
+// SetParentID sets the "parent" edge to the TodoItem entity by ID.
+func (tiuo *TodoItemUpdateOne) SetParentID(id int) *TodoItemUpdateOne {
+	tiuo.mutation.SetParentID(id)
+	return tiuo
+}
+
+// SetNillableParentID sets the "parent" edge to the TodoItem entity by ID if the given value is not nil.
+func (tiuo *TodoItemUpdateOne) SetNillableParentID(id *int) *TodoItemUpdateOne {
+	if id != nil {
+		tiuo = tiuo.SetParentID(*id)
+	}
+	return tiuo
+}
+
+// SetParent sets the "parent" edge to the TodoItem entity.
+func (tiuo *TodoItemUpdateOne) SetParent(t *TodoItem) *TodoItemUpdateOne {
+	return tiuo.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the TodoItem entity by IDs.
+func (tiuo *TodoItemUpdateOne) AddChildIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.AddChildIDs(ids...)
+	return tiuo
+}
+
+// AddChildren adds the "children" edges to the TodoItem entity.
+func (tiuo *TodoItemUpdateOne) AddChildren(t ...*TodoItem) *TodoItemUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiuo.AddChildIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tiuo *TodoItemUpdateOne) AddTagIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.AddTagIDs(ids...)
+	return tiuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tiuo *TodoItemUpdateOne) AddTags(t ...*Tag) *TodoItemUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiuo.AddTagIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tiuo *TodoItemUpdateOne) AddCommentIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.AddCommentIDs(ids...)
+	return tiuo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tiuo *TodoItemUpdateOne) AddComments(c ...*Comment) *TodoItemUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tiuo.AddCommentIDs(ids...)
+}
+
+// AddTimeEntryIDs adds the "time_entries" edge to the TimeEntry entity by IDs.
+func (tiuo *TodoItemUpdateOne) AddTimeEntryIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.AddTimeEntryIDs(ids...)
+	return tiuo
+}
+
+// AddTimeEntries adds the "time_entries" edges to the TimeEntry entity.
+func (tiuo *TodoItemUpdateOne) AddTimeEntries(t ...*TimeEntry) *TodoItemUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiuo.AddTimeEntryIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (tiuo *TodoItemUpdateOne) AddAttachmentIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.AddAttachmentIDs(ids...)
+	return tiuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (tiuo *TodoItemUpdateOne) AddAttachments(a ...*Attachment) *TodoItemUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tiu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{todoitem.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{err.Error(), err}
-		}
-		return 0, err
+	return tiuo.AddAttachmentIDs(ids...)
+}
+
+// Mutation returns the TodoItemMutation object of the builder.
+func (tiuo *TodoItemUpdateOne) Mutation() *TodoItemMutation {
+	return tiuo.mutation
+}
+
+// ClearList clears the "list" edge to the TodoList entity.
+func (tiuo *TodoItemUpdateOne) ClearList() *TodoItemUpdateOne {
+	tiuo.mutation.ClearList()
+	return tiuo
+}
+
+// ClearParent clears the "parent" edge to the TodoItem entity.
+func (tiuo *TodoItemUpdateOne) ClearParent() *TodoItemUpdateOne {
+	tiuo.mutation.ClearParent()
+	return tiuo
+}
+
+// ClearChildren clears all "children" edges to the TodoItem entity.
+func (tiuo *TodoItemUpdateOne) ClearChildren() *TodoItemUpdateOne {
+	tiuo.mutation.ClearChildren()
+	return tiuo
+}
+
+// RemoveChildIDs removes the "children" edge to TodoItem entities by IDs.
+func (tiuo *TodoItemUpdateOne) RemoveChildIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.RemoveChildIDs(ids...)
+	return tiuo
+}
+
+// RemoveChildren removes "children" edges to TodoItem entities.
+func (tiuo *TodoItemUpdateOne) RemoveChildren(t ...*TodoItem) *TodoItemUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	return n, nil
+	return tiuo.RemoveChildIDs(ids...)
 }
 
-// TodoItemUpdateOne is the builder for updating a single TodoItem entity.
-type TodoItemUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TodoItemMutation
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tiuo *TodoItemUpdateOne) ClearTags() *TodoItemUpdateOne {
+	tiuo.mutation.ClearTags()
+	return tiuo
 }
 
-// SetTitle sets the "title" field.
-func (tiuo *TodoItemUpdateOne) SetTitle(s string) *TodoItemUpdateOne {
-	tiuo.mutation.SetTitle(s)
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tiuo *TodoItemUpdateOne) RemoveTagIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.RemoveTagIDs(ids...)
 	return tiuo
 }
 
-// SetCompleted sets the "completed" field.
-func (tiuo *TodoItemUpdateOne) SetCompleted(b bool) *TodoItemUpdateOne {
-	tiuo.mutation.SetCompleted(b)
+// RemoveTags removes "tags" edges to Tag entities.
+func (tiuo *TodoItemUpdateOne) RemoveTags(t ...*Tag) *TodoItemUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tiuo.RemoveTagIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (tiuo *TodoItemUpdateOne) ClearComments() *TodoItemUpdateOne {
+	tiuo.mutation.ClearComments()
 	return tiuo
 }
 
-// SetOrder sets the "order" field.
-func (tiuo *TodoItemUpdateOne) SetOrder(i int) *TodoItemUpdateOne {
-	tiuo.mutation.ResetOrder()
-	tiuo.mutation.SetOrder(i)
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (tiuo *TodoItemUpdateOne) RemoveCommentIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.RemoveCommentIDs(ids...)
 	return tiuo
 }
 
-// AddOrder adds i to the "order" field.
-func (tiuo *TodoItemUpdateOne) AddOrder(i int) *TodoItemUpdateOne {
-	tiuo.mutation.AddOrder(i)
+// RemoveComments removes "comments" edges to Comment entities.
+func (tiuo *TodoItemUpdateOne) RemoveComments(c ...*Comment) *TodoItemUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tiuo.RemoveCommentIDs(ids...)
+}
+
+// ClearTimeEntries clears all "time_entries" edges to the TimeEntry entity.
+func (tiuo *TodoItemUpdateOne) ClearTimeEntries() *TodoItemUpdateOne {
+	tiuo.mutation.ClearTimeEntries()
 	return tiuo
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (tiuo *TodoItemUpdateOne) SetCreatedAt(t time.Time) *TodoItemUpdateOne {
-	tiuo.mutation.SetCreatedAt(t)
+// RemoveTimeEntryIDs removes the "time_entries" edge to TimeEntry entities by IDs.
+func (tiuo *TodoItemUpdateOne) RemoveTimeEntryIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.RemoveTimeEntryIDs(ids...)
 	return tiuo
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (tiuo *TodoItemUpdateOne) SetNillableCreatedAt(t *time.Time) *TodoItemUpdateOne {
-	if t != nil {
-		tiuo.SetCreatedAt(*t)
+// RemoveTimeEntries removes "time_entries" edges to TimeEntry entities.
+func (tiuo *TodoItemUpdateOne) RemoveTimeEntries(t ...*TimeEntry) *TodoItemUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tiuo.RemoveTimeEntryIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (tiuo *TodoItemUpdateOne) ClearAttachments() *TodoItemUpdateOne {
+	tiuo.mutation.ClearAttachments()
 	return tiuo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (tiuo *TodoItemUpdateOne) SetUpdatedAt(t time.Time) *TodoItemUpdateOne {
-	tiuo.mutation.SetUpdatedAt(t)
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (tiuo *TodoItemUpdateOne) RemoveAttachmentIDs(ids ...int) *TodoItemUpdateOne {
+	tiuo.mutation.RemoveAttachmentIDs(ids...)
 	return tiuo
 }
 
-// Mutation returns the TodoItemMutation object of the builder.
-func (tiuo *TodoItemUpdateOne) Mutation() *TodoItemMutation {
-	return tiuo.mutation
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (tiuo *TodoItemUpdateOne) RemoveAttachments(a ...*Attachment) *TodoItemUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tiuo.RemoveAttachmentIDs(ids...)
 }
 
 // Select allows selecting one or more fields (columns) of the returned entity.
@@ -284,6 +1742,9 @@ func (tiuo *TodoItemUpdateOne) Save(ctx context.Context) (*TodoItem, error) {
 	)
 	tiuo.defaults()
 	if len(tiuo.hooks) == 0 {
+		if err = tiuo.check(); err != nil {
+			return nil, err
+		}
 		node, err = tiuo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -291,6 +1752,9 @@ func (tiuo *TodoItemUpdateOne) Save(ctx context.Context) (*TodoItem, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = tiuo.check(); err != nil {
+				return nil, err
+			}
 			tiuo.mutation = mutation
 			node, err = tiuo.sqlSave(ctx)
 			mutation.done = true
@@ -339,6 +1803,16 @@ func (tiuo *TodoItemUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (tiuo *TodoItemUpdateOne) check() error {
+	if v, ok := tiuo.mutation.SeriesID(); ok {
+		if err := todoitem.SeriesIDValidator(v); err != nil {
+			return &ValidationError{Name: "series_id", err: fmt.Errorf("ent: validator failed for field \"series_id\": %w", err)}
+		}
+	}
+	return nil
+}
+
 func (tiuo *TodoItemUpdateOne) sqlSave(ctx context.Context) (_node *TodoItem, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -381,6 +1855,20 @@ func (tiuo *TodoItemUpdateOne) sqlSave(ctx context.Context) (_node *TodoItem, er
 			Column: todoitem.FieldTitle,
 		})
 	}
+	if value, ok := tiuo.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldNotes,
+		})
+	}
+	if value, ok := tiuo.mutation.Assignee(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldAssignee,
+		})
+	}
 	if value, ok := tiuo.mutation.Completed(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -402,6 +1890,126 @@ func (tiuo *TodoItemUpdateOne) sqlSave(ctx context.Context) (_node *TodoItem, er
 			Column: todoitem.FieldOrder,
 		})
 	}
+	if value, ok := tiuo.mutation.DueAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldDueAt,
+		})
+	}
+	if tiuo.mutation.DueAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todoitem.FieldDueAt,
+		})
+	}
+	if value, ok := tiuo.mutation.DueSoonNotified(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todoitem.FieldDueSoonNotified,
+		})
+	}
+	if value, ok := tiuo.mutation.OverdueNotified(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todoitem.FieldOverdueNotified,
+		})
+	}
+	if value, ok := tiuo.mutation.RecurrenceRule(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceRule,
+		})
+	}
+	if tiuo.mutation.RecurrenceRuleCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: todoitem.FieldRecurrenceRule,
+		})
+	}
+	if value, ok := tiuo.mutation.RecurrenceTimezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceTimezone,
+		})
+	}
+	if tiuo.mutation.RecurrenceTimezoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: todoitem.FieldRecurrenceTimezone,
+		})
+	}
+	if value, ok := tiuo.mutation.RecurrenceStart(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceStart,
+		})
+	}
+	if tiuo.mutation.RecurrenceStartCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todoitem.FieldRecurrenceStart,
+		})
+	}
+	if value, ok := tiuo.mutation.RecurrenceOccurrence(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldRecurrenceOccurrence,
+		})
+	}
+	if tiuo.mutation.RecurrenceOccurrenceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todoitem.FieldRecurrenceOccurrence,
+		})
+	}
+	if value, ok := tiuo.mutation.SeriesID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldSeriesID,
+		})
+	}
+	if tiuo.mutation.SeriesIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: todoitem.FieldSeriesID,
+		})
+	}
+	if value, ok := tiuo.mutation.AutoComplete(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todoitem.FieldAutoComplete,
+		})
+	}
+	if value, ok := tiuo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todoitem.FieldPriority,
+		})
+	}
+	if value, ok := tiuo.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todoitem.FieldPriority,
+		})
+	}
+	if value, ok := tiuo.mutation.Rank(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todoitem.FieldRank,
+		})
+	}
 	if value, ok := tiuo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -416,6 +2024,359 @@ func (tiuo *TodoItemUpdateOne) sqlSave(ctx context.Context) (_node *TodoItem, er
 			Column: todoitem.FieldUpdatedAt,
 		})
 	}
+	if value, ok := tiuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todoitem.FieldDeletedAt,
+		})
+	}
+	if tiuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todoitem.FieldDeletedAt,
+		})
+	}
+	if tiuo.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ListTable,
+			Columns: []string{todoitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ListTable,
+			Columns: []string{todoitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todolist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ParentTable,
+			Columns: []string{todoitem.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoitem.ParentTable,
+			Columns: []string{todoitem.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.ChildrenTable,
+			Columns: []string{todoitem.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tiuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.ChildrenTable,
+			Columns: []string{todoitem.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.ChildrenTable,
+			Columns: []string{todoitem.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoitem.TagsTable,
+			Columns: todoitem.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tiuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoitem.TagsTable,
+			Columns: todoitem.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoitem.TagsTable,
+			Columns: todoitem.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.CommentsTable,
+			Columns: []string{todoitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tiuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.CommentsTable,
+			Columns: []string{todoitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.CommentsTable,
+			Columns: []string{todoitem.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiuo.mutation.TimeEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.TimeEntriesTable,
+			Columns: []string{todoitem.TimeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: timeentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.RemovedTimeEntriesIDs(); len(nodes) > 0 && !tiuo.mutation.TimeEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.TimeEntriesTable,
+			Columns: []string{todoitem.TimeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: timeentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.TimeEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.TimeEntriesTable,
+			Columns: []string{todoitem.TimeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: timeentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tiuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.AttachmentsTable,
+			Columns: []string{todoitem.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !tiuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.AttachmentsTable,
+			Columns: []string{todoitem.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tiuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoitem.AttachmentsTable,
+			Columns: []string{todoitem.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &TodoItem{config: tiuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
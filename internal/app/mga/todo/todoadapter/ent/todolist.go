@@ -0,0 +1,212 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// TodoList is the model entity for the TodoList schema.
+type TodoList struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Archived holds the value of the "archived" field.
+	Archived bool `json:"archived,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoListQuery when eager-loading is set.
+	Edges TodoListEdges `json:"edges"`
+}
+
+// TodoListEdges holds the relations/edges for other nodes in the graph.
+type TodoListEdges struct {
+	// Items holds the value of the items edge.
+	Items []*TodoItem `json:"items,omitempty"`
+	// ShareTokens holds the value of the share_tokens edge.
+	ShareTokens []*ShareToken `json:"share_tokens,omitempty"`
+	// Collaborators holds the value of the collaborators edge.
+	Collaborators []*Collaborator `json:"collaborators,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoListEdges) ItemsOrErr() ([]*TodoItem, error) {
+	if e.loadedTypes[0] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// ShareTokensOrErr returns the ShareTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoListEdges) ShareTokensOrErr() ([]*ShareToken, error) {
+	if e.loadedTypes[1] {
+		return e.ShareTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "share_tokens"}
+}
+
+// CollaboratorsOrErr returns the Collaborators value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoListEdges) CollaboratorsOrErr() ([]*Collaborator, error) {
+	if e.loadedTypes[2] {
+		return e.Collaborators, nil
+	}
+	return nil, &NotLoadedError{edge: "collaborators"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoList) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todolist.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case todolist.FieldID:
+			values[i] = new(sql.NullInt64)
+		case todolist.FieldUID, todolist.FieldName, todolist.FieldDescription:
+			values[i] = new(sql.NullString)
+		case todolist.FieldCreatedAt, todolist.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type TodoList", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoList fields.
+func (tl *TodoList) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todolist.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tl.ID = int(value.Int64)
+		case todolist.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				tl.UID = value.String
+			}
+		case todolist.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				tl.Name = value.String
+			}
+		case todolist.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				tl.Description = value.String
+			}
+		case todolist.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				tl.Archived = value.Bool
+			}
+		case todolist.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tl.CreatedAt = value.Time
+			}
+		case todolist.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tl.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryItems queries the "items" edge of the TodoList entity.
+func (tl *TodoList) QueryItems() *TodoItemQuery {
+	return (&TodoListClient{config: tl.config}).QueryItems(tl)
+}
+
+// QueryShareTokens queries the "share_tokens" edge of the TodoList entity.
+func (tl *TodoList) QueryShareTokens() *ShareTokenQuery {
+	return (&TodoListClient{config: tl.config}).QueryShareTokens(tl)
+}
+
+// QueryCollaborators queries the "collaborators" edge of the TodoList entity.
+func (tl *TodoList) QueryCollaborators() *CollaboratorQuery {
+	return (&TodoListClient{config: tl.config}).QueryCollaborators(tl)
+}
+
+// Update returns a builder for updating this TodoList.
+// Note that you need to call TodoList.Unwrap() before calling this method if this TodoList
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tl *TodoList) Update() *TodoListUpdateOne {
+	return (&TodoListClient{config: tl.config}).UpdateOne(tl)
+}
+
+// Unwrap unwraps the TodoList entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tl *TodoList) Unwrap() *TodoList {
+	tx, ok := tl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoList is not a transactional entity")
+	}
+	tl.config.driver = tx.drv
+	return tl
+}
+
+// String implements the fmt.Stringer.
+func (tl *TodoList) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoList(")
+	builder.WriteString(fmt.Sprintf("id=%v", tl.ID))
+	builder.WriteString(", uid=")
+	builder.WriteString(tl.UID)
+	builder.WriteString(", name=")
+	builder.WriteString(tl.Name)
+	builder.WriteString(", description=")
+	builder.WriteString(tl.Description)
+	builder.WriteString(", archived=")
+	builder.WriteString(fmt.Sprintf("%v", tl.Archived))
+	builder.WriteString(", created_at=")
+	builder.WriteString(tl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(tl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoLists is a parsable slice of TodoList.
+type TodoLists []*TodoList
+
+func (tl TodoLists) config(cfg config) {
+	for _i := range tl {
+		tl[_i].config = cfg
+	}
+}
@@ -0,0 +1,93 @@
+// Code generated by entc, DO NOT EDIT.
+
+package todolist
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the todolist type in the database.
+	Label = "todo_list"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeItems holds the string denoting the items edge name in mutations.
+	EdgeItems = "items"
+	// EdgeShareTokens holds the string denoting the share_tokens edge name in mutations.
+	EdgeShareTokens = "share_tokens"
+	// EdgeCollaborators holds the string denoting the collaborators edge name in mutations.
+	EdgeCollaborators = "collaborators"
+	// Table holds the table name of the todolist in the database.
+	Table = "todo_lists"
+	// ItemsTable is the table that holds the items relation/edge.
+	ItemsTable = "todo_items"
+	// ItemsInverseTable is the table name for the TodoItem entity.
+	// It exists in this package in order to avoid circular dependency with the "todoitem" package.
+	ItemsInverseTable = "todo_items"
+	// ItemsColumn is the table column denoting the items relation/edge.
+	ItemsColumn = "todo_list_items"
+	// ShareTokensTable is the table that holds the share_tokens relation/edge.
+	ShareTokensTable = "share_tokens"
+	// ShareTokensInverseTable is the table name for the ShareToken entity.
+	// It exists in this package in order to avoid circular dependency with the "sharetoken" package.
+	ShareTokensInverseTable = "share_tokens"
+	// ShareTokensColumn is the table column denoting the share_tokens relation/edge.
+	ShareTokensColumn = "todo_list_share_tokens"
+	// CollaboratorsTable is the table that holds the collaborators relation/edge.
+	CollaboratorsTable = "collaborators"
+	// CollaboratorsInverseTable is the table name for the Collaborator entity.
+	// It exists in this package in order to avoid circular dependency with the "collaborator" package.
+	CollaboratorsInverseTable = "collaborators"
+	// CollaboratorsColumn is the table column denoting the collaborators relation/edge.
+	CollaboratorsColumn = "todo_list_collaborators"
+)
+
+// Columns holds all SQL columns for todolist fields.
+var Columns = []string{
+	FieldID,
+	FieldUID,
+	FieldName,
+	FieldDescription,
+	FieldArchived,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	UIDValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultDescription holds the default value on creation for the "description" field.
+	DefaultDescription string
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,475 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// TodoListCreate is the builder for creating a TodoList entity.
+type TodoListCreate struct {
+	config
+	mutation *TodoListMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (tlc *TodoListCreate) SetUID(s string) *TodoListCreate {
+	tlc.mutation.SetUID(s)
+	return tlc
+}
+
+// SetName sets the "name" field.
+func (tlc *TodoListCreate) SetName(s string) *TodoListCreate {
+	tlc.mutation.SetName(s)
+	return tlc
+}
+
+// SetDescription sets the "description" field.
+func (tlc *TodoListCreate) SetDescription(s string) *TodoListCreate {
+	tlc.mutation.SetDescription(s)
+	return tlc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tlc *TodoListCreate) SetNillableDescription(s *string) *TodoListCreate {
+	if s != nil {
+		tlc.SetDescription(*s)
+	}
+	return tlc
+}
+
+// SetArchived sets the "archived" field.
+func (tlc *TodoListCreate) SetArchived(b bool) *TodoListCreate {
+	tlc.mutation.SetArchived(b)
+	return tlc
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (tlc *TodoListCreate) SetNillableArchived(b *bool) *TodoListCreate {
+	if b != nil {
+		tlc.SetArchived(*b)
+	}
+	return tlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tlc *TodoListCreate) SetCreatedAt(t time.Time) *TodoListCreate {
+	tlc.mutation.SetCreatedAt(t)
+	return tlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tlc *TodoListCreate) SetNillableCreatedAt(t *time.Time) *TodoListCreate {
+	if t != nil {
+		tlc.SetCreatedAt(*t)
+	}
+	return tlc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tlc *TodoListCreate) SetUpdatedAt(t time.Time) *TodoListCreate {
+	tlc.mutation.SetUpdatedAt(t)
+	return tlc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tlc *TodoListCreate) SetNillableUpdatedAt(t *time.Time) *TodoListCreate {
+	if t != nil {
+		tlc.SetUpdatedAt(*t)
+	}
+	return tlc
+}
+
+// AddItemIDs adds the "items" edge to the TodoItem entity by IDs.
+func (tlc *TodoListCreate) AddItemIDs(ids ...int) *TodoListCreate {
+	tlc.mutation.AddItemIDs(ids...)
+	return tlc
+}
+
+// AddItems adds the "items" edges to the TodoItem entity.
+func (tlc *TodoListCreate) AddItems(t ...*TodoItem) *TodoListCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tlc.AddItemIDs(ids...)
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by IDs.
+func (tlc *TodoListCreate) AddShareTokenIDs(ids ...int) *TodoListCreate {
+	tlc.mutation.AddShareTokenIDs(ids...)
+	return tlc
+}
+
+// AddShareTokens adds the "share_tokens" edges to the ShareToken entity.
+func (tlc *TodoListCreate) AddShareTokens(s ...*ShareToken) *TodoListCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tlc.AddShareTokenIDs(ids...)
+}
+
+// AddCollaboratorIDs adds the "collaborators" edge to the Collaborator entity by IDs.
+func (tlc *TodoListCreate) AddCollaboratorIDs(ids ...int) *TodoListCreate {
+	tlc.mutation.AddCollaboratorIDs(ids...)
+	return tlc
+}
+
+// AddCollaborators adds the "collaborators" edges to the Collaborator entity.
+func (tlc *TodoListCreate) AddCollaborators(c ...*Collaborator) *TodoListCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tlc.AddCollaboratorIDs(ids...)
+}
+
+// Mutation returns the TodoListMutation object of the builder.
+func (tlc *TodoListCreate) Mutation() *TodoListMutation {
+	return tlc.mutation
+}
+
+// Save creates the TodoList in the database.
+func (tlc *TodoListCreate) Save(ctx context.Context) (*TodoList, error) {
+	var (
+		err  error
+		node *TodoList
+	)
+	tlc.defaults()
+	if len(tlc.hooks) == 0 {
+		if err = tlc.check(); err != nil {
+			return nil, err
+		}
+		node, err = tlc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TodoListMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tlc.check(); err != nil {
+				return nil, err
+			}
+			tlc.mutation = mutation
+			if node, err = tlc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tlc.hooks) - 1; i >= 0; i-- {
+			if tlc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tlc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tlc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tlc *TodoListCreate) SaveX(ctx context.Context) *TodoList {
+	v, err := tlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tlc *TodoListCreate) Exec(ctx context.Context) error {
+	_, err := tlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tlc *TodoListCreate) ExecX(ctx context.Context) {
+	if err := tlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tlc *TodoListCreate) defaults() {
+	if _, ok := tlc.mutation.Description(); !ok {
+		v := todolist.DefaultDescription
+		tlc.mutation.SetDescription(v)
+	}
+	if _, ok := tlc.mutation.Archived(); !ok {
+		v := todolist.DefaultArchived
+		tlc.mutation.SetArchived(v)
+	}
+	if _, ok := tlc.mutation.CreatedAt(); !ok {
+		v := todolist.DefaultCreatedAt()
+		tlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tlc.mutation.UpdatedAt(); !ok {
+		v := todolist.DefaultUpdatedAt()
+		tlc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tlc *TodoListCreate) check() error {
+	if _, ok := tlc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "uid"`)}
+	}
+	if v, ok := tlc.mutation.UID(); ok {
+		if err := todolist.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "uid": %w`, err)}
+		}
+	}
+	if _, ok := tlc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "name"`)}
+	}
+	if v, ok := tlc.mutation.Name(); ok {
+		if err := todolist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "name": %w`, err)}
+		}
+	}
+	if _, ok := tlc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "description"`)}
+	}
+	if _, ok := tlc.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "archived"`)}
+	}
+	if _, ok := tlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
+	}
+	if _, ok := tlc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "updated_at"`)}
+	}
+	return nil
+}
+
+func (tlc *TodoListCreate) sqlSave(ctx context.Context) (*TodoList, error) {
+	_node, _spec := tlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (tlc *TodoListCreate) createSpec() (*TodoList, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoList{config: tlc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: todolist.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: todolist.FieldID,
+			},
+		}
+	)
+	if value, ok := tlc.mutation.UID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todolist.FieldUID,
+		})
+		_node.UID = value
+	}
+	if value, ok := tlc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todolist.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := tlc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todolist.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := tlc.mutation.Archived(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todolist.FieldArchived,
+		})
+		_node.Archived = value
+	}
+	if value, ok := tlc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todolist.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := tlc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todolist.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := tlc.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ItemsTable,
+			Columns: []string{todolist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tlc.mutation.ShareTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ShareTokensTable,
+			Columns: []string{todolist.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sharetoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tlc.mutation.CollaboratorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.CollaboratorsTable,
+			Columns: []string{todolist.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: collaborator.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoListCreateBulk is the builder for creating many TodoList entities in bulk.
+type TodoListCreateBulk struct {
+	config
+	builders []*TodoListCreate
+}
+
+// Save creates the TodoList entities in the database.
+func (tlcb *TodoListCreateBulk) Save(ctx context.Context) ([]*TodoList, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(tlcb.builders))
+	nodes := make([]*TodoList, len(tlcb.builders))
+	mutators := make([]Mutator, len(tlcb.builders))
+	for i := range tlcb.builders {
+		func(i int, root context.Context) {
+			builder := tlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoListMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tlcb *TodoListCreateBulk) SaveX(ctx context.Context) []*TodoList {
+	v, err := tlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tlcb *TodoListCreateBulk) Exec(ctx context.Context) error {
+	_, err := tlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tlcb *TodoListCreateBulk) ExecX(ctx context.Context) {
+	if err := tlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// TodoListDelete is the builder for deleting a TodoList entity.
+type TodoListDelete struct {
+	config
+	hooks    []Hook
+	mutation *TodoListMutation
+}
+
+// Where appends a list predicates to the TodoListDelete builder.
+func (tld *TodoListDelete) Where(ps ...predicate.TodoList) *TodoListDelete {
+	tld.mutation.Where(ps...)
+	return tld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tld *TodoListDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tld.hooks) == 0 {
+		affected, err = tld.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TodoListMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tld.mutation = mutation
+			affected, err = tld.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tld.hooks) - 1; i >= 0; i-- {
+			if tld.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tld.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tld.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tld *TodoListDelete) ExecX(ctx context.Context) int {
+	n, err := tld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tld *TodoListDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: todolist.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: todolist.FieldID,
+			},
+		},
+	}
+	if ps := tld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, tld.driver, _spec)
+}
+
+// TodoListDeleteOne is the builder for deleting a single TodoList entity.
+type TodoListDeleteOne struct {
+	tld *TodoListDelete
+}
+
+// Exec executes the deletion query.
+func (tldo *TodoListDeleteOne) Exec(ctx context.Context) error {
+	n, err := tldo.tld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{todolist.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tldo *TodoListDeleteOne) ExecX(ctx context.Context) {
+	tldo.tld.ExecX(ctx)
+}
@@ -0,0 +1,1117 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// TodoListQuery is the builder for querying TodoList entities.
+type TodoListQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.TodoList
+	// eager-loading edges.
+	withItems         *TodoItemQuery
+	withShareTokens   *ShareTokenQuery
+	withCollaborators *CollaboratorQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TodoListQuery builder.
+func (tlq *TodoListQuery) Where(ps ...predicate.TodoList) *TodoListQuery {
+	tlq.predicates = append(tlq.predicates, ps...)
+	return tlq
+}
+
+// Limit adds a limit step to the query.
+func (tlq *TodoListQuery) Limit(limit int) *TodoListQuery {
+	tlq.limit = &limit
+	return tlq
+}
+
+// Offset adds an offset step to the query.
+func (tlq *TodoListQuery) Offset(offset int) *TodoListQuery {
+	tlq.offset = &offset
+	return tlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tlq *TodoListQuery) Unique(unique bool) *TodoListQuery {
+	tlq.unique = &unique
+	return tlq
+}
+
+// Order adds an order step to the query.
+func (tlq *TodoListQuery) Order(o ...OrderFunc) *TodoListQuery {
+	tlq.order = append(tlq.order, o...)
+	return tlq
+}
+
+// QueryItems chains the current query on the "items" edge.
+func (tlq *TodoListQuery) QueryItems() *TodoItemQuery {
+	query := &TodoItemQuery{config: tlq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, selector),
+			sqlgraph.To(todoitem.Table, todoitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todolist.ItemsTable, todolist.ItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShareTokens chains the current query on the "share_tokens" edge.
+func (tlq *TodoListQuery) QueryShareTokens() *ShareTokenQuery {
+	query := &ShareTokenQuery{config: tlq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, selector),
+			sqlgraph.To(sharetoken.Table, sharetoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todolist.ShareTokensTable, todolist.ShareTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCollaborators chains the current query on the "collaborators" edge.
+func (tlq *TodoListQuery) QueryCollaborators() *CollaboratorQuery {
+	query := &CollaboratorQuery{config: tlq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, selector),
+			sqlgraph.To(collaborator.Table, collaborator.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todolist.CollaboratorsTable, todolist.CollaboratorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TodoList entity from the query.
+// Returns a *NotFoundError when no TodoList was found.
+func (tlq *TodoListQuery) First(ctx context.Context) (*TodoList, error) {
+	nodes, err := tlq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{todolist.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tlq *TodoListQuery) FirstX(ctx context.Context) *TodoList {
+	node, err := tlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TodoList ID from the query.
+// Returns a *NotFoundError when no TodoList ID was found.
+func (tlq *TodoListQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tlq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{todolist.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tlq *TodoListQuery) FirstIDX(ctx context.Context) int {
+	id, err := tlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TodoList entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one TodoList entity is not found.
+// Returns a *NotFoundError when no TodoList entities are found.
+func (tlq *TodoListQuery) Only(ctx context.Context) (*TodoList, error) {
+	nodes, err := tlq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{todolist.Label}
+	default:
+		return nil, &NotSingularError{todolist.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tlq *TodoListQuery) OnlyX(ctx context.Context) *TodoList {
+	node, err := tlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TodoList ID in the query.
+// Returns a *NotSingularError when exactly one TodoList ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (tlq *TodoListQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tlq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{todolist.Label}
+	default:
+		err = &NotSingularError{todolist.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tlq *TodoListQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TodoLists.
+func (tlq *TodoListQuery) All(ctx context.Context) ([]*TodoList, error) {
+	if err := tlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return tlq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tlq *TodoListQuery) AllX(ctx context.Context) []*TodoList {
+	nodes, err := tlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TodoList IDs.
+func (tlq *TodoListQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := tlq.Select(todolist.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tlq *TodoListQuery) IDsX(ctx context.Context) []int {
+	ids, err := tlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tlq *TodoListQuery) Count(ctx context.Context) (int, error) {
+	if err := tlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return tlq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tlq *TodoListQuery) CountX(ctx context.Context) int {
+	count, err := tlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tlq *TodoListQuery) Exist(ctx context.Context) (bool, error) {
+	if err := tlq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return tlq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tlq *TodoListQuery) ExistX(ctx context.Context) bool {
+	exist, err := tlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TodoListQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tlq *TodoListQuery) Clone() *TodoListQuery {
+	if tlq == nil {
+		return nil
+	}
+	return &TodoListQuery{
+		config:            tlq.config,
+		limit:             tlq.limit,
+		offset:            tlq.offset,
+		order:             append([]OrderFunc{}, tlq.order...),
+		predicates:        append([]predicate.TodoList{}, tlq.predicates...),
+		withItems:         tlq.withItems.Clone(),
+		withShareTokens:   tlq.withShareTokens.Clone(),
+		withCollaborators: tlq.withCollaborators.Clone(),
+		// clone intermediate query.
+		sql:  tlq.sql.Clone(),
+		path: tlq.path,
+	}
+}
+
+// WithItems tells the query-builder to eager-load the nodes that are connected to
+// the "items" edge. The optional arguments are used to configure the query builder of the edge.
+func (tlq *TodoListQuery) WithItems(opts ...func(*TodoItemQuery)) *TodoListQuery {
+	query := &TodoItemQuery{config: tlq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tlq.withItems = query
+	return tlq
+}
+
+// WithShareTokens tells the query-builder to eager-load the nodes that are connected to
+// the "share_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (tlq *TodoListQuery) WithShareTokens(opts ...func(*ShareTokenQuery)) *TodoListQuery {
+	query := &ShareTokenQuery{config: tlq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tlq.withShareTokens = query
+	return tlq
+}
+
+// WithCollaborators tells the query-builder to eager-load the nodes that are connected to
+// the "collaborators" edge. The optional arguments are used to configure the query builder of the edge.
+func (tlq *TodoListQuery) WithCollaborators(opts ...func(*CollaboratorQuery)) *TodoListQuery {
+	query := &CollaboratorQuery{config: tlq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tlq.withCollaborators = query
+	return tlq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TodoList.Query().
+//		GroupBy(todolist.FieldUID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tlq *TodoListQuery) GroupBy(field string, fields ...string) *TodoListGroupBy {
+	group := &TodoListGroupBy{config: tlq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := tlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return tlq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UID string `json:"uid,omitempty"`
+//	}
+//
+//	client.TodoList.Query().
+//		Select(todolist.FieldUID).
+//		Scan(ctx, &v)
+func (tlq *TodoListQuery) Select(fields ...string) *TodoListSelect {
+	tlq.fields = append(tlq.fields, fields...)
+	return &TodoListSelect{TodoListQuery: tlq}
+}
+
+func (tlq *TodoListQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range tlq.fields {
+		if !todolist.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tlq.path != nil {
+		prev, err := tlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tlq.sql = prev
+	}
+	return nil
+}
+
+func (tlq *TodoListQuery) sqlAll(ctx context.Context) ([]*TodoList, error) {
+	var (
+		nodes       = []*TodoList{}
+		_spec       = tlq.querySpec()
+		loadedTypes = [3]bool{
+			tlq.withItems != nil,
+			tlq.withShareTokens != nil,
+			tlq.withCollaborators != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &TodoList{config: tlq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := tlq.withItems; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*TodoList)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Items = []*TodoItem{}
+		}
+		query.withFKs = true
+		query.Where(predicate.TodoItem(func(s *sql.Selector) {
+			s.Where(sql.InValues(todolist.ItemsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.todo_list_items
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "todo_list_items" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_list_items" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Items = append(node.Edges.Items, n)
+		}
+	}
+
+	if query := tlq.withShareTokens; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*TodoList)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.ShareTokens = []*ShareToken{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ShareToken(func(s *sql.Selector) {
+			s.Where(sql.InValues(todolist.ShareTokensColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.todo_list_share_tokens
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "todo_list_share_tokens" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_list_share_tokens" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.ShareTokens = append(node.Edges.ShareTokens, n)
+		}
+	}
+
+	if query := tlq.withCollaborators; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*TodoList)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Collaborators = []*Collaborator{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Collaborator(func(s *sql.Selector) {
+			s.Where(sql.InValues(todolist.CollaboratorsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.todo_list_collaborators
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "todo_list_collaborators" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_list_collaborators" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Collaborators = append(node.Edges.Collaborators, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (tlq *TodoListQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tlq.querySpec()
+	return sqlgraph.CountNodes(ctx, tlq.driver, _spec)
+}
+
+func (tlq *TodoListQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := tlq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (tlq *TodoListQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   todolist.Table,
+			Columns: todolist.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: todolist.FieldID,
+			},
+		},
+		From:   tlq.sql,
+		Unique: true,
+	}
+	if unique := tlq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := tlq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todolist.FieldID)
+		for i := range fields {
+			if fields[i] != todolist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tlq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tlq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tlq *TodoListQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tlq.driver.Dialect())
+	t1 := builder.Table(todolist.Table)
+	columns := tlq.fields
+	if len(columns) == 0 {
+		columns = todolist.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tlq.sql != nil {
+		selector = tlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range tlq.predicates {
+		p(selector)
+	}
+	for _, p := range tlq.order {
+		p(selector)
+	}
+	if offset := tlq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tlq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TodoListGroupBy is the group-by builder for TodoList entities.
+type TodoListGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tlgb *TodoListGroupBy) Aggregate(fns ...AggregateFunc) *TodoListGroupBy {
+	tlgb.fns = append(tlgb.fns, fns...)
+	return tlgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (tlgb *TodoListGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := tlgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	tlgb.sql = query
+	return tlgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tlgb *TodoListGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := tlgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tlgb *TodoListGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(tlgb.fields) > 1 {
+		return nil, errors.New("ent: TodoListGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := tlgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tlgb *TodoListGroupBy) StringsX(ctx context.Context) []string {
+	v, err := tlgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tlgb *TodoListGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tlgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todolist.Label}
+	default:
+		err = fmt.Errorf("ent: TodoListGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tlgb *TodoListGroupBy) StringX(ctx context.Context) string {
+	v, err := tlgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tlgb *TodoListGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(tlgb.fields) > 1 {
+		return nil, errors.New("ent: TodoListGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := tlgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tlgb *TodoListGroupBy) IntsX(ctx context.Context) []int {
+	v, err := tlgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tlgb *TodoListGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tlgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todolist.Label}
+	default:
+		err = fmt.Errorf("ent: TodoListGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tlgb *TodoListGroupBy) IntX(ctx context.Context) int {
+	v, err := tlgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tlgb *TodoListGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tlgb.fields) > 1 {
+		return nil, errors.New("ent: TodoListGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := tlgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tlgb *TodoListGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := tlgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tlgb *TodoListGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tlgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todolist.Label}
+	default:
+		err = fmt.Errorf("ent: TodoListGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tlgb *TodoListGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := tlgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tlgb *TodoListGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(tlgb.fields) > 1 {
+		return nil, errors.New("ent: TodoListGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := tlgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tlgb *TodoListGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := tlgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tlgb *TodoListGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tlgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todolist.Label}
+	default:
+		err = fmt.Errorf("ent: TodoListGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tlgb *TodoListGroupBy) BoolX(ctx context.Context) bool {
+	v, err := tlgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tlgb *TodoListGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range tlgb.fields {
+		if !todolist.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := tlgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tlgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (tlgb *TodoListGroupBy) sqlQuery() *sql.Selector {
+	selector := tlgb.sql.Select()
+	aggregation := make([]string, 0, len(tlgb.fns))
+	for _, fn := range tlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(tlgb.fields)+len(tlgb.fns))
+		for _, f := range tlgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(tlgb.fields...)...)
+}
+
+// TodoListSelect is the builder for selecting fields of TodoList entities.
+type TodoListSelect struct {
+	*TodoListQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tls *TodoListSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := tls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	tls.sql = tls.TodoListQuery.sqlQuery(ctx)
+	return tls.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tls *TodoListSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := tls.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (tls *TodoListSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(tls.fields) > 1 {
+		return nil, errors.New("ent: TodoListSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := tls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tls *TodoListSelect) StringsX(ctx context.Context) []string {
+	v, err := tls.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (tls *TodoListSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tls.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todolist.Label}
+	default:
+		err = fmt.Errorf("ent: TodoListSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tls *TodoListSelect) StringX(ctx context.Context) string {
+	v, err := tls.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (tls *TodoListSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(tls.fields) > 1 {
+		return nil, errors.New("ent: TodoListSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := tls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tls *TodoListSelect) IntsX(ctx context.Context) []int {
+	v, err := tls.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (tls *TodoListSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tls.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todolist.Label}
+	default:
+		err = fmt.Errorf("ent: TodoListSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tls *TodoListSelect) IntX(ctx context.Context) int {
+	v, err := tls.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (tls *TodoListSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tls.fields) > 1 {
+		return nil, errors.New("ent: TodoListSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := tls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tls *TodoListSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := tls.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (tls *TodoListSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tls.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todolist.Label}
+	default:
+		err = fmt.Errorf("ent: TodoListSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tls *TodoListSelect) Float64X(ctx context.Context) float64 {
+	v, err := tls.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (tls *TodoListSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(tls.fields) > 1 {
+		return nil, errors.New("ent: TodoListSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := tls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tls *TodoListSelect) BoolsX(ctx context.Context) []bool {
+	v, err := tls.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (tls *TodoListSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tls.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todolist.Label}
+	default:
+		err = fmt.Errorf("ent: TodoListSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tls *TodoListSelect) BoolX(ctx context.Context) bool {
+	v, err := tls.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tls *TodoListSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := tls.sql.Query()
+	if err := tls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
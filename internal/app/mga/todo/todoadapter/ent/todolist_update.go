@@ -0,0 +1,1010 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// TodoListUpdate is the builder for updating TodoList entities.
+type TodoListUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoListMutation
+}
+
+// Where appends a list predicates to the TodoListUpdate builder.
+func (tlu *TodoListUpdate) Where(ps ...predicate.TodoList) *TodoListUpdate {
+	tlu.mutation.Where(ps...)
+	return tlu
+}
+
+// SetName sets the "name" field.
+func (tlu *TodoListUpdate) SetName(s string) *TodoListUpdate {
+	tlu.mutation.SetName(s)
+	return tlu
+}
+
+// SetDescription sets the "description" field.
+func (tlu *TodoListUpdate) SetDescription(s string) *TodoListUpdate {
+	tlu.mutation.SetDescription(s)
+	return tlu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tlu *TodoListUpdate) SetNillableDescription(s *string) *TodoListUpdate {
+	if s != nil {
+		tlu.SetDescription(*s)
+	}
+	return tlu
+}
+
+// SetArchived sets the "archived" field.
+func (tlu *TodoListUpdate) SetArchived(b bool) *TodoListUpdate {
+	tlu.mutation.SetArchived(b)
+	return tlu
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (tlu *TodoListUpdate) SetNillableArchived(b *bool) *TodoListUpdate {
+	if b != nil {
+		tlu.SetArchived(*b)
+	}
+	return tlu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tlu *TodoListUpdate) SetCreatedAt(t time.Time) *TodoListUpdate {
+	tlu.mutation.SetCreatedAt(t)
+	return tlu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tlu *TodoListUpdate) SetNillableCreatedAt(t *time.Time) *TodoListUpdate {
+	if t != nil {
+		tlu.SetCreatedAt(*t)
+	}
+	return tlu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tlu *TodoListUpdate) SetUpdatedAt(t time.Time) *TodoListUpdate {
+	tlu.mutation.SetUpdatedAt(t)
+	return tlu
+}
+
+// AddItemIDs adds the "items" edge to the TodoItem entity by IDs.
+func (tlu *TodoListUpdate) AddItemIDs(ids ...int) *TodoListUpdate {
+	tlu.mutation.AddItemIDs(ids...)
+	return tlu
+}
+
+// AddItems adds the "items" edges to the TodoItem entity.
+func (tlu *TodoListUpdate) AddItems(t ...*TodoItem) *TodoListUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tlu.AddItemIDs(ids...)
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by IDs.
+func (tlu *TodoListUpdate) AddShareTokenIDs(ids ...int) *TodoListUpdate {
+	tlu.mutation.AddShareTokenIDs(ids...)
+	return tlu
+}
+
+// AddShareTokens adds the "share_tokens" edges to the ShareToken entity.
+func (tlu *TodoListUpdate) AddShareTokens(s ...*ShareToken) *TodoListUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tlu.AddShareTokenIDs(ids...)
+}
+
+// AddCollaboratorIDs adds the "collaborators" edge to the Collaborator entity by IDs.
+func (tlu *TodoListUpdate) AddCollaboratorIDs(ids ...int) *TodoListUpdate {
+	tlu.mutation.AddCollaboratorIDs(ids...)
+	return tlu
+}
+
+// AddCollaborators adds the "collaborators" edges to the Collaborator entity.
+func (tlu *TodoListUpdate) AddCollaborators(c ...*Collaborator) *TodoListUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tlu.AddCollaboratorIDs(ids...)
+}
+
+// Mutation returns the TodoListMutation object of the builder.
+func (tlu *TodoListUpdate) Mutation() *TodoListMutation {
+	return tlu.mutation
+}
+
+// ClearItems clears all "items" edges to the TodoItem entity.
+func (tlu *TodoListUpdate) ClearItems() *TodoListUpdate {
+	tlu.mutation.ClearItems()
+	return tlu
+}
+
+// RemoveItemIDs removes the "items" edge to TodoItem entities by IDs.
+func (tlu *TodoListUpdate) RemoveItemIDs(ids ...int) *TodoListUpdate {
+	tlu.mutation.RemoveItemIDs(ids...)
+	return tlu
+}
+
+// RemoveItems removes "items" edges to TodoItem entities.
+func (tlu *TodoListUpdate) RemoveItems(t ...*TodoItem) *TodoListUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tlu.RemoveItemIDs(ids...)
+}
+
+// ClearShareTokens clears all "share_tokens" edges to the ShareToken entity.
+func (tlu *TodoListUpdate) ClearShareTokens() *TodoListUpdate {
+	tlu.mutation.ClearShareTokens()
+	return tlu
+}
+
+// RemoveShareTokenIDs removes the "share_tokens" edge to ShareToken entities by IDs.
+func (tlu *TodoListUpdate) RemoveShareTokenIDs(ids ...int) *TodoListUpdate {
+	tlu.mutation.RemoveShareTokenIDs(ids...)
+	return tlu
+}
+
+// RemoveShareTokens removes "share_tokens" edges to ShareToken entities.
+func (tlu *TodoListUpdate) RemoveShareTokens(s ...*ShareToken) *TodoListUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tlu.RemoveShareTokenIDs(ids...)
+}
+
+// ClearCollaborators clears all "collaborators" edges to the Collaborator entity.
+func (tlu *TodoListUpdate) ClearCollaborators() *TodoListUpdate {
+	tlu.mutation.ClearCollaborators()
+	return tlu
+}
+
+// RemoveCollaboratorIDs removes the "collaborators" edge to Collaborator entities by IDs.
+func (tlu *TodoListUpdate) RemoveCollaboratorIDs(ids ...int) *TodoListUpdate {
+	tlu.mutation.RemoveCollaboratorIDs(ids...)
+	return tlu
+}
+
+// RemoveCollaborators removes "collaborators" edges to Collaborator entities.
+func (tlu *TodoListUpdate) RemoveCollaborators(c ...*Collaborator) *TodoListUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tlu.RemoveCollaboratorIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tlu *TodoListUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	tlu.defaults()
+	if len(tlu.hooks) == 0 {
+		if err = tlu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = tlu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TodoListMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tlu.check(); err != nil {
+				return 0, err
+			}
+			tlu.mutation = mutation
+			affected, err = tlu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tlu.hooks) - 1; i >= 0; i-- {
+			if tlu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tlu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tlu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tlu *TodoListUpdate) SaveX(ctx context.Context) int {
+	affected, err := tlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tlu *TodoListUpdate) Exec(ctx context.Context) error {
+	_, err := tlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tlu *TodoListUpdate) ExecX(ctx context.Context) {
+	if err := tlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tlu *TodoListUpdate) defaults() {
+	if _, ok := tlu.mutation.UpdatedAt(); !ok {
+		v := todolist.UpdateDefaultUpdatedAt()
+		tlu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tlu *TodoListUpdate) check() error {
+	if v, ok := tlu.mutation.Name(); ok {
+		if err := todolist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (tlu *TodoListUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   todolist.Table,
+			Columns: todolist.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: todolist.FieldID,
+			},
+		},
+	}
+	if ps := tlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tlu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todolist.FieldName,
+		})
+	}
+	if value, ok := tlu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todolist.FieldDescription,
+		})
+	}
+	if value, ok := tlu.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todolist.FieldArchived,
+		})
+	}
+	if value, ok := tlu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todolist.FieldCreatedAt,
+		})
+	}
+	if value, ok := tlu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todolist.FieldUpdatedAt,
+		})
+	}
+	if tlu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ItemsTable,
+			Columns: []string{todolist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tlu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !tlu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ItemsTable,
+			Columns: []string{todolist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tlu.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ItemsTable,
+			Columns: []string{todolist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tlu.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ShareTokensTable,
+			Columns: []string{todolist.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sharetoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tlu.mutation.RemovedShareTokensIDs(); len(nodes) > 0 && !tlu.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ShareTokensTable,
+			Columns: []string{todolist.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sharetoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tlu.mutation.ShareTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ShareTokensTable,
+			Columns: []string{todolist.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sharetoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tlu.mutation.CollaboratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.CollaboratorsTable,
+			Columns: []string{todolist.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: collaborator.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tlu.mutation.RemovedCollaboratorsIDs(); len(nodes) > 0 && !tlu.mutation.CollaboratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.CollaboratorsTable,
+			Columns: []string{todolist.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: collaborator.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tlu.mutation.CollaboratorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.CollaboratorsTable,
+			Columns: []string{todolist.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: collaborator.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todolist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TodoListUpdateOne is the builder for updating a single TodoList entity.
+type TodoListUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoListMutation
+}
+
+// SetName sets the "name" field.
+func (tluo *TodoListUpdateOne) SetName(s string) *TodoListUpdateOne {
+	tluo.mutation.SetName(s)
+	return tluo
+}
+
+// SetDescription sets the "description" field.
+func (tluo *TodoListUpdateOne) SetDescription(s string) *TodoListUpdateOne {
+	tluo.mutation.SetDescription(s)
+	return tluo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tluo *TodoListUpdateOne) SetNillableDescription(s *string) *TodoListUpdateOne {
+	if s != nil {
+		tluo.SetDescription(*s)
+	}
+	return tluo
+}
+
+// SetArchived sets the "archived" field.
+func (tluo *TodoListUpdateOne) SetArchived(b bool) *TodoListUpdateOne {
+	tluo.mutation.SetArchived(b)
+	return tluo
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (tluo *TodoListUpdateOne) SetNillableArchived(b *bool) *TodoListUpdateOne {
+	if b != nil {
+		tluo.SetArchived(*b)
+	}
+	return tluo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tluo *TodoListUpdateOne) SetCreatedAt(t time.Time) *TodoListUpdateOne {
+	tluo.mutation.SetCreatedAt(t)
+	return tluo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tluo *TodoListUpdateOne) SetNillableCreatedAt(t *time.Time) *TodoListUpdateOne {
+	if t != nil {
+		tluo.SetCreatedAt(*t)
+	}
+	return tluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tluo *TodoListUpdateOne) SetUpdatedAt(t time.Time) *TodoListUpdateOne {
+	tluo.mutation.SetUpdatedAt(t)
+	return tluo
+}
+
+// AddItemIDs adds the "items" edge to the TodoItem entity by IDs.
+func (tluo *TodoListUpdateOne) AddItemIDs(ids ...int) *TodoListUpdateOne {
+	tluo.mutation.AddItemIDs(ids...)
+	return tluo
+}
+
+// AddItems adds the "items" edges to the TodoItem entity.
+func (tluo *TodoListUpdateOne) AddItems(t ...*TodoItem) *TodoListUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tluo.AddItemIDs(ids...)
+}
+
+// AddShareTokenIDs adds the "share_tokens" edge to the ShareToken entity by IDs.
+func (tluo *TodoListUpdateOne) AddShareTokenIDs(ids ...int) *TodoListUpdateOne {
+	tluo.mutation.AddShareTokenIDs(ids...)
+	return tluo
+}
+
+// AddShareTokens adds the "share_tokens" edges to the ShareToken entity.
+func (tluo *TodoListUpdateOne) AddShareTokens(s ...*ShareToken) *TodoListUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tluo.AddShareTokenIDs(ids...)
+}
+
+// AddCollaboratorIDs adds the "collaborators" edge to the Collaborator entity by IDs.
+func (tluo *TodoListUpdateOne) AddCollaboratorIDs(ids ...int) *TodoListUpdateOne {
+	tluo.mutation.AddCollaboratorIDs(ids...)
+	return tluo
+}
+
+// AddCollaborators adds the "collaborators" edges to the Collaborator entity.
+func (tluo *TodoListUpdateOne) AddCollaborators(c ...*Collaborator) *TodoListUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tluo.AddCollaboratorIDs(ids...)
+}
+
+// Mutation returns the TodoListMutation object of the builder.
+func (tluo *TodoListUpdateOne) Mutation() *TodoListMutation {
+	return tluo.mutation
+}
+
+// ClearItems clears all "items" edges to the TodoItem entity.
+func (tluo *TodoListUpdateOne) ClearItems() *TodoListUpdateOne {
+	tluo.mutation.ClearItems()
+	return tluo
+}
+
+// RemoveItemIDs removes the "items" edge to TodoItem entities by IDs.
+func (tluo *TodoListUpdateOne) RemoveItemIDs(ids ...int) *TodoListUpdateOne {
+	tluo.mutation.RemoveItemIDs(ids...)
+	return tluo
+}
+
+// RemoveItems removes "items" edges to TodoItem entities.
+func (tluo *TodoListUpdateOne) RemoveItems(t ...*TodoItem) *TodoListUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tluo.RemoveItemIDs(ids...)
+}
+
+// ClearShareTokens clears all "share_tokens" edges to the ShareToken entity.
+func (tluo *TodoListUpdateOne) ClearShareTokens() *TodoListUpdateOne {
+	tluo.mutation.ClearShareTokens()
+	return tluo
+}
+
+// RemoveShareTokenIDs removes the "share_tokens" edge to ShareToken entities by IDs.
+func (tluo *TodoListUpdateOne) RemoveShareTokenIDs(ids ...int) *TodoListUpdateOne {
+	tluo.mutation.RemoveShareTokenIDs(ids...)
+	return tluo
+}
+
+// RemoveShareTokens removes "share_tokens" edges to ShareToken entities.
+func (tluo *TodoListUpdateOne) RemoveShareTokens(s ...*ShareToken) *TodoListUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tluo.RemoveShareTokenIDs(ids...)
+}
+
+// ClearCollaborators clears all "collaborators" edges to the Collaborator entity.
+func (tluo *TodoListUpdateOne) ClearCollaborators() *TodoListUpdateOne {
+	tluo.mutation.ClearCollaborators()
+	return tluo
+}
+
+// RemoveCollaboratorIDs removes the "collaborators" edge to Collaborator entities by IDs.
+func (tluo *TodoListUpdateOne) RemoveCollaboratorIDs(ids ...int) *TodoListUpdateOne {
+	tluo.mutation.RemoveCollaboratorIDs(ids...)
+	return tluo
+}
+
+// RemoveCollaborators removes "collaborators" edges to Collaborator entities.
+func (tluo *TodoListUpdateOne) RemoveCollaborators(c ...*Collaborator) *TodoListUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tluo.RemoveCollaboratorIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tluo *TodoListUpdateOne) Select(field string, fields ...string) *TodoListUpdateOne {
+	tluo.fields = append([]string{field}, fields...)
+	return tluo
+}
+
+// Save executes the query and returns the updated TodoList entity.
+func (tluo *TodoListUpdateOne) Save(ctx context.Context) (*TodoList, error) {
+	var (
+		err  error
+		node *TodoList
+	)
+	tluo.defaults()
+	if len(tluo.hooks) == 0 {
+		if err = tluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = tluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TodoListMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tluo.check(); err != nil {
+				return nil, err
+			}
+			tluo.mutation = mutation
+			node, err = tluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tluo.hooks) - 1; i >= 0; i-- {
+			if tluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tluo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tluo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tluo *TodoListUpdateOne) SaveX(ctx context.Context) *TodoList {
+	node, err := tluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tluo *TodoListUpdateOne) Exec(ctx context.Context) error {
+	_, err := tluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tluo *TodoListUpdateOne) ExecX(ctx context.Context) {
+	if err := tluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tluo *TodoListUpdateOne) defaults() {
+	if _, ok := tluo.mutation.UpdatedAt(); !ok {
+		v := todolist.UpdateDefaultUpdatedAt()
+		tluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tluo *TodoListUpdateOne) check() error {
+	if v, ok := tluo.mutation.Name(); ok {
+		if err := todolist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (tluo *TodoListUpdateOne) sqlSave(ctx context.Context) (_node *TodoList, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   todolist.Table,
+			Columns: todolist.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: todolist.FieldID,
+			},
+		},
+	}
+	id, ok := tluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing TodoList.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todolist.FieldID)
+		for _, f := range fields {
+			if !todolist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todolist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tluo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todolist.FieldName,
+		})
+	}
+	if value, ok := tluo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todolist.FieldDescription,
+		})
+	}
+	if value, ok := tluo.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: todolist.FieldArchived,
+		})
+	}
+	if value, ok := tluo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todolist.FieldCreatedAt,
+		})
+	}
+	if value, ok := tluo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todolist.FieldUpdatedAt,
+		})
+	}
+	if tluo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ItemsTable,
+			Columns: []string{todolist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tluo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !tluo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ItemsTable,
+			Columns: []string{todolist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tluo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ItemsTable,
+			Columns: []string{todolist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: todoitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tluo.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ShareTokensTable,
+			Columns: []string{todolist.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sharetoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tluo.mutation.RemovedShareTokensIDs(); len(nodes) > 0 && !tluo.mutation.ShareTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ShareTokensTable,
+			Columns: []string{todolist.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sharetoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tluo.mutation.ShareTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.ShareTokensTable,
+			Columns: []string{todolist.ShareTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sharetoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tluo.mutation.CollaboratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.CollaboratorsTable,
+			Columns: []string{todolist.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: collaborator.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tluo.mutation.RemovedCollaboratorsIDs(); len(nodes) > 0 && !tluo.mutation.CollaboratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.CollaboratorsTable,
+			Columns: []string{todolist.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: collaborator.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tluo.mutation.CollaboratorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todolist.CollaboratorsTable,
+			Columns: []string{todolist.CollaboratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: collaborator.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TodoList{config: tluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todolist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -12,8 +12,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// Collaborator is the client for interacting with the Collaborator builders.
+	Collaborator *CollaboratorClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// ItemHistory is the client for interacting with the ItemHistory builders.
+	ItemHistory *ItemHistoryClient
+	// Operation is the client for interacting with the Operation builders.
+	Operation *OperationClient
+	// Rule is the client for interacting with the Rule builders.
+	Rule *RuleClient
+	// RuleExecution is the client for interacting with the RuleExecution builders.
+	RuleExecution *RuleExecutionClient
+	// ShareAccess is the client for interacting with the ShareAccess builders.
+	ShareAccess *ShareAccessClient
+	// ShareToken is the client for interacting with the ShareToken builders.
+	ShareToken *ShareTokenClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// Template is the client for interacting with the Template builders.
+	Template *TemplateClient
+	// TimeEntry is the client for interacting with the TimeEntry builders.
+	TimeEntry *TimeEntryClient
 	// TodoItem is the client for interacting with the TodoItem builders.
 	TodoItem *TodoItemClient
+	// TodoList is the client for interacting with the TodoList builders.
+	TodoList *TodoListClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,7 +175,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.Collaborator = NewCollaboratorClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
+	tx.ItemHistory = NewItemHistoryClient(tx.config)
+	tx.Operation = NewOperationClient(tx.config)
+	tx.Rule = NewRuleClient(tx.config)
+	tx.RuleExecution = NewRuleExecutionClient(tx.config)
+	tx.ShareAccess = NewShareAccessClient(tx.config)
+	tx.ShareToken = NewShareTokenClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.Template = NewTemplateClient(tx.config)
+	tx.TimeEntry = NewTimeEntryClient(tx.config)
 	tx.TodoItem = NewTodoItemClient(tx.config)
+	tx.TodoList = NewTodoListClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,171 @@
+package todoadapter
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/rule"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/ruleexecution"
+)
+
+// StoreRule stores a rule.
+func (s EntStore) StoreRule(ctx context.Context, r todo.Rule) error {
+	existing, err := s.db(ctx).Rule.Query().Where(rule.UID(r.ID)).First(ctx)
+	if ent.IsNotFound(err) {
+		_, err := s.db(ctx).Rule.Create().
+			SetUID(r.ID).
+			SetName(r.Name).
+			SetTrigger(string(r.Trigger)).
+			SetConditions(r.Conditions).
+			SetActions(r.Actions).
+			SetEnabled(r.Enabled).
+			SetDryRun(r.DryRun).
+			SetOwner(r.Owner).
+			SetCreatedAt(r.CreatedAt).
+			SetUpdatedAt(r.UpdatedAt).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.db(ctx).Rule.UpdateOneID(existing.ID).
+		SetName(r.Name).
+		SetTrigger(string(r.Trigger)).
+		SetConditions(r.Conditions).
+		SetActions(r.Actions).
+		SetEnabled(r.Enabled).
+		SetDryRun(r.DryRun).
+		SetUpdatedAt(r.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetAllRules returns all rules.
+func (s EntStore) GetAllRules(ctx context.Context) ([]todo.Rule, error) {
+	ruleModels, err := s.db(ctx).Rule.Query().Order(ent.Asc(rule.FieldUID)).All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rules := make([]todo.Rule, 0, len(ruleModels))
+
+	for _, ruleModel := range ruleModels {
+		rules = append(rules, unmarshalRule(ruleModel))
+	}
+
+	return rules, nil
+}
+
+// GetOneRule returns a single rule by its ID.
+func (s EntStore) GetOneRule(ctx context.Context, id string) (todo.Rule, error) {
+	ruleModel, err := s.db(ctx).Rule.Query().Where(rule.UID(id)).First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.Rule{}, errors.WithStack(todo.RuleNotFoundError{ID: id})
+	}
+	if err != nil {
+		return todo.Rule{}, errors.WithStack(err)
+	}
+
+	return unmarshalRule(ruleModel), nil
+}
+
+// DeleteOneRule deletes a single rule by its ID with its executions.
+func (s EntStore) DeleteOneRule(ctx context.Context, id string) error {
+	_, err := s.db(ctx).RuleExecution.Delete().Where(ruleexecution.RuleUID(id)).Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.db(ctx).Rule.Delete().Where(rule.UID(id)).Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// StoreRuleExecution stores a rule execution.
+func (s EntStore) StoreRuleExecution(ctx context.Context, execution todo.RuleExecution) error {
+	_, err := s.db(ctx).RuleExecution.Create().
+		SetUID(execution.ID).
+		SetRuleUID(execution.RuleID).
+		SetTrigger(string(execution.Trigger)).
+		SetItemUID(execution.ItemID).
+		SetCorrelationID(execution.CorrelationID).
+		SetStatus(string(execution.Status)).
+		SetError(execution.Error).
+		SetExecutedAt(execution.ExecutedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetRuleExecutions returns the rule executions matching a filter, oldest first.
+func (s EntStore) GetRuleExecutions(ctx context.Context, filter todo.RuleExecutionFilter) ([]todo.RuleExecution, error) {
+	var predicates []predicate.RuleExecution
+
+	if filter.RuleID != "" {
+		predicates = append(predicates, ruleexecution.RuleUID(filter.RuleID))
+	}
+
+	if filter.CorrelationID != "" {
+		predicates = append(predicates, ruleexecution.CorrelationID(filter.CorrelationID))
+	}
+
+	executionModels, err := s.db(ctx).RuleExecution.Query().
+		Where(predicates...).
+		Order(ent.Asc(ruleexecution.FieldExecutedAt), ent.Asc(ruleexecution.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	executions := make([]todo.RuleExecution, 0, len(executionModels))
+
+	for _, executionModel := range executionModels {
+		executions = append(executions, todo.RuleExecution{
+			ID:            executionModel.UID,
+			RuleID:        executionModel.RuleUID,
+			Trigger:       todo.RuleTrigger(executionModel.Trigger),
+			ItemID:        executionModel.ItemUID,
+			CorrelationID: executionModel.CorrelationID,
+			Status:        todo.RuleExecutionStatus(executionModel.Status),
+			Error:         executionModel.Error,
+			ExecutedAt:    executionModel.ExecutedAt,
+		})
+	}
+
+	return executions, nil
+}
+
+func unmarshalRule(ruleModel *ent.Rule) todo.Rule {
+	return todo.Rule{
+		ID:         ruleModel.UID,
+		Name:       ruleModel.Name,
+		Trigger:    todo.RuleTrigger(ruleModel.Trigger),
+		Conditions: ruleModel.Conditions,
+		Actions:    ruleModel.Actions,
+		Enabled:    ruleModel.Enabled,
+		DryRun:     ruleModel.DryRun,
+		Owner:      ruleModel.Owner,
+		CreatedAt:  ruleModel.CreatedAt,
+		UpdatedAt:  ruleModel.UpdatedAt,
+	}
+}
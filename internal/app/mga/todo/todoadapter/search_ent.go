@@ -0,0 +1,204 @@
+package todoadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+)
+
+// searchIndexName is the name of the FULLTEXT index of items.
+const searchIndexName = "todoitem_search"
+
+// searchColumns are the columns of the FULLTEXT index of items.
+// nolint: gochecknoglobals
+var searchColumns = []string{todoitem.FieldTitle, todoitem.FieldNotes}
+
+// Search returns the items containing every term (as the prefix of a word), the most relevant first.
+// Items of every list are searched when the list ID is empty.
+func (s EntStore) Search(ctx context.Context, terms []string, listID string, limit int) ([]todo.Item, error) {
+	match, against := matchTerms(terms)
+
+	predicates := []predicate.TodoItem{
+		todoitem.DeletedAtIsNil(),
+		func(s *entsql.Selector) {
+			s.Where(entsql.ExprP(fmt.Sprintf(match, searchColumnList(s)), against))
+		},
+	}
+
+	if listID != "" {
+		predicates = append(predicates, inList(listID))
+	}
+
+	// Items are not joined with other tables, so DISTINCT is not needed (and it would restrict ordering)
+	todoModels, err := s.db(ctx).TodoItem.Query().Where(predicates...).
+		Unique(false).
+		Order(
+			func(s *entsql.Selector) {
+				s.OrderExpr(entsql.Expr(fmt.Sprintf(match+" DESC", searchColumnList(s)), against))
+			},
+			ent.Asc(todoitem.FieldUID),
+		).
+		Limit(limit).
+		WithList().
+		WithParent().
+		WithTags(orderTags).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return unmarshalItems(todoModels), nil
+}
+
+// searchColumnList returns the columns of the FULLTEXT index as the column list of a MATCH expression.
+func searchColumnList(s *entsql.Selector) string {
+	columns := make([]string, 0, len(searchColumns))
+
+	for _, column := range searchColumns {
+		columns = append(columns, s.C(column))
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+// matchTerms returns a MATCH expression (with a placeholder for the column) and its search string
+// matching every term as the prefix of a word.
+func matchTerms(terms []string) (string, string) {
+	words := make([]string, 0, len(terms))
+
+	// Terms only contain letters and digits: they are safe to use as boolean search operands
+	for _, term := range terms {
+		words = append(words, "+"+term+"*")
+	}
+
+	return "MATCH(%s) AGAINST (? IN BOOLEAN MODE)", strings.Join(words, " ")
+}
+
+// FullTextIndexHook is a migration hook that maintains the FULLTEXT index searched by the store.
+// Ent cannot declare FULLTEXT indexes, so the index is created (or recreated when its columns change)
+// once the rest of the schema is migrated.
+func FullTextIndexHook(db *sql.DB) schema.Hook {
+	return func(next schema.Creator) schema.Creator {
+		return schema.CreateFunc(func(ctx context.Context, tables ...*schema.Table) error {
+			columns, err := indexColumns(ctx, db, todoitem.Table, searchIndexName)
+			if err != nil {
+				return err
+			}
+
+			current := equalColumns(columns, searchColumns)
+
+			// An up-to-date index is declared to the migration, otherwise it would be dropped as unknown
+			if current {
+				tables = withSearchIndex(tables)
+			}
+
+			err = next.Create(ctx, tables...)
+			if err != nil || current {
+				return err
+			}
+
+			// The migration might have dropped the outdated index already
+			columns, err = indexColumns(ctx, db, todoitem.Table, searchIndexName)
+			if err != nil {
+				return err
+			}
+
+			if len(columns) > 0 {
+				_, err := db.ExecContext(ctx, fmt.Sprintf("DROP INDEX `%s` ON `%s`", searchIndexName, todoitem.Table))
+				if err != nil {
+					return errors.WrapIf(err, "drop outdated search index")
+				}
+			}
+
+			_, err = db.ExecContext(ctx, fmt.Sprintf(
+				"CREATE FULLTEXT INDEX `%s` ON `%s` (`%s`)",
+				searchIndexName,
+				todoitem.Table,
+				strings.Join(searchColumns, "`, `"),
+			))
+
+			return errors.WrapIf(err, "create search index")
+		})
+	}
+}
+
+// indexColumns returns the columns of an index in order (or nothing if the index does not exist).
+func indexColumns(ctx context.Context, db *sql.DB, table string, index string) ([]string, error) {
+	rows, err := db.QueryContext(
+		ctx,
+		"SELECT `COLUMN_NAME` FROM `INFORMATION_SCHEMA`.`STATISTICS` "+
+			"WHERE `TABLE_SCHEMA` = DATABASE() AND `TABLE_NAME` = ? AND `INDEX_NAME` = ? ORDER BY `SEQ_IN_INDEX`",
+		table,
+		index,
+	)
+	if err != nil {
+		return nil, errors.WrapIf(err, "get index columns")
+	}
+	defer rows.Close()
+
+	var columns []string
+
+	for rows.Next() {
+		var column string
+
+		err := rows.Scan(&column)
+		if err != nil {
+			return nil, errors.WrapIf(err, "get index columns")
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, errors.WrapIf(rows.Err(), "get index columns")
+}
+
+func equalColumns(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// withSearchIndex returns the tables with the search index added to (a copy of) the table of items.
+func withSearchIndex(tables []*schema.Table) []*schema.Table {
+	tables = append([]*schema.Table(nil), tables...)
+
+	for i, table := range tables {
+		if table.Name != todoitem.Table {
+			continue
+		}
+
+		index := &schema.Index{Name: searchIndexName}
+
+		for _, name := range searchColumns {
+			for _, column := range table.Columns {
+				if column.Name == name {
+					index.Columns = append(index.Columns, column)
+				}
+			}
+		}
+
+		t := *table
+		t.Indexes = append(append([]*schema.Index(nil), table.Indexes...), index)
+		tables[i] = &t
+	}
+
+	return tables
+}
@@ -0,0 +1,269 @@
+package todoadapter
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/collaborator"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/shareaccess"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/sharetoken"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
+)
+
+// StoreShareToken stores a share token (without the secret token).
+func (s EntStore) StoreShareToken(ctx context.Context, t todo.ShareToken) error {
+	existing, err := s.db(ctx).ShareToken.Query().Where(sharetoken.UID(t.ID)).First(ctx)
+	if ent.IsNotFound(err) {
+		listID, err := s.db(ctx).TodoList.Query().Where(todolist.UID(t.ListID)).OnlyID(ctx)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+
+		_, err = s.db(ctx).ShareToken.Create().
+			SetUID(t.ID).
+			SetListID(listID).
+			SetAccess(string(t.Access)).
+			SetTokenHash(t.TokenHash).
+			SetCreatedBy(t.CreatedBy).
+			SetCreatedAt(t.CreatedAt).
+			SetNillableExpiresAt(t.ExpiresAt).
+			SetNillableRevokedAt(t.RevokedAt).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	update := s.db(ctx).ShareToken.UpdateOneID(existing.ID)
+
+	if t.RevokedAt != nil {
+		update.SetRevokedAt(*t.RevokedAt)
+	} else {
+		update.ClearRevokedAt()
+	}
+
+	_, err = update.Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetShareTokens returns the share tokens of a list, oldest first.
+func (s EntStore) GetShareTokens(ctx context.Context, listID string) ([]todo.ShareToken, error) {
+	tokenModels, err := s.db(ctx).ShareToken.Query().
+		Where(sharetoken.HasListWith(todolist.UID(listID))).
+		Order(ent.Asc(sharetoken.FieldCreatedAt), ent.Asc(sharetoken.FieldUID)).
+		WithList().
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	tokens := make([]todo.ShareToken, 0, len(tokenModels))
+
+	for _, tokenModel := range tokenModels {
+		tokens = append(tokens, unmarshalShareToken(tokenModel))
+	}
+
+	return tokens, nil
+}
+
+// GetOneShareToken returns a single share token by its ID.
+func (s EntStore) GetOneShareToken(ctx context.Context, id string) (todo.ShareToken, error) {
+	tokenModel, err := s.db(ctx).ShareToken.Query().Where(sharetoken.UID(id)).WithList().First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.ShareToken{}, errors.WithStack(todo.ShareTokenNotFoundError{ID: id})
+	}
+	if err != nil {
+		return todo.ShareToken{}, errors.WithStack(err)
+	}
+
+	return unmarshalShareToken(tokenModel), nil
+}
+
+// GetShareTokenByHash returns a single share token by the hash of the secret token.
+func (s EntStore) GetShareTokenByHash(ctx context.Context, hash string) (todo.ShareToken, error) {
+	tokenModel, err := s.db(ctx).ShareToken.Query().Where(sharetoken.TokenHash(hash)).WithList().First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.ShareToken{}, errors.WithStack(todo.ShareTokenNotFoundError{})
+	}
+	if err != nil {
+		return todo.ShareToken{}, errors.WithStack(err)
+	}
+
+	return unmarshalShareToken(tokenModel), nil
+}
+
+func unmarshalShareToken(tokenModel *ent.ShareToken) todo.ShareToken {
+	t := todo.ShareToken{
+		ID:        tokenModel.UID,
+		Access:    todo.ShareAccess(tokenModel.Access),
+		TokenHash: tokenModel.TokenHash,
+		CreatedBy: tokenModel.CreatedBy,
+		CreatedAt: tokenModel.CreatedAt,
+		ExpiresAt: tokenModel.ExpiresAt,
+		RevokedAt: tokenModel.RevokedAt,
+	}
+
+	if tokenModel.Edges.List != nil {
+		t.ListID = tokenModel.Edges.List.UID
+	}
+
+	return t
+}
+
+// StoreCollaborator stores a collaborator.
+func (s EntStore) StoreCollaborator(ctx context.Context, c todo.Collaborator) error {
+	existing, err := s.db(ctx).Collaborator.Query().Where(collaborator.UID(c.ID)).First(ctx)
+	if ent.IsNotFound(err) {
+		listID, err := s.db(ctx).TodoList.Query().Where(todolist.UID(c.ListID)).OnlyID(ctx)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+
+		_, err = s.db(ctx).Collaborator.Create().
+			SetUID(c.ID).
+			SetListID(listID).
+			SetEmail(c.Email).
+			SetRole(string(c.Role)).
+			SetInvitedBy(c.InvitedBy).
+			SetCreatedAt(c.CreatedAt).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.db(ctx).Collaborator.UpdateOneID(existing.ID).
+		SetRole(string(c.Role)).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetCollaborators returns the collaborators of a list, oldest first.
+func (s EntStore) GetCollaborators(ctx context.Context, listID string) ([]todo.Collaborator, error) {
+	collaboratorModels, err := s.db(ctx).Collaborator.Query().
+		Where(collaborator.HasListWith(todolist.UID(listID))).
+		Order(ent.Asc(collaborator.FieldCreatedAt), ent.Asc(collaborator.FieldUID)).
+		WithList().
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	collaborators := make([]todo.Collaborator, 0, len(collaboratorModels))
+
+	for _, collaboratorModel := range collaboratorModels {
+		collaborators = append(collaborators, unmarshalCollaborator(collaboratorModel))
+	}
+
+	return collaborators, nil
+}
+
+// GetCollaboratorByEmail returns a single collaborator of a list by their email address.
+func (s EntStore) GetCollaboratorByEmail(ctx context.Context, listID string, email string) (todo.Collaborator, error) {
+	collaboratorModel, err := s.db(ctx).Collaborator.Query().
+		Where(collaborator.HasListWith(todolist.UID(listID)), collaborator.Email(email)).
+		WithList().
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.Collaborator{}, errors.WithStack(todo.CollaboratorNotFoundError{ListID: listID, Email: email})
+	}
+	if err != nil {
+		return todo.Collaborator{}, errors.WithStack(err)
+	}
+
+	return unmarshalCollaborator(collaboratorModel), nil
+}
+
+// DeleteOneCollaborator deletes a single collaborator by their ID.
+func (s EntStore) DeleteOneCollaborator(ctx context.Context, id string) error {
+	_, err := s.db(ctx).Collaborator.Delete().Where(collaborator.UID(id)).Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func unmarshalCollaborator(collaboratorModel *ent.Collaborator) todo.Collaborator {
+	c := todo.Collaborator{
+		ID:        collaboratorModel.UID,
+		Email:     collaboratorModel.Email,
+		Role:      todo.CollaboratorRole(collaboratorModel.Role),
+		InvitedBy: collaboratorModel.InvitedBy,
+		CreatedAt: collaboratorModel.CreatedAt,
+	}
+
+	if collaboratorModel.Edges.List != nil {
+		c.ListID = collaboratorModel.Edges.List.UID
+	}
+
+	return c
+}
+
+// AddShareAccess stores an access log entry.
+func (s EntStore) AddShareAccess(ctx context.Context, entry todo.ShareAccessEntry) error {
+	_, err := s.db(ctx).ShareAccess.Create().
+		SetUID(entry.ID).
+		SetShareUID(entry.ShareID).
+		SetListUID(entry.ListID).
+		SetOperation(entry.Operation).
+		SetItemUID(entry.ItemID).
+		SetCorrelationID(entry.CorrelationID).
+		SetDenied(entry.Denied).
+		SetAccessedAt(entry.AccessedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetShareAccess returns the access log of a list, oldest first.
+func (s EntStore) GetShareAccess(ctx context.Context, listID string) ([]todo.ShareAccessEntry, error) {
+	accessModels, err := s.db(ctx).ShareAccess.Query().
+		Where(shareaccess.ListUID(listID)).
+		Order(ent.Asc(shareaccess.FieldAccessedAt), ent.Asc(shareaccess.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	entries := make([]todo.ShareAccessEntry, 0, len(accessModels))
+
+	for _, accessModel := range accessModels {
+		entries = append(entries, todo.ShareAccessEntry{
+			ID:            accessModel.UID,
+			ShareID:       accessModel.ShareUID,
+			ListID:        accessModel.ListUID,
+			Operation:     accessModel.Operation,
+			ItemID:        accessModel.ItemUID,
+			CorrelationID: accessModel.CorrelationID,
+			Denied:        accessModel.Denied,
+			AccessedAt:    accessModel.AccessedAt,
+		})
+	}
+
+	return entries, nil
+}
@@ -2,35 +2,110 @@ package todoadapter
 
 import (
 	"context"
+	"time"
 
 	"emperror.dev/errors"
 
-	"github.com/sagikazarmark/todobackend-go-kit/todo"
-
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/itemhistory"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/operation"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/tag"
 	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todolist"
 )
 
-type entStore struct {
+// EntStore is a todo store backed by Ent ORM.
+type EntStore struct {
 	client *ent.Client
 }
 
 // NewEntStore returns a new todo store backed by Ent ORM.
-func NewEntStore(client *ent.Client) todo.Store {
-	return entStore{
+func NewEntStore(client *ent.Client) EntStore {
+	return EntStore{
 		client: client,
 	}
 }
 
-func (s entStore) Store(ctx context.Context, todo todo.Item) error {
-	existing, err := s.client.TodoItem.Query().Where(todoitem.UID(todo.ID)).First(ctx)
+// Transaction runs a function in a transaction: changes are rolled back if the function returns an error.
+// Functions called in a transaction take part in it.
+func (s EntStore) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = fn(ent.NewTxContext(ctx, tx))
+	if err != nil {
+		rerr := tx.Rollback()
+		if rerr != nil {
+			return errors.Combine(err, errors.WithStack(rerr))
+		}
+
+		return err
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+// db returns the client of the transaction in the context (if any).
+func (s EntStore) db(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return s.client
+}
+
+// Store stores an item.
+func (s EntStore) Store(ctx context.Context, todo todo.Item) error {
+	listID, err := s.db(ctx).TodoList.Query().Where(todolist.UID(todo.ListID)).OnlyID(ctx)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	var parentID *int
+
+	if todo.ParentID != "" {
+		id, err := s.db(ctx).TodoItem.Query().Where(todoitem.UID(todo.ParentID)).OnlyID(ctx)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+
+		parentID = &id
+	}
+
+	existing, err := s.db(ctx).TodoItem.Query().Where(todoitem.UID(todo.ID)).First(ctx)
 	if ent.IsNotFound(err) {
-		_, err := s.client.TodoItem.Create().
+		create := s.db(ctx).TodoItem.Create().
 			SetUID(todo.ID).
+			SetListID(listID).
+			SetNillableParentID(parentID).
 			SetTitle(todo.Title).
+			SetNotes(todo.Notes).
+			SetAssignee(todo.Assignee).
 			SetCompleted(todo.Completed).
 			SetOrder(todo.Order).
-			Save(ctx)
+			SetNillableDueAt(todo.DueAt).
+			SetPriority(int(todo.Priority)).
+			SetRank(todo.Rank).
+			SetAutoComplete(todo.AutoComplete)
+
+		if r := todo.Recurrence; r != nil {
+			create.
+				SetRecurrenceRule(r.Rule).
+				SetRecurrenceTimezone(r.Timezone).
+				SetRecurrenceStart(r.Start).
+				SetRecurrenceOccurrence(r.Occurrence).
+				SetSeriesID(r.SeriesID)
+		}
+
+		_, err := create.Save(ctx)
 		if err != nil {
 			return err
 		}
@@ -41,11 +116,53 @@ func (s entStore) Store(ctx context.Context, todo todo.Item) error {
 		return err
 	}
 
-	_, err = s.client.TodoItem.UpdateOneID(existing.ID).
+	update := s.db(ctx).TodoItem.UpdateOneID(existing.ID).
+		SetListID(listID).
 		SetTitle(todo.Title).
+		SetNotes(todo.Notes).
+		SetAssignee(todo.Assignee).
 		SetCompleted(todo.Completed).
 		SetOrder(todo.Order).
-		Save(ctx)
+		SetPriority(int(todo.Priority)).
+		SetRank(todo.Rank).
+		SetAutoComplete(todo.AutoComplete)
+
+	if parentID != nil {
+		update.SetParentID(*parentID)
+	} else {
+		update.ClearParent()
+	}
+
+	// Notifications are sent again when the due date changes
+	if !sameTime(existing.DueAt, todo.DueAt) {
+		update.
+			SetDueSoonNotified(false).
+			SetOverdueNotified(false)
+	}
+
+	if todo.DueAt != nil {
+		update.SetDueAt(*todo.DueAt)
+	} else {
+		update.ClearDueAt()
+	}
+
+	if r := todo.Recurrence; r != nil {
+		update.
+			SetRecurrenceRule(r.Rule).
+			SetRecurrenceTimezone(r.Timezone).
+			SetRecurrenceStart(r.Start).
+			SetRecurrenceOccurrence(r.Occurrence).
+			SetSeriesID(r.SeriesID)
+	} else {
+		update.
+			ClearRecurrenceRule().
+			ClearRecurrenceTimezone().
+			ClearRecurrenceStart().
+			ClearRecurrenceOccurrence().
+			ClearSeriesID()
+	}
+
+	_, err = update.Save(ctx)
 	if err != nil {
 		return err
 	}
@@ -53,42 +170,325 @@ func (s entStore) Store(ctx context.Context, todo todo.Item) error {
 	return nil
 }
 
-func (s entStore) GetAll(ctx context.Context) ([]todo.Item, error) {
-	todoModels, err := s.client.TodoItem.Query().All(ctx)
+// GetAll returns all items matching a filter.
+func (s EntStore) GetAll(ctx context.Context, filter todo.ItemFilter) ([]todo.Item, error) {
+	predicates := []predicate.TodoItem{todoitem.DeletedAtIsNil()}
+
+	if filter.ListID != "" {
+		predicates = append(predicates, inList(filter.ListID))
+	}
+
+	if filter.DueAfter != nil {
+		predicates = append(predicates, todoitem.DueAtGT(*filter.DueAfter))
+	}
+
+	if filter.DueBefore != nil {
+		predicates = append(predicates, todoitem.DueAtLT(*filter.DueBefore))
+	}
+
+	if filter.Overdue {
+		predicates = append(predicates, todoitem.CompletedEQ(false), todoitem.DueAtLT(time.Now()))
+	}
+
+	if filter.SeriesID != "" {
+		predicates = append(predicates, todoitem.SeriesID(filter.SeriesID))
+	}
+
+	if filter.ParentID != "" {
+		predicates = append(predicates, todoitem.HasParentWith(todoitem.UID(filter.ParentID)))
+	}
+
+	if len(filter.Tags) > 0 {
+		predicates = append(predicates, hasTags(filter.Tags, filter.MatchAllTags))
+	}
+
+	if filter.Assignee != "" {
+		predicates = append(predicates, todoitem.Assignee(filter.Assignee))
+	}
+
+	todoModels, err := s.db(ctx).TodoItem.Query().Where(predicates...).
+		WithList().
+		WithParent().
+		WithTags(orderTags).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	todos := make([]todo.Item, 0, len(todoModels))
+	return unmarshalItems(todoModels), nil
+}
 
-	for _, todoModel := range todoModels {
-		todos = append(todos, todo.Item{
-			ID:        todoModel.UID,
-			Title:     todoModel.Title,
-			Completed: todoModel.Completed,
-			Order:     todoModel.Order,
-		})
+// GetOne returns a single item by its ID.
+func (s EntStore) GetOne(ctx context.Context, id string) (todo.Item, error) {
+	return s.getOne(ctx, id, todoitem.DeletedAtIsNil())
+}
+
+func (s EntStore) getOne(ctx context.Context, id string, predicates ...predicate.TodoItem) (todo.Item, error) {
+	todoModel, err := s.db(ctx).TodoItem.Query().Where(append(predicates, todoitem.UID(id))...).
+		WithList().
+		WithParent().
+		WithTags(orderTags).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.Item{}, errors.WithStack(todo.NotFoundError{ID: id})
+	}
+	if err != nil {
+		return todo.Item{}, errors.WithStack(err)
+	}
+
+	return unmarshalItem(todoModel), nil
+}
+
+// GetMaxRank returns the highest rank in a list.
+func (s EntStore) GetMaxRank(ctx context.Context, listID string) (string, error) {
+	ranks, err := s.db(ctx).TodoItem.Query().
+		Where(inList(listID), todoitem.DeletedAtIsNil()).
+		Order(ent.Desc(todoitem.FieldRank)).
+		Limit(1).
+		Select(todoitem.FieldRank).
+		Strings(ctx)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if len(ranks) == 0 {
+		return "", nil
+	}
+
+	return ranks[0], nil
+}
+
+// DeleteAll moves all items of a list to the trash.
+func (s EntStore) DeleteAll(ctx context.Context, listID string) error {
+	_, err := s.db(ctx).TodoItem.Update().
+		Where(inList(listID), todoitem.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne moves a single item to the trash by its ID.
+func (s EntStore) DeleteOne(ctx context.Context, id string) error {
+	_, err := s.db(ctx).TodoItem.Update().
+		Where(todoitem.UID(id), todoitem.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetTrash returns the deleted items of a list, most recently deleted first.
+func (s EntStore) GetTrash(ctx context.Context, listID string) ([]todo.Item, error) {
+	todoModels, err := s.db(ctx).TodoItem.Query().
+		Where(inList(listID), todoitem.DeletedAtNotNil()).
+		Order(ent.Desc(todoitem.FieldDeletedAt), ent.Asc(todoitem.FieldUID)).
+		WithList().
+		WithParent().
+		WithTags(orderTags).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return unmarshalItems(todoModels), nil
+}
+
+// GetOneDeleted returns a single deleted item by its ID.
+func (s EntStore) GetOneDeleted(ctx context.Context, id string) (todo.Item, error) {
+	return s.getOne(ctx, id, todoitem.DeletedAtNotNil())
+}
+
+// GetDeletedSubtasks returns the deleted subtasks of an item (one level deep).
+func (s EntStore) GetDeletedSubtasks(ctx context.Context, parentID string) ([]todo.Item, error) {
+	todoModels, err := s.db(ctx).TodoItem.Query().
+		Where(todoitem.HasParentWith(todoitem.UID(parentID)), todoitem.DeletedAtNotNil()).
+		Order(ent.Asc(todoitem.FieldUID)).
+		WithList().
+		WithParent().
+		WithTags(orderTags).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return unmarshalItems(todoModels), nil
+}
+
+// RestoreOne restores a deleted item.
+func (s EntStore) RestoreOne(ctx context.Context, id string) error {
+	_, err := s.db(ctx).TodoItem.Update().
+		Where(todoitem.UID(id), todoitem.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// PurgeOne permanently deletes an item.
+func (s EntStore) PurgeOne(ctx context.Context, id string) error {
+	_, err := s.db(ctx).TodoItem.Delete().Where(todoitem.UID(id)).Exec(ctx)
+
+	if err != nil {
+		return errors.WithStack(err)
 	}
 
-	return todos, nil
+	return nil
 }
 
-func (s entStore) GetOne(ctx context.Context, id string) (todo.Item, error) {
-	todoModel, err := s.client.TodoItem.Query().Where(todoitem.UID(id)).First(ctx)
+// PurgeAll permanently deletes all items of a list, including deleted ones.
+func (s EntStore) PurgeAll(ctx context.Context, listID string) error {
+	_, err := s.db(ctx).TodoItem.Delete().Where(inList(listID)).Exec(ctx)
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// PurgeDeletedBefore permanently deletes items deleted before a point in time.
+func (s EntStore) PurgeDeletedBefore(ctx context.Context, deletedBefore time.Time) (int, error) {
+	n, err := s.db(ctx).TodoItem.Delete().Where(todoitem.DeletedAtLT(deletedBefore)).Exec(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return n, nil
+}
+
+// GetItemsToNotify returns incomplete items due before a point in time
+// that have not been notified about yet.
+func (s EntStore) GetItemsToNotify(
+	ctx context.Context,
+	notification todo.DueNotification,
+	dueBefore time.Time,
+) ([]todo.Item, error) {
+	query := s.db(ctx).TodoItem.Query().
+		Where(todoitem.DeletedAtIsNil(), todoitem.CompletedEQ(false), todoitem.DueAtLT(dueBefore)).
+		WithList().
+		WithParent().
+		WithTags(orderTags)
+
+	switch notification {
+	case todo.DueSoonNotification:
+		query.Where(todoitem.DueSoonNotifiedEQ(false))
+
+	case todo.OverdueNotification:
+		query.Where(todoitem.OverdueNotifiedEQ(false))
+
+	default:
+		return nil, errors.NewWithDetails("unknown due notification", "notification", notification)
+	}
+
+	todoModels, err := query.All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return unmarshalItems(todoModels), nil
+}
+
+// MarkNotified records that a notification has been sent about an item.
+func (s EntStore) MarkNotified(ctx context.Context, id string, notification todo.DueNotification) error {
+	update := s.db(ctx).TodoItem.Update().Where(todoitem.UID(id), todoitem.DeletedAtIsNil())
+
+	switch notification {
+	case todo.DueSoonNotification:
+		update.SetDueSoonNotified(true)
+
+	case todo.OverdueNotification:
+		update.SetOverdueNotified(true)
+
+	default:
+		return errors.NewWithDetails("unknown due notification", "notification", notification)
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if n == 0 {
+		return errors.WithStack(todo.NotFoundError{ID: id})
+	}
+
+	return nil
+}
+
+// StoreList stores a list.
+func (s EntStore) StoreList(ctx context.Context, list todo.List) error {
+	existing, err := s.db(ctx).TodoList.Query().Where(todolist.UID(list.ID)).First(ctx)
 	if ent.IsNotFound(err) {
-		return todo.Item{}, errors.WithStack(todo.NotFoundError{ID: id})
+		_, err := s.db(ctx).TodoList.Create().
+			SetUID(list.ID).
+			SetName(list.Name).
+			SetDescription(list.Description).
+			SetArchived(list.Archived).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.db(ctx).TodoList.UpdateOneID(existing.ID).
+		SetName(list.Name).
+		SetDescription(list.Description).
+		SetArchived(list.Archived).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetAllLists returns all lists.
+func (s EntStore) GetAllLists(ctx context.Context) ([]todo.List, error) {
+	listModels, err := s.db(ctx).TodoList.Query().Order(ent.Asc(todolist.FieldUID)).All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
 
-	return todo.Item{
-		ID:        todoModel.UID,
-		Title:     todoModel.Title,
-		Completed: todoModel.Completed,
-		Order:     todoModel.Order,
-	}, nil
+	lists := make([]todo.List, 0, len(listModels))
+
+	for _, listModel := range listModels {
+		lists = append(lists, unmarshalList(listModel))
+	}
+
+	return lists, nil
 }
 
-func (s entStore) DeleteAll(ctx context.Context) error {
-	_, err := s.client.TodoItem.Delete().Exec(ctx)
+// GetOneList returns a single list by its ID.
+func (s EntStore) GetOneList(ctx context.Context, id string) (todo.List, error) {
+	listModel, err := s.db(ctx).TodoList.Query().Where(todolist.UID(id)).First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.List{}, errors.WithStack(todo.ListNotFoundError{ID: id})
+	}
+	if err != nil {
+		return todo.List{}, errors.WithStack(err)
+	}
+
+	return unmarshalList(listModel), nil
+}
+
+// DeleteOneList deletes a single list by its ID.
+func (s EntStore) DeleteOneList(ctx context.Context, id string) error {
+	_, err := s.db(ctx).TodoList.Delete().Where(todolist.UID(id)).Exec(ctx)
 
 	if err != nil {
 		return errors.WithStack(err)
@@ -97,12 +497,462 @@ func (s entStore) DeleteAll(ctx context.Context) error {
 	return nil
 }
 
-func (s entStore) DeleteOne(ctx context.Context, id string) error {
-	_, err := s.client.TodoItem.Delete().Where(todoitem.UID(id)).Exec(ctx)
+// StoreTag stores a tag.
+func (s EntStore) StoreTag(ctx context.Context, t todo.Tag) error {
+	existing, err := s.db(ctx).Tag.Query().Where(tag.UID(t.ID)).First(ctx)
+	if ent.IsNotFound(err) {
+		_, err := s.db(ctx).Tag.Create().
+			SetUID(t.ID).
+			SetOwner(t.Owner).
+			SetName(t.Name).
+			SetColor(t.Color).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
 
+	_, err = s.db(ctx).Tag.UpdateOneID(existing.ID).
+		SetName(t.Name).
+		SetColor(t.Color).
+		Save(ctx)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
 	return nil
 }
+
+// GetAllTags returns the tags of an owner with their usage counts, ordered by name.
+func (s EntStore) GetAllTags(ctx context.Context, owner string) ([]todo.Tag, error) {
+	tagModels, err := s.db(ctx).Tag.Query().
+		Where(tag.Owner(owner)).
+		Order(ent.Asc(tag.FieldName)).
+		WithItems(func(query *ent.TodoItemQuery) {
+			// Deleted items are not counted
+			query.Where(todoitem.DeletedAtIsNil())
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	tags := make([]todo.Tag, 0, len(tagModels))
+
+	for _, tagModel := range tagModels {
+		t := unmarshalTag(tagModel)
+		t.ItemCount = len(tagModel.Edges.Items)
+
+		tags = append(tags, t)
+	}
+
+	return tags, nil
+}
+
+// GetOneTag returns a single tag by its ID with its usage count.
+func (s EntStore) GetOneTag(ctx context.Context, id string) (todo.Tag, error) {
+	tagModel, err := s.db(ctx).Tag.Query().Where(tag.UID(id)).First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.Tag{}, errors.WithStack(todo.TagNotFoundError{ID: id})
+	}
+	if err != nil {
+		return todo.Tag{}, errors.WithStack(err)
+	}
+
+	count, err := tagModel.QueryItems().Where(todoitem.DeletedAtIsNil()).Count(ctx)
+	if err != nil {
+		return todo.Tag{}, errors.WithStack(err)
+	}
+
+	t := unmarshalTag(tagModel)
+	t.ItemCount = count
+
+	return t, nil
+}
+
+// GetTagsByName returns the tags of an owner with the given names.
+func (s EntStore) GetTagsByName(ctx context.Context, owner string, names []string) ([]todo.Tag, error) {
+	tagModels, err := s.db(ctx).Tag.Query().
+		Where(tag.Owner(owner), tag.NameIn(names...)).
+		Order(ent.Asc(tag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return unmarshalTags(tagModels), nil
+}
+
+// DeleteOneTag deletes a single tag by its ID, removing it from every item.
+func (s EntStore) DeleteOneTag(ctx context.Context, id string) error {
+	_, err := s.db(ctx).Tag.Delete().Where(tag.UID(id)).Exec(ctx)
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// AttachTags attaches tags to an item.
+func (s EntStore) AttachTags(ctx context.Context, itemID string, tagIDs []string) error {
+	itemModel, err := s.db(ctx).TodoItem.Query().Where(todoitem.UID(itemID), todoitem.DeletedAtIsNil()).First(ctx)
+	if ent.IsNotFound(err) {
+		return errors.WithStack(todo.NotFoundError{ID: itemID})
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Tags already attached to the item are skipped
+	ids, err := s.db(ctx).Tag.Query().
+		Where(tag.UIDIn(tagIDs...), tag.Not(tag.HasItemsWith(todoitem.ID(itemModel.ID)))).
+		IDs(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.db(ctx).TodoItem.UpdateOneID(itemModel.ID).AddTagIDs(ids...).Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DetachTags detaches tags from an item.
+func (s EntStore) DetachTags(ctx context.Context, itemID string, tagIDs []string) error {
+	ids, err := s.db(ctx).Tag.Query().Where(tag.UIDIn(tagIDs...)).IDs(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.db(ctx).TodoItem.Update().Where(todoitem.UID(itemID)).RemoveTagIDs(ids...).Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// MergeTags moves the items of a tag to another one and deletes the source tag.
+func (s EntStore) MergeTags(ctx context.Context, sourceID string, targetID string) error {
+	tx, err := s.db(ctx).Tx(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = mergeTags(ctx, tx.Client(), sourceID, targetID)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+func mergeTags(ctx context.Context, client *ent.Client, sourceID string, targetID string) error {
+	target, err := client.Tag.Query().Where(tag.UID(targetID)).First(ctx)
+	if ent.IsNotFound(err) {
+		return errors.WithStack(todo.TagNotFoundError{ID: targetID})
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Items already tagged with the target only lose the source tag
+	ids, err := client.TodoItem.Query().
+		Where(
+			todoitem.HasTagsWith(tag.UID(sourceID)),
+			todoitem.Not(todoitem.HasTagsWith(tag.ID(target.ID))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = client.Tag.UpdateOneID(target.ID).AddItemIDs(ids...).Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = client.Tag.Delete().Where(tag.UID(sourceID)).Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// AddHistoryEntry stores a history entry.
+func (s EntStore) AddHistoryEntry(ctx context.Context, entry todo.HistoryEntry) error {
+	_, err := s.db(ctx).ItemHistory.Create().
+		SetUID(entry.ID).
+		SetItemUID(entry.ItemID).
+		SetActor(entry.Actor).
+		SetOperation(entry.Operation).
+		SetCorrelationID(entry.CorrelationID).
+		SetChanges(entry.Changes).
+		SetCreatedAt(entry.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetHistory returns the history entries of an item, oldest first.
+func (s EntStore) GetHistory(ctx context.Context, itemID string) ([]todo.HistoryEntry, error) {
+	historyModels, err := s.db(ctx).ItemHistory.Query().
+		Where(itemhistory.ItemUID(itemID)).
+		Order(ent.Asc(itemhistory.FieldCreatedAt), ent.Asc(itemhistory.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	entries := make([]todo.HistoryEntry, 0, len(historyModels))
+
+	for _, historyModel := range historyModels {
+		entries = append(entries, todo.HistoryEntry{
+			ID:            historyModel.UID,
+			ItemID:        historyModel.ItemUID,
+			Actor:         historyModel.Actor,
+			Operation:     historyModel.Operation,
+			CorrelationID: historyModel.CorrelationID,
+			Changes:       historyModel.Changes,
+			CreatedAt:     historyModel.CreatedAt,
+		})
+	}
+
+	return entries, nil
+}
+
+// AddOperation stores an operation.
+func (s EntStore) AddOperation(ctx context.Context, operation todo.Operation) error {
+	_, err := s.db(ctx).Operation.Create().
+		SetUID(operation.ID).
+		SetActor(operation.Actor).
+		SetName(operation.Name).
+		SetChanges(operation.Changes).
+		SetUndone(operation.Undone).
+		SetCreatedAt(operation.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetUndoableOperations returns the last operations of an actor that have not been undone, newest first.
+func (s EntStore) GetUndoableOperations(ctx context.Context, actor string, limit int) ([]todo.Operation, error) {
+	operationModels, err := s.db(ctx).Operation.Query().
+		Where(operation.Actor(actor), operation.Undone(false)).
+		Order(ent.Desc(operation.FieldCreatedAt), ent.Desc(operation.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return operationsFromModels(operationModels), nil
+}
+
+// GetRedoableOperations returns the first undone operations of an actor, oldest first.
+func (s EntStore) GetRedoableOperations(ctx context.Context, actor string, limit int) ([]todo.Operation, error) {
+	operationModels, err := s.db(ctx).Operation.Query().
+		Where(operation.Actor(actor), operation.Undone(true)).
+		Order(ent.Asc(operation.FieldCreatedAt), ent.Asc(operation.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return operationsFromModels(operationModels), nil
+}
+
+// MarkUndone records whether an operation has been undone.
+func (s EntStore) MarkUndone(ctx context.Context, id string, undone bool) error {
+	_, err := s.db(ctx).Operation.Update().
+		Where(operation.UID(id)).
+		SetUndone(undone).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DiscardRedoableOperations deletes the undone operations of an actor.
+func (s EntStore) DiscardRedoableOperations(ctx context.Context, actor string) error {
+	_, err := s.db(ctx).Operation.Delete().
+		Where(operation.Actor(actor), operation.Undone(true)).
+		Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func operationsFromModels(operationModels []*ent.Operation) []todo.Operation {
+	operations := make([]todo.Operation, 0, len(operationModels))
+
+	for _, operationModel := range operationModels {
+		operations = append(operations, todo.Operation{
+			ID:        operationModel.UID,
+			Actor:     operationModel.Actor,
+			Name:      operationModel.Name,
+			Changes:   operationModel.Changes,
+			Undone:    operationModel.Undone,
+			CreatedAt: operationModel.CreatedAt,
+		})
+	}
+
+	return operations
+}
+
+// hasTags matches items tagged with any (or all) of the tags.
+func hasTags(names []string, all bool) predicate.TodoItem {
+	if !all {
+		return todoitem.HasTagsWith(tag.NameIn(names...))
+	}
+
+	predicates := make([]predicate.TodoItem, 0, len(names))
+
+	for _, name := range names {
+		predicates = append(predicates, todoitem.HasTagsWith(tag.Name(name)))
+	}
+
+	return todoitem.And(predicates...)
+}
+
+func orderTags(query *ent.TagQuery) {
+	query.Order(ent.Asc(tag.FieldName))
+}
+
+func unmarshalTags(tagModels []*ent.Tag) []todo.Tag {
+	tags := make([]todo.Tag, 0, len(tagModels))
+
+	for _, tagModel := range tagModels {
+		tags = append(tags, unmarshalTag(tagModel))
+	}
+
+	return tags
+}
+
+func unmarshalTag(tagModel *ent.Tag) todo.Tag {
+	return todo.Tag{
+		ID:    tagModel.UID,
+		Owner: tagModel.Owner,
+		Name:  tagModel.Name,
+		Color: tagModel.Color,
+	}
+}
+
+// inList matches the items of a list.
+// Items without a list belong to the default list.
+func inList(listID string) predicate.TodoItem {
+	if listID == todo.DefaultListID {
+		return todoitem.Or(
+			todoitem.Not(todoitem.HasList()),
+			todoitem.HasListWith(todolist.UID(listID)),
+		)
+	}
+
+	return todoitem.HasListWith(todolist.UID(listID))
+}
+
+func unmarshalList(listModel *ent.TodoList) todo.List {
+	return todo.List{
+		ID:          listModel.UID,
+		Name:        listModel.Name,
+		Description: listModel.Description,
+		Archived:    listModel.Archived,
+	}
+}
+
+func unmarshalItems(todoModels []*ent.TodoItem) []todo.Item {
+	todos := make([]todo.Item, 0, len(todoModels))
+
+	for _, todoModel := range todoModels {
+		todos = append(todos, unmarshalItem(todoModel))
+	}
+
+	return todos
+}
+
+func unmarshalItem(todoModel *ent.TodoItem) todo.Item {
+	item := todo.Item{
+		ID:           todoModel.UID,
+		ListID:       todo.DefaultListID,
+		Title:        todoModel.Title,
+		Notes:        todoModel.Notes,
+		Assignee:     todoModel.Assignee,
+		Completed:    todoModel.Completed,
+		Order:        todoModel.Order,
+		DueAt:        todoModel.DueAt,
+		Priority:     todo.Priority(todoModel.Priority),
+		Rank:         todoModel.Rank,
+		AutoComplete: todoModel.AutoComplete,
+		DeletedAt:    todoModel.DeletedAt,
+	}
+
+	if todoModel.Edges.List != nil {
+		item.ListID = todoModel.Edges.List.UID
+	}
+
+	if todoModel.Edges.Parent != nil {
+		item.ParentID = todoModel.Edges.Parent.UID
+	}
+
+	if len(todoModel.Edges.Tags) > 0 {
+		item.Tags = unmarshalTags(todoModel.Edges.Tags)
+	}
+
+	if todoModel.RecurrenceRule != nil {
+		item.Recurrence = &todo.Recurrence{
+			Rule:       *todoModel.RecurrenceRule,
+			Timezone:   stringValue(todoModel.RecurrenceTimezone),
+			Start:      timeValue(todoModel.RecurrenceStart),
+			SeriesID:   stringValue(todoModel.SeriesID),
+			Occurrence: timeValue(todoModel.RecurrenceOccurrence),
+		}
+	}
+
+	return item
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
+
+func sameTime(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
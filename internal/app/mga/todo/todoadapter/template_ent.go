@@ -0,0 +1,91 @@
+package todoadapter
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/template"
+)
+
+// StoreTemplate stores a template.
+func (s EntStore) StoreTemplate(ctx context.Context, t todo.Template) error {
+	existing, err := s.db(ctx).Template.Query().Where(template.UID(t.ID)).First(ctx)
+	if ent.IsNotFound(err) {
+		_, err := s.db(ctx).Template.Create().
+			SetUID(t.ID).
+			SetName(t.Name).
+			SetDescription(t.Description).
+			SetItems(t.Items).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = s.db(ctx).Template.UpdateOneID(existing.ID).
+		SetName(t.Name).
+		SetDescription(t.Description).
+		SetItems(t.Items).
+		Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetAllTemplates returns all templates.
+func (s EntStore) GetAllTemplates(ctx context.Context) ([]todo.Template, error) {
+	templateModels, err := s.db(ctx).Template.Query().Order(ent.Asc(template.FieldUID)).All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	templates := make([]todo.Template, 0, len(templateModels))
+
+	for _, templateModel := range templateModels {
+		templates = append(templates, unmarshalTemplate(templateModel))
+	}
+
+	return templates, nil
+}
+
+// GetOneTemplate returns a single template by its ID.
+func (s EntStore) GetOneTemplate(ctx context.Context, id string) (todo.Template, error) {
+	templateModel, err := s.db(ctx).Template.Query().Where(template.UID(id)).First(ctx)
+	if ent.IsNotFound(err) {
+		return todo.Template{}, errors.WithStack(todo.TemplateNotFoundError{ID: id})
+	}
+	if err != nil {
+		return todo.Template{}, errors.WithStack(err)
+	}
+
+	return unmarshalTemplate(templateModel), nil
+}
+
+// DeleteOneTemplate deletes a single template by its ID.
+func (s EntStore) DeleteOneTemplate(ctx context.Context, id string) error {
+	_, err := s.db(ctx).Template.Delete().Where(template.UID(id)).Exec(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func unmarshalTemplate(templateModel *ent.Template) todo.Template {
+	return todo.Template{
+		ID:          templateModel.UID,
+		Name:        templateModel.Name,
+		Description: templateModel.Description,
+		Items:       templateModel.Items,
+	}
+}
@@ -0,0 +1,112 @@
+package todoadapter
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/predicate"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/timeentry"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo/todoadapter/ent/todoitem"
+)
+
+// StoreTimeEntry stores a time entry.
+func (s EntStore) StoreTimeEntry(ctx context.Context, entry todo.TimeEntry) error {
+	existing, err := s.db(ctx).TimeEntry.Query().Where(timeentry.UID(entry.ID)).First(ctx)
+	if ent.IsNotFound(err) {
+		itemID, err := s.db(ctx).TodoItem.Query().Where(todoitem.UID(entry.ItemID)).OnlyID(ctx)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+
+		_, err = s.db(ctx).TimeEntry.Create().
+			SetUID(entry.ID).
+			SetItemID(itemID).
+			SetUser(entry.User).
+			SetStartedAt(entry.StartedAt).
+			SetNillableEndedAt(entry.EndedAt).
+			Save(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	update := s.db(ctx).TimeEntry.UpdateOneID(existing.ID)
+
+	if entry.EndedAt != nil {
+		update.SetEndedAt(*entry.EndedAt)
+	} else {
+		update.ClearEndedAt()
+	}
+
+	_, err = update.Save(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// GetTimeEntries returns the time entries matching a filter, oldest first.
+func (s EntStore) GetTimeEntries(ctx context.Context, filter todo.TimeEntryFilter) ([]todo.TimeEntry, error) {
+	var predicates []predicate.TimeEntry
+
+	if filter.ItemID != "" {
+		predicates = append(predicates, timeentry.HasItemWith(todoitem.UID(filter.ItemID)))
+	}
+
+	if filter.User != "" {
+		predicates = append(predicates, timeentry.User(filter.User))
+	}
+
+	if filter.Running {
+		predicates = append(predicates, timeentry.EndedAtIsNil())
+	}
+
+	if filter.From != nil {
+		predicates = append(predicates, timeentry.Or(timeentry.EndedAtIsNil(), timeentry.EndedAtGT(*filter.From)))
+	}
+
+	if filter.To != nil {
+		predicates = append(predicates, timeentry.StartedAtLT(*filter.To))
+	}
+
+	entryModels, err := s.db(ctx).TimeEntry.Query().
+		Where(predicates...).
+		Order(ent.Asc(timeentry.FieldStartedAt), ent.Asc(timeentry.FieldUID)).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	entries := make([]todo.TimeEntry, 0, len(entryModels))
+
+	for _, entryModel := range entryModels {
+		entries = append(entries, unmarshalTimeEntry(entryModel))
+	}
+
+	return entries, nil
+}
+
+func unmarshalTimeEntry(entryModel *ent.TimeEntry) todo.TimeEntry {
+	entry := todo.TimeEntry{
+		ID:        entryModel.UID,
+		User:      entryModel.User,
+		StartedAt: entryModel.StartedAt,
+		EndedAt:   entryModel.EndedAt,
+	}
+
+	if entryModel.Edges.Item != nil {
+		entry.ItemID = entryModel.Edges.Item.UID
+	}
+
+	return entry
+}
@@ -0,0 +1,228 @@
+// Package gqlenum marshals domain types to and from GraphQL enums.
+//
+// The functions live in their own package, because the generated GraphQL code
+// cannot import the driver package (the driver imports the generated code).
+package gqlenum
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"emperror.dev/errors"
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// MarshalPriority marshals a priority to a GraphQL enum value.
+func MarshalPriority(priority todo.Priority) graphql.Marshaler {
+	return marshalEnum(priority.String())
+}
+
+// UnmarshalPriority unmarshals a priority from a GraphQL enum value.
+func UnmarshalPriority(v interface{}) (todo.Priority, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return todo.PriorityNone, err
+	}
+
+	return todo.ParsePriority(s)
+}
+
+// MarshalItemSort marshals an item order to a GraphQL enum value.
+func MarshalItemSort(sort todo.ItemSort) graphql.Marshaler {
+	return marshalEnum(string(sort))
+}
+
+// UnmarshalItemSort unmarshals an item order from a GraphQL enum value.
+func UnmarshalItemSort(v interface{}) (todo.ItemSort, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return "", err
+	}
+
+	return todo.ItemSort(s), nil
+}
+
+// MarshalBulkMode marshals a bulk mode to a GraphQL enum value.
+func MarshalBulkMode(mode todo.BulkMode) graphql.Marshaler {
+	if mode == todo.BulkBestEffort {
+		return marshalEnum("best_effort")
+	}
+
+	return marshalEnum(string(mode))
+}
+
+// UnmarshalBulkMode unmarshals a bulk mode from a GraphQL enum value.
+func UnmarshalBulkMode(v interface{}) (todo.BulkMode, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return "", err
+	}
+
+	// Enum values cannot be camel case
+	if s == "best_effort" {
+		return todo.BulkBestEffort, nil
+	}
+
+	return todo.BulkMode(s), nil
+}
+
+// MarshalBulkStatus marshals the outcome of a bulk change to a GraphQL enum value.
+func MarshalBulkStatus(status todo.BulkStatus) graphql.Marshaler {
+	return marshalEnum(string(status))
+}
+
+// UnmarshalBulkStatus unmarshals the outcome of a bulk change from a GraphQL enum value.
+func UnmarshalBulkStatus(v interface{}) (todo.BulkStatus, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return "", err
+	}
+
+	return todo.BulkStatus(s), nil
+}
+
+// MarshalShareAccess marshals a share access level to a GraphQL enum value.
+func MarshalShareAccess(access todo.ShareAccess) graphql.Marshaler {
+	return marshalEnum(string(access))
+}
+
+// UnmarshalShareAccess unmarshals a share access level from a GraphQL enum value.
+func UnmarshalShareAccess(v interface{}) (todo.ShareAccess, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return "", err
+	}
+
+	return todo.ShareAccess(s), nil
+}
+
+// MarshalCollaboratorRole marshals a collaborator role to a GraphQL enum value.
+func MarshalCollaboratorRole(role todo.CollaboratorRole) graphql.Marshaler {
+	return marshalEnum(string(role))
+}
+
+// UnmarshalCollaboratorRole unmarshals a collaborator role from a GraphQL enum value.
+func UnmarshalCollaboratorRole(v interface{}) (todo.CollaboratorRole, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return "", err
+	}
+
+	return todo.CollaboratorRole(s), nil
+}
+
+// MarshalRuleTrigger marshals a rule trigger to a GraphQL enum value.
+func MarshalRuleTrigger(trigger todo.RuleTrigger) graphql.Marshaler {
+	return marshalEnum(snakeCase(string(trigger)))
+}
+
+// UnmarshalRuleTrigger unmarshals a rule trigger from a GraphQL enum value.
+func UnmarshalRuleTrigger(v interface{}) (todo.RuleTrigger, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return "", err
+	}
+
+	return todo.RuleTrigger(camelCase(s, true)), nil
+}
+
+// MarshalRuleOperator marshals a rule operator to a GraphQL enum value.
+func MarshalRuleOperator(operator todo.RuleOperator) graphql.Marshaler {
+	return marshalEnum(snakeCase(string(operator)))
+}
+
+// UnmarshalRuleOperator unmarshals a rule operator from a GraphQL enum value.
+func UnmarshalRuleOperator(v interface{}) (todo.RuleOperator, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return "", err
+	}
+
+	return todo.RuleOperator(camelCase(s, false)), nil
+}
+
+// MarshalRuleActionType marshals a rule action type to a GraphQL enum value.
+func MarshalRuleActionType(actionType todo.RuleActionType) graphql.Marshaler {
+	return marshalEnum(snakeCase(string(actionType)))
+}
+
+// UnmarshalRuleActionType unmarshals a rule action type from a GraphQL enum value.
+func UnmarshalRuleActionType(v interface{}) (todo.RuleActionType, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return "", err
+	}
+
+	return todo.RuleActionType(camelCase(s, false)), nil
+}
+
+// MarshalRuleExecutionStatus marshals the outcome of a rule execution to a GraphQL enum value.
+func MarshalRuleExecutionStatus(status todo.RuleExecutionStatus) graphql.Marshaler {
+	return marshalEnum(snakeCase(string(status)))
+}
+
+// UnmarshalRuleExecutionStatus unmarshals the outcome of a rule execution from a GraphQL enum value.
+func UnmarshalRuleExecutionStatus(v interface{}) (todo.RuleExecutionStatus, error) {
+	s, err := unmarshalEnum(v)
+	if err != nil {
+		return "", err
+	}
+
+	return todo.RuleExecutionStatus(camelCase(s, false)), nil
+}
+
+// marshalEnum writes a domain value as an (upper case) enum value.
+func marshalEnum(s string) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Quote(strings.ToUpper(s)))
+	})
+}
+
+// unmarshalEnum reads an enum value as a (lower case) domain value.
+func unmarshalEnum(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", errors.New(fmt.Sprintf("enums must be strings, got %T", v))
+	}
+
+	return strings.ToLower(s), nil
+}
+
+// snakeCase converts a camel case domain value to snake case (enum values cannot be camel case).
+func snakeCase(s string) string {
+	var b strings.Builder
+
+	for i, r := range s {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteRune('_')
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// camelCase converts a snake case enum value to a camel case domain value.
+func camelCase(s string, upperFirst bool) string {
+	var b strings.Builder
+
+	for i, word := range strings.Split(s, "_") {
+		if word == "" {
+			continue
+		}
+
+		if i > 0 || upperFirst {
+			word = strings.ToUpper(word[:1]) + word[1:]
+		}
+
+		b.WriteString(word)
+	}
+
+	return b.String()
+}
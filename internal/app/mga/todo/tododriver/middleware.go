@@ -2,17 +2,17 @@ package tododriver
 
 import (
 	"context"
+	"io"
 
-	"github.com/sagikazarmark/todobackend-go-kit/todo"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/trace"
 
-	todo2 "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
 )
 
 // LoggingMiddleware is a service level logging middleware.
-func LoggingMiddleware(logger todo2.Logger) todo2.Middleware {
+func LoggingMiddleware(logger todo.Logger) todo.Middleware {
 	return func(next todo.Service) todo.Service {
 		return loggingMiddleware{
 			next:   next,
@@ -23,7 +23,7 @@ func LoggingMiddleware(logger todo2.Logger) todo2.Middleware {
 
 type loggingMiddleware struct {
 	next   todo.Service
-	logger todo2.Logger
+	logger todo.Logger
 }
 
 func (mw loggingMiddleware) AddItem(ctx context.Context, newItem todo.NewItem) (todo.Item, error) {
@@ -41,20 +41,20 @@ func (mw loggingMiddleware) AddItem(ctx context.Context, newItem todo.NewItem) (
 	return id, err
 }
 
-func (mw loggingMiddleware) ListItems(ctx context.Context) ([]todo.Item, error) {
+func (mw loggingMiddleware) ListItems(ctx context.Context, filter todo.ItemFilter) ([]todo.Item, error) {
 	logger := mw.logger.WithContext(ctx)
 
 	logger.Info("listing item")
 
-	return mw.next.ListItems(ctx)
+	return mw.next.ListItems(ctx, filter)
 }
 
-func (mw loggingMiddleware) DeleteItems(ctx context.Context) error {
+func (mw loggingMiddleware) DeleteItems(ctx context.Context, listID string) error {
 	logger := mw.logger.WithContext(ctx)
 
-	logger.Info("deleting all items")
+	logger.Info("deleting all items", map[string]interface{}{"list_id": listID})
 
-	return mw.next.DeleteItems(ctx)
+	return mw.next.DeleteItems(ctx, listID)
 }
 
 func (mw loggingMiddleware) GetItem(ctx context.Context, id string) (todo.Item, error) {
@@ -73,12 +73,655 @@ func (mw loggingMiddleware) UpdateItem(ctx context.Context, id string, itemUpdat
 	return mw.next.UpdateItem(ctx, id, itemUpdate)
 }
 
-func (mw loggingMiddleware) DeleteItem(ctx context.Context, id string) error {
+func (mw loggingMiddleware) ListItemTree(ctx context.Context, filter todo.ItemFilter, depth int) ([]todo.ItemTree, error) { // nolint: lll
 	logger := mw.logger.WithContext(ctx)
 
-	logger.Info("deleting item", map[string]interface{}{"item_id": id})
+	logger.Info("listing item tree", map[string]interface{}{"list_id": filter.ListID, "depth": depth})
 
-	return mw.next.DeleteItem(ctx, id)
+	return mw.next.ListItemTree(ctx, filter, depth)
+}
+
+func (mw loggingMiddleware) DeleteItem(ctx context.Context, id string, cascade bool) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("deleting item", map[string]interface{}{"item_id": id, "cascade": cascade})
+
+	return mw.next.DeleteItem(ctx, id, cascade)
+}
+
+func (mw loggingMiddleware) ListTrash(ctx context.Context, listID string) ([]todo.Item, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing trash", map[string]interface{}{"list_id": listID})
+
+	return mw.next.ListTrash(ctx, listID)
+}
+
+func (mw loggingMiddleware) RestoreItem(ctx context.Context, id string) (todo.Item, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("restoring item", map[string]interface{}{"item_id": id})
+
+	return mw.next.RestoreItem(ctx, id)
+}
+
+func (mw loggingMiddleware) PurgeItem(ctx context.Context, id string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("purging item", map[string]interface{}{"item_id": id})
+
+	return mw.next.PurgeItem(ctx, id)
+}
+
+func (mw loggingMiddleware) EmptyTrash(ctx context.Context, listID string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("emptying trash", map[string]interface{}{"list_id": listID})
+
+	return mw.next.EmptyTrash(ctx, listID)
+}
+
+func (mw loggingMiddleware) CreateList(ctx context.Context, newList todo.NewList) (todo.List, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("creating list")
+
+	list, err := mw.next.CreateList(ctx, newList)
+	if err != nil {
+		return list, err
+	}
+
+	logger.Info("created list", map[string]interface{}{"list_id": list.ID})
+
+	return list, err
+}
+
+func (mw loggingMiddleware) ListLists(ctx context.Context) ([]todo.List, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing lists")
+
+	return mw.next.ListLists(ctx)
+}
+
+func (mw loggingMiddleware) GetList(ctx context.Context, id string) (todo.List, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting list details", map[string]interface{}{"list_id": id})
+
+	return mw.next.GetList(ctx, id)
+}
+
+func (mw loggingMiddleware) UpdateList(ctx context.Context, id string, listUpdate todo.ListUpdate) (todo.List, error) { // nolint: lll
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("updating list", map[string]interface{}{"list_id": id})
+
+	return mw.next.UpdateList(ctx, id, listUpdate)
+}
+
+func (mw loggingMiddleware) DeleteList(ctx context.Context, id string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("deleting list", map[string]interface{}{"list_id": id})
+
+	return mw.next.DeleteList(ctx, id)
+}
+
+func (mw loggingMiddleware) CreateTag(ctx context.Context, newTag todo.NewTag) (todo.Tag, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("creating tag")
+
+	tag, err := mw.next.CreateTag(ctx, newTag)
+	if err != nil {
+		return tag, err
+	}
+
+	logger.Info("created tag", map[string]interface{}{"tag_id": tag.ID})
+
+	return tag, err
+}
+
+func (mw loggingMiddleware) ListTags(ctx context.Context) ([]todo.Tag, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing tags")
+
+	return mw.next.ListTags(ctx)
+}
+
+func (mw loggingMiddleware) GetTag(ctx context.Context, id string) (todo.Tag, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting tag details", map[string]interface{}{"tag_id": id})
+
+	return mw.next.GetTag(ctx, id)
+}
+
+func (mw loggingMiddleware) UpdateTag(ctx context.Context, id string, tagUpdate todo.TagUpdate) (todo.Tag, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("updating tag", map[string]interface{}{"tag_id": id})
+
+	return mw.next.UpdateTag(ctx, id, tagUpdate)
+}
+
+func (mw loggingMiddleware) MergeTags(ctx context.Context, sourceID string, targetID string) (todo.Tag, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("merging tags", map[string]interface{}{"tag_id": sourceID, "target_tag_id": targetID})
+
+	return mw.next.MergeTags(ctx, sourceID, targetID)
+}
+
+func (mw loggingMiddleware) DeleteTag(ctx context.Context, id string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("deleting tag", map[string]interface{}{"tag_id": id})
+
+	return mw.next.DeleteTag(ctx, id)
+}
+
+func (mw loggingMiddleware) TagItem(ctx context.Context, id string, tags []string) (todo.Item, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("tagging item", map[string]interface{}{"item_id": id})
+
+	return mw.next.TagItem(ctx, id, tags)
+}
+
+func (mw loggingMiddleware) UntagItem(ctx context.Context, id string, tags []string) (todo.Item, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("untagging item", map[string]interface{}{"item_id": id})
+
+	return mw.next.UntagItem(ctx, id, tags)
+}
+
+func (mw loggingMiddleware) AssignItem(ctx context.Context, id string, assignee string) (todo.Item, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("assigning item", map[string]interface{}{"item_id": id, "assignee": assignee})
+
+	return mw.next.AssignItem(ctx, id, assignee)
+}
+
+func (mw loggingMiddleware) UnassignItem(ctx context.Context, id string) (todo.Item, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("unassigning item", map[string]interface{}{"item_id": id})
+
+	return mw.next.UnassignItem(ctx, id)
+}
+
+func (mw loggingMiddleware) MoveItem(ctx context.Context, id string, move todo.ItemMove) (todo.Item, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("moving item", map[string]interface{}{"item_id": id})
+
+	return mw.next.MoveItem(ctx, id, move)
+}
+
+func (mw loggingMiddleware) ItemHistory(ctx context.Context, id string) ([]todo.HistoryEntry, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting item history", map[string]interface{}{"item_id": id})
+
+	return mw.next.ItemHistory(ctx, id)
+}
+
+func (mw loggingMiddleware) Undo(ctx context.Context, steps int) ([]todo.Operation, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("undoing operations", map[string]interface{}{"steps": steps})
+
+	return mw.next.Undo(ctx, steps)
+}
+
+func (mw loggingMiddleware) Redo(ctx context.Context, steps int) ([]todo.Operation, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("redoing operations", map[string]interface{}{"steps": steps})
+
+	return mw.next.Redo(ctx, steps)
+}
+
+func (mw loggingMiddleware) BulkAddItems(
+	ctx context.Context,
+	newItems []todo.NewItem,
+	mode todo.BulkMode,
+) ([]todo.BulkResult, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("adding items", map[string]interface{}{"count": len(newItems), "mode": string(mode)})
+
+	return mw.next.BulkAddItems(ctx, newItems, mode)
+}
+
+func (mw loggingMiddleware) BulkUpdateItems(
+	ctx context.Context,
+	updates []todo.BulkItemUpdate,
+	mode todo.BulkMode,
+) ([]todo.BulkResult, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("updating items", map[string]interface{}{"count": len(updates), "mode": string(mode)})
+
+	return mw.next.BulkUpdateItems(ctx, updates, mode)
+}
+
+func (mw loggingMiddleware) BulkDeleteItems(
+	ctx context.Context,
+	ids []string,
+	cascade bool,
+	mode todo.BulkMode,
+) ([]todo.BulkResult, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("deleting items", map[string]interface{}{"count": len(ids), "cascade": cascade, "mode": string(mode)})
+
+	return mw.next.BulkDeleteItems(ctx, ids, cascade, mode)
+}
+
+func (mw loggingMiddleware) ExportItems(ctx context.Context, listID string, format todo.Format) (todo.Export, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("exporting items", map[string]interface{}{"list_id": listID, "format": string(format)})
+
+	return mw.next.ExportItems(ctx, listID, format)
+}
+
+func (mw loggingMiddleware) ImportItems(
+	ctx context.Context,
+	data io.Reader,
+	options todo.ImportOptions,
+) (todo.ImportReport, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("importing items", map[string]interface{}{
+		"list_id":  options.ListID,
+		"format":   string(options.Format),
+		"strategy": string(options.Strategy),
+		"dry_run":  options.DryRun,
+	})
+
+	return mw.next.ImportItems(ctx, data, options)
+}
+
+func (mw loggingMiddleware) SearchItems(ctx context.Context, query todo.SearchQuery) ([]todo.SearchResult, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("searching items", map[string]interface{}{"list_id": query.ListID})
+
+	return mw.next.SearchItems(ctx, query)
+}
+
+func (mw loggingMiddleware) AddComment(ctx context.Context, itemID string, newComment todo.NewComment) (todo.Comment, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("adding comment", map[string]interface{}{"item_id": itemID})
+
+	return mw.next.AddComment(ctx, itemID, newComment)
+}
+
+func (mw loggingMiddleware) ListComments(ctx context.Context, itemID string) ([]todo.Comment, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing comments", map[string]interface{}{"item_id": itemID})
+
+	return mw.next.ListComments(ctx, itemID)
+}
+
+func (mw loggingMiddleware) GetComment(ctx context.Context, id string) (todo.Comment, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting comment details", map[string]interface{}{"comment_id": id})
+
+	return mw.next.GetComment(ctx, id)
+}
+
+func (mw loggingMiddleware) UpdateComment(ctx context.Context, id string, body string) (todo.Comment, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("updating comment", map[string]interface{}{"comment_id": id})
+
+	return mw.next.UpdateComment(ctx, id, body)
+}
+
+func (mw loggingMiddleware) DeleteComment(ctx context.Context, id string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("deleting comment", map[string]interface{}{"comment_id": id})
+
+	return mw.next.DeleteComment(ctx, id)
+}
+
+func (mw loggingMiddleware) AddAttachment(
+	ctx context.Context,
+	itemID string,
+	newAttachment todo.NewAttachment,
+) (todo.Attachment, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("adding attachment", map[string]interface{}{"item_id": itemID, "name": newAttachment.Name})
+
+	return mw.next.AddAttachment(ctx, itemID, newAttachment)
+}
+
+func (mw loggingMiddleware) ListAttachments(ctx context.Context, itemID string) ([]todo.Attachment, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing attachments", map[string]interface{}{"item_id": itemID})
+
+	return mw.next.ListAttachments(ctx, itemID)
+}
+
+func (mw loggingMiddleware) GetAttachment(ctx context.Context, id string) (todo.Attachment, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting attachment details", map[string]interface{}{"attachment_id": id})
+
+	return mw.next.GetAttachment(ctx, id)
+}
+
+func (mw loggingMiddleware) DownloadAttachment(
+	ctx context.Context,
+	id string,
+	link todo.AttachmentLink,
+) (todo.AttachmentDownload, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("downloading attachment", map[string]interface{}{"attachment_id": id})
+
+	return mw.next.DownloadAttachment(ctx, id, link)
+}
+
+func (mw loggingMiddleware) DeleteAttachment(ctx context.Context, id string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("deleting attachment", map[string]interface{}{"attachment_id": id})
+
+	return mw.next.DeleteAttachment(ctx, id)
+}
+
+func (mw loggingMiddleware) CreateShareToken(
+	ctx context.Context,
+	listID string,
+	newShareToken todo.NewShareToken,
+) (todo.ShareToken, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("creating share token", map[string]interface{}{"list_id": listID, "access": string(newShareToken.Access)})
+
+	return mw.next.CreateShareToken(ctx, listID, newShareToken)
+}
+
+func (mw loggingMiddleware) ListShareTokens(ctx context.Context, listID string) ([]todo.ShareToken, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing share tokens", map[string]interface{}{"list_id": listID})
+
+	return mw.next.ListShareTokens(ctx, listID)
+}
+
+func (mw loggingMiddleware) RevokeShareToken(ctx context.Context, id string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("revoking share token", map[string]interface{}{"share_id": id})
+
+	return mw.next.RevokeShareToken(ctx, id)
+}
+
+func (mw loggingMiddleware) ListShareAccess(ctx context.Context, listID string) ([]todo.ShareAccessEntry, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing share access", map[string]interface{}{"list_id": listID})
+
+	return mw.next.ListShareAccess(ctx, listID)
+}
+
+func (mw loggingMiddleware) InviteCollaborator(
+	ctx context.Context,
+	listID string,
+	invitation todo.Invitation,
+) (todo.Collaborator, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("inviting collaborator", map[string]interface{}{"list_id": listID, "role": string(invitation.Role)})
+
+	return mw.next.InviteCollaborator(ctx, listID, invitation)
+}
+
+func (mw loggingMiddleware) ListCollaborators(ctx context.Context, listID string) ([]todo.Collaborator, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing collaborators", map[string]interface{}{"list_id": listID})
+
+	return mw.next.ListCollaborators(ctx, listID)
+}
+
+func (mw loggingMiddleware) RemoveCollaborator(ctx context.Context, listID string, email string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("removing collaborator", map[string]interface{}{"list_id": listID})
+
+	return mw.next.RemoveCollaborator(ctx, listID, email)
+}
+
+// GetSharedList does not log the secret token: accesses through it are recorded in the access log of the list.
+func (mw loggingMiddleware) GetSharedList(ctx context.Context, token string) (todo.SharedList, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting shared list")
+
+	return mw.next.GetSharedList(ctx, token)
+}
+
+func (mw loggingMiddleware) AddSharedItem(ctx context.Context, token string, newItem todo.NewItem) (todo.Item, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("adding shared item")
+
+	return mw.next.AddSharedItem(ctx, token, newItem)
+}
+
+func (mw loggingMiddleware) UpdateSharedItem(
+	ctx context.Context,
+	token string,
+	id string,
+	itemUpdate todo.ItemUpdate,
+) (todo.Item, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("updating shared item", map[string]interface{}{"item_id": id})
+
+	return mw.next.UpdateSharedItem(ctx, token, id, itemUpdate)
+}
+
+func (mw loggingMiddleware) StartTimer(ctx context.Context, itemID string) (todo.TimeEntry, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("starting timer", map[string]interface{}{"item_id": itemID})
+
+	return mw.next.StartTimer(ctx, itemID)
+}
+
+func (mw loggingMiddleware) StopTimer(ctx context.Context) (todo.TimeEntry, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("stopping timer")
+
+	return mw.next.StopTimer(ctx)
+}
+
+func (mw loggingMiddleware) GetTimer(ctx context.Context) (todo.TimeEntry, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting running timer")
+
+	return mw.next.GetTimer(ctx)
+}
+
+func (mw loggingMiddleware) ListTimeEntries(ctx context.Context, filter todo.TimeEntryFilter) ([]todo.TimeEntry, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing time entries", map[string]interface{}{"item_id": filter.ItemID, "user": filter.User})
+
+	return mw.next.ListTimeEntries(ctx, filter)
+}
+
+func (mw loggingMiddleware) TimeTotals(ctx context.Context, filter todo.TimeEntryFilter) (todo.TimeTotals, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("summing up time entries", map[string]interface{}{"item_id": filter.ItemID, "user": filter.User})
+
+	return mw.next.TimeTotals(ctx, filter)
+}
+
+func (mw loggingMiddleware) ExportTimesheet(ctx context.Context, filter todo.TimeEntryFilter) (todo.Timesheet, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("exporting timesheet", map[string]interface{}{"item_id": filter.ItemID, "user": filter.User})
+
+	return mw.next.ExportTimesheet(ctx, filter)
+}
+
+func (mw loggingMiddleware) CreateTemplate(ctx context.Context, newTemplate todo.NewTemplate) (todo.Template, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("creating template")
+
+	template, err := mw.next.CreateTemplate(ctx, newTemplate)
+	if err != nil {
+		return template, err
+	}
+
+	logger.Info("created template", map[string]interface{}{"template_id": template.ID})
+
+	return template, err
+}
+
+func (mw loggingMiddleware) ListTemplates(ctx context.Context) ([]todo.Template, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing templates")
+
+	return mw.next.ListTemplates(ctx)
+}
+
+func (mw loggingMiddleware) GetTemplate(ctx context.Context, id string) (todo.Template, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting template details", map[string]interface{}{"template_id": id})
+
+	return mw.next.GetTemplate(ctx, id)
+}
+
+func (mw loggingMiddleware) UpdateTemplate(
+	ctx context.Context,
+	id string,
+	templateUpdate todo.TemplateUpdate,
+) (todo.Template, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("updating template", map[string]interface{}{"template_id": id})
+
+	return mw.next.UpdateTemplate(ctx, id, templateUpdate)
+}
+
+func (mw loggingMiddleware) DeleteTemplate(ctx context.Context, id string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("deleting template", map[string]interface{}{"template_id": id})
+
+	return mw.next.DeleteTemplate(ctx, id)
+}
+
+func (mw loggingMiddleware) CreateRule(ctx context.Context, newRule todo.NewRule) (todo.Rule, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("creating rule")
+
+	rule, err := mw.next.CreateRule(ctx, newRule)
+	if err != nil {
+		return rule, err
+	}
+
+	logger.Info("created rule", map[string]interface{}{"rule_id": rule.ID})
+
+	return rule, err
+}
+
+func (mw loggingMiddleware) ListRules(ctx context.Context) ([]todo.Rule, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing rules")
+
+	return mw.next.ListRules(ctx)
+}
+
+func (mw loggingMiddleware) GetRule(ctx context.Context, id string) (todo.Rule, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("getting rule details", map[string]interface{}{"rule_id": id})
+
+	return mw.next.GetRule(ctx, id)
+}
+
+func (mw loggingMiddleware) UpdateRule(ctx context.Context, id string, ruleUpdate todo.RuleUpdate) (todo.Rule, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("updating rule", map[string]interface{}{"rule_id": id})
+
+	return mw.next.UpdateRule(ctx, id, ruleUpdate)
+}
+
+func (mw loggingMiddleware) DeleteRule(ctx context.Context, id string) error {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("deleting rule", map[string]interface{}{"rule_id": id})
+
+	return mw.next.DeleteRule(ctx, id)
+}
+
+func (mw loggingMiddleware) EvaluateRule(ctx context.Context, id string, itemID string) (todo.RuleEvaluation, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("evaluating rule", map[string]interface{}{"rule_id": id, "item_id": itemID})
+
+	return mw.next.EvaluateRule(ctx, id, itemID)
+}
+
+func (mw loggingMiddleware) ListRuleExecutions(ctx context.Context, id string) ([]todo.RuleExecution, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("listing rule executions", map[string]interface{}{"rule_id": id})
+
+	return mw.next.ListRuleExecutions(ctx, id)
+}
+
+func (mw loggingMiddleware) InstantiateTemplate(
+	ctx context.Context,
+	id string,
+	instantiation todo.TemplateInstantiation,
+) (todo.TemplateInstance, error) {
+	logger := mw.logger.WithContext(ctx)
+
+	logger.Info("instantiating template", map[string]interface{}{"template_id": id, "list_id": instantiation.ListID})
+
+	instance, err := mw.next.InstantiateTemplate(ctx, id, instantiation)
+	if err != nil {
+		return instance, err
+	}
+
+	logger.Info("instantiated template", map[string]interface{}{
+		"template_id": id,
+		"list_id":     instance.List.ID,
+		"item_count":  len(instance.Items),
+	})
+
+	return instance, err
 }
 
 // Business metrics
@@ -106,10 +749,10 @@ var (
 )
 
 // InstrumentationMiddleware is a service level instrumentation middleware.
-func InstrumentationMiddleware() todo2.Middleware {
+func InstrumentationMiddleware() todo.Middleware {
 	return func(next todo.Service) todo.Service {
 		return instrumentationMiddleware{
-			Service: todo2.DefaultMiddleware{Service: next},
+			Service: todo.DefaultMiddleware{Service: next},
 			next:    next,
 		}
 	}
@@ -146,3 +789,109 @@ func (mw instrumentationMiddleware) UpdateItem(ctx context.Context, id string, i
 
 	return mw.next.UpdateItem(ctx, id, itemUpdate)
 }
+
+func (mw instrumentationMiddleware) BulkAddItems(
+	ctx context.Context,
+	newItems []todo.NewItem,
+	mode todo.BulkMode,
+) ([]todo.BulkResult, error) {
+	results, err := mw.next.BulkAddItems(ctx, newItems, mode)
+	if err != nil {
+		return results, err
+	}
+
+	stats.Record(ctx, CreatedTodoItemCount.M(countSucceeded(results, nil)))
+
+	return results, nil
+}
+
+func (mw instrumentationMiddleware) BulkUpdateItems(
+	ctx context.Context,
+	updates []todo.BulkItemUpdate,
+	mode todo.BulkMode,
+) ([]todo.BulkResult, error) {
+	results, err := mw.next.BulkUpdateItems(ctx, updates, mode)
+	if err != nil {
+		return results, err
+	}
+
+	completed := countSucceeded(results, func(i int) bool {
+		return updates[i].Update.Completed != nil && *updates[i].Update.Completed
+	})
+
+	stats.Record(ctx, CompleteTodoItemCount.M(completed))
+
+	return results, nil
+}
+
+func (mw instrumentationMiddleware) InstantiateTemplate(
+	ctx context.Context,
+	id string,
+	instantiation todo.TemplateInstantiation,
+) (todo.TemplateInstance, error) {
+	instance, err := mw.next.InstantiateTemplate(ctx, id, instantiation)
+	if err != nil {
+		return instance, err
+	}
+
+	stats.Record(ctx, CreatedTodoItemCount.M(int64(len(instance.Items))))
+
+	return instance, nil
+}
+
+func (mw instrumentationMiddleware) AddSharedItem(
+	ctx context.Context,
+	token string,
+	newItem todo.NewItem,
+) (todo.Item, error) {
+	item, err := mw.next.AddSharedItem(ctx, token, newItem)
+	if err != nil {
+		return item, err
+	}
+
+	stats.Record(ctx, CreatedTodoItemCount.M(1))
+
+	return item, nil
+}
+
+func (mw instrumentationMiddleware) UpdateSharedItem(
+	ctx context.Context,
+	token string,
+	id string,
+	itemUpdate todo.ItemUpdate,
+) (todo.Item, error) {
+	if itemUpdate.Completed != nil && *itemUpdate.Completed {
+		stats.Record(ctx, CompleteTodoItemCount.M(1))
+	}
+
+	return mw.next.UpdateSharedItem(ctx, token, id, itemUpdate)
+}
+
+// ImportItems counts the imported items just like adding them one by one.
+func (mw instrumentationMiddleware) ImportItems(
+	ctx context.Context,
+	data io.Reader,
+	options todo.ImportOptions,
+) (todo.ImportReport, error) {
+	report, err := mw.next.ImportItems(ctx, data, options)
+	if err != nil || report.DryRun {
+		return report, err
+	}
+
+	stats.Record(ctx, CreatedTodoItemCount.M(int64(report.Added)))
+
+	return report, nil
+}
+
+// countSucceeded counts the succeeded changes of a bulk operation (matching an optional filter).
+func countSucceeded(results []todo.BulkResult, match func(i int) bool) int64 {
+	var count int64
+
+	for i, result := range results {
+		if result.Status == todo.BulkSucceeded && (match == nil || match(i)) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,2501 @@
+package tododriver
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	graphql2 "github.com/99designs/gqlgen/graphql"
+	appkiterrors "github.com/sagikazarmark/appkit/errors"
+	kitxgraphql "github.com/sagikazarmark/kitx/transport/graphql"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/generated/api/todo/v1/graphql"
+)
+
+// MakeGraphQLSchema mounts all of the service endpoints into a GraphQL executable schema.
+func MakeGraphQLSchema(endpoints Endpoints, options ...kitxgraphql.ServerOption) graphql2.ExecutableSchema {
+	return graphql.NewExecutableSchema(graphql.Config{
+		Resolvers: MakeGraphQLResolver(endpoints, options...),
+	})
+}
+
+// MakeGraphQLResolver mounts all of the service endpoints into a GraphQL resolver.
+func MakeGraphQLResolver(endpoints Endpoints, options ...kitxgraphql.ServerOption) graphql.ResolverRoot {
+	errorEncoder := func(_ context.Context, err error) error {
+		return err
+	}
+
+	return &resolver{
+		AddTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.AddItem,
+			decodeAddItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeAddItemGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.UpdateItem,
+			decodeUpdateItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeUpdateItemGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListTodoItemsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListItems,
+			decodeListItemsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListItemsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListTodoItemTreeHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListItemTree,
+			decodeListItemTreeGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListItemTreeGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.DeleteItem,
+			decodeDeleteItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteTodoItemsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.DeleteItems,
+			decodeDeleteItemsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateTodoListHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.CreateList,
+			decodeCreateListGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeCreateListGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListTodoListsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListLists,
+			decodeListListsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListListsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetTodoListHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.GetList,
+			decodeGetListGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeGetListGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateTodoListHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.UpdateList,
+			decodeUpdateListGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeUpdateListGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteTodoListHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.DeleteList,
+			decodeDeleteListGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateTagHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.CreateTag,
+			decodeCreateTagGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeCreateTagGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListTagsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListTags,
+			decodeListTagsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListTagsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetTagHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.GetTag,
+			decodeGetTagGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeGetTagGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateTagHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.UpdateTag,
+			decodeUpdateTagGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeUpdateTagGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MergeTagsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.MergeTags,
+			decodeMergeTagsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeMergeTagsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteTagHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.DeleteTag,
+			decodeDeleteTagGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TagTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.TagItem,
+			decodeTagItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeTagItemGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UntagTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.UntagItem,
+			decodeUntagItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeUntagItemGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		AssignTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.AssignItem,
+			decodeAssignItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeAssignItemGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UnassignTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.UnassignItem,
+			decodeUnassignItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeUnassignItemGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MoveTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.MoveItem,
+			decodeMoveItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeMoveItemGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListTrashHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListTrash,
+			decodeListTrashGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListTrashGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RestoreTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.RestoreItem,
+			decodeRestoreItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeRestoreItemGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		PurgeTodoItemHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.PurgeItem,
+			decodePurgeItemGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		EmptyTrashHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.EmptyTrash,
+			decodeEmptyTrashGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TodoItemHistoryHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ItemHistory,
+			decodeItemHistoryGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeItemHistoryGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		SearchTodoItemsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.SearchItems,
+			decodeSearchItemsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeSearchItemsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UndoHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.Undo,
+			decodeUndoGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeUndoGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RedoHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.Redo,
+			decodeRedoGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeRedoGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		BulkAddTodoItemsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.BulkAddItems,
+			decodeBulkAddItemsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeBulkAddItemsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		BulkUpdateTodoItemsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.BulkUpdateItems,
+			decodeBulkUpdateItemsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeBulkUpdateItemsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		BulkDeleteTodoItemsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.BulkDeleteItems,
+			decodeBulkDeleteItemsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeBulkDeleteItemsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		AddCommentHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.AddComment,
+			decodeAddCommentGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeAddCommentGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TodoItemCommentsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListComments,
+			decodeListCommentsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListCommentsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CommentHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.GetComment,
+			decodeGetCommentGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeGetCommentGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateCommentHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.UpdateComment,
+			decodeUpdateCommentGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeUpdateCommentGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteCommentHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.DeleteComment,
+			decodeDeleteCommentGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		AddAttachmentHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.AddAttachment,
+			decodeAddAttachmentGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeAddAttachmentGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TodoItemAttachmentsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListAttachments,
+			decodeListAttachmentsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListAttachmentsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		AttachmentHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.GetAttachment,
+			decodeGetAttachmentGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeGetAttachmentGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteAttachmentHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.DeleteAttachment,
+			decodeDeleteAttachmentGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateShareTokenHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.CreateShareToken,
+			decodeCreateShareTokenGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeCreateShareTokenGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ShareTokensHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListShareTokens,
+			decodeListShareTokensGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListShareTokensGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RevokeShareTokenHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.RevokeShareToken,
+			decodeRevokeShareTokenGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ShareAccessLogHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListShareAccess,
+			decodeListShareAccessGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListShareAccessGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		InviteCollaboratorHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.InviteCollaborator,
+			decodeInviteCollaboratorGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeInviteCollaboratorGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CollaboratorsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListCollaborators,
+			decodeListCollaboratorsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListCollaboratorsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RemoveCollaboratorHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.RemoveCollaborator,
+			decodeRemoveCollaboratorGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		StartTimerHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.StartTimer,
+			decodeStartTimerGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeTimeEntryGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		StopTimerHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.StopTimer,
+			decodeStopTimerGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeTimeEntryGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TimerHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.GetTimer,
+			decodeGetTimerGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeTimeEntryGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TimeEntriesHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListTimeEntries,
+			decodeListTimeEntriesGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListTimeEntriesGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TimeTotalsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.TimeTotals,
+			decodeTimeTotalsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeTimeTotalsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateTemplateHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.CreateTemplate,
+			decodeCreateTemplateGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeTemplateGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TemplatesHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListTemplates,
+			decodeListTemplatesGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListTemplatesGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TemplateHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.GetTemplate,
+			decodeGetTemplateGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeTemplateGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateTemplateHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.UpdateTemplate,
+			decodeUpdateTemplateGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeTemplateGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteTemplateHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.DeleteTemplate,
+			decodeDeleteTemplateGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		InstantiateTemplateHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.InstantiateTemplate,
+			decodeInstantiateTemplateGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeInstantiateTemplateGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateRuleHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.CreateRule,
+			decodeCreateRuleGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeRuleGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RulesHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListRules,
+			decodeListRulesGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListRulesGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RuleHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.GetRule,
+			decodeGetRuleGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeRuleGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateRuleHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.UpdateRule,
+			decodeUpdateRuleGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeRuleGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteRuleHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.DeleteRule,
+			decodeDeleteRuleGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeDeleteGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		EvaluateRuleHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.EvaluateRule,
+			decodeEvaluateRuleGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeEvaluateRuleGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RuleExecutionsHandler: kitxgraphql.NewErrorEncoderHandler(kitxgraphql.NewServer(
+			endpoints.ListRuleExecutions,
+			decodeListRuleExecutionsGraphQLRequest,
+			kitxgraphql.ErrorResponseEncoder(encodeListRuleExecutionsGraphQLResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+	}
+}
+
+func decodeAddItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return AddItemRequest{
+		NewItem: request.(todo.NewItem),
+	}, nil
+}
+
+func encodeAddItemGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	item := response.(AddItemResponse).Item
+
+	return &item, nil
+}
+
+func decodeUpdateItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(graphql.TodoItemUpdate)
+
+	return UpdateItemRequest{
+		Id: req.ID,
+		ItemUpdate: todo.ItemUpdate{
+			Title:          req.Title,
+			Notes:          req.Notes,
+			Completed:      req.Completed,
+			Order:          req.Order,
+			DueAt:          req.DueAt,
+			ClearDueAt:     req.ClearDueAt != nil && *req.ClearDueAt,
+			Priority:       req.Priority,
+			Recurrence:     req.Recurrence,
+			EndRecurrence:  req.EndRecurrence != nil && *req.EndRecurrence,
+			SkipOccurrence: req.SkipOccurrence != nil && *req.SkipOccurrence,
+			ListID:         req.ListID,
+			ParentID:       req.ParentID,
+			AutoComplete:   req.AutoComplete,
+		},
+	}, nil
+}
+
+func encodeUpdateItemGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	item := response.(UpdateItemResponse).Item
+
+	return &item, nil
+}
+
+func decodeListItemsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	var filter todo.ItemFilter
+
+	if f, ok := request.(*todo.ItemFilter); ok && f != nil {
+		filter = *f
+	}
+
+	return ListItemsRequest{
+		Filter: filter,
+	}, nil
+}
+
+func encodeListItemsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListItemsResponse).Items, nil
+}
+
+// todoItemTreeGraphQLRequest holds the arguments of the todoItemTree query.
+type todoItemTreeGraphQLRequest struct {
+	filter *todo.ItemFilter
+	depth  *int
+}
+
+func decodeListItemTreeGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(todoItemTreeGraphQLRequest)
+
+	var filter todo.ItemFilter
+
+	if req.filter != nil {
+		filter = *req.filter
+	}
+
+	var depth int
+
+	if req.depth != nil {
+		depth = *req.depth
+	}
+
+	return ListItemTreeRequest{
+		Filter: filter,
+		Depth:  depth,
+	}, nil
+}
+
+func encodeListItemTreeGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListItemTreeResponse).Trees, nil
+}
+
+// deleteTodoItemGraphQLRequest holds the arguments of the deleteTodoItem mutation.
+type deleteTodoItemGraphQLRequest struct {
+	id      string
+	cascade *bool
+}
+
+func decodeDeleteItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(deleteTodoItemGraphQLRequest)
+
+	return DeleteItemRequest{
+		Id:      req.id,
+		Cascade: req.cascade != nil && *req.cascade,
+	}, nil
+}
+
+func decodeDeleteItemsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	var listID string
+
+	if id, ok := request.(*string); ok && id != nil {
+		listID = *id
+	}
+
+	return DeleteItemsRequest{
+		ListID: listID,
+	}, nil
+}
+
+func encodeDeleteGraphQLResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return true, nil
+}
+
+func decodeCreateListGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return CreateListRequest{
+		NewList: request.(todo.NewList),
+	}, nil
+}
+
+func encodeCreateListGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	list := response.(CreateListResponse).List
+
+	return &list, nil
+}
+
+func decodeListListsGraphQLRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return ListListsRequest{}, nil
+}
+
+func encodeListListsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListListsResponse).Lists, nil
+}
+
+func decodeGetListGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return GetListRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func encodeGetListGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	list := response.(GetListResponse).List
+
+	return &list, nil
+}
+
+func decodeUpdateListGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(graphql.TodoListUpdate)
+
+	return UpdateListRequest{
+		Id: req.ID,
+		ListUpdate: todo.ListUpdate{
+			Name:        req.Name,
+			Description: req.Description,
+			Archived:    req.Archived,
+		},
+	}, nil
+}
+
+func encodeUpdateListGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	list := response.(UpdateListResponse).List
+
+	return &list, nil
+}
+
+func decodeDeleteListGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return DeleteListRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func decodeCreateTagGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return CreateTagRequest{
+		NewTag: request.(todo.NewTag),
+	}, nil
+}
+
+func encodeCreateTagGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	tag := response.(CreateTagResponse).Tag
+
+	return &tag, nil
+}
+
+func decodeListTagsGraphQLRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return ListTagsRequest{}, nil
+}
+
+func encodeListTagsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListTagsResponse).Tags, nil
+}
+
+func decodeGetTagGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return GetTagRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func encodeGetTagGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	tag := response.(GetTagResponse).Tag
+
+	return &tag, nil
+}
+
+func decodeUpdateTagGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(graphql.TagUpdate)
+
+	return UpdateTagRequest{
+		Id: req.ID,
+		TagUpdate: todo.TagUpdate{
+			Name:  req.Name,
+			Color: req.Color,
+		},
+	}, nil
+}
+
+func encodeUpdateTagGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	tag := response.(UpdateTagResponse).Tag
+
+	return &tag, nil
+}
+
+// mergeTagsGraphQLRequest holds the arguments of the mergeTags mutation.
+type mergeTagsGraphQLRequest struct {
+	id       string
+	targetID string
+}
+
+func decodeMergeTagsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mergeTagsGraphQLRequest)
+
+	return MergeTagsRequest{
+		SourceID: req.id,
+		TargetID: req.targetID,
+	}, nil
+}
+
+func encodeMergeTagsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	tag := response.(MergeTagsResponse).Tag
+
+	return &tag, nil
+}
+
+func decodeDeleteTagGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return DeleteTagRequest{
+		Id: request.(string),
+	}, nil
+}
+
+// tagTodoItemGraphQLRequest holds the arguments of the tagTodoItem and untagTodoItem mutations.
+type tagTodoItemGraphQLRequest struct {
+	id   string
+	tags []string
+}
+
+func decodeTagItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(tagTodoItemGraphQLRequest)
+
+	return TagItemRequest{
+		Id:   req.id,
+		Tags: req.tags,
+	}, nil
+}
+
+func encodeTagItemGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	item := response.(TagItemResponse).Item
+
+	return &item, nil
+}
+
+func decodeUntagItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(tagTodoItemGraphQLRequest)
+
+	return UntagItemRequest{
+		Id:   req.id,
+		Tags: req.tags,
+	}, nil
+}
+
+func encodeUntagItemGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	item := response.(UntagItemResponse).Item
+
+	return &item, nil
+}
+
+// assignTodoItemGraphQLRequest holds the arguments of the assignTodoItem mutation.
+type assignTodoItemGraphQLRequest struct {
+	id       string
+	assignee string
+}
+
+func decodeAssignItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(assignTodoItemGraphQLRequest)
+
+	return AssignItemRequest{
+		Id:       req.id,
+		Assignee: req.assignee,
+	}, nil
+}
+
+func encodeAssignItemGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	item := response.(AssignItemResponse).Item
+
+	return &item, nil
+}
+
+func decodeUnassignItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return UnassignItemRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func encodeUnassignItemGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	item := response.(UnassignItemResponse).Item
+
+	return &item, nil
+}
+
+// moveTodoItemGraphQLRequest holds the arguments of the moveTodoItem mutation.
+type moveTodoItemGraphQLRequest struct {
+	id     string
+	before *string
+	after  *string
+}
+
+func decodeMoveItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(moveTodoItemGraphQLRequest)
+
+	var move todo.ItemMove
+
+	if req.before != nil {
+		move.Before = *req.before
+	}
+
+	if req.after != nil {
+		move.After = *req.after
+	}
+
+	return MoveItemRequest{
+		Id:   req.id,
+		Move: move,
+	}, nil
+}
+
+func encodeMoveItemGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	item := response.(MoveItemResponse).Item
+
+	return &item, nil
+}
+
+func decodeListTrashGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	var listID string
+
+	if id, ok := request.(*string); ok && id != nil {
+		listID = *id
+	}
+
+	return ListTrashRequest{
+		ListID: listID,
+	}, nil
+}
+
+func encodeListTrashGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListTrashResponse).Items, nil
+}
+
+func decodeRestoreItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return RestoreItemRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func encodeRestoreItemGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	item := response.(RestoreItemResponse).Item
+
+	return &item, nil
+}
+
+func decodePurgeItemGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return PurgeItemRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func decodeItemHistoryGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return ItemHistoryRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func encodeItemHistoryGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ItemHistoryResponse).Entries, nil
+}
+
+// searchTodoItemsGraphQLRequest holds the arguments of the searchTodoItems query.
+type searchTodoItemsGraphQLRequest struct {
+	query  string
+	listID *string
+	limit  *int
+}
+
+func decodeSearchItemsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(searchTodoItemsGraphQLRequest)
+
+	query := todo.SearchQuery{
+		Query: req.query,
+	}
+
+	if req.listID != nil {
+		query.ListID = *req.listID
+	}
+
+	if req.limit != nil {
+		query.Limit = *req.limit
+	}
+
+	return SearchItemsRequest{
+		Query: query,
+	}, nil
+}
+
+func encodeSearchItemsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(SearchItemsResponse).Results, nil
+}
+
+// commentGraphQLRequest holds the arguments of the addComment and updateComment mutations.
+type commentGraphQLRequest struct {
+	id   string
+	body string
+}
+
+func decodeAddCommentGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(commentGraphQLRequest)
+
+	return AddCommentRequest{
+		ItemID: req.id,
+		NewComment: todo.NewComment{
+			Body: req.body,
+		},
+	}, nil
+}
+
+func encodeAddCommentGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	comment := response.(AddCommentResponse).Comment
+
+	return &comment, nil
+}
+
+func decodeListCommentsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return ListCommentsRequest{
+		ItemID: request.(string),
+	}, nil
+}
+
+func encodeListCommentsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListCommentsResponse).Comments, nil
+}
+
+func decodeGetCommentGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return GetCommentRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func encodeGetCommentGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	comment := response.(GetCommentResponse).Comment
+
+	return &comment, nil
+}
+
+func decodeUpdateCommentGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(commentGraphQLRequest)
+
+	return UpdateCommentRequest{
+		Id:   req.id,
+		Body: req.body,
+	}, nil
+}
+
+func encodeUpdateCommentGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	comment := response.(UpdateCommentResponse).Comment
+
+	return &comment, nil
+}
+
+func decodeDeleteCommentGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return DeleteCommentRequest{
+		Id: request.(string),
+	}, nil
+}
+
+// addAttachmentGraphQLRequest holds the arguments of the addAttachment mutation.
+type addAttachmentGraphQLRequest struct {
+	itemID   string
+	file     graphql2.Upload
+	checksum *string
+}
+
+func decodeAddAttachmentGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(addAttachmentGraphQLRequest)
+
+	newAttachment := todo.NewAttachment{
+		Name:        req.file.Filename,
+		ContentType: req.file.ContentType,
+		Size:        req.file.Size,
+		Content:     req.file.File,
+	}
+
+	if req.checksum != nil {
+		newAttachment.Checksum = *req.checksum
+	}
+
+	return AddAttachmentRequest{
+		ItemID:        req.itemID,
+		NewAttachment: newAttachment,
+	}, nil
+}
+
+func encodeAddAttachmentGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	attachment := response.(AddAttachmentResponse).Attachment
+
+	return &attachment, nil
+}
+
+func decodeListAttachmentsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return ListAttachmentsRequest{
+		ItemID: request.(string),
+	}, nil
+}
+
+func encodeListAttachmentsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListAttachmentsResponse).Attachments, nil
+}
+
+func decodeGetAttachmentGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return GetAttachmentRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func encodeGetAttachmentGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	attachment := response.(GetAttachmentResponse).Attachment
+
+	return &attachment, nil
+}
+
+func decodeDeleteAttachmentGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return DeleteAttachmentRequest{
+		Id: request.(string),
+	}, nil
+}
+
+// createShareTokenGraphQLRequest holds the arguments of the createShareToken mutation.
+type createShareTokenGraphQLRequest struct {
+	listID    string
+	access    *todo.ShareAccess
+	expiresAt *time.Time
+}
+
+func decodeCreateShareTokenGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(createShareTokenGraphQLRequest)
+
+	newShareToken := todo.NewShareToken{
+		ExpiresAt: req.expiresAt,
+	}
+
+	if req.access != nil {
+		newShareToken.Access = *req.access
+	}
+
+	return CreateShareTokenRequest{
+		ListID:        req.listID,
+		NewShareToken: newShareToken,
+	}, nil
+}
+
+func encodeCreateShareTokenGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	token := response.(CreateShareTokenResponse).Token
+
+	return &token, nil
+}
+
+func decodeListShareTokensGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return ListShareTokensRequest{
+		ListID: request.(string),
+	}, nil
+}
+
+func encodeListShareTokensGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListShareTokensResponse).Tokens, nil
+}
+
+func decodeRevokeShareTokenGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return RevokeShareTokenRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func decodeListShareAccessGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return ListShareAccessRequest{
+		ListID: request.(string),
+	}, nil
+}
+
+func encodeListShareAccessGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListShareAccessResponse).Entries, nil
+}
+
+// collaboratorGraphQLRequest holds the arguments of the collaborator mutations.
+type collaboratorGraphQLRequest struct {
+	listID string
+	email  string
+	role   *todo.CollaboratorRole
+}
+
+func decodeInviteCollaboratorGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(collaboratorGraphQLRequest)
+
+	invitation := todo.Invitation{
+		Email: req.email,
+	}
+
+	if req.role != nil {
+		invitation.Role = *req.role
+	}
+
+	return InviteCollaboratorRequest{
+		ListID:     req.listID,
+		Invitation: invitation,
+	}, nil
+}
+
+func encodeInviteCollaboratorGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	collaborator := response.(InviteCollaboratorResponse).Collaborator
+
+	return &collaborator, nil
+}
+
+func decodeListCollaboratorsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return ListCollaboratorsRequest{
+		ListID: request.(string),
+	}, nil
+}
+
+func encodeListCollaboratorsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListCollaboratorsResponse).Collaborators, nil
+}
+
+func decodeRemoveCollaboratorGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(collaboratorGraphQLRequest)
+
+	return RemoveCollaboratorRequest{
+		ListID: req.listID,
+		Email:  req.email,
+	}, nil
+}
+
+func decodeStartTimerGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return StartTimerRequest{
+		ItemID: request.(string),
+	}, nil
+}
+
+func decodeStopTimerGraphQLRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return StopTimerRequest{}, nil
+}
+
+func decodeGetTimerGraphQLRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return GetTimerRequest{}, nil
+}
+
+func encodeTimeEntryGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	var entry todo.TimeEntry
+
+	switch resp := response.(type) {
+	case StartTimerResponse:
+		entry = resp.Entry
+	case StopTimerResponse:
+		entry = resp.Entry
+	case GetTimerResponse:
+		entry = resp.Entry
+	}
+
+	return &entry, nil
+}
+
+func decodeListTimeEntriesGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	var filter todo.TimeEntryFilter
+
+	if f := request.(*todo.TimeEntryFilter); f != nil {
+		filter = *f
+	}
+
+	return ListTimeEntriesRequest{
+		Filter: filter,
+	}, nil
+}
+
+func encodeListTimeEntriesGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListTimeEntriesResponse).Entries, nil
+}
+
+func decodeTimeTotalsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	var filter todo.TimeEntryFilter
+
+	if f := request.(*todo.TimeEntryFilter); f != nil {
+		filter = *f
+	}
+
+	return TimeTotalsRequest{
+		Filter: filter,
+	}, nil
+}
+
+func encodeTimeTotalsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	totals := response.(TimeTotalsResponse).Totals
+
+	return &totals, nil
+}
+
+func decodeCreateTemplateGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(graphql.NewTemplate)
+
+	newTemplate := todo.NewTemplate{
+		Name:  req.Name,
+		Items: unmarshalTemplateItemsGraphQL(req.Items),
+	}
+
+	if req.Description != nil {
+		newTemplate.Description = *req.Description
+	}
+
+	return CreateTemplateRequest{
+		NewTemplate: newTemplate,
+	}, nil
+}
+
+func decodeListTemplatesGraphQLRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return ListTemplatesRequest{}, nil
+}
+
+func encodeListTemplatesGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListTemplatesResponse).Templates, nil
+}
+
+func decodeGetTemplateGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return GetTemplateRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func decodeUpdateTemplateGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(graphql.TemplateUpdate)
+
+	templateUpdate := todo.TemplateUpdate{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	if req.Items != nil {
+		templateUpdate.Items = unmarshalTemplateItemsGraphQL(req.Items)
+	}
+
+	return UpdateTemplateRequest{
+		Id:             req.ID,
+		TemplateUpdate: templateUpdate,
+	}, nil
+}
+
+func encodeTemplateGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	var template todo.Template
+
+	switch resp := response.(type) {
+	case CreateTemplateResponse:
+		template = resp.Template
+	case GetTemplateResponse:
+		template = resp.Template
+	case UpdateTemplateResponse:
+		template = resp.Template
+	}
+
+	return &template, nil
+}
+
+func decodeDeleteTemplateGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return DeleteTemplateRequest{
+		Id: request.(string),
+	}, nil
+}
+
+type instantiateTemplateGraphQLRequest struct {
+	id            string
+	instantiation *todo.TemplateInstantiation
+}
+
+func decodeInstantiateTemplateGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(instantiateTemplateGraphQLRequest)
+
+	var instantiation todo.TemplateInstantiation
+
+	if req.instantiation != nil {
+		instantiation = *req.instantiation
+	}
+
+	return InstantiateTemplateRequest{
+		Id:            req.id,
+		Instantiation: instantiation,
+	}, nil
+}
+
+func encodeInstantiateTemplateGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	instance := response.(InstantiateTemplateResponse).Instance
+
+	return &instance, nil
+}
+
+func unmarshalTemplateItemsGraphQL(inputs []graphql.TemplateItemInput) []todo.TemplateItem {
+	items := make([]todo.TemplateItem, 0, len(inputs))
+
+	for _, input := range inputs {
+		item := todo.TemplateItem{
+			Title: input.Title,
+			Tags:  input.Tags,
+		}
+
+		if input.Notes != nil {
+			item.Notes = *input.Notes
+		}
+
+		if input.Priority != nil {
+			item.Priority = *input.Priority
+		}
+
+		if input.Order != nil {
+			item.Order = *input.Order
+		}
+
+		if input.DueOffset != nil {
+			offset := time.Duration(*input.DueOffset) * time.Second
+			item.DueOffset = &offset
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func decodeCreateRuleGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(graphql.NewRule)
+
+	return CreateRuleRequest{
+		NewRule: todo.NewRule{
+			Name:       req.Name,
+			Trigger:    req.Trigger,
+			Conditions: req.Conditions,
+			Actions:    req.Actions,
+			Enabled:    req.Enabled,
+			DryRun:     req.DryRun != nil && *req.DryRun,
+		},
+	}, nil
+}
+
+func decodeListRulesGraphQLRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return ListRulesRequest{}, nil
+}
+
+func encodeListRulesGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListRulesResponse).Rules, nil
+}
+
+func decodeGetRuleGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return GetRuleRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func decodeUpdateRuleGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(graphql.RuleUpdate)
+
+	return UpdateRuleRequest{
+		Id: req.ID,
+		RuleUpdate: todo.RuleUpdate{
+			Name:       req.Name,
+			Trigger:    req.Trigger,
+			Conditions: req.Conditions,
+			Actions:    req.Actions,
+			Enabled:    req.Enabled,
+			DryRun:     req.DryRun,
+		},
+	}, nil
+}
+
+func encodeRuleGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	var rule todo.Rule
+
+	switch resp := response.(type) {
+	case CreateRuleResponse:
+		rule = resp.Rule
+	case GetRuleResponse:
+		rule = resp.Rule
+	case UpdateRuleResponse:
+		rule = resp.Rule
+	}
+
+	return &rule, nil
+}
+
+func decodeDeleteRuleGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return DeleteRuleRequest{
+		Id: request.(string),
+	}, nil
+}
+
+type evaluateRuleGraphQLRequest struct {
+	id     string
+	itemID string
+}
+
+func decodeEvaluateRuleGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(evaluateRuleGraphQLRequest)
+
+	return EvaluateRuleRequest{
+		Id:     req.id,
+		ItemID: req.itemID,
+	}, nil
+}
+
+func encodeEvaluateRuleGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	evaluation := response.(EvaluateRuleResponse).Evaluation
+
+	return &evaluation, nil
+}
+
+func decodeListRuleExecutionsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return ListRuleExecutionsRequest{
+		Id: request.(string),
+	}, nil
+}
+
+func encodeListRuleExecutionsGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(ListRuleExecutionsResponse).Executions, nil
+}
+
+func decodeUndoGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	var steps int
+
+	if s, ok := request.(*int); ok && s != nil {
+		steps = *s
+	}
+
+	return UndoRequest{
+		Steps: steps,
+	}, nil
+}
+
+func encodeUndoGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(UndoResponse).Operations, nil
+}
+
+func decodeRedoGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	var steps int
+
+	if s, ok := request.(*int); ok && s != nil {
+		steps = *s
+	}
+
+	return RedoRequest{
+		Steps: steps,
+	}, nil
+}
+
+func encodeRedoGraphQLResponse(_ context.Context, response interface{}) (interface{}, error) {
+	return response.(RedoResponse).Operations, nil
+}
+
+func decodeEmptyTrashGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	var listID string
+
+	if id, ok := request.(*string); ok && id != nil {
+		listID = *id
+	}
+
+	return EmptyTrashRequest{
+		ListID: listID,
+	}, nil
+}
+
+// makeTagConnectionGraphQL returns a page of tags.
+// Tag IDs are used as cursors.
+func makeTagConnectionGraphQL(tags []todo.Tag, first *int, after *string) (*graphql.TagConnection, error) {
+	start := 0
+
+	if after != nil {
+		start = -1
+
+		for i, tag := range tags {
+			if tag.ID == *after {
+				start = i + 1
+
+				break
+			}
+		}
+
+		if start < 0 {
+			return nil, errors.NewWithDetails("invalid cursor", "cursor", *after)
+		}
+	}
+
+	end := len(tags)
+
+	if first != nil {
+		if *first < 0 {
+			return nil, errors.NewWithDetails("first must not be negative", "first", *first)
+		}
+
+		if start+*first < end {
+			end = start + *first
+		}
+	}
+
+	connection := &graphql.TagConnection{
+		Edges: make([]graphql.TagEdge, 0, end-start),
+		PageInfo: &graphql.PageInfo{
+			HasNextPage: end < len(tags),
+		},
+		TotalCount: len(tags),
+	}
+
+	for i := start; i < end; i++ {
+		tag := tags[i]
+
+		connection.Edges = append(connection.Edges, graphql.TagEdge{
+			Cursor: tag.ID,
+			Node:   &tag,
+		})
+		connection.PageInfo.EndCursor = &tag.ID
+	}
+
+	return connection, nil
+}
+
+type bulkAddTodoItemsGraphQLRequest struct {
+	input []todo.NewItem
+	mode  *todo.BulkMode
+}
+
+func decodeBulkAddItemsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(bulkAddTodoItemsGraphQLRequest)
+
+	return BulkAddItemsRequest{
+		NewItems: req.input,
+		Mode:     unmarshalBulkModeGraphQL(req.mode),
+	}, nil
+}
+
+func encodeBulkAddItemsGraphQLResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	return marshalBulkResultsGraphQL(ctx, response.(BulkAddItemsResponse).Results), nil
+}
+
+type bulkUpdateTodoItemsGraphQLRequest struct {
+	input []graphql.BulkTodoItemUpdate
+	mode  *todo.BulkMode
+}
+
+func decodeBulkUpdateItemsGraphQLRequest(ctx context.Context, request interface{}) (interface{}, error) {
+	req := request.(bulkUpdateTodoItemsGraphQLRequest)
+
+	updates := make([]todo.BulkItemUpdate, 0, len(req.input))
+
+	for _, item := range req.input {
+		r, err := decodeUpdateItemGraphQLRequest(ctx, *item.Update)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, todo.BulkItemUpdate{
+			ID:     item.Update.ID,
+			Update: r.(UpdateItemRequest).ItemUpdate,
+			Tags:   item.Tags,
+			Untags: item.Untags,
+		})
+	}
+
+	return BulkUpdateItemsRequest{
+		Updates: updates,
+		Mode:    unmarshalBulkModeGraphQL(req.mode),
+	}, nil
+}
+
+func encodeBulkUpdateItemsGraphQLResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	return marshalBulkResultsGraphQL(ctx, response.(BulkUpdateItemsResponse).Results), nil
+}
+
+type bulkDeleteTodoItemsGraphQLRequest struct {
+	ids     []string
+	cascade *bool
+	mode    *todo.BulkMode
+}
+
+func decodeBulkDeleteItemsGraphQLRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(bulkDeleteTodoItemsGraphQLRequest)
+
+	return BulkDeleteItemsRequest{
+		Ids:     req.ids,
+		Cascade: req.cascade != nil && *req.cascade,
+		Mode:    unmarshalBulkModeGraphQL(req.mode),
+	}, nil
+}
+
+func encodeBulkDeleteItemsGraphQLResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	return marshalBulkResultsGraphQL(ctx, response.(BulkDeleteItemsResponse).Results), nil
+}
+
+func unmarshalBulkModeGraphQL(mode *todo.BulkMode) todo.BulkMode {
+	if mode == nil {
+		return ""
+	}
+
+	return *mode
+}
+
+// marshalBulkResultsGraphQL converts the outcome of a bulk operation.
+// Only service errors are exposed to clients, just like the errors of single item operations.
+func marshalBulkResultsGraphQL(_ context.Context, results []todo.BulkResult) []graphql.BulkResult {
+	apiResults := make([]graphql.BulkResult, 0, len(results))
+
+	for _, result := range results {
+		result := result
+
+		apiResult := graphql.BulkResult{
+			Status: result.Status,
+		}
+
+		if result.ID != "" {
+			apiResult.ID = &result.ID
+		}
+
+		if result.Status == todo.BulkSucceeded && result.Item.ID != "" {
+			apiResult.Item = &result.Item
+		}
+
+		if result.Err != nil {
+			message := "something went wrong"
+
+			if appkiterrors.IsServiceError(result.Err) {
+				message = result.Err.Error()
+			}
+
+			apiResult.Error = &message
+		}
+
+		apiResults = append(apiResults, apiResult)
+	}
+
+	return apiResults
+}
+
+type resolver struct {
+	AddTodoItemHandler         kitxgraphql.Handler
+	UpdateTodoItemHandler      kitxgraphql.Handler
+	ListTodoItemsHandler       kitxgraphql.Handler
+	ListTodoItemTreeHandler    kitxgraphql.Handler
+	DeleteTodoItemHandler      kitxgraphql.Handler
+	DeleteTodoItemsHandler     kitxgraphql.Handler
+	CreateTodoListHandler      kitxgraphql.Handler
+	ListTodoListsHandler       kitxgraphql.Handler
+	GetTodoListHandler         kitxgraphql.Handler
+	UpdateTodoListHandler      kitxgraphql.Handler
+	DeleteTodoListHandler      kitxgraphql.Handler
+	CreateTagHandler           kitxgraphql.Handler
+	ListTagsHandler            kitxgraphql.Handler
+	GetTagHandler              kitxgraphql.Handler
+	UpdateTagHandler           kitxgraphql.Handler
+	MergeTagsHandler           kitxgraphql.Handler
+	DeleteTagHandler           kitxgraphql.Handler
+	TagTodoItemHandler         kitxgraphql.Handler
+	UntagTodoItemHandler       kitxgraphql.Handler
+	AssignTodoItemHandler      kitxgraphql.Handler
+	UnassignTodoItemHandler    kitxgraphql.Handler
+	MoveTodoItemHandler        kitxgraphql.Handler
+	ListTrashHandler           kitxgraphql.Handler
+	RestoreTodoItemHandler     kitxgraphql.Handler
+	PurgeTodoItemHandler       kitxgraphql.Handler
+	EmptyTrashHandler          kitxgraphql.Handler
+	TodoItemHistoryHandler     kitxgraphql.Handler
+	SearchTodoItemsHandler     kitxgraphql.Handler
+	UndoHandler                kitxgraphql.Handler
+	RedoHandler                kitxgraphql.Handler
+	BulkAddTodoItemsHandler    kitxgraphql.Handler
+	BulkUpdateTodoItemsHandler kitxgraphql.Handler
+	BulkDeleteTodoItemsHandler kitxgraphql.Handler
+	AddCommentHandler          kitxgraphql.Handler
+	TodoItemCommentsHandler    kitxgraphql.Handler
+	CommentHandler             kitxgraphql.Handler
+	UpdateCommentHandler       kitxgraphql.Handler
+	DeleteCommentHandler       kitxgraphql.Handler
+	AddAttachmentHandler       kitxgraphql.Handler
+	TodoItemAttachmentsHandler kitxgraphql.Handler
+	AttachmentHandler          kitxgraphql.Handler
+	DeleteAttachmentHandler    kitxgraphql.Handler
+	CreateShareTokenHandler    kitxgraphql.Handler
+	ShareTokensHandler         kitxgraphql.Handler
+	RevokeShareTokenHandler    kitxgraphql.Handler
+	ShareAccessLogHandler      kitxgraphql.Handler
+	InviteCollaboratorHandler  kitxgraphql.Handler
+	CollaboratorsHandler       kitxgraphql.Handler
+	RemoveCollaboratorHandler  kitxgraphql.Handler
+	StartTimerHandler          kitxgraphql.Handler
+	StopTimerHandler           kitxgraphql.Handler
+	TimerHandler               kitxgraphql.Handler
+	TimeEntriesHandler         kitxgraphql.Handler
+	TimeTotalsHandler          kitxgraphql.Handler
+	CreateTemplateHandler      kitxgraphql.Handler
+	TemplatesHandler           kitxgraphql.Handler
+	TemplateHandler            kitxgraphql.Handler
+	UpdateTemplateHandler      kitxgraphql.Handler
+	DeleteTemplateHandler      kitxgraphql.Handler
+	InstantiateTemplateHandler kitxgraphql.Handler
+	CreateRuleHandler          kitxgraphql.Handler
+	RulesHandler               kitxgraphql.Handler
+	RuleHandler                kitxgraphql.Handler
+	UpdateRuleHandler          kitxgraphql.Handler
+	DeleteRuleHandler          kitxgraphql.Handler
+	EvaluateRuleHandler        kitxgraphql.Handler
+	RuleExecutionsHandler      kitxgraphql.Handler
+}
+
+func (r *resolver) Mutation() graphql.MutationResolver {
+	return &mutationResolver{r}
+}
+
+func (r *resolver) Query() graphql.QueryResolver {
+	return &queryResolver{r}
+}
+
+func (r *resolver) TodoItem() graphql.TodoItemResolver {
+	return &todoItemResolver{r}
+}
+
+func (r *resolver) Attachment() graphql.AttachmentResolver {
+	return &attachmentResolver{r}
+}
+
+func (r *resolver) ShareToken() graphql.ShareTokenResolver {
+	return &shareTokenResolver{r}
+}
+
+func (r *resolver) ShareAccessEntry() graphql.ShareAccessEntryResolver {
+	return &shareAccessEntryResolver{r}
+}
+
+func (r *resolver) TimeEntry() graphql.TimeEntryResolver {
+	return &timeEntryResolver{r}
+}
+
+func (r *resolver) TimeTotals() graphql.TimeTotalsResolver {
+	return &timeTotalsResolver{r}
+}
+
+func (r *resolver) ItemTime() graphql.ItemTimeResolver {
+	return &itemTimeResolver{r}
+}
+
+func (r *resolver) DayTime() graphql.DayTimeResolver {
+	return &dayTimeResolver{r}
+}
+
+func (r *resolver) TemplateItem() graphql.TemplateItemResolver {
+	return &templateItemResolver{r}
+}
+
+func (r *resolver) RuleAction() graphql.RuleActionResolver {
+	return &ruleActionResolver{r}
+}
+
+type mutationResolver struct{ *resolver }
+
+func (r *mutationResolver) AddTodoItem(ctx context.Context, input todo.NewItem) (*todo.Item, error) {
+	_, resp, err := r.AddTodoItemHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Item), nil
+}
+
+func (r *mutationResolver) UpdateTodoItem(ctx context.Context, input graphql.TodoItemUpdate) (*todo.Item, error) {
+	_, resp, err := r.UpdateTodoItemHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Item), nil
+}
+
+func (r *mutationResolver) DeleteTodoItem(ctx context.Context, id string, cascade *bool) (bool, error) {
+	_, resp, err := r.DeleteTodoItemHandler.ServeGraphQL(ctx, deleteTodoItemGraphQLRequest{id: id, cascade: cascade})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *mutationResolver) DeleteTodoItems(ctx context.Context, listID *string) (bool, error) {
+	_, resp, err := r.DeleteTodoItemsHandler.ServeGraphQL(ctx, listID)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *mutationResolver) CreateTodoList(ctx context.Context, input todo.NewList) (*todo.List, error) {
+	_, resp, err := r.CreateTodoListHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.List), nil
+}
+
+func (r *mutationResolver) UpdateTodoList(ctx context.Context, input graphql.TodoListUpdate) (*todo.List, error) {
+	_, resp, err := r.UpdateTodoListHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.List), nil
+}
+
+func (r *mutationResolver) DeleteTodoList(ctx context.Context, id string) (bool, error) {
+	_, resp, err := r.DeleteTodoListHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *mutationResolver) CreateTag(ctx context.Context, input todo.NewTag) (*todo.Tag, error) {
+	_, resp, err := r.CreateTagHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Tag), nil
+}
+
+func (r *mutationResolver) UpdateTag(ctx context.Context, input graphql.TagUpdate) (*todo.Tag, error) {
+	_, resp, err := r.UpdateTagHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Tag), nil
+}
+
+func (r *mutationResolver) MergeTags(ctx context.Context, id string, targetID string) (*todo.Tag, error) {
+	_, resp, err := r.MergeTagsHandler.ServeGraphQL(ctx, mergeTagsGraphQLRequest{id: id, targetID: targetID})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Tag), nil
+}
+
+func (r *mutationResolver) DeleteTag(ctx context.Context, id string) (bool, error) {
+	_, resp, err := r.DeleteTagHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *mutationResolver) TagTodoItem(ctx context.Context, id string, tags []string) (*todo.Item, error) {
+	_, resp, err := r.TagTodoItemHandler.ServeGraphQL(ctx, tagTodoItemGraphQLRequest{id: id, tags: tags})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Item), nil
+}
+
+func (r *mutationResolver) UntagTodoItem(ctx context.Context, id string, tags []string) (*todo.Item, error) {
+	_, resp, err := r.UntagTodoItemHandler.ServeGraphQL(ctx, tagTodoItemGraphQLRequest{id: id, tags: tags})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Item), nil
+}
+
+func (r *mutationResolver) AssignTodoItem(ctx context.Context, id string, assignee string) (*todo.Item, error) { // nolint: lll
+	_, resp, err := r.AssignTodoItemHandler.ServeGraphQL(ctx, assignTodoItemGraphQLRequest{id: id, assignee: assignee})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Item), nil
+}
+
+func (r *mutationResolver) UnassignTodoItem(ctx context.Context, id string) (*todo.Item, error) {
+	_, resp, err := r.UnassignTodoItemHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Item), nil
+}
+
+func (r *mutationResolver) MoveTodoItem(ctx context.Context, id string, before *string, after *string) (*todo.Item, error) { // nolint: lll
+	req := moveTodoItemGraphQLRequest{id: id, before: before, after: after}
+
+	_, resp, err := r.MoveTodoItemHandler.ServeGraphQL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Item), nil
+}
+
+func (r *mutationResolver) RestoreTodoItem(ctx context.Context, id string) (*todo.Item, error) {
+	_, resp, err := r.RestoreTodoItemHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Item), nil
+}
+
+func (r *mutationResolver) PurgeTodoItem(ctx context.Context, id string) (bool, error) {
+	_, resp, err := r.PurgeTodoItemHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *mutationResolver) EmptyTrash(ctx context.Context, listID *string) (bool, error) {
+	_, resp, err := r.EmptyTrashHandler.ServeGraphQL(ctx, listID)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *mutationResolver) Undo(ctx context.Context, steps *int) ([]todo.Operation, error) {
+	_, resp, err := r.UndoHandler.ServeGraphQL(ctx, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.Operation), nil
+}
+
+func (r *mutationResolver) Redo(ctx context.Context, steps *int) ([]todo.Operation, error) {
+	_, resp, err := r.RedoHandler.ServeGraphQL(ctx, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.Operation), nil
+}
+
+type queryResolver struct{ *resolver }
+
+func (r *queryResolver) TodoItems(ctx context.Context, filter *todo.ItemFilter) ([]todo.Item, error) {
+	_, resp, err := r.ListTodoItemsHandler.ServeGraphQL(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.Item), nil
+}
+
+func (r *queryResolver) TodoItemTree(ctx context.Context, filter *todo.ItemFilter, depth *int) ([]todo.ItemTree, error) { // nolint: lll
+	_, resp, err := r.ListTodoItemTreeHandler.ServeGraphQL(ctx, todoItemTreeGraphQLRequest{filter: filter, depth: depth})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.ItemTree), nil
+}
+
+func (r *queryResolver) TodoLists(ctx context.Context) ([]todo.List, error) {
+	_, resp, err := r.ListTodoListsHandler.ServeGraphQL(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.List), nil
+}
+
+func (r *queryResolver) TodoList(ctx context.Context, id string) (*todo.List, error) {
+	_, resp, err := r.GetTodoListHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.List), nil
+}
+
+func (r *queryResolver) Tags(ctx context.Context, first *int, after *string) (*graphql.TagConnection, error) {
+	_, resp, err := r.ListTagsHandler.ServeGraphQL(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return makeTagConnectionGraphQL(resp.([]todo.Tag), first, after)
+}
+
+func (r *queryResolver) Tag(ctx context.Context, id string) (*todo.Tag, error) {
+	_, resp, err := r.GetTagHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Tag), nil
+}
+
+func (r *queryResolver) Trash(ctx context.Context, listID *string) ([]todo.Item, error) {
+	_, resp, err := r.ListTrashHandler.ServeGraphQL(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.Item), nil
+}
+
+func (r *queryResolver) TodoItemHistory(ctx context.Context, id string) ([]todo.HistoryEntry, error) {
+	_, resp, err := r.TodoItemHistoryHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.HistoryEntry), nil
+}
+
+func (r *queryResolver) SearchTodoItems(
+	ctx context.Context,
+	query string,
+	listID *string,
+	limit *int,
+) ([]todo.SearchResult, error) {
+	req := searchTodoItemsGraphQLRequest{query: query, listID: listID, limit: limit}
+
+	_, resp, err := r.SearchTodoItemsHandler.ServeGraphQL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.SearchResult), nil
+}
+
+func (r *queryResolver) TodoItemComments(ctx context.Context, id string) ([]todo.Comment, error) {
+	_, resp, err := r.TodoItemCommentsHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.Comment), nil
+}
+
+func (r *queryResolver) Comment(ctx context.Context, id string) (*todo.Comment, error) {
+	_, resp, err := r.CommentHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Comment), nil
+}
+
+type todoItemResolver struct{ *resolver }
+
+func (r *todoItemResolver) ParentID(_ context.Context, obj *todo.Item) (*string, error) {
+	if obj.ParentID == "" {
+		return nil, nil
+	}
+
+	return &obj.ParentID, nil
+}
+
+func (r *todoItemResolver) Assignee(_ context.Context, obj *todo.Item) (*string, error) {
+	if obj.Assignee == "" {
+		return nil, nil
+	}
+
+	return &obj.Assignee, nil
+}
+
+func (r *todoItemResolver) Tags(_ context.Context, obj *todo.Item, first *int, after *string) (*graphql.TagConnection, error) { // nolint: lll
+	return makeTagConnectionGraphQL(obj.Tags, first, after)
+}
+
+type attachmentResolver struct{ *resolver }
+
+func (r *attachmentResolver) URL(_ context.Context, obj *todo.Attachment) (string, error) {
+	return attachmentDownloadURL(*obj), nil
+}
+
+func (r *attachmentResolver) ExpiresAt(_ context.Context, obj *todo.Attachment) (*time.Time, error) {
+	return &obj.Link.ExpiresAt, nil
+}
+
+func (r *mutationResolver) BulkAddTodoItems(
+	ctx context.Context,
+	input []todo.NewItem,
+	mode *todo.BulkMode,
+) ([]graphql.BulkResult, error) {
+	req := bulkAddTodoItemsGraphQLRequest{input: input, mode: mode}
+
+	_, resp, err := r.BulkAddTodoItemsHandler.ServeGraphQL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]graphql.BulkResult), nil
+}
+
+func (r *mutationResolver) BulkUpdateTodoItems(
+	ctx context.Context,
+	input []graphql.BulkTodoItemUpdate,
+	mode *todo.BulkMode,
+) ([]graphql.BulkResult, error) {
+	req := bulkUpdateTodoItemsGraphQLRequest{input: input, mode: mode}
+
+	_, resp, err := r.BulkUpdateTodoItemsHandler.ServeGraphQL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]graphql.BulkResult), nil
+}
+
+func (r *mutationResolver) BulkDeleteTodoItems(
+	ctx context.Context,
+	ids []string,
+	cascade *bool,
+	mode *todo.BulkMode,
+) ([]graphql.BulkResult, error) {
+	req := bulkDeleteTodoItemsGraphQLRequest{ids: ids, cascade: cascade, mode: mode}
+
+	_, resp, err := r.BulkDeleteTodoItemsHandler.ServeGraphQL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]graphql.BulkResult), nil
+}
+
+func (r *mutationResolver) AddComment(ctx context.Context, itemID string, body string) (*todo.Comment, error) {
+	_, resp, err := r.AddCommentHandler.ServeGraphQL(ctx, commentGraphQLRequest{id: itemID, body: body})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Comment), nil
+}
+
+func (r *mutationResolver) UpdateComment(ctx context.Context, id string, body string) (*todo.Comment, error) {
+	_, resp, err := r.UpdateCommentHandler.ServeGraphQL(ctx, commentGraphQLRequest{id: id, body: body})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Comment), nil
+}
+
+func (r *mutationResolver) DeleteComment(ctx context.Context, id string) (bool, error) {
+	_, resp, err := r.DeleteCommentHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *queryResolver) TodoItemAttachments(ctx context.Context, id string) ([]todo.Attachment, error) {
+	_, resp, err := r.TodoItemAttachmentsHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.Attachment), nil
+}
+
+func (r *queryResolver) Attachment(ctx context.Context, id string) (*todo.Attachment, error) {
+	_, resp, err := r.AttachmentHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Attachment), nil
+}
+
+func (r *mutationResolver) AddAttachment(
+	ctx context.Context,
+	itemID string,
+	file graphql2.Upload,
+	checksum *string,
+) (*todo.Attachment, error) {
+	req := addAttachmentGraphQLRequest{itemID: itemID, file: file, checksum: checksum}
+
+	_, resp, err := r.AddAttachmentHandler.ServeGraphQL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Attachment), nil
+}
+
+func (r *mutationResolver) DeleteAttachment(ctx context.Context, id string) (bool, error) {
+	_, resp, err := r.DeleteAttachmentHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *queryResolver) ShareTokens(ctx context.Context, listID string) ([]todo.ShareToken, error) {
+	_, resp, err := r.ShareTokensHandler.ServeGraphQL(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.ShareToken), nil
+}
+
+func (r *queryResolver) ShareAccessLog(ctx context.Context, listID string) ([]todo.ShareAccessEntry, error) {
+	_, resp, err := r.ShareAccessLogHandler.ServeGraphQL(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.ShareAccessEntry), nil
+}
+
+func (r *queryResolver) Collaborators(ctx context.Context, listID string) ([]todo.Collaborator, error) {
+	_, resp, err := r.CollaboratorsHandler.ServeGraphQL(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.Collaborator), nil
+}
+
+func (r *mutationResolver) CreateShareToken(
+	ctx context.Context,
+	listID string,
+	access *todo.ShareAccess,
+	expiresAt *time.Time,
+) (*todo.ShareToken, error) {
+	req := createShareTokenGraphQLRequest{listID: listID, access: access, expiresAt: expiresAt}
+
+	_, resp, err := r.CreateShareTokenHandler.ServeGraphQL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.ShareToken), nil
+}
+
+func (r *mutationResolver) RevokeShareToken(ctx context.Context, id string) (bool, error) {
+	_, resp, err := r.RevokeShareTokenHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *mutationResolver) InviteCollaborator(
+	ctx context.Context,
+	listID string,
+	email string,
+	role *todo.CollaboratorRole,
+) (*todo.Collaborator, error) {
+	req := collaboratorGraphQLRequest{listID: listID, email: email, role: role}
+
+	_, resp, err := r.InviteCollaboratorHandler.ServeGraphQL(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Collaborator), nil
+}
+
+func (r *mutationResolver) RemoveCollaborator(ctx context.Context, listID string, email string) (bool, error) {
+	_, resp, err := r.RemoveCollaboratorHandler.ServeGraphQL(ctx, collaboratorGraphQLRequest{listID: listID, email: email})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+type shareTokenResolver struct{ *resolver }
+
+func (r *shareTokenResolver) Token(_ context.Context, obj *todo.ShareToken) (*string, error) {
+	if obj.Token == "" {
+		return nil, nil
+	}
+
+	return &obj.Token, nil
+}
+
+func (r *shareTokenResolver) URL(_ context.Context, obj *todo.ShareToken) (*string, error) {
+	u := sharedListURL(*obj)
+	if u == "" {
+		return nil, nil
+	}
+
+	return &u, nil
+}
+
+func (r *shareTokenResolver) Active(_ context.Context, obj *todo.ShareToken) (bool, error) {
+	return obj.Active(time.Now()), nil
+}
+
+type shareAccessEntryResolver struct{ *resolver }
+
+func (r *shareAccessEntryResolver) ItemID(_ context.Context, obj *todo.ShareAccessEntry) (*string, error) {
+	if obj.ItemID == "" {
+		return nil, nil
+	}
+
+	return &obj.ItemID, nil
+}
+
+func (r *queryResolver) Timer(ctx context.Context) (*todo.TimeEntry, error) {
+	_, resp, err := r.TimerHandler.ServeGraphQL(ctx, nil)
+	if errors.As(err, &todo.TimerNotRunningError{}) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.TimeEntry), nil
+}
+
+func (r *queryResolver) TimeEntries(ctx context.Context, filter *todo.TimeEntryFilter) ([]todo.TimeEntry, error) {
+	_, resp, err := r.TimeEntriesHandler.ServeGraphQL(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.TimeEntry), nil
+}
+
+func (r *queryResolver) TimeTotals(ctx context.Context, filter *todo.TimeEntryFilter) (*todo.TimeTotals, error) {
+	_, resp, err := r.TimeTotalsHandler.ServeGraphQL(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.TimeTotals), nil
+}
+
+func (r *mutationResolver) StartTimer(ctx context.Context, itemID string) (*todo.TimeEntry, error) {
+	_, resp, err := r.StartTimerHandler.ServeGraphQL(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.TimeEntry), nil
+}
+
+func (r *mutationResolver) StopTimer(ctx context.Context) (*todo.TimeEntry, error) {
+	_, resp, err := r.StopTimerHandler.ServeGraphQL(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.TimeEntry), nil
+}
+
+type timeEntryResolver struct{ *resolver }
+
+func (r *timeEntryResolver) Running(_ context.Context, obj *todo.TimeEntry) (bool, error) {
+	return obj.Running(), nil
+}
+
+func (r *timeEntryResolver) Duration(_ context.Context, obj *todo.TimeEntry) (int, error) {
+	return int(seconds(obj.Duration)), nil
+}
+
+type timeTotalsResolver struct{ *resolver }
+
+func (r *timeTotalsResolver) Total(_ context.Context, obj *todo.TimeTotals) (int, error) {
+	return int(seconds(obj.Total)), nil
+}
+
+type itemTimeResolver struct{ *resolver }
+
+func (r *itemTimeResolver) Duration(_ context.Context, obj *todo.ItemTime) (int, error) {
+	return int(seconds(obj.Duration)), nil
+}
+
+type dayTimeResolver struct{ *resolver }
+
+func (r *dayTimeResolver) Day(_ context.Context, obj *todo.DayTime) (string, error) {
+	return obj.Day.Format("2006-01-02"), nil
+}
+
+func (r *dayTimeResolver) Duration(_ context.Context, obj *todo.DayTime) (int, error) {
+	return int(seconds(obj.Duration)), nil
+}
+
+func (r *queryResolver) Templates(ctx context.Context) ([]todo.Template, error) {
+	_, resp, err := r.TemplatesHandler.ServeGraphQL(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.Template), nil
+}
+
+func (r *queryResolver) Template(ctx context.Context, id string) (*todo.Template, error) {
+	_, resp, err := r.TemplateHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Template), nil
+}
+
+func (r *mutationResolver) CreateTemplate(ctx context.Context, input graphql.NewTemplate) (*todo.Template, error) {
+	_, resp, err := r.CreateTemplateHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Template), nil
+}
+
+func (r *mutationResolver) UpdateTemplate(ctx context.Context, input graphql.TemplateUpdate) (*todo.Template, error) {
+	_, resp, err := r.UpdateTemplateHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Template), nil
+}
+
+func (r *mutationResolver) DeleteTemplate(ctx context.Context, id string) (bool, error) {
+	_, resp, err := r.DeleteTemplateHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+func (r *mutationResolver) InstantiateTemplate(
+	ctx context.Context,
+	id string,
+	input *todo.TemplateInstantiation,
+) (*todo.TemplateInstance, error) {
+	_, resp, err := r.InstantiateTemplateHandler.ServeGraphQL(ctx, instantiateTemplateGraphQLRequest{
+		id:            id,
+		instantiation: input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.TemplateInstance), nil
+}
+
+type templateItemResolver struct{ *resolver }
+
+func (r *templateItemResolver) DueOffset(_ context.Context, obj *todo.TemplateItem) (*int, error) {
+	if obj.DueOffset == nil {
+		return nil, nil
+	}
+
+	offset := int(seconds(*obj.DueOffset))
+
+	return &offset, nil
+}
+
+func (r *queryResolver) Rules(ctx context.Context) ([]todo.Rule, error) {
+	_, resp, err := r.RulesHandler.ServeGraphQL(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.Rule), nil
+}
+
+func (r *queryResolver) Rule(ctx context.Context, id string) (*todo.Rule, error) {
+	_, resp, err := r.RuleHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Rule), nil
+}
+
+func (r *queryResolver) EvaluateRule(ctx context.Context, id string, itemID string) (*todo.RuleEvaluation, error) {
+	_, resp, err := r.EvaluateRuleHandler.ServeGraphQL(ctx, evaluateRuleGraphQLRequest{
+		id:     id,
+		itemID: itemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.RuleEvaluation), nil
+}
+
+func (r *queryResolver) RuleExecutions(ctx context.Context, id string) ([]todo.RuleExecution, error) {
+	_, resp, err := r.RuleExecutionsHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.([]todo.RuleExecution), nil
+}
+
+func (r *mutationResolver) CreateRule(ctx context.Context, input graphql.NewRule) (*todo.Rule, error) {
+	_, resp, err := r.CreateRuleHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Rule), nil
+}
+
+func (r *mutationResolver) UpdateRule(ctx context.Context, input graphql.RuleUpdate) (*todo.Rule, error) {
+	_, resp, err := r.UpdateRuleHandler.ServeGraphQL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*todo.Rule), nil
+}
+
+func (r *mutationResolver) DeleteRule(ctx context.Context, id string) (bool, error) {
+	_, resp, err := r.DeleteRuleHandler.ServeGraphQL(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(bool), nil
+}
+
+type ruleActionResolver struct{ *resolver }
+
+func (r *ruleActionResolver) ListID(_ context.Context, obj *todo.RuleAction) (*string, error) {
+	if obj.ListID == "" {
+		return nil, nil
+	}
+
+	return &obj.ListID, nil
+}
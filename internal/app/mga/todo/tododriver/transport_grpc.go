@@ -0,0 +1,2519 @@
+package tododriver
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	appkitgrpc "github.com/sagikazarmark/appkit/transport/grpc"
+	kitxgrpc "github.com/sagikazarmark/kitx/transport/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+// MakeGRPCServer makes a set of endpoints available as a gRPC server.
+func MakeGRPCServer(endpoints Endpoints, options ...kitgrpc.ServerOption) api.TodoListServiceServer {
+	errorEncoder := kitxgrpc.NewStatusErrorResponseEncoder(appkitgrpc.NewDefaultStatusConverter())
+
+	return api.TodoListServiceKitServer{
+		AddItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.AddItem,
+			decodeAddItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeAddItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListItemsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListItems,
+			decodeListItemsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListItemsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteItemsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.DeleteItems,
+			decodeDeleteItemsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeDeleteItemsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.GetItem,
+			decodeGetItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeGetItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.UpdateItem,
+			decodeUpdateItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUpdateItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListItemTreeHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListItemTree,
+			decodeListItemTreeGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListItemTreeGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.DeleteItem,
+			decodeDeleteItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeDeleteItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateListHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.CreateList,
+			decodeCreateListGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeCreateListGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListListsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListLists,
+			decodeListListsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListListsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetListHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.GetList,
+			decodeGetListGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeGetListGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateListHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.UpdateList,
+			decodeUpdateListGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUpdateListGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteListHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.DeleteList,
+			decodeDeleteListGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeDeleteListGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateTagHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.CreateTag,
+			decodeCreateTagGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeCreateTagGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListTagsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListTags,
+			decodeListTagsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListTagsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetTagHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.GetTag,
+			decodeGetTagGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeGetTagGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateTagHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.UpdateTag,
+			decodeUpdateTagGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUpdateTagGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MergeTagsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.MergeTags,
+			decodeMergeTagsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeMergeTagsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteTagHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.DeleteTag,
+			decodeDeleteTagGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeDeleteTagGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TagItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.TagItem,
+			decodeTagItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeTagItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UntagItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.UntagItem,
+			decodeUntagItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUntagItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		AssignItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.AssignItem,
+			decodeAssignItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeAssignItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UnassignItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.UnassignItem,
+			decodeUnassignItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUnassignItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		MoveItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.MoveItem,
+			decodeMoveItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeMoveItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListTrashHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListTrash,
+			decodeListTrashGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListTrashGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RestoreItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.RestoreItem,
+			decodeRestoreItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeRestoreItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		PurgeItemHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.PurgeItem,
+			decodePurgeItemGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodePurgeItemGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		EmptyTrashHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.EmptyTrash,
+			decodeEmptyTrashGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeEmptyTrashGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ItemHistoryHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ItemHistory,
+			decodeItemHistoryGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeItemHistoryGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UndoHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.Undo,
+			decodeUndoGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUndoGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RedoHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.Redo,
+			decodeRedoGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeRedoGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		BulkAddItemsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.BulkAddItems,
+			decodeBulkAddItemsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeBulkAddItemsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		BulkUpdateItemsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.BulkUpdateItems,
+			decodeBulkUpdateItemsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeBulkUpdateItemsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		BulkDeleteItemsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.BulkDeleteItems,
+			decodeBulkDeleteItemsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeBulkDeleteItemsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ExportItemsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ExportItems,
+			decodeExportItemsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeExportItemsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ImportItemsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ImportItems,
+			decodeImportItemsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeImportItemsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		SearchItemsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.SearchItems,
+			decodeSearchItemsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeSearchItemsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		AddCommentHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.AddComment,
+			decodeAddCommentGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeAddCommentGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListCommentsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListComments,
+			decodeListCommentsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListCommentsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetCommentHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.GetComment,
+			decodeGetCommentGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeGetCommentGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateCommentHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.UpdateComment,
+			decodeUpdateCommentGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUpdateCommentGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteCommentHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.DeleteComment,
+			decodeDeleteCommentGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeDeleteCommentGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		AddAttachmentHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.AddAttachment,
+			decodeAddAttachmentGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeAddAttachmentGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListAttachmentsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListAttachments,
+			decodeListAttachmentsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListAttachmentsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetAttachmentHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.GetAttachment,
+			decodeGetAttachmentGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeGetAttachmentGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteAttachmentHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.DeleteAttachment,
+			decodeDeleteAttachmentGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeDeleteAttachmentGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateShareTokenHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.CreateShareToken,
+			decodeCreateShareTokenGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeCreateShareTokenGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListShareTokensHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListShareTokens,
+			decodeListShareTokensGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListShareTokensGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RevokeShareTokenHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.RevokeShareToken,
+			decodeRevokeShareTokenGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeRevokeShareTokenGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListShareAccessHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListShareAccess,
+			decodeListShareAccessGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListShareAccessGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		InviteCollaboratorHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.InviteCollaborator,
+			decodeInviteCollaboratorGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeInviteCollaboratorGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListCollaboratorsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListCollaborators,
+			decodeListCollaboratorsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListCollaboratorsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		RemoveCollaboratorHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.RemoveCollaborator,
+			decodeRemoveCollaboratorGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeRemoveCollaboratorGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		StartTimerHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.StartTimer,
+			decodeStartTimerGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeStartTimerGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		StopTimerHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.StopTimer,
+			decodeStopTimerGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeStopTimerGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetTimerHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.GetTimer,
+			decodeGetTimerGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeGetTimerGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListTimeEntriesHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListTimeEntries,
+			decodeListTimeEntriesGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListTimeEntriesGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		TimeTotalsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.TimeTotals,
+			decodeTimeTotalsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeTimeTotalsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ExportTimesheetHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ExportTimesheet,
+			decodeExportTimesheetGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeExportTimesheetGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateTemplateHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.CreateTemplate,
+			decodeCreateTemplateGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeCreateTemplateGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListTemplatesHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListTemplates,
+			decodeListTemplatesGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListTemplatesGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetTemplateHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.GetTemplate,
+			decodeGetTemplateGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeGetTemplateGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateTemplateHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.UpdateTemplate,
+			decodeUpdateTemplateGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUpdateTemplateGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteTemplateHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.DeleteTemplate,
+			decodeDeleteTemplateGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeDeleteTemplateGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		InstantiateTemplateHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.InstantiateTemplate,
+			decodeInstantiateTemplateGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeInstantiateTemplateGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		CreateRuleHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.CreateRule,
+			decodeCreateRuleGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeCreateRuleGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListRulesHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListRules,
+			decodeListRulesGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListRulesGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		GetRuleHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.GetRule,
+			decodeGetRuleGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeGetRuleGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		UpdateRuleHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.UpdateRule,
+			decodeUpdateRuleGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeUpdateRuleGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		DeleteRuleHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.DeleteRule,
+			decodeDeleteRuleGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeDeleteRuleGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		EvaluateRuleHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.EvaluateRule,
+			decodeEvaluateRuleGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeEvaluateRuleGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+		ListRuleExecutionsHandler: kitxgrpc.NewErrorEncoderHandler(kitgrpc.NewServer(
+			endpoints.ListRuleExecutions,
+			decodeListRuleExecutionsGRPCRequest,
+			kitxgrpc.ErrorResponseEncoder(encodeListRuleExecutionsGRPCResponse, errorEncoder),
+			options...,
+		), errorEncoder),
+	}
+}
+
+func decodeAddItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.AddItemRequest)
+
+	return AddItemRequest{
+		NewItem: todo.NewItem{
+			ListID:       req.GetListId(),
+			ParentID:     req.GetParentId(),
+			Title:        req.GetTitle(),
+			Notes:        req.GetNotes(),
+			Assignee:     req.GetAssignee(),
+			Order:        int(req.GetOrder()),
+			DueAt:        unmarshalTimestampGRPC(req.GetDueAt()),
+			Priority:     unmarshalPriorityGRPC(req.GetPriority()),
+			Recurrence:   unmarshalRecurrenceGRPC(req.GetRecurrence()),
+			AutoComplete: req.GetAutoComplete(),
+		},
+	}, nil
+}
+
+func encodeAddItemGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(AddItemResponse)
+
+	return &api.AddItemResponse{
+		Item: marshalItemGRPC(resp.Item),
+	}, nil
+}
+
+func decodeListItemsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListItemsRequest)
+
+	return ListItemsRequest{
+		Filter: todo.ItemFilter{
+			ListID:       req.GetListId(),
+			DueAfter:     unmarshalTimestampGRPC(req.GetDueAfter()),
+			DueBefore:    unmarshalTimestampGRPC(req.GetDueBefore()),
+			Overdue:      req.GetOverdue(),
+			SeriesID:     req.GetSeriesId(),
+			ParentID:     req.GetParentId(),
+			Tags:         req.GetTags(),
+			MatchAllTags: req.GetMatchAllTags(),
+			Assignee:     req.GetAssignee(),
+			Mine:         req.GetMine(),
+			Sort:         unmarshalItemSortGRPC(req.GetSort()),
+		},
+	}, nil
+}
+
+func encodeListItemsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListItemsResponse)
+
+	items := make([]*api.TodoItem, 0, len(resp.Items))
+
+	for _, item := range resp.Items {
+		items = append(items, marshalItemGRPC(item))
+	}
+
+	return &api.ListItemsResponse{
+		Items: items,
+	}, nil
+}
+
+func decodeListItemTreeGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListItemTreeRequest)
+
+	return ListItemTreeRequest{
+		Filter: todo.ItemFilter{
+			ListID:       req.GetListId(),
+			DueAfter:     unmarshalTimestampGRPC(req.GetDueAfter()),
+			DueBefore:    unmarshalTimestampGRPC(req.GetDueBefore()),
+			Overdue:      req.GetOverdue(),
+			SeriesID:     req.GetSeriesId(),
+			ParentID:     req.GetParentId(),
+			Tags:         req.GetTags(),
+			MatchAllTags: req.GetMatchAllTags(),
+			Assignee:     req.GetAssignee(),
+			Mine:         req.GetMine(),
+			Sort:         unmarshalItemSortGRPC(req.GetSort()),
+		},
+		Depth: int(req.GetDepth()),
+	}, nil
+}
+
+func encodeListItemTreeGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListItemTreeResponse)
+
+	return &api.ListItemTreeResponse{
+		Trees: marshalItemTreesGRPC(resp.Trees),
+	}, nil
+}
+
+func decodeDeleteItemsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.DeleteItemsRequest)
+
+	return DeleteItemsRequest{
+		ListID: req.GetListId(),
+	}, nil
+}
+
+func encodeDeleteItemsGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.DeleteItemsResponse{}, nil
+}
+
+func decodeGetItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.GetItemRequest)
+
+	return GetItemRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeGetItemGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetItemResponse)
+
+	return &api.GetItemResponse{
+		Item: marshalItemGRPC(resp.Item),
+	}, nil
+}
+
+func decodeUpdateItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UpdateItemRequest)
+
+	var (
+		title        *string
+		notes        *string
+		completed    *bool
+		order        *int
+		listID       *string
+		parentID     *string
+		autoComplete *bool
+		priority     *todo.Priority
+	)
+
+	if req.Title != nil {
+		title = &req.Title.Value
+	}
+
+	if req.Notes != nil {
+		notes = &req.Notes.Value
+	}
+
+	if req.Completed != nil {
+		completed = &req.Completed.Value
+	}
+
+	if req.Order != nil {
+		o := int(req.Order.Value)
+		order = &o
+	}
+
+	if req.ListId != nil {
+		listID = &req.ListId.Value
+	}
+
+	if req.ParentId != nil {
+		parentID = &req.ParentId.Value
+	}
+
+	if req.AutoComplete != nil {
+		autoComplete = &req.AutoComplete.Value
+	}
+
+	if req.Priority != api.Priority_PRIORITY_UNSPECIFIED {
+		p := unmarshalPriorityGRPC(req.Priority)
+		priority = &p
+	}
+
+	return UpdateItemRequest{
+		Id: req.GetId(),
+		ItemUpdate: todo.ItemUpdate{
+			Title:          title,
+			Notes:          notes,
+			Completed:      completed,
+			Order:          order,
+			DueAt:          unmarshalTimestampGRPC(req.GetDueAt()),
+			ClearDueAt:     req.GetClearDueAt(),
+			Priority:       priority,
+			Recurrence:     unmarshalRecurrenceGRPC(req.GetRecurrence()),
+			EndRecurrence:  req.GetEndRecurrence(),
+			SkipOccurrence: req.GetSkipOccurrence(),
+			ListID:         listID,
+			ParentID:       parentID,
+			AutoComplete:   autoComplete,
+		},
+	}, nil
+}
+
+func encodeUpdateItemGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UpdateItemResponse)
+
+	return &api.UpdateItemResponse{
+		Item: marshalItemGRPC(resp.Item),
+	}, nil
+}
+
+func decodeDeleteItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.DeleteItemRequest)
+
+	return DeleteItemRequest{
+		Id:      req.GetId(),
+		Cascade: req.GetCascade(),
+	}, nil
+}
+
+func encodeDeleteItemGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.DeleteItemResponse{}, nil
+}
+
+func decodeCreateListGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.CreateListRequest)
+
+	return CreateListRequest{
+		NewList: todo.NewList{
+			Name:        req.GetName(),
+			Description: req.GetDescription(),
+		},
+	}, nil
+}
+
+func encodeCreateListGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(CreateListResponse)
+
+	return &api.CreateListResponse{
+		List: marshalListGRPC(resp.List),
+	}, nil
+}
+
+func decodeListListsGRPCRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return ListListsRequest{}, nil
+}
+
+func encodeListListsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListListsResponse)
+
+	lists := make([]*api.TodoList, 0, len(resp.Lists))
+
+	for _, list := range resp.Lists {
+		lists = append(lists, marshalListGRPC(list))
+	}
+
+	return &api.ListListsResponse{
+		Lists: lists,
+	}, nil
+}
+
+func decodeGetListGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.GetListRequest)
+
+	return GetListRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeGetListGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetListResponse)
+
+	return &api.GetListResponse{
+		List: marshalListGRPC(resp.List),
+	}, nil
+}
+
+func decodeUpdateListGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UpdateListRequest)
+
+	var listUpdate todo.ListUpdate
+
+	if req.Name != nil {
+		listUpdate.Name = &req.Name.Value
+	}
+
+	if req.Description != nil {
+		listUpdate.Description = &req.Description.Value
+	}
+
+	if req.Archived != nil {
+		listUpdate.Archived = &req.Archived.Value
+	}
+
+	return UpdateListRequest{
+		Id:         req.GetId(),
+		ListUpdate: listUpdate,
+	}, nil
+}
+
+func encodeUpdateListGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UpdateListResponse)
+
+	return &api.UpdateListResponse{
+		List: marshalListGRPC(resp.List),
+	}, nil
+}
+
+func decodeDeleteListGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.DeleteListRequest)
+
+	return DeleteListRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeDeleteListGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.DeleteListResponse{}, nil
+}
+
+func decodeCreateTagGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.CreateTagRequest)
+
+	return CreateTagRequest{
+		NewTag: todo.NewTag{
+			Name:  req.GetName(),
+			Color: req.GetColor(),
+		},
+	}, nil
+}
+
+func encodeCreateTagGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(CreateTagResponse)
+
+	return &api.CreateTagResponse{
+		Tag: marshalTagGRPC(resp.Tag),
+	}, nil
+}
+
+func decodeListTagsGRPCRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return ListTagsRequest{}, nil
+}
+
+func encodeListTagsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListTagsResponse)
+
+	return &api.ListTagsResponse{
+		Tags: marshalTagsGRPC(resp.Tags),
+	}, nil
+}
+
+func decodeGetTagGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.GetTagRequest)
+
+	return GetTagRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeGetTagGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetTagResponse)
+
+	return &api.GetTagResponse{
+		Tag: marshalTagGRPC(resp.Tag),
+	}, nil
+}
+
+func decodeUpdateTagGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UpdateTagRequest)
+
+	var tagUpdate todo.TagUpdate
+
+	if req.Name != nil {
+		tagUpdate.Name = &req.Name.Value
+	}
+
+	if req.Color != nil {
+		tagUpdate.Color = &req.Color.Value
+	}
+
+	return UpdateTagRequest{
+		Id:        req.GetId(),
+		TagUpdate: tagUpdate,
+	}, nil
+}
+
+func encodeUpdateTagGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UpdateTagResponse)
+
+	return &api.UpdateTagResponse{
+		Tag: marshalTagGRPC(resp.Tag),
+	}, nil
+}
+
+func decodeMergeTagsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.MergeTagsRequest)
+
+	return MergeTagsRequest{
+		SourceID: req.GetId(),
+		TargetID: req.GetTargetId(),
+	}, nil
+}
+
+func encodeMergeTagsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(MergeTagsResponse)
+
+	return &api.MergeTagsResponse{
+		Tag: marshalTagGRPC(resp.Tag),
+	}, nil
+}
+
+func decodeDeleteTagGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.DeleteTagRequest)
+
+	return DeleteTagRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeDeleteTagGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.DeleteTagResponse{}, nil
+}
+
+func decodeTagItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.TagItemRequest)
+
+	return TagItemRequest{
+		Id:   req.GetId(),
+		Tags: req.GetTags(),
+	}, nil
+}
+
+func encodeTagItemGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(TagItemResponse)
+
+	return &api.TagItemResponse{
+		Item: marshalItemGRPC(resp.Item),
+	}, nil
+}
+
+func decodeUntagItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UntagItemRequest)
+
+	return UntagItemRequest{
+		Id:   req.GetId(),
+		Tags: req.GetTags(),
+	}, nil
+}
+
+func encodeUntagItemGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UntagItemResponse)
+
+	return &api.UntagItemResponse{
+		Item: marshalItemGRPC(resp.Item),
+	}, nil
+}
+
+func decodeAssignItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.AssignItemRequest)
+
+	return AssignItemRequest{
+		Id:       req.GetId(),
+		Assignee: req.GetAssignee(),
+	}, nil
+}
+
+func encodeAssignItemGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(AssignItemResponse)
+
+	return &api.AssignItemResponse{
+		Item: marshalItemGRPC(resp.Item),
+	}, nil
+}
+
+func decodeUnassignItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UnassignItemRequest)
+
+	return UnassignItemRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeUnassignItemGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UnassignItemResponse)
+
+	return &api.UnassignItemResponse{
+		Item: marshalItemGRPC(resp.Item),
+	}, nil
+}
+
+func decodeMoveItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.MoveItemRequest)
+
+	return MoveItemRequest{
+		Id: req.GetId(),
+		Move: todo.ItemMove{
+			Before: req.GetBeforeId(),
+			After:  req.GetAfterId(),
+		},
+	}, nil
+}
+
+func encodeMoveItemGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(MoveItemResponse)
+
+	return &api.MoveItemResponse{
+		Item: marshalItemGRPC(resp.Item),
+	}, nil
+}
+
+func decodeListTrashGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListTrashRequest)
+
+	return ListTrashRequest{
+		ListID: req.GetListId(),
+	}, nil
+}
+
+func encodeListTrashGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListTrashResponse)
+
+	items := make([]*api.TodoItem, 0, len(resp.Items))
+
+	for _, item := range resp.Items {
+		items = append(items, marshalItemGRPC(item))
+	}
+
+	return &api.ListTrashResponse{
+		Items: items,
+	}, nil
+}
+
+func decodeRestoreItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.RestoreItemRequest)
+
+	return RestoreItemRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeRestoreItemGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(RestoreItemResponse)
+
+	return &api.RestoreItemResponse{
+		Item: marshalItemGRPC(resp.Item),
+	}, nil
+}
+
+func decodePurgeItemGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.PurgeItemRequest)
+
+	return PurgeItemRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodePurgeItemGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.PurgeItemResponse{}, nil
+}
+
+func decodeEmptyTrashGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.EmptyTrashRequest)
+
+	return EmptyTrashRequest{
+		ListID: req.GetListId(),
+	}, nil
+}
+
+func encodeEmptyTrashGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.EmptyTrashResponse{}, nil
+}
+
+func decodeItemHistoryGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ItemHistoryRequest)
+
+	return ItemHistoryRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeItemHistoryGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ItemHistoryResponse)
+
+	entries := make([]*api.HistoryEntry, 0, len(resp.Entries))
+
+	for _, entry := range resp.Entries {
+		changes := make([]*api.FieldChange, 0, len(entry.Changes))
+
+		for _, change := range entry.Changes {
+			changes = append(changes, &api.FieldChange{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			})
+		}
+
+		entries = append(entries, &api.HistoryEntry{
+			Id:            entry.ID,
+			ItemId:        entry.ItemID,
+			Actor:         entry.Actor,
+			Operation:     entry.Operation,
+			CorrelationId: entry.CorrelationID,
+			Changes:       changes,
+			CreatedAt:     timestamppb.New(entry.CreatedAt),
+		})
+	}
+
+	return &api.ItemHistoryResponse{
+		Entries: entries,
+	}, nil
+}
+
+func decodeUndoGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UndoRequest)
+
+	return UndoRequest{
+		Steps: int(req.GetSteps()),
+	}, nil
+}
+
+func encodeUndoGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UndoResponse)
+
+	return &api.UndoResponse{
+		Operations: marshalOperationsGRPC(resp.Operations),
+	}, nil
+}
+
+func decodeRedoGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.RedoRequest)
+
+	return RedoRequest{
+		Steps: int(req.GetSteps()),
+	}, nil
+}
+
+func encodeRedoGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(RedoResponse)
+
+	return &api.RedoResponse{
+		Operations: marshalOperationsGRPC(resp.Operations),
+	}, nil
+}
+
+func decodeBulkAddItemsGRPCRequest(ctx context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.BulkAddItemsRequest)
+
+	newItems := make([]todo.NewItem, 0, len(req.GetItems()))
+
+	for _, item := range req.GetItems() {
+		r, err := decodeAddItemGRPCRequest(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+
+		newItems = append(newItems, r.(AddItemRequest).NewItem)
+	}
+
+	return BulkAddItemsRequest{
+		NewItems: newItems,
+		Mode:     unmarshalBulkModeGRPC(req.GetMode()),
+	}, nil
+}
+
+func encodeBulkAddItemsGRPCResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(BulkAddItemsResponse)
+
+	return &api.BulkAddItemsResponse{
+		Results: marshalBulkResultsGRPC(ctx, resp.Results),
+	}, nil
+}
+
+func decodeBulkUpdateItemsGRPCRequest(ctx context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.BulkUpdateItemsRequest)
+
+	updates := make([]todo.BulkItemUpdate, 0, len(req.GetItems()))
+
+	for _, item := range req.GetItems() {
+		update := item.GetUpdate()
+		if update == nil {
+			update = &api.UpdateItemRequest{}
+		}
+
+		r, err := decodeUpdateItemGRPCRequest(ctx, update)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, todo.BulkItemUpdate{
+			ID:     update.GetId(),
+			Update: r.(UpdateItemRequest).ItemUpdate,
+			Tags:   item.GetTags(),
+			Untags: item.GetUntags(),
+		})
+	}
+
+	return BulkUpdateItemsRequest{
+		Updates: updates,
+		Mode:    unmarshalBulkModeGRPC(req.GetMode()),
+	}, nil
+}
+
+func encodeBulkUpdateItemsGRPCResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(BulkUpdateItemsResponse)
+
+	return &api.BulkUpdateItemsResponse{
+		Results: marshalBulkResultsGRPC(ctx, resp.Results),
+	}, nil
+}
+
+func decodeBulkDeleteItemsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.BulkDeleteItemsRequest)
+
+	return BulkDeleteItemsRequest{
+		Ids:     req.GetIds(),
+		Cascade: req.GetCascade(),
+		Mode:    unmarshalBulkModeGRPC(req.GetMode()),
+	}, nil
+}
+
+func encodeBulkDeleteItemsGRPCResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(BulkDeleteItemsResponse)
+
+	return &api.BulkDeleteItemsResponse{
+		Results: marshalBulkResultsGRPC(ctx, resp.Results),
+	}, nil
+}
+
+func decodeExportItemsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ExportItemsRequest)
+
+	return ExportItemsRequest{
+		ListID: req.GetListId(),
+		Format: unmarshalItemFormatGRPC(req.GetFormat()),
+	}, nil
+}
+
+func encodeExportItemsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	export := response.(ExportItemsResponse).Export
+
+	var buf bytes.Buffer
+
+	_, err := export.WriteTo(&buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ExportItemsResponse{
+		Data:        buf.Bytes(),
+		ContentType: export.Format.ContentType(),
+	}, nil
+}
+
+func decodeImportItemsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ImportItemsRequest)
+
+	var strategy todo.ImportStrategy
+
+	switch req.GetStrategy() {
+	case api.ImportStrategy_IMPORT_STRATEGY_MERGE:
+		strategy = todo.ImportMerge
+	case api.ImportStrategy_IMPORT_STRATEGY_REPLACE:
+		strategy = todo.ImportReplace
+	}
+
+	return ImportItemsRequest{
+		Data: bytes.NewReader(req.GetData()),
+		Options: todo.ImportOptions{
+			ListID:   req.GetListId(),
+			Format:   unmarshalItemFormatGRPC(req.GetFormat()),
+			Strategy: strategy,
+			DryRun:   req.GetDryRun(),
+		},
+	}, nil
+}
+
+func encodeImportItemsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	report := response.(ImportItemsResponse).Report
+
+	return &api.ImportItemsResponse{
+		Added:   int32(report.Added),
+		Skipped: int32(report.Skipped),
+		Deleted: int32(report.Deleted),
+		DryRun:  report.DryRun,
+	}, nil
+}
+
+func decodeSearchItemsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.SearchItemsRequest)
+
+	return SearchItemsRequest{
+		Query: todo.SearchQuery{
+			Query:  req.GetQuery(),
+			ListID: req.GetListId(),
+			Limit:  int(req.GetLimit()),
+		},
+	}, nil
+}
+
+func encodeSearchItemsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(SearchItemsResponse)
+
+	results := make([]*api.SearchResult, 0, len(resp.Results))
+
+	for _, result := range resp.Results {
+		highlights := make([]*api.Highlight, 0, len(result.Highlights))
+
+		for _, highlight := range result.Highlights {
+			highlights = append(highlights, &api.Highlight{
+				Field:    highlight.Field,
+				Fragment: highlight.Fragment,
+			})
+		}
+
+		results = append(results, &api.SearchResult{
+			Item:       marshalItemGRPC(result.Item),
+			Highlights: highlights,
+		})
+	}
+
+	return &api.SearchItemsResponse{
+		Results: results,
+	}, nil
+}
+
+func decodeAddCommentGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.AddCommentRequest)
+
+	return AddCommentRequest{
+		ItemID: req.GetItemId(),
+		NewComment: todo.NewComment{
+			Body: req.GetBody(),
+		},
+	}, nil
+}
+
+func encodeAddCommentGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(AddCommentResponse)
+
+	return &api.AddCommentResponse{
+		Comment: marshalCommentGRPC(resp.Comment),
+	}, nil
+}
+
+func decodeListCommentsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListCommentsRequest)
+
+	return ListCommentsRequest{
+		ItemID: req.GetItemId(),
+	}, nil
+}
+
+func encodeListCommentsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListCommentsResponse)
+
+	comments := make([]*api.Comment, 0, len(resp.Comments))
+
+	for _, comment := range resp.Comments {
+		comments = append(comments, marshalCommentGRPC(comment))
+	}
+
+	return &api.ListCommentsResponse{
+		Comments: comments,
+	}, nil
+}
+
+func decodeGetCommentGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.GetCommentRequest)
+
+	return GetCommentRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeGetCommentGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetCommentResponse)
+
+	return &api.GetCommentResponse{
+		Comment: marshalCommentGRPC(resp.Comment),
+	}, nil
+}
+
+func decodeUpdateCommentGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UpdateCommentRequest)
+
+	return UpdateCommentRequest{
+		Id:   req.GetId(),
+		Body: req.GetBody(),
+	}, nil
+}
+
+func encodeUpdateCommentGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UpdateCommentResponse)
+
+	return &api.UpdateCommentResponse{
+		Comment: marshalCommentGRPC(resp.Comment),
+	}, nil
+}
+
+func decodeDeleteCommentGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.DeleteCommentRequest)
+
+	return DeleteCommentRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeDeleteCommentGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.DeleteCommentResponse{}, nil
+}
+
+func marshalCommentGRPC(comment todo.Comment) *api.Comment {
+	edits := make([]*api.CommentEdit, 0, len(comment.Edits))
+
+	for _, edit := range comment.Edits {
+		edits = append(edits, &api.CommentEdit{
+			Body:     edit.Body,
+			EditedAt: timestamppb.New(edit.EditedAt),
+		})
+	}
+
+	return &api.Comment{
+		Id:        comment.ID,
+		ItemId:    comment.ItemID,
+		Author:    comment.Author,
+		Body:      comment.Body,
+		Mentions:  comment.Mentions,
+		Edits:     edits,
+		CreatedAt: timestamppb.New(comment.CreatedAt),
+		UpdatedAt: marshalTimestampGRPC(comment.UpdatedAt),
+	}
+}
+
+func decodeAddAttachmentGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.AddAttachmentRequest)
+
+	return AddAttachmentRequest{
+		ItemID: req.GetItemId(),
+		NewAttachment: todo.NewAttachment{
+			Name:        req.GetName(),
+			ContentType: req.GetContentType(),
+			Size:        int64(len(req.GetContent())),
+			Checksum:    req.GetChecksum(),
+			Content:     bytes.NewReader(req.GetContent()),
+		},
+	}, nil
+}
+
+func encodeAddAttachmentGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(AddAttachmentResponse)
+
+	return &api.AddAttachmentResponse{
+		Attachment: marshalAttachmentGRPC(resp.Attachment),
+	}, nil
+}
+
+func decodeListAttachmentsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListAttachmentsRequest)
+
+	return ListAttachmentsRequest{
+		ItemID: req.GetItemId(),
+	}, nil
+}
+
+func encodeListAttachmentsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListAttachmentsResponse)
+
+	attachments := make([]*api.Attachment, 0, len(resp.Attachments))
+
+	for _, attachment := range resp.Attachments {
+		attachments = append(attachments, marshalAttachmentGRPC(attachment))
+	}
+
+	return &api.ListAttachmentsResponse{
+		Attachments: attachments,
+	}, nil
+}
+
+func decodeGetAttachmentGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.GetAttachmentRequest)
+
+	return GetAttachmentRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeGetAttachmentGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetAttachmentResponse)
+
+	return &api.GetAttachmentResponse{
+		Attachment: marshalAttachmentGRPC(resp.Attachment),
+	}, nil
+}
+
+func decodeDeleteAttachmentGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.DeleteAttachmentRequest)
+
+	return DeleteAttachmentRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeDeleteAttachmentGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.DeleteAttachmentResponse{}, nil
+}
+
+func marshalAttachmentGRPC(attachment todo.Attachment) *api.Attachment {
+	return &api.Attachment{
+		Id:          attachment.ID,
+		ItemId:      attachment.ItemID,
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		Uploader:    attachment.Uploader,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+		Url:         attachmentDownloadURL(attachment),
+		ExpiresAt:   timestamppb.New(attachment.Link.ExpiresAt),
+	}
+}
+
+func decodeCreateShareTokenGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.CreateShareTokenRequest)
+
+	return CreateShareTokenRequest{
+		ListID: req.GetListId(),
+		NewShareToken: todo.NewShareToken{
+			Access:    unmarshalShareAccessGRPC(req.GetAccess()),
+			ExpiresAt: unmarshalTimestampGRPC(req.GetExpiresAt()),
+		},
+	}, nil
+}
+
+func encodeCreateShareTokenGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(CreateShareTokenResponse)
+
+	return &api.CreateShareTokenResponse{
+		Token: marshalShareTokenGRPC(resp.Token),
+	}, nil
+}
+
+func decodeListShareTokensGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListShareTokensRequest)
+
+	return ListShareTokensRequest{
+		ListID: req.GetListId(),
+	}, nil
+}
+
+func encodeListShareTokensGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListShareTokensResponse)
+
+	tokens := make([]*api.ShareToken, 0, len(resp.Tokens))
+
+	for _, token := range resp.Tokens {
+		tokens = append(tokens, marshalShareTokenGRPC(token))
+	}
+
+	return &api.ListShareTokensResponse{
+		Tokens: tokens,
+	}, nil
+}
+
+func decodeRevokeShareTokenGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.RevokeShareTokenRequest)
+
+	return RevokeShareTokenRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeRevokeShareTokenGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.RevokeShareTokenResponse{}, nil
+}
+
+func decodeListShareAccessGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListShareAccessRequest)
+
+	return ListShareAccessRequest{
+		ListID: req.GetListId(),
+	}, nil
+}
+
+func encodeListShareAccessGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListShareAccessResponse)
+
+	entries := make([]*api.ShareAccessEntry, 0, len(resp.Entries))
+
+	for _, entry := range resp.Entries {
+		entries = append(entries, &api.ShareAccessEntry{
+			Id:            entry.ID,
+			ShareId:       entry.ShareID,
+			ListId:        entry.ListID,
+			Operation:     entry.Operation,
+			ItemId:        entry.ItemID,
+			CorrelationId: entry.CorrelationID,
+			Denied:        entry.Denied,
+			AccessedAt:    timestamppb.New(entry.AccessedAt),
+		})
+	}
+
+	return &api.ListShareAccessResponse{
+		Entries: entries,
+	}, nil
+}
+
+func decodeInviteCollaboratorGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.InviteCollaboratorRequest)
+
+	return InviteCollaboratorRequest{
+		ListID: req.GetListId(),
+		Invitation: todo.Invitation{
+			Email: req.GetEmail(),
+			Role:  unmarshalCollaboratorRoleGRPC(req.GetRole()),
+		},
+	}, nil
+}
+
+func encodeInviteCollaboratorGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(InviteCollaboratorResponse)
+
+	return &api.InviteCollaboratorResponse{
+		Collaborator: marshalCollaboratorGRPC(resp.Collaborator),
+	}, nil
+}
+
+func decodeListCollaboratorsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListCollaboratorsRequest)
+
+	return ListCollaboratorsRequest{
+		ListID: req.GetListId(),
+	}, nil
+}
+
+func encodeListCollaboratorsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListCollaboratorsResponse)
+
+	collaborators := make([]*api.Collaborator, 0, len(resp.Collaborators))
+
+	for _, collaborator := range resp.Collaborators {
+		collaborators = append(collaborators, marshalCollaboratorGRPC(collaborator))
+	}
+
+	return &api.ListCollaboratorsResponse{
+		Collaborators: collaborators,
+	}, nil
+}
+
+func decodeRemoveCollaboratorGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.RemoveCollaboratorRequest)
+
+	return RemoveCollaboratorRequest{
+		ListID: req.GetListId(),
+		Email:  req.GetEmail(),
+	}, nil
+}
+
+func encodeRemoveCollaboratorGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.RemoveCollaboratorResponse{}, nil
+}
+
+func decodeStartTimerGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.StartTimerRequest)
+
+	return StartTimerRequest{
+		ItemID: req.GetItemId(),
+	}, nil
+}
+
+func encodeStartTimerGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(StartTimerResponse)
+
+	return &api.StartTimerResponse{
+		Entry: marshalTimeEntryGRPC(resp.Entry),
+	}, nil
+}
+
+func decodeStopTimerGRPCRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return StopTimerRequest{}, nil
+}
+
+func encodeStopTimerGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(StopTimerResponse)
+
+	return &api.StopTimerResponse{
+		Entry: marshalTimeEntryGRPC(resp.Entry),
+	}, nil
+}
+
+func decodeGetTimerGRPCRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return GetTimerRequest{}, nil
+}
+
+func encodeGetTimerGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetTimerResponse)
+
+	return &api.GetTimerResponse{
+		Entry: marshalTimeEntryGRPC(resp.Entry),
+	}, nil
+}
+
+func decodeListTimeEntriesGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return ListTimeEntriesRequest{
+		Filter: unmarshalTimeEntryFilterGRPC(request.(*api.ListTimeEntriesRequest)),
+	}, nil
+}
+
+func encodeListTimeEntriesGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListTimeEntriesResponse)
+
+	entries := make([]*api.TimeEntry, 0, len(resp.Entries))
+
+	for _, entry := range resp.Entries {
+		entries = append(entries, marshalTimeEntryGRPC(entry))
+	}
+
+	return &api.ListTimeEntriesResponse{
+		Entries: entries,
+	}, nil
+}
+
+func decodeTimeTotalsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return TimeTotalsRequest{
+		Filter: unmarshalTimeEntryFilterGRPC(request.(*api.TimeTotalsRequest)),
+	}, nil
+}
+
+func encodeTimeTotalsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	totals := response.(TimeTotalsResponse).Totals
+
+	apiTotals := &api.TimeTotals{
+		Items: make([]*api.ItemTime, 0, len(totals.Items)),
+		Days:  make([]*api.DayTime, 0, len(totals.Days)),
+		Total: durationpb.New(totals.Total),
+	}
+
+	for _, item := range totals.Items {
+		apiTotals.Items = append(apiTotals.Items, &api.ItemTime{
+			ItemId:   item.ItemID,
+			Title:    item.Title,
+			Duration: durationpb.New(item.Duration),
+		})
+	}
+
+	for _, day := range totals.Days {
+		apiTotals.Days = append(apiTotals.Days, &api.DayTime{
+			Day:      day.Day.Format("2006-01-02"),
+			Duration: durationpb.New(day.Duration),
+		})
+	}
+
+	return &api.TimeTotalsResponse{
+		Totals: apiTotals,
+	}, nil
+}
+
+func decodeExportTimesheetGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return ExportTimesheetRequest{
+		Filter: unmarshalTimeEntryFilterGRPC(request.(*api.ExportTimesheetRequest)),
+	}, nil
+}
+
+func encodeExportTimesheetGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	timesheet := response.(ExportTimesheetResponse).Timesheet
+
+	var buf bytes.Buffer
+
+	_, err := timesheet.WriteTo(&buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ExportTimesheetResponse{
+		Data:        buf.Bytes(),
+		ContentType: todo.FormatCSV.ContentType(),
+	}, nil
+}
+
+func decodeCreateTemplateGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.CreateTemplateRequest)
+
+	return CreateTemplateRequest{
+		NewTemplate: todo.NewTemplate{
+			Name:        req.GetName(),
+			Description: req.GetDescription(),
+			Items:       unmarshalTemplateItemsGRPC(req.GetItems()),
+		},
+	}, nil
+}
+
+func encodeCreateTemplateGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(CreateTemplateResponse)
+
+	return &api.CreateTemplateResponse{
+		Template: marshalTemplateGRPC(resp.Template),
+	}, nil
+}
+
+func decodeListTemplatesGRPCRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return ListTemplatesRequest{}, nil
+}
+
+func encodeListTemplatesGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListTemplatesResponse)
+
+	templates := make([]*api.Template, 0, len(resp.Templates))
+
+	for _, template := range resp.Templates {
+		templates = append(templates, marshalTemplateGRPC(template))
+	}
+
+	return &api.ListTemplatesResponse{
+		Templates: templates,
+	}, nil
+}
+
+func decodeGetTemplateGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.GetTemplateRequest)
+
+	return GetTemplateRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeGetTemplateGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetTemplateResponse)
+
+	return &api.GetTemplateResponse{
+		Template: marshalTemplateGRPC(resp.Template),
+	}, nil
+}
+
+func decodeUpdateTemplateGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UpdateTemplateRequest)
+
+	var templateUpdate todo.TemplateUpdate
+
+	if req.Name != nil {
+		templateUpdate.Name = &req.Name.Value
+	}
+
+	if req.Description != nil {
+		templateUpdate.Description = &req.Description.Value
+	}
+
+	if req.Items != nil {
+		templateUpdate.Items = unmarshalTemplateItemsGRPC(req.Items.GetItems())
+	}
+
+	return UpdateTemplateRequest{
+		Id:             req.GetId(),
+		TemplateUpdate: templateUpdate,
+	}, nil
+}
+
+func encodeUpdateTemplateGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UpdateTemplateResponse)
+
+	return &api.UpdateTemplateResponse{
+		Template: marshalTemplateGRPC(resp.Template),
+	}, nil
+}
+
+func decodeDeleteTemplateGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.DeleteTemplateRequest)
+
+	return DeleteTemplateRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeDeleteTemplateGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.DeleteTemplateResponse{}, nil
+}
+
+func decodeInstantiateTemplateGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.InstantiateTemplateRequest)
+
+	return InstantiateTemplateRequest{
+		Id: req.GetId(),
+		Instantiation: todo.TemplateInstantiation{
+			ListID:   req.GetListId(),
+			ListName: req.GetListName(),
+			Start:    unmarshalTimestampGRPC(req.GetStart()),
+		},
+	}, nil
+}
+
+func encodeInstantiateTemplateGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	instance := response.(InstantiateTemplateResponse).Instance
+
+	items := make([]*api.TodoItem, 0, len(instance.Items))
+
+	for _, item := range instance.Items {
+		items = append(items, marshalItemGRPC(item))
+	}
+
+	return &api.InstantiateTemplateResponse{
+		List:  marshalListGRPC(instance.List),
+		Items: items,
+	}, nil
+}
+
+func marshalTemplateGRPC(template todo.Template) *api.Template {
+	items := make([]*api.TemplateItem, 0, len(template.Items))
+
+	for _, item := range template.Items {
+		apiItem := &api.TemplateItem{
+			Title:    item.Title,
+			Notes:    item.Notes,
+			Priority: api.Priority(item.Priority + 1),
+			Order:    int32(item.Order),
+			Tags:     item.Tags,
+		}
+
+		if item.DueOffset != nil {
+			apiItem.DueOffset = durationpb.New(*item.DueOffset)
+		}
+
+		items = append(items, apiItem)
+	}
+
+	return &api.Template{
+		Id:          template.ID,
+		Name:        template.Name,
+		Description: template.Description,
+		Items:       items,
+	}
+}
+
+func unmarshalTemplateItemsGRPC(apiItems []*api.TemplateItem) []todo.TemplateItem {
+	items := make([]todo.TemplateItem, 0, len(apiItems))
+
+	for _, apiItem := range apiItems {
+		item := todo.TemplateItem{
+			Title:    apiItem.GetTitle(),
+			Notes:    apiItem.GetNotes(),
+			Priority: unmarshalPriorityGRPC(apiItem.GetPriority()),
+			Order:    int(apiItem.GetOrder()),
+			Tags:     apiItem.GetTags(),
+		}
+
+		if apiItem.DueOffset != nil {
+			offset := apiItem.DueOffset.AsDuration()
+			item.DueOffset = &offset
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// timeEntryFilterGRPC is implemented by the requests filtering time entries.
+type timeEntryFilterGRPC interface {
+	GetItemId() string
+	GetUser() string
+	GetMine() bool
+	GetFrom() *timestamppb.Timestamp
+	GetTo() *timestamppb.Timestamp
+	GetRunning() bool
+}
+
+func unmarshalTimeEntryFilterGRPC(req timeEntryFilterGRPC) todo.TimeEntryFilter {
+	return todo.TimeEntryFilter{
+		ItemID:  req.GetItemId(),
+		User:    req.GetUser(),
+		Mine:    req.GetMine(),
+		From:    unmarshalTimestampGRPC(req.GetFrom()),
+		To:      unmarshalTimestampGRPC(req.GetTo()),
+		Running: req.GetRunning(),
+	}
+}
+
+func marshalTimeEntryGRPC(entry todo.TimeEntry) *api.TimeEntry {
+	return &api.TimeEntry{
+		Id:        entry.ID,
+		ItemId:    entry.ItemID,
+		User:      entry.User,
+		StartedAt: timestamppb.New(entry.StartedAt),
+		EndedAt:   marshalTimestampGRPC(entry.EndedAt),
+		Running:   entry.Running(),
+		Duration:  durationpb.New(entry.Duration),
+	}
+}
+
+func marshalShareTokenGRPC(token todo.ShareToken) *api.ShareToken {
+	return &api.ShareToken{
+		Id:        token.ID,
+		ListId:    token.ListID,
+		Access:    marshalShareAccessGRPC(token.Access),
+		Token:     token.Token,
+		Url:       sharedListURL(token),
+		Active:    token.Active(time.Now()),
+		CreatedBy: token.CreatedBy,
+		CreatedAt: timestamppb.New(token.CreatedAt),
+		ExpiresAt: marshalTimestampGRPC(token.ExpiresAt),
+		RevokedAt: marshalTimestampGRPC(token.RevokedAt),
+	}
+}
+
+func marshalShareAccessGRPC(access todo.ShareAccess) api.ShareAccess {
+	switch access {
+	case todo.ShareRead:
+		return api.ShareAccess_SHARE_ACCESS_READ
+	case todo.ShareEdit:
+		return api.ShareAccess_SHARE_ACCESS_EDIT
+	default:
+		return api.ShareAccess_SHARE_ACCESS_UNSPECIFIED
+	}
+}
+
+func unmarshalShareAccessGRPC(access api.ShareAccess) todo.ShareAccess {
+	switch access {
+	case api.ShareAccess_SHARE_ACCESS_READ:
+		return todo.ShareRead
+	case api.ShareAccess_SHARE_ACCESS_EDIT:
+		return todo.ShareEdit
+	default:
+		return ""
+	}
+}
+
+func marshalCollaboratorGRPC(collaborator todo.Collaborator) *api.Collaborator {
+	return &api.Collaborator{
+		Id:        collaborator.ID,
+		ListId:    collaborator.ListID,
+		Email:     collaborator.Email,
+		Role:      marshalCollaboratorRoleGRPC(collaborator.Role),
+		InvitedBy: collaborator.InvitedBy,
+		CreatedAt: timestamppb.New(collaborator.CreatedAt),
+	}
+}
+
+func marshalCollaboratorRoleGRPC(role todo.CollaboratorRole) api.CollaboratorRole {
+	switch role {
+	case todo.CollaboratorViewer:
+		return api.CollaboratorRole_COLLABORATOR_ROLE_VIEWER
+	case todo.CollaboratorEditor:
+		return api.CollaboratorRole_COLLABORATOR_ROLE_EDITOR
+	default:
+		return api.CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED
+	}
+}
+
+func unmarshalCollaboratorRoleGRPC(role api.CollaboratorRole) todo.CollaboratorRole {
+	switch role {
+	case api.CollaboratorRole_COLLABORATOR_ROLE_VIEWER:
+		return todo.CollaboratorViewer
+	case api.CollaboratorRole_COLLABORATOR_ROLE_EDITOR:
+		return todo.CollaboratorEditor
+	default:
+		return ""
+	}
+}
+
+func unmarshalItemFormatGRPC(format api.ItemFormat) todo.Format {
+	switch format {
+	case api.ItemFormat_ITEM_FORMAT_JSON:
+		return todo.FormatJSON
+	case api.ItemFormat_ITEM_FORMAT_CSV:
+		return todo.FormatCSV
+	case api.ItemFormat_ITEM_FORMAT_TODO_TXT:
+		return todo.FormatTodoTxt
+	case api.ItemFormat_ITEM_FORMAT_ICALENDAR:
+		return todo.FormatICalendar
+	default:
+		return ""
+	}
+}
+
+func unmarshalBulkModeGRPC(mode api.BulkMode) todo.BulkMode {
+	switch mode {
+	case api.BulkMode_BULK_MODE_ATOMIC:
+		return todo.BulkAtomic
+	case api.BulkMode_BULK_MODE_BEST_EFFORT:
+		return todo.BulkBestEffort
+	default:
+		return ""
+	}
+}
+
+// marshalBulkResultsGRPC converts the outcome of a bulk operation.
+// Failures are converted to statuses the same way as errors of single item operations.
+func marshalBulkResultsGRPC(ctx context.Context, results []todo.BulkResult) []*api.BulkResult {
+	statusConverter := appkitgrpc.NewDefaultStatusConverter()
+
+	apiResults := make([]*api.BulkResult, 0, len(results))
+
+	for _, result := range results {
+		apiResult := &api.BulkResult{
+			Id: result.ID,
+		}
+
+		switch result.Status {
+		case todo.BulkSucceeded:
+			apiResult.Status = api.BulkStatus_BULK_STATUS_SUCCEEDED
+		case todo.BulkFailed:
+			apiResult.Status = api.BulkStatus_BULK_STATUS_FAILED
+		case todo.BulkAborted:
+			apiResult.Status = api.BulkStatus_BULK_STATUS_ABORTED
+		}
+
+		if result.Status == todo.BulkSucceeded && result.Item.ID != "" {
+			apiResult.Item = marshalItemGRPC(result.Item)
+		}
+
+		if result.Err != nil {
+			st := statusConverter.NewStatus(ctx, result.Err)
+
+			apiResult.ErrorCode = uint32(st.Code())
+			apiResult.ErrorMessage = st.Message()
+		}
+
+		apiResults = append(apiResults, apiResult)
+	}
+
+	return apiResults
+}
+
+func marshalOperationsGRPC(operations []todo.Operation) []*api.Operation {
+	apiOperations := make([]*api.Operation, 0, len(operations))
+
+	for _, operation := range operations {
+		apiOperations = append(apiOperations, &api.Operation{
+			Id:        operation.ID,
+			Operation: operation.Name,
+			ItemIds:   operation.ItemIDs(),
+			CreatedAt: timestamppb.New(operation.CreatedAt),
+		})
+	}
+
+	return apiOperations
+}
+
+func marshalTagGRPC(tag todo.Tag) *api.Tag {
+	return &api.Tag{
+		Id:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		ItemCount: int32(tag.ItemCount),
+	}
+}
+
+func marshalTagsGRPC(tags []todo.Tag) []*api.Tag {
+	t := make([]*api.Tag, 0, len(tags))
+
+	for _, tag := range tags {
+		t = append(t, marshalTagGRPC(tag))
+	}
+
+	return t
+}
+
+func marshalListGRPC(list todo.List) *api.TodoList {
+	return &api.TodoList{
+		Id:          list.ID,
+		Name:        list.Name,
+		Description: list.Description,
+		Archived:    list.Archived,
+	}
+}
+
+func marshalItemGRPC(item todo.Item) *api.TodoItem {
+	return &api.TodoItem{
+		Id:           item.ID,
+		ListId:       item.ListID,
+		ParentId:     item.ParentID,
+		Title:        item.Title,
+		Completed:    item.Completed,
+		Order:        int32(item.Order),
+		DueAt:        marshalTimestampGRPC(item.DueAt),
+		Recurrence:   marshalRecurrenceGRPC(item.Recurrence),
+		AutoComplete: item.AutoComplete,
+		Tags:         marshalTagsGRPC(item.Tags),
+		Priority:     api.Priority(item.Priority + 1),
+		Rank:         item.Rank,
+		DeletedAt:    marshalTimestampGRPC(item.DeletedAt),
+		Notes:        item.Notes,
+		Assignee:     item.Assignee,
+	}
+}
+
+func marshalItemTreesGRPC(trees []todo.ItemTree) []*api.TodoItemTree {
+	items := make([]*api.TodoItemTree, 0, len(trees))
+
+	for _, tree := range trees {
+		items = append(items, &api.TodoItemTree{
+			Item:     marshalItemGRPC(tree.Item),
+			Children: marshalItemTreesGRPC(tree.Children),
+		})
+	}
+
+	return items
+}
+
+func marshalRecurrenceGRPC(recurrence *todo.Recurrence) *api.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	return &api.Recurrence{
+		Rule:       recurrence.Rule,
+		Timezone:   recurrence.Timezone,
+		Start:      timestamppb.New(recurrence.Start),
+		SeriesId:   recurrence.SeriesID,
+		Occurrence: timestamppb.New(recurrence.Occurrence),
+	}
+}
+
+func unmarshalRecurrenceGRPC(recurrence *api.Recurrence) *todo.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	r := todo.Recurrence{
+		Rule:     recurrence.GetRule(),
+		Timezone: recurrence.GetTimezone(),
+		SeriesID: recurrence.GetSeriesId(),
+	}
+
+	if recurrence.Start != nil {
+		r.Start = recurrence.Start.AsTime()
+	}
+
+	if recurrence.Occurrence != nil {
+		r.Occurrence = recurrence.Occurrence.AsTime()
+	}
+
+	return &r
+}
+
+// unmarshalPriorityGRPC converts a priority. Unspecified means no priority.
+func unmarshalPriorityGRPC(priority api.Priority) todo.Priority {
+	if priority == api.Priority_PRIORITY_UNSPECIFIED {
+		return todo.PriorityNone
+	}
+
+	return todo.Priority(priority - 1)
+}
+
+func unmarshalItemSortGRPC(sort api.ItemSort) todo.ItemSort {
+	switch sort {
+	case api.ItemSort_ITEM_SORT_UNSPECIFIED:
+		return ""
+	case api.ItemSort_ITEM_SORT_MANUAL:
+		return todo.SortManual
+	case api.ItemSort_ITEM_SORT_SMART:
+		return todo.SortSmart
+	default:
+		return todo.ItemSort(sort.String())
+	}
+}
+
+func marshalTimestampGRPC(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func unmarshalTimestampGRPC(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
+
+func decodeCreateRuleGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.CreateRuleRequest)
+
+	newRule := todo.NewRule{
+		Name:       req.GetName(),
+		Trigger:    unmarshalRuleTriggerGRPC(req.GetTrigger()),
+		Conditions: unmarshalRuleConditionsGRPC(req.GetConditions()),
+		Actions:    unmarshalRuleActionsGRPC(req.GetActions()),
+		DryRun:     req.GetDryRun(),
+	}
+
+	if req.Enabled != nil {
+		newRule.Enabled = &req.Enabled.Value
+	}
+
+	return CreateRuleRequest{
+		NewRule: newRule,
+	}, nil
+}
+
+func encodeCreateRuleGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(CreateRuleResponse)
+
+	return &api.CreateRuleResponse{
+		Rule: marshalRuleGRPC(resp.Rule),
+	}, nil
+}
+
+func decodeListRulesGRPCRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return ListRulesRequest{}, nil
+}
+
+func encodeListRulesGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListRulesResponse)
+
+	grpcResp := &api.ListRulesResponse{
+		Rules: make([]*api.Rule, 0, len(resp.Rules)),
+	}
+
+	for _, rule := range resp.Rules {
+		grpcResp.Rules = append(grpcResp.Rules, marshalRuleGRPC(rule))
+	}
+
+	return grpcResp, nil
+}
+
+func decodeGetRuleGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.GetRuleRequest)
+
+	return GetRuleRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeGetRuleGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetRuleResponse)
+
+	return &api.GetRuleResponse{
+		Rule: marshalRuleGRPC(resp.Rule),
+	}, nil
+}
+
+func decodeUpdateRuleGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.UpdateRuleRequest)
+
+	var ruleUpdate todo.RuleUpdate
+
+	if req.Name != nil {
+		ruleUpdate.Name = &req.Name.Value
+	}
+
+	if req.GetTrigger() != api.RuleTrigger_RULE_TRIGGER_UNSPECIFIED {
+		trigger := unmarshalRuleTriggerGRPC(req.GetTrigger())
+		ruleUpdate.Trigger = &trigger
+	}
+
+	if req.Conditions != nil {
+		ruleUpdate.Conditions = unmarshalRuleConditionsGRPC(req.Conditions.GetConditions())
+	}
+
+	if req.Actions != nil {
+		ruleUpdate.Actions = unmarshalRuleActionsGRPC(req.Actions.GetActions())
+	}
+
+	if req.Enabled != nil {
+		ruleUpdate.Enabled = &req.Enabled.Value
+	}
+
+	if req.DryRun != nil {
+		ruleUpdate.DryRun = &req.DryRun.Value
+	}
+
+	return UpdateRuleRequest{
+		Id:         req.GetId(),
+		RuleUpdate: ruleUpdate,
+	}, nil
+}
+
+func encodeUpdateRuleGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(UpdateRuleResponse)
+
+	return &api.UpdateRuleResponse{
+		Rule: marshalRuleGRPC(resp.Rule),
+	}, nil
+}
+
+func decodeDeleteRuleGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.DeleteRuleRequest)
+
+	return DeleteRuleRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeDeleteRuleGRPCResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &api.DeleteRuleResponse{}, nil
+}
+
+func decodeEvaluateRuleGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.EvaluateRuleRequest)
+
+	return EvaluateRuleRequest{
+		Id:     req.GetId(),
+		ItemID: req.GetItemId(),
+	}, nil
+}
+
+func encodeEvaluateRuleGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	evaluation := response.(EvaluateRuleResponse).Evaluation
+
+	grpcEvaluation := &api.RuleEvaluation{
+		Matched:    evaluation.Matched,
+		Conditions: make([]*api.RuleConditionResult, 0, len(evaluation.Conditions)),
+		Actions:    marshalRuleActionsGRPC(evaluation.Actions),
+	}
+
+	for _, result := range evaluation.Conditions {
+		grpcEvaluation.Conditions = append(grpcEvaluation.Conditions, &api.RuleConditionResult{
+			Condition: marshalRuleConditionGRPC(result.Condition),
+			Matched:   result.Matched,
+			Values:    result.Values,
+		})
+	}
+
+	return &api.EvaluateRuleResponse{
+		Evaluation: grpcEvaluation,
+	}, nil
+}
+
+func decodeListRuleExecutionsGRPCRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*api.ListRuleExecutionsRequest)
+
+	return ListRuleExecutionsRequest{
+		Id: req.GetId(),
+	}, nil
+}
+
+func encodeListRuleExecutionsGRPCResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListRuleExecutionsResponse)
+
+	grpcResp := &api.ListRuleExecutionsResponse{
+		Executions: make([]*api.RuleExecution, 0, len(resp.Executions)),
+	}
+
+	for _, execution := range resp.Executions {
+		grpcResp.Executions = append(grpcResp.Executions, &api.RuleExecution{
+			Id:            execution.ID,
+			RuleId:        execution.RuleID,
+			Trigger:       ruleTriggersGRPC[execution.Trigger],
+			ItemId:        execution.ItemID,
+			CorrelationId: execution.CorrelationID,
+			Status:        ruleExecutionStatusesGRPC[execution.Status],
+			Error:         execution.Error,
+			ExecutedAt:    timestamppb.New(execution.ExecutedAt),
+		})
+	}
+
+	return grpcResp, nil
+}
+
+// nolint: gochecknoglobals
+var (
+	ruleTriggersGRPC = map[todo.RuleTrigger]api.RuleTrigger{
+		todo.RuleOnItemAdded:        api.RuleTrigger_RULE_TRIGGER_ITEM_ADDED,
+		todo.RuleOnItemUpdated:      api.RuleTrigger_RULE_TRIGGER_ITEM_UPDATED,
+		todo.RuleOnMarkedAsComplete: api.RuleTrigger_RULE_TRIGGER_MARKED_AS_COMPLETE,
+		todo.RuleOnItemDueSoon:      api.RuleTrigger_RULE_TRIGGER_ITEM_DUE_SOON,
+		todo.RuleOnItemOverdue:      api.RuleTrigger_RULE_TRIGGER_ITEM_OVERDUE,
+		todo.RuleOnItemAssigned:     api.RuleTrigger_RULE_TRIGGER_ITEM_ASSIGNED,
+	}
+
+	ruleOperatorsGRPC = map[todo.RuleOperator]api.RuleOperator{
+		todo.RuleEquals:     api.RuleOperator_RULE_OPERATOR_EQUALS,
+		todo.RuleNotEquals:  api.RuleOperator_RULE_OPERATOR_NOT_EQUALS,
+		todo.RuleContains:   api.RuleOperator_RULE_OPERATOR_CONTAINS,
+		todo.RuleIsEmpty:    api.RuleOperator_RULE_OPERATOR_IS_EMPTY,
+		todo.RuleIsNotEmpty: api.RuleOperator_RULE_OPERATOR_IS_NOT_EMPTY,
+	}
+
+	ruleActionTypesGRPC = map[todo.RuleActionType]api.RuleActionType{
+		todo.RuleAddItem:     api.RuleActionType_RULE_ACTION_TYPE_ADD_ITEM,
+		todo.RuleSetPriority: api.RuleActionType_RULE_ACTION_TYPE_SET_PRIORITY,
+		todo.RuleComplete:    api.RuleActionType_RULE_ACTION_TYPE_COMPLETE,
+		todo.RuleTag:         api.RuleActionType_RULE_ACTION_TYPE_TAG,
+		todo.RuleUntag:       api.RuleActionType_RULE_ACTION_TYPE_UNTAG,
+		todo.RuleAssign:      api.RuleActionType_RULE_ACTION_TYPE_ASSIGN,
+		todo.RuleUnassign:    api.RuleActionType_RULE_ACTION_TYPE_UNASSIGN,
+		todo.RuleMoveToList:  api.RuleActionType_RULE_ACTION_TYPE_MOVE_TO_LIST,
+	}
+
+	ruleExecutionStatusesGRPC = map[todo.RuleExecutionStatus]api.RuleExecutionStatus{
+		todo.RuleApplied: api.RuleExecutionStatus_RULE_EXECUTION_STATUS_APPLIED,
+		todo.RuleFailed:  api.RuleExecutionStatus_RULE_EXECUTION_STATUS_FAILED,
+		todo.RuleDryRun:  api.RuleExecutionStatus_RULE_EXECUTION_STATUS_DRY_RUN,
+		todo.RuleSkipped: api.RuleExecutionStatus_RULE_EXECUTION_STATUS_SKIPPED,
+	}
+)
+
+// unmarshalRuleTriggerGRPC returns the trigger of a gRPC trigger (empty if it's unspecified).
+func unmarshalRuleTriggerGRPC(trigger api.RuleTrigger) todo.RuleTrigger {
+	for t, grpcTrigger := range ruleTriggersGRPC {
+		if grpcTrigger == trigger {
+			return t
+		}
+	}
+
+	return ""
+}
+
+func marshalRuleGRPC(rule todo.Rule) *api.Rule {
+	grpcRule := &api.Rule{
+		Id:         rule.ID,
+		Name:       rule.Name,
+		Trigger:    ruleTriggersGRPC[rule.Trigger],
+		Conditions: make([]*api.RuleCondition, 0, len(rule.Conditions)),
+		Actions:    marshalRuleActionsGRPC(rule.Actions),
+		Enabled:    rule.Enabled,
+		DryRun:     rule.DryRun,
+		Owner:      rule.Owner,
+		CreatedAt:  timestamppb.New(rule.CreatedAt),
+		UpdatedAt:  timestamppb.New(rule.UpdatedAt),
+	}
+
+	for _, condition := range rule.Conditions {
+		grpcRule.Conditions = append(grpcRule.Conditions, marshalRuleConditionGRPC(condition))
+	}
+
+	return grpcRule
+}
+
+func marshalRuleConditionGRPC(condition todo.RuleCondition) *api.RuleCondition {
+	return &api.RuleCondition{
+		Field:    condition.Field,
+		Operator: ruleOperatorsGRPC[condition.Operator],
+		Value:    condition.Value,
+	}
+}
+
+func marshalRuleActionsGRPC(actions []todo.RuleAction) []*api.RuleAction {
+	grpcActions := make([]*api.RuleAction, 0, len(actions))
+
+	for _, action := range actions {
+		grpcActions = append(grpcActions, &api.RuleAction{
+			Type:   ruleActionTypesGRPC[action.Type],
+			Value:  action.Value,
+			ListId: action.ListID,
+		})
+	}
+
+	return grpcActions
+}
+
+func unmarshalRuleConditionsGRPC(grpcConditions []*api.RuleCondition) []todo.RuleCondition {
+	conditions := make([]todo.RuleCondition, 0, len(grpcConditions))
+
+	for _, grpcCondition := range grpcConditions {
+		condition := todo.RuleCondition{
+			Field: grpcCondition.GetField(),
+			Value: grpcCondition.GetValue(),
+		}
+
+		for operator, grpcOperator := range ruleOperatorsGRPC {
+			if grpcOperator == grpcCondition.GetOperator() {
+				condition.Operator = operator
+			}
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions
+}
+
+func unmarshalRuleActionsGRPC(grpcActions []*api.RuleAction) []todo.RuleAction {
+	actions := make([]todo.RuleAction, 0, len(grpcActions))
+
+	for _, grpcAction := range grpcActions {
+		action := todo.RuleAction{
+			Value:  grpcAction.GetValue(),
+			ListID: grpcAction.GetListId(),
+		}
+
+		for actionType, grpcActionType := range ruleActionTypesGRPC {
+			if grpcActionType == grpcAction.GetType() {
+				action.Type = actionType
+			}
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
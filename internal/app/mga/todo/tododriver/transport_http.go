@@ -0,0 +1,3454 @@
+package tododriver
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	appkithttp "github.com/sagikazarmark/appkit/transport/http"
+	kitxhttp "github.com/sagikazarmark/kitx/transport/http"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+type contextKey int
+
+const (
+	ContextKeyBaseURL contextKey = iota
+)
+
+// RegisterHTTPHandlers mounts all of the service endpoints into a router.
+func RegisterHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodPost).Path("").Handler(kithttp.NewServer(
+		endpoints.AddItem,
+		decodeAddItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeAddItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("").Handler(kithttp.NewServer(
+		endpoints.ListItems,
+		decodeListItemsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListItemsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("").Handler(kithttp.NewServer(
+		endpoints.DeleteItems,
+		decodeDeleteItemsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/bulk/add").Handler(kithttp.NewServer(
+		endpoints.BulkAddItems,
+		decodeBulkAddItemsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeBulkAddItemsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/bulk/update").Handler(kithttp.NewServer(
+		endpoints.BulkUpdateItems,
+		decodeBulkUpdateItemsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeBulkUpdateItemsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/bulk/delete").Handler(kithttp.NewServer(
+		endpoints.BulkDeleteItems,
+		decodeBulkDeleteItemsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeBulkDeleteItemsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/export").Handler(kithttp.NewServer(
+		endpoints.ExportItems,
+		decodeExportItemsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeExportItemsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/import").Handler(kithttp.NewServer(
+		endpoints.ImportItems,
+		decodeImportItemsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeImportItemsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/search").Handler(kithttp.NewServer(
+		endpoints.SearchItems,
+		decodeSearchItemsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeSearchItemsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/tree").Handler(kithttp.NewServer(
+		endpoints.ListItemTree,
+		decodeListItemTreeHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListItemTreeHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/trash").Handler(kithttp.NewServer(
+		endpoints.ListTrash,
+		decodeListTrashHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListTrashHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/trash").Handler(kithttp.NewServer(
+		endpoints.EmptyTrash,
+		decodeEmptyTrashHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/trash/{id}/restore").Handler(kithttp.NewServer(
+		endpoints.RestoreItem,
+		decodeRestoreItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeRestoreItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/trash/{id}").Handler(kithttp.NewServer(
+		endpoints.PurgeItem,
+		decodePurgeItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/undo").Handler(kithttp.NewServer(
+		endpoints.Undo,
+		decodeUndoHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUndoHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/redo").Handler(kithttp.NewServer(
+		endpoints.Redo,
+		decodeRedoHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeRedoHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.GetItem,
+		decodeGetItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeGetItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPatch).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.UpdateItem,
+		decodeUpdateItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUpdateItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.DeleteItem,
+		decodeDeleteItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/tags").Handler(kithttp.NewServer(
+		endpoints.TagItem,
+		decodeTagItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeTagItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}/tags").Handler(kithttp.NewServer(
+		endpoints.UntagItem,
+		decodeUntagItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUntagItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPut).Path("/{id}/assignee").Handler(kithttp.NewServer(
+		endpoints.AssignItem,
+		decodeAssignItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeAssignItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}/assignee").Handler(kithttp.NewServer(
+		endpoints.UnassignItem,
+		decodeUnassignItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUnassignItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/move").Handler(kithttp.NewServer(
+		endpoints.MoveItem,
+		decodeMoveItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeMoveItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}/history").Handler(kithttp.NewServer(
+		endpoints.ItemHistory,
+		decodeItemHistoryHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeItemHistoryHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/comments").Handler(kithttp.NewServer(
+		endpoints.AddComment,
+		decodeAddCommentHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeAddCommentHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}/comments").Handler(kithttp.NewServer(
+		endpoints.ListComments,
+		decodeListCommentsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListCommentsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/attachments").Handler(kithttp.NewServer(
+		endpoints.AddAttachment,
+		decodeAddAttachmentHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeAddAttachmentHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}/attachments").Handler(kithttp.NewServer(
+		endpoints.ListAttachments,
+		decodeListAttachmentsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListAttachmentsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/timer").Handler(kithttp.NewServer(
+		endpoints.StartTimer,
+		decodeStartTimerHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeStartTimerHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}/time-entries").Handler(kithttp.NewServer(
+		endpoints.ListTimeEntries,
+		decodeListItemTimeEntriesHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListTimeEntriesHTTPResponse, errorEncoder),
+		options...,
+	))
+}
+
+// RegisterListHTTPHandlers mounts the list endpoints into a router.
+// Items of a list are available under /{listId}/todos.
+func RegisterListHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodPost).Path("").Handler(kithttp.NewServer(
+		endpoints.CreateList,
+		decodeCreateListHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeCreateListHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("").Handler(kithttp.NewServer(
+		endpoints.ListLists,
+		kithttp.NopRequestDecoder,
+		kitxhttp.ErrorResponseEncoder(encodeListListsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.GetList,
+		decodeGetListHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeGetListHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPatch).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.UpdateList,
+		decodeUpdateListHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUpdateListHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.DeleteList,
+		decodeDeleteListHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/shares").Handler(kithttp.NewServer(
+		endpoints.CreateShareToken,
+		decodeCreateShareTokenHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeCreateShareTokenHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}/shares").Handler(kithttp.NewServer(
+		endpoints.ListShareTokens,
+		decodeListShareTokensHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListShareTokensHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}/access-log").Handler(kithttp.NewServer(
+		endpoints.ListShareAccess,
+		decodeListShareAccessHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListShareAccessHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/collaborators").Handler(kithttp.NewServer(
+		endpoints.InviteCollaborator,
+		decodeInviteCollaboratorHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeInviteCollaboratorHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}/collaborators").Handler(kithttp.NewServer(
+		endpoints.ListCollaborators,
+		decodeListCollaboratorsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListCollaboratorsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}/collaborators/{email}").Handler(kithttp.NewServer(
+		endpoints.RemoveCollaborator,
+		decodeRemoveCollaboratorHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	RegisterHTTPHandlers(endpoints, router.PathPrefix("/{listId}/todos").Subrouter(), options...)
+}
+
+// RegisterTagHTTPHandlers mounts the tag endpoints into a router.
+func RegisterTagHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodPost).Path("").Handler(kithttp.NewServer(
+		endpoints.CreateTag,
+		decodeCreateTagHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeCreateTagHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("").Handler(kithttp.NewServer(
+		endpoints.ListTags,
+		kithttp.NopRequestDecoder,
+		kitxhttp.ErrorResponseEncoder(encodeListTagsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.GetTag,
+		decodeGetTagHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeGetTagHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPatch).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.UpdateTag,
+		decodeUpdateTagHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUpdateTagHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.DeleteTag,
+		decodeDeleteTagHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/merge").Handler(kithttp.NewServer(
+		endpoints.MergeTags,
+		decodeMergeTagsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeMergeTagsHTTPResponse, errorEncoder),
+		options...,
+	))
+}
+
+// RegisterCommentHTTPHandlers mounts the comment endpoints into a router.
+// Comments are added to (and listed for) an item under /todos/{id}/comments.
+func RegisterCommentHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodGet).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.GetComment,
+		decodeGetCommentHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeGetCommentHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPatch).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.UpdateComment,
+		decodeUpdateCommentHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUpdateCommentHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.DeleteComment,
+		decodeDeleteCommentHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+}
+
+// RegisterAttachmentHTTPHandlers mounts the attachment endpoints into a router.
+// Files are attached to (and listed for) an item under /todos/{id}/attachments.
+func RegisterAttachmentHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodGet).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.GetAttachment,
+		decodeGetAttachmentHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeGetAttachmentHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.DeleteAttachment,
+		decodeDeleteAttachmentHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}/download").Handler(kithttp.NewServer(
+		endpoints.DownloadAttachment,
+		decodeDownloadAttachmentHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeDownloadAttachmentHTTPResponse, errorEncoder),
+		options...,
+	))
+}
+
+// RegisterTimeHTTPHandlers mounts the time tracking endpoints into a router.
+// Timers are started on an item under /todos/{id}/timer.
+func RegisterTimeHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodGet).Path("/timer").Handler(kithttp.NewServer(
+		endpoints.GetTimer,
+		kithttp.NopRequestDecoder,
+		kitxhttp.ErrorResponseEncoder(encodeGetTimerHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/timer").Handler(kithttp.NewServer(
+		endpoints.StopTimer,
+		kithttp.NopRequestDecoder,
+		kitxhttp.ErrorResponseEncoder(encodeStopTimerHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/entries").Handler(kithttp.NewServer(
+		endpoints.ListTimeEntries,
+		decodeListTimeEntriesHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListTimeEntriesHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/totals").Handler(kithttp.NewServer(
+		endpoints.TimeTotals,
+		decodeTimeTotalsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeTimeTotalsHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/timesheet").Handler(kithttp.NewServer(
+		endpoints.ExportTimesheet,
+		decodeExportTimesheetHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeExportTimesheetHTTPResponse, errorEncoder),
+		options...,
+	))
+}
+
+// RegisterTemplateHTTPHandlers mounts the template endpoints into a router.
+func RegisterTemplateHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodPost).Path("").Handler(kithttp.NewServer(
+		endpoints.CreateTemplate,
+		decodeCreateTemplateHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeCreateTemplateHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("").Handler(kithttp.NewServer(
+		endpoints.ListTemplates,
+		kithttp.NopRequestDecoder,
+		kitxhttp.ErrorResponseEncoder(encodeListTemplatesHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.GetTemplate,
+		decodeGetTemplateHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeGetTemplateHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPatch).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.UpdateTemplate,
+		decodeUpdateTemplateHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUpdateTemplateHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.DeleteTemplate,
+		decodeDeleteTemplateHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/instantiate").Handler(kithttp.NewServer(
+		endpoints.InstantiateTemplate,
+		decodeInstantiateTemplateHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeInstantiateTemplateHTTPResponse, errorEncoder),
+		options...,
+	))
+}
+
+// RegisterRuleHTTPHandlers mounts the automation rule endpoints into a router.
+func RegisterRuleHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodPost).Path("").Handler(kithttp.NewServer(
+		endpoints.CreateRule,
+		decodeCreateRuleHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeCreateRuleHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("").Handler(kithttp.NewServer(
+		endpoints.ListRules,
+		kithttp.NopRequestDecoder,
+		kitxhttp.ErrorResponseEncoder(encodeListRulesHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.GetRule,
+		decodeGetRuleHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeGetRuleHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPatch).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.UpdateRule,
+		decodeUpdateRuleHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUpdateRuleHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodDelete).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.DeleteRule,
+		decodeDeleteRuleHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{id}/evaluate").Handler(kithttp.NewServer(
+		endpoints.EvaluateRule,
+		decodeEvaluateRuleHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeEvaluateRuleHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodGet).Path("/{id}/executions").Handler(kithttp.NewServer(
+		endpoints.ListRuleExecutions,
+		decodeListRuleExecutionsHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeListRuleExecutionsHTTPResponse, errorEncoder),
+		options...,
+	))
+}
+
+// RegisterShareHTTPHandlers mounts the share token endpoints into a router.
+// Lists are shared (and their tokens are listed) under /lists/{id}/shares.
+func RegisterShareHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter())
+
+	router.Methods(http.MethodDelete).Path("/{id}").Handler(kithttp.NewServer(
+		endpoints.RevokeShareToken,
+		decodeRevokeShareTokenHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(kitxhttp.StatusCodeResponseEncoder(http.StatusNoContent), errorEncoder),
+		options...,
+	))
+}
+
+// RegisterSharedHTTPHandlers mounts the endpoints of lists shared through tokens into a router.
+// These endpoints do not require authentication: the token in the path grants access.
+func RegisterSharedHTTPHandlers(endpoints Endpoints, router *mux.Router, options ...kithttp.ServerOption) {
+	errorEncoder := kitxhttp.NewJSONProblemErrorResponseEncoder(appkithttp.NewDefaultProblemConverter(
+		appkithttp.WithProblemMatchers(appkithttp.NewStatusProblemMatcher(http.StatusForbidden, isForbiddenError)),
+	))
+
+	// Keep the token in the path from leaking to other sites
+	options = append(options, kithttp.ServerAfter(kithttp.SetResponseHeader("Referrer-Policy", "no-referrer")))
+
+	router.Methods(http.MethodGet).Path("/{token}").Handler(kithttp.NewServer(
+		endpoints.GetSharedList,
+		decodeGetSharedListHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeGetSharedListHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPost).Path("/{token}/todos").Handler(kithttp.NewServer(
+		endpoints.AddSharedItem,
+		decodeAddSharedItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeAddSharedItemHTTPResponse, errorEncoder),
+		options...,
+	))
+
+	router.Methods(http.MethodPatch).Path("/{token}/todos/{id}").Handler(kithttp.NewServer(
+		endpoints.UpdateSharedItem,
+		decodeUpdateSharedItemHTTPRequest,
+		kitxhttp.ErrorResponseEncoder(encodeUpdateSharedItemHTTPResponse, errorEncoder),
+		options...,
+	))
+}
+
+// isForbiddenError checks if an error is related to a lack of permission.
+func isForbiddenError(err error) bool {
+	var e interface{ Forbidden() bool }
+
+	return errors.As(err, &e) && e.Forbidden()
+}
+
+// todoItemHTTP is the HTTP representation of a todo item.
+type todoItemHTTP struct {
+	ID           string          `json:"id"`
+	ListID       string          `json:"listId"`
+	ParentID     string          `json:"parentId,omitempty"`
+	Title        string          `json:"title"`
+	Notes        string          `json:"notes"`
+	Assignee     string          `json:"assignee,omitempty"`
+	Completed    bool            `json:"completed"`
+	Order        int32           `json:"order"`
+	URL          string          `json:"url"`
+	DueAt        *time.Time      `json:"dueAt,omitempty"`
+	Recurrence   *recurrenceHTTP `json:"recurrence,omitempty"`
+	AutoComplete bool            `json:"autoComplete"`
+	Tags         []itemTagHTTP   `json:"tags"`
+	Priority     string          `json:"priority"`
+	Rank         string          `json:"rank"`
+	DeletedAt    *time.Time      `json:"deletedAt,omitempty"`
+}
+
+// todoItemTreeHTTP is the HTTP representation of a todo item with its subtasks.
+type todoItemTreeHTTP struct {
+	todoItemHTTP
+
+	Children []todoItemTreeHTTP `json:"children"`
+}
+
+// recurrenceHTTP is the HTTP representation of a recurrence schedule.
+type recurrenceHTTP struct {
+	Rule       string     `json:"rule"`
+	Timezone   string     `json:"timezone,omitempty"`
+	Start      *time.Time `json:"start,omitempty"`
+	SeriesID   string     `json:"seriesId,omitempty"`
+	Occurrence *time.Time `json:"occurrence,omitempty"`
+}
+
+// historyEntryHTTP is the HTTP representation of a change of a todo item.
+type historyEntryHTTP struct {
+	ID            string            `json:"id"`
+	ItemID        string            `json:"itemId"`
+	Actor         string            `json:"actor,omitempty"`
+	Operation     string            `json:"operation"`
+	CorrelationID string            `json:"correlationId,omitempty"`
+	Changes       []fieldChangeHTTP `json:"changes"`
+	CreatedAt     time.Time         `json:"createdAt"`
+}
+
+// fieldChangeHTTP is the HTTP representation of the change of a single field.
+type fieldChangeHTTP struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// operationHTTP is the HTTP representation of an undone (or redone) operation.
+type operationHTTP struct {
+	ID        string    `json:"id"`
+	Operation string    `json:"operation"`
+	ItemIDs   []string  `json:"itemIds"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// commentHTTP is the HTTP representation of a comment of a todo item.
+type commentHTTP struct {
+	ID        string            `json:"id"`
+	ItemID    string            `json:"itemId"`
+	Author    string            `json:"author,omitempty"`
+	Body      string            `json:"body"`
+	Mentions  []string          `json:"mentions"`
+	Edits     []commentEditHTTP `json:"edits"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt *time.Time        `json:"updatedAt,omitempty"`
+}
+
+// commentEditHTTP is the HTTP representation of an earlier version of a comment.
+type commentEditHTTP struct {
+	Body     string    `json:"body"`
+	EditedAt time.Time `json:"editedAt"`
+}
+
+// attachmentHTTP is the HTTP representation of an attachment.
+type attachmentHTTP struct {
+	ID          string    `json:"id"`
+	ItemID      string    `json:"itemId"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"`
+	Uploader    string    `json:"uploader,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+
+	// URL is a signed download link of the attachment.
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// shareTokenHTTP is the HTTP representation of a share token.
+type shareTokenHTTP struct {
+	ID     string `json:"id"`
+	ListID string `json:"listId"`
+	Access string `json:"access"`
+
+	// Token and URL are only returned when the token is created.
+	Token string `json:"token,omitempty"`
+	URL   string `json:"url,omitempty"`
+
+	Active    bool       `json:"active"`
+	CreatedBy string     `json:"createdBy,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// shareAccessHTTP is the HTTP representation of an access to a list through a share token.
+type shareAccessHTTP struct {
+	ID            string    `json:"id"`
+	ShareID       string    `json:"shareId"`
+	ListID        string    `json:"listId"`
+	Operation     string    `json:"operation"`
+	ItemID        string    `json:"itemId,omitempty"`
+	CorrelationID string    `json:"correlationId,omitempty"`
+	Denied        bool      `json:"denied"`
+	AccessedAt    time.Time `json:"accessedAt"`
+}
+
+// collaboratorHTTP is the HTTP representation of a collaborator of a list.
+type collaboratorHTTP struct {
+	ID        string    `json:"id"`
+	ListID    string    `json:"listId"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	InvitedBy string    `json:"invitedBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// timeEntryHTTP is the HTTP representation of a time entry.
+type timeEntryHTTP struct {
+	ID        string     `json:"id"`
+	ItemID    string     `json:"itemId"`
+	User      string     `json:"user"`
+	StartedAt time.Time  `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+	Running   bool       `json:"running"`
+
+	// Duration is the tracked time in seconds.
+	Duration int64 `json:"duration"`
+}
+
+// timeTotalsHTTP is the HTTP representation of the time tracked per item and per day (in seconds).
+type timeTotalsHTTP struct {
+	Items []itemTimeHTTP `json:"items"`
+	Days  []dayTimeHTTP  `json:"days"`
+	Total int64          `json:"total"`
+}
+
+// itemTimeHTTP is the HTTP representation of the time tracked on an item.
+type itemTimeHTTP struct {
+	ItemID   string `json:"itemId"`
+	Title    string `json:"title"`
+	Duration int64  `json:"duration"`
+}
+
+// dayTimeHTTP is the HTTP representation of the time tracked on a day.
+type dayTimeHTTP struct {
+	Day      string `json:"day"`
+	Duration int64  `json:"duration"`
+}
+
+// templateHTTP is the HTTP representation of a template.
+type templateHTTP struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Items       []templateItemHTTP `json:"items"`
+}
+
+// templateItemHTTP is the HTTP representation of an item blueprint of a template.
+type templateItemHTTP struct {
+	Title    string `json:"title"`
+	Notes    string `json:"notes"`
+	Priority string `json:"priority"`
+	Order    int    `json:"order"`
+
+	// DueOffset is the due date relative to the start of the instance in seconds.
+	DueOffset *int64   `json:"dueOffset,omitempty"`
+	Tags      []string `json:"tags"`
+}
+
+type createTemplateHTTPRequest struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Items       []templateItemHTTP `json:"items"`
+}
+
+type updateTemplateHTTPRequest struct {
+	Name        *string             `json:"name"`
+	Description *string             `json:"description"`
+	Items       *[]templateItemHTTP `json:"items"`
+}
+
+type instantiateTemplateHTTPRequest struct {
+	ListID   string     `json:"listId"`
+	ListName string     `json:"listName"`
+	Start    *time.Time `json:"start"`
+}
+
+// templateInstanceHTTP is the HTTP representation of the list and the items created from a template.
+type templateInstanceHTTP struct {
+	List  todoListHTTP   `json:"list"`
+	Items []todoItemHTTP `json:"items"`
+}
+
+// ruleHTTP is the HTTP representation of an automation rule.
+type ruleHTTP struct {
+	ID         string              `json:"id"`
+	Name       string              `json:"name"`
+	Trigger    string              `json:"trigger"`
+	Conditions []ruleConditionHTTP `json:"conditions"`
+	Actions    []ruleActionHTTP    `json:"actions"`
+	Enabled    bool                `json:"enabled"`
+	DryRun     bool                `json:"dryRun"`
+	Owner      string              `json:"owner,omitempty"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	UpdatedAt  time.Time           `json:"updatedAt"`
+}
+
+// ruleConditionHTTP is the HTTP representation of a condition of a rule.
+type ruleConditionHTTP struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// ruleActionHTTP is the HTTP representation of an action of a rule.
+type ruleActionHTTP struct {
+	Type   string `json:"type"`
+	Value  string `json:"value,omitempty"`
+	ListID string `json:"listId,omitempty"`
+}
+
+type createRuleHTTPRequest struct {
+	Name       string              `json:"name"`
+	Trigger    string              `json:"trigger"`
+	Conditions []ruleConditionHTTP `json:"conditions"`
+	Actions    []ruleActionHTTP    `json:"actions"`
+	Enabled    *bool               `json:"enabled"`
+	DryRun     bool                `json:"dryRun"`
+}
+
+type updateRuleHTTPRequest struct {
+	Name       *string              `json:"name"`
+	Trigger    *string              `json:"trigger"`
+	Conditions *[]ruleConditionHTTP `json:"conditions"`
+	Actions    *[]ruleActionHTTP    `json:"actions"`
+	Enabled    *bool                `json:"enabled"`
+	DryRun     *bool                `json:"dryRun"`
+}
+
+type evaluateRuleHTTPRequest struct {
+	ItemID string `json:"itemId"`
+}
+
+// ruleEvaluationHTTP is the HTTP representation of a rule evaluation.
+type ruleEvaluationHTTP struct {
+	Matched    bool                      `json:"matched"`
+	Conditions []ruleConditionResultHTTP `json:"conditions"`
+	Actions    []ruleActionHTTP          `json:"actions"`
+}
+
+// ruleConditionResultHTTP is the HTTP representation of an evaluated condition.
+type ruleConditionResultHTTP struct {
+	ruleConditionHTTP
+
+	Matched bool     `json:"matched"`
+	Values  []string `json:"values"`
+}
+
+// ruleExecutionHTTP is the HTTP representation of a rule execution log entry.
+type ruleExecutionHTTP struct {
+	ID            string    `json:"id"`
+	RuleID        string    `json:"ruleId"`
+	Trigger       string    `json:"trigger"`
+	ItemID        string    `json:"itemId"`
+	CorrelationID string    `json:"correlationId"`
+	Status        string    `json:"status"`
+	Error         string    `json:"error,omitempty"`
+	ExecutedAt    time.Time `json:"executedAt"`
+}
+
+// sharedListHTTP is the HTTP representation of a list viewed through a share token.
+type sharedListHTTP struct {
+	List      todoListHTTP   `json:"list"`
+	Items     []todoItemHTTP `json:"items"`
+	Access    string         `json:"access"`
+	ExpiresAt *time.Time     `json:"expiresAt,omitempty"`
+}
+
+// todoListHTTP is the HTTP representation of a todo list.
+type todoListHTTP struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Archived    bool   `json:"archived"`
+}
+
+// itemTagHTTP is the HTTP representation of a tag of an item.
+type itemTagHTTP struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+// tagHTTP is the HTTP representation of a tag.
+type tagHTTP struct {
+	itemTagHTTP
+
+	ItemCount int `json:"itemCount"`
+}
+
+type createTagHTTPRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type updateTagHTTPRequest struct {
+	Name  *string `json:"name"`
+	Color *string `json:"color"`
+}
+
+type mergeTagsHTTPRequest struct {
+	TargetID string `json:"targetId"`
+}
+
+type tagTodoItemHTTPRequest struct {
+	Tags []string `json:"tags"`
+}
+
+type assignTodoItemHTTPRequest struct {
+	Assignee string `json:"assignee"`
+}
+
+type moveTodoItemHTTPRequest struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type addCommentHTTPRequest struct {
+	Body string `json:"body"`
+}
+
+type updateCommentHTTPRequest struct {
+	Body string `json:"body"`
+}
+
+type createShareTokenHTTPRequest struct {
+	Access    string     `json:"access"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+type inviteCollaboratorHTTPRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+type createTodoListHTTPRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type updateTodoListHTTPRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	Archived    *bool   `json:"archived"`
+}
+
+type addTodoItemHTTPRequest struct {
+	Title        string          `json:"title"`
+	Notes        string          `json:"notes"`
+	Assignee     string          `json:"assignee"`
+	Order        int32           `json:"order"`
+	DueAt        *time.Time      `json:"dueAt"`
+	Recurrence   *recurrenceHTTP `json:"recurrence"`
+	ParentID     string          `json:"parentId"`
+	AutoComplete bool            `json:"autoComplete"`
+	Priority     string          `json:"priority"`
+}
+
+type updateTodoItemHTTPRequest struct {
+	Title          *string          `json:"title"`
+	Notes          *string          `json:"notes"`
+	Completed      *bool            `json:"completed"`
+	Order          *int32           `json:"order"`
+	DueAt          nullableTimeHTTP `json:"dueAt"`
+	Recurrence     *recurrenceHTTP  `json:"recurrence"`
+	ListID         *string          `json:"listId"`
+	ParentID       *string          `json:"parentId"`
+	AutoComplete   *bool            `json:"autoComplete"`
+	Priority       *string          `json:"priority"`
+	EndRecurrence  bool             `json:"endRecurrence"`
+	SkipOccurrence bool             `json:"skipOccurrence"`
+}
+
+type bulkAddItemsHTTPRequest struct {
+	Mode  todo.BulkMode     `json:"mode"`
+	Items []bulkNewItemHTTP `json:"items"`
+}
+
+type bulkNewItemHTTP struct {
+	addTodoItemHTTPRequest
+
+	ListID string `json:"listId"`
+}
+
+type bulkUpdateItemsHTTPRequest struct {
+	Mode  todo.BulkMode        `json:"mode"`
+	Items []bulkItemUpdateHTTP `json:"items"`
+}
+
+type bulkItemUpdateHTTP struct {
+	updateTodoItemHTTPRequest
+
+	ID     string   `json:"id"`
+	Tags   []string `json:"tags"`
+	Untags []string `json:"untags"`
+}
+
+type bulkDeleteItemsHTTPRequest struct {
+	Mode    todo.BulkMode `json:"mode"`
+	IDs     []string      `json:"ids"`
+	Cascade bool          `json:"cascade"`
+}
+
+// bulkResultsHTTP is the HTTP representation of the outcome of a bulk operation.
+type bulkResultsHTTP struct {
+	Results []bulkResultHTTP `json:"results"`
+}
+
+type bulkResultHTTP struct {
+	ID     string          `json:"id,omitempty"`
+	Status todo.BulkStatus `json:"status"`
+	Item   *todoItemHTTP   `json:"item,omitempty"`
+	Error  interface{}     `json:"error,omitempty"`
+}
+
+// nullableTimeHTTP tells an explicit null value apart from a missing one.
+type nullableTimeHTTP struct {
+	Set   bool
+	Value *time.Time
+}
+
+func (t *nullableTimeHTTP) UnmarshalJSON(data []byte) error {
+	t.Set = true
+
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	return json.Unmarshal(data, &t.Value)
+}
+
+func decodeAddItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest addTodoItemHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	newItem, err := unmarshalNewItemHTTP(apiRequest, mux.Vars(r)["listId"])
+	if err != nil {
+		return nil, err
+	}
+
+	return AddItemRequest{
+		NewItem: newItem,
+	}, nil
+}
+
+func unmarshalNewItemHTTP(apiRequest addTodoItemHTTPRequest, listID string) (todo.NewItem, error) {
+	priority, err := todo.ParsePriority(apiRequest.Priority)
+	if err != nil {
+		return todo.NewItem{}, err
+	}
+
+	return todo.NewItem{
+		ListID:       listID,
+		ParentID:     apiRequest.ParentID,
+		Title:        apiRequest.Title,
+		Notes:        apiRequest.Notes,
+		Assignee:     apiRequest.Assignee,
+		Order:        int(apiRequest.Order),
+		DueAt:        apiRequest.DueAt,
+		Priority:     priority,
+		Recurrence:   unmarshalRecurrenceHTTP(apiRequest.Recurrence),
+		AutoComplete: apiRequest.AutoComplete,
+	}, nil
+}
+
+func encodeAddItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(AddItemResponse)
+
+	apiResponse := marshalItemHTTP(ctx, resp.Item)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func decodeListItemsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	filter, err := decodeItemFilterHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListItemsRequest{
+		Filter: filter,
+	}, nil
+}
+
+func decodeItemFilterHTTP(r *http.Request) (todo.ItemFilter, error) {
+	query := r.URL.Query()
+
+	filter := todo.ItemFilter{
+		ListID: mux.Vars(r)["listId"],
+	}
+
+	for param, value := range map[string]**time.Time{
+		"dueAfter":  &filter.DueAfter,
+		"dueBefore": &filter.DueBefore,
+	} {
+		if v := query.Get(param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return filter, errors.WrapIfWithDetails(err, "invalid query parameter", "param", param)
+			}
+
+			*value = &t
+		}
+	}
+
+	if v := query.Get("overdue"); v != "" {
+		overdue, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "overdue")
+		}
+
+		filter.Overdue = overdue
+	}
+
+	if v := query.Get("matchAllTags"); v != "" {
+		matchAllTags, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "matchAllTags")
+		}
+
+		filter.MatchAllTags = matchAllTags
+	}
+
+	if v := query.Get("mine"); v != "" {
+		mine, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "mine")
+		}
+
+		filter.Mine = mine
+	}
+
+	filter.SeriesID = query.Get("seriesId")
+	filter.ParentID = query.Get("parentId")
+	filter.Tags = query["tag"]
+	filter.Assignee = query.Get("assignee")
+	filter.Sort = todo.ItemSort(query.Get("sort"))
+
+	return filter, nil
+}
+
+func encodeListItemsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListItemsResponse)
+
+	items := make([]todoItemHTTP, 0, len(resp.Items))
+
+	for _, item := range resp.Items {
+		items = append(items, marshalItemHTTP(ctx, item))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, items)
+}
+
+func decodeListItemTreeHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	filter, err := decodeItemFilterHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var depth int
+
+	if v := r.URL.Query().Get("depth"); v != "" {
+		depth, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "depth")
+		}
+	}
+
+	return ListItemTreeRequest{
+		Filter: filter,
+		Depth:  depth,
+	}, nil
+}
+
+func encodeListItemTreeHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListItemTreeResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalItemTreesHTTP(ctx, resp.Trees))
+}
+
+func decodeDeleteItemsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return DeleteItemsRequest{
+		ListID: mux.Vars(r)["listId"],
+	}, nil
+}
+
+func decodeGetItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetItemRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeGetItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(GetItemResponse)
+
+	apiResponse := marshalItemHTTP(ctx, resp.Item)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, apiResponse)
+}
+
+func decodeUpdateItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest updateTodoItemHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	itemUpdate, err := unmarshalItemUpdateHTTP(apiRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return UpdateItemRequest{
+		Id:         id,
+		ItemUpdate: itemUpdate,
+	}, nil
+}
+
+func unmarshalItemUpdateHTTP(apiRequest updateTodoItemHTTPRequest) (todo.ItemUpdate, error) {
+	var order *int
+
+	if apiRequest.Order != nil {
+		o := int(*apiRequest.Order)
+		order = &o
+	}
+
+	var priority *todo.Priority
+
+	if apiRequest.Priority != nil {
+		p, err := todo.ParsePriority(*apiRequest.Priority)
+		if err != nil {
+			return todo.ItemUpdate{}, err
+		}
+
+		priority = &p
+	}
+
+	return todo.ItemUpdate{
+		Title:          apiRequest.Title,
+		Notes:          apiRequest.Notes,
+		Completed:      apiRequest.Completed,
+		Order:          order,
+		DueAt:          apiRequest.DueAt.Value,
+		ClearDueAt:     apiRequest.DueAt.Set && apiRequest.DueAt.Value == nil,
+		Priority:       priority,
+		Recurrence:     unmarshalRecurrenceHTTP(apiRequest.Recurrence),
+		EndRecurrence:  apiRequest.EndRecurrence,
+		SkipOccurrence: apiRequest.SkipOccurrence,
+		ListID:         apiRequest.ListID,
+		ParentID:       apiRequest.ParentID,
+		AutoComplete:   apiRequest.AutoComplete,
+	}, nil
+}
+
+func encodeUpdateItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UpdateItemResponse)
+
+	apiResponse := marshalItemHTTP(ctx, resp.Item)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, apiResponse)
+}
+
+func decodeDeleteItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var cascade bool
+
+	if v := r.URL.Query().Get("cascade"); v != "" {
+		cascade, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "cascade")
+		}
+	}
+
+	return DeleteItemRequest{
+		Id:      id,
+		Cascade: cascade,
+	}, nil
+}
+
+func decodeListTrashHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return ListTrashRequest{
+		ListID: mux.Vars(r)["listId"],
+	}, nil
+}
+
+func encodeListTrashHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListTrashResponse)
+
+	items := make([]todoItemHTTP, 0, len(resp.Items))
+
+	for _, item := range resp.Items {
+		items = append(items, marshalItemHTTP(ctx, item))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, items)
+}
+
+func decodeEmptyTrashHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return EmptyTrashRequest{
+		ListID: mux.Vars(r)["listId"],
+	}, nil
+}
+
+func decodeRestoreItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return RestoreItemRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeRestoreItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(RestoreItemResponse)
+
+	apiResponse := marshalItemHTTP(ctx, resp.Item)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, apiResponse)
+}
+
+func decodePurgeItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return PurgeItemRequest{
+		Id: id,
+	}, nil
+}
+
+func decodeCreateListHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest createTodoListHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return CreateListRequest{
+		NewList: todo.NewList{
+			Name:        apiRequest.Name,
+			Description: apiRequest.Description,
+		},
+	}, nil
+}
+
+func encodeCreateListHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(CreateListResponse)
+
+	apiResponse := marshalListHTTP(resp.List)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func encodeListListsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListListsResponse)
+
+	lists := make([]todoListHTTP, 0, len(resp.Lists))
+
+	for _, list := range resp.Lists {
+		lists = append(lists, marshalListHTTP(list))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, lists)
+}
+
+func decodeGetListHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetListRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeGetListHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(GetListResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalListHTTP(resp.List))
+}
+
+func decodeUpdateListHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest updateTodoListHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return UpdateListRequest{
+		Id: id,
+		ListUpdate: todo.ListUpdate{
+			Name:        apiRequest.Name,
+			Description: apiRequest.Description,
+			Archived:    apiRequest.Archived,
+		},
+	}, nil
+}
+
+func encodeUpdateListHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UpdateListResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalListHTTP(resp.List))
+}
+
+func decodeDeleteListHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteListRequest{
+		Id: id,
+	}, nil
+}
+
+func decodeTagItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest tagTodoItemHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return TagItemRequest{
+		Id:   id,
+		Tags: apiRequest.Tags,
+	}, nil
+}
+
+func encodeTagItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(TagItemResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalItemHTTP(ctx, resp.Item))
+}
+
+func decodeUntagItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return UntagItemRequest{
+		Id:   id,
+		Tags: r.URL.Query()["tag"],
+	}, nil
+}
+
+func encodeUntagItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UntagItemResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalItemHTTP(ctx, resp.Item))
+}
+
+func decodeAssignItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest assignTodoItemHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return AssignItemRequest{
+		Id:       id,
+		Assignee: apiRequest.Assignee,
+	}, nil
+}
+
+func encodeAssignItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(AssignItemResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalItemHTTP(ctx, resp.Item))
+}
+
+func decodeUnassignItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return UnassignItemRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeUnassignItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UnassignItemResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalItemHTTP(ctx, resp.Item))
+}
+
+func decodeMoveItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest moveTodoItemHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return MoveItemRequest{
+		Id: id,
+		Move: todo.ItemMove{
+			Before: apiRequest.Before,
+			After:  apiRequest.After,
+		},
+	}, nil
+}
+
+func encodeMoveItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(MoveItemResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalItemHTTP(ctx, resp.Item))
+}
+
+func decodeItemHistoryHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ItemHistoryRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeItemHistoryHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ItemHistoryResponse)
+
+	entries := make([]historyEntryHTTP, 0, len(resp.Entries))
+
+	for _, entry := range resp.Entries {
+		changes := make([]fieldChangeHTTP, 0, len(entry.Changes))
+
+		for _, change := range entry.Changes {
+			changes = append(changes, fieldChangeHTTP(change))
+		}
+
+		entries = append(entries, historyEntryHTTP{
+			ID:            entry.ID,
+			ItemID:        entry.ItemID,
+			Actor:         entry.Actor,
+			Operation:     entry.Operation,
+			CorrelationID: entry.CorrelationID,
+			Changes:       changes,
+			CreatedAt:     entry.CreatedAt,
+		})
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, entries)
+}
+
+func decodeAddCommentHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest addCommentHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return AddCommentRequest{
+		ItemID: id,
+		NewComment: todo.NewComment{
+			Body: apiRequest.Body,
+		},
+	}, nil
+}
+
+func encodeAddCommentHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(AddCommentResponse)
+
+	apiResponse := marshalCommentHTTP(resp.Comment)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func decodeListCommentsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListCommentsRequest{
+		ItemID: id,
+	}, nil
+}
+
+func encodeListCommentsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListCommentsResponse)
+
+	comments := make([]commentHTTP, 0, len(resp.Comments))
+
+	for _, comment := range resp.Comments {
+		comments = append(comments, marshalCommentHTTP(comment))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, comments)
+}
+
+func decodeGetCommentHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetCommentRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeGetCommentHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(GetCommentResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalCommentHTTP(resp.Comment))
+}
+
+func decodeUpdateCommentHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest updateCommentHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return UpdateCommentRequest{
+		Id:   id,
+		Body: apiRequest.Body,
+	}, nil
+}
+
+func encodeUpdateCommentHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UpdateCommentResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalCommentHTTP(resp.Comment))
+}
+
+func decodeDeleteCommentHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteCommentRequest{
+		Id: id,
+	}, nil
+}
+
+func marshalCommentHTTP(comment todo.Comment) commentHTTP {
+	mentions := append(make([]string, 0, len(comment.Mentions)), comment.Mentions...)
+
+	edits := make([]commentEditHTTP, 0, len(comment.Edits))
+
+	for _, edit := range comment.Edits {
+		edits = append(edits, commentEditHTTP(edit))
+	}
+
+	return commentHTTP{
+		ID:        comment.ID,
+		ItemID:    comment.ItemID,
+		Author:    comment.Author,
+		Body:      comment.Body,
+		Mentions:  mentions,
+		Edits:     edits,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
+
+func decodeAddAttachmentHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		_, params, _ := mime.ParseMediaType(r.Header.Get("Content-Disposition"))
+
+		name = params["filename"]
+	}
+
+	checksum := r.URL.Query().Get("checksum")
+	if checksum == "" {
+		checksum, err = checksumFromDigest(r.Header.Get("Digest"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var size int64
+
+	if r.ContentLength > 0 {
+		size = r.ContentLength
+	}
+
+	return AddAttachmentRequest{
+		ItemID: id,
+		NewAttachment: todo.NewAttachment{
+			Name:        name,
+			ContentType: r.Header.Get("Content-Type"),
+			Size:        size,
+			Checksum:    checksum,
+			Content:     r.Body,
+		},
+	}, nil
+}
+
+// checksumFromDigest returns the hex encoded SHA-256 checksum of a Digest header (RFC 3230) if there is one.
+func checksumFromDigest(header string) (string, error) {
+	for _, digest := range strings.Split(header, ",") {
+		algorithm, value, ok := cutString(strings.TrimSpace(digest), "=")
+		if !ok || !strings.EqualFold(algorithm, "sha-256") {
+			continue
+		}
+
+		sum, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return "", errors.WrapIf(err, "decode digest header")
+		}
+
+		return hex.EncodeToString(sum), nil
+	}
+
+	return "", nil
+}
+
+// cutString slices s around the first instance of sep.
+func cutString(s string, sep string) (string, string, bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+
+	return s, "", false
+}
+
+func encodeAddAttachmentHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(AddAttachmentResponse)
+
+	apiResponse := marshalAttachmentHTTP(resp.Attachment)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func decodeListAttachmentsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListAttachmentsRequest{
+		ItemID: id,
+	}, nil
+}
+
+func encodeListAttachmentsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListAttachmentsResponse)
+
+	attachments := make([]attachmentHTTP, 0, len(resp.Attachments))
+
+	for _, attachment := range resp.Attachments {
+		attachments = append(attachments, marshalAttachmentHTTP(attachment))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, attachments)
+}
+
+func decodeGetAttachmentHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetAttachmentRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeGetAttachmentHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(GetAttachmentResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalAttachmentHTTP(resp.Attachment))
+}
+
+func decodeDeleteAttachmentHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteAttachmentRequest{
+		Id: id,
+	}, nil
+}
+
+func decodeDownloadAttachmentHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.URL.Query()
+
+	var link todo.AttachmentLink
+
+	// Malformed links are rejected by the service like any other invalid link
+	if expires, err := strconv.ParseInt(query.Get("expires"), 10, 64); err == nil {
+		link.ExpiresAt = time.Unix(expires, 0)
+	}
+
+	link.Signature = query.Get("signature")
+
+	return DownloadAttachmentRequest{
+		Id:   id,
+		Link: link,
+	}, nil
+}
+
+// encodeDownloadAttachmentHTTPResponse streams the content of an attachment as a file download.
+func encodeDownloadAttachmentHTTPResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	download := response.(DownloadAttachmentResponse).Download
+	defer download.Content.Close()
+
+	attachment := download.Attachment
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	w.Header().Set("ETag", strconv.Quote(attachment.Checksum))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	_, err := io.Copy(w, download.Content)
+
+	return err
+}
+
+// attachmentDownloadURL returns the signed download link of an attachment.
+func attachmentDownloadURL(attachment todo.Attachment) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(attachment.Link.ExpiresAt.Unix(), 10))
+	query.Set("signature", attachment.Link.Signature)
+
+	return "/attachments/" + url.PathEscape(attachment.ID) + "/download?" + query.Encode()
+}
+
+func marshalAttachmentHTTP(attachment todo.Attachment) attachmentHTTP {
+	return attachmentHTTP{
+		ID:          attachment.ID,
+		ItemID:      attachment.ItemID,
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		Uploader:    attachment.Uploader,
+		CreatedAt:   attachment.CreatedAt,
+		URL:         attachmentDownloadURL(attachment),
+		ExpiresAt:   attachment.Link.ExpiresAt,
+	}
+}
+
+func decodeCreateShareTokenHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest createShareTokenHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return CreateShareTokenRequest{
+		ListID: id,
+		NewShareToken: todo.NewShareToken{
+			Access:    todo.ShareAccess(apiRequest.Access),
+			ExpiresAt: apiRequest.ExpiresAt,
+		},
+	}, nil
+}
+
+func encodeCreateShareTokenHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(CreateShareTokenResponse)
+
+	apiResponse := marshalShareTokenHTTP(resp.Token)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func decodeListShareTokensHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListShareTokensRequest{
+		ListID: id,
+	}, nil
+}
+
+func encodeListShareTokensHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListShareTokensResponse)
+
+	tokens := make([]shareTokenHTTP, 0, len(resp.Tokens))
+
+	for _, token := range resp.Tokens {
+		tokens = append(tokens, marshalShareTokenHTTP(token))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, tokens)
+}
+
+func decodeRevokeShareTokenHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return RevokeShareTokenRequest{
+		Id: id,
+	}, nil
+}
+
+func decodeListShareAccessHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListShareAccessRequest{
+		ListID: id,
+	}, nil
+}
+
+func encodeListShareAccessHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListShareAccessResponse)
+
+	entries := make([]shareAccessHTTP, 0, len(resp.Entries))
+
+	for _, entry := range resp.Entries {
+		entries = append(entries, shareAccessHTTP{
+			ID:            entry.ID,
+			ShareID:       entry.ShareID,
+			ListID:        entry.ListID,
+			Operation:     entry.Operation,
+			ItemID:        entry.ItemID,
+			CorrelationID: entry.CorrelationID,
+			Denied:        entry.Denied,
+			AccessedAt:    entry.AccessedAt,
+		})
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, entries)
+}
+
+func decodeInviteCollaboratorHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest inviteCollaboratorHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return InviteCollaboratorRequest{
+		ListID: id,
+		Invitation: todo.Invitation{
+			Email: apiRequest.Email,
+			Role:  todo.CollaboratorRole(apiRequest.Role),
+		},
+	}, nil
+}
+
+func encodeInviteCollaboratorHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(InviteCollaboratorResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalCollaboratorHTTP(resp.Collaborator))
+}
+
+func decodeListCollaboratorsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListCollaboratorsRequest{
+		ListID: id,
+	}, nil
+}
+
+func encodeListCollaboratorsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListCollaboratorsResponse)
+
+	collaborators := make([]collaboratorHTTP, 0, len(resp.Collaborators))
+
+	for _, collaborator := range resp.Collaborators {
+		collaborators = append(collaborators, marshalCollaboratorHTTP(collaborator))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, collaborators)
+}
+
+func decodeRemoveCollaboratorHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return RemoveCollaboratorRequest{
+		ListID: id,
+		Email:  mux.Vars(r)["email"],
+	}, nil
+}
+
+func decodeGetSharedListHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return GetSharedListRequest{
+		Token: mux.Vars(r)["token"],
+	}, nil
+}
+
+func encodeGetSharedListHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	shared := response.(GetSharedListResponse).Shared
+
+	items := make([]todoItemHTTP, 0, len(shared.Items))
+
+	for _, item := range shared.Items {
+		items = append(items, marshalItemHTTP(ctx, item))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, sharedListHTTP{
+		List:      marshalListHTTP(shared.List),
+		Items:     items,
+		Access:    string(shared.Access),
+		ExpiresAt: shared.ExpiresAt,
+	})
+}
+
+func decodeAddSharedItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest addTodoItemHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	// The list is set by the service
+	newItem, err := unmarshalNewItemHTTP(apiRequest, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return AddSharedItemRequest{
+		Token:   mux.Vars(r)["token"],
+		NewItem: newItem,
+	}, nil
+}
+
+func encodeAddSharedItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(AddSharedItemResponse)
+
+	apiResponse := marshalItemHTTP(ctx, resp.Item)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func decodeUpdateSharedItemHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest updateTodoItemHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	itemUpdate, err := unmarshalItemUpdateHTTP(apiRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return UpdateSharedItemRequest{
+		Token:      mux.Vars(r)["token"],
+		Id:         id,
+		ItemUpdate: itemUpdate,
+	}, nil
+}
+
+func encodeUpdateSharedItemHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UpdateSharedItemResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalItemHTTP(ctx, resp.Item))
+}
+
+// sharedListURL returns the share link of a list (if the secret token is known).
+func sharedListURL(token todo.ShareToken) string {
+	if token.Token == "" {
+		return ""
+	}
+
+	return "/shared/" + url.PathEscape(token.Token)
+}
+
+func marshalShareTokenHTTP(token todo.ShareToken) shareTokenHTTP {
+	return shareTokenHTTP{
+		ID:        token.ID,
+		ListID:    token.ListID,
+		Access:    string(token.Access),
+		Token:     token.Token,
+		URL:       sharedListURL(token),
+		Active:    token.Active(time.Now()),
+		CreatedBy: token.CreatedBy,
+		CreatedAt: token.CreatedAt,
+		ExpiresAt: token.ExpiresAt,
+		RevokedAt: token.RevokedAt,
+	}
+}
+
+func marshalCollaboratorHTTP(collaborator todo.Collaborator) collaboratorHTTP {
+	return collaboratorHTTP{
+		ID:        collaborator.ID,
+		ListID:    collaborator.ListID,
+		Email:     collaborator.Email,
+		Role:      string(collaborator.Role),
+		InvitedBy: collaborator.InvitedBy,
+		CreatedAt: collaborator.CreatedAt,
+	}
+}
+
+func decodeBulkAddItemsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest bulkAddItemsHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	newItems := make([]todo.NewItem, 0, len(apiRequest.Items))
+
+	for _, item := range apiRequest.Items {
+		newItem, err := unmarshalNewItemHTTP(item.addTodoItemHTTPRequest, item.ListID)
+		if err != nil {
+			return nil, err
+		}
+
+		newItems = append(newItems, newItem)
+	}
+
+	return BulkAddItemsRequest{
+		NewItems: newItems,
+		Mode:     apiRequest.Mode,
+	}, nil
+}
+
+func encodeBulkAddItemsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(BulkAddItemsResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalBulkResultsHTTP(ctx, resp.Results))
+}
+
+func decodeBulkUpdateItemsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest bulkUpdateItemsHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	updates := make([]todo.BulkItemUpdate, 0, len(apiRequest.Items))
+
+	for _, item := range apiRequest.Items {
+		itemUpdate, err := unmarshalItemUpdateHTTP(item.updateTodoItemHTTPRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, todo.BulkItemUpdate{
+			ID:     item.ID,
+			Update: itemUpdate,
+			Tags:   item.Tags,
+			Untags: item.Untags,
+		})
+	}
+
+	return BulkUpdateItemsRequest{
+		Updates: updates,
+		Mode:    apiRequest.Mode,
+	}, nil
+}
+
+func encodeBulkUpdateItemsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(BulkUpdateItemsResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalBulkResultsHTTP(ctx, resp.Results))
+}
+
+func decodeBulkDeleteItemsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest bulkDeleteItemsHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return BulkDeleteItemsRequest{
+		Ids:     apiRequest.IDs,
+		Cascade: apiRequest.Cascade,
+		Mode:    apiRequest.Mode,
+	}, nil
+}
+
+func encodeBulkDeleteItemsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(BulkDeleteItemsResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalBulkResultsHTTP(ctx, resp.Results))
+}
+
+// marshalBulkResultsHTTP converts the outcome of a bulk operation.
+// Failures are converted to problems the same way as errors of single item operations.
+func marshalBulkResultsHTTP(ctx context.Context, results []todo.BulkResult) bulkResultsHTTP {
+	problemConverter := appkithttp.NewDefaultProblemConverter()
+
+	apiResults := make([]bulkResultHTTP, 0, len(results))
+
+	for _, result := range results {
+		apiResult := bulkResultHTTP{
+			ID:     result.ID,
+			Status: result.Status,
+		}
+
+		if result.Status == todo.BulkSucceeded && result.Item.ID != "" {
+			item := marshalItemHTTP(ctx, result.Item)
+			apiResult.Item = &item
+		}
+
+		if result.Err != nil {
+			apiResult.Error = problemConverter.NewProblem(ctx, result.Err)
+		}
+
+		apiResults = append(apiResults, apiResult)
+	}
+
+	return bulkResultsHTTP{Results: apiResults}
+}
+
+func decodeExportItemsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return ExportItemsRequest{
+		ListID: mux.Vars(r)["listId"],
+		Format: todo.Format(r.URL.Query().Get("format")),
+	}, nil
+}
+
+// encodeExportItemsHTTPResponse streams the export as a file download.
+func encodeExportItemsHTTPResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	export := response.(ExportItemsResponse).Export
+
+	w.Header().Set("Content-Type", export.Format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="todos%s"`, export.Format.Extension()))
+
+	_, err := export.WriteTo(w)
+
+	return err
+}
+
+// importReportHTTP is the HTTP representation of the outcome of an import.
+type importReportHTTP struct {
+	Added   int  `json:"added"`
+	Skipped int  `json:"skipped"`
+	Deleted int  `json:"deleted"`
+	DryRun  bool `json:"dryRun"`
+}
+
+func decodeImportItemsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	query := r.URL.Query()
+
+	format := todo.Format(query.Get("format"))
+	if format == "" {
+		format = formatFromContentType(r.Header.Get("Content-Type"))
+	}
+
+	var dryRun bool
+
+	if v := query.Get("dryRun"); v != "" {
+		var err error
+
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "dryRun")
+		}
+	}
+
+	return ImportItemsRequest{
+		Data: r.Body,
+		Options: todo.ImportOptions{
+			ListID:   mux.Vars(r)["listId"],
+			Format:   format,
+			Strategy: todo.ImportStrategy(query.Get("strategy")),
+			DryRun:   dryRun,
+		},
+	}, nil
+}
+
+// formatFromContentType tells the format of an import by its media type (when it's not set explicitly).
+func formatFromContentType(contentType string) todo.Format {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch mediaType {
+	case "application/json":
+		return todo.FormatJSON
+	case "text/csv":
+		return todo.FormatCSV
+	case "text/calendar":
+		return todo.FormatICalendar
+	case "text/plain":
+		return todo.FormatTodoTxt
+	default:
+		return ""
+	}
+}
+
+func encodeImportItemsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	report := response.(ImportItemsResponse).Report
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, importReportHTTP{
+		Added:   report.Added,
+		Skipped: report.Skipped,
+		Deleted: report.Deleted,
+		DryRun:  report.DryRun,
+	})
+}
+
+type searchResultsHTTP struct {
+	Results []searchResultHTTP `json:"results"`
+}
+
+type searchResultHTTP struct {
+	Item       todoItemHTTP    `json:"item"`
+	Highlights []highlightHTTP `json:"highlights"`
+}
+
+type highlightHTTP struct {
+	Field    string `json:"field"`
+	Fragment string `json:"fragment"`
+}
+
+func decodeSearchItemsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	query := todo.SearchQuery{
+		Query:  r.URL.Query().Get("q"),
+		ListID: mux.Vars(r)["listId"],
+	}
+
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "limit")
+		}
+
+		query.Limit = limit
+	}
+
+	return SearchItemsRequest{
+		Query: query,
+	}, nil
+}
+
+func encodeSearchItemsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(SearchItemsResponse)
+
+	results := make([]searchResultHTTP, 0, len(resp.Results))
+
+	for _, result := range resp.Results {
+		highlights := make([]highlightHTTP, 0, len(result.Highlights))
+
+		for _, highlight := range result.Highlights {
+			highlights = append(highlights, highlightHTTP(highlight))
+		}
+
+		results = append(results, searchResultHTTP{
+			Item:       marshalItemHTTP(ctx, result.Item),
+			Highlights: highlights,
+		})
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, searchResultsHTTP{Results: results})
+}
+
+func decodeUndoHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	steps, err := getStepsParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return UndoRequest{
+		Steps: steps,
+	}, nil
+}
+
+func encodeUndoHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UndoResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalOperationsHTTP(resp.Operations))
+}
+
+func decodeRedoHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	steps, err := getStepsParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return RedoRequest{
+		Steps: steps,
+	}, nil
+}
+
+func encodeRedoHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(RedoResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalOperationsHTTP(resp.Operations))
+}
+
+func getStepsParamFromRequest(r *http.Request) (int, error) {
+	v := r.URL.Query().Get("steps")
+	if v == "" {
+		return 0, nil
+	}
+
+	steps, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, errors.WrapIfWithDetails(err, "invalid query parameter", "param", "steps")
+	}
+
+	return steps, nil
+}
+
+func marshalOperationsHTTP(operations []todo.Operation) []operationHTTP {
+	apiOperations := make([]operationHTTP, 0, len(operations))
+
+	for _, operation := range operations {
+		apiOperations = append(apiOperations, operationHTTP{
+			ID:        operation.ID,
+			Operation: operation.Name,
+			ItemIDs:   operation.ItemIDs(),
+			CreatedAt: operation.CreatedAt,
+		})
+	}
+
+	return apiOperations
+}
+
+func decodeCreateTagHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest createTagHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return CreateTagRequest{
+		NewTag: todo.NewTag{
+			Name:  apiRequest.Name,
+			Color: apiRequest.Color,
+		},
+	}, nil
+}
+
+func encodeCreateTagHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(CreateTagResponse)
+
+	apiResponse := marshalTagHTTP(resp.Tag)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func encodeListTagsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListTagsResponse)
+
+	tags := make([]tagHTTP, 0, len(resp.Tags))
+
+	for _, tag := range resp.Tags {
+		tags = append(tags, marshalTagHTTP(tag))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, tags)
+}
+
+func decodeGetTagHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetTagRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeGetTagHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(GetTagResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalTagHTTP(resp.Tag))
+}
+
+func decodeUpdateTagHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest updateTagHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return UpdateTagRequest{
+		Id: id,
+		TagUpdate: todo.TagUpdate{
+			Name:  apiRequest.Name,
+			Color: apiRequest.Color,
+		},
+	}, nil
+}
+
+func encodeUpdateTagHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UpdateTagResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalTagHTTP(resp.Tag))
+}
+
+func decodeMergeTagsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest mergeTagsHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return MergeTagsRequest{
+		SourceID: id,
+		TargetID: apiRequest.TargetID,
+	}, nil
+}
+
+func encodeMergeTagsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(MergeTagsResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalTagHTTP(resp.Tag))
+}
+
+func decodeDeleteTagHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteTagRequest{
+		Id: id,
+	}, nil
+}
+
+func marshalTagHTTP(tag todo.Tag) tagHTTP {
+	return tagHTTP{
+		itemTagHTTP: marshalItemTagHTTP(tag),
+		ItemCount:   tag.ItemCount,
+	}
+}
+
+func marshalItemTagHTTP(tag todo.Tag) itemTagHTTP {
+	return itemTagHTTP{
+		ID:    tag.ID,
+		Name:  tag.Name,
+		Color: tag.Color,
+	}
+}
+
+func marshalListHTTP(list todo.List) todoListHTTP {
+	return todoListHTTP{
+		ID:          list.ID,
+		Name:        list.Name,
+		Description: list.Description,
+		Archived:    list.Archived,
+	}
+}
+
+func marshalItemHTTP(ctx context.Context, item todo.Item) todoItemHTTP {
+	baseURL, _ := ctx.Value(ContextKeyBaseURL).(string)
+
+	tags := make([]itemTagHTTP, 0, len(item.Tags))
+
+	for _, tag := range item.Tags {
+		tags = append(tags, marshalItemTagHTTP(tag))
+	}
+
+	return todoItemHTTP{
+		ID:           item.ID,
+		ListID:       item.ListID,
+		ParentID:     item.ParentID,
+		Title:        item.Title,
+		Notes:        item.Notes,
+		Assignee:     item.Assignee,
+		Completed:    item.Completed,
+		Order:        int32(item.Order),
+		URL:          fmt.Sprintf("%s/%s", baseURL, item.ID),
+		DueAt:        item.DueAt,
+		Recurrence:   marshalRecurrenceHTTP(item.Recurrence),
+		AutoComplete: item.AutoComplete,
+		Tags:         tags,
+		Priority:     item.Priority.String(),
+		Rank:         item.Rank,
+		DeletedAt:    item.DeletedAt,
+	}
+}
+
+func marshalItemTreesHTTP(ctx context.Context, trees []todo.ItemTree) []todoItemTreeHTTP {
+	items := make([]todoItemTreeHTTP, 0, len(trees))
+
+	for _, tree := range trees {
+		items = append(items, todoItemTreeHTTP{
+			todoItemHTTP: marshalItemHTTP(ctx, tree.Item),
+			Children:     marshalItemTreesHTTP(ctx, tree.Children),
+		})
+	}
+
+	return items
+}
+
+func marshalRecurrenceHTTP(recurrence *todo.Recurrence) *recurrenceHTTP {
+	if recurrence == nil {
+		return nil
+	}
+
+	return &recurrenceHTTP{
+		Rule:       recurrence.Rule,
+		Timezone:   recurrence.Timezone,
+		Start:      &recurrence.Start,
+		SeriesID:   recurrence.SeriesID,
+		Occurrence: &recurrence.Occurrence,
+	}
+}
+
+func unmarshalRecurrenceHTTP(recurrence *recurrenceHTTP) *todo.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	r := todo.Recurrence{
+		Rule:     recurrence.Rule,
+		Timezone: recurrence.Timezone,
+		SeriesID: recurrence.SeriesID,
+	}
+
+	if recurrence.Start != nil {
+		r.Start = *recurrence.Start
+	}
+
+	if recurrence.Occurrence != nil {
+		r.Occurrence = *recurrence.Occurrence
+	}
+
+	return &r
+}
+
+func getIDParamFromRequest(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+
+	id, ok := vars["id"]
+	if !ok || id == "" {
+		return "", errors.NewWithDetails("missing parameter from the URL", "param", "id")
+	}
+
+	return id, nil
+}
+
+func decodeStartTimerHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return StartTimerRequest{
+		ItemID: id,
+	}, nil
+}
+
+func encodeStartTimerHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(StartTimerResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalTimeEntryHTTP(resp.Entry))
+}
+
+func encodeStopTimerHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(StopTimerResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalTimeEntryHTTP(resp.Entry))
+}
+
+func encodeGetTimerHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(GetTimerResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalTimeEntryHTTP(resp.Entry))
+}
+
+func decodeListItemTimeEntriesHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := decodeTimeEntryFilterHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.ItemID = id
+
+	return ListTimeEntriesRequest{
+		Filter: filter,
+	}, nil
+}
+
+func decodeListTimeEntriesHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	filter, err := decodeTimeEntryFilterHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListTimeEntriesRequest{
+		Filter: filter,
+	}, nil
+}
+
+func decodeTimeEntryFilterHTTP(r *http.Request) (todo.TimeEntryFilter, error) {
+	query := r.URL.Query()
+
+	filter := todo.TimeEntryFilter{
+		ItemID: query.Get("itemId"),
+		User:   query.Get("user"),
+	}
+
+	for param, value := range map[string]**time.Time{
+		"from": &filter.From,
+		"to":   &filter.To,
+	} {
+		if v := query.Get(param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return filter, errors.WrapIfWithDetails(err, "invalid query parameter", "param", param)
+			}
+
+			*value = &t
+		}
+	}
+
+	for param, value := range map[string]*bool{
+		"mine":    &filter.Mine,
+		"running": &filter.Running,
+	} {
+		if v := query.Get(param); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return filter, errors.WrapIfWithDetails(err, "invalid query parameter", "param", param)
+			}
+
+			*value = b
+		}
+	}
+
+	return filter, nil
+}
+
+func encodeListTimeEntriesHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListTimeEntriesResponse)
+
+	entries := make([]timeEntryHTTP, 0, len(resp.Entries))
+
+	for _, entry := range resp.Entries {
+		entries = append(entries, marshalTimeEntryHTTP(entry))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, entries)
+}
+
+func decodeTimeTotalsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	filter, err := decodeTimeEntryFilterHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return TimeTotalsRequest{
+		Filter: filter,
+	}, nil
+}
+
+func encodeTimeTotalsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	totals := response.(TimeTotalsResponse).Totals
+
+	apiResponse := timeTotalsHTTP{
+		Items: make([]itemTimeHTTP, 0, len(totals.Items)),
+		Days:  make([]dayTimeHTTP, 0, len(totals.Days)),
+		Total: seconds(totals.Total),
+	}
+
+	for _, item := range totals.Items {
+		apiResponse.Items = append(apiResponse.Items, itemTimeHTTP{
+			ItemID:   item.ItemID,
+			Title:    item.Title,
+			Duration: seconds(item.Duration),
+		})
+	}
+
+	for _, day := range totals.Days {
+		apiResponse.Days = append(apiResponse.Days, dayTimeHTTP{
+			Day:      day.Day.Format("2006-01-02"),
+			Duration: seconds(day.Duration),
+		})
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, apiResponse)
+}
+
+func decodeExportTimesheetHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	filter, err := decodeTimeEntryFilterHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ExportTimesheetRequest{
+		Filter: filter,
+	}, nil
+}
+
+// encodeExportTimesheetHTTPResponse sends the timesheet as a CSV file download.
+func encodeExportTimesheetHTTPResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	timesheet := response.(ExportTimesheetResponse).Timesheet
+
+	w.Header().Set("Content-Type", todo.FormatCSV.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="timesheet.csv"`)
+
+	_, err := timesheet.WriteTo(w)
+
+	return err
+}
+
+func marshalTimeEntryHTTP(entry todo.TimeEntry) timeEntryHTTP {
+	return timeEntryHTTP{
+		ID:        entry.ID,
+		ItemID:    entry.ItemID,
+		User:      entry.User,
+		StartedAt: entry.StartedAt,
+		EndedAt:   entry.EndedAt,
+		Running:   entry.Running(),
+		Duration:  seconds(entry.Duration),
+	}
+}
+
+// seconds converts a duration to whole seconds.
+func seconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
+
+func decodeCreateTemplateHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest createTemplateHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	items, err := unmarshalTemplateItemsHTTP(apiRequest.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateTemplateRequest{
+		NewTemplate: todo.NewTemplate{
+			Name:        apiRequest.Name,
+			Description: apiRequest.Description,
+			Items:       items,
+		},
+	}, nil
+}
+
+func encodeCreateTemplateHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(CreateTemplateResponse)
+
+	apiResponse := marshalTemplateHTTP(resp.Template)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func encodeListTemplatesHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListTemplatesResponse)
+
+	templates := make([]templateHTTP, 0, len(resp.Templates))
+
+	for _, template := range resp.Templates {
+		templates = append(templates, marshalTemplateHTTP(template))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, templates)
+}
+
+func decodeGetTemplateHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetTemplateRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeGetTemplateHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(GetTemplateResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalTemplateHTTP(resp.Template))
+}
+
+func decodeUpdateTemplateHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest updateTemplateHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	templateUpdate := todo.TemplateUpdate{
+		Name:        apiRequest.Name,
+		Description: apiRequest.Description,
+	}
+
+	if apiRequest.Items != nil {
+		templateUpdate.Items, err = unmarshalTemplateItemsHTTP(*apiRequest.Items)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return UpdateTemplateRequest{
+		Id:             id,
+		TemplateUpdate: templateUpdate,
+	}, nil
+}
+
+func encodeUpdateTemplateHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UpdateTemplateResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalTemplateHTTP(resp.Template))
+}
+
+func decodeDeleteTemplateHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteTemplateRequest{
+		Id: id,
+	}, nil
+}
+
+func decodeInstantiateTemplateHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest instantiateTemplateHTTPRequest
+
+	// An empty body creates a new list named after the template
+	if r.ContentLength != 0 {
+		err = json.NewDecoder(r.Body).Decode(&apiRequest)
+		if err != nil {
+			return nil, errors.Wrap(err, "decode request")
+		}
+	}
+
+	return InstantiateTemplateRequest{
+		Id: id,
+		Instantiation: todo.TemplateInstantiation{
+			ListID:   apiRequest.ListID,
+			ListName: apiRequest.ListName,
+			Start:    apiRequest.Start,
+		},
+	}, nil
+}
+
+func encodeInstantiateTemplateHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	instance := response.(InstantiateTemplateResponse).Instance
+
+	apiResponse := templateInstanceHTTP{
+		List:  marshalListHTTP(instance.List),
+		Items: make([]todoItemHTTP, 0, len(instance.Items)),
+	}
+
+	for _, item := range instance.Items {
+		apiResponse.Items = append(apiResponse.Items, marshalItemHTTP(ctx, item))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func marshalTemplateHTTP(template todo.Template) templateHTTP {
+	items := make([]templateItemHTTP, 0, len(template.Items))
+
+	for _, item := range template.Items {
+		apiItem := templateItemHTTP{
+			Title:    item.Title,
+			Notes:    item.Notes,
+			Priority: item.Priority.String(),
+			Order:    item.Order,
+			Tags:     item.Tags,
+		}
+
+		if apiItem.Tags == nil {
+			apiItem.Tags = []string{}
+		}
+
+		if item.DueOffset != nil {
+			offset := seconds(*item.DueOffset)
+			apiItem.DueOffset = &offset
+		}
+
+		items = append(items, apiItem)
+	}
+
+	return templateHTTP{
+		ID:          template.ID,
+		Name:        template.Name,
+		Description: template.Description,
+		Items:       items,
+	}
+}
+
+func unmarshalTemplateItemsHTTP(apiItems []templateItemHTTP) ([]todo.TemplateItem, error) {
+	items := make([]todo.TemplateItem, 0, len(apiItems))
+
+	for _, apiItem := range apiItems {
+		priority, err := todo.ParsePriority(apiItem.Priority)
+		if err != nil {
+			return nil, err
+		}
+
+		item := todo.TemplateItem{
+			Title:    apiItem.Title,
+			Notes:    apiItem.Notes,
+			Priority: priority,
+			Order:    apiItem.Order,
+			Tags:     apiItem.Tags,
+		}
+
+		if apiItem.DueOffset != nil {
+			offset := time.Duration(*apiItem.DueOffset) * time.Second
+			item.DueOffset = &offset
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func decodeCreateRuleHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var apiRequest createRuleHTTPRequest
+
+	err := json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return CreateRuleRequest{
+		NewRule: todo.NewRule{
+			Name:       apiRequest.Name,
+			Trigger:    todo.RuleTrigger(apiRequest.Trigger),
+			Conditions: unmarshalRuleConditionsHTTP(apiRequest.Conditions),
+			Actions:    unmarshalRuleActionsHTTP(apiRequest.Actions),
+			Enabled:    apiRequest.Enabled,
+			DryRun:     apiRequest.DryRun,
+		},
+	}, nil
+}
+
+func encodeCreateRuleHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(CreateRuleResponse)
+
+	apiResponse := marshalRuleHTTP(resp.Rule)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, kitxhttp.WithStatusCode(apiResponse, http.StatusCreated))
+}
+
+func encodeListRulesHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListRulesResponse)
+
+	rules := make([]ruleHTTP, 0, len(resp.Rules))
+
+	for _, rule := range resp.Rules {
+		rules = append(rules, marshalRuleHTTP(rule))
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, rules)
+}
+
+func decodeGetRuleHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetRuleRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeGetRuleHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(GetRuleResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalRuleHTTP(resp.Rule))
+}
+
+func decodeUpdateRuleHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest updateRuleHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	ruleUpdate := todo.RuleUpdate{
+		Name:    apiRequest.Name,
+		Enabled: apiRequest.Enabled,
+		DryRun:  apiRequest.DryRun,
+	}
+
+	if apiRequest.Trigger != nil {
+		trigger := todo.RuleTrigger(*apiRequest.Trigger)
+		ruleUpdate.Trigger = &trigger
+	}
+
+	if apiRequest.Conditions != nil {
+		ruleUpdate.Conditions = unmarshalRuleConditionsHTTP(*apiRequest.Conditions)
+	}
+
+	if apiRequest.Actions != nil {
+		ruleUpdate.Actions = unmarshalRuleActionsHTTP(*apiRequest.Actions)
+	}
+
+	return UpdateRuleRequest{
+		Id:         id,
+		RuleUpdate: ruleUpdate,
+	}, nil
+}
+
+func encodeUpdateRuleHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(UpdateRuleResponse)
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, marshalRuleHTTP(resp.Rule))
+}
+
+func decodeDeleteRuleHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeleteRuleRequest{
+		Id: id,
+	}, nil
+}
+
+func decodeEvaluateRuleHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiRequest evaluateRuleHTTPRequest
+
+	err = json.NewDecoder(r.Body).Decode(&apiRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode request")
+	}
+
+	return EvaluateRuleRequest{
+		Id:     id,
+		ItemID: apiRequest.ItemID,
+	}, nil
+}
+
+func encodeEvaluateRuleHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	evaluation := response.(EvaluateRuleResponse).Evaluation
+
+	apiResponse := ruleEvaluationHTTP{
+		Matched:    evaluation.Matched,
+		Conditions: make([]ruleConditionResultHTTP, 0, len(evaluation.Conditions)),
+		Actions:    marshalRuleActionsHTTP(evaluation.Actions),
+	}
+
+	for _, result := range evaluation.Conditions {
+		values := result.Values
+		if values == nil {
+			values = []string{}
+		}
+
+		apiResponse.Conditions = append(apiResponse.Conditions, ruleConditionResultHTTP{
+			ruleConditionHTTP: marshalRuleConditionHTTP(result.Condition),
+			Matched:           result.Matched,
+			Values:            values,
+		})
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, apiResponse)
+}
+
+func decodeListRuleExecutionsHTTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := getIDParamFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListRuleExecutionsRequest{
+		Id: id,
+	}, nil
+}
+
+func encodeListRuleExecutionsHTTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(ListRuleExecutionsResponse)
+
+	executions := make([]ruleExecutionHTTP, 0, len(resp.Executions))
+
+	for _, execution := range resp.Executions {
+		executions = append(executions, ruleExecutionHTTP{
+			ID:            execution.ID,
+			RuleID:        execution.RuleID,
+			Trigger:       string(execution.Trigger),
+			ItemID:        execution.ItemID,
+			CorrelationID: execution.CorrelationID,
+			Status:        string(execution.Status),
+			Error:         execution.Error,
+			ExecutedAt:    execution.ExecutedAt,
+		})
+	}
+
+	return kitxhttp.JSONResponseEncoder(ctx, w, executions)
+}
+
+func marshalRuleHTTP(rule todo.Rule) ruleHTTP {
+	conditions := make([]ruleConditionHTTP, 0, len(rule.Conditions))
+
+	for _, condition := range rule.Conditions {
+		conditions = append(conditions, marshalRuleConditionHTTP(condition))
+	}
+
+	return ruleHTTP{
+		ID:         rule.ID,
+		Name:       rule.Name,
+		Trigger:    string(rule.Trigger),
+		Conditions: conditions,
+		Actions:    marshalRuleActionsHTTP(rule.Actions),
+		Enabled:    rule.Enabled,
+		DryRun:     rule.DryRun,
+		Owner:      rule.Owner,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
+
+func marshalRuleConditionHTTP(condition todo.RuleCondition) ruleConditionHTTP {
+	return ruleConditionHTTP{
+		Field:    condition.Field,
+		Operator: string(condition.Operator),
+		Value:    condition.Value,
+	}
+}
+
+func marshalRuleActionsHTTP(actions []todo.RuleAction) []ruleActionHTTP {
+	apiActions := make([]ruleActionHTTP, 0, len(actions))
+
+	for _, action := range actions {
+		apiActions = append(apiActions, ruleActionHTTP{
+			Type:   string(action.Type),
+			Value:  action.Value,
+			ListID: action.ListID,
+		})
+	}
+
+	return apiActions
+}
+
+func unmarshalRuleConditionsHTTP(apiConditions []ruleConditionHTTP) []todo.RuleCondition {
+	conditions := make([]todo.RuleCondition, 0, len(apiConditions))
+
+	for _, apiCondition := range apiConditions {
+		conditions = append(conditions, todo.RuleCondition{
+			Field:    apiCondition.Field,
+			Operator: todo.RuleOperator(apiCondition.Operator),
+			Value:    apiCondition.Value,
+		})
+	}
+
+	return conditions
+}
+
+func unmarshalRuleActionsHTTP(apiActions []ruleActionHTTP) []todo.RuleAction {
+	actions := make([]todo.RuleAction, 0, len(apiActions))
+
+	for _, apiAction := range apiActions {
+		actions = append(actions, todo.RuleAction{
+			Type:   todo.RuleActionType(apiAction.Type),
+			Value:  apiAction.Value,
+			ListID: apiAction.ListID,
+		})
+	}
+
+	return actions
+}
@@ -0,0 +1,251 @@
+package tododriver
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"regexp"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	platformmail "github.com/sagikazarmark/modern-go-application/internal/platform/mail"
+)
+
+// maxMIMEDepth limits the nesting of multipart bodies.
+const maxMIMEDepth = 5
+
+// MakeSMTPHandler returns a handler creating items from the emails received by an SMTP server.
+// Senders are identified by the envelope sender: the server is expected to receive emails relayed by
+// a mail server verifying senders.
+func MakeSMTPHandler(gateway todo.EmailGateway) platformmail.Handler {
+	return smtpHandler{gateway: gateway}
+}
+
+type smtpHandler struct {
+	gateway todo.EmailGateway
+}
+
+func (h smtpHandler) CheckSender(ctx context.Context, _ net.Addr, from string) error {
+	return encodeSMTPError(h.gateway.CheckSender(ctx, from))
+}
+
+func (h smtpHandler) CheckRecipient(ctx context.Context, _ platformmail.Envelope, to string) error {
+	return encodeSMTPError(h.gateway.CheckMailbox(ctx, to))
+}
+
+func (h smtpHandler) Receive(ctx context.Context, envelope platformmail.Envelope, data []byte) error {
+	email, err := decodeEmail(data)
+	if err != nil {
+		return platformmail.Error{Code: 554, Message: "5.6.0 Malformed message"}
+	}
+
+	email.From = envelope.From
+	email.To = envelope.To
+
+	_, err = h.gateway.Receive(ctx, email)
+
+	return encodeSMTPError(err)
+}
+
+// encodeSMTPError translates service errors to SMTP replies.
+func encodeSMTPError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.As(err, &todo.UnknownSenderError{}):
+		return platformmail.Error{Code: 550, Message: "5.7.1 Sender is not allowed to create items"}
+
+	case errors.As(err, &todo.UnknownMailboxError{}):
+		return platformmail.Error{Code: 550, Message: "5.1.1 No such mailbox"}
+
+	case errors.As(err, &todo.RateLimitError{}):
+		return platformmail.Error{Code: 450, Message: "4.7.0 Too many emails, try again later"}
+	}
+
+	var serr interface{ ServiceError() bool }
+	if errors.As(err, &serr) && serr.ServiceError() {
+		return platformmail.Error{Code: 554, Message: "5.6.0 " + err.Error()}
+	}
+
+	return err
+}
+
+// decodeEmail decodes the subject, the text body and the attachments of an email.
+// HTML bodies are converted to plain text if an email has no text body.
+func decodeEmail(data []byte) (todo.InboundEmail, error) {
+	message, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return todo.InboundEmail{}, errors.WithStack(err)
+	}
+
+	var email todo.InboundEmail
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	if err != nil {
+		subject = message.Header.Get("Subject")
+	}
+
+	email.Subject = subject
+
+	var body emailBody
+
+	err = body.decodePart(textproto.MIMEHeader(message.Header), message.Body, 0)
+	if err != nil {
+		return todo.InboundEmail{}, err
+	}
+
+	email.Body = body.text
+	if email.Body == "" && body.html != "" {
+		email.Body = htmlToText(body.html)
+	}
+
+	email.Attachments = body.attachments
+
+	return email, nil
+}
+
+// emailBody collects the parts of an email.
+type emailBody struct {
+	text        string
+	html        string
+	attachments []todo.InboundAttachment
+}
+
+func (b *emailBody) decodePart(header textproto.MIMEHeader, r io.Reader, depth int) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		// Defaults of RFC 2045
+		mediaType, params = "text/plain", nil
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxMIMEDepth {
+			return errors.New("email parts are nested too deep")
+		}
+
+		reader := multipart.NewReader(r, params["boundary"])
+
+		for {
+			part, err := reader.NextPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			err = b.decodePart(part.Header, part, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	content, err := io.ReadAll(decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), r))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if name := partFileName(header); name != "" {
+		b.attachments = append(b.attachments, todo.InboundAttachment{
+			Name:        name,
+			ContentType: mediaType,
+			Content:     content,
+		})
+
+		return nil
+	}
+
+	switch {
+	case mediaType == "text/plain" && b.text == "":
+		b.text = string(content)
+
+	case mediaType == "text/html" && b.html == "":
+		b.html = string(content)
+	}
+
+	return nil
+}
+
+// decodeTransferEncoding decodes the content of a part.
+// Quoted-printable parts of multipart bodies are decoded by the multipart reader already
+// (and their Content-Transfer-Encoding header is removed).
+func decodeTransferEncoding(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, r)
+
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+
+	default:
+		return r
+	}
+}
+
+// partFileName returns the file name of a part (empty if the part is not an attachment).
+func partFileName(header textproto.MIMEHeader) string {
+	var name string
+
+	disposition, params, err := mime.ParseMediaType(header.Get("Content-Disposition"))
+	if err == nil {
+		name = params["filename"]
+
+		if name == "" && disposition == "attachment" {
+			name = "attachment"
+		}
+	}
+
+	// Some clients only name attachments in the content type
+	if name == "" {
+		if _, params, err := mime.ParseMediaType(header.Get("Content-Type")); err == nil {
+			name = params["name"]
+		}
+	}
+
+	if decoded, err := new(mime.WordDecoder).DecodeHeader(name); err == nil {
+		name = decoded
+	}
+
+	// Keep the base name of paths
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return strings.TrimSpace(name)
+}
+
+var (
+	htmlBlockEnd   = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|tr|h[1-6])>`)
+	htmlTag        = regexp.MustCompile(`(?s)<[^>]*>`)
+	htmlIgnored    = regexp.MustCompile(`(?is)<(style|script|head)[^>]*>.*?</(style|script|head)>`)
+	htmlBlankLines = regexp.MustCompile(`\n\s*\n\s*\n+`)
+)
+
+// htmlToText converts an HTML body to plain text.
+func htmlToText(s string) string {
+	s = htmlIgnored.ReplaceAllString(s, "")
+	s = htmlBlockEnd.ReplaceAllString(s, "\n")
+	s = htmlTag.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+
+	return strings.TrimSpace(htmlBlankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
@@ -35,3 +35,103 @@ func (d EventDispatcher) MarkedAsComplete(ctx context.Context, event todo.Marked
 
 	return nil
 }
+
+// ItemDueSoon dispatches a(n) ItemDueSoon event.
+func (d EventDispatcher) ItemDueSoon(ctx context.Context, event todo.ItemDueSoon) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "ItemDueSoon")
+	}
+
+	return nil
+}
+
+// ItemOverdue dispatches a(n) ItemOverdue event.
+func (d EventDispatcher) ItemOverdue(ctx context.Context, event todo.ItemOverdue) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "ItemOverdue")
+	}
+
+	return nil
+}
+
+// OperationUndone dispatches a(n) OperationUndone event.
+func (d EventDispatcher) OperationUndone(ctx context.Context, event todo.OperationUndone) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "OperationUndone")
+	}
+
+	return nil
+}
+
+// OperationRedone dispatches a(n) OperationRedone event.
+func (d EventDispatcher) OperationRedone(ctx context.Context, event todo.OperationRedone) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "OperationRedone")
+	}
+
+	return nil
+}
+
+// ItemAdded dispatches a(n) ItemAdded event.
+func (d EventDispatcher) ItemAdded(ctx context.Context, event todo.ItemAdded) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "ItemAdded")
+	}
+
+	return nil
+}
+
+// ItemUpdated dispatches a(n) ItemUpdated event.
+func (d EventDispatcher) ItemUpdated(ctx context.Context, event todo.ItemUpdated) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "ItemUpdated")
+	}
+
+	return nil
+}
+
+// ItemDeleted dispatches a(n) ItemDeleted event.
+func (d EventDispatcher) ItemDeleted(ctx context.Context, event todo.ItemDeleted) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "ItemDeleted")
+	}
+
+	return nil
+}
+
+// UserMentioned dispatches a(n) UserMentioned event.
+func (d EventDispatcher) UserMentioned(ctx context.Context, event todo.UserMentioned) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "UserMentioned")
+	}
+
+	return nil
+}
+
+// CollaboratorInvited dispatches a(n) CollaboratorInvited event.
+func (d EventDispatcher) CollaboratorInvited(ctx context.Context, event todo.CollaboratorInvited) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "CollaboratorInvited")
+	}
+
+	return nil
+}
+
+// ItemAssigned dispatches a(n) ItemAssigned event.
+func (d EventDispatcher) ItemAssigned(ctx context.Context, event todo.ItemAssigned) error {
+	err := d.bus.Publish(ctx, event)
+	if err != nil {
+		return errors.WithDetails(errors.WithMessage(err, "failed to dispatch event"), "event", "ItemAssigned")
+	}
+
+	return nil
+}
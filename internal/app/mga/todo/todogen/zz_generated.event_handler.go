@@ -50,3 +50,403 @@ func (h MarkedAsCompleteEventHandler) Handle(ctx context.Context, event interfac
 
 	return h.handler.MarkedAsComplete(ctx, *e)
 }
+
+// ItemDueSoonHandler handles ItemDueSoon events.
+type ItemDueSoonHandler interface {
+	// ItemDueSoon handles a(n) ItemDueSoon event.
+	ItemDueSoon(ctx context.Context, event todo.ItemDueSoon) error
+}
+
+// ItemDueSoonEventHandler handles ItemDueSoon events.
+type ItemDueSoonEventHandler struct {
+	handler ItemDueSoonHandler
+	name    string
+}
+
+// NewItemDueSoonEventHandler returns a new ItemDueSoonEventHandler instance.
+func NewItemDueSoonEventHandler(handler ItemDueSoonHandler, name string) ItemDueSoonEventHandler {
+	return ItemDueSoonEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h ItemDueSoonEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h ItemDueSoonEventHandler) NewEvent() interface{} {
+	return &todo.ItemDueSoon{}
+}
+
+// Handle handles an event.
+func (h ItemDueSoonEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.ItemDueSoon)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.ItemDueSoon(ctx, *e)
+}
+
+// ItemOverdueHandler handles ItemOverdue events.
+type ItemOverdueHandler interface {
+	// ItemOverdue handles a(n) ItemOverdue event.
+	ItemOverdue(ctx context.Context, event todo.ItemOverdue) error
+}
+
+// ItemOverdueEventHandler handles ItemOverdue events.
+type ItemOverdueEventHandler struct {
+	handler ItemOverdueHandler
+	name    string
+}
+
+// NewItemOverdueEventHandler returns a new ItemOverdueEventHandler instance.
+func NewItemOverdueEventHandler(handler ItemOverdueHandler, name string) ItemOverdueEventHandler {
+	return ItemOverdueEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h ItemOverdueEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h ItemOverdueEventHandler) NewEvent() interface{} {
+	return &todo.ItemOverdue{}
+}
+
+// Handle handles an event.
+func (h ItemOverdueEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.ItemOverdue)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.ItemOverdue(ctx, *e)
+}
+
+// OperationUndoneHandler handles OperationUndone events.
+type OperationUndoneHandler interface {
+	// OperationUndone handles a(n) OperationUndone event.
+	OperationUndone(ctx context.Context, event todo.OperationUndone) error
+}
+
+// OperationUndoneEventHandler handles OperationUndone events.
+type OperationUndoneEventHandler struct {
+	handler OperationUndoneHandler
+	name    string
+}
+
+// NewOperationUndoneEventHandler returns a new OperationUndoneEventHandler instance.
+func NewOperationUndoneEventHandler(handler OperationUndoneHandler, name string) OperationUndoneEventHandler {
+	return OperationUndoneEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h OperationUndoneEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h OperationUndoneEventHandler) NewEvent() interface{} {
+	return &todo.OperationUndone{}
+}
+
+// Handle handles an event.
+func (h OperationUndoneEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.OperationUndone)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.OperationUndone(ctx, *e)
+}
+
+// OperationRedoneHandler handles OperationRedone events.
+type OperationRedoneHandler interface {
+	// OperationRedone handles a(n) OperationRedone event.
+	OperationRedone(ctx context.Context, event todo.OperationRedone) error
+}
+
+// OperationRedoneEventHandler handles OperationRedone events.
+type OperationRedoneEventHandler struct {
+	handler OperationRedoneHandler
+	name    string
+}
+
+// NewOperationRedoneEventHandler returns a new OperationRedoneEventHandler instance.
+func NewOperationRedoneEventHandler(handler OperationRedoneHandler, name string) OperationRedoneEventHandler {
+	return OperationRedoneEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h OperationRedoneEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h OperationRedoneEventHandler) NewEvent() interface{} {
+	return &todo.OperationRedone{}
+}
+
+// Handle handles an event.
+func (h OperationRedoneEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.OperationRedone)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.OperationRedone(ctx, *e)
+}
+
+// ItemAddedHandler handles ItemAdded events.
+type ItemAddedHandler interface {
+	// ItemAdded handles a(n) ItemAdded event.
+	ItemAdded(ctx context.Context, event todo.ItemAdded) error
+}
+
+// ItemAddedEventHandler handles ItemAdded events.
+type ItemAddedEventHandler struct {
+	handler ItemAddedHandler
+	name    string
+}
+
+// NewItemAddedEventHandler returns a new ItemAddedEventHandler instance.
+func NewItemAddedEventHandler(handler ItemAddedHandler, name string) ItemAddedEventHandler {
+	return ItemAddedEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h ItemAddedEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h ItemAddedEventHandler) NewEvent() interface{} {
+	return &todo.ItemAdded{}
+}
+
+// Handle handles an event.
+func (h ItemAddedEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.ItemAdded)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.ItemAdded(ctx, *e)
+}
+
+// ItemUpdatedHandler handles ItemUpdated events.
+type ItemUpdatedHandler interface {
+	// ItemUpdated handles a(n) ItemUpdated event.
+	ItemUpdated(ctx context.Context, event todo.ItemUpdated) error
+}
+
+// ItemUpdatedEventHandler handles ItemUpdated events.
+type ItemUpdatedEventHandler struct {
+	handler ItemUpdatedHandler
+	name    string
+}
+
+// NewItemUpdatedEventHandler returns a new ItemUpdatedEventHandler instance.
+func NewItemUpdatedEventHandler(handler ItemUpdatedHandler, name string) ItemUpdatedEventHandler {
+	return ItemUpdatedEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h ItemUpdatedEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h ItemUpdatedEventHandler) NewEvent() interface{} {
+	return &todo.ItemUpdated{}
+}
+
+// Handle handles an event.
+func (h ItemUpdatedEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.ItemUpdated)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.ItemUpdated(ctx, *e)
+}
+
+// ItemDeletedHandler handles ItemDeleted events.
+type ItemDeletedHandler interface {
+	// ItemDeleted handles a(n) ItemDeleted event.
+	ItemDeleted(ctx context.Context, event todo.ItemDeleted) error
+}
+
+// ItemDeletedEventHandler handles ItemDeleted events.
+type ItemDeletedEventHandler struct {
+	handler ItemDeletedHandler
+	name    string
+}
+
+// NewItemDeletedEventHandler returns a new ItemDeletedEventHandler instance.
+func NewItemDeletedEventHandler(handler ItemDeletedHandler, name string) ItemDeletedEventHandler {
+	return ItemDeletedEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h ItemDeletedEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h ItemDeletedEventHandler) NewEvent() interface{} {
+	return &todo.ItemDeleted{}
+}
+
+// Handle handles an event.
+func (h ItemDeletedEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.ItemDeleted)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.ItemDeleted(ctx, *e)
+}
+
+// UserMentionedHandler handles UserMentioned events.
+type UserMentionedHandler interface {
+	// UserMentioned handles a(n) UserMentioned event.
+	UserMentioned(ctx context.Context, event todo.UserMentioned) error
+}
+
+// UserMentionedEventHandler handles UserMentioned events.
+type UserMentionedEventHandler struct {
+	handler UserMentionedHandler
+	name    string
+}
+
+// NewUserMentionedEventHandler returns a new UserMentionedEventHandler instance.
+func NewUserMentionedEventHandler(handler UserMentionedHandler, name string) UserMentionedEventHandler {
+	return UserMentionedEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h UserMentionedEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h UserMentionedEventHandler) NewEvent() interface{} {
+	return &todo.UserMentioned{}
+}
+
+// Handle handles an event.
+func (h UserMentionedEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.UserMentioned)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.UserMentioned(ctx, *e)
+}
+
+// CollaboratorInvitedHandler handles CollaboratorInvited events.
+type CollaboratorInvitedHandler interface {
+	// CollaboratorInvited handles a(n) CollaboratorInvited event.
+	CollaboratorInvited(ctx context.Context, event todo.CollaboratorInvited) error
+}
+
+// CollaboratorInvitedEventHandler handles CollaboratorInvited events.
+type CollaboratorInvitedEventHandler struct {
+	handler CollaboratorInvitedHandler
+	name    string
+}
+
+// NewCollaboratorInvitedEventHandler returns a new CollaboratorInvitedEventHandler instance.
+func NewCollaboratorInvitedEventHandler(handler CollaboratorInvitedHandler, name string) CollaboratorInvitedEventHandler {
+	return CollaboratorInvitedEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h CollaboratorInvitedEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h CollaboratorInvitedEventHandler) NewEvent() interface{} {
+	return &todo.CollaboratorInvited{}
+}
+
+// Handle handles an event.
+func (h CollaboratorInvitedEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.CollaboratorInvited)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.CollaboratorInvited(ctx, *e)
+}
+
+// ItemAssignedHandler handles ItemAssigned events.
+type ItemAssignedHandler interface {
+	// ItemAssigned handles a(n) ItemAssigned event.
+	ItemAssigned(ctx context.Context, event todo.ItemAssigned) error
+}
+
+// ItemAssignedEventHandler handles ItemAssigned events.
+type ItemAssignedEventHandler struct {
+	handler ItemAssignedHandler
+	name    string
+}
+
+// NewItemAssignedEventHandler returns a new ItemAssignedEventHandler instance.
+func NewItemAssignedEventHandler(handler ItemAssignedHandler, name string) ItemAssignedEventHandler {
+	return ItemAssignedEventHandler{
+		handler: handler,
+		name:    name,
+	}
+}
+
+// HandlerName returns the name of the event handler.
+func (h ItemAssignedEventHandler) HandlerName() string {
+	return h.name
+}
+
+// NewEvent returns a new empty event used for serialization.
+func (h ItemAssignedEventHandler) NewEvent() interface{} {
+	return &todo.ItemAssigned{}
+}
+
+// Handle handles an event.
+func (h ItemAssignedEventHandler) Handle(ctx context.Context, event interface{}) error {
+	e, ok := event.(*todo.ItemAssigned)
+	if !ok {
+		return errors.NewWithDetails("unexpected event type", "type", fmt.Sprintf("%T", event))
+	}
+
+	return h.handler.ItemAssigned(ctx, *e)
+}
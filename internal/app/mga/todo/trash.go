@@ -0,0 +1,190 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// TrashStore keeps deleted items until they are restored or purged.
+//
+// Deleting items (see Store) moves them to the trash:
+// deleted items are excluded from every other store operation.
+type TrashStore interface {
+	// GetTrash returns the deleted items of a list.
+	GetTrash(ctx context.Context, listID string) ([]Item, error)
+
+	// GetOneDeleted returns a single deleted item by its ID.
+	GetOneDeleted(ctx context.Context, id string) (Item, error)
+
+	// GetDeletedSubtasks returns the deleted subtasks of an item (one level deep).
+	GetDeletedSubtasks(ctx context.Context, parentID string) ([]Item, error)
+
+	// RestoreOne restores a deleted item.
+	RestoreOne(ctx context.Context, id string) error
+
+	// PurgeOne permanently deletes an item.
+	PurgeOne(ctx context.Context, id string) error
+
+	// PurgeAll permanently deletes all items of a list, including deleted ones.
+	PurgeAll(ctx context.Context, listID string) error
+
+	// PurgeDeletedBefore permanently deletes items deleted before a point in time
+	// and returns the number of purged items.
+	PurgeDeletedBefore(ctx context.Context, deletedBefore time.Time) (int, error)
+}
+
+func (s service) ListTrash(ctx context.Context, listID string) ([]Item, error) {
+	if listID == "" {
+		listID = DefaultListID
+	}
+
+	_, err := s.getList(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.trashStore.GetTrash(ctx, listID)
+}
+
+func (s service) RestoreItem(ctx context.Context, id string) (Item, error) {
+	item, err := s.trashStore.GetOneDeleted(ctx, id)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "restore item")
+	}
+
+	_, err = s.getWritableList(ctx, item.ListID)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "restore item")
+	}
+
+	subtasks, err := s.getDeletedSubtasks(ctx, item)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "restore item")
+	}
+
+	err = s.trashStore.RestoreOne(ctx, item.ID)
+	if err != nil {
+		return Item{}, errors.WithMessage(err, "restore item")
+	}
+
+	item.DeletedAt = nil
+
+	// Items are restored as top level items when their parent is gone
+	if item.ParentID != "" {
+		_, err := s.store.GetOne(ctx, item.ParentID)
+		if errors.As(err, &NotFoundError{}) {
+			item.ParentID = ""
+
+			err = s.store.Store(ctx, item)
+		}
+		if err != nil {
+			return Item{}, errors.WithMessage(err, "restore item")
+		}
+	}
+
+	for _, subtask := range subtasks {
+		err := s.trashStore.RestoreOne(ctx, subtask.ID)
+		if err != nil {
+			return Item{}, errors.WithMessage(err, "restore subtask")
+		}
+	}
+
+	return item, nil
+}
+
+func (s service) PurgeItem(ctx context.Context, id string) error {
+	item, err := s.trashStore.GetOneDeleted(ctx, id)
+	if err != nil {
+		return errors.WithMessage(err, "purge item")
+	}
+
+	subtasks, err := s.getDeletedSubtasks(ctx, item)
+	if err != nil {
+		return errors.WithMessage(err, "purge item")
+	}
+
+	// Subtasks are purged bottom-up, so parents are never left with dangling children
+	for i := len(subtasks) - 1; i >= 0; i-- {
+		err := s.trashStore.PurgeOne(ctx, subtasks[i].ID)
+		if err != nil {
+			return errors.WithMessage(err, "purge subtask")
+		}
+	}
+
+	err = s.trashStore.PurgeOne(ctx, item.ID)
+	if err != nil {
+		return errors.WithMessage(err, "purge item")
+	}
+
+	s.collectAttachments(ctx)
+
+	return nil
+}
+
+func (s service) EmptyTrash(ctx context.Context, listID string) error {
+	items, err := s.ListTrash(ctx, listID)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		err := s.trashStore.PurgeOne(ctx, item.ID)
+		if err != nil {
+			return errors.WithMessage(err, "purge item")
+		}
+	}
+
+	s.collectAttachments(ctx)
+
+	return nil
+}
+
+// getDeletedSubtasks returns the subtasks of an item in the trash on every level, parents first.
+func (s service) getDeletedSubtasks(ctx context.Context, item Item) ([]Item, error) {
+	return deletedSubtasks(ctx, s.trashStore, item.ID)
+}
+
+// deletedSubtasks returns the subtasks of an item in the trash on every level, parents first.
+func deletedSubtasks(ctx context.Context, store TrashStore, id string) ([]Item, error) {
+	var subtasks []Item
+
+	for parents := []string{id}; len(parents) > 0; parents = parents[1:] {
+		children, err := store.GetDeletedSubtasks(ctx, parents[0])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, child := range children {
+			subtasks = append(subtasks, child)
+			parents = append(parents, child.ID)
+		}
+	}
+
+	return subtasks, nil
+}
+
+// TrashPurger permanently deletes items that have been in the trash for longer than a retention period.
+type TrashPurger struct {
+	store     TrashStore
+	retention time.Duration
+}
+
+// NewTrashPurger returns a new TrashPurger.
+func NewTrashPurger(store TrashStore, retention time.Duration) TrashPurger {
+	return TrashPurger{
+		store:     store,
+		retention: retention,
+	}
+}
+
+// Purge permanently deletes items deleted before the retention period.
+func (p TrashPurger) Purge(ctx context.Context) error {
+	_, err := p.store.PurgeDeletedBefore(ctx, time.Now().Add(-p.retention))
+	if err != nil {
+		return errors.WithMessage(err, "purge trash")
+	}
+
+	return nil
+}
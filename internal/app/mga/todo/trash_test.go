@@ -0,0 +1,250 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+func TestService_Trash(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	parent, err := service.AddItem(ctx, NewItem{Title: "Clean the house"})
+	require.NoError(t, err)
+
+	subtask, err := service.AddItem(ctx, NewItem{Title: "Vacuum", ParentID: parent.ID})
+	require.NoError(t, err)
+
+	other, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	_, err = service.TagItem(ctx, other.ID, []string{"shopping"})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, parent.ID, true)
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, other.ID, false)
+	require.NoError(t, err)
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	_, err = service.GetItem(ctx, parent.ID)
+	assert.True(t, errors.As(err, &NotFoundError{}))
+
+	tags, err := service.ListTags(ctx)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, 0, tags[0].ItemCount, "deleted items are not counted")
+
+	trash, err := service.ListTrash(ctx, "")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{parent.ID, subtask.ID, other.ID}, itemIDs(trash))
+
+	for _, item := range trash {
+		assert.NotNil(t, item.DeletedAt)
+	}
+
+	restored, err := service.RestoreItem(ctx, parent.ID)
+	require.NoError(t, err)
+	assert.Nil(t, restored.DeletedAt)
+
+	items, err = service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{parent.ID, subtask.ID}, itemIDs(items), "subtasks are restored with their parent")
+
+	_, err = service.RestoreItem(ctx, parent.ID)
+	assert.True(t, errors.As(err, &NotFoundError{}), "items not in the trash cannot be restored")
+
+	err = service.PurgeItem(ctx, other.ID)
+	require.NoError(t, err)
+
+	trash, err = service.ListTrash(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+
+	tags, err = service.ListTags(ctx)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, 0, tags[0].ItemCount)
+}
+
+func TestService_PurgeItem_Subtasks(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	parent, err := service.AddItem(ctx, NewItem{Title: "Clean the house"})
+	require.NoError(t, err)
+
+	subtask, err := service.AddItem(ctx, NewItem{Title: "Vacuum", ParentID: parent.ID})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, subtask.ID, false)
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, parent.ID, false)
+	require.NoError(t, err)
+
+	err = service.PurgeItem(ctx, parent.ID)
+	require.NoError(t, err)
+
+	trash, err := service.ListTrash(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, trash, "deleted subtasks are purged with their parent")
+}
+
+func TestService_RestoreItem_MissingParent(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	parent, err := service.AddItem(ctx, NewItem{Title: "Clean the house"})
+	require.NoError(t, err)
+
+	subtask, err := service.AddItem(ctx, NewItem{Title: "Vacuum", ParentID: parent.ID})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, subtask.ID, false)
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, parent.ID, false)
+	require.NoError(t, err)
+
+	// Restoring the subtask alone leaves its parent in the trash
+	restored, err := service.RestoreItem(ctx, subtask.ID)
+	require.NoError(t, err)
+	assert.Empty(t, restored.ParentID)
+
+	item, err := service.GetItem(ctx, subtask.ID)
+	require.NoError(t, err)
+	assert.Empty(t, item.ParentID)
+}
+
+func TestService_EmptyTrash(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{Title: "Clean the house"})
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Buy milk"})
+	require.NoError(t, err)
+
+	err = service.DeleteItems(ctx, "")
+	require.NoError(t, err)
+
+	err = service.DeleteItems(ctx, list.ID)
+	require.NoError(t, err)
+
+	err = service.EmptyTrash(ctx, list.ID)
+	require.NoError(t, err)
+
+	trash, err := service.ListTrash(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+
+	trash, err = service.ListTrash(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, trash, 1, "only the trash of the list is emptied")
+
+	_, err = service.ListTrash(ctx, "unknown")
+	assert.True(t, errors.As(err, &ListNotFoundError{}))
+}
+
+func TestEventMiddleware_Trash(t *testing.T) {
+	ctx := context.Background()
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	parent, err := service.AddItem(ctx, NewItem{Title: "Clean the house"})
+	require.NoError(t, err)
+
+	subtask, err := service.AddItem(ctx, NewItem{Title: "Vacuum", ParentID: parent.ID})
+	require.NoError(t, err)
+
+	other, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	err = service.DeleteItems(ctx, "")
+	require.NoError(t, err)
+
+	events.added, events.deleted = nil, nil
+
+	_, err = service.RestoreItem(ctx, parent.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, []ItemAdded{{ID: parent.ID, ListID: DefaultListID}, {ID: subtask.ID, ListID: DefaultListID}}, events.added)
+
+	err = service.DeleteItem(ctx, parent.ID, true)
+	require.NoError(t, err)
+
+	events.deleted = nil
+
+	err = service.PurgeItem(ctx, parent.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, []ItemDeleted{{ID: parent.ID, ListID: DefaultListID}, {ID: subtask.ID, ListID: DefaultListID}}, events.deleted)
+
+	events.deleted = nil
+
+	err = service.EmptyTrash(ctx, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []ItemDeleted{{ID: other.ID, ListID: DefaultListID}}, events.deleted)
+}
+
+func TestService_DeleteList_Trash(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(testServiceConfig{})
+
+	list, err := service.CreateList(ctx, NewList{Name: "Groceries"})
+	require.NoError(t, err)
+
+	item, err := service.AddItem(ctx, NewItem{ListID: list.ID, Title: "Buy milk"})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, item.ID, false)
+	require.NoError(t, err)
+
+	err = service.DeleteList(ctx, list.ID)
+	require.NoError(t, err)
+
+	_, err = store.GetOneDeleted(ctx, item.ID)
+	assert.True(t, errors.As(err, &NotFoundError{}), "deleting a list purges its trash")
+}
+
+func TestTrashPurger(t *testing.T) {
+	ctx := context.Background()
+	service, store := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, item.ID, false)
+	require.NoError(t, err)
+
+	err = NewTrashPurger(store, time.Hour).Purge(ctx)
+	require.NoError(t, err)
+
+	trash, err := service.ListTrash(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, trash, 1, "recently deleted items are kept")
+
+	err = NewTrashPurger(store, -time.Hour).Purge(ctx)
+	require.NoError(t, err)
+
+	trash, err = service.ListTrash(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+}
@@ -0,0 +1,485 @@
+package todo
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+// maxUndoSteps is the maximum number of operations undone (or redone) at once.
+const maxUndoSteps = 50
+
+// Operation is a change of items made by a single service call that can be undone.
+type Operation struct {
+	ID string
+
+	// Actor is the user who made the change.
+	Actor string
+
+	// Name is the name of the service method that changed the items (eg. UpdateItem).
+	Name string
+
+	// Changes lists the items changed by the operation.
+	Changes []ItemChange
+
+	// Undone tells whether the operation has been undone (and can be redone).
+	Undone bool
+
+	CreatedAt time.Time
+}
+
+// ItemIDs returns the IDs of the items changed by the operation.
+func (o Operation) ItemIDs() []string {
+	ids := make([]string, 0, len(o.Changes))
+
+	for _, change := range o.Changes {
+		ids = append(ids, change.ItemID())
+	}
+
+	return ids
+}
+
+// ItemChange is the state of an item before and after an operation.
+// Before is nil for added items, After is nil for deleted items.
+type ItemChange struct {
+	Before *Item `json:"before"`
+	After  *Item `json:"after"`
+}
+
+// ItemID returns the ID of the changed item.
+func (c ItemChange) ItemID() string {
+	if c.After != nil {
+		return c.After.ID
+	}
+
+	return c.Before.ID
+}
+
+// OperationStore keeps the log of operations that can be undone.
+//
+// Undone operations of an actor always follow the operations that have not been undone:
+// they are discarded once the actor makes a new change.
+type OperationStore interface {
+	// AddOperation stores an operation.
+	AddOperation(ctx context.Context, operation Operation) error
+
+	// GetUndoableOperations returns the last operations of an actor that have not been undone, newest first.
+	GetUndoableOperations(ctx context.Context, actor string, limit int) ([]Operation, error)
+
+	// GetRedoableOperations returns the first undone operations of an actor, oldest first.
+	GetRedoableOperations(ctx context.Context, actor string, limit int) ([]Operation, error)
+
+	// MarkUndone records whether an operation has been undone.
+	MarkUndone(ctx context.Context, id string, undone bool) error
+
+	// DiscardRedoableOperations deletes the undone operations of an actor.
+	DiscardRedoableOperations(ctx context.Context, actor string) error
+}
+
+// ConflictError is returned if an operation cannot be undone (or redone)
+// because an item has been changed since.
+type ConflictError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (ConflictError) Error() string {
+	return "item has been modified since"
+}
+
+// Details returns error details.
+func (e ConflictError) Details() []interface{} {
+	return []interface{}{"item_id", e.ID}
+}
+
+// Conflict tells a client that this error is related to a conflicting change of a resource.
+// Can be used to translate the error to eg. status code.
+func (ConflictError) Conflict() bool {
+	return true
+}
+
+// ServiceError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (ConflictError) ServiceError() bool {
+	return true
+}
+
+func (s service) Undo(ctx context.Context, steps int) ([]Operation, error) {
+	steps, err := undoSteps(steps)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := undoActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var operations []Operation
+
+	err = s.transactor.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		operations, err = s.operationStore.GetUndoableOperations(ctx, actor, steps)
+		if err != nil {
+			return err
+		}
+
+		return s.replayOperations(ctx, operations, true)
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "undo")
+	}
+
+	return operations, nil
+}
+
+func (s service) Redo(ctx context.Context, steps int) ([]Operation, error) {
+	steps, err := undoSteps(steps)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := undoActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var operations []Operation
+
+	err = s.transactor.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		operations, err = s.operationStore.GetRedoableOperations(ctx, actor, steps)
+		if err != nil {
+			return err
+		}
+
+		return s.replayOperations(ctx, operations, false)
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "redo")
+	}
+
+	return operations, nil
+}
+
+// undoActor returns the user whose operations are undone (or redone).
+// Anonymous changes are not recorded, so they cannot be undone either.
+func undoActor(ctx context.Context) (string, error) {
+	actor, ok := principal.FromContext(ctx)
+	if !ok {
+		return "", errors.WithStack(validationError{violations: map[string][]string{
+			"user": {
+				"undoing changes requires an authenticated user",
+			},
+		}})
+	}
+
+	return actor, nil
+}
+
+// undoSteps validates the number of operations to undo (or redo).
+func undoSteps(steps int) (int, error) {
+	if steps == 0 {
+		return 1, nil
+	}
+
+	if steps < 0 || steps > maxUndoSteps {
+		return 0, errors.WithStack(validationError{violations: map[string][]string{
+			"steps": {
+				"steps must be between 1 and 50",
+			},
+		}})
+	}
+
+	return steps, nil
+}
+
+// replayOperations reverts (or reapplies) operations in order.
+// Nothing is changed unless every item is in the state the operations left it in.
+// It should be called in a transaction, so that a failed change leaves nothing changed.
+func (s service) replayOperations(ctx context.Context, operations []Operation, undo bool) error {
+	states := make(map[string]*Item)
+
+	for _, operation := range operations {
+		for _, change := range operation.Changes {
+			from, to := change.Before, change.After
+			if undo {
+				from, to = to, from
+			}
+
+			id := change.ItemID()
+
+			current, ok := states[id]
+			if !ok {
+				var err error
+
+				current, err = s.currentItem(ctx, id)
+				if err != nil {
+					return err
+				}
+			}
+
+			if !sameItem(current, from) {
+				return errors.WithStack(ConflictError{ID: id})
+			}
+
+			states[id] = to
+		}
+	}
+
+	for _, operation := range operations {
+		for _, change := range operation.Changes {
+			to := change.After
+			if undo {
+				to = change.Before
+			}
+
+			err := s.setItem(ctx, change.ItemID(), to)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := s.operationStore.MarkUndone(ctx, operation.ID, undo)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// currentItem returns the current state of an item: nil for items in the trash.
+// Permanently deleted items cannot be changed anymore.
+func (s service) currentItem(ctx context.Context, id string) (*Item, error) {
+	item, err := s.store.GetOne(ctx, id)
+	if err == nil {
+		return &item, nil
+	}
+	if !errors.As(err, &NotFoundError{}) {
+		return nil, err
+	}
+
+	_, err = s.trashStore.GetOneDeleted(ctx, id)
+	if errors.As(err, &NotFoundError{}) {
+		return nil, errors.WithStack(ConflictError{ID: id})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// setItem brings an item to a state: nil moves the item to the trash.
+func (s service) setItem(ctx context.Context, id string, item *Item) error {
+	if item == nil {
+		return s.store.DeleteOne(ctx, id)
+	}
+
+	_, err := s.trashStore.GetOneDeleted(ctx, id)
+	if err == nil {
+		err = s.trashStore.RestoreOne(ctx, id)
+	}
+	if err != nil && !errors.As(err, &NotFoundError{}) {
+		return err
+	}
+
+	return s.store.Store(ctx, *item)
+}
+
+// sameItem tells whether two states of an item are the same.
+func sameItem(a *Item, b *Item) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return reflect.DeepEqual(itemFields(a), itemFields(b))
+}
+
+// OperationLogMiddleware records the operations that can be undone.
+// Operations are recorded in the same transaction as the change (along with the state of the items before it).
+// Events fired by the change are dispatched to events once the transaction is committed.
+func OperationLogMiddleware(idgenerator IDGenerator, store OperationStore, transactor Transactor, events Events) Middleware {
+	return func(next Service) Service {
+		return operationLogMiddleware{
+			Service: DefaultMiddleware{Service: next},
+			next:    next,
+
+			idgenerator: idgenerator,
+			store:       store,
+			transactor:  transactor,
+			events:      events,
+		}
+	}
+}
+
+type operationLogMiddleware struct {
+	Service
+	next Service
+
+	idgenerator IDGenerator
+	store       OperationStore
+	transactor  Transactor
+	events      Events
+}
+
+// transaction runs a change and records the operation in a transaction.
+func (mw operationLogMiddleware) transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return transaction(ctx, mw.transactor, mw.events, fn)
+}
+
+func (mw operationLogMiddleware) AddItem(ctx context.Context, newItem NewItem) (Item, error) {
+	var item Item
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		item, err = mw.next.AddItem(ctx, newItem)
+		if err != nil {
+			return err
+		}
+
+		return mw.record(ctx, "AddItem", []ItemChange{{After: &item}})
+	})
+
+	return item, err
+}
+
+func (mw operationLogMiddleware) UpdateItem(ctx context.Context, id string, itemUpdate ItemUpdate) (Item, error) {
+	return mw.changeItem(ctx, "UpdateItem", id, func(ctx context.Context) (Item, error) {
+		return mw.next.UpdateItem(ctx, id, itemUpdate)
+	})
+}
+
+func (mw operationLogMiddleware) AssignItem(ctx context.Context, id string, assignee string) (Item, error) {
+	return mw.changeItem(ctx, "AssignItem", id, func(ctx context.Context) (Item, error) {
+		return mw.next.AssignItem(ctx, id, assignee)
+	})
+}
+
+func (mw operationLogMiddleware) UnassignItem(ctx context.Context, id string) (Item, error) {
+	return mw.changeItem(ctx, "UnassignItem", id, func(ctx context.Context) (Item, error) {
+		return mw.next.UnassignItem(ctx, id)
+	})
+}
+
+// changeItem records an operation changing a single item.
+func (mw operationLogMiddleware) changeItem(
+	ctx context.Context,
+	name string,
+	id string,
+	change func(ctx context.Context) (Item, error),
+) (Item, error) {
+	var item Item
+
+	err := mw.transaction(ctx, func(ctx context.Context) error {
+		before, err := mw.next.GetItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		item, err = change(ctx)
+		if err != nil {
+			return err
+		}
+
+		return mw.record(ctx, name, []ItemChange{{Before: &before, After: &item}})
+	})
+
+	return item, err
+}
+
+func (mw operationLogMiddleware) DeleteItems(ctx context.Context, listID string) error {
+	return mw.transaction(ctx, func(ctx context.Context) error {
+		items, err := mw.next.ListItems(ctx, ItemFilter{ListID: listID})
+		if err != nil {
+			return err
+		}
+
+		err = mw.next.DeleteItems(ctx, listID)
+		if err != nil {
+			return err
+		}
+
+		return mw.record(ctx, "DeleteItems", deletedItemChanges(items))
+	})
+}
+
+func (mw operationLogMiddleware) DeleteItem(ctx context.Context, id string, cascade bool) error {
+	return mw.transaction(ctx, func(ctx context.Context) error {
+		before, err := mw.next.GetItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		items := []Item{before}
+
+		if cascade {
+			trees, err := mw.next.ListItemTree(ctx, ItemFilter{ListID: before.ListID, ParentID: id}, 0)
+			if err != nil {
+				return err
+			}
+
+			items = append(items, flattenItemTrees(trees)...)
+		}
+
+		err = mw.next.DeleteItem(ctx, id, cascade)
+		if err != nil {
+			return err
+		}
+
+		return mw.record(ctx, "DeleteItem", deletedItemChanges(items))
+	})
+}
+
+// record stores an operation and discards the operations undone before it.
+// Changes of anonymous users are not recorded: they cannot be undone.
+func (mw operationLogMiddleware) record(ctx context.Context, name string, changes []ItemChange) error {
+	actor, ok := principal.FromContext(ctx)
+	if !ok || len(changes) == 0 {
+		return nil
+	}
+
+	id, err := mw.idgenerator.Generate()
+	if err != nil {
+		return errors.WithMessage(err, "record operation")
+	}
+
+	err = mw.store.DiscardRedoableOperations(ctx, actor)
+	if err != nil {
+		return errors.WithMessage(err, "record operation")
+	}
+
+	operation := Operation{
+		ID:        id,
+		Actor:     actor,
+		Name:      name,
+		Changes:   changes,
+		CreatedAt: time.Now(),
+	}
+
+	err = mw.store.AddOperation(ctx, operation)
+	if err != nil {
+		return errors.WithMessage(err, "record operation")
+	}
+
+	return nil
+}
+
+// deletedItemChanges returns the changes of items moved to the trash.
+func deletedItemChanges(items []Item) []ItemChange {
+	changes := make([]ItemChange, 0, len(items))
+
+	for i := range items {
+		changes = append(changes, ItemChange{Before: &items[i]})
+	}
+
+	return changes
+}
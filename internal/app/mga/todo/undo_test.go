@@ -0,0 +1,272 @@
+package todo_test
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+	"github.com/sagikazarmark/modern-go-application/internal/platform/principal"
+)
+
+func TestService_Undo(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _ := newTestService(testServiceConfig{})
+
+	parent, err := service.AddItem(ctx, NewItem{Title: "Clean the house"})
+	require.NoError(t, err)
+
+	subtask, err := service.AddItem(ctx, NewItem{Title: "Vacuum", ParentID: parent.ID})
+	require.NoError(t, err)
+
+	completed := true
+
+	_, err = service.UpdateItem(ctx, subtask.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	err = service.DeleteItem(ctx, parent.ID, true)
+	require.NoError(t, err)
+
+	operations, err := service.Undo(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+	assert.Equal(t, "DeleteItem", operations[0].Name)
+	assert.Equal(t, []string{parent.ID, subtask.ID}, operations[0].ItemIDs())
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{parent.ID, subtask.ID}, itemIDs(items), "subtasks are restored with their parent")
+
+	operations, err = service.Undo(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+	assert.Equal(t, "UpdateItem", operations[0].Name)
+
+	item, err := service.GetItem(ctx, subtask.ID)
+	require.NoError(t, err)
+	assert.False(t, item.Completed)
+
+	operations, err = service.Redo(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, operations, 2)
+	assert.Equal(t, "UpdateItem", operations[0].Name, "operations are redone in order")
+	assert.Equal(t, "DeleteItem", operations[1].Name)
+
+	items, err = service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	operations, err = service.Undo(ctx, 4)
+	require.NoError(t, err)
+	assert.Len(t, operations, 4)
+
+	items, err = service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items, "added items are moved to the trash")
+
+	operations, err = service.Undo(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, operations)
+}
+
+func TestService_Undo_NewChangeDiscardsRedo(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	_, err = service.Undo(ctx, 1)
+	require.NoError(t, err)
+
+	_, err = service.AddItem(ctx, NewItem{Title: "Buy bread"})
+	require.NoError(t, err)
+
+	operations, err := service.Redo(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, operations)
+}
+
+func TestService_Undo_Conflict(t *testing.T) {
+	john := principal.ToContext(context.Background(), "john")
+	jane := principal.ToContext(context.Background(), "jane")
+	service, _ := newTestService(testServiceConfig{})
+
+	item, err := service.AddItem(john, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	completed := true
+
+	_, err = service.UpdateItem(john, item.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	title := "Buy oat milk"
+
+	_, err = service.UpdateItem(jane, item.ID, ItemUpdate{Title: &title})
+	require.NoError(t, err)
+
+	_, err = service.Undo(john, 1)
+	require.Error(t, err)
+
+	var conflictErr ConflictError
+
+	require.True(t, errors.As(err, &conflictErr))
+	assert.Equal(t, item.ID, conflictErr.ID)
+	assert.True(t, conflictErr.Conflict())
+
+	item, err = service.GetItem(john, item.ID)
+	require.NoError(t, err)
+	assert.True(t, item.Completed, "nothing is changed when undo fails")
+
+	operations, err := service.Undo(jane, 1)
+	require.NoError(t, err)
+	require.Len(t, operations, 1, "users undo their own changes")
+
+	operations, err = service.Undo(john, 1)
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+
+	item, err = service.GetItem(john, item.ID)
+	require.NoError(t, err)
+	assert.False(t, item.Completed)
+}
+
+func TestService_Undo_Events(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	events := &recordingEvents{}
+	service, _ := newTestService(testServiceConfig{Events: events})
+
+	item, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	completed := true
+
+	_, err = service.UpdateItem(ctx, item.ID, ItemUpdate{Completed: &completed})
+	require.NoError(t, err)
+
+	operations, err := service.Undo(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+
+	require.Len(t, events.undone, 1)
+	assert.Equal(t, OperationUndone{ID: operations[0].ID, Operation: "UpdateItem", ItemIDs: []string{item.ID}}, events.undone[0])
+	assert.Len(t, events.completed, 1)
+
+	_, err = service.Redo(ctx, 1)
+	require.NoError(t, err)
+
+	require.Len(t, events.redone, 1)
+	assert.Equal(t, OperationRedone{ID: operations[0].ID, Operation: "UpdateItem", ItemIDs: []string{item.ID}}, events.redone[0])
+	assert.Len(t, events.completed, 2, "redoing a completion marks the item as complete")
+
+	// Undoing an addition moves the item to the trash, redoing it brings it back
+	events.added, events.updated, events.deleted = nil, nil, nil
+
+	err = service.DeleteItem(ctx, item.ID, false)
+	require.NoError(t, err)
+
+	_, err = service.Undo(ctx, 1)
+	require.NoError(t, err)
+
+	_, err = service.Redo(ctx, 1)
+	require.NoError(t, err)
+
+	event := ItemDeleted{ID: item.ID, ListID: DefaultListID}
+
+	assert.Equal(t, []ItemDeleted{event, event}, events.deleted)
+	assert.Equal(t, []ItemAdded{{ID: item.ID, ListID: DefaultListID}}, events.added)
+	assert.Empty(t, events.updated)
+}
+
+func TestService_Undo_InvalidSteps(t *testing.T) {
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.Undo(context.Background(), -1)
+	assert.True(t, isValidationError(err))
+
+	_, err = service.Redo(context.Background(), 51)
+	assert.True(t, isValidationError(err))
+}
+
+func TestService_Undo_Anonymous(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(testServiceConfig{})
+
+	_, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.NoError(t, err)
+
+	_, err = service.Undo(ctx, 1)
+	assert.True(t, isValidationError(err))
+
+	_, err = service.Redo(ctx, 1)
+	assert.True(t, isValidationError(err))
+
+	operations, err := service.Undo(principal.ToContext(ctx, "john"), 1)
+	require.NoError(t, err)
+	assert.Empty(t, operations, "anonymous changes are not recorded")
+}
+
+type failingOperationStore struct {
+	*InMemoryStore
+}
+
+func (failingOperationStore) MarkUndone(_ context.Context, _ string, _ bool) error {
+	return errors.New("operation log is unavailable")
+}
+
+func TestService_Undo_Rollback(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, _ := newTestService(testServiceConfig{
+		Store: func(store *InMemoryStore) ServiceStore { return failingOperationStore{store} },
+	})
+
+	for _, title := range []string{"Buy milk", "Buy bread"} {
+		_, err := service.AddItem(ctx, NewItem{Title: title})
+		require.NoError(t, err)
+	}
+
+	err := service.DeleteItems(ctx, "")
+	require.NoError(t, err)
+
+	_, err = service.Undo(ctx, 1)
+	require.EqualError(t, err, "undo: operation log is unavailable")
+
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, items, "nothing is changed when undo fails")
+}
+
+type unrecordedOperationStore struct {
+	*InMemoryStore
+}
+
+func (unrecordedOperationStore) AddOperation(_ context.Context, _ Operation) error {
+	return errors.New("operation log is unavailable")
+}
+
+func TestOperationLogMiddleware_RecordFails(t *testing.T) {
+	ctx := principal.ToContext(context.Background(), "john")
+	service, store := newTestService(testServiceConfig{
+		Store: func(store *InMemoryStore) ServiceStore { return unrecordedOperationStore{store} },
+	})
+
+	_, err := service.AddItem(ctx, NewItem{Title: "Buy milk"})
+	require.EqualError(t, err, "record operation: operation log is unavailable")
+
+	err = store.Store(ctx, Item{ID: "01", ListID: DefaultListID, Title: "Buy bread"})
+	require.NoError(t, err)
+
+	title := "Buy rye bread"
+
+	_, err = service.UpdateItem(ctx, "01", ItemUpdate{Title: &title})
+	require.EqualError(t, err, "record operation: operation log is unavailable")
+
+	// Changes are not kept without an operation to undo them
+	items, err := service.ListItems(ctx, ItemFilter{})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Buy bread", items[0].Title)
+}
@@ -8,13 +8,23 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
-	todov1 "github.com/sagikazarmark/todobackend-go-kit/api/todo/v1"
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
 )
 
 type createOptions struct {
-	title  string
-	client todov1.TodoListServiceClient
+	title        string
+	notes        string
+	list         string
+	parent       string
+	autoComplete bool
+	due          string
+	repeat       string
+	timezone     string
+	priority     string
+	assignee     string
+	client       todov1.TodoListServiceClient
 }
 
 // NewAddCommand creates a new cobra.Command for adding a new item to the list.
@@ -37,12 +47,54 @@ func NewAddCommand(c Context) *cobra.Command {
 		},
 	}
 
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.notes, "notes", "", "Detailed description of the item (in Markdown)")
+	flags.StringVar(&options.list, "list", "", "ID of the list to add the item to (defaults to the default list)")
+	flags.StringVar(&options.parent, "parent", "", "ID of the item to add the item to as a subtask")
+	flags.BoolVar(&options.autoComplete, "auto-complete", false, "Complete the item once all of its subtasks are complete")
+	flags.StringVar(&options.due, "due", "", `Due date (RFC3339 or relative, eg. "tomorrow 9am", "+2h")`)
+	flags.StringVar(&options.repeat, "repeat", "", `Repeat the item by an iCalendar RRULE (eg. "FREQ=WEEKLY;BYDAY=MO,WE")`)
+	flags.StringVar(&options.timezone, "timezone", "", "IANA timezone of the repeat rule (defaults to UTC)")
+	flags.StringVar(&options.priority, "priority", "", "Priority of the item (none, low, medium, high or urgent)")
+	flags.StringVar(&options.assignee, "assignee", "", "ID of the user to assign the item to")
+
 	return cmd
 }
 
 func runCreate(options createOptions) error {
 	req := &todov1.AddItemRequest{
-		Title: options.title,
+		Title:        options.title,
+		Notes:        options.notes,
+		ListId:       options.list,
+		ParentId:     options.parent,
+		AutoComplete: options.autoComplete,
+		Assignee:     options.assignee,
+	}
+
+	if options.due != "" {
+		dueAt, err := parseDueTime(options.due, time.Now())
+		if err != nil {
+			return err
+		}
+
+		req.DueAt = timestamppb.New(dueAt)
+	}
+
+	if options.priority != "" {
+		priority, err := parsePriority(options.priority)
+		if err != nil {
+			return err
+		}
+
+		req.Priority = priority
+	}
+
+	if options.repeat != "" {
+		req.Recurrence = &todov1.Recurrence{
+			Rule:     options.repeat,
+			Timezone: options.timezone,
+		}
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
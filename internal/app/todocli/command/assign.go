@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type assignOptions struct {
+	todoID   string
+	assignee string
+	client   todov1.TodoListServiceClient
+}
+
+// NewAssignCommand creates a new cobra.Command for assigning a todo item to a user.
+func NewAssignCommand(c Context) *cobra.Command {
+	options := assignOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "assign ID USER",
+		Short: "Assign a todo item to a user (or reassign it)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.todoID = args[0]
+			options.assignee = args[1]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runAssign(options)
+		},
+	}
+
+	return cmd
+}
+
+func runAssign(options assignOptions) error {
+	req := &todov1.AssignItemRequest{
+		Id:       options.todoID,
+		Assignee: options.assignee,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.AssignItem(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Todo item with ID %s is assigned to %s", options.todoID, assigneeName(resp.GetItem()))
+
+	return nil
+}
+
+// NewUnassignCommand creates a new cobra.Command for removing the assignee of a todo item.
+func NewUnassignCommand(c Context) *cobra.Command {
+	options := assignOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "unassign ID",
+		Short: "Remove the assignee of a todo item",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.todoID = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runUnassign(options)
+		},
+	}
+
+	return cmd
+}
+
+func runUnassign(options assignOptions) error {
+	req := &todov1.UnassignItemRequest{
+		Id: options.todoID,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.UnassignItem(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Todo item with ID %s is assigned to %s", options.todoID, assigneeName(resp.GetItem()))
+
+	return nil
+}
+
+func assigneeName(item *todov1.TodoItem) string {
+	if item.GetAssignee() == "" {
+		return "nobody"
+	}
+
+	return item.GetAssignee()
+}
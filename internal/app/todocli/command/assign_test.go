@@ -0,0 +1,14 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestAssigneeName(t *testing.T) {
+	assert.Equal(t, "john", assigneeName(&todov1.TodoItem{Assignee: "john"}))
+	assert.Equal(t, "nobody", assigneeName(&todov1.TodoItem{}))
+}
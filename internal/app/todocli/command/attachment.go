@@ -0,0 +1,204 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type attachmentOptions struct {
+	id     string
+	file   string
+	client todov1.TodoListServiceClient
+}
+
+// NewAttachmentCommand creates a new cobra.Command for managing the attachments of todo items.
+func NewAttachmentCommand(c Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "attachment",
+		Aliases: []string{"attach"},
+		Short:   "Manage the files attached to todo items",
+	}
+
+	cmd.AddCommand(
+		newAttachmentAddCommand(c),
+		newAttachmentListCommand(c),
+		newAttachmentDeleteCommand(c),
+	)
+
+	return cmd
+}
+
+func newAttachmentAddCommand(c Context) *cobra.Command {
+	options := attachmentOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add ID FILE",
+		Short: "Attach a file to a todo item",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.file = args[1]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runAttachmentAdd(options)
+		},
+	}
+
+	return cmd
+}
+
+func runAttachmentAdd(options attachmentOptions) error {
+	content, err := os.ReadFile(options.file)
+	if err != nil {
+		return err
+	}
+
+	req := &todov1.AddAttachmentRequest{
+		ItemId:      options.id,
+		Name:        filepath.Base(options.file),
+		ContentType: mime.TypeByExtension(filepath.Ext(options.file)),
+		Content:     content,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := options.client.AddAttachment(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("File %s has been attached to todo item with ID %s.", resp.GetAttachment().GetName(), options.id)
+
+	return nil
+}
+
+func newAttachmentListCommand(c Context) *cobra.Command {
+	options := attachmentOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list ID",
+		Aliases: []string{"ls"},
+		Short:   "List the attachments of a todo item",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runAttachmentList(options)
+		},
+	}
+
+	return cmd
+}
+
+func runAttachmentList(options attachmentOptions) error {
+	req := &todov1.ListAttachmentsRequest{
+		ItemId: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ListAttachments(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Name", "Type", "Size", "Download"})
+	table.AppendBulk(attachmentRows(resp.GetAttachments()))
+	table.Render()
+
+	return nil
+}
+
+// attachmentRows renders attachments with human readable sizes.
+func attachmentRows(attachments []*todov1.Attachment) [][]string {
+	rows := make([][]string, 0, len(attachments))
+
+	for _, attachment := range attachments {
+		rows = append(rows, []string{
+			attachment.GetId(),
+			attachment.GetName(),
+			attachment.GetContentType(),
+			formatSize(attachment.GetSize()),
+			attachment.GetUrl(),
+		})
+	}
+
+	return rows
+}
+
+// formatSize formats a size in bytes for humans.
+func formatSize(size int64) string {
+	const unit = 1024
+
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func newAttachmentDeleteCommand(c Context) *cobra.Command {
+	options := attachmentOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete ATTACHMENT_ID",
+		Aliases: []string{"rm"},
+		Short:   "Delete an attachment",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runAttachmentDelete(options)
+		},
+	}
+
+	return cmd
+}
+
+func runAttachmentDelete(options attachmentOptions) error {
+	req := &todov1.DeleteAttachmentRequest{
+		Id: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := options.client.DeleteAttachment(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Attachment with ID %s has been deleted.", options.id)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestAttachmentRows(t *testing.T) {
+	attachments := []*todov1.Attachment{
+		{
+			Id:          "1",
+			Name:        "bills.txt",
+			ContentType: "text/plain",
+			Size:        512,
+			Url:         "/attachments/1/download?expires=1&signature=abc",
+		},
+		{
+			Id:          "2",
+			Name:        "receipt.png",
+			ContentType: "image/png",
+			Size:        3 << 19,
+		},
+	}
+
+	rows := attachmentRows(attachments)
+
+	assert.Len(t, rows, 2)
+	assert.Equal(t, []string{"1", "bills.txt", "text/plain", "512 B", "/attachments/1/download?expires=1&signature=abc"}, rows[0])
+	assert.Equal(t, "1.5 MiB", rows[1][3])
+}
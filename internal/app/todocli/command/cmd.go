@@ -3,7 +3,7 @@ package command
 import (
 	"github.com/spf13/cobra"
 
-	todov1 "github.com/sagikazarmark/todobackend-go-kit/api/todo/v1"
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
 )
 
 // Context represents the application context.
@@ -15,7 +15,26 @@ type Context interface {
 func AddCommands(cmd *cobra.Command, c Context) {
 	cmd.AddCommand(
 		NewAddCommand(c),
+		NewAssignCommand(c),
+		NewAttachmentCommand(c),
+		NewCommentCommand(c),
+		NewExportCommand(c),
+		NewHistoryCommand(c),
+		NewImportCommand(c),
 		NewListCommand(c),
 		NewMarkAsCompleteCommand(c),
+		NewMoveCommand(c),
+		NewRedoCommand(c),
+		NewRestoreCommand(c),
+		NewRuleCommand(c),
+		NewSearchCommand(c),
+		NewShareCommand(c),
+		NewTagCommand(c),
+		NewTemplateCommand(c),
+		NewTimerCommand(c),
+		NewTrashCommand(c),
+		NewUnassignCommand(c),
+		NewUndoCommand(c),
+		NewUntagCommand(c),
 	)
 }
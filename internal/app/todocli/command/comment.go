@@ -0,0 +1,229 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type commentOptions struct {
+	id     string
+	body   string
+	client todov1.TodoListServiceClient
+}
+
+// NewCommentCommand creates a new cobra.Command for managing the comments of todo items.
+func NewCommentCommand(c Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comment",
+		Short: "Manage the comments of todo items",
+	}
+
+	cmd.AddCommand(
+		newCommentAddCommand(c),
+		newCommentListCommand(c),
+		newCommentEditCommand(c),
+		newCommentDeleteCommand(c),
+	)
+
+	return cmd
+}
+
+func newCommentAddCommand(c Context) *cobra.Command {
+	options := commentOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add ID TEXT",
+		Short: "Comment on a todo item (mention users with @name)",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.body = strings.Join(args[1:], " ")
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runCommentAdd(options)
+		},
+	}
+
+	return cmd
+}
+
+func runCommentAdd(options commentOptions) error {
+	req := &todov1.AddCommentRequest{
+		ItemId: options.id,
+		Body:   options.body,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.AddComment(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Comment with ID %s has been added to todo item with ID %s.", resp.GetComment().GetId(), options.id)
+
+	return nil
+}
+
+func newCommentListCommand(c Context) *cobra.Command {
+	options := commentOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list ID",
+		Aliases: []string{"ls"},
+		Short:   "List the comments of a todo item",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runCommentList(options)
+		},
+	}
+
+	return cmd
+}
+
+func runCommentList(options commentOptions) error {
+	req := &todov1.ListCommentsRequest{
+		ItemId: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ListComments(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Time", "Author", "Comment"})
+	table.AppendBulk(commentRows(resp.GetComments()))
+	table.Render()
+
+	return nil
+}
+
+// commentRows renders comments with edited comments marked as such.
+func commentRows(comments []*todov1.Comment) [][]string {
+	rows := make([][]string, 0, len(comments))
+
+	for _, comment := range comments {
+		body := comment.GetBody()
+
+		if comment.GetUpdatedAt() != nil {
+			body += " (edited)"
+		}
+
+		author := comment.GetAuthor()
+		if author == "" {
+			author = "(anonymous)"
+		}
+
+		rows = append(rows, []string{
+			comment.GetId(),
+			comment.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+			author,
+			body,
+		})
+	}
+
+	return rows
+}
+
+func newCommentEditCommand(c Context) *cobra.Command {
+	options := commentOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "edit COMMENT_ID TEXT",
+		Short: "Replace the text of a comment",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.body = strings.Join(args[1:], " ")
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runCommentEdit(options)
+		},
+	}
+
+	return cmd
+}
+
+func runCommentEdit(options commentOptions) error {
+	req := &todov1.UpdateCommentRequest{
+		Id:   options.id,
+		Body: options.body,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := options.client.UpdateComment(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Comment with ID %s has been edited.", options.id)
+
+	return nil
+}
+
+func newCommentDeleteCommand(c Context) *cobra.Command {
+	options := commentOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete COMMENT_ID",
+		Aliases: []string{"rm"},
+		Short:   "Delete a comment",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runCommentDelete(options)
+		},
+	}
+
+	return cmd
+}
+
+func runCommentDelete(options commentOptions) error {
+	req := &todov1.DeleteCommentRequest{
+		Id: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := options.client.DeleteComment(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Comment with ID %s has been deleted.", options.id)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestCommentRows(t *testing.T) {
+	comments := []*todov1.Comment{
+		{
+			Id:        "1",
+			Author:    "john",
+			Body:      "Which shop?",
+			CreatedAt: timestamppb.Now(),
+			UpdatedAt: timestamppb.Now(),
+		},
+		{
+			Id:        "2",
+			Body:      "The one around the corner",
+			CreatedAt: timestamppb.Now(),
+		},
+	}
+
+	rows := commentRows(comments)
+
+	assert.Len(t, rows, 2)
+	assert.Equal(t, []string{"john", "Which shop? (edited)"}, rows[0][2:])
+	assert.Equal(t, []string{"(anonymous)", "The one around the corner"}, rows[1][2:])
+}
@@ -3,30 +3,43 @@ package command
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
+	"emperror.dev/errors"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
-	todov1 "github.com/sagikazarmark/todobackend-go-kit/api/todo/v1"
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
 )
 
 type markAsCompleteOptions struct {
-	todoID string
-	client todov1.TodoListServiceClient
+	todoIDs    []string
+	all        bool
+	list       string
+	bestEffort bool
+	client     todov1.TodoListServiceClient
 }
 
-// NewMarkAsCompleteCommand creates a new cobra.Command for marking a todo item as complete.
+// NewMarkAsCompleteCommand creates a new cobra.Command for marking todo items as complete.
 func NewMarkAsCompleteCommand(c Context) *cobra.Command {
 	options := markAsCompleteOptions{}
 
 	cmd := &cobra.Command{
-		Use:     "complete",
+		Use:     "complete [ID...]",
 		Aliases: []string{"c"},
-		Short:   "Mark a todo item as complete",
-		Args:    cobra.ExactArgs(1),
+		Short:   "Mark todo items as complete",
+		Args: func(_ *cobra.Command, args []string) error {
+			if options.all == (len(args) > 0) {
+				return errors.New("either pass todo item IDs or --all")
+			}
+
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			options.todoID = args[0]
+			options.todoIDs = args
 			options.client = c.GetTodoClient()
 
 			cmd.SilenceErrors = true
@@ -36,26 +49,109 @@ func NewMarkAsCompleteCommand(c Context) *cobra.Command {
 		},
 	}
 
+	flags := cmd.Flags()
+
+	flags.BoolVar(&options.all, "all", false, "Mark every incomplete item of the list as complete")
+	flags.StringVar(&options.list, "list", "", "ID of the list to complete with --all (defaults to the default list)")
+	flags.BoolVar(&options.bestEffort, "best-effort", false, "Complete the rest of the items when some of them fail")
+
 	return cmd
 }
 
 func runMarkAsComplete(options markAsCompleteOptions) error {
-	req := &todov1.UpdateItemRequest{
-		Id: options.todoID,
-		Completed: &wrappers.BoolValue{
-			Value: true,
-		},
-	}
-
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
-	_, err := options.client.UpdateItem(ctx, req)
+	if len(options.todoIDs) == 1 {
+		req := &todov1.UpdateItemRequest{
+			Id: options.todoIDs[0],
+			Completed: &wrappers.BoolValue{
+				Value: true,
+			},
+		}
+
+		_, err := options.client.UpdateItem(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Todo item with ID %s has been marked as complete.", options.todoIDs[0])
+
+		return nil
+	}
+
+	ids := options.todoIDs
+
+	if options.all {
+		resp, err := options.client.ListItems(ctx, &todov1.ListItemsRequest{ListId: options.list})
+		if err != nil {
+			return err
+		}
+
+		ids = incompleteItemIDs(resp.GetItems())
+
+		if len(ids) == 0 {
+			fmt.Println("There are no incomplete todo items.")
+
+			return nil
+		}
+	}
+
+	req := &todov1.BulkUpdateItemsRequest{
+		Mode: todov1.BulkMode_BULK_MODE_ATOMIC,
+	}
+
+	if options.bestEffort {
+		req.Mode = todov1.BulkMode_BULK_MODE_BEST_EFFORT
+	}
+
+	for _, id := range ids {
+		req.Items = append(req.Items, &todov1.BulkItemUpdate{
+			Update: &todov1.UpdateItemRequest{
+				Id:        id,
+				Completed: &wrappers.BoolValue{Value: true},
+			},
+		})
+	}
+
+	resp, err := options.client.BulkUpdateItems(ctx, req)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Todo item with ID %s has been marked as complete.", options.todoID)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Title", "Status", "Error"})
+	table.AppendBulk(bulkResultRows(resp.GetResults()))
+	table.Render()
 
 	return nil
 }
+
+// incompleteItemIDs returns the IDs of items that are not complete yet.
+func incompleteItemIDs(items []*todov1.TodoItem) []string {
+	var ids []string
+
+	for _, item := range items {
+		if !item.GetCompleted() {
+			ids = append(ids, item.GetId())
+		}
+	}
+
+	return ids
+}
+
+// bulkResultRows renders the outcome of a bulk operation.
+func bulkResultRows(results []*todov1.BulkResult) [][]string {
+	rows := make([][]string, 0, len(results))
+
+	for _, result := range results {
+		rows = append(rows, []string{
+			result.GetId(),
+			result.GetItem().GetTitle(),
+			strings.ToLower(strings.TrimPrefix(result.GetStatus().String(), "BULK_STATUS_")),
+			result.GetErrorMessage(),
+		})
+	}
+
+	return rows
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestIncompleteItemIDs(t *testing.T) {
+	items := []*todov1.TodoItem{
+		{Id: "1", Completed: true},
+		{Id: "2"},
+		{Id: "3"},
+	}
+
+	assert.Equal(t, []string{"2", "3"}, incompleteItemIDs(items))
+	assert.Empty(t, incompleteItemIDs(items[:1]))
+}
+
+func TestBulkResultRows(t *testing.T) {
+	results := []*todov1.BulkResult{
+		{
+			Id:     "1",
+			Status: todov1.BulkStatus_BULK_STATUS_SUCCEEDED,
+			Item:   &todov1.TodoItem{Id: "1", Title: "Buy milk"},
+		},
+		{
+			Id:           "2",
+			Status:       todov1.BulkStatus_BULK_STATUS_FAILED,
+			ErrorMessage: "todo item not found",
+		},
+		{
+			Id:     "3",
+			Status: todov1.BulkStatus_BULK_STATUS_ABORTED,
+		},
+	}
+
+	assert.Equal(
+		t,
+		[][]string{
+			{"1", "Buy milk", "succeeded", ""},
+			{"2", "", "failed", "todo item not found"},
+			{"3", "", "aborted", ""},
+		},
+		bulkResultRows(results),
+	)
+}
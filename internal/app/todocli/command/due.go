@@ -0,0 +1,152 @@
+package command
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// nolint: gochecknoglobals
+var (
+	relativeDurationPattern = regexp.MustCompile(`^\+((?:\d+[wdhm])+)$`)
+	durationPartPattern     = regexp.MustCompile(`(\d+)([wdhm])`)
+	timeOfDayPattern        = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(am|pm)?$`)
+)
+
+// parseDueTime parses a due date relative to now.
+//
+// Accepted formats:
+//   - RFC3339 (eg. 2021-01-02T15:04:05Z)
+//   - durations relative to now (eg. +2h, +1d12h, +1w)
+//   - a day with an optional time of day (eg. today 5pm, tomorrow 9am, friday 17:30)
+//   - a time of day (eg. 9am, 17:30) which refers to its next occurrence
+//
+// A day without a time of day refers to the end of that day.
+func parseDueTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	value = strings.ToLower(value)
+
+	if m := relativeDurationPattern.FindStringSubmatch(value); m != nil {
+		return now.Add(sumDurationParts(m[1])), nil
+	}
+
+	day, timeOfDay := value, ""
+	if i := strings.IndexByte(value, ' '); i >= 0 {
+		day, timeOfDay = value[:i], strings.TrimSpace(value[i+1:])
+	}
+
+	date, ok := parseDay(day, now)
+	if !ok {
+		// The value might be a time of day on its own
+		hour, minute, err := parseTimeOfDay(value)
+		if err != nil {
+			return time.Time{}, errors.NewWithDetails("invalid due date", "value", value)
+		}
+
+		t := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, now.Location())
+		if !t.After(now) {
+			t = t.AddDate(0, 0, 1)
+		}
+
+		return t, nil
+	}
+
+	if timeOfDay == "" {
+		return time.Date(date.Year(), date.Month(), date.Day(), 23, 59, 59, 0, now.Location()), nil
+	}
+
+	hour, minute, err := parseTimeOfDay(timeOfDay)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, now.Location()), nil
+}
+
+// sumDurationParts adds up the weeks, days, hours and minutes of a duration (eg. 1d12h).
+func sumDurationParts(value string) time.Duration {
+	var d time.Duration
+
+	for _, part := range durationPartPattern.FindAllStringSubmatch(value, -1) {
+		n, _ := strconv.Atoi(part[1])
+
+		d += time.Duration(n) * map[string]time.Duration{
+			"w": 7 * 24 * time.Hour,
+			"d": 24 * time.Hour,
+			"h": time.Hour,
+			"m": time.Minute,
+		}[part[2]]
+	}
+
+	return d
+}
+
+// parseDay parses a day name relative to now.
+// Weekdays refer to their next occurrence after today.
+func parseDay(day string, now time.Time) (time.Time, bool) {
+	switch day {
+	case "today":
+		return now, true
+
+	case "tomorrow":
+		return now.AddDate(0, 0, 1), true
+	}
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		name := strings.ToLower(weekday.String())
+
+		if day == name || day == name[:3] {
+			days := (int(weekday) - int(now.Weekday()) + 7) % 7
+			if days == 0 {
+				days = 7
+			}
+
+			return now.AddDate(0, 0, days), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseTimeOfDay parses a time of day in 12-hour (9am, 5:30pm) or 24-hour (17:30) format.
+func parseTimeOfDay(value string) (int, int, error) {
+	m := timeOfDayPattern.FindStringSubmatch(value)
+	if m == nil {
+		return 0, 0, errors.NewWithDetails("invalid time of day", "value", value)
+	}
+
+	hour, _ := strconv.Atoi(m[1])
+	minute, _ := strconv.Atoi(m[2])
+
+	switch m[3] {
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return 0, 0, errors.NewWithDetails("invalid time of day", "value", value)
+		}
+
+		hour %= 12
+		if m[3] == "pm" {
+			hour += 12
+		}
+
+	case "":
+		// A plain number is ambiguous without minutes
+		if m[2] == "" {
+			return 0, 0, errors.NewWithDetails("invalid time of day", "value", value)
+		}
+	}
+
+	if hour > 23 || minute > 59 {
+		return 0, 0, errors.NewWithDetails("invalid time of day", "value", value)
+	}
+
+	return hour, minute, nil
+}
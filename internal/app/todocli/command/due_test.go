@@ -0,0 +1,51 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDueTime(t *testing.T) {
+	loc := time.FixedZone("CET", 3600)
+
+	// Wednesday
+	now := time.Date(2021, time.March, 3, 14, 30, 0, 0, loc)
+
+	tests := map[string]time.Time{
+		"2021-03-10T08:00:00Z": time.Date(2021, time.March, 10, 8, 0, 0, 0, time.UTC),
+		"+2h":                  now.Add(2 * time.Hour),
+		"+1d12h":               now.Add(36 * time.Hour),
+		"+1w":                  now.AddDate(0, 0, 7),
+		"+45m":                 now.Add(45 * time.Minute),
+		"today":                time.Date(2021, time.March, 3, 23, 59, 59, 0, loc),
+		"today 5pm":            time.Date(2021, time.March, 3, 17, 0, 0, 0, loc),
+		"tomorrow 9am":         time.Date(2021, time.March, 4, 9, 0, 0, 0, loc),
+		"Tomorrow 12am":        time.Date(2021, time.March, 4, 0, 0, 0, 0, loc),
+		"tomorrow 17:30":       time.Date(2021, time.March, 4, 17, 30, 0, 0, loc),
+		"friday 9:15am":        time.Date(2021, time.March, 5, 9, 15, 0, 0, loc),
+		"wed":                  time.Date(2021, time.March, 10, 23, 59, 59, 0, loc),
+		"3pm":                  time.Date(2021, time.March, 3, 15, 0, 0, 0, loc),
+		"9am":                  time.Date(2021, time.March, 4, 9, 0, 0, 0, loc),
+	}
+
+	for value, expected := range tests {
+		value, expected := value, expected
+
+		t.Run(value, func(t *testing.T) {
+			due, err := parseDueTime(value, now)
+			require.NoError(t, err)
+
+			assert.True(t, expected.Equal(due), "expected %s, got %s", expected, due)
+		})
+	}
+}
+
+func TestParseDueTime_Invalid(t *testing.T) {
+	for _, value := range []string{"", "+2", "+2y", "yesterday", "tomorrow 25:00", "13pm", "tomorrow 9", "someday"} {
+		_, err := parseDueTime(value, time.Now())
+		assert.Error(t, err, value)
+	}
+}
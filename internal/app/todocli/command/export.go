@@ -0,0 +1,241 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+// Files are moved as a whole, so they get more time than other requests.
+const transferTimeout = 30 * time.Second
+
+type exportOptions struct {
+	list   string
+	format string
+	output string
+	client todov1.TodoListServiceClient
+}
+
+// NewExportCommand creates a new cobra.Command for exporting the items of a list.
+func NewExportCommand(c Context) *cobra.Command {
+	options := exportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the todo items of a list",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runExport(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.list, "list", "", "ID of the list to export (defaults to the default list)")
+	flags.StringVar(&options.format, "format", "", "Format of the export: json, csv, todotxt or ical (defaults to the extension of the output)")
+	flags.StringVarP(&options.output, "output", "o", "", "File to write the export to (defaults to the standard output)")
+
+	return cmd
+}
+
+func runExport(options exportOptions) error {
+	format, err := parseItemFormat(options.format, options.output)
+	if err != nil {
+		return err
+	}
+
+	req := &todov1.ExportItemsRequest{
+		ListId: options.list,
+		Format: format,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transferTimeout)
+	defer cancel()
+
+	resp, err := options.client.ExportItems(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if options.output == "" {
+		_, err := os.Stdout.Write(resp.GetData())
+
+		return err
+	}
+
+	return ioutil.WriteFile(options.output, resp.GetData(), 0644) // nolint: gosec
+}
+
+type importOptions struct {
+	file     string
+	list     string
+	format   string
+	strategy string
+	dryRun   bool
+	client   todov1.TodoListServiceClient
+}
+
+// NewImportCommand creates a new cobra.Command for importing items to a list.
+func NewImportCommand(c Context) *cobra.Command {
+	options := importOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Import todo items from a file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.file = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runImport(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.list, "list", "", "ID of the list to import to (defaults to the default list)")
+	flags.StringVar(&options.format, "format", "", "Format of the file: json, csv, todotxt or ical (defaults to its extension)")
+	flags.StringVar(&options.strategy, "strategy", "merge", "What happens to the items of the list: merge (keep them) or replace (trash them)")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "Validate the file and show the outcome without importing anything")
+
+	return cmd
+}
+
+func runImport(options importOptions) error {
+	format, err := parseItemFormat(options.format, options.file)
+	if err != nil {
+		return err
+	}
+
+	strategy, ok := todov1.ImportStrategy_value["IMPORT_STRATEGY_"+strings.ToUpper(options.strategy)]
+	if !ok || strategy == int32(todov1.ImportStrategy_IMPORT_STRATEGY_UNSPECIFIED) {
+		return fmt.Errorf("invalid strategy %q (expected merge or replace)", options.strategy)
+	}
+
+	data, err := ioutil.ReadFile(options.file)
+	if err != nil {
+		return err
+	}
+
+	req := &todov1.ImportItemsRequest{
+		ListId:   options.list,
+		Format:   format,
+		Data:     data,
+		Strategy: todov1.ImportStrategy(strategy),
+		DryRun:   options.dryRun,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transferTimeout)
+	defer cancel()
+
+	resp, err := options.client.ImportItems(ctx, req)
+	if err != nil {
+		if violations := importViolations(err); len(violations) > 0 {
+			return fmt.Errorf("%s:\n%s", status.Convert(err).Message(), strings.Join(violations, "\n"))
+		}
+
+		return err
+	}
+
+	action := "Imported"
+	if resp.GetDryRun() {
+		action = "Would import"
+	}
+
+	fmt.Printf(
+		"%s %d todo items (%d skipped, %d moved to the trash).\n",
+		action, resp.GetAdded(), resp.GetSkipped(), resp.GetDeleted(),
+	)
+
+	return nil
+}
+
+// parseItemFormat parses the name of a format (eg. "csv") or guesses it by the extension of a file.
+func parseItemFormat(name string, file string) (todov1.ItemFormat, error) {
+	if name == "" {
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".json":
+			name = "json"
+		case ".csv":
+			name = "csv"
+		case ".txt":
+			name = "todotxt"
+		case ".ics":
+			name = "ical"
+		default:
+			return todov1.ItemFormat_ITEM_FORMAT_UNSPECIFIED, fmt.Errorf("the format of %q is unknown: use --format", file)
+		}
+	}
+
+	formats := map[string]todov1.ItemFormat{
+		"json":    todov1.ItemFormat_ITEM_FORMAT_JSON,
+		"csv":     todov1.ItemFormat_ITEM_FORMAT_CSV,
+		"todotxt": todov1.ItemFormat_ITEM_FORMAT_TODO_TXT,
+		"ical":    todov1.ItemFormat_ITEM_FORMAT_ICALENDAR,
+	}
+
+	format, ok := formats[strings.ToLower(name)]
+	if !ok {
+		return todov1.ItemFormat_ITEM_FORMAT_UNSPECIFIED, fmt.Errorf(
+			"invalid format %q (expected one of json, csv, todotxt or ical)", name,
+		)
+	}
+
+	return format, nil
+}
+
+// importViolations returns the problems of an invalid import ordered by line.
+func importViolations(err error) []string {
+	type violation struct {
+		line    int
+		message string
+	}
+
+	var violations []violation
+
+	for _, detail := range status.Convert(err).Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+
+		for _, fv := range badRequest.GetFieldViolations() {
+			line, _ := strconv.Atoi(strings.TrimPrefix(fv.GetField(), "line "))
+
+			violations = append(violations, violation{
+				line:    line,
+				message: fmt.Sprintf("  %s: %s", fv.GetField(), fv.GetDescription()),
+			})
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].line < violations[j].line })
+
+	messages := make([]string, 0, len(violations))
+
+	for _, v := range violations {
+		messages = append(messages, v.message)
+	}
+
+	return messages
+}
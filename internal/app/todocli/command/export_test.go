@@ -0,0 +1,45 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestParseItemFormat(t *testing.T) {
+	format, err := parseItemFormat("", "backup/todos.ICS")
+	require.NoError(t, err)
+	assert.Equal(t, todov1.ItemFormat_ITEM_FORMAT_ICALENDAR, format)
+
+	format, err = parseItemFormat("todotxt", "todos.csv")
+	require.NoError(t, err)
+	assert.Equal(t, todov1.ItemFormat_ITEM_FORMAT_TODO_TXT, format, "the format flag wins over the extension")
+
+	_, err = parseItemFormat("", "")
+	assert.Error(t, err)
+
+	_, err = parseItemFormat("xml", "")
+	assert.Error(t, err)
+}
+
+func TestImportViolations(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid import").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "line 12", Description: "title cannot be empty"},
+			{Field: "line 3", Description: "invalid due date \"tomorrow\""},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]string{"  line 3: invalid due date \"tomorrow\"", "  line 12: title cannot be empty"},
+		importViolations(st.Err()),
+	)
+}
@@ -0,0 +1,95 @@
+package command
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type historyOptions struct {
+	todoID string
+	client todov1.TodoListServiceClient
+}
+
+// NewHistoryCommand creates a new cobra.Command for showing the change history of a todo item.
+func NewHistoryCommand(c Context) *cobra.Command {
+	options := historyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "history ID",
+		Short: "Show the change history of a todo item",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.todoID = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runHistory(options)
+		},
+	}
+
+	return cmd
+}
+
+func runHistory(options historyOptions) error {
+	req := &todov1.ItemHistoryRequest{
+		Id: options.todoID,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ItemHistory(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Time", "Actor", "Operation", "Field", "Before", "After"})
+	table.AppendBulk(historyRows(resp.GetEntries()))
+	table.Render()
+
+	return nil
+}
+
+// historyRows renders every field change of history entries in a separate row.
+func historyRows(entries []*todov1.HistoryEntry) [][]string {
+	var rows [][]string
+
+	for _, entry := range entries {
+		row := []string{
+			entry.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+			entry.GetActor(),
+			entry.GetOperation(),
+			"",
+			"",
+			"",
+		}
+
+		if len(entry.GetChanges()) == 0 {
+			rows = append(rows, row)
+
+			continue
+		}
+
+		for i, change := range entry.GetChanges() {
+			// Entry details are only shown once
+			if i > 0 {
+				row = []string{"", "", "", "", "", ""}
+			}
+
+			row[3], row[4], row[5] = change.GetField(), change.GetBefore(), change.GetAfter()
+
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,35 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestHistoryRows(t *testing.T) {
+	entries := []*todov1.HistoryEntry{
+		{
+			Actor:     "john",
+			Operation: "UpdateItem",
+			CreatedAt: timestamppb.Now(),
+			Changes: []*todov1.FieldChange{
+				{Field: "completed", Before: "false", After: "true"},
+				{Field: "title", Before: "Buy milk", After: "Buy oat milk"},
+			},
+		},
+		{
+			Operation: "PurgeItem",
+			CreatedAt: timestamppb.Now(),
+		},
+	}
+
+	rows := historyRows(entries)
+
+	assert.Len(t, rows, 3)
+	assert.Equal(t, []string{"john", "UpdateItem", "completed", "false", "true"}, rows[0][1:])
+	assert.Equal(t, []string{"", "", "", "title", "Buy milk", "Buy oat milk"}, rows[1])
+	assert.Equal(t, []string{"", "PurgeItem", "", "", ""}, rows[2][1:])
+}
@@ -4,16 +4,24 @@ import (
 	"context"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
-	todov1 "github.com/sagikazarmark/todobackend-go-kit/api/todo/v1"
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
 )
 
 type listOptions struct {
-	client todov1.TodoListServiceClient
+	list         string
+	depth        int32
+	tags         []string
+	matchAllTags bool
+	assignee     string
+	mine         bool
+	sort         string
+	client       todov1.TodoListServiceClient
 }
 
 // NewListCommand creates a new cobra.Command for listing todo items.
@@ -36,27 +44,84 @@ func NewListCommand(c Context) *cobra.Command {
 	}
 	cobra.OnInitialize()
 
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.list, "list", "", "ID of the list to show (defaults to the default list)")
+	flags.Int32Var(&options.depth, "depth", 0, "Number of subtask levels to show (0 shows all of them)")
+	flags.StringArrayVar(&options.tags, "tag", nil, "Only show items tagged with this tag (can be repeated)")
+	flags.BoolVar(&options.matchAllTags, "all-tags", false, "Only show items tagged with all of the tags")
+	flags.StringVar(&options.assignee, "assignee", "", "Only show items assigned to this user")
+	flags.BoolVar(&options.mine, "mine", false, "Only show items assigned to you (see --user)")
+	flags.StringVar(&options.sort, "sort", "", "Order of the items: manual (default) or smart (by priority and due date)")
+
 	return cmd
 }
 
 func runList(options listOptions) error {
-	req := &todov1.ListItemsRequest{}
+	req := &todov1.ListItemTreeRequest{
+		ListId:       options.list,
+		Depth:        options.depth,
+		Tags:         options.tags,
+		MatchAllTags: options.matchAllTags,
+		Assignee:     options.assignee,
+		Mine:         options.mine,
+	}
+
+	if options.sort != "" {
+		sort, err := parseItemSort(options.sort)
+		if err != nil {
+			return err
+		}
+
+		req.Sort = sort
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
-	resp, err := options.client.ListItems(ctx, req)
+	resp, err := options.client.ListItemTree(ctx, req)
 	if err != nil {
 		return err
 	}
 
 	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Title", "Completed"})
-
-	for _, item := range resp.GetItems() {
-		table.Append([]string{item.GetId(), item.GetTitle(), strconv.FormatBool(item.GetCompleted())})
-	}
+	table.SetHeader([]string{"ID", "Title", "Completed", "Priority", "Due", "Repeats", "Tags", "Assignee"})
+	table.AppendBulk(itemTreeRows(resp.GetTrees(), 0))
 	table.Render()
 
 	return nil
 }
+
+// itemTreeRows renders items with their subtasks indented under them.
+func itemTreeRows(trees []*todov1.TodoItemTree, level int) [][]string {
+	var rows [][]string
+
+	for _, tree := range trees {
+		item := tree.GetItem()
+
+		var due string
+		if item.GetDueAt() != nil {
+			due = item.GetDueAt().AsTime().Local().Format(time.RFC3339)
+		}
+
+		title := item.GetTitle()
+		if level > 0 {
+			title = strings.Repeat("  ", level-1) + "└ " + title
+		}
+
+		rows = append(rows, []string{
+			item.GetId(),
+			title,
+			strconv.FormatBool(item.GetCompleted()),
+			priorityName(item.GetPriority()),
+			due,
+			item.GetRecurrence().GetRule(),
+			joinTagNames(item.GetTags()),
+			item.GetAssignee(),
+		})
+
+		rows = append(rows, itemTreeRows(tree.GetChildren(), level+1)...)
+	}
+
+	return rows
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestItemTreeRows(t *testing.T) {
+	trees := []*todov1.TodoItemTree{
+		{
+			Item: &todov1.TodoItem{Id: "1", Title: "Clean the house"},
+			Children: []*todov1.TodoItemTree{
+				{
+					Item: &todov1.TodoItem{
+						Id:        "2",
+						Title:     "Clean the kitchen",
+						Completed: true,
+						Priority:  todov1.Priority_PRIORITY_HIGH,
+					},
+					Children: []*todov1.TodoItemTree{
+						{Item: &todov1.TodoItem{Id: "3", Title: "Clean the fridge"}},
+					},
+				},
+			},
+		},
+		{
+			Item: &todov1.TodoItem{
+				Id:       "4",
+				Title:    "Mow the lawn",
+				Tags:     []*todov1.Tag{{Name: "garden"}, {Name: "weekend"}},
+				Assignee: "john",
+			},
+		},
+	}
+
+	expected := [][]string{
+		{"1", "Clean the house", "false", "", "", "", "", ""},
+		{"2", "└ Clean the kitchen", "true", "high", "", "", "", ""},
+		{"3", "  └ Clean the fridge", "false", "", "", "", "", ""},
+		{"4", "Mow the lawn", "false", "", "", "", "garden, weekend", "john"},
+	}
+
+	assert.Equal(t, expected, itemTreeRows(trees, 0))
+}
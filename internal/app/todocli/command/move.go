@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type moveOptions struct {
+	todoID string
+	before string
+	after  string
+	client todov1.TodoListServiceClient
+}
+
+// NewMoveCommand creates a new cobra.Command for moving a todo item in the manual order of its list.
+func NewMoveCommand(c Context) *cobra.Command {
+	options := moveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "move ID",
+		Short: "Move a todo item before or after another item",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.todoID = args[0]
+			options.client = c.GetTodoClient()
+
+			if (options.before == "") == (options.after == "") {
+				return errors.New("exactly one of --before and --after must be set")
+			}
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runMove(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.before, "before", "", "ID of the item to move the item before")
+	flags.StringVar(&options.after, "after", "", "ID of the item to move the item after")
+
+	return cmd
+}
+
+func runMove(options moveOptions) error {
+	req := &todov1.MoveItemRequest{
+		Id:       options.todoID,
+		BeforeId: options.before,
+		AfterId:  options.after,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := options.client.MoveItem(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Todo item with ID %s has been moved.", options.todoID)
+
+	return nil
+}
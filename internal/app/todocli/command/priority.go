@@ -0,0 +1,39 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+// parsePriority parses a priority name (eg. "high").
+func parsePriority(name string) (todov1.Priority, error) {
+	priority, ok := todov1.Priority_value["PRIORITY_"+strings.ToUpper(name)]
+	if !ok || priority == int32(todov1.Priority_PRIORITY_UNSPECIFIED) {
+		return todov1.Priority_PRIORITY_UNSPECIFIED, fmt.Errorf(
+			"invalid priority %q (expected one of none, low, medium, high or urgent)", name,
+		)
+	}
+
+	return todov1.Priority(priority), nil
+}
+
+// parseItemSort parses the name of an item order (eg. "smart").
+func parseItemSort(name string) (todov1.ItemSort, error) {
+	sort, ok := todov1.ItemSort_value["ITEM_SORT_"+strings.ToUpper(name)]
+	if !ok || sort == int32(todov1.ItemSort_ITEM_SORT_UNSPECIFIED) {
+		return todov1.ItemSort_ITEM_SORT_UNSPECIFIED, fmt.Errorf("invalid sort %q (expected manual or smart)", name)
+	}
+
+	return todov1.ItemSort(sort), nil
+}
+
+// priorityName returns the name of a priority. No priority is shown as an empty string.
+func priorityName(priority todov1.Priority) string {
+	if priority == todov1.Priority_PRIORITY_UNSPECIFIED || priority == todov1.Priority_PRIORITY_NONE {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimPrefix(priority.String(), "PRIORITY_"))
+}
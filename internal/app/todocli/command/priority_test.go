@@ -0,0 +1,37 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestParsePriority(t *testing.T) {
+	priority, err := parsePriority("High")
+	require.NoError(t, err)
+	assert.Equal(t, todov1.Priority_PRIORITY_HIGH, priority)
+	assert.Equal(t, "high", priorityName(priority))
+
+	priority, err = parsePriority("none")
+	require.NoError(t, err)
+	assert.Equal(t, todov1.Priority_PRIORITY_NONE, priority)
+	assert.Equal(t, "", priorityName(priority))
+
+	_, err = parsePriority("unspecified")
+	assert.Error(t, err)
+
+	_, err = parsePriority("critical")
+	assert.Error(t, err)
+}
+
+func TestParseItemSort(t *testing.T) {
+	sort, err := parseItemSort("smart")
+	require.NoError(t, err)
+	assert.Equal(t, todov1.ItemSort_ITEM_SORT_SMART, sort)
+
+	_, err = parseItemSort("alphabetical")
+	assert.Error(t, err)
+}
@@ -0,0 +1,558 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"emperror.dev/errors"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type ruleOptions struct {
+	id     string
+	itemID string
+	file   string
+	dryRun bool
+	client todov1.TodoListServiceClient
+}
+
+// NewRuleCommand creates a new cobra.Command for managing automation rules.
+func NewRuleCommand(c Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rule",
+		Short: "Manage automation rules",
+	}
+
+	cmd.AddCommand(
+		newRuleListCommand(c),
+		newRuleShowCommand(c),
+		newRuleCreateCommand(c),
+		newRuleDeleteCommand(c),
+		newRuleEnableCommand(c, true),
+		newRuleEnableCommand(c, false),
+		newRuleTestCommand(c),
+		newRuleLogCommand(c),
+	)
+
+	return cmd
+}
+
+func newRuleListCommand(c Context) *cobra.Command {
+	options := ruleOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List rules",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runRuleList(options)
+		},
+	}
+
+	return cmd
+}
+
+func runRuleList(options ruleOptions) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ListRules(ctx, &todov1.ListRulesRequest{})
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Name", "Trigger", "Enabled", "Dry run"})
+
+	for _, rule := range resp.GetRules() {
+		table.Append([]string{
+			rule.GetId(),
+			rule.GetName(),
+			formatRuleTrigger(rule.GetTrigger()),
+			strconv.FormatBool(rule.GetEnabled()),
+			strconv.FormatBool(rule.GetDryRun()),
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
+
+func newRuleShowCommand(c Context) *cobra.Command {
+	options := ruleOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "show ID",
+		Short: "Show the conditions and actions of a rule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runRuleShow(options)
+		},
+	}
+
+	return cmd
+}
+
+func runRuleShow(options ruleOptions) error {
+	req := &todov1.GetRuleRequest{
+		Id: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.GetRule(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	rule := resp.GetRule()
+
+	status := "enabled"
+	if !rule.GetEnabled() {
+		status = "disabled"
+	}
+
+	if rule.GetDryRun() {
+		status += ", dry run"
+	}
+
+	fmt.Printf("%s (%s)\n", rule.GetName(), status)
+	fmt.Printf("When: %s\n", formatRuleTrigger(rule.GetTrigger()))
+
+	for _, condition := range rule.GetConditions() {
+		fmt.Printf("If:   %s\n", formatRuleCondition(condition))
+	}
+
+	for _, action := range rule.GetActions() {
+		fmt.Printf("Then: %s\n", formatRuleAction(action))
+	}
+
+	return nil
+}
+
+func newRuleCreateCommand(c Context) *cobra.Command {
+	options := ruleOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create FILE",
+		Short: "Create a rule from a JSON file",
+		Long: `Create a rule from a JSON file.
+
+The file contains the event triggering the rule, the conditions matching the item and the actions run on it:
+
+  {
+    "name": "Escalate invoices",
+    "trigger": "item_added",
+    "conditions": [
+      {"field": "item.title", "operator": "contains", "value": "invoice"}
+    ],
+    "actions": [
+      {"type": "set_priority", "value": "high"},
+      {"type": "tag", "value": "finance"}
+    ]
+  }
+
+Conditions refer to fields of the item (item.title, item.tags, ...) or of the event (eg. event.previousAssignee).
+
+Triggers: item_added, item_updated, marked_as_complete, item_due_soon, item_overdue, item_assigned
+Operators: equals, not_equals, contains, is_empty, is_not_empty
+Actions: add_item, set_priority, complete, tag, untag, assign, unassign, move_to_list`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.file = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runRuleCreate(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.BoolVar(&options.dryRun, "dry-run", false, "Log the executions of the rule without running its actions")
+
+	return cmd
+}
+
+func runRuleCreate(options ruleOptions) error {
+	data, err := ioutil.ReadFile(options.file)
+	if err != nil {
+		return err
+	}
+
+	req, err := parseRuleFile(data)
+	if err != nil {
+		return err
+	}
+
+	req.DryRun = req.DryRun || options.dryRun
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.CreateRule(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Rule %q has been created with ID %s", resp.GetRule().GetName(), resp.GetRule().GetId())
+
+	return nil
+}
+
+func newRuleDeleteCommand(c Context) *cobra.Command {
+	options := ruleOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete ID",
+		Short: "Delete a rule and its execution log",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runRuleDelete(options)
+		},
+	}
+
+	return cmd
+}
+
+func runRuleDelete(options ruleOptions) error {
+	req := &todov1.DeleteRuleRequest{
+		Id: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := options.client.DeleteRule(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Rule with ID %s has been deleted.", options.id)
+
+	return nil
+}
+
+func newRuleEnableCommand(c Context, enabled bool) *cobra.Command {
+	options := ruleOptions{}
+
+	use, short := "enable ID", "Enable a rule"
+	if !enabled {
+		use, short = "disable ID", "Disable a rule"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runRuleEnable(options, enabled)
+		},
+	}
+
+	return cmd
+}
+
+func runRuleEnable(options ruleOptions, enabled bool) error {
+	req := &todov1.UpdateRuleRequest{
+		Id:      options.id,
+		Enabled: wrapperspb.Bool(enabled),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.UpdateRule(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	status := "enabled"
+	if !enabled {
+		status = "disabled"
+	}
+
+	fmt.Printf("Rule %q has been %s.", resp.GetRule().GetName(), status)
+
+	return nil
+}
+
+func newRuleTestCommand(c Context) *cobra.Command {
+	options := ruleOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "test ID ITEM_ID",
+		Short: "Show whether a rule matches a todo item without running its actions",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.itemID = args[1]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runRuleTest(options)
+		},
+	}
+
+	return cmd
+}
+
+func runRuleTest(options ruleOptions) error {
+	req := &todov1.EvaluateRuleRequest{
+		Id:     options.id,
+		ItemId: options.itemID,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.EvaluateRule(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	evaluation := resp.GetEvaluation()
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Condition", "Values", "Matched"})
+
+	for _, result := range evaluation.GetConditions() {
+		table.Append([]string{
+			formatRuleCondition(result.GetCondition()),
+			strings.Join(result.GetValues(), ", "),
+			strconv.FormatBool(result.GetMatched()),
+		})
+	}
+
+	table.Render()
+
+	if !evaluation.GetMatched() {
+		fmt.Println("The rule does not match the item.")
+
+		return nil
+	}
+
+	fmt.Println("The rule matches the item and would run:")
+
+	for _, action := range evaluation.GetActions() {
+		fmt.Printf("  %s\n", formatRuleAction(action))
+	}
+
+	return nil
+}
+
+func newRuleLogCommand(c Context) *cobra.Command {
+	options := ruleOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "log ID",
+		Short: "Show the execution log of a rule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runRuleLog(options)
+		},
+	}
+
+	return cmd
+}
+
+func runRuleLog(options ruleOptions) error {
+	req := &todov1.ListRuleExecutionsRequest{
+		Id: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ListRuleExecutions(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Time", "Trigger", "Item ID", "Status", "Error"})
+
+	for _, execution := range resp.GetExecutions() {
+		table.Append([]string{
+			execution.GetExecutedAt().AsTime().Local().Format(time.RFC3339),
+			formatRuleTrigger(execution.GetTrigger()),
+			execution.GetItemId(),
+			strings.ToLower(strings.TrimPrefix(execution.GetStatus().String(), "RULE_EXECUTION_STATUS_")),
+			execution.GetError(),
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
+
+// ruleFile is the JSON file format of rules.
+type ruleFile struct {
+	Name       string `json:"name"`
+	Trigger    string `json:"trigger"`
+	Conditions []struct {
+		Field    string `json:"field"`
+		Operator string `json:"operator"`
+		Value    string `json:"value"`
+	} `json:"conditions"`
+	Actions []struct {
+		Type   string `json:"type"`
+		Value  string `json:"value"`
+		ListID string `json:"listId"`
+	} `json:"actions"`
+	Enabled *bool `json:"enabled"`
+	DryRun  bool  `json:"dryRun"`
+}
+
+// parseRuleFile parses a rule from a JSON file. Enum values may be written in snake or camel case.
+func parseRuleFile(data []byte) (*todov1.CreateRuleRequest, error) {
+	var file ruleFile
+
+	err := json.Unmarshal(data, &file)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid rule file")
+	}
+
+	req := &todov1.CreateRuleRequest{
+		Name:   file.Name,
+		DryRun: file.DryRun,
+	}
+
+	if file.Enabled != nil {
+		req.Enabled = wrapperspb.Bool(*file.Enabled)
+	}
+
+	trigger, ok := todov1.RuleTrigger_value["RULE_TRIGGER_"+enumName(file.Trigger)]
+	if !ok || trigger == int32(todov1.RuleTrigger_RULE_TRIGGER_UNSPECIFIED) {
+		return nil, errors.Errorf("invalid trigger %q", file.Trigger)
+	}
+
+	req.Trigger = todov1.RuleTrigger(trigger)
+
+	for i, condition := range file.Conditions {
+		operator, ok := todov1.RuleOperator_value["RULE_OPERATOR_"+enumName(condition.Operator)]
+		if !ok || operator == int32(todov1.RuleOperator_RULE_OPERATOR_UNSPECIFIED) {
+			return nil, errors.Errorf("condition %d: invalid operator %q", i+1, condition.Operator)
+		}
+
+		req.Conditions = append(req.Conditions, &todov1.RuleCondition{
+			Field:    condition.Field,
+			Operator: todov1.RuleOperator(operator),
+			Value:    condition.Value,
+		})
+	}
+
+	for i, action := range file.Actions {
+		actionType, ok := todov1.RuleActionType_value["RULE_ACTION_TYPE_"+enumName(action.Type)]
+		if !ok || actionType == int32(todov1.RuleActionType_RULE_ACTION_TYPE_UNSPECIFIED) {
+			return nil, errors.Errorf("action %d: invalid action %q", i+1, action.Type)
+		}
+
+		req.Actions = append(req.Actions, &todov1.RuleAction{
+			Type:   todov1.RuleActionType(actionType),
+			Value:  action.Value,
+			ListId: action.ListID,
+		})
+	}
+
+	return req, nil
+}
+
+// enumName converts a snake or camel case value (eg. item_added, itemAdded) to the suffix of a protobuf enum value.
+func enumName(value string) string {
+	var (
+		b    strings.Builder
+		prev rune
+	)
+
+	for _, r := range strings.TrimSpace(value) {
+		if unicode.IsUpper(r) && unicode.IsLower(prev) {
+			b.WriteRune('_')
+		}
+
+		b.WriteRune(unicode.ToUpper(r))
+
+		prev = r
+	}
+
+	return b.String()
+}
+
+func formatRuleTrigger(trigger todov1.RuleTrigger) string {
+	return strings.ToLower(strings.TrimPrefix(trigger.String(), "RULE_TRIGGER_"))
+}
+
+// formatRuleCondition formats a condition as a sentence (eg. title contains "invoice").
+func formatRuleCondition(condition *todov1.RuleCondition) string {
+	operator := strings.ToLower(strings.TrimPrefix(condition.GetOperator().String(), "RULE_OPERATOR_"))
+
+	switch condition.GetOperator() {
+	case todov1.RuleOperator_RULE_OPERATOR_IS_EMPTY, todov1.RuleOperator_RULE_OPERATOR_IS_NOT_EMPTY:
+		return fmt.Sprintf("%s %s", condition.GetField(), operator)
+	default:
+		return fmt.Sprintf("%s %s %q", condition.GetField(), operator, condition.GetValue())
+	}
+}
+
+// formatRuleAction formats an action with its argument (eg. tag "finance").
+func formatRuleAction(action *todov1.RuleAction) string {
+	s := strings.ToLower(strings.TrimPrefix(action.GetType().String(), "RULE_ACTION_TYPE_"))
+
+	if action.GetValue() != "" {
+		s += fmt.Sprintf(" %q", action.GetValue())
+	}
+
+	if action.GetListId() != "" {
+		s += " in list " + action.GetListId()
+	}
+
+	return s
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestParseRuleFile(t *testing.T) {
+	data := []byte(`{
+		"name": "Escalate invoices",
+		"trigger": "item_added",
+		"conditions": [
+			{"field": "item.title", "operator": "contains", "value": "invoice"},
+			{"field": "item.assignee", "operator": "isEmpty"}
+		],
+		"actions": [
+			{"type": "setPriority", "value": "high"},
+			{"type": "MOVE_TO_LIST", "listId": "1234"}
+		],
+		"enabled": false
+	}`)
+
+	req, err := parseRuleFile(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Escalate invoices", req.GetName())
+	assert.Equal(t, todov1.RuleTrigger_RULE_TRIGGER_ITEM_ADDED, req.GetTrigger())
+	assert.False(t, req.GetEnabled().GetValue())
+
+	require.Len(t, req.GetConditions(), 2)
+	assert.Equal(t, todov1.RuleOperator_RULE_OPERATOR_CONTAINS, req.GetConditions()[0].GetOperator())
+	assert.Equal(t, todov1.RuleOperator_RULE_OPERATOR_IS_EMPTY, req.GetConditions()[1].GetOperator())
+
+	require.Len(t, req.GetActions(), 2)
+	assert.Equal(t, todov1.RuleActionType_RULE_ACTION_TYPE_SET_PRIORITY, req.GetActions()[0].GetType())
+	assert.Equal(t, "high", req.GetActions()[0].GetValue())
+	assert.Equal(t, todov1.RuleActionType_RULE_ACTION_TYPE_MOVE_TO_LIST, req.GetActions()[1].GetType())
+	assert.Equal(t, "1234", req.GetActions()[1].GetListId())
+
+	_, err = parseRuleFile([]byte(`{"name": "Rule", "trigger": "item_deleted", "actions": [{"type": "complete"}]}`))
+	assert.Error(t, err)
+
+	_, err = parseRuleFile([]byte(`{"name": "Rule", "trigger": "item_added", "actions": [{"type": "archive"}]}`))
+	assert.Error(t, err)
+
+	_, err = parseRuleFile([]byte(`{"name": `))
+	assert.Error(t, err)
+}
+
+func TestFormatRuleCondition(t *testing.T) {
+	assert.Equal(t, `item.title contains "invoice"`, formatRuleCondition(&todov1.RuleCondition{
+		Field:    "item.title",
+		Operator: todov1.RuleOperator_RULE_OPERATOR_CONTAINS,
+		Value:    "invoice",
+	}))
+
+	assert.Equal(t, "item.assignee is_not_empty", formatRuleCondition(&todov1.RuleCondition{
+		Field:    "item.assignee",
+		Operator: todov1.RuleOperator_RULE_OPERATOR_IS_NOT_EMPTY,
+	}))
+}
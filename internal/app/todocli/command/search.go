@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+	"html"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type searchOptions struct {
+	query  string
+	list   string
+	limit  int32
+	client todov1.TodoListServiceClient
+}
+
+// NewSearchCommand creates a new cobra.Command for searching todo items.
+func NewSearchCommand(c Context) *cobra.Command {
+	options := searchOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "search QUERY",
+		Aliases: []string{"s"},
+		Short:   "Search todo items by their title and notes",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.query = strings.Join(args, " ")
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runSearch(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.list, "list", "", "ID of the list to search (defaults to every list)")
+	flags.Int32Var(&options.limit, "limit", 0, "Maximum number of results (defaults to 20)")
+
+	return cmd
+}
+
+func runSearch(options searchOptions) error {
+	req := &todov1.SearchItemsRequest{
+		Query:  options.query,
+		ListId: options.list,
+		Limit:  options.limit,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.SearchItems(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Title", "List", "Completed", "Tags"})
+	table.AppendBulk(searchResultRows(resp.GetResults()))
+	table.Render()
+
+	return nil
+}
+
+// searchResultRows renders search results with the matching words of titles in brackets.
+func searchResultRows(results []*todov1.SearchResult) [][]string {
+	rows := make([][]string, 0, len(results))
+
+	for _, result := range results {
+		item := result.GetItem()
+
+		title := item.GetTitle()
+
+		for _, highlight := range result.GetHighlights() {
+			if highlight.GetField() == "title" {
+				title = plainHighlight(highlight.GetFragment())
+			}
+		}
+
+		rows = append(rows, []string{
+			item.GetId(),
+			title,
+			item.GetListId(),
+			strconv.FormatBool(item.GetCompleted()),
+			joinTagNames(item.GetTags()),
+		})
+	}
+
+	return rows
+}
+
+// plainHighlight converts a highlighted fragment to plain text with the matching words in brackets.
+func plainHighlight(fragment string) string {
+	fragment = strings.NewReplacer("<mark>", "[", "</mark>", "]").Replace(fragment)
+
+	return html.UnescapeString(fragment)
+}
@@ -0,0 +1,32 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestSearchResultRows(t *testing.T) {
+	results := []*todov1.SearchResult{
+		{
+			Item: &todov1.TodoItem{Id: "1", ListId: "default", Title: "Buy milk & bread"},
+			Highlights: []*todov1.Highlight{
+				{Field: "title", Fragment: "Buy <mark>milk</mark> &amp; bread"},
+			},
+		},
+		{
+			Item: &todov1.TodoItem{Id: "2", ListId: "groceries", Title: "Milk", Completed: true},
+		},
+	}
+
+	assert.Equal(
+		t,
+		[][]string{
+			{"1", "Buy [milk] & bread", "default", "false", ""},
+			{"2", "Milk", "groceries", "true", ""},
+		},
+		searchResultRows(results),
+	)
+}
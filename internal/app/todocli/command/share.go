@@ -0,0 +1,455 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type shareOptions struct {
+	listID  string
+	id      string
+	access  string
+	expires string
+	email   string
+	role    string
+	client  todov1.TodoListServiceClient
+}
+
+// NewShareCommand creates a new cobra.Command for sharing todo lists.
+func NewShareCommand(c Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share",
+		Short: "Share todo lists with links and collaborators",
+	}
+
+	cmd.AddCommand(
+		newShareCreateCommand(c),
+		newShareListCommand(c),
+		newShareRevokeCommand(c),
+		newShareLogCommand(c),
+		newShareInviteCommand(c),
+		newShareCollaboratorsCommand(c),
+		newShareRemoveCommand(c),
+	)
+
+	return cmd
+}
+
+func newShareCreateCommand(c Context) *cobra.Command {
+	options := shareOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create LIST_ID",
+		Short: "Create a share link for a todo list",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.listID = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runShareCreate(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.access, "access", "read", "Access granted by the link (read, edit)")
+	flags.StringVar(&options.expires, "expires", "", "Expiry of the link (eg. +1w, friday 5pm, 2021-01-02T15:04:05Z)")
+
+	return cmd
+}
+
+func runShareCreate(options shareOptions) error {
+	access, err := parseShareAccess(options.access)
+	if err != nil {
+		return err
+	}
+
+	req := &todov1.CreateShareTokenRequest{
+		ListId: options.listID,
+		Access: access,
+	}
+
+	if options.expires != "" {
+		expiresAt, err := parseDueTime(options.expires, time.Now())
+		if err != nil {
+			return err
+		}
+
+		req.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.CreateShareToken(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"Share link with ID %s has been created: %s\nThe link is not shown again, keep it safe.",
+		resp.GetToken().GetId(),
+		resp.GetToken().GetUrl(),
+	)
+
+	return nil
+}
+
+func newShareListCommand(c Context) *cobra.Command {
+	options := shareOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list LIST_ID",
+		Aliases: []string{"ls"},
+		Short:   "List the share links of a todo list",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.listID = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runShareList(options)
+		},
+	}
+
+	return cmd
+}
+
+func runShareList(options shareOptions) error {
+	req := &todov1.ListShareTokensRequest{
+		ListId: options.listID,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ListShareTokens(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Access", "Status", "Created", "Expires"})
+	table.AppendBulk(shareTokenRows(resp.GetTokens()))
+	table.Render()
+
+	return nil
+}
+
+// shareTokenRows renders share tokens with their status.
+func shareTokenRows(tokens []*todov1.ShareToken) [][]string {
+	rows := make([][]string, 0, len(tokens))
+
+	for _, token := range tokens {
+		status := "active"
+
+		switch {
+		case token.GetRevokedAt() != nil:
+			status = "revoked"
+		case !token.GetActive():
+			status = "expired"
+		}
+
+		expires := "never"
+		if token.GetExpiresAt() != nil {
+			expires = token.GetExpiresAt().AsTime().Local().Format(time.RFC3339)
+		}
+
+		rows = append(rows, []string{
+			token.GetId(),
+			formatShareAccess(token.GetAccess()),
+			status,
+			token.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+			expires,
+		})
+	}
+
+	return rows
+}
+
+func newShareRevokeCommand(c Context) *cobra.Command {
+	options := shareOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "revoke SHARE_ID",
+		Short: "Revoke a share link",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runShareRevoke(options)
+		},
+	}
+
+	return cmd
+}
+
+func runShareRevoke(options shareOptions) error {
+	req := &todov1.RevokeShareTokenRequest{
+		Id: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := options.client.RevokeShareToken(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Share link with ID %s has been revoked.", options.id)
+
+	return nil
+}
+
+func newShareLogCommand(c Context) *cobra.Command {
+	options := shareOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "log LIST_ID",
+		Short: "Show the accesses to a todo list through share links",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.listID = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runShareLog(options)
+		},
+	}
+
+	return cmd
+}
+
+func runShareLog(options shareOptions) error {
+	req := &todov1.ListShareAccessRequest{
+		ListId: options.listID,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ListShareAccess(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Time", "Share ID", "Operation", "Item ID", "Result"})
+
+	for _, entry := range resp.GetEntries() {
+		result := "allowed"
+		if entry.GetDenied() {
+			result = "denied"
+		}
+
+		table.Append([]string{
+			entry.GetAccessedAt().AsTime().Local().Format(time.RFC3339),
+			entry.GetShareId(),
+			entry.GetOperation(),
+			entry.GetItemId(),
+			result,
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
+
+func newShareInviteCommand(c Context) *cobra.Command {
+	options := shareOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "invite LIST_ID EMAIL",
+		Short: "Invite a collaborator to a todo list (or change their role)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.listID = args[0]
+			options.email = args[1]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runShareInvite(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.role, "role", "viewer", "Role of the collaborator (viewer, editor)")
+
+	return cmd
+}
+
+func runShareInvite(options shareOptions) error {
+	role, err := parseCollaboratorRole(options.role)
+	if err != nil {
+		return err
+	}
+
+	req := &todov1.InviteCollaboratorRequest{
+		ListId: options.listID,
+		Email:  options.email,
+		Role:   role,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.InviteCollaborator(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"%s has been invited to todo list with ID %s as %s.",
+		resp.GetCollaborator().GetEmail(),
+		options.listID,
+		formatCollaboratorRole(resp.GetCollaborator().GetRole()),
+	)
+
+	return nil
+}
+
+func newShareCollaboratorsCommand(c Context) *cobra.Command {
+	options := shareOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "collaborators LIST_ID",
+		Short: "List the collaborators of a todo list",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.listID = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runShareCollaborators(options)
+		},
+	}
+
+	return cmd
+}
+
+func runShareCollaborators(options shareOptions) error {
+	req := &todov1.ListCollaboratorsRequest{
+		ListId: options.listID,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ListCollaborators(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Email", "Role", "Invited"})
+
+	for _, collaborator := range resp.GetCollaborators() {
+		table.Append([]string{
+			collaborator.GetEmail(),
+			formatCollaboratorRole(collaborator.GetRole()),
+			collaborator.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
+
+func newShareRemoveCommand(c Context) *cobra.Command {
+	options := shareOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "remove LIST_ID EMAIL",
+		Aliases: []string{"rm"},
+		Short:   "Remove a collaborator from a todo list",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.listID = args[0]
+			options.email = args[1]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runShareRemove(options)
+		},
+	}
+
+	return cmd
+}
+
+func runShareRemove(options shareOptions) error {
+	req := &todov1.RemoveCollaboratorRequest{
+		ListId: options.listID,
+		Email:  options.email,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := options.client.RemoveCollaborator(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s has been removed from todo list with ID %s.", options.email, options.listID)
+
+	return nil
+}
+
+// parseShareAccess parses a share access level (read, edit).
+func parseShareAccess(value string) (todov1.ShareAccess, error) {
+	access, ok := todov1.ShareAccess_value["SHARE_ACCESS_"+strings.ToUpper(value)]
+	if !ok || access == int32(todov1.ShareAccess_SHARE_ACCESS_UNSPECIFIED) {
+		return todov1.ShareAccess_SHARE_ACCESS_UNSPECIFIED, errors.NewWithDetails("invalid share access", "access", value)
+	}
+
+	return todov1.ShareAccess(access), nil
+}
+
+func formatShareAccess(access todov1.ShareAccess) string {
+	return strings.ToLower(strings.TrimPrefix(access.String(), "SHARE_ACCESS_"))
+}
+
+// parseCollaboratorRole parses a collaborator role (viewer, editor).
+func parseCollaboratorRole(value string) (todov1.CollaboratorRole, error) {
+	role, ok := todov1.CollaboratorRole_value["COLLABORATOR_ROLE_"+strings.ToUpper(value)]
+	if !ok || role == int32(todov1.CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED) {
+		return todov1.CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED, errors.NewWithDetails("invalid role", "role", value)
+	}
+
+	return todov1.CollaboratorRole(role), nil
+}
+
+func formatCollaboratorRole(role todov1.CollaboratorRole) string {
+	return strings.ToLower(strings.TrimPrefix(role.String(), "COLLABORATOR_ROLE_"))
+}
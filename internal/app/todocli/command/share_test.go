@@ -0,0 +1,63 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestParseShareAccess(t *testing.T) {
+	access, err := parseShareAccess("Edit")
+	require.NoError(t, err)
+	assert.Equal(t, todov1.ShareAccess_SHARE_ACCESS_EDIT, access)
+
+	for _, value := range []string{"", "unspecified", "write"} {
+		_, err := parseShareAccess(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestParseCollaboratorRole(t *testing.T) {
+	role, err := parseCollaboratorRole("editor")
+	require.NoError(t, err)
+	assert.Equal(t, todov1.CollaboratorRole_COLLABORATOR_ROLE_EDITOR, role)
+
+	_, err = parseCollaboratorRole("owner")
+	assert.Error(t, err)
+}
+
+func TestShareTokenRows(t *testing.T) {
+	tokens := []*todov1.ShareToken{
+		{
+			Id:        "1",
+			Access:    todov1.ShareAccess_SHARE_ACCESS_READ,
+			Active:    true,
+			CreatedAt: timestamppb.Now(),
+		},
+		{
+			Id:        "2",
+			Access:    todov1.ShareAccess_SHARE_ACCESS_EDIT,
+			CreatedAt: timestamppb.Now(),
+			ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+		},
+		{
+			Id:        "3",
+			Access:    todov1.ShareAccess_SHARE_ACCESS_READ,
+			CreatedAt: timestamppb.Now(),
+			RevokedAt: timestamppb.Now(),
+		},
+	}
+
+	rows := shareTokenRows(tokens)
+
+	require.Len(t, rows, 3)
+	assert.Equal(t, []string{"1", "read", "active"}, rows[0][:3])
+	assert.Equal(t, "never", rows[0][4])
+	assert.Equal(t, []string{"2", "edit", "expired"}, rows[1][:3])
+	assert.Equal(t, "revoked", rows[2][2])
+}
@@ -0,0 +1,120 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type tagOptions struct {
+	todoID string
+	tags   []string
+	client todov1.TodoListServiceClient
+}
+
+// NewTagCommand creates a new cobra.Command for tagging a todo item.
+func NewTagCommand(c Context) *cobra.Command {
+	options := tagOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tag ID TAG...",
+		Short: "Tag a todo item (unknown tags are created)",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.todoID = args[0]
+			options.tags = args[1:]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTag(options)
+		},
+	}
+
+	return cmd
+}
+
+func runTag(options tagOptions) error {
+	req := &todov1.TagItemRequest{
+		Id:   options.todoID,
+		Tags: options.tags,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.TagItem(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Todo item with ID %s is tagged with: %s", options.todoID, tagNames(resp.GetItem().GetTags()))
+
+	return nil
+}
+
+// NewUntagCommand creates a new cobra.Command for removing tags from a todo item.
+func NewUntagCommand(c Context) *cobra.Command {
+	options := tagOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "untag ID TAG...",
+		Short: "Remove tags from a todo item",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.todoID = args[0]
+			options.tags = args[1:]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runUntag(options)
+		},
+	}
+
+	return cmd
+}
+
+func runUntag(options tagOptions) error {
+	req := &todov1.UntagItemRequest{
+		Id:   options.todoID,
+		Tags: options.tags,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.UntagItem(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Todo item with ID %s is tagged with: %s", options.todoID, tagNames(resp.GetItem().GetTags()))
+
+	return nil
+}
+
+func tagNames(tags []*todov1.Tag) string {
+	if len(tags) == 0 {
+		return "(no tags)"
+	}
+
+	return joinTagNames(tags)
+}
+
+func joinTagNames(tags []*todov1.Tag) string {
+	names := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		names = append(names, tag.GetName())
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,416 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+// nolint: gochecknoglobals
+var dueOffsetPattern = regexp.MustCompile(`^([+-]?)((?:\d+[wdhm])+)$`)
+
+type templateOptions struct {
+	id       string
+	file     string
+	listID   string
+	listName string
+	start    string
+	client   todov1.TodoListServiceClient
+}
+
+// NewTemplateCommand creates a new cobra.Command for managing templates.
+func NewTemplateCommand(c Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Manage templates of todo items",
+	}
+
+	cmd.AddCommand(
+		newTemplateListCommand(c),
+		newTemplateShowCommand(c),
+		newTemplateCreateCommand(c),
+		newTemplateDeleteCommand(c),
+		newTemplateInstantiateCommand(c),
+	)
+
+	return cmd
+}
+
+func newTemplateListCommand(c Context) *cobra.Command {
+	options := templateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List templates",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTemplateList(options)
+		},
+	}
+
+	return cmd
+}
+
+func runTemplateList(options templateOptions) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ListTemplates(ctx, &todov1.ListTemplatesRequest{})
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Name", "Items"})
+
+	for _, template := range resp.GetTemplates() {
+		table.Append([]string{template.GetId(), template.GetName(), strconv.Itoa(len(template.GetItems()))})
+	}
+
+	table.Render()
+
+	return nil
+}
+
+func newTemplateShowCommand(c Context) *cobra.Command {
+	options := templateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "show ID",
+		Short: "Show the items of a template",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTemplateShow(options)
+		},
+	}
+
+	return cmd
+}
+
+func runTemplateShow(options templateOptions) error {
+	req := &todov1.GetTemplateRequest{
+		Id: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.GetTemplate(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	template := resp.GetTemplate()
+
+	fmt.Println(template.GetName())
+
+	if template.GetDescription() != "" {
+		fmt.Println(template.GetDescription())
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Title", "Priority", "Due", "Tags"})
+
+	for _, item := range template.GetItems() {
+		table.Append([]string{
+			item.GetTitle(),
+			priorityName(item.GetPriority()),
+			formatDueOffset(item.GetDueOffset()),
+			strings.Join(item.GetTags(), ", "),
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
+
+func newTemplateCreateCommand(c Context) *cobra.Command {
+	options := templateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create FILE",
+		Short: "Create a template from a JSON file",
+		Long: `Create a template from a JSON file.
+
+The file contains the name and the items of the template:
+
+  {
+    "name": "Weekly review",
+    "description": "Every Friday",
+    "items": [
+      {"title": "Clear the inbox", "priority": "high", "dueOffset": "+2h", "tags": ["review"]},
+      {"title": "Plan next week", "dueOffset": "+1d"}
+    ]
+  }
+
+Due offsets are relative to the time the template is instantiated (eg. +1w2d, -1d, +30m).`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.file = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTemplateCreate(options)
+		},
+	}
+
+	return cmd
+}
+
+func runTemplateCreate(options templateOptions) error {
+	data, err := ioutil.ReadFile(options.file)
+	if err != nil {
+		return err
+	}
+
+	req, err := parseTemplateFile(data)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.CreateTemplate(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Template %q has been created with ID %s", resp.GetTemplate().GetName(), resp.GetTemplate().GetId())
+
+	return nil
+}
+
+func newTemplateDeleteCommand(c Context) *cobra.Command {
+	options := templateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete ID",
+		Short: "Delete a template (items created from it are kept)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTemplateDelete(options)
+		},
+	}
+
+	return cmd
+}
+
+func runTemplateDelete(options templateOptions) error {
+	req := &todov1.DeleteTemplateRequest{
+		Id: options.id,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := options.client.DeleteTemplate(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Template with ID %s has been deleted.", options.id)
+
+	return nil
+}
+
+func newTemplateInstantiateCommand(c Context) *cobra.Command {
+	options := templateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "instantiate ID",
+		Short: "Create todo items from a template in a new or an existing list",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTemplateInstantiate(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.listID, "list", "", "Append the items to an existing todo list")
+	flags.StringVar(&options.listName, "name", "", "Name of the new todo list (defaults to the name of the template)")
+	flags.StringVar(&options.start, "start", "", "Time due offsets are relative to (eg. monday 9am, +1w, 2021-01-02T15:04:05Z)")
+
+	return cmd
+}
+
+func runTemplateInstantiate(options templateOptions) error {
+	req := &todov1.InstantiateTemplateRequest{
+		Id:       options.id,
+		ListId:   options.listID,
+		ListName: options.listName,
+	}
+
+	if options.start != "" {
+		start, err := parseDueTime(options.start, time.Now())
+		if err != nil {
+			return err
+		}
+
+		req.Start = timestamppb.New(start)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transferTimeout)
+	defer cancel()
+
+	resp, err := options.client.InstantiateTemplate(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"%d todo items have been created in list %q with ID %s",
+		len(resp.GetItems()),
+		resp.GetList().GetName(),
+		resp.GetList().GetId(),
+	)
+
+	return nil
+}
+
+// templateFile is the JSON file format of templates.
+type templateFile struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Items       []struct {
+		Title     string   `json:"title"`
+		Notes     string   `json:"notes"`
+		Priority  string   `json:"priority"`
+		DueOffset string   `json:"dueOffset"`
+		Tags      []string `json:"tags"`
+	} `json:"items"`
+}
+
+// parseTemplateFile parses a template from a JSON file. Items are created in the order of the file.
+func parseTemplateFile(data []byte) (*todov1.CreateTemplateRequest, error) {
+	var file templateFile
+
+	err := json.Unmarshal(data, &file)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid template file")
+	}
+
+	req := &todov1.CreateTemplateRequest{
+		Name:        file.Name,
+		Description: file.Description,
+	}
+
+	for i, item := range file.Items {
+		templateItem := &todov1.TemplateItem{
+			Title: item.Title,
+			Notes: item.Notes,
+			Order: int32(i),
+			Tags:  item.Tags,
+		}
+
+		if item.Priority != "" {
+			templateItem.Priority, err = parsePriority(item.Priority)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "item %d", i+1)
+			}
+		}
+
+		if item.DueOffset != "" {
+			offset, err := parseDueOffset(item.DueOffset)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "item %d", i+1)
+			}
+
+			templateItem.DueOffset = durationpb.New(offset)
+		}
+
+		req.Items = append(req.Items, templateItem)
+	}
+
+	return req, nil
+}
+
+// parseDueOffset parses a due date relative to the start of a template instance (eg. +1d12h, -1w).
+func parseDueOffset(value string) (time.Duration, error) {
+	m := dueOffsetPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if m == nil {
+		return 0, errors.Errorf("invalid due offset %q (eg. +1d12h, -1w)", value)
+	}
+
+	d := sumDurationParts(m[2])
+
+	if m[1] == "-" {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// formatDueOffset formats a due offset in days, hours and minutes. No offset is shown as an empty string.
+func formatDueOffset(offset *durationpb.Duration) string {
+	if offset == nil {
+		return ""
+	}
+
+	d := offset.AsDuration().Round(time.Minute)
+
+	sign := "+"
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	var b strings.Builder
+
+	b.WriteString(sign)
+
+	if days := d / (24 * time.Hour); days > 0 {
+		fmt.Fprintf(&b, "%dd", days)
+	}
+
+	if hours := (d % (24 * time.Hour)) / time.Hour; hours > 0 {
+		fmt.Fprintf(&b, "%dh", hours)
+	}
+
+	if minutes := (d % time.Hour) / time.Minute; minutes > 0 || d == 0 {
+		fmt.Fprintf(&b, "%dm", minutes)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,66 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+func TestParseTemplateFile(t *testing.T) {
+	data := []byte(`{
+		"name": "Weekly review",
+		"items": [
+			{"title": "Clear the inbox", "priority": "high", "dueOffset": "+2h", "tags": ["review"]},
+			{"title": "Plan next week"}
+		]
+	}`)
+
+	req, err := parseTemplateFile(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Weekly review", req.GetName())
+	require.Len(t, req.GetItems(), 2)
+
+	assert.Equal(t, todov1.Priority_PRIORITY_HIGH, req.GetItems()[0].GetPriority())
+	assert.Equal(t, 2*time.Hour, req.GetItems()[0].GetDueOffset().AsDuration())
+	assert.Equal(t, []string{"review"}, req.GetItems()[0].GetTags())
+
+	assert.Equal(t, int32(1), req.GetItems()[1].GetOrder(), "items keep the order of the file")
+	assert.Nil(t, req.GetItems()[1].GetDueOffset())
+
+	_, err = parseTemplateFile([]byte(`{"name": "Review", "items": [{"title": "Plan", "priority": "asap"}]}`))
+	assert.Error(t, err)
+
+	_, err = parseTemplateFile([]byte(`{"name": `))
+	assert.Error(t, err)
+}
+
+func TestParseDueOffset(t *testing.T) {
+	tests := map[string]time.Duration{
+		"+2h":    2 * time.Hour,
+		"1d12h":  36 * time.Hour,
+		"-1w":    -7 * 24 * time.Hour,
+		" +30M ": 30 * time.Minute,
+	}
+
+	for value, expected := range tests {
+		offset, err := parseDueOffset(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, offset, value)
+	}
+
+	_, err := parseDueOffset("tomorrow")
+	assert.Error(t, err)
+}
+
+func TestFormatDueOffset(t *testing.T) {
+	assert.Equal(t, "", formatDueOffset(nil))
+	assert.Equal(t, "+0m", formatDueOffset(durationpb.New(0)))
+	assert.Equal(t, "+1d12h", formatDueOffset(durationpb.New(36*time.Hour)))
+	assert.Equal(t, "-2h30m", formatDueOffset(durationpb.New(-150*time.Minute)))
+}
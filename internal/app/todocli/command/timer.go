@@ -0,0 +1,344 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type timerOptions struct {
+	todoID string
+	user   string
+	all    bool
+	from   string
+	to     string
+	output string
+	client todov1.TodoListServiceClient
+}
+
+// NewTimerCommand creates a new cobra.Command for tracking time spent on todo items.
+func NewTimerCommand(c Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timer",
+		Short: "Track time spent on todo items",
+	}
+
+	cmd.AddCommand(
+		newTimerStartCommand(c),
+		newTimerStopCommand(c),
+		newTimerStatusCommand(c),
+		newTimerReportCommand(c),
+		newTimerExportCommand(c),
+	)
+
+	return cmd
+}
+
+func newTimerStartCommand(c Context) *cobra.Command {
+	options := timerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "start ID",
+		Short: "Start a timer on a todo item (stops the running timer)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.todoID = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTimerStart(options)
+		},
+	}
+
+	return cmd
+}
+
+func runTimerStart(options timerOptions) error {
+	req := &todov1.StartTimerRequest{
+		ItemId: options.todoID,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.StartTimer(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"Timer on todo item with ID %s is running since %s",
+		options.todoID,
+		resp.GetEntry().GetStartedAt().AsTime().Local().Format(time.Kitchen),
+	)
+
+	return nil
+}
+
+func newTimerStopCommand(c Context) *cobra.Command {
+	options := timerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stop the running timer",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTimerStop(options)
+		},
+	}
+
+	return cmd
+}
+
+func runTimerStop(options timerOptions) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.StopTimer(ctx, &todov1.StopTimerRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"Timer on todo item with ID %s has been stopped after %s",
+		resp.GetEntry().GetItemId(),
+		formatTrackedTime(resp.GetEntry().GetDuration().AsDuration()),
+	)
+
+	return nil
+}
+
+func newTimerStatusCommand(c Context) *cobra.Command {
+	options := timerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the running timer",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTimerStatus(options)
+		},
+	}
+
+	return cmd
+}
+
+func runTimerStatus(options timerOptions) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.GetTimer(ctx, &todov1.GetTimerRequest{})
+	if status.Code(err) == codes.NotFound {
+		fmt.Print("No timer is running")
+
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"Timer on todo item with ID %s is running for %s",
+		resp.GetEntry().GetItemId(),
+		formatTrackedTime(resp.GetEntry().GetDuration().AsDuration()),
+	)
+
+	return nil
+}
+
+func newTimerReportCommand(c Context) *cobra.Command {
+	options := timerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Show the time tracked per todo item and per day",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTimerReport(options)
+		},
+	}
+
+	addTimeEntryFilterFlags(cmd, &options)
+
+	return cmd
+}
+
+func runTimerReport(options timerOptions) error {
+	req := &todov1.TimeTotalsRequest{
+		ItemId: options.todoID,
+		User:   options.user,
+		Mine:   options.user == "" && !options.all,
+	}
+
+	var err error
+
+	req.From, req.To, err = parseTimePeriod(options.from, options.to)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.TimeTotals(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	totals := resp.GetTotals()
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Title", "Time"})
+
+	for _, item := range totals.GetItems() {
+		table.Append([]string{item.GetItemId(), item.GetTitle(), formatTrackedTime(item.GetDuration().AsDuration())})
+	}
+
+	table.Render()
+
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Day", "Time"})
+
+	for _, day := range totals.GetDays() {
+		table.Append([]string{day.GetDay(), formatTrackedTime(day.GetDuration().AsDuration())})
+	}
+
+	table.Render()
+
+	fmt.Printf("Total: %s", formatTrackedTime(totals.GetTotal().AsDuration()))
+
+	return nil
+}
+
+func newTimerExportCommand(c Context) *cobra.Command {
+	options := timerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a CSV timesheet of the tracked time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTimerExport(options)
+		},
+	}
+
+	addTimeEntryFilterFlags(cmd, &options)
+
+	cmd.Flags().StringVarP(&options.output, "output", "o", "", "File to write the timesheet to (defaults to the standard output)")
+
+	return cmd
+}
+
+func runTimerExport(options timerOptions) error {
+	req := &todov1.ExportTimesheetRequest{
+		ItemId: options.todoID,
+		User:   options.user,
+		Mine:   options.user == "" && !options.all,
+	}
+
+	var err error
+
+	req.From, req.To, err = parseTimePeriod(options.from, options.to)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transferTimeout)
+	defer cancel()
+
+	resp, err := options.client.ExportTimesheet(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if options.output == "" {
+		_, err := os.Stdout.Write(resp.GetData())
+
+		return err
+	}
+
+	return ioutil.WriteFile(options.output, resp.GetData(), 0644) // nolint: gosec
+}
+
+func addTimeEntryFilterFlags(cmd *cobra.Command, options *timerOptions) {
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.todoID, "item", "", "Only include the time tracked on a todo item")
+	flags.StringVar(&options.user, "by", "", "Only include the time tracked by a user (defaults to the current user)")
+	flags.BoolVar(&options.all, "all", false, "Include the time tracked by every user")
+	flags.StringVar(&options.from, "from", "", "Start of the period (eg. 2021-01-02 or 2021-01-02T15:04:05Z)")
+	flags.StringVar(&options.to, "to", "", "End of the period, exclusive (eg. 2021-01-09 or 2021-01-09T15:04:05Z)")
+}
+
+// parseTimePeriod parses the start and the end of a period.
+// Dates without a time refer to the local midnight starting the day.
+func parseTimePeriod(from string, to string) (*timestamppb.Timestamp, *timestamppb.Timestamp, error) {
+	parse := func(value string) (*timestamppb.Timestamp, error) {
+		if value == "" {
+			return nil, nil
+		}
+
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return timestamppb.New(t), nil
+		}
+
+		t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return nil, errors.Errorf("invalid time %q: use a date (2021-01-02) or RFC3339", value)
+		}
+
+		return timestamppb.New(t), nil
+	}
+
+	fromTime, err := parse(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toTime, err := parse(to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fromTime, toTime, nil
+}
+
+// formatTrackedTime formats tracked time in hours and minutes.
+func formatTrackedTime(d time.Duration) string {
+	d = d.Round(time.Minute)
+
+	return fmt.Sprintf("%dh%02dm", d/time.Hour, (d%time.Hour)/time.Minute)
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimePeriod(t *testing.T) {
+	from, to, err := parseTimePeriod("2021-01-02", "2021-01-09T15:04:05Z")
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2021, 1, 2, 0, 0, 0, 0, time.Local), from.AsTime().Local())
+	assert.Equal(t, time.Date(2021, 1, 9, 15, 4, 5, 0, time.UTC), to.AsTime())
+
+	from, to, err = parseTimePeriod("", "")
+	require.NoError(t, err)
+	assert.Nil(t, from)
+	assert.Nil(t, to)
+
+	_, _, err = parseTimePeriod("yesterday", "")
+	assert.Error(t, err)
+}
+
+func TestFormatTrackedTime(t *testing.T) {
+	assert.Equal(t, "0h00m", formatTrackedTime(0))
+	assert.Equal(t, "1h05m", formatTrackedTime(time.Hour+5*time.Minute+20*time.Second))
+	assert.Equal(t, "26h00m", formatTrackedTime(26*time.Hour-10*time.Second))
+}
@@ -0,0 +1,117 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type trashOptions struct {
+	list   string
+	client todov1.TodoListServiceClient
+}
+
+// NewTrashCommand creates a new cobra.Command for listing deleted todo items.
+func NewTrashCommand(c Context) *cobra.Command {
+	options := trashOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "trash",
+		Short: "List deleted todo items",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runTrash(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.list, "list", "", "ID of the list to show the trash of (defaults to the default list)")
+
+	return cmd
+}
+
+func runTrash(options trashOptions) error {
+	req := &todov1.ListTrashRequest{
+		ListId: options.list,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.ListTrash(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Title", "Deleted"})
+
+	for _, item := range resp.GetItems() {
+		table.Append([]string{
+			item.GetId(),
+			item.GetTitle(),
+			item.GetDeletedAt().AsTime().Local().Format(time.RFC3339),
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
+
+type restoreOptions struct {
+	todoID string
+	client todov1.TodoListServiceClient
+}
+
+// NewRestoreCommand creates a new cobra.Command for restoring a deleted todo item.
+func NewRestoreCommand(c Context) *cobra.Command {
+	options := restoreOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "restore ID",
+		Short: "Restore a todo item from the trash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.todoID = args[0]
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runRestore(options)
+		},
+	}
+
+	return cmd
+}
+
+func runRestore(options restoreOptions) error {
+	req := &todov1.RestoreItemRequest{
+		Id: options.todoID,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := options.client.RestoreItem(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Todo item with ID %s has been restored.", options.todoID)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
+)
+
+type undoOptions struct {
+	steps  int32
+	client todov1.TodoListServiceClient
+}
+
+// NewUndoCommand creates a new cobra.Command for undoing the last changes.
+func NewUndoCommand(c Context) *cobra.Command {
+	options := undoOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "undo",
+		Short: "Undo the last changes of todo items",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runUndo(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.Int32Var(&options.steps, "steps", 1, "Number of changes to undo")
+
+	return cmd
+}
+
+func runUndo(options undoOptions) error {
+	req := &todov1.UndoRequest{
+		Steps: options.steps,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.Undo(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	renderOperations("undone", resp.GetOperations())
+
+	return nil
+}
+
+type redoOptions struct {
+	steps  int32
+	client todov1.TodoListServiceClient
+}
+
+// NewRedoCommand creates a new cobra.Command for redoing the last undone changes.
+func NewRedoCommand(c Context) *cobra.Command {
+	options := redoOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "redo",
+		Short: "Redo the last undone changes of todo items",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			options.client = c.GetTodoClient()
+
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runRedo(options)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.Int32Var(&options.steps, "steps", 1, "Number of changes to redo")
+
+	return cmd
+}
+
+func runRedo(options redoOptions) error {
+	req := &todov1.RedoRequest{
+		Steps: options.steps,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := options.client.Redo(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	renderOperations("redone", resp.GetOperations())
+
+	return nil
+}
+
+// renderOperations prints the undone (or redone) operations.
+func renderOperations(action string, operations []*todov1.Operation) {
+	if len(operations) == 0 {
+		fmt.Printf("There is nothing to be %s.\n", action)
+
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Time", "Operation", "Items"})
+
+	for _, operation := range operations {
+		table.Append([]string{
+			operation.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+			operation.GetOperation(),
+			strings.Join(operation.GetItemIds(), ", "),
+		})
+	}
+
+	table.Render()
+}
@@ -1,13 +1,17 @@
 package todocli
 
 import (
+	"context"
+	"os"
+
 	"contrib.go.opencensus.io/exporter/ocagent"
 	"emperror.dev/errors"
-	todov1 "github.com/sagikazarmark/todobackend-go-kit/api/todo/v1"
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
 	"github.com/spf13/cobra"
 	"go.opencensus.io/plugin/ocgrpc"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/sagikazarmark/modern-go-application/internal/app/todocli/command"
 )
@@ -15,12 +19,14 @@ import (
 // Configure configures a root command.
 func Configure(rootCmd *cobra.Command) {
 	var address string
+	var user string
 
 	flags := rootCmd.PersistentFlags()
 
 	flags.StringVar(&address, "address", "127.0.0.1:8001", "Todo service address")
+	flags.StringVar(&user, "user", os.Getenv("TODOCLI_USER"), "ID of the user to act as (defaults to $TODOCLI_USER)")
 
-	c := &context{}
+	c := &cliContext{}
 
 	var grpcConn *grpc.ClientConn
 	var ocagentExporter *ocagent.Exporter
@@ -35,6 +41,7 @@ func Configure(rootCmd *cobra.Command) {
 					SpanKind: trace.SpanKindClient,
 				},
 			}),
+			grpc.WithUnaryInterceptor(userInterceptor(user)),
 		)
 		if err != nil {
 			return errors.WrapIf(err, "failed to dial service")
@@ -65,3 +72,21 @@ func Configure(rootCmd *cobra.Command) {
 
 	command.AddCommands(rootCmd, c)
 }
+
+// userInterceptor sends the ID of the user the CLI acts as with every request (if any).
+func userInterceptor(user string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if user != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", user)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -1,13 +1,13 @@
 package todocli
 
 import (
-	todov1 "github.com/sagikazarmark/todobackend-go-kit/api/todo/v1"
+	todov1 "github.com/sagikazarmark/modern-go-application/api/todo/v1"
 )
 
-type context struct {
+type cliContext struct {
 	client todov1.TodoListServiceClient
 }
 
-func (c *context) GetTodoClient() todov1.TodoListServiceClient {
+func (c *cliContext) GetTodoClient() todov1.TodoListServiceClient {
 	return c.client
 }
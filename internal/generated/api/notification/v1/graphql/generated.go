@@ -0,0 +1,54 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graphql
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type NotificationKind string
+
+const (
+	NotificationKindItemCompleted NotificationKind = "ITEM_COMPLETED"
+	NotificationKindItemAssigned  NotificationKind = "ITEM_ASSIGNED"
+	NotificationKindMentioned     NotificationKind = "MENTIONED"
+	NotificationKindItemOverdue   NotificationKind = "ITEM_OVERDUE"
+)
+
+var AllNotificationKind = []NotificationKind{
+	NotificationKindItemCompleted,
+	NotificationKindItemAssigned,
+	NotificationKindMentioned,
+	NotificationKindItemOverdue,
+}
+
+func (e NotificationKind) IsValid() bool {
+	switch e {
+	case NotificationKindItemCompleted, NotificationKindItemAssigned, NotificationKindMentioned, NotificationKindItemOverdue:
+		return true
+	}
+	return false
+}
+
+func (e NotificationKind) String() string {
+	return string(e)
+}
+
+func (e *NotificationKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationKind", str)
+	}
+	return nil
+}
+
+func (e NotificationKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
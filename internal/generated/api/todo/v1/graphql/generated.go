@@ -0,0 +1,109 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graphql
+
+import (
+	"time"
+
+	"github.com/sagikazarmark/modern-go-application/internal/app/mga/todo"
+)
+
+type BulkResult struct {
+	ID     *string         `json:"id"`
+	Status todo.BulkStatus `json:"status"`
+	Item   *todo.Item      `json:"item"`
+	Error  *string         `json:"error"`
+}
+
+type BulkTodoItemUpdate struct {
+	Update *TodoItemUpdate `json:"update"`
+	Tags   []string        `json:"tags"`
+	Untags []string        `json:"untags"`
+}
+
+type NewRule struct {
+	Name       string               `json:"name"`
+	Trigger    todo.RuleTrigger     `json:"trigger"`
+	Conditions []todo.RuleCondition `json:"conditions"`
+	Actions    []todo.RuleAction    `json:"actions"`
+	Enabled    *bool                `json:"enabled"`
+	DryRun     *bool                `json:"dryRun"`
+}
+
+type NewTemplate struct {
+	Name        string              `json:"name"`
+	Description *string             `json:"description"`
+	Items       []TemplateItemInput `json:"items"`
+}
+
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+type RuleUpdate struct {
+	ID         string               `json:"id"`
+	Name       *string              `json:"name"`
+	Trigger    *todo.RuleTrigger    `json:"trigger"`
+	Conditions []todo.RuleCondition `json:"conditions"`
+	Actions    []todo.RuleAction    `json:"actions"`
+	Enabled    *bool                `json:"enabled"`
+	DryRun     *bool                `json:"dryRun"`
+}
+
+type TagConnection struct {
+	Edges      []TagEdge `json:"edges"`
+	PageInfo   *PageInfo `json:"pageInfo"`
+	TotalCount int       `json:"totalCount"`
+}
+
+type TagEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *todo.Tag `json:"node"`
+}
+
+type TagUpdate struct {
+	ID    string  `json:"id"`
+	Name  *string `json:"name"`
+	Color *string `json:"color"`
+}
+
+type TemplateItemInput struct {
+	Title     string         `json:"title"`
+	Notes     *string        `json:"notes"`
+	Priority  *todo.Priority `json:"priority"`
+	Order     *int           `json:"order"`
+	DueOffset *int           `json:"dueOffset"`
+	Tags      []string       `json:"tags"`
+}
+
+type TemplateUpdate struct {
+	ID          string              `json:"id"`
+	Name        *string             `json:"name"`
+	Description *string             `json:"description"`
+	Items       []TemplateItemInput `json:"items"`
+}
+
+type TodoItemUpdate struct {
+	ID             string           `json:"id"`
+	Title          *string          `json:"title"`
+	Notes          *string          `json:"notes"`
+	Completed      *bool            `json:"completed"`
+	Order          *int             `json:"order"`
+	DueAt          *time.Time       `json:"dueAt"`
+	ClearDueAt     *bool            `json:"clearDueAt"`
+	Recurrence     *todo.Recurrence `json:"recurrence"`
+	EndRecurrence  *bool            `json:"endRecurrence"`
+	SkipOccurrence *bool            `json:"skipOccurrence"`
+	ListID         *string          `json:"listId"`
+	ParentID       *string          `json:"parentId"`
+	AutoComplete   *bool            `json:"autoComplete"`
+	Priority       *todo.Priority   `json:"priority"`
+}
+
+type TodoListUpdate struct {
+	ID          string  `json:"id"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	Archived    *bool   `json:"archived"`
+}
@@ -0,0 +1,100 @@
+// Package blob stores binary objects (eg. uploaded files) on pluggable backends.
+package blob
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"emperror.dev/errors"
+)
+
+// Storage drivers.
+const (
+	// DriverLocal stores objects in a directory of the local filesystem.
+	DriverLocal = "local"
+
+	// DriverS3 stores objects in a bucket of an S3 compatible object storage.
+	DriverS3 = "s3"
+)
+
+// ErrNotFound is returned when an object does not exist.
+const ErrNotFound = errors.Sentinel("object not found")
+
+// Store stores binary objects by key.
+type Store interface {
+	// Put stores an object, replacing any object stored under the same key.
+	// Size is the length of the content or -1 if it's unknown.
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+
+	// Get returns the content of an object. The caller must close the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete deletes an object. Deleting an object that does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// Config holds the configuration of a blob store.
+type Config struct {
+	// Driver is the storage backend (local or s3).
+	Driver string
+
+	// Local holds the configuration of the local driver.
+	Local LocalConfig
+
+	// S3 holds the configuration of the s3 driver.
+	S3 S3Config
+}
+
+// LocalConfig holds the configuration of a local filesystem store.
+type LocalConfig struct {
+	// Dir is the directory the objects are stored in.
+	Dir string
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	switch c.Driver {
+	case DriverLocal:
+		if c.Local.Dir == "" {
+			return errors.New("blob storage directory is required")
+		}
+
+	case DriverS3:
+		return c.S3.Validate()
+
+	default:
+		return errors.New("blob storage driver must be local or s3")
+	}
+
+	return nil
+}
+
+// NewStore returns a new Store for the configured driver.
+func NewStore(config Config) (Store, error) {
+	switch config.Driver {
+	case DriverLocal:
+		return NewFileStore(config.Local.Dir)
+
+	case DriverS3:
+		return NewS3Store(config.S3, nil), nil
+
+	default:
+		return nil, errors.NewWithDetails("unknown blob storage driver", "driver", config.Driver)
+	}
+}
+
+// validateKey makes sure a key can be used as a (relative) path.
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") {
+		return errors.NewWithDetails("invalid object key", "key", key)
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return errors.NewWithDetails("invalid object key", "key", key)
+		}
+	}
+
+	return nil
+}
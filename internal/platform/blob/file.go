@@ -0,0 +1,106 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"emperror.dev/errors"
+)
+
+// FileStore stores objects as files in a directory.
+// Keys are relative paths: slashes separate directories.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a new FileStore. The directory is created if it does not exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, errors.WrapIf(err, "create blob storage directory")
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Put stores an object. The content is written to a temporary file first,
+// so readers never see partially written objects.
+func (s *FileStore) Put(_ context.Context, key string, content io.Reader, _ int64, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return errors.WrapIf(err, "create object directory")
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errors.WrapIf(err, "create object")
+	}
+
+	_, err = io.Copy(file, content)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+
+		return errors.WrapIfWithDetails(err, "write object", "key", key)
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		_ = os.Remove(file.Name())
+
+		return errors.WrapIfWithDetails(err, "store object", "key", key)
+	}
+
+	return nil
+}
+
+// Get returns the content of an object.
+func (s *FileStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, errors.WithDetails(ErrNotFound, "key", key)
+	}
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "open object", "key", key)
+	}
+
+	return file, nil
+}
+
+// Delete deletes an object.
+func (s *FileStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.WrapIfWithDetails(err, "delete object", "key", key)
+	}
+
+	return nil
+}
+
+func (s *FileStore) path(key string) (string, error) {
+	err := validateKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,72 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewFileStore(filepath.Join(dir, "blobs"))
+	require.NoError(t, err)
+
+	err = store.Put(ctx, "items/1/notes.txt", strings.NewReader("Buy milk"), -1, "text/plain")
+	require.NoError(t, err)
+
+	content, err := store.Get(ctx, "items/1/notes.txt")
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	require.NoError(t, content.Close())
+	assert.Equal(t, "Buy milk", string(data))
+
+	err = store.Delete(ctx, "items/1/notes.txt")
+	require.NoError(t, err)
+
+	_, err = store.Get(ctx, "items/1/notes.txt")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	err = store.Delete(ctx, "items/1/notes.txt")
+	assert.NoError(t, err, "deleting missing objects is not an error")
+}
+
+func TestFileStore_FailedUpload(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+
+	err = store.Put(ctx, "notes.txt", io.MultiReader(strings.NewReader("Buy"), failingReader{}), -1, "")
+	require.Error(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "partial uploads are removed")
+}
+
+func TestFileStore_InvalidKey(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../secret", "items//1", "items/"} {
+		err := store.Put(context.Background(), key, strings.NewReader(""), 0, "")
+		assert.Error(t, err, key)
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
@@ -0,0 +1,249 @@
+package blob
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// S3Config holds the configuration of an S3 compatible object storage.
+type S3Config struct {
+	// Endpoint is the base URL of the storage (eg. https://s3.eu-west-1.amazonaws.com or http://localhost:9000).
+	Endpoint string
+
+	// Region is used for signing requests.
+	Region string
+
+	// Bucket holds the objects.
+	Bucket string
+
+	AccessKey string
+	SecretKey string
+
+	// PathStyle addresses the bucket in the path of requests instead of a subdomain of the endpoint.
+	// Most S3 compatible storages (eg. MinIO) require path style requests.
+	PathStyle bool
+}
+
+// Validate validates the configuration.
+func (c S3Config) Validate() error {
+	if c.Endpoint == "" {
+		return errors.New("s3 endpoint is required")
+	}
+
+	if u, err := url.Parse(c.Endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.New("s3 endpoint must be an absolute URL")
+	}
+
+	if c.Region == "" {
+		return errors.New("s3 region is required")
+	}
+
+	if c.Bucket == "" {
+		return errors.New("s3 bucket is required")
+	}
+
+	if c.AccessKey == "" || c.SecretKey == "" {
+		return errors.New("s3 credentials are required")
+	}
+
+	return nil
+}
+
+// S3Store stores objects in a bucket of an S3 compatible object storage.
+// Requests are signed with AWS Signature Version 4.
+type S3Store struct {
+	config S3Config
+	client *http.Client
+
+	now func() time.Time
+}
+
+// NewS3Store returns a new S3Store. The default HTTP client is used if client is nil.
+func NewS3Store(config S3Config, client *http.Client) *S3Store {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &S3Store{
+		config: config,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Put stores an object.
+// S3 requires the length of the content upfront: content of unknown size is buffered in a temporary file first.
+func (s *S3Store) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	if size < 0 {
+		file, n, err := spool(content)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "buffer object", "key", key)
+		}
+		defer func() {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}()
+
+		content, size = file, n
+	}
+
+	req, err := s.newRequest(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+
+	if size == 0 {
+		// Make sure the request is sent with a Content-Length header
+		req.Body = http.NoBody
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return errors.WithDetails(err, "key", key)
+	}
+
+	return errors.WrapIf(resp.Body.Close(), "close response")
+}
+
+// Get returns the content of an object.
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, errors.WithDetails(err, "key", key)
+	}
+
+	return resp.Body, nil
+}
+
+// Delete deletes an object.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.WithDetails(err, "key", key)
+	}
+
+	return errors.WrapIf(resp.Body.Close(), "close response")
+}
+
+// objectURL returns the URL of an object.
+func (s *S3Store) objectURL(key string) (*url.URL, error) {
+	u, err := url.Parse(s.config.Endpoint)
+	if err != nil {
+		return nil, errors.WrapIf(err, "parse s3 endpoint")
+	}
+
+	path := strings.TrimSuffix(u.Path, "/") + "/" + key
+
+	if s.config.PathStyle {
+		path = strings.TrimSuffix(u.Path, "/") + "/" + s.config.Bucket + "/" + key
+	} else {
+		u.Host = s.config.Bucket + "." + u.Host
+	}
+
+	u.Path = path
+	u.RawPath = uriEncode(path, false)
+
+	return u, nil
+}
+
+func (s *S3Store) newRequest(ctx context.Context, method string, key string, body io.Reader) (*http.Request, error) {
+	err := validateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, errors.WrapIf(err, "create s3 request")
+	}
+
+	return req, nil
+}
+
+// do signs and sends a request. Responses with an error status are closed and returned as errors.
+func (s *S3Store) do(req *http.Request) (*http.Response, error) {
+	signV4(req, unsignedPayload, s.config.AccessKey, s.config.SecretKey, s.config.Region, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.WrapIf(err, "send s3 request")
+	}
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.WithStack(ErrNotFound)
+	}
+
+	var s3Error struct {
+		Code    string
+		Message string
+	}
+
+	// The error document is optional (eg. HEAD requests)
+	_ = xml.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&s3Error)
+
+	return nil, errors.NewWithDetails(
+		"s3 request failed",
+		"status", resp.StatusCode,
+		"code", s3Error.Code,
+		"message", s3Error.Message,
+	)
+}
+
+// spool copies content to a temporary file and returns the file (rewound) with the length of the content.
+func spool(content io.Reader) (*os.File, int64, error) {
+	file, err := os.CreateTemp("", "blob-*")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	n, err := io.Copy(file, content)
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+
+		return nil, 0, err
+	}
+
+	return file, n, nil
+}
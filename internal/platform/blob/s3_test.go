@@ -0,0 +1,191 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is a local stand-in of an S3 compatible storage serving path style requests of a single bucket.
+// It checks the signature of every request.
+type fakeS3 struct {
+	t *testing.T
+
+	bucket    string
+	accessKey string
+	secretKey string
+	region    string
+
+	mu           sync.Mutex
+	objects      map[string][]byte
+	contentTypes map[string]string
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.validSignature(r) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, "<Error><Code>SignatureDoesNotMatch</Code><Message>Invalid signature</Message></Error>")
+
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/"+s.bucket+"/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("Content-Length") == "" {
+			w.WriteHeader(http.StatusLengthRequired)
+
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(s.t, err)
+
+		s.objects[key] = body
+		s.contentTypes[key] = r.Header.Get("Content-Type")
+
+	case http.MethodGet:
+		body, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, "<Error><Code>NoSuchKey</Code></Error>")
+
+			return
+		}
+
+		_, _ = w.Write(body)
+
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// validSignature signs the received request again with the signed headers and compares the signatures.
+func (s *fakeS3) validSignature(r *http.Request) bool {
+	authorization := r.Header.Get("Authorization")
+
+	i := strings.Index(authorization, "SignedHeaders=")
+	if i < 0 {
+		return false
+	}
+
+	signedHeaders := strings.Split(strings.SplitN(authorization[i+len("SignedHeaders="):], ",", 2)[0], ";")
+
+	req, err := http.NewRequest(r.Method, "http://"+r.Host+r.URL.RequestURI(), nil)
+	require.NoError(s.t, err)
+
+	for _, name := range signedHeaders {
+		if name != "host" {
+			req.Header.Set(name, r.Header.Get(name))
+		}
+	}
+
+	t, err := time.Parse(sigV4DateFormat, r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		return false
+	}
+
+	signV4(req, r.Header.Get("X-Amz-Content-Sha256"), s.accessKey, s.secretKey, s.region, t)
+
+	return req.Header.Get("Authorization") == authorization
+}
+
+func newTestS3Store(t *testing.T) (*S3Store, *fakeS3) {
+	fake := &fakeS3{
+		t:            t,
+		bucket:       "attachments",
+		accessKey:    "access",
+		secretKey:    "secret",
+		region:       "us-east-1",
+		objects:      make(map[string][]byte),
+		contentTypes: make(map[string]string),
+	}
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	config := S3Config{
+		Endpoint:  server.URL,
+		Region:    fake.region,
+		Bucket:    fake.bucket,
+		AccessKey: fake.accessKey,
+		SecretKey: fake.secretKey,
+		PathStyle: true,
+	}
+	require.NoError(t, config.Validate())
+
+	return NewS3Store(config, server.Client()), fake
+}
+
+func TestS3Store(t *testing.T) {
+	ctx := context.Background()
+	store, fake := newTestS3Store(t)
+
+	err := store.Put(ctx, "items/1/report 2021.pdf", strings.NewReader("%PDF-1.4"), 8, "application/pdf")
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte("%PDF-1.4"), fake.objects["items/1/report 2021.pdf"])
+	assert.Equal(t, "application/pdf", fake.contentTypes["items/1/report 2021.pdf"])
+
+	content, err := store.Get(ctx, "items/1/report 2021.pdf")
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	require.NoError(t, content.Close())
+	assert.Equal(t, "%PDF-1.4", string(data))
+
+	err = store.Delete(ctx, "items/1/report 2021.pdf")
+	require.NoError(t, err)
+
+	_, err = store.Get(ctx, "items/1/report 2021.pdf")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	err = store.Delete(ctx, "items/1/report 2021.pdf")
+	assert.NoError(t, err, "deleting missing objects is not an error")
+}
+
+func TestS3Store_UnknownSize(t *testing.T) {
+	store, fake := newTestS3Store(t)
+
+	// Hide the length of the content
+	content := io.MultiReader(bytes.NewReader(bytes.Repeat([]byte("a"), 100_000)))
+
+	err := store.Put(context.Background(), "big", content, -1, "")
+	require.NoError(t, err)
+
+	assert.Len(t, fake.objects["big"], 100_000)
+}
+
+func TestS3Store_InvalidCredentials(t *testing.T) {
+	store, _ := newTestS3Store(t)
+	store.config.SecretKey = "wrong"
+
+	err := store.Put(context.Background(), "key", strings.NewReader("data"), 4, "text/plain")
+	require.Error(t, err)
+	assert.Contains(t, errors.GetDetails(err), "SignatureDoesNotMatch")
+}
+
+func TestS3Store_VirtualHostedStyle(t *testing.T) {
+	store := NewS3Store(S3Config{Endpoint: "https://s3.eu-west-1.amazonaws.com", Bucket: "files"}, nil)
+
+	u, err := store.objectURL("items/a b.txt")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://files.s3.eu-west-1.amazonaws.com/items/a%20b.txt", u.String())
+}
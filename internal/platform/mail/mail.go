@@ -0,0 +1,92 @@
+// Package mail sends and receives emails over SMTP.
+package mail
+
+import (
+	"context"
+	"net/mail"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// Connection security modes.
+const (
+	// SecurityNone sends emails over a plain text connection.
+	SecurityNone = "none"
+
+	// SecuritySTARTTLS upgrades a plain text connection to TLS before sending emails.
+	SecuritySTARTTLS = "starttls"
+
+	// SecurityTLS connects to the server over TLS (also known as SMTPS).
+	SecurityTLS = "tls"
+)
+
+// Message is an email with a plain text body and an optional HTML alternative.
+type Message struct {
+	To      []string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Sender sends emails.
+type Sender interface {
+	// Send sends an email.
+	Send(ctx context.Context, message Message) error
+}
+
+// Config holds the configuration of an SMTP client.
+type Config struct {
+	Host string
+	Port int
+
+	// Username and Password authenticate the client (authentication is skipped if the username is empty).
+	Username string
+	Password string
+
+	// From is the sender of emails (eg. Todo <todo@example.com>).
+	From string
+
+	// Security is the connection security mode (none, starttls or tls).
+	Security string
+
+	// Timeout is the maximum duration of a single delivery attempt.
+	Timeout time.Duration
+
+	// Retries is the number of times a temporarily failed delivery is retried.
+	Retries int
+
+	// RetryInterval is the time to wait between attempts.
+	RetryInterval time.Duration
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if c.Host == "" {
+		return errors.New("mail server host is required")
+	}
+
+	if c.Port < 1 || c.Port > 65535 {
+		return errors.New("mail server port must be between 1 and 65535")
+	}
+
+	if _, err := mail.ParseAddress(c.From); err != nil {
+		return errors.New("mail sender must be a valid email address")
+	}
+
+	switch c.Security {
+	case SecurityNone, SecuritySTARTTLS, SecurityTLS:
+	default:
+		return errors.New("mail connection security must be none, starttls or tls")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("mail timeout must be positive")
+	}
+
+	if c.Retries < 0 {
+		return errors.New("mail retries cannot be negative")
+	}
+
+	return nil
+}
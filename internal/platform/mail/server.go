@@ -0,0 +1,575 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// maxCommandLength limits the length of command lines including CRLF (RFC 5321 4.5.3.1.4).
+const maxCommandLength = 512
+
+// Envelope holds the SMTP envelope of an incoming email.
+type Envelope struct {
+	RemoteAddr net.Addr
+	From       string
+	To         []string
+}
+
+// Handler decides about incoming emails.
+// Returning an Error rejects a command with its reply code, other errors are reported as local (temporary) failures.
+type Handler interface {
+	// CheckSender is called when a client starts a new email.
+	CheckSender(ctx context.Context, remoteAddr net.Addr, from string) error
+
+	// CheckRecipient is called for every recipient of an email.
+	CheckRecipient(ctx context.Context, envelope Envelope, to string) error
+
+	// Receive is called with the content of an email once it's fully received.
+	Receive(ctx context.Context, envelope Envelope, data []byte) error
+}
+
+// Error is an SMTP reply rejecting a command.
+type Error struct {
+	Code    int
+	Message string
+}
+
+// Error implements the error interface.
+func (e Error) Error() string {
+	return fmt.Sprintf("%d %s", e.Code, e.Message)
+}
+
+// ErrorHandler handles an error.
+type ErrorHandler interface {
+	Handle(err error)
+}
+
+// ServerConfig holds the configuration of an SMTP server.
+type ServerConfig struct {
+	// Domain is the name the server introduces itself with.
+	Domain string
+
+	// MaxMessageSize limits the size of emails in bytes.
+	MaxMessageSize int64
+
+	// MaxRecipients limits the number of recipients of an email.
+	MaxRecipients int
+
+	// MaxSessions limits the number of clients served at the same time (others are turned away).
+	MaxSessions int
+
+	// Timeout is the maximum duration of waiting for a client command.
+	Timeout time.Duration
+}
+
+// Validate validates the configuration.
+func (c ServerConfig) Validate() error {
+	if c.Domain == "" {
+		return errors.New("smtp server domain is required")
+	}
+
+	if c.MaxMessageSize < 1 {
+		return errors.New("smtp server message size limit must be positive")
+	}
+
+	if c.MaxRecipients < 1 {
+		return errors.New("smtp server recipient limit must be positive")
+	}
+
+	if c.MaxSessions < 1 {
+		return errors.New("smtp server session limit must be positive")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("smtp server timeout must be positive")
+	}
+
+	return nil
+}
+
+// ErrServerClosed is returned by Serve after the server is closed.
+var ErrServerClosed = errors.NewPlain("mail: server closed")
+
+// Server receives emails over SMTP (without TLS and authentication).
+// It's meant to receive emails relayed by a mail server.
+type Server struct {
+	config       ServerConfig
+	handler      Handler
+	errorHandler ErrorHandler
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// sessions holds a slot for every client being served
+	sessions chan struct{}
+
+	mu        sync.Mutex
+	listeners map[net.Listener]struct{}
+	conns     map[net.Conn]struct{}
+	closed    bool
+	wg        sync.WaitGroup
+}
+
+// NewServer returns a new Server.
+// Errors returned by the handler (other than rejections) are passed to the error handler.
+func NewServer(config ServerConfig, handler Handler, errorHandler ErrorHandler) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Server{
+		config:       config,
+		handler:      handler,
+		errorHandler: errorHandler,
+		ctx:          ctx,
+		cancel:       cancel,
+		sessions:     make(chan struct{}, config.MaxSessions),
+		listeners:    make(map[net.Listener]struct{}),
+		conns:        make(map[net.Conn]struct{}),
+	}
+}
+
+// Serve accepts connections on a listener until the server is closed.
+func (s *Server) Serve(ln net.Listener) error {
+	if !s.track(ln, nil) {
+		return ErrServerClosed
+	}
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return ErrServerClosed
+			}
+
+			var nerr net.Error
+			if errors.As(err, &nerr) && nerr.Temporary() { // nolint: staticcheck
+				time.Sleep(100 * time.Millisecond)
+
+				continue
+			}
+
+			return errors.WithStack(err)
+		}
+
+		if !s.track(nil, conn) {
+			_ = conn.Close()
+
+			return ErrServerClosed
+		}
+
+		s.wg.Add(1)
+
+		go func() {
+			defer s.wg.Done()
+			defer s.untrack(conn)
+
+			select {
+			case s.sessions <- struct{}{}:
+				defer func() { <-s.sessions }()
+
+				s.serveConn(conn)
+
+			default:
+				s.turnAway(conn)
+			}
+		}()
+	}
+}
+
+// Close stops accepting connections, closes the open ones and waits for their handlers to return.
+func (s *Server) Close() error {
+	s.mu.Lock()
+
+	s.closed = true
+	s.cancel()
+
+	var errs []error
+
+	for ln := range s.listeners {
+		if err := ln.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			errs = append(errs, err)
+		}
+	}
+
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+
+	s.mu.Unlock()
+
+	s.wg.Wait()
+
+	return errors.Combine(errs...)
+}
+
+func (s *Server) track(ln net.Listener, conn net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return false
+	}
+
+	if ln != nil {
+		s.listeners[ln] = struct{}{}
+	}
+
+	if conn != nil {
+		s.conns[conn] = struct{}{}
+	}
+
+	return true
+}
+
+func (s *Server) untrack(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.conns, conn)
+}
+
+func (s *Server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.closed
+}
+
+// turnAway closes the connection of a client exceeding the session limit.
+func (s *Server) turnAway(conn net.Conn) {
+	defer conn.Close()
+
+	_ = conn.SetWriteDeadline(time.Now().Add(s.config.Timeout))
+	_, _ = fmt.Fprintf(conn, "421 %s Too many connections, try again later\r\n", s.config.Domain)
+}
+
+// session is the state of an SMTP conversation.
+type session struct {
+	server   *Server
+	conn     net.Conn
+	input    *io.LimitedReader
+	text     *textproto.Conn
+	greeted  bool
+	envelope *Envelope
+}
+
+// limitedConn reads a connection through a limited reader.
+type limitedConn struct {
+	net.Conn
+
+	r io.Reader
+}
+
+func (c limitedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	// The amount of data read from the client is limited for every command (and the content of emails)
+	input := &io.LimitedReader{R: conn}
+
+	sess := &session{
+		server: s,
+		conn:   conn,
+		input:  input,
+		text:   textproto.NewConn(limitedConn{Conn: conn, r: input}),
+	}
+
+	sess.reply(220, s.config.Domain+" ESMTP ready")
+
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(s.config.Timeout))
+
+		input.N = maxCommandLength
+
+		line, err := sess.text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		// A line longer than the limit is cut short by the limited reader
+		if len(line) > maxCommandLength-2 {
+			sess.reply(500, "Line too long")
+
+			return
+		}
+
+		verb, arg := line, ""
+		if i := strings.IndexByte(line, ' '); i >= 0 {
+			verb, arg = line[:i], strings.TrimSpace(line[i+1:])
+		}
+
+		switch strings.ToUpper(verb) {
+		case "HELO":
+			sess.hello()
+			sess.reply(250, s.config.Domain)
+
+		case "EHLO":
+			sess.hello()
+			sess.replyLines(250, s.config.Domain, "8BITMIME", "SIZE "+strconv.FormatInt(s.config.MaxMessageSize, 10))
+
+		case "MAIL":
+			sess.mail(arg)
+
+		case "RCPT":
+			sess.rcpt(arg)
+
+		case "DATA":
+			if !sess.data() {
+				return
+			}
+
+		case "RSET":
+			sess.envelope = nil
+			sess.reply(250, "OK")
+
+		case "NOOP":
+			sess.reply(250, "OK")
+
+		case "VRFY":
+			sess.reply(252, "Cannot verify user")
+
+		case "QUIT":
+			sess.reply(221, "Bye")
+
+			return
+
+		default:
+			sess.reply(502, "Command not implemented")
+		}
+	}
+}
+
+func (sess *session) reply(code int, message string) {
+	_ = sess.conn.SetWriteDeadline(time.Now().Add(sess.server.config.Timeout))
+	_ = sess.text.PrintfLine("%d %s", code, message)
+}
+
+func (sess *session) replyLines(code int, lines ...string) {
+	_ = sess.conn.SetWriteDeadline(time.Now().Add(sess.server.config.Timeout))
+
+	for i, line := range lines {
+		sep := "-"
+		if i == len(lines)-1 {
+			sep = " "
+		}
+
+		_ = sess.text.PrintfLine("%d%s%s", code, sep, line)
+	}
+}
+
+// replyError replies to a command rejected by the handler.
+func (sess *session) replyError(err error) {
+	var serr Error
+	if errors.As(err, &serr) {
+		sess.reply(serr.Code, serr.Message)
+
+		return
+	}
+
+	sess.server.errorHandler.Handle(errors.WithDetails(err, "remote_addr", sess.conn.RemoteAddr().String()))
+
+	sess.reply(451, "Requested action aborted: local error in processing")
+}
+
+func (sess *session) hello() {
+	sess.greeted = true
+	sess.envelope = nil
+}
+
+func (sess *session) mail(arg string) {
+	if !sess.greeted {
+		sess.reply(503, "Say hello first")
+
+		return
+	}
+
+	if sess.envelope != nil {
+		sess.reply(503, "Sender already specified")
+
+		return
+	}
+
+	from, params, ok := parsePath(arg, "FROM:")
+	if !ok {
+		sess.reply(501, "Syntax: MAIL FROM:<address>")
+
+		return
+	}
+
+	if size, ok := params["SIZE"]; ok {
+		n, err := strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			sess.reply(501, "Invalid SIZE parameter")
+
+			return
+		}
+
+		if n > sess.server.config.MaxMessageSize {
+			sess.reply(552, "Message size exceeds fixed limit")
+
+			return
+		}
+	}
+
+	err := sess.server.handler.CheckSender(sess.server.ctx, sess.conn.RemoteAddr(), from)
+	if err != nil {
+		sess.replyError(err)
+
+		return
+	}
+
+	sess.envelope = &Envelope{
+		RemoteAddr: sess.conn.RemoteAddr(),
+		From:       from,
+	}
+
+	sess.reply(250, "OK")
+}
+
+func (sess *session) rcpt(arg string) {
+	if sess.envelope == nil {
+		sess.reply(503, "Need MAIL before RCPT")
+
+		return
+	}
+
+	to, _, ok := parsePath(arg, "TO:")
+	if !ok || to == "" {
+		sess.reply(501, "Syntax: RCPT TO:<address>")
+
+		return
+	}
+
+	if len(sess.envelope.To) >= sess.server.config.MaxRecipients {
+		sess.reply(452, "Too many recipients")
+
+		return
+	}
+
+	err := sess.server.handler.CheckRecipient(sess.server.ctx, *sess.envelope, to)
+	if err != nil {
+		sess.replyError(err)
+
+		return
+	}
+
+	sess.envelope.To = append(sess.envelope.To, to)
+
+	sess.reply(250, "OK")
+}
+
+// data receives the content of an email. It returns false if the connection should be closed.
+func (sess *session) data() bool {
+	if sess.envelope == nil || len(sess.envelope.To) == 0 {
+		sess.reply(503, "Need RCPT before DATA")
+
+		return true
+	}
+
+	sess.reply(354, "End data with <CR><LF>.<CR><LF>")
+
+	_ = sess.conn.SetReadDeadline(time.Now().Add(sess.server.config.Timeout))
+
+	limit := sess.server.config.MaxMessageSize
+
+	// Line endings and dot-stuffing at most double the size of an email on the wire (plus the final dot)
+	sess.input.N = 2*(limit+1) + 5
+
+	dot := sess.text.DotReader()
+
+	data, err := io.ReadAll(io.LimitReader(dot, limit+1))
+	if err != nil {
+		return sess.tooLarge()
+	}
+
+	envelope := *sess.envelope
+	sess.envelope = nil
+
+	if int64(len(data)) > limit {
+		// Discard the rest of the message to keep the conversation in sync
+		_, err := io.Copy(io.Discard, dot)
+		if err != nil {
+			return sess.tooLarge()
+		}
+
+		sess.reply(552, "Message size exceeds fixed limit")
+
+		return true
+	}
+
+	err = sess.server.handler.Receive(sess.server.ctx, envelope, data)
+	if err != nil {
+		sess.replyError(err)
+
+		return true
+	}
+
+	sess.reply(250, "OK: message accepted")
+
+	return true
+}
+
+// tooLarge rejects an email that cannot be read to its end.
+// It returns false as the connection should be closed: the rest of the email is left unread.
+func (sess *session) tooLarge() bool {
+	if sess.input.N <= 0 {
+		sess.reply(552, "Message size exceeds fixed limit")
+	}
+
+	return false
+}
+
+// parsePath parses the argument of a MAIL or RCPT command (eg. FROM:<john@example.com> SIZE=1024).
+// The null reverse path (<>) is returned as an empty address.
+func parsePath(arg string, prefix string) (string, map[string]string, bool) {
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", nil, false
+	}
+
+	arg = strings.TrimSpace(arg[len(prefix):])
+
+	if !strings.HasPrefix(arg, "<") {
+		return "", nil, false
+	}
+
+	end := strings.IndexByte(arg, '>')
+	if end < 0 {
+		return "", nil, false
+	}
+
+	address := arg[1:end]
+
+	if address != "" {
+		parsed, err := mail.ParseAddress("<" + address + ">")
+		if err != nil {
+			return "", nil, false
+		}
+
+		address = parsed.Address
+	}
+
+	params := make(map[string]string)
+
+	for _, param := range strings.Fields(arg[end+1:]) {
+		key, value := param, ""
+		if i := strings.IndexByte(param, '='); i >= 0 {
+			key, value = param[:i], param[i+1:]
+		}
+
+		params[strings.ToUpper(key)] = value
+	}
+
+	return address, params, true
+}
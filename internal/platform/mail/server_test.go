@@ -0,0 +1,357 @@
+package mail
+
+import (
+	"context"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingHandler struct {
+	mu        sync.Mutex
+	envelopes []Envelope
+	data      [][]byte
+
+	senderErr    error
+	recipientErr error
+	receiveErr   error
+}
+
+func (h *recordingHandler) CheckSender(_ context.Context, _ net.Addr, _ string) error {
+	return h.senderErr
+}
+
+func (h *recordingHandler) CheckRecipient(_ context.Context, _ Envelope, to string) error {
+	if strings.HasPrefix(to, "unknown@") {
+		return h.recipientErr
+	}
+
+	return nil
+}
+
+func (h *recordingHandler) Receive(_ context.Context, envelope Envelope, data []byte) error {
+	if h.receiveErr != nil {
+		return h.receiveErr
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.envelopes = append(h.envelopes, envelope)
+	h.data = append(h.data, data)
+
+	return nil
+}
+
+type recordingErrorHandler struct {
+	mu     sync.Mutex
+	errors []error
+}
+
+func (h *recordingErrorHandler) Handle(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.errors = append(h.errors, err)
+}
+
+func newTestServer(t *testing.T, handler Handler, errorHandler ErrorHandler) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := NewServer(ServerConfig{
+		Domain:         "todo.example.com",
+		MaxMessageSize: 1024,
+		MaxRecipients:  2,
+		MaxSessions:    2,
+		Timeout:        5 * time.Second,
+	}, handler, errorHandler)
+
+	done := make(chan error, 1)
+
+	go func() { done <- server.Serve(ln) }()
+
+	t.Cleanup(func() {
+		require.NoError(t, server.Close())
+		assert.Equal(t, ErrServerClosed, <-done)
+	})
+
+	return ln.Addr().String()
+}
+
+func replyCode(t *testing.T, err error) int {
+	t.Helper()
+
+	var protoErr *textproto.Error
+	require.True(t, errors.As(err, &protoErr), "expected an SMTP reply, got %v", err)
+
+	return protoErr.Code
+}
+
+func TestServer(t *testing.T) {
+	handler := &recordingHandler{}
+	addr := newTestServer(t, handler, &recordingErrorHandler{})
+
+	message := "Subject: Mow the lawn\r\n\r\nIt's getting long.\r\n..and a line starting with a dot\r\n"
+
+	err := smtp.SendMail(addr, nil, "john@example.com", []string{"todo@example.com"}, []byte(message))
+	require.NoError(t, err)
+
+	require.Len(t, handler.envelopes, 1)
+
+	assert.Equal(t, "john@example.com", handler.envelopes[0].From)
+	assert.Equal(t, []string{"todo@example.com"}, handler.envelopes[0].To)
+	assert.NotNil(t, handler.envelopes[0].RemoteAddr)
+	assert.Equal(t, "Subject: Mow the lawn\n\nIt's getting long.\n..and a line starting with a dot\n", string(handler.data[0]))
+}
+
+func TestServer_MultipleMessages(t *testing.T) {
+	handler := &recordingHandler{}
+	addr := newTestServer(t, handler, &recordingErrorHandler{})
+
+	client, err := smtp.Dial(addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	for _, subject := range []string{"First", "Second"} {
+		require.NoError(t, client.Mail("john@example.com"))
+		require.NoError(t, client.Rcpt("todo@example.com"))
+
+		w, err := client.Data()
+		require.NoError(t, err)
+
+		_, err = w.Write([]byte("Subject: " + subject + "\r\n\r\nBody\r\n"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+	}
+
+	require.NoError(t, client.Quit())
+
+	assert.Len(t, handler.envelopes, 2)
+}
+
+func TestServer_RejectsSender(t *testing.T) {
+	handler := &recordingHandler{senderErr: Error{Code: 550, Message: "Unknown sender"}}
+	addr := newTestServer(t, handler, &recordingErrorHandler{})
+
+	err := smtp.SendMail(addr, nil, "joe@example.com", []string{"todo@example.com"}, []byte("Subject: Hi\r\n\r\nHi\r\n"))
+
+	assert.Equal(t, 550, replyCode(t, err))
+	assert.Empty(t, handler.envelopes)
+}
+
+func TestServer_RejectsRecipient(t *testing.T) {
+	handler := &recordingHandler{recipientErr: Error{Code: 550, Message: "No such mailbox"}}
+	addr := newTestServer(t, handler, &recordingErrorHandler{})
+
+	err := smtp.SendMail(addr, nil, "john@example.com", []string{"unknown@example.com"}, []byte("Subject: Hi\r\n\r\nHi\r\n"))
+
+	assert.Equal(t, 550, replyCode(t, err))
+	assert.Empty(t, handler.envelopes)
+}
+
+func TestServer_TooManyRecipients(t *testing.T) {
+	handler := &recordingHandler{}
+	addr := newTestServer(t, handler, &recordingErrorHandler{})
+
+	to := []string{"a@example.com", "b@example.com", "c@example.com"}
+
+	err := smtp.SendMail(addr, nil, "john@example.com", to, []byte("Subject: Hi\r\n\r\nHi\r\n"))
+
+	assert.Equal(t, 452, replyCode(t, err))
+}
+
+func TestServer_TooLarge(t *testing.T) {
+	handler := &recordingHandler{}
+	addr := newTestServer(t, handler, &recordingErrorHandler{})
+
+	client, err := smtp.Dial(addr)
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.Mail("john@example.com"))
+	require.NoError(t, client.Rcpt("todo@example.com"))
+
+	w, err := client.Data()
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("Subject: Hi\r\n\r\n" + strings.Repeat("a", 1500) + "\r\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 552, replyCode(t, w.Close()))
+
+	// The conversation can go on
+	require.NoError(t, client.Reset())
+	require.NoError(t, client.Noop())
+
+	assert.Empty(t, handler.envelopes)
+}
+
+func TestServer_WayTooLarge(t *testing.T) {
+	handler := &recordingHandler{}
+	addr := newTestServer(t, handler, &recordingErrorHandler{})
+
+	conn, err := textproto.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, _, err = conn.ReadResponse(220)
+	require.NoError(t, err)
+
+	for _, command := range []string{"HELO client.example.com", "MAIL FROM:<john@example.com>", "RCPT TO:<todo@example.com>"} {
+		_, err := conn.Cmd(command)
+		require.NoError(t, err)
+
+		_, _, err = conn.ReadResponse(250)
+		require.NoError(t, err)
+	}
+
+	_, err = conn.Cmd("DATA")
+	require.NoError(t, err)
+
+	_, _, err = conn.ReadResponse(354)
+	require.NoError(t, err)
+
+	// The server stops reading long before the end of the email
+	go func() {
+		w := conn.DotWriter()
+
+		_, _ = w.Write([]byte(strings.Repeat("a", 1<<20)))
+		_ = w.Close()
+	}()
+
+	code, _, _ := conn.ReadResponse(0)
+	assert.Equal(t, 552, code)
+
+	_, err = conn.ReadLine()
+	assert.Error(t, err, "the connection is closed")
+
+	assert.Empty(t, handler.envelopes)
+}
+
+func TestServer_LineTooLong(t *testing.T) {
+	addr := newTestServer(t, &recordingHandler{}, &recordingErrorHandler{})
+
+	conn, err := textproto.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, _, err = conn.ReadResponse(220)
+	require.NoError(t, err)
+
+	_, err = conn.Cmd("NOOP %s", strings.Repeat("a", 1024))
+	require.NoError(t, err)
+
+	code, _, _ := conn.ReadResponse(0)
+	assert.Equal(t, 500, code)
+
+	_, err = conn.ReadLine()
+	assert.Error(t, err, "the connection is closed")
+}
+
+func TestServer_TooManySessions(t *testing.T) {
+	addr := newTestServer(t, &recordingHandler{}, &recordingErrorHandler{})
+
+	var clients []*smtp.Client
+
+	for i := 0; i < 2; i++ {
+		client, err := smtp.Dial(addr)
+		require.NoError(t, err)
+
+		clients = append(clients, client)
+	}
+
+	_, err := smtp.Dial(addr)
+	assert.Equal(t, 421, replyCode(t, err))
+
+	require.NoError(t, clients[0].Quit())
+	defer clients[1].Close()
+
+	// A session is available again once a client leaves
+	assert.Eventually(t, func() bool {
+		client, err := smtp.Dial(addr)
+		if err != nil {
+			return false
+		}
+
+		_ = client.Quit()
+
+		return true
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestServer_HandlerError(t *testing.T) {
+	handler := &recordingHandler{receiveErr: errors.New("database is down")}
+	errorHandler := &recordingErrorHandler{}
+	addr := newTestServer(t, handler, errorHandler)
+
+	err := smtp.SendMail(addr, nil, "john@example.com", []string{"todo@example.com"}, []byte("Subject: Hi\r\n\r\nHi\r\n"))
+
+	assert.Equal(t, 451, replyCode(t, err))
+
+	require.Len(t, errorHandler.errors, 1)
+	assert.EqualError(t, errorHandler.errors[0], "database is down")
+}
+
+func TestServer_CommandSequence(t *testing.T) {
+	addr := newTestServer(t, &recordingHandler{}, &recordingErrorHandler{})
+
+	conn, err := textproto.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, _, err = conn.ReadResponse(220)
+	require.NoError(t, err)
+
+	for _, c := range []struct {
+		command string
+		code    int
+	}{
+		{"MAIL FROM:<john@example.com>", 503},
+		{"HELO client.example.com", 250},
+		{"RCPT TO:<todo@example.com>", 503},
+		{"MAIL FROM:john@example.com", 501},
+		{"MAIL FROM:<john@example.com> SIZE=4096", 552},
+		{"MAIL FROM:<john@example.com> SIZE=512", 250},
+		{"DATA", 503},
+		{"RCPT TO:<todo@example.com>", 250},
+		{"RSET", 250},
+		{"RCPT TO:<todo@example.com>", 503},
+		{"STARTTLS", 502},
+		{"QUIT", 221},
+	} {
+		id, err := conn.Cmd(c.command)
+		require.NoError(t, err)
+
+		conn.StartResponse(id)
+		code, _, _ := conn.ReadResponse(0)
+		conn.EndResponse(id)
+
+		assert.Equal(t, c.code, code, c.command)
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	address, params, ok := parsePath("from:<John@Example.com> SIZE=1024 BODY=8BITMIME", "FROM:")
+	require.True(t, ok)
+
+	assert.Equal(t, "John@Example.com", address)
+	assert.Equal(t, map[string]string{"SIZE": "1024", "BODY": "8BITMIME"}, params)
+
+	address, _, ok = parsePath("FROM:<>", "FROM:")
+	require.True(t, ok)
+	assert.Empty(t, address)
+
+	_, _, ok = parsePath("TO:<not an address>", "TO:")
+	assert.False(t, ok)
+}
@@ -0,0 +1,153 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// SMTPSender sends emails through an SMTP server.
+// Deliveries failing temporarily (eg. network errors or 4xx replies) are retried.
+type SMTPSender struct {
+	config Config
+	from   *mail.Address
+
+	now func() time.Time
+}
+
+// NewSMTPSender returns a new SMTPSender.
+func NewSMTPSender(config Config) (*SMTPSender, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, errors.WrapIf(err, "parse sender address")
+	}
+
+	return &SMTPSender{
+		config: config,
+		from:   from,
+		now:    time.Now,
+	}, nil
+}
+
+// Send sends an email.
+func (s *SMTPSender) Send(ctx context.Context, message Message) error {
+	if len(message.To) == 0 {
+		return errors.New("email has no recipients")
+	}
+
+	data, err := buildMessage(s.from, message, s.now())
+	if err != nil {
+		return errors.WrapIf(err, "build email")
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = s.send(ctx, message.To, data)
+		if err == nil || !isTemporary(err) || attempt >= s.config.Retries {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.WrapIf(ctx.Err(), "send email")
+
+		case <-time.After(s.config.RetryInterval):
+		}
+	}
+
+	return errors.WrapIfWithDetails(err, "send email", "subject", message.Subject)
+}
+
+func (s *SMTPSender) send(ctx context.Context, to []string, data []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	tlsConfig := &tls.Config{ServerName: s.config.Host, MinVersion: tls.VersionTLS12}
+
+	if s.config.Security == SecurityTLS {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer client.Close()
+
+	if s.config.Security == SecuritySTARTTLS {
+		err = client.StartTLS(tlsConfig)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	if s.config.Username != "" {
+		err = client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	err = client.Mail(s.from.Address)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, address := range to {
+		err = client.Rcpt(address)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = w.Write(data)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(client.Quit())
+}
+
+// isTemporary reports whether a delivery error is worth retrying:
+// transient negative replies (4xx) and errors not coming from the server (eg. network errors) are.
+func isTemporary(err error) bool {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		return protoErr.Code >= 400 && protoErr.Code < 500
+	}
+
+	return true
+}
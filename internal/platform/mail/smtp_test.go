@@ -0,0 +1,395 @@
+package mail
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTP is an in-process stand-in of an SMTP server accepting every email.
+// It can be told to reject the next deliveries with a given reply code.
+type fakeSMTP struct {
+	t        *testing.T
+	listener net.Listener
+
+	username string
+	password string
+
+	mu       sync.Mutex
+	messages []fakeSMTPMessage
+	failures int
+	failCode int
+	attempts int
+}
+
+type fakeSMTPMessage struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeSMTP{
+		t:        t,
+		listener: listener,
+	}
+
+	go s.serve()
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	return s
+}
+
+func (s *fakeSMTP) config() Config {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+
+	return Config{
+		Host:          host,
+		Port:          p,
+		Username:      s.username,
+		Password:      s.password,
+		From:          "Todo <todo@example.com>",
+		Security:      SecurityNone,
+		Timeout:       5 * time.Second,
+		Retries:       2,
+		RetryInterval: time.Millisecond,
+	}
+}
+
+func (s *fakeSMTP) failNext(n int, code int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = n
+	s.failCode = code
+}
+
+func (s *fakeSMTP) received() ([]fakeSMTPMessage, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]fakeSMTPMessage(nil), s.messages...), s.attempts
+}
+
+func (s *fakeSMTP) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+
+	reply := func(code int, msg string) {
+		_ = tp.PrintfLine("%d %s", code, msg)
+	}
+
+	reply(220, "fake ESMTP")
+
+	var message fakeSMTPMessage
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg := line, ""
+		if i := strings.IndexByte(line, ' '); i >= 0 {
+			verb, arg = line[:i], line[i+1:]
+		}
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			_ = tp.PrintfLine("250-fake")
+			_ = tp.PrintfLine("250-8BITMIME")
+			_ = tp.PrintfLine("250 AUTH PLAIN")
+
+		case "HELO":
+			reply(250, "fake")
+
+		case "AUTH":
+			fields := strings.Fields(arg)
+			if len(fields) != 2 || fields[0] != "PLAIN" {
+				reply(504, "unsupported authentication")
+
+				continue
+			}
+
+			credentials, _ := base64.StdEncoding.DecodeString(fields[1])
+			if string(credentials) != "\x00"+s.username+"\x00"+s.password {
+				reply(535, "invalid credentials")
+
+				continue
+			}
+
+			reply(235, "authenticated")
+
+		case "MAIL":
+			s.mu.Lock()
+			s.attempts++
+			fail := s.failures > 0
+			if fail {
+				s.failures--
+			}
+			code := s.failCode
+			s.mu.Unlock()
+
+			if fail {
+				reply(code, "rejected")
+
+				continue
+			}
+
+			message = fakeSMTPMessage{From: fakeSMTPPath(arg)}
+
+			reply(250, "ok")
+
+		case "RCPT":
+			message.To = append(message.To, fakeSMTPPath(arg))
+
+			reply(250, "ok")
+
+		case "DATA":
+			reply(354, "go ahead")
+
+			data, err := io.ReadAll(tp.DotReader())
+			if err != nil {
+				return
+			}
+
+			message.Data = data
+
+			s.mu.Lock()
+			s.messages = append(s.messages, message)
+			s.mu.Unlock()
+
+			reply(250, "queued")
+
+		case "RSET", "NOOP":
+			reply(250, "ok")
+
+		case "QUIT":
+			reply(221, "bye")
+
+			return
+
+		default:
+			reply(502, "command not implemented")
+		}
+	}
+}
+
+// fakeSMTPPath returns the address of a MAIL or RCPT command argument (eg. FROM:<john@example.com> BODY=8BITMIME).
+func fakeSMTPPath(arg string) string {
+	start, end := strings.IndexByte(arg, '<'), strings.IndexByte(arg, '>')
+	if start < 0 || end < start {
+		return ""
+	}
+
+	return arg[start+1 : end]
+}
+
+func TestSMTPSender_Send(t *testing.T) {
+	server := newFakeSMTP(t)
+
+	sender, err := NewSMTPSender(server.config())
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{
+		To:      []string{"john@example.com", "jane@example.com"},
+		Subject: "Árvíztűrő tükörfúrógép",
+		Text:    "Hello,\nplain text",
+		HTML:    "<p>Hello, <b>HTML</b></p>",
+	})
+	require.NoError(t, err)
+
+	messages, _ := server.received()
+	require.Len(t, messages, 1)
+
+	assert.Equal(t, "todo@example.com", messages[0].From)
+	assert.Equal(t, []string{"john@example.com", "jane@example.com"}, messages[0].To)
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(messages[0].Data)))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Árvíztűrő tükörfúrógép", subject)
+	assert.Equal(t, `"Todo" <todo@example.com>`, msg.Header.Get("From"))
+	assert.Equal(t, "<john@example.com>, <jane@example.com>", msg.Header.Get("To"))
+	assert.NotEmpty(t, msg.Header.Get("Message-ID"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	var parts []string
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+
+		parts = append(parts, part.Header.Get("Content-Type")+": "+string(body))
+	}
+
+	assert.Equal(t, []string{
+		"text/plain; charset=utf-8: Hello,\nplain text",
+		"text/html; charset=utf-8: <p>Hello, <b>HTML</b></p>",
+	}, parts)
+}
+
+func TestSMTPSender_Send_TextOnly(t *testing.T) {
+	server := newFakeSMTP(t)
+
+	sender, err := NewSMTPSender(server.config())
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{
+		To:      []string{"john@example.com"},
+		Subject: "Hello",
+		Text:    "plain text",
+	})
+	require.NoError(t, err)
+
+	messages, _ := server.received()
+	require.Len(t, messages, 1)
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(messages[0].Data)))
+	require.NoError(t, err)
+
+	assert.Equal(t, "text/plain; charset=utf-8", msg.Header.Get("Content-Type"))
+
+	assert.Equal(t, "quoted-printable", msg.Header.Get("Content-Transfer-Encoding"))
+
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	require.NoError(t, err)
+
+	assert.Equal(t, "plain text", strings.TrimSpace(string(body)))
+}
+
+func TestSMTPSender_Send_Auth(t *testing.T) {
+	server := newFakeSMTP(t)
+	server.username = "user"
+	server.password = "secret"
+
+	config := server.config()
+
+	sender, err := NewSMTPSender(config)
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{To: []string{"john@example.com"}, Subject: "Hello", Text: "Hello"})
+	require.NoError(t, err)
+
+	config.Password = "wrong"
+
+	sender, err = NewSMTPSender(config)
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{To: []string{"john@example.com"}, Subject: "Hello", Text: "Hello"})
+	require.Error(t, err)
+
+	messages, _ := server.received()
+	assert.Len(t, messages, 1)
+}
+
+func TestSMTPSender_Send_RetriesTemporaryFailures(t *testing.T) {
+	server := newFakeSMTP(t)
+	server.failNext(2, 451)
+
+	sender, err := NewSMTPSender(server.config())
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{To: []string{"john@example.com"}, Subject: "Hello", Text: "Hello"})
+	require.NoError(t, err)
+
+	messages, attempts := server.received()
+	assert.Len(t, messages, 1)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestSMTPSender_Send_GivesUpAfterRetries(t *testing.T) {
+	server := newFakeSMTP(t)
+	server.failNext(3, 421)
+
+	sender, err := NewSMTPSender(server.config())
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{To: []string{"john@example.com"}, Subject: "Hello", Text: "Hello"})
+	require.Error(t, err)
+
+	messages, attempts := server.received()
+	assert.Empty(t, messages)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestSMTPSender_Send_DoesNotRetryPermanentFailures(t *testing.T) {
+	server := newFakeSMTP(t)
+	server.failNext(1, 550)
+
+	sender, err := NewSMTPSender(server.config())
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{To: []string{"john@example.com"}, Subject: "Hello", Text: "Hello"})
+	require.Error(t, err)
+
+	_, attempts := server.received()
+	assert.Equal(t, 1, attempts)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	config := Config{
+		Host:     "localhost",
+		Port:     25,
+		From:     "todo@example.com",
+		Security: SecurityNone,
+		Timeout:  time.Second,
+	}
+
+	assert.NoError(t, config.Validate())
+
+	invalid := config
+	invalid.From = "not an address"
+	assert.Error(t, invalid.Validate())
+
+	invalid = config
+	invalid.Security = "ssl"
+	assert.Error(t, invalid.Validate())
+}
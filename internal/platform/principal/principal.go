@@ -0,0 +1,25 @@
+// Package principal carries the identity of the user making a request.
+//
+// The application does not authenticate users itself:
+// it trusts an authenticating proxy in front of it to set the user ID header on every request.
+package principal
+
+import (
+	"context"
+)
+
+type contextKey string
+
+const userIDContextKey contextKey = "UserID"
+
+// FromContext returns the ID of the user making the request (if any).
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(userIDContextKey).(string)
+
+	return id, ok && id != ""
+}
+
+// ToContext adds the ID of the user making the request to the context.
+func ToContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, userIDContextKey, id)
+}
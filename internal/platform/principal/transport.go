@@ -0,0 +1,43 @@
+package principal
+
+import (
+	"context"
+	"net/http"
+
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"google.golang.org/grpc/metadata"
+)
+
+// Note: capital letters are invalid in HTTP/2.
+const defaultUserIDHeader = "x-user-id"
+
+// HTTPToContext moves the user ID from the request header to the context (if any).
+func HTTPToContext() kithttp.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		if id := r.Header.Get(defaultUserIDHeader); id != "" {
+			return ToContext(ctx, id)
+		}
+
+		return ctx
+	}
+}
+
+// GRPCToContext moves the user ID from the request metadata to the context (if any).
+func GRPCToContext() kitgrpc.ServerRequestFunc {
+	return func(ctx context.Context, md metadata.MD) context.Context {
+		if id, ok := md[defaultUserIDHeader]; ok && id[0] != "" {
+			return ToContext(ctx, id[0])
+		}
+
+		return ctx
+	}
+}
+
+// HTTPHandler moves the user ID from the request header to the context of handlers
+// that are not served by go-kit (eg. GraphQL).
+func HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(HTTPToContext()(r.Context(), r)))
+	})
+}
@@ -0,0 +1,47 @@
+package principal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestHTTPToContext(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-User-ID", "john")
+
+	id, ok := FromContext(HTTPToContext()(context.Background(), r))
+	assert.True(t, ok)
+	assert.Equal(t, "john", id)
+
+	_, ok = FromContext(HTTPToContext()(context.Background(), httptest.NewRequest(http.MethodGet, "/", nil)))
+	assert.False(t, ok)
+}
+
+func TestGRPCToContext(t *testing.T) {
+	id, ok := FromContext(GRPCToContext()(context.Background(), metadata.Pairs("x-user-id", "john")))
+	assert.True(t, ok)
+	assert.Equal(t, "john", id)
+
+	_, ok = FromContext(GRPCToContext()(context.Background(), metadata.MD{}))
+	assert.False(t, ok)
+}
+
+func TestHTTPHandler(t *testing.T) {
+	var id string
+
+	handler := HTTPHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		id, _ = FromContext(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-User-ID", "john")
+
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	assert.Equal(t, "john", id)
+}
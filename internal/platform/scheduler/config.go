@@ -0,0 +1,44 @@
+package scheduler
+
+import (
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/robfig/cron/v3"
+)
+
+// JobConfig holds the schedule and execution parameters of a job.
+type JobConfig struct {
+	// Schedule is a standard cron expression (or a descriptor like @daily or @every 1h).
+	Schedule string
+
+	// Timeout is the maximum duration of a single attempt.
+	Timeout time.Duration
+
+	// Retries is the number of times a failed attempt is retried.
+	Retries int
+
+	// RetryInterval is the time to wait between attempts.
+	RetryInterval time.Duration
+}
+
+// Validate validates the configuration.
+func (c JobConfig) Validate() error {
+	if c.Schedule == "" {
+		return errors.New("job schedule is required")
+	}
+
+	if _, err := cron.ParseStandard(c.Schedule); err != nil {
+		return errors.WrapIf(err, "invalid job schedule")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("job timeout must be positive")
+	}
+
+	if c.Retries < 0 {
+		return errors.New("job retries cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Locker makes sure a scheduled run of a job is executed by a single instance only.
+type Locker interface {
+	// Acquire acquires a lease for running a job scheduled at the given time.
+	// It returns false if another instance holds the lease or already executed the scheduled run.
+	Acquire(ctx context.Context, job string, scheduledAt time.Time) (Lease, bool, error)
+}
+
+// Lease is held while running a job.
+type Lease interface {
+	// Release releases the lease.
+	Release() error
+}
+
+// NewLocalLocker returns a Locker for single instance deployments.
+func NewLocalLocker() Locker {
+	return &localLocker{
+		running:  make(map[string]bool),
+		lastRuns: make(map[string]time.Time),
+	}
+}
+
+type localLocker struct {
+	mu       sync.Mutex
+	running  map[string]bool
+	lastRuns map[string]time.Time
+}
+
+func (l *localLocker) Acquire(_ context.Context, job string, scheduledAt time.Time) (Lease, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.running[job] || !scheduledAt.After(l.lastRuns[job]) {
+		return nil, false, nil
+	}
+
+	l.running[job] = true
+	l.lastRuns[job] = scheduledAt
+
+	return leaseFunc(func() error {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		delete(l.running, job)
+
+		return nil
+	}), true, nil
+}
+
+type leaseFunc func() error
+
+func (fn leaseFunc) Release() error {
+	return fn()
+}
@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+const leaseTableSchema = `CREATE TABLE IF NOT EXISTS scheduler_leases (
+	job VARCHAR(64) NOT NULL PRIMARY KEY,
+	scheduled_at DATETIME(6) NOT NULL,
+	holder VARCHAR(255) NOT NULL,
+	acquired_at DATETIME(6) NOT NULL
+)`
+
+// MySQLLocker uses MySQL named locks (GET_LOCK) and a lease table
+// to make sure a scheduled run of a job is executed by a single instance only.
+type MySQLLocker struct {
+	db     *sql.DB
+	holder string
+}
+
+// NewMySQLLocker returns a new MySQLLocker.
+// Holder identifies the current instance in the lease table.
+func NewMySQLLocker(db *sql.DB, holder string) MySQLLocker {
+	return MySQLLocker{
+		db:     db,
+		holder: holder,
+	}
+}
+
+// CreateSchema creates the lease table.
+func (l MySQLLocker) CreateSchema(ctx context.Context) error {
+	_, err := l.db.ExecContext(ctx, leaseTableSchema)
+
+	return errors.WrapIf(err, "failed to create scheduler lease table")
+}
+
+// Acquire acquires a lease for running a job scheduled at the given time.
+func (l MySQLLocker) Acquire(ctx context.Context, job string, scheduledAt time.Time) (Lease, bool, error) {
+	// Named locks are bound to the session, so the same connection has to be used for releasing it
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, false, errors.WrapIf(err, "failed to get database connection")
+	}
+
+	lockName := "scheduler." + job
+
+	var acquired sql.NullInt64
+
+	err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", lockName).Scan(&acquired)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, false, errors.WrapIfWithDetails(err, "failed to acquire lock", "job", job)
+	}
+
+	if !acquired.Valid || acquired.Int64 != 1 {
+		_ = conn.Close()
+
+		return nil, false, nil
+	}
+
+	lease := leaseFunc(func() error {
+		defer conn.Close()
+
+		_, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+
+		return errors.WrapIfWithDetails(err, "failed to release lock", "job", job)
+	})
+
+	var lastScheduledAt time.Time
+
+	err = conn.QueryRowContext(ctx, "SELECT scheduled_at FROM scheduler_leases WHERE job = ?", job).
+		Scan(&lastScheduledAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, errors.Combine(errors.WrapIfWithDetails(err, "failed to query lease", "job", job), lease.Release())
+	}
+
+	// Another instance already executed this run
+	if err == nil && !scheduledAt.After(lastScheduledAt) {
+		return nil, false, lease.Release()
+	}
+
+	_, err = conn.ExecContext(
+		ctx,
+		"INSERT INTO scheduler_leases (job, scheduled_at, holder, acquired_at) VALUES (?, ?, ?, ?) "+
+			"ON DUPLICATE KEY UPDATE scheduled_at = VALUES(scheduled_at), holder = VALUES(holder), acquired_at = VALUES(acquired_at)",
+		job, scheduledAt.UTC(), l.holder, time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, false, errors.Combine(errors.WrapIfWithDetails(err, "failed to store lease", "job", job), lease.Release())
+	}
+
+	return lease, true, nil
+}
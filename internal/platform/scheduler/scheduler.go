@@ -0,0 +1,328 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/robfig/cron/v3"
+	"logur.dev/logur"
+)
+
+// maxRecentFailures is the number of job failures kept for debugging purposes.
+const maxRecentFailures = 50
+
+// Job run results.
+const (
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+	ResultSkipped   = "skipped"
+)
+
+// JobFunc is executed when a job runs.
+type JobFunc func(ctx context.Context) error
+
+// ErrorHandler handles an error.
+type ErrorHandler interface {
+	Handle(err error)
+}
+
+// JobStatus holds information about a scheduled job.
+type JobStatus struct {
+	Name     string
+	Schedule string
+
+	Running      bool
+	LastRun      time.Time
+	LastDuration time.Duration
+	LastResult   string
+	LastError    string
+	NextRun      time.Time
+
+	Runs     int64
+	Failures int64
+}
+
+// JobFailure describes a failed job attempt.
+type JobFailure struct {
+	Job     string
+	Attempt int
+	Error   string
+	Time    time.Time
+}
+
+// Scheduler runs recurring jobs according to their cron schedule.
+type Scheduler struct {
+	locker       Locker
+	logger       logur.Logger
+	errorHandler ErrorHandler
+
+	mu       sync.Mutex
+	funcs    map[string]JobFunc
+	jobs     map[string]*job
+	failures []JobFailure
+}
+
+type job struct {
+	fn       JobFunc
+	config   JobConfig
+	schedule cron.Schedule
+	status   JobStatus
+}
+
+// New returns a new Scheduler.
+func New(locker Locker, logger logur.Logger, errorHandler ErrorHandler) *Scheduler {
+	return &Scheduler{
+		locker:       locker,
+		logger:       logger,
+		errorHandler: errorHandler,
+		funcs:        make(map[string]JobFunc),
+		jobs:         make(map[string]*job),
+	}
+}
+
+// RegisterJob registers a job implementation under a name.
+// A registered job only runs once it gets scheduled.
+func (s *Scheduler) RegisterJob(name string, fn JobFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.funcs[name] = fn
+}
+
+// ScheduleJob schedules a registered job.
+func (s *Scheduler) ScheduleJob(name string, config JobConfig) error {
+	if err := config.Validate(); err != nil {
+		return errors.WithDetails(err, "job", name)
+	}
+
+	schedule, err := cron.ParseStandard(config.Schedule)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "invalid job schedule", "job", name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, ok := s.funcs[name]
+	if !ok {
+		return errors.NewWithDetails("unknown job", "job", name)
+	}
+
+	s.jobs[name] = &job{
+		fn:       fn,
+		config:   config,
+		schedule: schedule,
+		status: JobStatus{
+			Name:     name,
+			Schedule: config.Schedule,
+			NextRun:  schedule.Next(time.Now()),
+		},
+	}
+
+	return nil
+}
+
+// Run executes scheduled jobs until the context is canceled.
+func (s *Scheduler) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		next, ok := s.nextRun()
+		if !ok {
+			<-ctx.Done()
+
+			return nil
+		}
+
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil
+
+		case <-timer.C:
+		}
+
+		now := time.Now()
+
+		s.mu.Lock()
+		for name, j := range s.jobs {
+			if j.status.NextRun.After(now) {
+				continue
+			}
+
+			scheduledAt := j.status.NextRun
+			j.status.NextRun = j.schedule.Next(now)
+
+			if j.status.Running {
+				s.logger.Warn("job is still running, skipping scheduled run", map[string]interface{}{"job": name})
+
+				continue
+			}
+
+			j.status.Running = true
+
+			wg.Add(1)
+
+			go func(name string, j *job) {
+				defer wg.Done()
+
+				s.execute(ctx, name, j, scheduledAt)
+			}(name, j)
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *Scheduler) nextRun() (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var next time.Time
+
+	for _, j := range s.jobs {
+		if next.IsZero() || j.status.NextRun.Before(next) {
+			next = j.status.NextRun
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
+func (s *Scheduler) execute(ctx context.Context, name string, j *job, scheduledAt time.Time) {
+	logger := logur.WithFields(s.logger, map[string]interface{}{"job": name})
+	start := time.Now()
+
+	result, err := s.run(ctx, name, j, scheduledAt)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j.status.Running = false
+	j.status.LastResult = result
+	j.status.LastError = ""
+
+	if result == ResultSkipped {
+		logger.Debug("job run skipped: executed by another instance")
+
+		return
+	}
+
+	j.status.Runs++
+	j.status.LastRun = start
+	j.status.LastDuration = time.Since(start)
+
+	if err != nil {
+		j.status.Failures++
+		j.status.LastError = err.Error()
+
+		s.errorHandler.Handle(errors.WithDetails(errors.WithMessage(err, "job failed"), "job", name))
+
+		return
+	}
+
+	logger.Info("job finished", map[string]interface{}{"duration": j.status.LastDuration.String()})
+}
+
+func (s *Scheduler) run(ctx context.Context, name string, j *job, scheduledAt time.Time) (string, error) {
+	lease, acquired, err := s.locker.Acquire(ctx, name, scheduledAt)
+	if err != nil {
+		return ResultFailed, err
+	}
+
+	if !acquired {
+		return ResultSkipped, nil
+	}
+
+	defer func() {
+		if err := lease.Release(); err != nil {
+			s.errorHandler.Handle(errors.WithDetails(err, "job", name))
+		}
+	}()
+
+	for attempt := 0; attempt <= j.config.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ResultFailed, errors.WithStack(ctx.Err())
+
+			case <-time.After(j.config.RetryInterval):
+			}
+		}
+
+		err = s.attempt(ctx, j)
+		if err == nil {
+			return ResultSucceeded, nil
+		}
+
+		s.recordFailure(JobFailure{
+			Job:     name,
+			Attempt: attempt + 1,
+			Error:   err.Error(),
+			Time:    time.Now(),
+		})
+	}
+
+	return ResultFailed, err
+}
+
+func (s *Scheduler) attempt(ctx context.Context, j *job) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, j.config.Timeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.NewWithDetails("job panicked", "panic", fmt.Sprint(r))
+		}
+	}()
+
+	return j.fn(ctx)
+}
+
+func (s *Scheduler) recordFailure(failure JobFailure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, failure)
+
+	if len(s.failures) > maxRecentFailures {
+		s.failures = s.failures[len(s.failures)-maxRecentFailures:]
+	}
+}
+
+// Jobs returns the status of every scheduled job ordered by name.
+func (s *Scheduler) Jobs() []JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]JobStatus, 0, len(s.jobs))
+
+	for _, j := range s.jobs {
+		jobs = append(jobs, j.status)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Name < jobs[j].Name })
+
+	return jobs
+}
+
+// RecentFailures returns the most recent failed job attempts, latest first.
+func (s *Scheduler) RecentFailures() []JobFailure {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	failures := make([]JobFailure, 0, len(s.failures))
+
+	for i := len(s.failures) - 1; i >= 0; i-- {
+		failures = append(failures, s.failures[i])
+	}
+
+	return failures
+}
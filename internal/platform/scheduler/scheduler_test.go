@@ -0,0 +1,142 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"emperror.dev/emperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"logur.dev/logur"
+)
+
+func newTestScheduler() *Scheduler {
+	return New(NewLocalLocker(), logur.NoopLogger{}, emperror.NewNoopHandler())
+}
+
+func TestScheduler_ScheduleJob(t *testing.T) {
+	s := newTestScheduler()
+
+	err := s.ScheduleJob("unknown", JobConfig{Schedule: "@daily", Timeout: time.Minute})
+	assert.EqualError(t, err, "unknown job")
+
+	s.RegisterJob("job", func(ctx context.Context) error { return nil })
+
+	err = s.ScheduleJob("job", JobConfig{Schedule: "61 * * * *", Timeout: time.Minute})
+	assert.Error(t, err)
+
+	err = s.ScheduleJob("job", JobConfig{Schedule: "0 3 * * *", Timeout: time.Minute})
+	require.NoError(t, err)
+
+	jobs := s.Jobs()
+	require.Len(t, jobs, 1)
+
+	assert.Equal(t, "job", jobs[0].Name)
+	assert.Equal(t, 3, jobs[0].NextRun.Hour())
+	assert.Equal(t, 0, jobs[0].NextRun.Minute())
+}
+
+func TestScheduler_Retries(t *testing.T) {
+	s := newTestScheduler()
+
+	var calls int32
+
+	s.RegisterJob("job", func(ctx context.Context) error {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			return errors.New("failed")
+		}
+
+		return nil
+	})
+
+	require.NoError(t, s.ScheduleJob("job", JobConfig{Schedule: "@daily", Timeout: time.Minute, Retries: 2}))
+
+	s.execute(context.Background(), "job", s.jobs["job"], time.Now())
+
+	status := s.Jobs()[0]
+
+	assert.Equal(t, ResultSucceeded, status.LastResult)
+	assert.Equal(t, int64(1), status.Runs)
+	assert.Equal(t, int64(0), status.Failures)
+	assert.Len(t, s.RecentFailures(), 2)
+	assert.Equal(t, 2, s.RecentFailures()[0].Attempt)
+}
+
+func TestScheduler_Timeout(t *testing.T) {
+	s := newTestScheduler()
+
+	s.RegisterJob("job", func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+
+	require.NoError(t, s.ScheduleJob("job", JobConfig{Schedule: "@daily", Timeout: 10 * time.Millisecond}))
+
+	s.execute(context.Background(), "job", s.jobs["job"], time.Now())
+
+	status := s.Jobs()[0]
+
+	assert.Equal(t, ResultFailed, status.LastResult)
+	assert.Equal(t, context.DeadlineExceeded.Error(), status.LastError)
+	assert.Equal(t, int64(1), status.Failures)
+}
+
+func TestScheduler_SingleRunPerSchedule(t *testing.T) {
+	s := newTestScheduler()
+
+	var calls int32
+
+	s.RegisterJob("job", func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+
+		return nil
+	})
+
+	require.NoError(t, s.ScheduleJob("job", JobConfig{Schedule: "@daily", Timeout: time.Minute}))
+
+	scheduledAt := time.Now()
+
+	s.execute(context.Background(), "job", s.jobs["job"], scheduledAt)
+	s.execute(context.Background(), "job", s.jobs["job"], scheduledAt)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, ResultSkipped, s.Jobs()[0].LastResult)
+}
+
+func TestScheduler_Run(t *testing.T) {
+	s := newTestScheduler()
+
+	done := make(chan struct{})
+
+	var calls int32
+
+	s.RegisterJob("job", func(ctx context.Context) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(done)
+		}
+
+		return nil
+	})
+
+	require.NoError(t, s.ScheduleJob("job", JobConfig{Schedule: "@every 1s", Timeout: time.Minute}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stopped := make(chan error)
+	go func() { stopped <- s.Run(ctx) }()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("job did not run")
+	}
+
+	cancel()
+
+	require.NoError(t, <-stopped)
+	assert.Equal(t, int64(1), s.Jobs()[0].Runs)
+}
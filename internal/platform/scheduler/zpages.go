@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"html/template"
+	"net/http"
+	"time"
+)
+
+// nolint: gochecknoglobals
+var jobsTemplate = template.Must(template.New("jobs").Funcs(template.FuncMap{
+	"even": func(i int) bool { return i%2 == 0 },
+	"time": func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+
+		return t.Format(time.RFC3339)
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en"><head>
+    <meta charset="utf-8">
+    <title>Jobs</title>
+    <link rel="stylesheet" href="public/opencensus.css"/>
+</head>
+<body>
+<h1>Jobs</h1>
+
+<table style="border-spacing: 0">
+    <tr>
+        <td><b>Job</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Schedule</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Last run</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Duration</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Result</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Next run</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center"><b>Runs</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center"><b>Failures</b></td>
+    </tr>
+{{range $i, $j := .Jobs}}
+    <tr{{if even $i}} style="background: #eee"{{end}}>
+        <td>{{$j.Name}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$j.Schedule}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{time $j.LastRun}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$j.LastDuration}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{if $j.Running}}running{{else if $j.LastResult}}{{$j.LastResult}}{{else}}-{{end}}{{if $j.LastError}}: {{$j.LastError}}{{end}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{time $j.NextRun}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center">{{$j.Runs}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center">{{$j.Failures}}</td>
+    </tr>
+{{else}}
+    <tr><td colspan="15">No scheduled jobs</td></tr>
+{{end}}
+</table>
+
+<h2>Recent failures</h2>
+<table style="border-spacing: 0">
+    <tr>
+        <td><b>Time</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Job</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center"><b>Attempt</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Error</b></td>
+    </tr>
+{{range $i, $f := .Failures}}
+    <tr{{if even $i}} style="background: #eee"{{end}}>
+        <td>{{time $f.Time}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$f.Job}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center">{{$f.Attempt}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$f.Error}}</td>
+    </tr>
+{{else}}
+    <tr><td colspan="7">No failures</td></tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
+
+// JobsHTTPHandler returns a zpages style HTTP handler listing scheduled jobs and recent failures.
+func JobsHTTPHandler(scheduler *Scheduler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+		_ = jobsTemplate.Execute(w, map[string]interface{}{
+			"Jobs":     scheduler.Jobs(),
+			"Failures": scheduler.RecentFailures(),
+		})
+	})
+}
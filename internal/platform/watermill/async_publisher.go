@@ -0,0 +1,291 @@
+package watermill
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+// Backpressure strategies applied when the publish queue is full.
+const (
+	// BackpressureBlock blocks the caller until there is room in the queue.
+	BackpressureBlock = "block"
+
+	// BackpressureDropOldest drops the oldest message in the queue to make room for the new one.
+	BackpressureDropOldest = "drop-oldest"
+
+	// BackpressureFail rejects the new message with ErrQueueFull.
+	BackpressureFail = "fail"
+)
+
+// ErrQueueFull is returned by an asynchronous publisher using the fail strategy when its queue is full.
+const ErrQueueFull = errors.Sentinel("publish queue is full")
+
+// ErrPublisherClosed is returned when publishing to a closed asynchronous publisher.
+const ErrPublisherClosed = errors.Sentinel("publisher is closed")
+
+// AsyncPublisherConfig holds the configuration of an asynchronous publisher.
+type AsyncPublisherConfig struct {
+	// QueueSize is the maximum number of messages waiting to be published.
+	QueueSize int
+
+	// BatchSize is the maximum number of messages published at once.
+	BatchSize int
+
+	// Backpressure is the strategy applied when the queue is full.
+	Backpressure string
+
+	// FlushTimeout is the time the publisher has to publish queued messages when closed.
+	FlushTimeout time.Duration
+}
+
+// Validate validates the configuration.
+func (c AsyncPublisherConfig) Validate() error {
+	if c.QueueSize < 1 {
+		return errors.New("async publisher queue size must be positive")
+	}
+
+	if c.BatchSize < 1 {
+		return errors.New("async publisher batch size must be positive")
+	}
+
+	switch c.Backpressure {
+	case BackpressureBlock, BackpressureDropOldest, BackpressureFail:
+	default:
+		return errors.New("async publisher backpressure must be block, drop-oldest or fail")
+	}
+
+	return nil
+}
+
+// Async publisher metrics
+// nolint: gochecknoglobals,lll
+var (
+	AsyncQueueLength         = stats.Int64("watermill_async_queue_length", "Number of messages waiting to be published", stats.UnitDimensionless)
+	AsyncDroppedMessageCount = stats.Int64("watermill_async_dropped_message_count", "Number of messages dropped by the asynchronous publisher", stats.UnitDimensionless)
+)
+
+// nolint: gochecknoglobals
+var (
+	AsyncQueueLengthView = &view.View{
+		Name:        "watermill_async_queue_length",
+		Description: "Number of messages waiting to be published",
+		Measure:     AsyncQueueLength,
+		Aggregation: view.LastValue(),
+	}
+
+	AsyncDroppedMessageCountView = &view.View{
+		Name:        "watermill_async_message_dropped_count",
+		Description: "Count of messages dropped by the asynchronous publisher by topic",
+		Measure:     AsyncDroppedMessageCount,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{Topic},
+	}
+)
+
+// ErrorHandler handles an error.
+type ErrorHandler interface {
+	Handle(err error)
+}
+
+// AsyncPublisher buffers messages in a bounded queue and publishes them in batches from a background worker.
+type AsyncPublisher struct {
+	publisher    message.Publisher
+	config       AsyncPublisherConfig
+	errorHandler ErrorHandler
+
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	queue    []queuedMessage
+	closed   bool
+
+	done chan struct{}
+}
+
+type queuedMessage struct {
+	topic string
+	msg   *message.Message
+}
+
+// NewAsyncPublisher returns a new AsyncPublisher and starts its background worker.
+// Errors of publishing batches are passed to the error handler.
+func NewAsyncPublisher(publisher message.Publisher, config AsyncPublisherConfig, errorHandler ErrorHandler) *AsyncPublisher {
+	p := &AsyncPublisher{
+		publisher:    publisher,
+		config:       config,
+		errorHandler: errorHandler,
+		queue:        make([]queuedMessage, 0, config.QueueSize),
+		done:         make(chan struct{}),
+	}
+
+	p.notEmpty = sync.NewCond(&p.mu)
+	p.notFull = sync.NewCond(&p.mu)
+
+	go p.run()
+
+	return p
+}
+
+// Publish queues messages for publishing.
+// When the queue is full, the configured backpressure strategy is applied.
+func (p *AsyncPublisher) Publish(topic string, messages ...*message.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return errors.WithStack(ErrPublisherClosed)
+	}
+
+	if p.config.Backpressure == BackpressureFail && len(p.queue)+len(messages) > p.config.QueueSize {
+		return errors.WithDetails(errors.WithStack(ErrQueueFull), "topic", topic)
+	}
+
+	for _, msg := range messages {
+		for len(p.queue) >= p.config.QueueSize {
+			if p.config.Backpressure == BackpressureDropOldest {
+				p.drop(p.queue[0].topic)
+				p.queue = p.queue[1:]
+
+				continue
+			}
+
+			p.notFull.Wait()
+
+			if p.closed {
+				return errors.WithStack(ErrPublisherClosed)
+			}
+		}
+
+		p.queue = append(p.queue, queuedMessage{topic: topic, msg: msg})
+	}
+
+	p.recordQueueLength()
+	p.notEmpty.Signal()
+
+	return nil
+}
+
+// Close stops accepting new messages and waits for the queued ones to be published
+// until the flush timeout expires. Messages still in the queue after that are dropped
+// and Close returns without waiting for the batch being published (if any).
+// The underlying publisher is left open: closing it is the responsibility of its owner.
+func (p *AsyncPublisher) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+
+		return nil
+	}
+
+	p.closed = true
+	p.notEmpty.Broadcast()
+	p.notFull.Broadcast()
+	p.mu.Unlock()
+
+	var err error
+
+	select {
+	case <-p.done:
+
+	case <-time.After(p.config.FlushTimeout):
+		p.mu.Lock()
+		dropped := len(p.queue)
+		for _, m := range p.queue {
+			p.drop(m.topic)
+		}
+		p.queue = nil
+		p.recordQueueLength()
+		p.mu.Unlock()
+
+		err = errors.NewWithDetails("failed to flush publish queue before deadline", "dropped", dropped)
+	}
+
+	return err
+}
+
+func (p *AsyncPublisher) run() {
+	defer close(p.done)
+
+	for {
+		batch, ok := p.next()
+		if !ok {
+			return
+		}
+
+		// Publish consecutive messages of the same topic together to preserve ordering
+		for start := 0; start < len(batch); {
+			end := start + 1
+			for end < len(batch) && batch[end].topic == batch[start].topic {
+				end++
+			}
+
+			messages := make([]*message.Message, 0, end-start)
+			for _, m := range batch[start:end] {
+				messages = append(messages, m.msg)
+			}
+
+			err := p.publisher.Publish(batch[start].topic, messages...)
+			if err != nil {
+				p.errorHandler.Handle(errors.WithDetails(
+					errors.WithMessage(err, "failed to publish batch"),
+					"topic", batch[start].topic,
+					"messages", len(messages),
+				))
+			}
+
+			start = end
+		}
+	}
+}
+
+// next waits for messages in the queue and returns the next batch.
+// It returns false when the publisher is closed and the queue is empty.
+func (p *AsyncPublisher) next() ([]queuedMessage, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for len(p.queue) == 0 {
+		if p.closed {
+			return nil, false
+		}
+
+		p.notEmpty.Wait()
+	}
+
+	n := len(p.queue)
+	if n > p.config.BatchSize {
+		n = p.config.BatchSize
+	}
+
+	batch := make([]queuedMessage, n)
+	copy(batch, p.queue)
+	p.queue = append(p.queue[:0], p.queue[n:]...)
+
+	p.recordQueueLength()
+	p.notFull.Broadcast()
+
+	return batch, true
+}
+
+// QueueLength returns the number of messages waiting to be published.
+func (p *AsyncPublisher) QueueLength() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.queue)
+}
+
+func (p *AsyncPublisher) drop(topic string) {
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{tag.Upsert(Topic, topic)}, AsyncDroppedMessageCount.M(1))
+}
+
+func (p *AsyncPublisher) recordQueueLength() {
+	stats.Record(context.Background(), AsyncQueueLength.M(int64(len(p.queue))))
+}
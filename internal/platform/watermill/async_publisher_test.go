@@ -0,0 +1,174 @@
+package watermill
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"emperror.dev/emperror"
+	"emperror.dev/errors"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingPublisher struct {
+	mu      sync.Mutex
+	batches [][]string
+	release chan struct{}
+	closed  bool
+}
+
+func (p *recordingPublisher) Publish(topic string, messages ...*message.Message) error {
+	if p.release != nil {
+		<-p.release
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	batch := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		batch = append(batch, topic+":"+msg.UUID)
+	}
+
+	p.batches = append(p.batches, batch)
+
+	return nil
+}
+
+func (p *recordingPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+
+	return nil
+}
+
+func (p *recordingPublisher) published() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var published []string
+	for _, batch := range p.batches {
+		published = append(published, batch...)
+	}
+
+	return published
+}
+
+func TestAsyncPublisher_Batches(t *testing.T) {
+	recorder := &recordingPublisher{release: make(chan struct{})}
+
+	publisher := NewAsyncPublisher(recorder, AsyncPublisherConfig{
+		QueueSize:    10,
+		BatchSize:    2,
+		Backpressure: BackpressureBlock,
+		FlushTimeout: time.Second,
+	}, emperror.NewNoopHandler())
+
+	require.NoError(t, publisher.Publish("a", message.NewMessage("1", nil)))
+
+	// Wait for the worker to pick up the first message so the rest of them get batched
+	require.Eventually(t, func() bool { return publisher.QueueLength() == 0 }, time.Second, time.Millisecond)
+
+	require.NoError(t, publisher.Publish("a", message.NewMessage("2", nil), message.NewMessage("3", nil)))
+	require.NoError(t, publisher.Publish("b", message.NewMessage("4", nil)))
+
+	close(recorder.release)
+
+	require.NoError(t, publisher.Close())
+
+	assert.Equal(t, []string{"a:1", "a:2", "a:3", "b:4"}, recorder.published())
+	assert.Equal(t, [][]string{{"a:1"}, {"a:2", "a:3"}, {"b:4"}}, recorder.batches)
+	assert.False(t, recorder.closed, "the underlying publisher is closed by its owner")
+
+	assert.True(t, errors.Is(publisher.Publish("a", message.NewMessage("5", nil)), ErrPublisherClosed))
+}
+
+func TestAsyncPublisher_Backpressure(t *testing.T) {
+	newPublisher := func(backpressure string) (*AsyncPublisher, *recordingPublisher) {
+		recorder := &recordingPublisher{release: make(chan struct{})}
+
+		publisher := NewAsyncPublisher(recorder, AsyncPublisherConfig{
+			QueueSize:    2,
+			BatchSize:    1,
+			Backpressure: backpressure,
+			FlushTimeout: time.Second,
+		}, emperror.NewNoopHandler())
+
+		// The worker holds the first message until released
+		require.NoError(t, publisher.Publish("topic", message.NewMessage("0", nil)))
+		require.Eventually(t, func() bool { return publisher.QueueLength() == 0 }, time.Second, time.Millisecond)
+
+		require.NoError(t, publisher.Publish("topic", message.NewMessage("1", nil), message.NewMessage("2", nil)))
+
+		return publisher, recorder
+	}
+
+	t.Run("fail", func(t *testing.T) {
+		publisher, recorder := newPublisher(BackpressureFail)
+
+		err := publisher.Publish("topic", message.NewMessage("3", nil))
+		assert.True(t, errors.Is(err, ErrQueueFull))
+
+		close(recorder.release)
+		require.NoError(t, publisher.Close())
+
+		assert.Equal(t, []string{"topic:0", "topic:1", "topic:2"}, recorder.published())
+	})
+
+	t.Run("drop-oldest", func(t *testing.T) {
+		publisher, recorder := newPublisher(BackpressureDropOldest)
+
+		require.NoError(t, publisher.Publish("topic", message.NewMessage("3", nil)))
+
+		close(recorder.release)
+		require.NoError(t, publisher.Close())
+
+		assert.Equal(t, []string{"topic:0", "topic:2", "topic:3"}, recorder.published())
+	})
+
+	t.Run("block", func(t *testing.T) {
+		publisher, recorder := newPublisher(BackpressureBlock)
+
+		published := make(chan error)
+		go func() { published <- publisher.Publish("topic", message.NewMessage("3", nil)) }()
+
+		select {
+		case <-published:
+			t.Fatal("publish should block while the queue is full")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(recorder.release)
+		require.NoError(t, <-published)
+		require.NoError(t, publisher.Close())
+
+		assert.Equal(t, []string{"topic:0", "topic:1", "topic:2", "topic:3"}, recorder.published())
+	})
+}
+
+func TestAsyncPublisher_FlushTimeout(t *testing.T) {
+	recorder := &recordingPublisher{release: make(chan struct{})}
+
+	publisher := NewAsyncPublisher(recorder, AsyncPublisherConfig{
+		QueueSize:    10,
+		BatchSize:    1,
+		Backpressure: BackpressureBlock,
+		FlushTimeout: 10 * time.Millisecond,
+	}, emperror.NewNoopHandler())
+
+	require.NoError(t, publisher.Publish("topic", message.NewMessage("1", nil), message.NewMessage("2", nil)))
+
+	err := publisher.Close()
+	assert.EqualError(t, err, "failed to flush publish queue before deadline")
+	assert.Equal(t, 0, publisher.QueueLength())
+
+	close(recorder.release)
+
+	assert.Eventually(t, func() bool { return len(recorder.published()) == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"topic:1"}, recorder.published(), "dropped messages are not published")
+	assert.False(t, recorder.closed)
+}
@@ -0,0 +1,116 @@
+package watermill
+
+import (
+	"context"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+// Metric tags
+// nolint: gochecknoglobals
+var (
+	HandlerName = tag.MustNewKey("watermill_handler")
+	Topic       = tag.MustNewKey("watermill_topic")
+)
+
+// Message metrics
+// nolint: gochecknoglobals,lll
+var (
+	PublishedMessageCount     = stats.Int64("watermill_published_message_count", "Number of messages published", stats.UnitDimensionless)
+	PublishFailedMessageCount = stats.Int64("watermill_publish_failed_message_count", "Number of messages that failed to be published", stats.UnitDimensionless)
+	ConsumedMessageCount      = stats.Int64("watermill_consumed_message_count", "Number of messages consumed by handlers", stats.UnitDimensionless)
+	FailedMessageCount        = stats.Int64("watermill_failed_message_count", "Number of messages handlers failed to process", stats.UnitDimensionless)
+	RetriedMessageCount       = stats.Int64("watermill_retried_message_count", "Number of message handling retries", stats.UnitDimensionless)
+	HandlerLatency            = stats.Float64("watermill_handler_latency", "Time spent processing a message", stats.UnitMilliseconds)
+)
+
+// nolint: gochecknoglobals
+var (
+	PublishedMessageCountView = &view.View{
+		Name:        "watermill_message_published_count",
+		Description: "Count of messages published by topic",
+		Measure:     PublishedMessageCount,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{Topic},
+	}
+
+	PublishFailedMessageCountView = &view.View{
+		Name:        "watermill_message_publish_failed_count",
+		Description: "Count of messages failed to be published by topic",
+		Measure:     PublishFailedMessageCount,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{Topic},
+	}
+
+	ConsumedMessageCountView = &view.View{
+		Name:        "watermill_message_consumed_count",
+		Description: "Count of messages consumed by handler",
+		Measure:     ConsumedMessageCount,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{HandlerName, Topic},
+	}
+
+	FailedMessageCountView = &view.View{
+		Name:        "watermill_message_failed_count",
+		Description: "Count of messages failed to be processed by handler",
+		Measure:     FailedMessageCount,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{HandlerName, Topic},
+	}
+
+	RetriedMessageCountView = &view.View{
+		Name:        "watermill_message_retried_count",
+		Description: "Count of message handling retries by handler",
+		Measure:     RetriedMessageCount,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{HandlerName, Topic},
+	}
+
+	HandlerLatencyView = &view.View{
+		Name:        "watermill_handler_latency",
+		Description: "Latency distribution of message handlers",
+		Measure:     HandlerLatency,
+		Aggregation: view.Distribution(1, 2, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000),
+		TagKeys:     []tag.Key{HandlerName, Topic},
+	}
+)
+
+// PublisherMetrics decorates a publisher with message metrics.
+func PublisherMetrics(publisher message.Publisher) message.Publisher {
+	return metricsPublisher{
+		Publisher: publisher,
+	}
+}
+
+type metricsPublisher struct {
+	message.Publisher
+}
+
+func (p metricsPublisher) Publish(topic string, messages ...*message.Message) error {
+	err := p.Publisher.Publish(topic, messages...)
+
+	measure := PublishedMessageCount
+	if err != nil {
+		measure = PublishFailedMessageCount
+	}
+
+	for range messages {
+		_ = stats.RecordWithTags(context.Background(), []tag.Mutator{tag.Upsert(Topic, topic)}, measure.M(1))
+	}
+
+	return err
+}
+
+func recordHandlerMetrics(msg *message.Message, ms ...stats.Measurement) {
+	_ = stats.RecordWithTags(
+		msg.Context(),
+		[]tag.Mutator{
+			tag.Upsert(HandlerName, message.HandlerNameFromCtx(msg.Context())),
+			tag.Upsert(Topic, message.SubscribeTopicFromCtx(msg.Context())),
+		},
+		ms...,
+	)
+}
@@ -0,0 +1,333 @@
+package watermill
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opencensus.io/stats"
+)
+
+// maxRecentFailures is the number of handler failures kept for debugging purposes.
+const maxRecentFailures = 50
+
+// RouterMonitor observes a message router: it keeps track of handlers, subscriptions,
+// messages being processed and recent failures.
+type RouterMonitor struct {
+	stallTimeout time.Duration
+
+	mu       sync.Mutex
+	started  time.Time
+	running  bool
+	closed   bool
+	handlers map[string]*HandlerStatus
+	inFlight map[*message.Message]inFlightMessage
+	failures []MessageFailure
+}
+
+// HandlerStatus holds information about a handler registered in the router.
+type HandlerStatus struct {
+	Name       string
+	Topic      string
+	Subscriber string
+
+	Subscribed   bool
+	SubscribedAt time.Time
+	Closed       bool
+
+	Consumed    int64
+	Failed      int64
+	Retried     int64
+	InFlight    int
+	LastMessage time.Time
+}
+
+// MessageFailure describes a message a handler failed to process.
+type MessageFailure struct {
+	Handler     string
+	MessageUUID string
+	Error       string
+	Time        time.Time
+}
+
+type inFlightMessage struct {
+	handler string
+	started time.Time
+}
+
+// NewRouterMonitor returns a new RouterMonitor.
+// A message being processed for longer than stallTimeout is considered stalled.
+func NewRouterMonitor(stallTimeout time.Duration) *RouterMonitor {
+	return &RouterMonitor{
+		stallTimeout: stallTimeout,
+		handlers:     make(map[string]*HandlerStatus),
+		inFlight:     make(map[*message.Message]inFlightMessage),
+	}
+}
+
+// Plugin marks the router running once it starts processing messages.
+func (m *RouterMonitor) Plugin(router *message.Router) error {
+	go func() {
+		<-router.Running()
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.running = true
+		m.started = time.Now()
+	}()
+
+	return nil
+}
+
+// SubscriberConstructor returns a subscriber constructor for event processors
+// that keeps track of the subscriptions of each handler.
+func (m *RouterMonitor) SubscriberConstructor(subscriber message.Subscriber) cqrs.EventsSubscriberConstructor {
+	return func(handlerName string) (message.Subscriber, error) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.handlers[handlerName] = &HandlerStatus{
+			Name:       handlerName,
+			Subscriber: fmt.Sprintf("%T", subscriber),
+		}
+
+		return monitoredSubscriber{
+			Subscriber:  subscriber,
+			handlerName: handlerName,
+			monitor:     m,
+		}, nil
+	}
+}
+
+type monitoredSubscriber struct {
+	message.Subscriber
+
+	handlerName string
+	monitor     *RouterMonitor
+}
+
+func (s monitoredSubscriber) Subscribe(ctx context.Context, topic string) (<-chan *message.Message, error) {
+	messages, err := s.Subscriber.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	s.monitor.update(s.handlerName, func(h *HandlerStatus) {
+		h.Topic = topic
+		h.Subscribed = true
+		h.SubscribedAt = time.Now()
+	})
+
+	// Messages are forwarded, so that the subscription is known to be closed
+	// before the handler (and eventually the router) stops.
+	out := make(chan *message.Message)
+
+	go func() {
+		defer close(out)
+		defer s.monitor.subscriptionClosed(s.handlerName)
+
+		for msg := range messages {
+			select {
+			case out <- msg:
+
+			// The handler stops reading messages when the subscription ends
+			case <-ctx.Done():
+				msg.Nack()
+
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+type attemptsContextKey struct{}
+
+// Middleware records metrics, in-flight messages and failures of message handlers.
+// It should be the outermost middleware in the router.
+func (m *RouterMonitor) Middleware(h message.HandlerFunc) message.HandlerFunc {
+	return func(msg *message.Message) ([]*message.Message, error) {
+		handlerName := message.HandlerNameFromCtx(msg.Context())
+		start := time.Now()
+
+		m.mu.Lock()
+		m.inFlight[msg] = inFlightMessage{handler: handlerName, started: start}
+		m.mu.Unlock()
+
+		m.update(handlerName, func(h *HandlerStatus) {
+			h.Consumed++
+			h.InFlight++
+			h.LastMessage = start
+		})
+
+		var attempts int
+		msg.SetContext(context.WithValue(msg.Context(), attemptsContextKey{}, &attempts))
+
+		messages, err := h(msg)
+
+		m.mu.Lock()
+		delete(m.inFlight, msg)
+		m.mu.Unlock()
+
+		m.update(handlerName, func(h *HandlerStatus) {
+			h.InFlight--
+
+			if err != nil {
+				h.Failed++
+			}
+		})
+
+		measurements := []stats.Measurement{
+			ConsumedMessageCount.M(1),
+			HandlerLatency.M(float64(time.Since(start)) / float64(time.Millisecond)),
+		}
+
+		if err != nil {
+			measurements = append(measurements, FailedMessageCount.M(1))
+
+			m.recordFailure(MessageFailure{
+				Handler:     handlerName,
+				MessageUUID: msg.UUID,
+				Error:       err.Error(),
+				Time:        time.Now(),
+			})
+		}
+
+		recordHandlerMetrics(msg, measurements...)
+
+		return messages, err
+	}
+}
+
+// RetryMiddleware counts message handling retries.
+// It should be placed right after the retry middleware in the router.
+func (m *RouterMonitor) RetryMiddleware(h message.HandlerFunc) message.HandlerFunc {
+	return func(msg *message.Message) ([]*message.Message, error) {
+		if attempts, ok := msg.Context().Value(attemptsContextKey{}).(*int); ok {
+			*attempts++
+
+			if *attempts > 1 {
+				m.update(message.HandlerNameFromCtx(msg.Context()), func(h *HandlerStatus) {
+					h.Retried++
+				})
+
+				recordHandlerMetrics(msg, RetriedMessageCount.M(1))
+			}
+		}
+
+		return h(msg)
+	}
+}
+
+func (m *RouterMonitor) update(handlerName string, fn func(h *HandlerStatus)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, ok := m.handlers[handlerName]
+	if !ok {
+		h = &HandlerStatus{Name: handlerName}
+		m.handlers[handlerName] = h
+	}
+
+	fn(h)
+}
+
+// subscriptionClosed marks the subscription of a handler closed.
+// The router stops once all of its handlers stop, so it's considered closed when every subscription is closed.
+func (m *RouterMonitor) subscriptionClosed(handlerName string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	closed := true
+
+	for name, h := range m.handlers {
+		if name == handlerName {
+			h.Closed = true
+		}
+
+		if h.Subscribed && !h.Closed {
+			closed = false
+		}
+	}
+
+	m.closed = closed
+}
+
+func (m *RouterMonitor) recordFailure(failure MessageFailure) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.failures = append(m.failures, failure)
+
+	if len(m.failures) > maxRecentFailures {
+		m.failures = m.failures[len(m.failures)-maxRecentFailures:]
+	}
+}
+
+// Handlers returns the status of every known handler ordered by name.
+func (m *RouterMonitor) Handlers() []HandlerStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	handlers := make([]HandlerStatus, 0, len(m.handlers))
+
+	for _, h := range m.handlers {
+		handlers = append(handlers, *h)
+	}
+
+	sort.Slice(handlers, func(i, j int) bool { return handlers[i].Name < handlers[j].Name })
+
+	return handlers
+}
+
+// RecentFailures returns the most recent handler failures, latest first.
+func (m *RouterMonitor) RecentFailures() []MessageFailure {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	failures := make([]MessageFailure, 0, len(m.failures))
+
+	for i := len(m.failures) - 1; i >= 0; i-- {
+		failures = append(failures, m.failures[i])
+	}
+
+	return failures
+}
+
+// Check checks whether the router is running and processes messages in a timely manner.
+// It implements the check function of a health checker.
+func (m *RouterMonitor) Check() (interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil, errors.New("message router is closed")
+	}
+
+	if !m.running {
+		return nil, errors.New("message router is not running")
+	}
+
+	for _, msg := range m.inFlight {
+		if since := time.Since(msg.started); since > m.stallTimeout {
+			return nil, errors.NewWithDetails(
+				"message router is stalled",
+				"handler", msg.handler,
+				"processing_time", since.String(),
+			)
+		}
+	}
+
+	return map[string]interface{}{
+		"handlers":  len(m.handlers),
+		"in_flight": len(m.inFlight),
+		"uptime":    time.Since(m.started).String(),
+	}, nil
+}
@@ -0,0 +1,129 @@
+package watermill
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"logur.dev/logur"
+)
+
+func TestRouterMonitor(t *testing.T) {
+	monitor := NewRouterMonitor(time.Minute)
+
+	_, err := monitor.Check()
+	assert.EqualError(t, err, "message router is not running")
+
+	pubsub := gochannel.NewGoChannel(gochannel.Config{}, nil)
+
+	router, err := NewRouter(logur.NoopLogger{}, monitor)
+	require.NoError(t, err)
+
+	subscriber, err := monitor.SubscriberConstructor(pubsub)("handler")
+	require.NoError(t, err)
+
+	var calls int
+	done := make(chan struct{})
+
+	router.AddNoPublisherHandler("handler", "topic", subscriber, func(msg *message.Message) error {
+		calls++
+
+		if calls == 1 {
+			return errors.New("failed")
+		}
+
+		close(done)
+
+		return nil
+	})
+
+	go func() { _ = router.Run(context.Background()) }()
+	<-router.Running()
+
+	require.NoError(t, pubsub.Publish("topic", message.NewMessage("1234", nil)))
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not processed")
+	}
+
+	require.Eventually(t, func() bool {
+		_, err := monitor.Check()
+
+		return err == nil && monitor.Handlers()[0].InFlight == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	handlers := monitor.Handlers()
+	require.Len(t, handlers, 1)
+
+	assert.Equal(t, "handler", handlers[0].Name)
+	assert.Equal(t, "topic", handlers[0].Topic)
+	assert.True(t, handlers[0].Subscribed)
+	assert.Equal(t, int64(1), handlers[0].Consumed)
+	assert.Equal(t, int64(1), handlers[0].Retried)
+	assert.Equal(t, int64(0), handlers[0].Failed)
+
+	require.NoError(t, router.Close())
+
+	_, err = monitor.Check()
+	assert.EqualError(t, err, "message router is closed")
+	assert.True(t, monitor.Handlers()[0].Closed)
+}
+
+func TestRouterMonitor_Stalled(t *testing.T) {
+	monitor := NewRouterMonitor(time.Millisecond)
+	monitor.running = true
+
+	msg := message.NewMessage("1234", nil)
+	monitor.inFlight[msg] = inFlightMessage{handler: "handler", started: time.Now().Add(-time.Second)}
+
+	_, err := monitor.Check()
+	assert.EqualError(t, err, "message router is stalled")
+}
+
+// channelSubscriber subscribes to a single channel of messages.
+type channelSubscriber struct {
+	messages chan *message.Message
+}
+
+func (s channelSubscriber) Subscribe(_ context.Context, _ string) (<-chan *message.Message, error) {
+	return s.messages, nil
+}
+
+func (s channelSubscriber) Close() error {
+	return nil
+}
+
+func TestRouterMonitor_SubscriptionEnds(t *testing.T) {
+	monitor := NewRouterMonitor(time.Minute)
+
+	upstream := channelSubscriber{messages: make(chan *message.Message, 1)}
+
+	subscriber, err := monitor.SubscriberConstructor(upstream)("handler")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err = subscriber.Subscribe(ctx, "topic")
+	require.NoError(t, err)
+
+	// Nobody reads the messages of the subscription
+	msg := message.NewMessage("1", nil)
+	upstream.messages <- msg
+
+	cancel()
+
+	select {
+	case <-msg.Nacked():
+	case <-time.After(time.Second):
+		t.Fatal("message was not nacked when the subscription ended")
+	}
+
+	assert.Eventually(t, func() bool { return monitor.Handlers()[0].Closed }, time.Second, time.Millisecond)
+}
@@ -11,7 +11,7 @@ import (
 )
 
 // NewRouter returns a new message router for message subscription logic.
-func NewRouter(logger logur.Logger) (*message.Router, error) {
+func NewRouter(logger logur.Logger, monitor *RouterMonitor) (*message.Router, error) {
 	h, err := message.NewRouter(
 		message.RouterConfig{},
 		watermilllog.New(logur.WithField(logger, "component", "watermill")),
@@ -24,10 +24,18 @@ func NewRouter(logger logur.Logger) (*message.Router, error) {
 	retryMiddleware.MaxRetries = 1
 	retryMiddleware.MaxInterval = time.Millisecond * 10
 
+	h.AddPlugin(monitor.Plugin)
+
 	h.AddMiddleware(
+		// monitor records metrics and failures of the whole message processing (including retries)
+		monitor.Middleware,
+
 		// if retries limit was exceeded, message is sent to poison queue (poison_queue topic)
 		retryMiddleware.Middleware,
 
+		// counts every attempt after the first one as a retry
+		monitor.RetryMiddleware,
+
 		// recovered recovers panic from handlers
 		middleware.Recoverer,
 
@@ -0,0 +1,113 @@
+package watermill
+
+import (
+	"html/template"
+	"net/http"
+	"time"
+)
+
+// nolint: gochecknoglobals
+var eventsTemplate = template.Must(template.New("events").Funcs(template.FuncMap{
+	"even": func(i int) bool { return i%2 == 0 },
+	"time": func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+
+		return t.Format(time.RFC3339)
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en"><head>
+    <meta charset="utf-8">
+    <title>Events</title>
+    <link rel="stylesheet" href="public/opencensus.css"/>
+</head>
+<body>
+<h1>Events</h1>
+<p>Router status: <b>{{.Status}}</b></p>
+
+<h2>Handlers</h2>
+<table style="border-spacing: 0">
+    <tr>
+        <td><b>Handler</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Topic</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center"><b>Consumed</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center"><b>Failed</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center"><b>Retried</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center"><b>In flight</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Last message</b></td>
+    </tr>
+{{range $i, $h := .Handlers}}
+    <tr{{if even $i}} style="background: #eee"{{end}}>
+        <td>{{$h.Name}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$h.Topic}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center">{{$h.Consumed}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center">{{$h.Failed}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center">{{$h.Retried}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td align="center">{{$h.InFlight}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{time $h.LastMessage}}</td>
+    </tr>
+{{end}}
+</table>
+
+<h2>Subscriptions</h2>
+<table style="border-spacing: 0">
+    <tr>
+        <td><b>Topic</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Handler</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Subscriber</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Subscribed at</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>State</b></td>
+    </tr>
+{{range $i, $h := .Handlers}}
+    <tr{{if even $i}} style="background: #eee"{{end}}>
+        <td>{{$h.Topic}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$h.Name}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$h.Subscriber}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{time $h.SubscribedAt}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{if $h.Closed}}closed{{else if $h.Subscribed}}subscribed{{else}}pending{{end}}</td>
+    </tr>
+{{end}}
+</table>
+
+<h2>Recent failures</h2>
+<table style="border-spacing: 0">
+    <tr>
+        <td><b>Time</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Handler</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Message</b></td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td><b>Error</b></td>
+    </tr>
+{{range $i, $f := .Failures}}
+    <tr{{if even $i}} style="background: #eee"{{end}}>
+        <td>{{time $f.Time}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$f.Handler}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$f.MessageUUID}}</td><td>&nbsp;&nbsp;|&nbsp;&nbsp;</td>
+        <td>{{$f.Error}}</td>
+    </tr>
+{{else}}
+    <tr><td colspan="7">No failures</td></tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
+
+// EventsHTTPHandler returns a zpages style HTTP handler listing message handlers,
+// subscriptions and recent failures.
+func EventsHTTPHandler(monitor *RouterMonitor) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := "running"
+		if _, err := monitor.Check(); err != nil {
+			status = err.Error()
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+		_ = eventsTemplate.Execute(w, map[string]interface{}{
+			"Status":   status,
+			"Handlers": monitor.Handlers(),
+			"Failures": monitor.RecentFailures(),
+		})
+	})
+}
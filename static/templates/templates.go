@@ -2,10 +2,10 @@ package templates
 
 import "embed"
 
-//go:embed landing.html
+//go:embed landing.html email
 var files embed.FS
 
-// Files returns a filesystem with static files.
+// Files returns a filesystem with static files (the landing page and email templates).
 func Files() embed.FS {
 	return files
 }